@@ -198,6 +198,11 @@ var (
 			earnclient.WithdrawProposalHandler,
 			communityclient.LendDepositProposalHandler,
 			communityclient.LendWithdrawProposalHandler,
+			communityclient.PaymentStreamProposalHandler,
+			communityclient.CancelPaymentStreamProposalHandler,
+			communityclient.SwapProposalHandler,
+			communityclient.ProvideLiquidityProposalHandler,
+			communityclient.WithdrawLiquidityProposalHandler,
 		}),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -240,29 +245,33 @@ var (
 	// If these are changed, the permissions stored in accounts
 	// must also be migrated during a chain upgrade.
 	mAccPerms = map[string][]string{
-		authtypes.FeeCollectorName:      nil,
-		distrtypes.ModuleName:           nil,
-		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:             {authtypes.Burner},
-		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:             {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		evmutiltypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-		kavadisttypes.KavaDistMacc:      {authtypes.Minter},
-		auctiontypes.ModuleName:         nil,
-		issuancetypes.ModuleAccountName: {authtypes.Minter, authtypes.Burner},
-		bep3types.ModuleName:            {authtypes.Burner, authtypes.Minter},
-		swaptypes.ModuleName:            nil,
-		cdptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-		cdptypes.LiquidatorMacc:         {authtypes.Minter, authtypes.Burner},
-		hardtypes.ModuleAccountName:     {authtypes.Minter},
-		savingstypes.ModuleAccountName:  nil,
-		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
-		kavadisttypes.FundModuleAccount: nil,
-		minttypes.ModuleName:            {authtypes.Minter},
-		communitytypes.ModuleName:       nil,
-		precisebanktypes.ModuleName:     {authtypes.Minter, authtypes.Burner}, // used for reserve account to back fractional amounts
+		authtypes.FeeCollectorName:          nil,
+		distrtypes.ModuleName:               nil,
+		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                 {authtypes.Burner},
+		ibctransfertypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:                 {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		evmutiltypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
+		kavadisttypes.KavaDistMacc:          {authtypes.Minter},
+		auctiontypes.ModuleName:             nil,
+		issuancetypes.ModuleAccountName:     {authtypes.Minter, authtypes.Burner},
+		bep3types.ModuleName:                {authtypes.Burner, authtypes.Minter},
+		swaptypes.ModuleName:                nil,
+		cdptypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		cdptypes.LiquidatorMacc:             {authtypes.Minter, authtypes.Burner},
+		hardtypes.ModuleAccountName:         {authtypes.Minter},
+		incentivetypes.BoostLockAccountName: nil,
+		incentivetypes.CampaignAccountName:  nil,
+		savingstypes.ModuleAccountName:      nil,
+		liquidtypes.ModuleAccountName:       {authtypes.Minter, authtypes.Burner},
+		liquidtypes.UnstakePoolAccountName:  nil,
+		earntypes.ModuleAccountName:         nil,
+		earntypes.CDPStrategyAccountName:    nil,
+		kavadisttypes.FundModuleAccount:     nil,
+		minttypes.ModuleName:                {authtypes.Minter},
+		communitytypes.ModuleName:           nil,
+		precisebanktypes.ModuleName:         {authtypes.Minter, authtypes.Burner}, // used for reserve account to back fractional amounts
 	}
 )
 
@@ -396,6 +405,7 @@ func NewApp(
 		committeetypes.StoreKey, incentivetypes.StoreKey, evmutiltypes.StoreKey,
 		savingstypes.StoreKey, earntypes.StoreKey, minttypes.StoreKey,
 		consensusparamtypes.StoreKey, crisistypes.StoreKey, precisebanktypes.StoreKey,
+		liquidtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -465,13 +475,15 @@ func NewApp(
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
 		govAuthAddrStr,
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
+	baseBankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.accountKeeper,
 		app.loadBlockedMaccAddrs(),
 		govAuthAddrStr,
 	)
+	// Restrictions on issued assets are enforced on every transfer. The issuance keeper is set later.
+	app.bankKeeper = issuancekeeper.NewTransferRestrictedBankKeeper(baseBankKeeper, &app.issuanceKeeper)
 	app.stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -626,7 +638,7 @@ func NewApp(
 		keys[issuancetypes.StoreKey],
 		issuanceSubspace,
 		app.accountKeeper,
-		app.bankKeeper,
+		baseBankKeeper, // the issuer can seize coins from blocked addresses
 	)
 	app.bep3Keeper = bep3keeper.NewKeeper(
 		appCodec,
@@ -669,10 +681,13 @@ func NewApp(
 	)
 	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
+		keys[liquidtypes.StoreKey],
+		keys[stakingtypes.StoreKey],
 		app.accountKeeper,
 		app.bankKeeper,
 		app.stakingKeeper,
 		&app.distrKeeper,
+		govAuthAddr,
 	)
 	savingsKeeper := savingskeeper.NewKeeper(
 		appCodec,
@@ -691,7 +706,11 @@ func NewApp(
 		&app.liquidKeeper,
 		&hardKeeper,
 		&savingsKeeper,
+		&swapKeeper,
+		&cdpKeeper,
+		app.pricefeedKeeper,
 		&app.distrKeeper,
+		govAuthAddr,
 	)
 
 	app.kavadistKeeper = kavadistkeeper.NewKeeper(
@@ -726,6 +745,7 @@ func NewApp(
 		&app.mintKeeper,
 		&app.kavadistKeeper,
 		app.stakingKeeper,
+		&swapKeeper,
 		govAuthAddr,
 	)
 
@@ -746,17 +766,42 @@ func NewApp(
 		app.distrKeeper,
 		app.pricefeedKeeper,
 	)
+	// register the reward sources of the built in reward types
+	app.incentiveKeeper.RegisterRewardSource(incentivetypes.USDXMintingRewardSourceType, incentivekeeper.NewUSDXMintingRewardSource(&cdpKeeper))
+	app.incentiveKeeper.RegisterRewardSource(incentivetypes.HardSupplyRewardSourceType, incentivekeeper.NewHardSupplyRewardSource(&hardKeeper))
+	app.incentiveKeeper.RegisterRewardSource(incentivetypes.HardBorrowRewardSourceType, incentivekeeper.NewHardBorrowRewardSource(&hardKeeper))
+	app.incentiveKeeper.RegisterRewardSource(incentivetypes.DelegatorRewardSourceType, incentivekeeper.NewDelegatorRewardSource(app.stakingKeeper))
+	app.incentiveKeeper.RegisterRewardSource(incentivetypes.SwapRewardSourceType, incentivekeeper.NewSwapRewardSource(&swapKeeper))
+	app.incentiveKeeper.RegisterRewardSource(incentivetypes.SavingsRewardSourceType, incentivekeeper.NewSavingsRewardSource(&savingsKeeper))
+	app.incentiveKeeper.RegisterRewardSource(incentivetypes.EarnRewardSourceType, incentivekeeper.NewEarnRewardSource(&earnKeeper))
 	app.routerKeeper = routerkeeper.NewKeeper(
 		&app.earnKeeper,
 		app.liquidKeeper,
 		app.stakingKeeper,
 	)
 
+	// register the staking hooks
+	app.stakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(
+			app.distrKeeper.Hooks(),
+			app.slashingKeeper.Hooks(),
+			app.incentiveKeeper.Hooks(),
+			app.liquidKeeper.Hooks(),
+		))
+
+	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
+	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
+	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
+	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
+	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
+	app.liquidKeeper.SetHooks(app.routerKeeper.Hooks())
+
 	// create committee keeper with router
 	committeeGovRouter := govv1beta1.NewRouter()
 	committeeGovRouter.
 		AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
 		AddRoute(communitytypes.RouterKey, community.NewCommunityPoolProposalHandler(app.communityKeeper)).
+		AddRoute(earntypes.RouterKey, earn.NewCommunityPoolProposalHandler(app.earnKeeper)).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(&app.upgradeKeeper))
 	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
@@ -765,25 +810,13 @@ func NewApp(
 		appCodec,
 		keys[committeetypes.StoreKey],
 		committeeGovRouter,
+		app.MsgServiceRouter(),
 		app.paramsKeeper,
 		app.accountKeeper,
 		app.bankKeeper,
+		govAuthAddr,
 	)
 
-	// register the staking hooks
-	app.stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(
-			app.distrKeeper.Hooks(),
-			app.slashingKeeper.Hooks(),
-			app.incentiveKeeper.Hooks(),
-		))
-
-	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
-	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
-	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
-	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
-	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
-
 	// create gov keeper with router
 	// NOTE this must be done after any keepers referenced in the gov router (ie committee) are defined
 	govRouter := govv1beta1.NewRouter()
@@ -823,7 +856,7 @@ func NewApp(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts, authSubspace),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper, bankSubspace),
+		newRestrictedBankModule(appCodec, app.bankKeeper, baseBankKeeper, app.accountKeeper, bankSubspace),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper, false), // todo: confirm if this is okay to not be sealed
 		staking.NewAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper, stakingSubspace),
 		distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper, distrSubspace),
@@ -854,7 +887,7 @@ func NewApp(
 		incentive.NewAppModule(app.incentiveKeeper, app.accountKeeper, app.bankKeeper, app.cdpKeeper),
 		evmutil.NewAppModule(app.evmutilKeeper, app.bankKeeper, app.accountKeeper),
 		savings.NewAppModule(app.savingsKeeper, app.accountKeeper, app.bankKeeper),
-		liquid.NewAppModule(app.liquidKeeper),
+		liquid.NewAppModule(app.liquidKeeper, app.accountKeeper),
 		earn.NewAppModule(app.earnKeeper, app.accountKeeper, app.bankKeeper),
 		router.NewAppModule(app.routerKeeper),
 		// nil InflationCalculationFn, use SDK's default inflation function
@@ -895,7 +928,16 @@ func NewApp(
 		bep3types.ModuleName,
 		hardtypes.ModuleName,
 		issuancetypes.ModuleName,
+		// Incentive begin blocker accumulates rewards for the time since the last block. It runs before liquid and earn
+		// change the delegations and vault shares the rewards are shared over.
 		incentivetypes.ModuleName,
+		// Liquid begin blocker undelegates the unstake pool and mints the derivatives of redelegations completed by the
+		// staking end blocker, depositing them back into earn. It runs before earn so the deposits are in the vaults
+		// earn processes.
+		liquidtypes.ModuleName,
+		// Earn begin blocker accrues vault fees and pays queued withdrawals from strategies, so it runs after hard has
+		// accrued interest on strategy deposits.
+		earntypes.ModuleName,
 		ibcexported.ModuleName,
 		// Add all remaining modules with an empty begin blocker below since cosmos 0.45.0 requires it
 		swaptypes.ModuleName,
@@ -912,8 +954,6 @@ func NewApp(
 		authz.ModuleName,
 		evmutiltypes.ModuleName,
 		savingstypes.ModuleName,
-		liquidtypes.ModuleName,
-		earntypes.ModuleName,
 		routertypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		packetforwardtypes.ModuleName,
@@ -1216,9 +1256,11 @@ func (app *App) loadBlockedMaccAddrs() map[string]bool {
 		// kavadist
 		app.accountKeeper.GetModuleAddress(kavadisttypes.ModuleName).String(): true,
 		// earn
-		app.accountKeeper.GetModuleAddress(earntypes.ModuleName).String(): true,
+		app.accountKeeper.GetModuleAddress(earntypes.ModuleName).String():             true,
+		app.accountKeeper.GetModuleAddress(earntypes.CDPStrategyAccountName).String(): true,
 		// liquid
-		app.accountKeeper.GetModuleAddress(liquidtypes.ModuleName).String(): true,
+		app.accountKeeper.GetModuleAddress(liquidtypes.ModuleName).String():             true,
+		app.accountKeeper.GetModuleAddress(liquidtypes.UnstakePoolAccountName).String(): true,
 		// kavadist fund
 		app.accountKeeper.GetModuleAddress(kavadisttypes.FundModuleAccount).String(): true,
 		// community
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// restrictedBankModule is the x/bank module with its msg server using a wrapped bank keeper, so that bank sends are
+// subject to the same restrictions as transfers made by other modules.
+// The x/bank module only accepts a BaseKeeper, which is still used for genesis, queries and migrations.
+type restrictedBankModule struct {
+	bank.AppModule
+
+	keeper         bankkeeper.Keeper
+	baseKeeper     bankkeeper.BaseKeeper
+	legacySubspace exported.Subspace
+}
+
+func newRestrictedBankModule(
+	cdc codec.Codec, keeper bankkeeper.Keeper, baseKeeper bankkeeper.BaseKeeper, ak banktypes.AccountKeeper, ss exported.Subspace,
+) restrictedBankModule {
+	return restrictedBankModule{
+		AppModule:      bank.NewAppModule(cdc, baseKeeper, ak, ss),
+		keeper:         keeper,
+		baseKeeper:     baseKeeper,
+		legacySubspace: ss,
+	}
+}
+
+// RegisterServices registers module services, using the wrapped keeper for the msg server.
+func (am restrictedBankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.baseKeeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 3 to 4: %v", err))
+	}
+}
@@ -58,7 +58,7 @@ func (suite *ABCITestSuite) ResetKeeper() {
 		randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 
 		// Create atomic swap and check err to confirm creation
-		err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, timestamp, expireHeight,
+		err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, types.HASH_SCHEME_BEP3, timestamp, expireHeight, 0,
 			suite.addrs[11], suite.addrs[i], TestSenderOtherChain, TestRecipientOtherChain,
 			amount, true)
 		suite.Nil(err)
@@ -19,6 +19,11 @@ import (
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
+const (
+	flagTimeSpan   = "time-span"
+	flagHashScheme = "hash-scheme"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	bep3TxCmd := &cobra.Command{
@@ -46,10 +51,13 @@ func GetTxCmd() *cobra.Command {
 
 // GetCmdCreateAtomicSwap cli command for creating atomic swaps
 func GetCmdCreateAtomicSwap() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "create [to] [recipient-other-chain] [sender-other-chain] [timestamp] [coins] [height-span]",
 		Short: "create a new atomic swap",
-		Example: fmt.Sprintf("%s tx %s create kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7 bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7 now 100bnb 270 --from validator",
+		Long: `Create a new atomic swap. Generic htlc swaps may set --time-span to expire by time instead of height, in which
+case height-span must be 0, and may set --hash-scheme=sha256 to lock the swap with a plain sha256 hash of the random number.`,
+		Example: fmt.Sprintf(`%[1]s tx %[2]s create kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7 bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7 now 100bnb 270 --from validator
+%[1]s tx %[2]s create kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj 0x8ba1f109551bD432803012645Ac136ddd64DBA72 0x8ba1f109551bD432803012645Ac136ddd64DBA72 now 1000000ukava 0 --time-span 24h --hash-scheme sha256 --from accA`,
 			version.AppName, types.ModuleName),
 		Args: cobra.ExactArgs(6),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -84,7 +92,11 @@ func GetCmdCreateAtomicSwap() *cobra.Command {
 				return err
 			}
 
-			randomNumberHash := types.CalculateRandomHash(randomNumber, timestamp)
+			hashScheme, err := types.NewHashSchemeFromString(cmd.Flag(flagHashScheme).Value.String())
+			if err != nil {
+				return err
+			}
+			randomNumberHash := types.CalculateSchemeHash(hashScheme, randomNumber, timestamp)
 
 			// Print random number, timestamp, and hash to user's console
 			fmt.Printf("\nRandom number: %s\n", hex.EncodeToString(randomNumber))
@@ -101,9 +113,17 @@ func GetCmdCreateAtomicSwap() *cobra.Command {
 				return err
 			}
 
+			timeSpan, err := cmd.Flags().GetDuration(flagTimeSpan)
+			if err != nil {
+				return err
+			}
+			if timeSpan < 0 {
+				return fmt.Errorf("time span cannot be negative: %s", timeSpan)
+			}
+
 			msg := types.NewMsgCreateAtomicSwap(
 				from.String(), to.String(), recipientOtherChain, senderOtherChain,
-				randomNumberHash, timestamp, coins, heightSpan,
+				randomNumberHash, timestamp, coins, heightSpan, uint64(timeSpan.Seconds()), hashScheme,
 			)
 
 			err = msg.ValidateBasic()
@@ -114,6 +134,11 @@ func GetCmdCreateAtomicSwap() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Duration(flagTimeSpan, 0, "expire the swap after this duration instead of after height-span blocks (generic htlc swaps only)")
+	cmd.Flags().String(flagHashScheme, "bep3", "hash scheme used to lock the swap, bep3 or sha256 (sha256 is for generic htlc swaps only)")
+
+	return cmd
 }
 
 // GetCmdClaimAtomicSwap cli command for claiming an atomic swap
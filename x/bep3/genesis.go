@@ -53,6 +53,24 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, accountKeeper types.Acco
 			panic(fmt.Sprintf("invalid swap %s", swap.GetSwapID()))
 		}
 
+		// HTLC swaps escrow their coins in the module account and are not tracked in asset supplies
+		if swap.SwapType == types.SWAP_TYPE_HTLC {
+			if _, found := keeper.GetHTLCAsset(ctx, swap.Amount[0].Denom); !found {
+				panic(fmt.Sprintf("swap has invalid htlc asset: %s", swap.Amount[0].Denom))
+			}
+			keeper.SetAtomicSwap(ctx, swap)
+			switch swap.Status {
+			case types.SWAP_STATUS_OPEN:
+				keeper.InsertIntoByBlockIndex(ctx, swap)
+			case types.SWAP_STATUS_EXPIRED:
+			case types.SWAP_STATUS_COMPLETED:
+				keeper.InsertIntoLongtermStorage(ctx, swap)
+			default:
+				panic(fmt.Sprintf("swap %s has invalid status %s", swap.GetSwapID(), swap.Status.String()))
+			}
+			continue
+		}
+
 		// Atomic swap assets must be both supported and active
 		err := keeper.ValidateLiveAsset(ctx, swap.Amount[0])
 		if err != nil {
@@ -2,6 +2,7 @@ package bep3_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -177,7 +178,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := types.NewAtomicSwap(cs(c("bnb", overLimitAmount.Int64())), randomNumberHash,
 					types.DefaultMinBlockLock, timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_INCOMING)
+					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_INCOMING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 				gs.AtomicSwaps = types.AtomicSwaps{swap}
 
 				// Set up asset supply with overlimit current supply
@@ -216,7 +217,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := types.NewAtomicSwap(cs(c("bnb", halfLimit)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_INCOMING)
+					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_INCOMING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 				gs.AtomicSwaps = types.AtomicSwaps{swap}
 
 				// Set up asset supply with overlimit supply
@@ -254,7 +255,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := types.NewAtomicSwap(cs(c("bnb", overLimitAmount.Int64())), randomNumberHash,
 					types.DefaultMinBlockLock, timestamp, addrs[1], suite.addrs[0], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_OUTGOING)
+					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_OUTGOING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 				gs.AtomicSwaps = types.AtomicSwaps{swap}
 
 				// Set up asset supply with overlimit outgoing supply
@@ -300,7 +301,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := types.NewAtomicSwap(cs(c("fake", 500000)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_INCOMING)
+					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_INCOMING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 
 				gs.AtomicSwaps = types.AtomicSwaps{swap}
 				return app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&gs)}
@@ -308,6 +309,44 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 			expectPass:  false,
 			expectedErr: "swap has invalid asset: fake: asset not found",
 		},
+		{
+			name: "htlc atomic swap",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				gs.Params.HTLCAssetParams = types.HTLCAssetParams{
+					types.NewHTLCAssetParam("ukava", true, math.NewInt(1), math.NewInt(1000000), 10, 1000, time.Minute, time.Hour),
+				}
+				_, addrs := app.GeneratePrivKeyAddressPairs(2)
+				timestamp := ts(0)
+				randomNumber, _ := types.GenerateSecureRandomNumber()
+				randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
+				swap := types.NewAtomicSwap(cs(c("ukava", 5000)), randomNumberHash,
+					uint64(360), timestamp, addrs[0], addrs[1], TestSenderOtherChain,
+					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_UNSPECIFIED, types.SWAP_TYPE_HTLC, types.HASH_SCHEME_BEP3, 0)
+
+				gs.AtomicSwaps = types.AtomicSwaps{swap}
+				return app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&gs)}
+			},
+			expectPass: true,
+		},
+		{
+			name: "htlc atomic swap asset is unsupported",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				_, addrs := app.GeneratePrivKeyAddressPairs(2)
+				timestamp := ts(0)
+				randomNumber, _ := types.GenerateSecureRandomNumber()
+				randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
+				swap := types.NewAtomicSwap(cs(c("ukava", 5000)), randomNumberHash,
+					uint64(360), timestamp, addrs[0], addrs[1], TestSenderOtherChain,
+					TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_UNSPECIFIED, types.SWAP_TYPE_HTLC, types.HASH_SCHEME_BEP3, 0)
+
+				gs.AtomicSwaps = types.AtomicSwaps{swap}
+				return app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&gs)}
+			},
+			expectPass:  false,
+			expectedErr: "swap has invalid htlc asset: ukava",
+		},
 		{
 			name: "atomic swap status is invalid",
 			genState: func() app.GenesisState {
@@ -318,7 +357,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := types.NewAtomicSwap(cs(c("bnb", 5000)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, types.SWAP_STATUS_UNSPECIFIED, true, types.SWAP_DIRECTION_INCOMING)
+					TestRecipientOtherChain, 0, types.SWAP_STATUS_UNSPECIFIED, true, types.SWAP_DIRECTION_INCOMING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 
 				gs.AtomicSwaps = types.AtomicSwaps{swap}
 				return app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&gs)}
@@ -105,7 +105,7 @@ func loadSwapAndSupply(addr sdk.AccAddress, index int) (types.AtomicSwap, types.
 	randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 	swap := types.NewAtomicSwap(cs(coin), randomNumberHash,
 		expireOffset, timestamp, addr, addr, TestSenderOtherChain,
-		TestRecipientOtherChain, 1, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_INCOMING)
+		TestRecipientOtherChain, 1, types.SWAP_STATUS_OPEN, true, types.SWAP_DIRECTION_INCOMING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 
 	supply := types.NewAssetSupply(coin, c(coin.Denom, 0),
 		c(coin.Denom, 0), c(coin.Denom, 0), time.Duration(0))
@@ -116,9 +116,9 @@ func (s queryServer) AtomicSwaps(ctx context.Context, req *types.QueryAtomicSwap
 			}
 		}
 
-		// match expiration block limit (if supplied)
+		// match expiration block limit (if supplied), swaps that expire by time have no expiration block
 		if req.Expiration > 0 {
-			if atomicSwap.ExpireHeight > req.Expiration {
+			if atomicSwap.ExpiresByTime() || atomicSwap.ExpireHeight > req.Expiration {
 				return false, nil
 			}
 		}
@@ -177,5 +177,8 @@ func mapAtomicSwapToResponse(atomicSwap types.AtomicSwap) types.AtomicSwapRespon
 		Status:              atomicSwap.Status,
 		CrossChain:          atomicSwap.CrossChain,
 		Direction:           atomicSwap.Direction,
+		SwapType:            atomicSwap.SwapType,
+		HashScheme:          atomicSwap.HashScheme,
+		ExpireTimestamp:     atomicSwap.ExpireTimestamp,
 	}
 }
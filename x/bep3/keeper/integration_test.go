@@ -115,5 +115,5 @@ func atomicSwap(ctx sdk.Context, index int) types.AtomicSwap {
 	return types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 		uint64(ctx.BlockHeight())+expireOffset, timestamp, TestUser1, TestUser2,
 		TestSenderOtherChain, TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN, true,
-		types.SWAP_DIRECTION_INCOMING)
+		types.SWAP_DIRECTION_INCOMING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 }
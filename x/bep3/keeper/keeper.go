@@ -108,19 +108,31 @@ func (k Keeper) GetAllAtomicSwaps(ctx sdk.Context) (atomicSwaps types.AtomicSwap
 //			Atomic Swap Block Index
 // ------------------------------------------
 
-// InsertIntoByBlockIndex adds a swap ID and expiration time into the byBlock index.
+// InsertIntoByBlockIndex adds a swap ID and expiration time into the byBlock index. Swaps that expire by time
+// are added to the byTime index instead.
 func (k Keeper) InsertIntoByBlockIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	if atomicSwap.ExpiresByTime() {
+		store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+		store.Set(types.GetAtomicSwapByTimeKey(atomicSwap.ExpireTimestamp, atomicSwap.GetSwapID()), atomicSwap.GetSwapID())
+		return
+	}
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByBlockPrefix)
 	store.Set(types.GetAtomicSwapByHeightKey(atomicSwap.ExpireHeight, atomicSwap.GetSwapID()), atomicSwap.GetSwapID())
 }
 
-// RemoveFromByBlockIndex removes an AtomicSwap from the byBlock index.
+// RemoveFromByBlockIndex removes an AtomicSwap from the byBlock index, or the byTime index if it expires by time.
 func (k Keeper) RemoveFromByBlockIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	if atomicSwap.ExpiresByTime() {
+		store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+		store.Delete(types.GetAtomicSwapByTimeKey(atomicSwap.ExpireTimestamp, atomicSwap.GetSwapID()))
+		return
+	}
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByBlockPrefix)
 	store.Delete(types.GetAtomicSwapByHeightKey(atomicSwap.ExpireHeight, atomicSwap.GetSwapID()))
 }
 
-// IterateAtomicSwapsByBlock provides an iterator over AtomicSwaps ordered by AtomicSwap expiration block
+// IterateAtomicSwapsByBlock provides an iterator over AtomicSwaps ordered by AtomicSwap expiration block. Swaps that
+// expire by time are iterated by IterateAtomicSwapsByTime.
 // For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
 func (k Keeper) IterateAtomicSwapsByBlock(ctx sdk.Context, inclusiveCutoffTime uint64, cb func(swapID []byte) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByBlockPrefix)
@@ -140,6 +152,23 @@ func (k Keeper) IterateAtomicSwapsByBlock(ctx sdk.Context, inclusiveCutoffTime u
 	}
 }
 
+// IterateAtomicSwapsByTime provides an iterator over AtomicSwaps that expire by time, ordered by expiration
+// unix timestamp. For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByTime(ctx sdk.Context, inclusiveCutoffTime int64, cb func(swapID []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(uint64(inclusiveCutoffTime))), // end of range
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Value()) {
+			break
+		}
+	}
+}
+
 // ------------------------------------------
 //		Atomic Swap Longterm Storage Index
 // ------------------------------------------
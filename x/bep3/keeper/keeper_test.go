@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -183,7 +184,7 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsByBlock() {
 		atomicSwap := types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 			uint64(blockCtx.BlockHeight()), timestamp, TestUser1, TestUser2,
 			TestSenderOtherChain, TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN,
-			true, types.SWAP_DIRECTION_INCOMING)
+			true, types.SWAP_DIRECTION_INCOMING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 
 		// Insert into block index
 		suite.keeper.InsertIntoByBlockIndex(blockCtx, atomicSwap)
@@ -210,6 +211,55 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsByBlock() {
 	suite.Equal(expectedSwapIDs, readSwapIDs)
 }
 
+func (suite *KeeperTestSuite) TestIterateAtomicSwapsByTime() {
+	suite.ResetChain()
+
+	var swaps types.AtomicSwaps
+	for i := 0; i < 8; i++ {
+		// Expire swaps an hour apart
+		timestamp := tmtime.Now().Add(time.Duration(i) * time.Minute).Unix()
+		randomNumber, _ := types.GenerateSecureRandomNumber()
+		randomNumberHash := types.CalculateSHA256Hash(randomNumber[:])
+		expireTimestamp := suite.ctx.BlockTime().Add(time.Duration(i+1) * time.Hour).Unix()
+
+		atomicSwap := types.NewAtomicSwap(cs(c("ukava", 50000)), randomNumberHash,
+			0, timestamp, TestUser1, TestUser2,
+			TestSenderOtherChain, TestRecipientOtherChain, 0, types.SWAP_STATUS_OPEN,
+			true, types.SWAP_DIRECTION_UNSPECIFIED, types.SWAP_TYPE_HTLC, types.HASH_SCHEME_SHA256, expireTimestamp)
+
+		suite.keeper.InsertIntoByBlockIndex(suite.ctx, atomicSwap)
+		swaps = append(swaps, atomicSwap)
+	}
+
+	// Swaps that expire by time are not in the by block index
+	suite.keeper.IterateAtomicSwapsByBlock(suite.ctx, math.MaxUint64, func(id []byte) bool {
+		suite.Fail("unexpected swap in by block index")
+		return false
+	})
+
+	cutoffTime := swaps[3].ExpireTimestamp
+	var expectedSwapIDs [][]byte
+	for _, swap := range swaps[:4] {
+		expectedSwapIDs = append(expectedSwapIDs, swap.GetSwapID())
+	}
+
+	var readSwapIDs [][]byte
+	suite.keeper.IterateAtomicSwapsByTime(suite.ctx, cutoffTime, func(id []byte) bool {
+		readSwapIDs = append(readSwapIDs, id)
+		return false
+	})
+	suite.Equal(expectedSwapIDs, readSwapIDs)
+
+	// Removing a swap removes it from the by time index
+	suite.keeper.RemoveFromByBlockIndex(suite.ctx, swaps[0])
+	readSwapIDs = nil
+	suite.keeper.IterateAtomicSwapsByTime(suite.ctx, cutoffTime, func(id []byte) bool {
+		readSwapIDs = append(readSwapIDs, id)
+		return false
+	})
+	suite.Equal(expectedSwapIDs[1:], readSwapIDs)
+}
+
 func (suite *KeeperTestSuite) TestInsertIntoLongtermStorage() {
 	suite.ResetChain()
 
@@ -274,7 +324,7 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 		atomicSwap := types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 			uint64(suite.ctx.BlockHeight()), timestamp, TestUser1, TestUser2,
 			TestSenderOtherChain, TestRecipientOtherChain, 100, types.SWAP_STATUS_OPEN,
-			true, types.SWAP_DIRECTION_INCOMING)
+			true, types.SWAP_DIRECTION_INCOMING, types.SWAP_TYPE_DEPUTY, types.HASH_SCHEME_BEP3, 0)
 
 		// Set closed block staggered by 100 blocks and insert into longterm storage
 		atomicSwap.ClosedBlock = int64(i) * 100
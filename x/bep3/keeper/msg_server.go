@@ -37,8 +37,8 @@ func (k msgServer) CreateAtomicSwap(goCtx context.Context, msg *types.MsgCreateA
 		return nil, err
 	}
 
-	if err = k.keeper.CreateAtomicSwap(ctx, randomNumberHash, msg.Timestamp, msg.HeightSpan,
-		from, to, msg.SenderOtherChain, msg.RecipientOtherChain, msg.Amount, true); err != nil {
+	if err = k.keeper.CreateAtomicSwap(ctx, randomNumberHash, msg.HashScheme, msg.Timestamp, msg.HeightSpan,
+		msg.TimeSpan, from, to, msg.SenderOtherChain, msg.RecipientOtherChain, msg.Amount, true); err != nil {
 		return nil, err
 	}
 
@@ -54,7 +54,7 @@ func (suite *MsgServerTestSuite) AddAtomicSwap() (tmbytes.HexBytes, tmbytes.HexB
 	randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 
 	// Create atomic swap and check err to confirm creation
-	err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, timestamp, expireHeight,
+	err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, types.HASH_SCHEME_BEP3, timestamp, expireHeight, 0,
 		suite.addrs[0], suite.addrs[1], TestSenderOtherChain, TestRecipientOtherChain,
 		amount, true)
 	suite.Nil(err)
@@ -72,7 +72,7 @@ func (suite *MsgServerTestSuite) TestMsgCreateAtomicSwap() {
 	msg := types.NewMsgCreateAtomicSwap(
 		suite.addrs[0].String(), suite.addrs[2].String(), TestRecipientOtherChain,
 		TestSenderOtherChain, randomNumberHash, timestamp, amount,
-		types.DefaultMinBlockLock)
+		types.DefaultMinBlockLock, 0, types.HASH_SCHEME_BEP3)
 
 	res, err := suite.msgServer.CreateAtomicSwap(sdk.WrapSDKContext(suite.ctx), &msg)
 	suite.Require().NoError(err)
@@ -51,6 +51,24 @@ func (k Keeper) GetAssets(ctx sdk.Context) (types.AssetParams, bool) {
 	return params.AssetParams, len(params.AssetParams) > 0
 }
 
+// GetHTLCAsset returns the htlc asset param associated with the input denom.
+// Param change proposals validate each param key on its own, so a denom can be added to both the deputy and htlc
+// assets. Deputy assets are minted and burned, so such a denom is not treated as an htlc asset.
+func (k Keeper) GetHTLCAsset(ctx sdk.Context, denom string) (types.HTLCAssetParam, bool) {
+	params := k.GetParams(ctx)
+	for _, asset := range params.AssetParams {
+		if denom == asset.Denom {
+			return types.HTLCAssetParam{}, false
+		}
+	}
+	for _, asset := range params.HTLCAssetParams {
+		if denom == asset.Denom {
+			return asset, true
+		}
+	}
+	return types.HTLCAssetParam{}, false
+}
+
 // ------------------------------------------
 //				Asset-specific getters
 // ------------------------------------------
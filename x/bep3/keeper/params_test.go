@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -51,6 +52,21 @@ func (suite *ParamsTestSuite) TestGetAssets() {
 	suite.Require().Equal(2, len(assets))
 }
 
+func (suite *ParamsTestSuite) TestGetHTLCAsset() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.HTLCAssetParams = types.HTLCAssetParams{
+		types.NewHTLCAssetParam("ukava", true, sdkmath.OneInt(), sdkmath.NewInt(1000), 10, 100, 0, 0),
+		// param changes are validated per key, so a deputy asset can also be set as an htlc asset
+		types.NewHTLCAssetParam("bnb", true, sdkmath.OneInt(), sdkmath.NewInt(1000), 10, 100, 0, 0),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	_, found := suite.keeper.GetHTLCAsset(suite.ctx, "ukava")
+	suite.Require().True(found)
+	_, found = suite.keeper.GetHTLCAsset(suite.ctx, "bnb")
+	suite.Require().False(found, "expected deputy asset to not be an htlc asset")
+}
+
 func (suite *ParamsTestSuite) TestGetSetDeputyAddress() {
 	asset, err := suite.keeper.GetAsset(suite.ctx, "bnb")
 	suite.Require().NoError(err)
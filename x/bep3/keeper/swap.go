@@ -14,8 +14,10 @@ import (
 )
 
 // CreateAtomicSwap creates a new atomic swap.
-func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, timestamp int64, heightSpan uint64,
-	sender, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string,
+// Swaps expire after heightSpan blocks, or after timeSpan seconds when timeSpan is set. Only generic htlc swaps
+// may expire by time or use the sha256 hash scheme.
+func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, hashScheme types.HashScheme, timestamp int64,
+	heightSpan uint64, timeSpan uint64, sender, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string,
 	amount sdk.Coins, crossChain bool,
 ) error {
 	// Confirm that this is not a duplicate swap
@@ -33,37 +35,103 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 	if len(amount) != 1 {
 		return fmt.Errorf("amount must contain exactly one coin")
 	}
-	asset, err := k.GetAsset(ctx, amount[0].Denom)
+
+	// Unix timestamp must be in range [-15 mins, 30 mins] of the current time for swaps using the bep3 hash scheme that
+	// expire by height, as the timestamp is part of their random number hash. Sha256 and time-expiring htlc swaps do
+	// not depend on the timestamp.
+	if hashScheme == types.HASH_SCHEME_BEP3 && timeSpan == 0 {
+		pastTimestampLimit := ctx.BlockTime().Add(time.Duration(-15) * time.Minute).Unix()
+		futureTimestampLimit := ctx.BlockTime().Add(time.Duration(30) * time.Minute).Unix()
+		if timestamp < pastTimestampLimit || timestamp >= futureTimestampLimit {
+			return errorsmod.Wrap(types.ErrInvalidTimestamp, fmt.Sprintf("block time: %s, timestamp: %s", ctx.BlockTime().String(), time.Unix(timestamp, 0).UTC().String()))
+		}
+	}
+
+	var direction types.SwapDirection
+	swapType := types.SWAP_TYPE_DEPUTY
+	var err error
+	if htlcAsset, found := k.GetHTLCAsset(ctx, amount[0].Denom); found {
+		swapType = types.SWAP_TYPE_HTLC
+		err = k.lockHTLCSwapCoins(ctx, htlcAsset, sender, amount, heightSpan, timeSpan)
+	} else {
+		// Deputies relay swaps using the bep3 hash scheme and height-based expiry only
+		if hashScheme != types.HASH_SCHEME_BEP3 {
+			return errorsmod.Wrapf(types.ErrInvalidHashScheme, "deputy swaps must use %s", types.HASH_SCHEME_BEP3)
+		}
+		if timeSpan > 0 {
+			return errorsmod.Wrap(types.ErrInvalidTimeSpan, "deputy swaps must expire by height")
+		}
+		direction, err = k.lockDeputySwapCoins(ctx, sender, recipient, amount, heightSpan)
+	}
 	if err != nil {
 		return err
 	}
 
+	// Store the details of the swap
+	var expireHeight uint64
+	var expireTimestamp int64
+	if timeSpan > 0 {
+		expireTimestamp = ctx.BlockTime().Add(time.Duration(timeSpan) * time.Second).Unix()
+	} else {
+		expireHeight = uint64(ctx.BlockHeight()) + heightSpan
+	}
+	atomicSwap := types.NewAtomicSwap(amount, randomNumberHash, expireHeight, timestamp, sender,
+		recipient, senderOtherChain, recipientOtherChain, 0, types.SWAP_STATUS_OPEN, crossChain, direction, swapType,
+		hashScheme, expireTimestamp)
+
+	// Insert the atomic swap under both keys
+	k.SetAtomicSwap(ctx, atomicSwap)
+	k.InsertIntoByBlockIndex(ctx, atomicSwap)
+
+	// Emit 'create_atomic_swap' event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateAtomicSwap,
+			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
+			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
+			sdk.NewAttribute(types.AttributeKeySenderOtherChain, atomicSwap.SenderOtherChain),
+			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
+			sdk.NewAttribute(types.AttributeKeyExpireTimestamp, fmt.Sprintf("%d", atomicSwap.ExpireTimestamp)),
+			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeySwapType, atomicSwap.SwapType.String()),
+			sdk.NewAttribute(types.AttributeKeyHashScheme, atomicSwap.HashScheme.String()),
+		),
+	)
+
+	return nil
+}
+
+// lockDeputySwapCoins validates a swap of a deputy asset, returning its direction. Incoming swaps are tracked in the
+// asset's incoming supply, and the coins of outgoing swaps are transferred to the module account.
+func (k Keeper) lockDeputySwapCoins(ctx sdk.Context, sender, recipient sdk.AccAddress, amount sdk.Coins, heightSpan uint64) (types.SwapDirection, error) {
+	asset, err := k.GetAsset(ctx, amount[0].Denom)
+	if err != nil {
+		return types.SWAP_DIRECTION_UNSPECIFIED, err
+	}
+
 	err = k.ValidateLiveAsset(ctx, amount[0])
 	if err != nil {
-		return err
+		return types.SWAP_DIRECTION_UNSPECIFIED, err
 	}
 
 	// Swap amount must be within the specified swap amount limits
 	if amount[0].Amount.LT(asset.MinSwapAmount) || amount[0].Amount.GT(asset.MaxSwapAmount) {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "amount %d outside range [%s, %s]", amount[0].Amount, asset.MinSwapAmount, asset.MaxSwapAmount)
-	}
-
-	// Unix timestamp must be in range [-15 mins, 30 mins] of the current time
-	pastTimestampLimit := ctx.BlockTime().Add(time.Duration(-15) * time.Minute).Unix()
-	futureTimestampLimit := ctx.BlockTime().Add(time.Duration(30) * time.Minute).Unix()
-	if timestamp < pastTimestampLimit || timestamp >= futureTimestampLimit {
-		return errorsmod.Wrap(types.ErrInvalidTimestamp, fmt.Sprintf("block time: %s, timestamp: %s", ctx.BlockTime().String(), time.Unix(timestamp, 0).UTC().String()))
+		return types.SWAP_DIRECTION_UNSPECIFIED, errorsmod.Wrapf(types.ErrInvalidAmount, "amount %d outside range [%s, %s]", amount[0].Amount, asset.MinSwapAmount, asset.MaxSwapAmount)
 	}
 
 	var direction types.SwapDirection
 	if sender.Equals(asset.DeputyAddress) {
 		if recipient.Equals(asset.DeputyAddress) {
-			return errorsmod.Wrapf(types.ErrInvalidSwapAccount, "deputy cannot be both sender and receiver: %s", asset.DeputyAddress)
+			return types.SWAP_DIRECTION_UNSPECIFIED, errorsmod.Wrapf(types.ErrInvalidSwapAccount, "deputy cannot be both sender and receiver: %s", asset.DeputyAddress)
 		}
 		direction = types.SWAP_DIRECTION_INCOMING
 	} else {
 		if !recipient.Equals(asset.DeputyAddress) {
-			return errorsmod.Wrapf(types.ErrInvalidSwapAccount, "deputy must be recipient for outgoing account: %s", recipient)
+			return types.SWAP_DIRECTION_UNSPECIFIED, errorsmod.Wrapf(types.ErrInvalidSwapAccount, "deputy must be recipient for outgoing account: %s", recipient)
 		}
 		direction = types.SWAP_DIRECTION_OUTGOING
 	}
@@ -83,51 +151,46 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 
 		// Outgoing swaps must have a height span within the accepted range
 		if heightSpan < asset.MinBlockLock || heightSpan > asset.MaxBlockLock {
-			return errorsmod.Wrapf(types.ErrInvalidHeightSpan, "height span %d outside range [%d, %d]", heightSpan, asset.MinBlockLock, asset.MaxBlockLock)
+			return types.SWAP_DIRECTION_UNSPECIFIED, errorsmod.Wrapf(types.ErrInvalidHeightSpan, "height span %d outside range [%d, %d]", heightSpan, asset.MinBlockLock, asset.MaxBlockLock)
 		}
 		// Amount in outgoing swaps must be able to pay the deputy's fixed fee.
 		if amount[0].Amount.LTE(asset.FixedFee.Add(asset.MinSwapAmount)) {
-			return errorsmod.Wrap(types.ErrInsufficientAmount, amount[0].String())
+			return types.SWAP_DIRECTION_UNSPECIFIED, errorsmod.Wrap(types.ErrInsufficientAmount, amount[0].String())
 		}
 		err = k.IncrementOutgoingAssetSupply(ctx, amount[0])
 		if err != nil {
-			return err
+			return types.SWAP_DIRECTION_UNSPECIFIED, err
 		}
 		// Transfer coins to module - only needed for outgoing swaps
 		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
-	default:
-		err = fmt.Errorf("invalid swap direction: %s", direction.String())
 	}
 	if err != nil {
-		return err
+		return types.SWAP_DIRECTION_UNSPECIFIED, err
 	}
+	return direction, nil
+}
 
-	// Store the details of the swap
-	expireHeight := uint64(ctx.BlockHeight()) + heightSpan
-	atomicSwap := types.NewAtomicSwap(amount, randomNumberHash, expireHeight, timestamp, sender,
-		recipient, senderOtherChain, recipientOtherChain, 0, types.SWAP_STATUS_OPEN, crossChain, direction)
-
-	// Insert the atomic swap under both keys
-	k.SetAtomicSwap(ctx, atomicSwap)
-	k.InsertIntoByBlockIndex(ctx, atomicSwap)
-
-	// Emit 'create_atomic_swap' event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeCreateAtomicSwap,
-			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender.String()),
-			sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient.String()),
-			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
-			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
-			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
-			sdk.NewAttribute(types.AttributeKeySenderOtherChain, atomicSwap.SenderOtherChain),
-			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
-			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
-		),
-	)
-
-	return nil
+// lockHTLCSwapCoins validates a generic htlc swap and escrows the sender's coins in the module account until the
+// swap is claimed or refunded.
+func (k Keeper) lockHTLCSwapCoins(ctx sdk.Context, asset types.HTLCAssetParam, sender sdk.AccAddress, amount sdk.Coins, heightSpan, timeSpan uint64) error {
+	if !asset.Active {
+		return errorsmod.Wrap(types.ErrAssetNotActive, asset.Denom)
+	}
+	// Swap amount must be within the specified swap amount limits
+	if amount[0].Amount.LT(asset.MinSwapAmount) || amount[0].Amount.GT(asset.MaxSwapAmount) {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "amount %d outside range [%s, %s]", amount[0].Amount, asset.MinSwapAmount, asset.MaxSwapAmount)
+	}
+	if timeSpan > 0 {
+		// Swaps that expire by time must have a time span within the accepted range
+		span := time.Duration(timeSpan) * time.Second
+		if asset.MaxTimeLock == 0 || span < asset.MinTimeLock || span > asset.MaxTimeLock {
+			return errorsmod.Wrapf(types.ErrInvalidTimeSpan, "time span %s outside range [%s, %s]", span, asset.MinTimeLock, asset.MaxTimeLock)
+		}
+	} else if heightSpan < asset.MinBlockLock || heightSpan > asset.MaxBlockLock {
+		// Swaps that expire by height must have a height span within the accepted range
+		return errorsmod.Wrapf(types.ErrInvalidHeightSpan, "height span %d outside range [%d, %d]", heightSpan, asset.MinBlockLock, asset.MaxBlockLock)
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
 }
 
 // ClaimAtomicSwap validates a claim attempt, and if successful, sends the escrowed amount and closes the AtomicSwap.
@@ -143,7 +206,7 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 	}
 
 	//  Calculate hashed secret using submitted number
-	hashedSubmittedNumber := types.CalculateRandomHash(randomNumber, atomicSwap.Timestamp)
+	hashedSubmittedNumber := types.CalculateSchemeHash(atomicSwap.HashScheme, randomNumber, atomicSwap.Timestamp)
 	hashedSecret := types.CalculateSwapID(hashedSubmittedNumber, atomicSwap.Sender, atomicSwap.SenderOtherChain)
 
 	// Confirm that secret unlocks the atomic swap
@@ -152,8 +215,14 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 	}
 
 	var err error
-	switch atomicSwap.Direction {
-	case types.SWAP_DIRECTION_INCOMING:
+	switch {
+	case atomicSwap.SwapType == types.SWAP_TYPE_HTLC:
+		// htlc case - escrowed coins are released to the recipient
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Recipient, atomicSwap.Amount)
+		if err != nil {
+			return err
+		}
+	case atomicSwap.Direction == types.SWAP_DIRECTION_INCOMING:
 		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
 		if err != nil {
 			return err
@@ -172,7 +241,7 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 		if err != nil {
 			return err
 		}
-	case types.SWAP_DIRECTION_OUTGOING:
+	case atomicSwap.Direction == types.SWAP_DIRECTION_OUTGOING:
 		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
 		if err != nil {
 			return err
@@ -226,10 +295,13 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 	}
 
 	var err error
-	switch atomicSwap.Direction {
-	case types.SWAP_DIRECTION_INCOMING:
+	switch {
+	case atomicSwap.SwapType == types.SWAP_TYPE_HTLC:
+		// Refund escrowed coins to original swap sender for htlc swaps
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Sender, atomicSwap.Amount)
+	case atomicSwap.Direction == types.SWAP_DIRECTION_INCOMING:
 		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
-	case types.SWAP_DIRECTION_OUTGOING:
+	case atomicSwap.Direction == types.SWAP_DIRECTION_OUTGOING:
 		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
 		if err != nil {
 			return err
@@ -266,10 +338,10 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 	return nil
 }
 
-// UpdateExpiredAtomicSwaps finds all AtomicSwaps that are past (or at) their ending times and expires them.
+// UpdateExpiredAtomicSwaps finds all AtomicSwaps that are past (or at) their ending heights or times and expires them.
 func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 	var expiredSwapIDs []string
-	k.IterateAtomicSwapsByBlock(ctx, uint64(ctx.BlockHeight()), func(id []byte) bool {
+	expireSwap := func(id []byte) bool {
 		atomicSwap, found := k.GetAtomicSwap(ctx, id)
 		if !found {
 			// NOTE: shouldn't happen. Continue to next item.
@@ -282,7 +354,9 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 		k.SetAtomicSwap(ctx, atomicSwap)
 		expiredSwapIDs = append(expiredSwapIDs, hex.EncodeToString(atomicSwap.GetSwapID()))
 		return false
-	})
+	}
+	k.IterateAtomicSwapsByBlock(ctx, uint64(ctx.BlockHeight()), expireSwap)
+	k.IterateAtomicSwapsByTime(ctx, ctx.BlockTime().Unix(), expireSwap)
 
 	// Emit 'swaps_expired' event
 	ctx.EventManager().EmitEvent(
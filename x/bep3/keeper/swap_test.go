@@ -10,7 +10,9 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/bep3"
@@ -37,6 +39,7 @@ const (
 	BNB_DENOM             = "bnb"
 	OTHER_DENOM           = "inc"
 	STARING_OTHER_BALANCE = int64(3000000000000)
+	HTLC_DENOM            = "ukava"
 )
 
 func (suite *AtomicSwapTestSuite) SetupTest() {
@@ -420,8 +423,8 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 			assetSupplyPre, _ := suite.keeper.GetAssetSupply(suite.ctx, swapAssetDenom)
 
 			// Create atomic swap
-			err := suite.keeper.CreateAtomicSwap(suite.ctx, tc.args.randomNumberHash, tc.args.timestamp,
-				tc.args.heightSpan, tc.args.sender, tc.args.recipient, tc.args.senderOtherChain,
+			err := suite.keeper.CreateAtomicSwap(suite.ctx, tc.args.randomNumberHash, types.HASH_SCHEME_BEP3, tc.args.timestamp,
+				tc.args.heightSpan, 0, tc.args.sender, tc.args.recipient, tc.args.senderOtherChain,
 				tc.args.recipientOtherChain, tc.args.coins, tc.args.crossChain)
 
 			// Load sender's account after swap creation
@@ -593,8 +596,8 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 			}
 
 			// Create atomic swap
-			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
-				types.DefaultMinBlockLock, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
+			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], types.HASH_SCHEME_BEP3, suite.timestamps[i],
+				types.DefaultMinBlockLock, 0, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
 				tc.args.coins, true)
 			suite.NoError(err)
 
@@ -747,8 +750,8 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 				suite.Nil(err)
 			}
 
-			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
-				types.DefaultMinBlockLock, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
+			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], types.HASH_SCHEME_BEP3, suite.timestamps[i],
+				types.DefaultMinBlockLock, 0, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
 				expectedRefundAmount, true)
 			suite.NoError(err)
 
@@ -824,6 +827,263 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 	}
 }
 
+func (suite *AtomicSwapTestSuite) setupHTLCAsset() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.HTLCAssetParams = types.HTLCAssetParams{
+		types.NewHTLCAssetParam(HTLC_DENOM, true, sdkmath.NewInt(100), sdkmath.NewInt(1000000), 10, 1000, time.Minute, time.Hour),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	for _, addr := range suite.addrs {
+		suite.Require().NoError(suite.app.FundAccount(suite.ctx, addr, cs(c(HTLC_DENOM, STARING_OTHER_BALANCE))))
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestCreateHTLCAtomicSwap() {
+	testCases := []struct {
+		name       string
+		amount     sdk.Coins
+		heightSpan uint64
+		active     bool
+		recipient  sdk.AccAddress
+		expectErr  error
+	}{
+		{"normal", cs(c(HTLC_DENOM, 50000)), 100, true, suite.addrs[2], nil},
+		{"below min swap amount", cs(c(HTLC_DENOM, 99)), 100, true, suite.addrs[2], types.ErrInvalidAmount},
+		{"above max swap amount", cs(c(HTLC_DENOM, 1000001)), 100, true, suite.addrs[2], types.ErrInvalidAmount},
+		{"height span below min block lock", cs(c(HTLC_DENOM, 50000)), 9, true, suite.addrs[2], types.ErrInvalidHeightSpan},
+		{"height span above max block lock", cs(c(HTLC_DENOM, 50000)), 1001, true, suite.addrs[2], types.ErrInvalidHeightSpan},
+		{"inactive asset", cs(c(HTLC_DENOM, 50000)), 100, false, suite.addrs[2], types.ErrAssetNotActive},
+		{"insufficient balance", cs(c(HTLC_DENOM, 50000)), 100, true, suite.addrs[2], sdkerrors.ErrInsufficientFunds},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupHTLCAsset()
+			params := suite.keeper.GetParams(suite.ctx)
+			params.HTLCAssetParams[0].Active = tc.active
+			suite.keeper.SetParams(suite.ctx, params)
+
+			// any account can create an htlc swap, without a deputy
+			sender := suite.addrs[1]
+			if tc.expectErr == sdkerrors.ErrInsufficientFunds {
+				sender = TestUser1
+			}
+			senderBalancePre := suite.app.GetBankKeeper().GetBalance(suite.ctx, sender, HTLC_DENOM)
+
+			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], types.HASH_SCHEME_BEP3, suite.timestamps[0],
+				tc.heightSpan, 0, sender, tc.recipient, TestSenderOtherChain, TestRecipientOtherChain, tc.amount, true)
+
+			swapID := types.CalculateSwapID(suite.randomNumberHashes[0], sender, TestSenderOtherChain)
+			swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(types.SWAP_TYPE_HTLC, swap.SwapType)
+			suite.Require().Equal(types.SWAP_DIRECTION_UNSPECIFIED, swap.Direction)
+			suite.Require().Equal(uint64(suite.ctx.BlockHeight())+tc.heightSpan, swap.ExpireHeight)
+			suite.Require().NoError(swap.Validate())
+
+			// coins are escrowed in the module account
+			senderBalancePost := suite.app.GetBankKeeper().GetBalance(suite.ctx, sender, HTLC_DENOM)
+			suite.Require().Equal(senderBalancePre.Sub(tc.amount[0]), senderBalancePost)
+			moduleAddr := suite.app.GetAccountKeeper().GetModuleAddress(types.ModuleName)
+			suite.Require().Equal(tc.amount[0], suite.app.GetBankKeeper().GetBalance(suite.ctx, moduleAddr, HTLC_DENOM))
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestClaimHTLCAtomicSwap() {
+	suite.SetupTest()
+	suite.setupHTLCAsset()
+	sender, recipient, claimer := suite.addrs[1], suite.addrs[2], suite.addrs[3]
+	amount := cs(c(HTLC_DENOM, 50000))
+
+	err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], types.HASH_SCHEME_BEP3, suite.timestamps[0],
+		100, 0, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], sender, TestSenderOtherChain)
+	recipientBalancePre := suite.app.GetBankKeeper().GetBalance(suite.ctx, recipient, HTLC_DENOM)
+
+	err = suite.keeper.ClaimAtomicSwap(suite.ctx, claimer, swapID, suite.randomNumbers[1])
+	suite.Require().ErrorIs(err, types.ErrInvalidClaimSecret)
+
+	// anyone with the secret can claim the swap on behalf of the recipient
+	err = suite.keeper.ClaimAtomicSwap(suite.ctx, claimer, swapID, suite.randomNumbers[0])
+	suite.Require().NoError(err)
+
+	recipientBalancePost := suite.app.GetBankKeeper().GetBalance(suite.ctx, recipient, HTLC_DENOM)
+	suite.Require().Equal(recipientBalancePre.Add(amount[0]), recipientBalancePost)
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SWAP_STATUS_COMPLETED, swap.Status)
+
+	// htlc swaps do not affect deputy asset supplies
+	_, found = suite.keeper.GetAssetSupply(suite.ctx, HTLC_DENOM)
+	suite.Require().False(found)
+}
+
+func (suite *AtomicSwapTestSuite) TestRefundHTLCAtomicSwap() {
+	suite.SetupTest()
+	suite.setupHTLCAsset()
+	sender, recipient := suite.addrs[1], suite.addrs[2]
+	amount := cs(c(HTLC_DENOM, 50000))
+	senderBalancePre := suite.app.GetBankKeeper().GetBalance(suite.ctx, sender, HTLC_DENOM)
+
+	err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], types.HASH_SCHEME_BEP3, suite.timestamps[0],
+		100, 0, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], sender, TestSenderOtherChain)
+
+	err = suite.keeper.RefundAtomicSwap(suite.ctx, recipient, swapID)
+	suite.Require().ErrorIs(err, types.ErrSwapNotRefundable)
+
+	expiredCtx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 100)
+	bep3.BeginBlocker(expiredCtx, suite.keeper)
+
+	err = suite.keeper.ClaimAtomicSwap(expiredCtx, recipient, swapID, suite.randomNumbers[0])
+	suite.Require().ErrorIs(err, types.ErrSwapNotClaimable)
+	err = suite.keeper.RefundAtomicSwap(expiredCtx, recipient, swapID)
+	suite.Require().NoError(err)
+
+	senderBalancePost := suite.app.GetBankKeeper().GetBalance(expiredCtx, sender, HTLC_DENOM)
+	suite.Require().Equal(senderBalancePre, senderBalancePost)
+	swap, found := suite.keeper.GetAtomicSwap(expiredCtx, swapID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SWAP_STATUS_COMPLETED, swap.Status)
+}
+
+func (suite *AtomicSwapTestSuite) TestCreateTimeLockedAtomicSwap() {
+	testCases := []struct {
+		name       string
+		amount     sdk.Coins
+		timeSpan   uint64
+		hashScheme types.HashScheme
+		expectErr  error
+	}{
+		{"normal", cs(c(HTLC_DENOM, 50000)), 3600, types.HASH_SCHEME_BEP3, nil},
+		{"sha256", cs(c(HTLC_DENOM, 50000)), 600, types.HASH_SCHEME_SHA256, nil},
+		{"time span below min time lock", cs(c(HTLC_DENOM, 50000)), 59, types.HASH_SCHEME_BEP3, types.ErrInvalidTimeSpan},
+		{"time span above max time lock", cs(c(HTLC_DENOM, 50000)), 3601, types.HASH_SCHEME_BEP3, types.ErrInvalidTimeSpan},
+		{"deputy asset with time span", cs(c(BNB_DENOM, 50000)), 3600, types.HASH_SCHEME_BEP3, types.ErrInvalidTimeSpan},
+		{"deputy asset with sha256", cs(c(BNB_DENOM, 50000)), 3600, types.HASH_SCHEME_SHA256, types.ErrInvalidHashScheme},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupHTLCAsset()
+			sender := suite.addrs[1]
+
+			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], tc.hashScheme, suite.timestamps[0],
+				0, tc.timeSpan, sender, suite.addrs[2], TestSenderOtherChain, TestRecipientOtherChain, tc.amount, true)
+
+			swapID := types.CalculateSwapID(suite.randomNumberHashes[0], sender, TestSenderOtherChain)
+			swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.hashScheme, swap.HashScheme)
+			suite.Require().Equal(uint64(0), swap.ExpireHeight)
+			suite.Require().Equal(suite.ctx.BlockTime().Unix()+int64(tc.timeSpan), swap.ExpireTimestamp)
+			suite.Require().NoError(swap.Validate())
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestCreateHTLCAtomicSwapTimestampWindow() {
+	testCases := []struct {
+		name       string
+		heightSpan uint64
+		timeSpan   uint64
+		hashScheme types.HashScheme
+		expectErr  error
+	}{
+		{"sha256", 100, 0, types.HASH_SCHEME_SHA256, nil},
+		{"time-expiring", 0, 3600, types.HASH_SCHEME_BEP3, nil},
+		{"bep3 hash scheme expiring by height", 100, 0, types.HASH_SCHEME_BEP3, types.ErrInvalidTimestamp},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupHTLCAsset()
+
+			// the timestamp is outside the [-15 mins, 30 mins] window of the block time
+			timestamp := suite.ctx.BlockTime().Add(-time.Hour).Unix()
+			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], tc.hashScheme, timestamp,
+				tc.heightSpan, tc.timeSpan, suite.addrs[1], suite.addrs[2], TestSenderOtherChain, TestRecipientOtherChain,
+				cs(c(HTLC_DENOM, 50000)), true)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestClaimSHA256AtomicSwap() {
+	suite.SetupTest()
+	suite.setupHTLCAsset()
+	sender, recipient := suite.addrs[1], suite.addrs[2]
+	amount := cs(c(HTLC_DENOM, 50000))
+	randomNumberHash := types.CalculateSHA256Hash(suite.randomNumbers[0])
+
+	err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, types.HASH_SCHEME_SHA256, suite.timestamps[0],
+		100, 0, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(randomNumberHash, sender, TestSenderOtherChain)
+	recipientBalancePre := suite.app.GetBankKeeper().GetBalance(suite.ctx, recipient, HTLC_DENOM)
+
+	err = suite.keeper.ClaimAtomicSwap(suite.ctx, recipient, swapID, suite.randomNumbers[1])
+	suite.Require().ErrorIs(err, types.ErrInvalidClaimSecret)
+
+	err = suite.keeper.ClaimAtomicSwap(suite.ctx, recipient, swapID, suite.randomNumbers[0])
+	suite.Require().NoError(err)
+	recipientBalancePost := suite.app.GetBankKeeper().GetBalance(suite.ctx, recipient, HTLC_DENOM)
+	suite.Require().Equal(recipientBalancePre.Add(amount[0]), recipientBalancePost)
+}
+
+func (suite *AtomicSwapTestSuite) TestExpireTimeLockedAtomicSwap() {
+	suite.SetupTest()
+	suite.setupHTLCAsset()
+	sender, recipient := suite.addrs[1], suite.addrs[2]
+	amount := cs(c(HTLC_DENOM, 50000))
+	senderBalancePre := suite.app.GetBankKeeper().GetBalance(suite.ctx, sender, HTLC_DENOM)
+
+	err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], types.HASH_SCHEME_BEP3, suite.timestamps[0],
+		0, 3600, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], sender, TestSenderOtherChain)
+
+	// swaps that expire by time are unaffected by block height
+	laterCtx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10000).WithBlockTime(suite.ctx.BlockTime().Add(59 * time.Minute))
+	suite.keeper.UpdateExpiredAtomicSwaps(laterCtx)
+	swap, found := suite.keeper.GetAtomicSwap(laterCtx, swapID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SWAP_STATUS_OPEN, swap.Status)
+
+	expiredCtx := laterCtx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.keeper.UpdateExpiredAtomicSwaps(expiredCtx)
+	swap, found = suite.keeper.GetAtomicSwap(expiredCtx, swapID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SWAP_STATUS_EXPIRED, swap.Status)
+
+	err = suite.keeper.RefundAtomicSwap(expiredCtx, recipient, swapID)
+	suite.Require().NoError(err)
+	senderBalancePost := suite.app.GetBankKeeper().GetBalance(expiredCtx, sender, HTLC_DENOM)
+	suite.Require().Equal(senderBalancePre, senderBalancePost)
+}
+
 func TestAtomicSwapTestSuite(t *testing.T) {
 	suite.Run(t, new(AtomicSwapTestSuite))
 }
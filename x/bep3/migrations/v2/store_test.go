@@ -0,0 +1,38 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2bep3 "github.com/kava-labs/kava/x/bep3/migrations/v2"
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+func TestStoreMigrationAddsHTLCAssetParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	bep3Key := sdk.NewKVStoreKey(types.ModuleName)
+	tBep3Key := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bep3Key, tBep3Key)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bep3Key, tBep3Key, types.ModuleName)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// Set the params that existed before the migration
+	paramstore.Set(ctx, types.KeyAssetParams, types.AssetParams{})
+	require.False(t, paramstore.Has(ctx, types.KeyHTLCAssetParams))
+
+	// Run migrations.
+	err := v2bep3.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new param is set and the full param set can be read.
+	require.True(t, paramstore.Has(ctx, types.KeyHTLCAssetParams))
+	var params types.Params
+	require.NotPanics(t, func() { paramstore.GetParamSet(ctx, &params) })
+	require.Empty(t, params.HTLCAssetParams)
+}
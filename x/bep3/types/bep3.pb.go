@@ -99,10 +99,68 @@ func (SwapDirection) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_01a01937d931b013, []int{1}
 }
 
+// SwapType is the type of an AtomicSwap
+type SwapType int32
+
+const (
+	// SWAP_TYPE_DEPUTY represents a swap relayed by an asset's deputy, where the asset is minted or burned on claim
+	SWAP_TYPE_DEPUTY SwapType = 0
+	// SWAP_TYPE_HTLC represents a generic hash time locked swap, where the sender's coins are escrowed until claim or refund
+	SWAP_TYPE_HTLC SwapType = 1
+)
+
+var SwapType_name = map[int32]string{
+	0: "SWAP_TYPE_DEPUTY",
+	1: "SWAP_TYPE_HTLC",
+}
+
+var SwapType_value = map[string]int32{
+	"SWAP_TYPE_DEPUTY": 0,
+	"SWAP_TYPE_HTLC":   1,
+}
+
+func (x SwapType) String() string {
+	return proto.EnumName(SwapType_name, int32(x))
+}
+
+func (SwapType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_01a01937d931b013, []int{2}
+}
+
+// HashScheme is the scheme used to hash an AtomicSwap's secret random number
+type HashScheme int32
+
+const (
+	// HASH_SCHEME_BEP3 represents the bep3 scheme, sha256(random_number || timestamp)
+	HASH_SCHEME_BEP3 HashScheme = 0
+	// HASH_SCHEME_SHA256 represents a plain sha256(random_number) hash, as used by bitcoin and ethereum htlcs
+	HASH_SCHEME_SHA256 HashScheme = 1
+)
+
+var HashScheme_name = map[int32]string{
+	0: "HASH_SCHEME_BEP3",
+	1: "HASH_SCHEME_SHA256",
+}
+
+var HashScheme_value = map[string]int32{
+	"HASH_SCHEME_BEP3":   0,
+	"HASH_SCHEME_SHA256": 1,
+}
+
+func (x HashScheme) String() string {
+	return proto.EnumName(HashScheme_name, int32(x))
+}
+
+func (HashScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_01a01937d931b013, []int{3}
+}
+
 // Params defines the parameters for the bep3 module.
 type Params struct {
 	// asset_params define the parameters for each bep3 asset
 	AssetParams AssetParams `protobuf:"bytes,1,rep,name=asset_params,json=assetParams,proto3,castrepeated=AssetParams" json:"asset_params"`
+	// htlc_asset_params define the parameters for each asset that can be swapped in generic htlc swaps
+	HTLCAssetParams HTLCAssetParams `protobuf:"bytes,2,rep,name=htlc_asset_params,json=htlcAssetParams,proto3,castrepeated=HTLCAssetParams" json:"htlc_asset_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -145,6 +203,13 @@ func (m *Params) GetAssetParams() AssetParams {
 	return nil
 }
 
+func (m *Params) GetHTLCAssetParams() HTLCAssetParams {
+	if m != nil {
+		return m.HTLCAssetParams
+	}
+	return nil
+}
+
 // AssetParam defines parameters for each bep3 asset.
 type AssetParam struct {
 	// denom represents the denominatin for this asset
@@ -251,6 +316,101 @@ func (m *AssetParam) GetMaxBlockLock() uint64 {
 	return 0
 }
 
+// HTLCAssetParam defines parameters for an asset that any account can lock in a generic htlc swap, without a deputy.
+type HTLCAssetParam struct {
+	// denom represents the denomination for this asset
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// active specifies if the asset is live or paused
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// min_swap_amount defines the minimum amount able to be swapped in a single message
+	MinSwapAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_swap_amount,json=minSwapAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_swap_amount"`
+	// max_swap_amount defines the maximum amount able to be swapped in a single message
+	MaxSwapAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_swap_amount,json=maxSwapAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_swap_amount"`
+	// min_block_lock defined the minimum blocks to lock
+	MinBlockLock uint64 `protobuf:"varint,5,opt,name=min_block_lock,json=minBlockLock,proto3" json:"min_block_lock,omitempty"`
+	// max_block_lock defined the maximum blocks to lock
+	MaxBlockLock uint64 `protobuf:"varint,6,opt,name=max_block_lock,json=maxBlockLock,proto3" json:"max_block_lock,omitempty"`
+	// min_time_lock defines the minimum duration to lock for swaps that expire by time
+	MinTimeLock time.Duration `protobuf:"bytes,7,opt,name=min_time_lock,json=minTimeLock,proto3,stdduration" json:"min_time_lock"`
+	// max_time_lock defines the maximum duration to lock for swaps that expire by time
+	MaxTimeLock time.Duration `protobuf:"bytes,8,opt,name=max_time_lock,json=maxTimeLock,proto3,stdduration" json:"max_time_lock"`
+}
+
+func (m *HTLCAssetParam) Reset()         { *m = HTLCAssetParam{} }
+func (m *HTLCAssetParam) String() string { return proto.CompactTextString(m) }
+func (*HTLCAssetParam) ProtoMessage()    {}
+func (*HTLCAssetParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01a01937d931b013, []int{2}
+}
+func (m *HTLCAssetParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTLCAssetParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HTLCAssetParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HTLCAssetParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTLCAssetParam.Merge(m, src)
+}
+func (m *HTLCAssetParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTLCAssetParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTLCAssetParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTLCAssetParam proto.InternalMessageInfo
+
+func (m *HTLCAssetParam) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *HTLCAssetParam) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *HTLCAssetParam) GetMinBlockLock() uint64 {
+	if m != nil {
+		return m.MinBlockLock
+	}
+	return 0
+}
+
+func (m *HTLCAssetParam) GetMaxBlockLock() uint64 {
+	if m != nil {
+		return m.MaxBlockLock
+	}
+	return 0
+}
+
+func (m *HTLCAssetParam) GetMinTimeLock() time.Duration {
+	if m != nil {
+		return m.MinTimeLock
+	}
+	return 0
+}
+
+func (m *HTLCAssetParam) GetMaxTimeLock() time.Duration {
+	if m != nil {
+		return m.MaxTimeLock
+	}
+	return 0
+}
+
 // SupplyLimit define the absolute and time-based limits for an assets's supply.
 type SupplyLimit struct {
 	// limit defines the total supply allowed
@@ -267,7 +427,7 @@ func (m *SupplyLimit) Reset()         { *m = SupplyLimit{} }
 func (m *SupplyLimit) String() string { return proto.CompactTextString(m) }
 func (*SupplyLimit) ProtoMessage()    {}
 func (*SupplyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_01a01937d931b013, []int{2}
+	return fileDescriptor_01a01937d931b013, []int{3}
 }
 func (m *SupplyLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,13 +496,19 @@ type AtomicSwap struct {
 	CrossChain bool `protobuf:"varint,11,opt,name=cross_chain,json=crossChain,proto3" json:"cross_chain,omitempty"`
 	// direction identifies if the swap is incoming or outgoing
 	Direction SwapDirection `protobuf:"varint,12,opt,name=direction,proto3,enum=kava.bep3.v1beta1.SwapDirection" json:"direction,omitempty"`
+	// swap_type identifies if the swap is relayed by a deputy or is a generic htlc swap
+	SwapType SwapType `protobuf:"varint,13,opt,name=swap_type,json=swapType,proto3,enum=kava.bep3.v1beta1.SwapType" json:"swap_type,omitempty"`
+	// hash_scheme identifies how the random number is hashed to the random number hash
+	HashScheme HashScheme `protobuf:"varint,14,opt,name=hash_scheme,json=hashScheme,proto3,enum=kava.bep3.v1beta1.HashScheme" json:"hash_scheme,omitempty"`
+	// expire_timestamp represents the unix time when the swap expires, it is 0 for swaps that expire by height
+	ExpireTimestamp int64 `protobuf:"varint,15,opt,name=expire_timestamp,json=expireTimestamp,proto3" json:"expire_timestamp,omitempty"`
 }
 
 func (m *AtomicSwap) Reset()         { *m = AtomicSwap{} }
 func (m *AtomicSwap) String() string { return proto.CompactTextString(m) }
 func (*AtomicSwap) ProtoMessage()    {}
 func (*AtomicSwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_01a01937d931b013, []int{3}
+	return fileDescriptor_01a01937d931b013, []int{4}
 }
 func (m *AtomicSwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,6 +621,27 @@ func (m *AtomicSwap) GetDirection() SwapDirection {
 	return SWAP_DIRECTION_UNSPECIFIED
 }
 
+func (m *AtomicSwap) GetSwapType() SwapType {
+	if m != nil {
+		return m.SwapType
+	}
+	return SWAP_TYPE_DEPUTY
+}
+
+func (m *AtomicSwap) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HASH_SCHEME_BEP3
+}
+
+func (m *AtomicSwap) GetExpireTimestamp() int64 {
+	if m != nil {
+		return m.ExpireTimestamp
+	}
+	return 0
+}
+
 // AssetSupply defines information about an asset's supply.
 type AssetSupply struct {
 	// incoming_supply represents the incoming supply of an asset
@@ -473,7 +660,7 @@ func (m *AssetSupply) Reset()         { *m = AssetSupply{} }
 func (m *AssetSupply) String() string { return proto.CompactTextString(m) }
 func (*AssetSupply) ProtoMessage()    {}
 func (*AssetSupply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_01a01937d931b013, []int{4}
+	return fileDescriptor_01a01937d931b013, []int{5}
 }
 func (m *AssetSupply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,8 +727,11 @@ func (m *AssetSupply) GetTimeElapsed() time.Duration {
 func init() {
 	proto.RegisterEnum("kava.bep3.v1beta1.SwapStatus", SwapStatus_name, SwapStatus_value)
 	proto.RegisterEnum("kava.bep3.v1beta1.SwapDirection", SwapDirection_name, SwapDirection_value)
+	proto.RegisterEnum("kava.bep3.v1beta1.SwapType", SwapType_name, SwapType_value)
+	proto.RegisterEnum("kava.bep3.v1beta1.HashScheme", HashScheme_name, HashScheme_value)
 	proto.RegisterType((*Params)(nil), "kava.bep3.v1beta1.Params")
 	proto.RegisterType((*AssetParam)(nil), "kava.bep3.v1beta1.AssetParam")
+	proto.RegisterType((*HTLCAssetParam)(nil), "kava.bep3.v1beta1.HTLCAssetParam")
 	proto.RegisterType((*SupplyLimit)(nil), "kava.bep3.v1beta1.SupplyLimit")
 	proto.RegisterType((*AtomicSwap)(nil), "kava.bep3.v1beta1.AtomicSwap")
 	proto.RegisterType((*AssetSupply)(nil), "kava.bep3.v1beta1.AssetSupply")
@@ -550,79 +740,94 @@ func init() {
 func init() { proto.RegisterFile("kava/bep3/v1beta1/bep3.proto", fileDescriptor_01a01937d931b013) }
 
 var fileDescriptor_01a01937d931b013 = []byte{
-	// 1147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1a, 0x57,
-	0x17, 0xf6, 0x18, 0x4c, 0xec, 0x03, 0x26, 0xbc, 0xd7, 0xc9, 0x1b, 0xec, 0xa4, 0x40, 0x9c, 0xaa,
-	0x42, 0x51, 0x0d, 0xf9, 0x68, 0x77, 0x55, 0x55, 0x06, 0x70, 0x8c, 0xe4, 0x00, 0x1a, 0x6c, 0xf5,
-	0x63, 0xd1, 0xe9, 0x9d, 0x99, 0x0b, 0x5c, 0x99, 0x99, 0x3b, 0x9a, 0x3b, 0x24, 0xf8, 0x1f, 0x74,
-	0xd1, 0x45, 0xbb, 0xeb, 0xbe, 0xbb, 0x2e, 0xab, 0xfc, 0x88, 0x2c, 0xa3, 0xac, 0xaa, 0x2e, 0x9c,
-	0xca, 0xf9, 0x17, 0xd9, 0xb4, 0xba, 0x1f, 0x06, 0x9c, 0xba, 0x15, 0x0b, 0x36, 0xf6, 0x9c, 0xaf,
-	0xe7, 0x9c, 0xb9, 0x73, 0x9e, 0xe7, 0x02, 0x77, 0x4e, 0xf0, 0x33, 0x5c, 0x75, 0x48, 0xf8, 0xb8,
-	0xfa, 0xec, 0xa1, 0x43, 0x62, 0xfc, 0x50, 0x1a, 0x95, 0x30, 0x62, 0x31, 0x43, 0xff, 0x13, 0xd1,
-	0x8a, 0x74, 0xe8, 0xe8, 0x4e, 0xc1, 0x65, 0xdc, 0x67, 0xbc, 0xea, 0x60, 0x4e, 0xa6, 0x25, 0x2e,
-	0xa3, 0x81, 0x2a, 0xd9, 0xd9, 0x56, 0x71, 0x5b, 0x5a, 0x55, 0x65, 0xe8, 0xd0, 0x8d, 0x01, 0x1b,
-	0x30, 0xe5, 0x17, 0x4f, 0xda, 0x5b, 0x18, 0x30, 0x36, 0x18, 0x91, 0xaa, 0xb4, 0x9c, 0x71, 0xbf,
-	0xea, 0x8d, 0x23, 0x1c, 0x53, 0xa6, 0x01, 0x77, 0x6d, 0x48, 0x75, 0x71, 0x84, 0x7d, 0x8e, 0x8e,
-	0x21, 0x83, 0x39, 0x27, 0xb1, 0x1d, 0x4a, 0x3b, 0x6f, 0x94, 0x12, 0xe5, 0xf4, 0xa3, 0x0f, 0x2a,
-	0xff, 0x18, 0xb2, 0x52, 0x13, 0x69, 0xb2, 0xca, 0xdc, 0x7a, 0x79, 0x56, 0x5c, 0xf9, 0xf5, 0x4d,
-	0x31, 0x3d, 0xf3, 0x71, 0x2b, 0x8d, 0x67, 0xc6, 0xee, 0x0f, 0x6b, 0x00, 0xb3, 0x20, 0xba, 0x01,
-	0x6b, 0x1e, 0x09, 0x98, 0x9f, 0x37, 0x4a, 0x46, 0x79, 0xc3, 0x52, 0x06, 0xba, 0x07, 0xd7, 0xc4,
-	0x4b, 0xda, 0xd4, 0xcb, 0xaf, 0x96, 0x8c, 0x72, 0xc2, 0x84, 0xf3, 0xb3, 0x62, 0xaa, 0xce, 0x68,
-	0xd0, 0x6a, 0x58, 0x29, 0x11, 0x6a, 0x79, 0xe8, 0x09, 0x64, 0xf8, 0x38, 0x0c, 0x47, 0xa7, 0xf6,
-	0x88, 0xfa, 0x34, 0xce, 0x27, 0x4a, 0x46, 0x39, 0xfd, 0xa8, 0x70, 0xc5, 0x80, 0x3d, 0x99, 0x76,
-	0x28, 0xb2, 0xcc, 0xa4, 0x98, 0xd0, 0x4a, 0xf3, 0x99, 0x0b, 0xfd, 0x1f, 0x52, 0xd8, 0x8d, 0xe9,
-	0x33, 0x92, 0x4f, 0x96, 0x8c, 0xf2, 0xba, 0xa5, 0x2d, 0xc4, 0x20, 0xeb, 0x91, 0x70, 0x1c, 0x9f,
-	0xda, 0xd8, 0xf3, 0x22, 0xc2, 0x79, 0x7e, 0xad, 0x64, 0x94, 0x33, 0xe6, 0xc1, 0xbb, 0xb3, 0xe2,
-	0xde, 0x80, 0xc6, 0xc3, 0xb1, 0x53, 0x71, 0x99, 0xaf, 0x8f, 0x5d, 0xff, 0xdb, 0xe3, 0xde, 0x49,
-	0x35, 0x3e, 0x0d, 0x09, 0xaf, 0xd4, 0x5c, 0xb7, 0xa6, 0x0a, 0x5f, 0xbf, 0xd8, 0xdb, 0xd2, 0x1f,
-	0x47, 0x7b, 0xcc, 0xd3, 0x98, 0x70, 0x6b, 0x53, 0xe1, 0x6b, 0x1f, 0xfa, 0x1a, 0x36, 0xfa, 0x74,
-	0x42, 0x3c, 0xbb, 0x4f, 0x48, 0x3e, 0x25, 0x0e, 0xc4, 0xfc, 0x4c, 0x8c, 0xfb, 0xc7, 0x59, 0xf1,
-	0xa3, 0x05, 0xfa, 0xb5, 0x82, 0xf8, 0xf5, 0x8b, 0x3d, 0xd0, 0x8d, 0x5a, 0x41, 0x6c, 0xad, 0x4b,
-	0xb8, 0x7d, 0x42, 0x90, 0x07, 0xd7, 0x7d, 0x1a, 0xd8, 0xfc, 0x39, 0x0e, 0x6d, 0xec, 0xb3, 0x71,
-	0x10, 0xe7, 0xaf, 0x2d, 0xa1, 0xc1, 0xa6, 0x4f, 0x83, 0xde, 0x73, 0x1c, 0xd6, 0x24, 0xa4, 0xec,
-	0x82, 0x27, 0x97, 0xba, 0xac, 0x2f, 0xa5, 0x0b, 0x9e, 0xcc, 0x75, 0xf9, 0x10, 0xb2, 0xe2, 0x5d,
-	0x9c, 0x11, 0x73, 0x4f, 0x6c, 0xf1, 0x27, 0xbf, 0x51, 0x32, 0xca, 0x49, 0x2b, 0xe3, 0xd3, 0xc0,
-	0x14, 0xf6, 0x21, 0x73, 0x4f, 0x64, 0x16, 0x9e, 0xcc, 0x67, 0x81, 0xce, 0xc2, 0x93, 0x69, 0xd6,
-	0xee, 0x6f, 0xab, 0x90, 0x9e, 0x5b, 0x0f, 0x64, 0xc1, 0x9a, 0xda, 0x26, 0x63, 0x09, 0x73, 0x2b,
-	0x28, 0x74, 0x17, 0x32, 0x31, 0xf5, 0x89, 0x5a, 0x53, 0xa2, 0x56, 0x7a, 0xdd, 0x4a, 0x0b, 0xdf,
-	0xa1, 0x72, 0xa1, 0x06, 0x48, 0xd3, 0x0e, 0x49, 0x44, 0x99, 0xa7, 0x57, 0x79, 0xbb, 0xa2, 0xc8,
-	0x5a, 0xb9, 0x20, 0x6b, 0xa5, 0xa1, 0xc9, 0x6a, 0xae, 0x8b, 0xb9, 0x7e, 0x7e, 0x53, 0x34, 0x2c,
-	0x10, 0x75, 0x5d, 0x59, 0x86, 0xfa, 0x90, 0x93, 0x28, 0x42, 0x2d, 0x3c, 0xcd, 0x8a, 0xe4, 0x12,
-	0xde, 0x23, 0x2b, 0x50, 0x4d, 0x01, 0x2a, 0xe7, 0xdd, 0xfd, 0x4b, 0x70, 0x38, 0x66, 0x3e, 0x75,
-	0xc5, 0x57, 0x41, 0x2e, 0xa4, 0xf4, 0xc7, 0x56, 0x1a, 0xb1, 0x5d, 0xd1, 0xb5, 0x62, 0x8e, 0x29,
-	0x09, 0x05, 0x7b, 0xcd, 0x07, 0x5a, 0x1f, 0xca, 0x0b, 0xcc, 0x21, 0x0a, 0xb8, 0xa5, 0xa1, 0x91,
-	0x03, 0x28, 0xc2, 0x81, 0xc7, 0x7c, 0x3b, 0x18, 0xfb, 0x0e, 0x89, 0xec, 0x21, 0xe6, 0x43, 0x79,
-	0x94, 0x19, 0xf3, 0x93, 0x77, 0x67, 0xc5, 0x07, 0x97, 0x10, 0x7d, 0x12, 0x3b, 0xfd, 0x78, 0xf6,
-	0x30, 0xa2, 0x0e, 0xaf, 0x3a, 0x82, 0x73, 0x95, 0x03, 0x32, 0x51, 0xe4, 0xcb, 0x29, 0xbc, 0xb6,
-	0x84, 0x3b, 0xc0, 0x7c, 0x88, 0xee, 0xc1, 0x26, 0x99, 0x84, 0x34, 0x22, 0xf6, 0x90, 0xd0, 0xc1,
-	0x50, 0x49, 0x4a, 0xd2, 0xca, 0x28, 0xe7, 0x81, 0xf4, 0xa1, 0x3b, 0xb0, 0x21, 0x8e, 0x83, 0xc7,
-	0xd8, 0x0f, 0xe5, 0xe9, 0x26, 0xac, 0x99, 0x03, 0x7d, 0x07, 0x29, 0x4e, 0x02, 0x8f, 0x44, 0x4b,
-	0xd7, 0x0a, 0x8d, 0x8b, 0xfa, 0xb0, 0x11, 0x11, 0x97, 0x86, 0x94, 0x04, 0xb1, 0x14, 0x89, 0x65,
-	0x36, 0x99, 0x41, 0xa3, 0x8f, 0x01, 0xa9, 0x8e, 0x36, 0x8b, 0x87, 0x24, 0xb2, 0xdd, 0x21, 0xa6,
-	0x81, 0x12, 0x0d, 0x2b, 0xa7, 0x22, 0x1d, 0x11, 0xa8, 0x0b, 0x3f, 0x7a, 0x04, 0x37, 0xa7, 0xa5,
-	0x97, 0x0a, 0x24, 0xff, 0xad, 0xad, 0x69, 0x70, 0xae, 0xe6, 0x2e, 0x64, 0xdc, 0x11, 0x13, 0xab,
-	0xea, 0x4c, 0x59, 0x9c, 0xb0, 0xd2, 0xca, 0x27, 0x29, 0x8a, 0x3e, 0x85, 0x14, 0x8f, 0x71, 0x3c,
-	0xe6, 0x92, 0xbc, 0xd9, 0x2b, 0xaf, 0x1f, 0xb1, 0x83, 0x3d, 0x99, 0x64, 0xe9, 0x64, 0x54, 0x84,
-	0xb4, 0x1b, 0x31, 0xce, 0xf5, 0x0c, 0x69, 0x49, 0x38, 0x90, 0x2e, 0xd5, 0xfa, 0x73, 0xd8, 0xf0,
-	0x68, 0x44, 0x5c, 0x41, 0xa6, 0x7c, 0x46, 0x42, 0x97, 0xfe, 0x05, 0xba, 0x71, 0x91, 0x67, 0xcd,
-	0x4a, 0x76, 0x7f, 0x4a, 0x80, 0xba, 0xe2, 0x94, 0x76, 0xa0, 0x03, 0xb8, 0x4e, 0x03, 0x97, 0xf9,
-	0x34, 0x18, 0xd8, 0xea, 0x6a, 0x91, 0x02, 0xf2, 0x9f, 0x5c, 0x50, 0x37, 0x51, 0xf6, 0xa2, 0x6e,
-	0x86, 0xc4, 0xc6, 0xf1, 0x80, 0xcd, 0x21, 0xad, 0x2e, 0x88, 0x74, 0x51, 0xa7, 0x91, 0xf6, 0x21,
-	0xeb, 0x8e, 0xa3, 0x48, 0x7c, 0x10, 0x0d, 0x94, 0x58, 0x0c, 0x68, 0x53, 0x97, 0x69, 0x9c, 0x6f,
-	0xe1, 0xf6, 0xbc, 0x7c, 0xd9, 0xef, 0x81, 0x26, 0x17, 0x03, 0xcd, 0xcf, 0xc9, 0x5d, 0xfd, 0x12,
-	0xfe, 0xbe, 0x96, 0x47, 0x32, 0xc2, 0x21, 0x27, 0x9e, 0x24, 0xce, 0x82, 0xe2, 0x27, 0x45, 0xb3,
-	0xa9, 0xea, 0xee, 0x9f, 0x02, 0xcc, 0x56, 0x01, 0xdd, 0x86, 0x5b, 0xbd, 0x2f, 0x6b, 0x5d, 0xbb,
-	0x77, 0x54, 0x3b, 0x3a, 0xee, 0xd9, 0xc7, 0xed, 0x5e, 0xb7, 0x59, 0x6f, 0xed, 0xb7, 0x9a, 0x8d,
-	0xdc, 0x0a, 0xba, 0x01, 0xb9, 0xf9, 0x60, 0xa7, 0xdb, 0x6c, 0xe7, 0x0c, 0xb4, 0x0d, 0x37, 0xe7,
-	0xbd, 0xf5, 0xce, 0xd3, 0xee, 0x61, 0xf3, 0xa8, 0xd9, 0xc8, 0xad, 0xa2, 0x5b, 0xb0, 0x35, 0x1f,
-	0x6a, 0x7e, 0xd5, 0x6d, 0x59, 0xcd, 0x46, 0x2e, 0xb1, 0x93, 0xfc, 0xfe, 0x97, 0xc2, 0xca, 0x7d,
-	0x06, 0x9b, 0x97, 0x56, 0x05, 0x15, 0x60, 0x47, 0xe6, 0x37, 0x5a, 0x56, 0xb3, 0x7e, 0xd4, 0xea,
-	0xb4, 0xdf, 0x1b, 0xe0, 0x62, 0xba, 0x59, 0xbc, 0xd5, 0xae, 0x77, 0x9e, 0xb6, 0xda, 0x4f, 0x72,
-	0xc6, 0x15, 0xc1, 0xce, 0xf1, 0xd1, 0x93, 0x8e, 0x08, 0xae, 0xaa, 0x86, 0xe6, 0x17, 0x2f, 0xcf,
-	0x0b, 0xc6, 0xab, 0xf3, 0x82, 0xf1, 0xe7, 0x79, 0xc1, 0xf8, 0xf1, 0x6d, 0x61, 0xe5, 0xd5, 0xdb,
-	0xc2, 0xca, 0xef, 0x6f, 0x0b, 0x2b, 0xdf, 0xcc, 0x2b, 0xbc, 0x58, 0xe8, 0xbd, 0x11, 0x76, 0xb8,
-	0x7c, 0xaa, 0x4e, 0xd4, 0x2f, 0x4f, 0xa9, 0x05, 0x4e, 0x4a, 0x9e, 0xeb, 0xe3, 0xbf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x79, 0xfe, 0xe7, 0xb2, 0x93, 0x0a, 0x00, 0x00,
+	// 1385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0x13, 0xd7,
+	0x17, 0xf7, 0xc4, 0x89, 0x93, 0x1c, 0x3b, 0x8e, 0xb9, 0xe1, 0x31, 0x09, 0xfc, 0x6d, 0x13, 0xfe,
+	0xaa, 0x5c, 0xd4, 0xd8, 0x10, 0x4a, 0x55, 0x55, 0x15, 0xc2, 0xaf, 0xc4, 0x96, 0x42, 0x62, 0x8d,
+	0x1d, 0xb5, 0x74, 0xd1, 0xe9, 0x3c, 0x6e, 0xec, 0x51, 0x3c, 0x0f, 0xcd, 0x1d, 0x83, 0xfd, 0x0d,
+	0xba, 0xe8, 0xa2, 0xdd, 0x75, 0xdf, 0xae, 0x58, 0x56, 0x7c, 0x08, 0x96, 0x88, 0x55, 0xc5, 0x22,
+	0x54, 0x61, 0xd3, 0xcf, 0xc0, 0xaa, 0xba, 0x0f, 0x7b, 0xc6, 0x90, 0x54, 0xae, 0x14, 0xb1, 0x81,
+	0x39, 0xbf, 0x73, 0xce, 0xef, 0x9c, 0x39, 0x3e, 0xf7, 0x77, 0x27, 0x70, 0xe3, 0x58, 0x7b, 0xa2,
+	0x95, 0x74, 0xec, 0xdd, 0x2b, 0x3d, 0xb9, 0xab, 0xe3, 0x40, 0xbb, 0xcb, 0x8c, 0xa2, 0xe7, 0xbb,
+	0x81, 0x8b, 0x2e, 0x51, 0x6f, 0x91, 0x01, 0xc2, 0xbb, 0x91, 0x35, 0x5c, 0x62, 0xbb, 0xa4, 0xa4,
+	0x6b, 0x04, 0x4f, 0x52, 0x0c, 0xd7, 0x72, 0x78, 0xca, 0xc6, 0x3a, 0xf7, 0xab, 0xcc, 0x2a, 0x71,
+	0x43, 0xb8, 0x2e, 0x77, 0xdd, 0xae, 0xcb, 0x71, 0xfa, 0x24, 0xd0, 0x6c, 0xd7, 0x75, 0xbb, 0x7d,
+	0x5c, 0x62, 0x96, 0x3e, 0x38, 0x2a, 0x99, 0x03, 0x5f, 0x0b, 0x2c, 0x57, 0x10, 0x6e, 0xbe, 0x96,
+	0x20, 0xd1, 0xd2, 0x7c, 0xcd, 0x26, 0xe8, 0x10, 0x52, 0x1a, 0x21, 0x38, 0x50, 0x3d, 0x66, 0xcb,
+	0x52, 0x3e, 0x5e, 0x48, 0x6e, 0xff, 0xaf, 0xf8, 0x41, 0x97, 0xc5, 0x32, 0x0d, 0x63, 0x59, 0x95,
+	0xb5, 0x17, 0x27, 0xb9, 0xd8, 0xb3, 0x37, 0xb9, 0x64, 0x88, 0x11, 0x25, 0xa9, 0x85, 0x06, 0x1a,
+	0xc1, 0xa5, 0x5e, 0xd0, 0x37, 0xd4, 0x29, 0xee, 0x39, 0xc6, 0x7d, 0xf3, 0x0c, 0xee, 0x46, 0x67,
+	0xaf, 0x1a, 0xe1, 0xbf, 0x4d, 0xf9, 0x4f, 0x4f, 0x72, 0xab, 0xd3, 0x38, 0x79, 0xf6, 0xe6, 0x03,
+	0x48, 0x59, 0xa5, 0x75, 0x22, 0xc0, 0xe6, 0x4f, 0x0b, 0x00, 0xa1, 0x8d, 0x2e, 0xc3, 0x82, 0x89,
+	0x1d, 0xd7, 0x96, 0xa5, 0xbc, 0x54, 0x58, 0x56, 0xb8, 0x81, 0x6e, 0xc1, 0x22, 0x1d, 0xb0, 0x6a,
+	0x99, 0xf2, 0x5c, 0x5e, 0x2a, 0xc4, 0x2b, 0x70, 0x7a, 0x92, 0x4b, 0x54, 0x5d, 0xcb, 0x69, 0xd6,
+	0x94, 0x04, 0x75, 0x35, 0x4d, 0xb4, 0x0b, 0x29, 0x32, 0xf0, 0xbc, 0xfe, 0x48, 0xed, 0x5b, 0xb6,
+	0x15, 0xc8, 0xf1, 0xbc, 0x54, 0x48, 0x6e, 0x67, 0xcf, 0xe8, 0xbf, 0xcd, 0xc2, 0xf6, 0x68, 0x54,
+	0x65, 0x9e, 0x36, 0xaf, 0x24, 0x49, 0x08, 0xa1, 0xab, 0x90, 0xd0, 0x8c, 0xc0, 0x7a, 0x82, 0xe5,
+	0xf9, 0xbc, 0x54, 0x58, 0x52, 0x84, 0x85, 0x5c, 0x48, 0x9b, 0xd8, 0x1b, 0x04, 0x23, 0x55, 0x33,
+	0x4d, 0x1f, 0x13, 0x22, 0x2f, 0xe4, 0xa5, 0x42, 0xaa, 0xd2, 0x78, 0x77, 0x92, 0xdb, 0xea, 0x5a,
+	0x41, 0x6f, 0xa0, 0x17, 0x0d, 0xd7, 0x16, 0x3f, 0xb9, 0xf8, 0x6f, 0x8b, 0x98, 0xc7, 0xa5, 0x60,
+	0xe4, 0x61, 0x52, 0x2c, 0x1b, 0x46, 0x99, 0x27, 0xbe, 0x7a, 0xbe, 0xb5, 0xc6, 0xdd, 0x45, 0x81,
+	0x54, 0x46, 0x01, 0x26, 0xca, 0x0a, 0xe7, 0x17, 0x18, 0x7a, 0x0c, 0xcb, 0x47, 0xd6, 0x10, 0x9b,
+	0xea, 0x11, 0xc6, 0x72, 0x82, 0x0e, 0xa4, 0xf2, 0x35, 0x6d, 0xf7, 0xf5, 0x49, 0xee, 0x93, 0x19,
+	0xea, 0x35, 0x9d, 0xe0, 0xd5, 0xf3, 0x2d, 0x10, 0x85, 0x9a, 0x4e, 0xa0, 0x2c, 0x31, 0xba, 0x1d,
+	0x8c, 0x91, 0x09, 0xab, 0xb6, 0xe5, 0xa8, 0xe4, 0xa9, 0xe6, 0xa9, 0x9a, 0xed, 0x0e, 0x9c, 0x40,
+	0x5e, 0xbc, 0x80, 0x02, 0x2b, 0xb6, 0xe5, 0xb4, 0x9f, 0x6a, 0x5e, 0x99, 0x51, 0xb2, 0x2a, 0xda,
+	0x70, 0xaa, 0xca, 0xd2, 0x85, 0x54, 0xd1, 0x86, 0x91, 0x2a, 0xff, 0x87, 0x34, 0x7d, 0x17, 0xbd,
+	0xef, 0x1a, 0xc7, 0x2a, 0xfd, 0x47, 0x5e, 0xce, 0x4b, 0x85, 0x79, 0x25, 0x65, 0x5b, 0x4e, 0x85,
+	0xda, 0x7b, 0xae, 0x71, 0xcc, 0xa2, 0xb4, 0x61, 0x34, 0x0a, 0x44, 0x94, 0x36, 0x9c, 0x44, 0x6d,
+	0xfe, 0x1d, 0x87, 0xf4, 0xf4, 0xce, 0x9e, 0xb3, 0x92, 0xe1, 0x92, 0xcc, 0x4d, 0x2d, 0xc9, 0x19,
+	0x83, 0x8d, 0x7f, 0x94, 0xc1, 0xce, 0x7f, 0x8c, 0xc1, 0x2e, 0xcc, 0x34, 0xd8, 0xc4, 0x87, 0x83,
+	0x45, 0xbb, 0x40, 0x5f, 0x41, 0x0d, 0x2c, 0x1b, 0xf3, 0xa0, 0x45, 0x76, 0x3c, 0xd7, 0x8b, 0x5c,
+	0xfc, 0x8a, 0x63, 0xf1, 0x2b, 0xd6, 0x84, 0xf8, 0x55, 0x96, 0xe8, 0xab, 0xfc, 0xfa, 0x26, 0x27,
+	0x29, 0x49, 0xdb, 0x72, 0x3a, 0x96, 0x8d, 0x27, 0x44, 0xda, 0x30, 0x42, 0xb4, 0xf4, 0x5f, 0x88,
+	0xb4, 0xe1, 0x98, 0x68, 0xf3, 0x8f, 0x39, 0x48, 0x46, 0x94, 0x00, 0x29, 0xb0, 0xc0, 0x85, 0x43,
+	0xba, 0x80, 0x49, 0x72, 0x2a, 0x74, 0x13, 0x52, 0xbc, 0x51, 0x6a, 0x61, 0x53, 0xec, 0x4a, 0x92,
+	0x62, 0x7b, 0x1c, 0x42, 0x35, 0x60, 0xa6, 0xea, 0x61, 0xdf, 0x72, 0x4d, 0x39, 0x3e, 0xfb, 0xdb,
+	0x00, 0xcd, 0x6b, 0xb1, 0x34, 0x74, 0x04, 0x19, 0xc6, 0x42, 0x2f, 0x25, 0x53, 0x08, 0xe0, 0x45,
+	0x6c, 0x44, 0x9a, 0xb2, 0x56, 0x28, 0x29, 0xeb, 0x77, 0xf3, 0xf7, 0x45, 0x80, 0x72, 0xe0, 0xda,
+	0x96, 0x41, 0xf7, 0x04, 0x19, 0x90, 0x10, 0xeb, 0xc7, 0x6f, 0xa2, 0xf5, 0xa2, 0xc8, 0xa5, 0x7d,
+	0x4c, 0xf4, 0x96, 0x0a, 0x75, 0xe5, 0x8e, 0xb8, 0x85, 0x0a, 0x33, 0xf4, 0x41, 0x13, 0x88, 0x22,
+	0xa8, 0x91, 0x0e, 0xc8, 0xd7, 0x1c, 0xd3, 0xb5, 0x55, 0x67, 0x60, 0xeb, 0xd8, 0x57, 0x7b, 0x1a,
+	0xe9, 0xb1, 0x51, 0xa6, 0x2a, 0x9f, 0xbf, 0x3b, 0xc9, 0xdd, 0x99, 0x62, 0xb4, 0x71, 0xa0, 0x1f,
+	0x05, 0xe1, 0x43, 0xdf, 0xd2, 0x49, 0x49, 0xa7, 0xf2, 0x5a, 0x6c, 0xe0, 0x21, 0xd7, 0xd9, 0x0c,
+	0xe7, 0xdb, 0x67, 0x74, 0x0d, 0x8d, 0xf4, 0xd0, 0x2d, 0x58, 0xc1, 0x43, 0xcf, 0xf2, 0xb1, 0xda,
+	0xc3, 0x56, 0xb7, 0xc7, 0x0f, 0xed, 0xbc, 0x92, 0xe2, 0x60, 0x83, 0x61, 0xe8, 0x06, 0x2c, 0xd3,
+	0x71, 0x90, 0x40, 0xb3, 0x3d, 0x36, 0xdd, 0xb8, 0x12, 0x02, 0xe8, 0x07, 0x48, 0x10, 0xec, 0x98,
+	0xd8, 0xbf, 0xf0, 0x6b, 0x41, 0xf0, 0xa2, 0x23, 0x58, 0xf6, 0xb1, 0x61, 0x79, 0x16, 0x76, 0x02,
+	0x39, 0x71, 0xc1, 0x45, 0x42, 0x6a, 0xf4, 0x19, 0x20, 0x5e, 0x51, 0x75, 0x83, 0x1e, 0xf6, 0x55,
+	0xa3, 0xa7, 0x59, 0x0e, 0xbf, 0x1f, 0x94, 0x0c, 0xf7, 0x1c, 0x50, 0x47, 0x95, 0xe2, 0x68, 0x1b,
+	0xae, 0x4c, 0x52, 0xa7, 0x12, 0x98, 0xd4, 0x2b, 0x6b, 0x13, 0x67, 0x24, 0xe7, 0x26, 0xa4, 0x8c,
+	0xbe, 0x4b, 0x57, 0x55, 0x9f, 0x08, 0x76, 0x5c, 0x49, 0x72, 0x8c, 0x89, 0x06, 0xba, 0x0f, 0x09,
+	0x12, 0x68, 0xc1, 0x80, 0x30, 0x9d, 0x4e, 0x9f, 0xf9, 0x91, 0x43, 0x77, 0xb0, 0xcd, 0x82, 0x14,
+	0x11, 0x8c, 0x72, 0x90, 0x34, 0x7c, 0x97, 0x10, 0xd1, 0x43, 0x92, 0x1d, 0x38, 0x60, 0x10, 0x2f,
+	0xfd, 0x00, 0x96, 0x4d, 0xcb, 0xc7, 0x06, 0x3d, 0x4c, 0x72, 0x8a, 0x51, 0xe7, 0xcf, 0xa1, 0xae,
+	0x8d, 0xe3, 0x94, 0x30, 0x05, 0x7d, 0x09, 0xcb, 0x4c, 0x76, 0xe9, 0x5c, 0xe5, 0x15, 0x96, 0x7f,
+	0xfd, 0x9c, 0xfc, 0xce, 0xc8, 0xc3, 0xca, 0x12, 0x11, 0x4f, 0xe8, 0x01, 0x24, 0xe9, 0xe6, 0xaa,
+	0xc4, 0xe8, 0x61, 0x1b, 0xcb, 0xe9, 0x73, 0x5f, 0x8b, 0x6e, 0x64, 0x9b, 0x05, 0x29, 0xd0, 0x9b,
+	0x3c, 0xa3, 0x4f, 0x21, 0x23, 0x76, 0x34, 0xdc, 0xc2, 0x55, 0x36, 0xb8, 0x55, 0x8e, 0x77, 0xc6,
+	0xf0, 0xe6, 0x2f, 0x71, 0xe0, 0x5f, 0x7b, 0x5c, 0xe0, 0x50, 0x03, 0x56, 0x2d, 0xc7, 0x70, 0x6d,
+	0xcb, 0xe9, 0xaa, 0xfc, 0x53, 0x87, 0xa9, 0xdc, 0xbf, 0x1e, 0x58, 0xfe, 0x65, 0x94, 0x1e, 0xe7,
+	0x85, 0x4c, 0xee, 0x20, 0xe8, 0xba, 0x11, 0xa6, 0xb9, 0x19, 0x99, 0xc6, 0x79, 0x82, 0x69, 0x07,
+	0xd2, 0xc6, 0xc0, 0xf7, 0xe9, 0xd6, 0x08, 0xa2, 0xf8, 0x6c, 0x44, 0x2b, 0x22, 0x4d, 0xf0, 0x7c,
+	0x0f, 0xd7, 0xa3, 0x1a, 0xab, 0xbe, 0x47, 0x3a, 0x3f, 0x1b, 0xa9, 0x1c, 0xd1, 0xe4, 0xea, 0x14,
+	0xff, 0x8e, 0xd0, 0x70, 0xdc, 0xd7, 0x3c, 0x82, 0x4d, 0x79, 0x41, 0x10, 0xce, 0x72, 0xdf, 0xd0,
+	0xc4, 0x3a, 0xcf, 0xbb, 0x3d, 0x02, 0x08, 0xf7, 0x15, 0x5d, 0x87, 0x6b, 0xed, 0x6f, 0xca, 0x2d,
+	0xb5, 0xdd, 0x29, 0x77, 0x0e, 0xdb, 0xea, 0xe1, 0x7e, 0xbb, 0x55, 0xaf, 0x36, 0x77, 0x9a, 0xf5,
+	0x5a, 0x26, 0x86, 0x2e, 0x43, 0x26, 0xea, 0x3c, 0x68, 0xd5, 0xf7, 0x33, 0x12, 0x5a, 0x87, 0x2b,
+	0x51, 0xb4, 0x7a, 0xf0, 0xa8, 0xb5, 0x57, 0xef, 0xd4, 0x6b, 0x99, 0x39, 0x74, 0x0d, 0xd6, 0xa2,
+	0xae, 0xfa, 0xb7, 0xad, 0xa6, 0x52, 0xaf, 0x65, 0xe2, 0x1b, 0xf3, 0x3f, 0xfe, 0x96, 0x8d, 0xdd,
+	0x76, 0x61, 0x65, 0x6a, 0x9f, 0x51, 0x16, 0x36, 0x58, 0x7c, 0xad, 0xa9, 0xd4, 0xab, 0x9d, 0xe6,
+	0xc1, 0xfe, 0x7b, 0x0d, 0x8c, 0xbb, 0x0b, 0xfd, 0xcd, 0xfd, 0xea, 0xc1, 0xa3, 0xe6, 0xfe, 0x6e,
+	0x46, 0x3a, 0xc3, 0x79, 0x70, 0xd8, 0xd9, 0x3d, 0xa0, 0xce, 0x39, 0x51, 0xf0, 0x2b, 0x58, 0x1a,
+	0x1f, 0x80, 0xc9, 0xcb, 0x74, 0x1e, 0xb7, 0xea, 0x6a, 0xad, 0xde, 0x3a, 0xec, 0x3c, 0xce, 0xc4,
+	0x10, 0x82, 0x74, 0x88, 0xd2, 0x2f, 0xae, 0x8c, 0x24, 0x72, 0x1f, 0x02, 0x84, 0x07, 0x80, 0x66,
+	0x37, 0xca, 0xed, 0x86, 0xda, 0xae, 0x36, 0xea, 0x8f, 0xea, 0x6a, 0xa5, 0xde, 0xba, 0x97, 0x89,
+	0xa1, 0xab, 0x80, 0xa2, 0x68, 0xbb, 0x51, 0xde, 0xbe, 0xff, 0xc5, 0x98, 0xa1, 0xf2, 0xf0, 0xc5,
+	0x69, 0x56, 0x7a, 0x79, 0x9a, 0x95, 0xfe, 0x3a, 0xcd, 0x4a, 0x3f, 0xbf, 0xcd, 0xc6, 0x5e, 0xbe,
+	0xcd, 0xc6, 0xfe, 0x7c, 0x9b, 0x8d, 0x7d, 0x17, 0xbd, 0x04, 0xe9, 0xb9, 0xdb, 0xea, 0x6b, 0x3a,
+	0x61, 0x4f, 0xa5, 0x21, 0xff, 0x1b, 0x90, 0xc9, 0xa5, 0x9e, 0x60, 0xbf, 0xea, 0xbd, 0x7f, 0x06,
+	0x00, 0x83, 0x27, 0xa3, 0x57, 0x1d, 0x0e, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -645,6 +850,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HTLCAssetParams) > 0 {
+		for iNdEx := len(m.HTLCAssetParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HTLCAssetParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBep3(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.AssetParams) > 0 {
 		for iNdEx := len(m.AssetParams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -764,6 +983,92 @@ func (m *AssetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTLCAssetParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTLCAssetParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTLCAssetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTimeLock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeLock):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintBep3(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinTimeLock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinTimeLock):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBep3(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxBlockLock != 0 {
+		i = encodeVarintBep3(dAtA, i, uint64(m.MaxBlockLock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinBlockLock != 0 {
+		i = encodeVarintBep3(dAtA, i, uint64(m.MinBlockLock))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxSwapAmount.Size()
+		i -= size
+		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBep3(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinSwapAmount.Size()
+		i -= size
+		if _, err := m.MinSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBep3(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBep3(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SupplyLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -794,12 +1099,12 @@ func (m *SupplyLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimePeriod):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimePeriod):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintBep3(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintBep3(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	if m.TimeLimited {
@@ -845,6 +1150,21 @@ func (m *AtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpireTimestamp != 0 {
+		i = encodeVarintBep3(dAtA, i, uint64(m.ExpireTimestamp))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintBep3(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SwapType != 0 {
+		i = encodeVarintBep3(dAtA, i, uint64(m.SwapType))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.Direction != 0 {
 		i = encodeVarintBep3(dAtA, i, uint64(m.Direction))
 		i--
@@ -952,12 +1272,12 @@ func (m *AssetSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeElapsed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeElapsed):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeElapsed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeElapsed):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintBep3(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintBep3(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -1026,6 +1346,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovBep3(uint64(l))
 		}
 	}
+	if len(m.HTLCAssetParams) > 0 {
+		for _, e := range m.HTLCAssetParams {
+			l = e.Size()
+			n += 1 + l + sovBep3(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1066,6 +1392,36 @@ func (m *AssetParam) Size() (n int) {
 	return n
 }
 
+func (m *HTLCAssetParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBep3(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = m.MinSwapAmount.Size()
+	n += 1 + l + sovBep3(uint64(l))
+	l = m.MaxSwapAmount.Size()
+	n += 1 + l + sovBep3(uint64(l))
+	if m.MinBlockLock != 0 {
+		n += 1 + sovBep3(uint64(m.MinBlockLock))
+	}
+	if m.MaxBlockLock != 0 {
+		n += 1 + sovBep3(uint64(m.MaxBlockLock))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinTimeLock)
+	n += 1 + l + sovBep3(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeLock)
+	n += 1 + l + sovBep3(uint64(l))
+	return n
+}
+
 func (m *SupplyLimit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1134,6 +1490,15 @@ func (m *AtomicSwap) Size() (n int) {
 	if m.Direction != 0 {
 		n += 1 + sovBep3(uint64(m.Direction))
 	}
+	if m.SwapType != 0 {
+		n += 1 + sovBep3(uint64(m.SwapType))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovBep3(uint64(m.HashScheme))
+	}
+	if m.ExpireTimestamp != 0 {
+		n += 1 + sovBep3(uint64(m.ExpireTimestamp))
+	}
 	return n
 }
 
@@ -1225,16 +1590,50 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBep3(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBep3
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTLCAssetParams", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep3
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep3
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HTLCAssetParams = append(m.HTLCAssetParams, HTLCAssetParam{})
+			if err := m.HTLCAssetParams[len(m.HTLCAssetParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep3(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep3
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -1574,6 +1973,280 @@ func (m *AssetParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HTLCAssetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep3
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTLCAssetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTLCAssetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep3
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep3
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep3
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep3
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep3
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep3
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockLock", wireType)
+			}
+			m.MinBlockLock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockLock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockLock", wireType)
+			}
+			m.MaxBlockLock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockLock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep3
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep3
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinTimeLock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep3
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep3
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTimeLock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep3(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep3
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2089,6 +2762,63 @@ func (m *AtomicSwap) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapType", wireType)
+			}
+			m.SwapType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapType |= SwapType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTimestamp", wireType)
+			}
+			m.ExpireTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep3
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBep3(dAtA[iNdEx:])
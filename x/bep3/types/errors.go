@@ -43,4 +43,8 @@ var (
 	ErrInvalidSwapAccount = errorsmod.Register(ModuleName, 19, "atomic swap has invalid account")
 	// ErrExceedsTimeBasedSupplyLimit error for when the proposed supply increase would put the supply above limit for the current time period
 	ErrExceedsTimeBasedSupplyLimit = errorsmod.Register(ModuleName, 20, "asset supply over limit for current time period")
+	// ErrInvalidTimeSpan error for when a swap's time span is outside acceptable range
+	ErrInvalidTimeSpan = errorsmod.Register(ModuleName, 21, "time span is outside acceptable range")
+	// ErrInvalidHashScheme error for when a swap uses a hash scheme that is not supported for its asset
+	ErrInvalidHashScheme = errorsmod.Register(ModuleName, 22, "hash scheme is not supported")
 )
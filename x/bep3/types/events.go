@@ -15,8 +15,11 @@ const (
 	AttributeKeyTimestamp        = "timestamp"
 	AttributeKeySenderOtherChain = "sender_other_chain"
 	AttributeKeyExpireHeight     = "expire_height"
+	AttributeKeyExpireTimestamp  = "expire_timestamp"
 	AttributeKeyAmount           = "amount"
 	AttributeKeyDirection        = "direction"
+	AttributeKeySwapType         = "swap_type"
+	AttributeKeyHashScheme       = "hash_scheme"
 	AttributeKeyClaimSender      = "claim_sender"
 	AttributeKeyRandomNumber     = "random_number"
 	AttributeKeyRefundSender     = "refund_sender"
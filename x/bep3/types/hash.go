@@ -26,6 +26,20 @@ func CalculateRandomHash(randomNumber []byte, timestamp int64) []byte {
 	return tmhash.Sum(data)
 }
 
+// CalculateSHA256Hash calculates the plain sha256 hash of a number, as used by bitcoin and ethereum htlcs
+func CalculateSHA256Hash(randomNumber []byte) []byte {
+	return tmhash.Sum(randomNumber)
+}
+
+// CalculateSchemeHash calculates the hash of a number using the given hash scheme. The timestamp is
+// only used by the bep3 scheme.
+func CalculateSchemeHash(scheme HashScheme, randomNumber []byte, timestamp int64) []byte {
+	if scheme == HASH_SCHEME_SHA256 {
+		return CalculateSHA256Hash(randomNumber)
+	}
+	return CalculateRandomHash(randomNumber, timestamp)
+}
+
 // CalculateSwapID calculates the hash of a RandomNumberHash, sdk.AccAddress, and string
 func CalculateSwapID(randomNumberHash []byte, sender sdk.AccAddress, senderOtherChain string) []byte {
 	senderOtherChain = strings.ToLower(senderOtherChain)
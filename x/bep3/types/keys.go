@@ -28,9 +28,15 @@ var (
 	AtomicSwapLongtermStoragePrefix = []byte{0x02} // prefix for keys of the AtomicSwapLongtermStorage index
 	AssetSupplyPrefix               = []byte{0x03}
 	PreviousBlockTimeKey            = []byte{0x04}
+	AtomicSwapByTimePrefix          = []byte{0x05} // prefix for keys of the AtomicSwapsByTime index
 )
 
 // GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock index and AtomicSwapLongtermStorage index
 func GetAtomicSwapByHeightKey(height uint64, swapID []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(height), swapID...)
 }
+
+// GetAtomicSwapByTimeKey is used by the AtomicSwapByTime index
+func GetAtomicSwapByTimeKey(timestamp int64, swapID []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(timestamp)), swapID...)
+}
@@ -39,7 +39,7 @@ var (
 // NewMsgCreateAtomicSwap initializes a new MsgCreateAtomicSwap
 func NewMsgCreateAtomicSwap(from, to string, recipientOtherChain,
 	senderOtherChain string, randomNumberHash tmbytes.HexBytes, timestamp int64,
-	amount sdk.Coins, heightSpan uint64,
+	amount sdk.Coins, heightSpan uint64, timeSpan uint64, hashScheme HashScheme,
 ) MsgCreateAtomicSwap {
 	return MsgCreateAtomicSwap{
 		From:                from,
@@ -50,6 +50,8 @@ func NewMsgCreateAtomicSwap(from, to string, recipientOtherChain,
 		Timestamp:           timestamp,
 		Amount:              amount,
 		HeightSpan:          heightSpan,
+		TimeSpan:            timeSpan,
+		HashScheme:          hashScheme,
 	}
 }
 
@@ -61,9 +63,9 @@ func (msg MsgCreateAtomicSwap) Type() string { return CreateAtomicSwap }
 
 // String prints the MsgCreateAtomicSwap
 func (msg MsgCreateAtomicSwap) String() string {
-	return fmt.Sprintf("AtomicSwap{%v#%v#%v#%v#%v#%v#%v#%v}",
+	return fmt.Sprintf("AtomicSwap{%v#%v#%v#%v#%v#%v#%v#%v#%v#%v}",
 		msg.From, msg.To, msg.RecipientOtherChain, msg.SenderOtherChain,
-		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.HeightSpan)
+		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.HeightSpan, msg.TimeSpan, msg.HashScheme)
 }
 
 // GetInvolvedAddresses gets the addresses involved in a MsgCreateAtomicSwap
@@ -121,9 +123,15 @@ func (msg MsgCreateAtomicSwap) ValidateBasic() error {
 	if !msg.Amount.IsValid() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
 	}
-	if msg.HeightSpan <= 0 {
+	if msg.HeightSpan > 0 && msg.TimeSpan > 0 {
+		return errors.New("only one of height span and time span can be set")
+	}
+	if msg.HeightSpan <= 0 && msg.TimeSpan <= 0 {
 		return errors.New("height span must be positive")
 	}
+	if !msg.HashScheme.IsValid() {
+		return fmt.Errorf("invalid hash scheme: %d", msg.HashScheme)
+	}
 	return nil
 }
 
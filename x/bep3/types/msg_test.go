@@ -55,14 +55,20 @@ func (suite *MsgTestSuite) TestMsgCreateAtomicSwap() {
 		timestamp           int64
 		amount              sdk.Coins
 		heightSpan          uint64
+		timeSpan            uint64
+		hashScheme          types.HashScheme
 		expectPass          bool
 	}{
-		{"normal cross-chain", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 500, true},
-		{"without other chain fields", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash.String(), timestampInt64, coinsSingle, 500, false},
-		{"invalid amount", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, nil, 500, false},
-		{"invalid from address", sdk.AccAddress{}, kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 500, false},
-		{"invalid to address", binanceAddrs[0], sdk.AccAddress{}, kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 500, false},
-		{"invalid rand hash", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), "ff", timestampInt64, coinsSingle, 500, false},
+		{"normal cross-chain", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 500, 0, types.HASH_SCHEME_BEP3, true},
+		{"without other chain fields", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash.String(), timestampInt64, coinsSingle, 500, 0, types.HASH_SCHEME_BEP3, false},
+		{"invalid amount", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, nil, 500, 0, types.HASH_SCHEME_BEP3, false},
+		{"invalid from address", sdk.AccAddress{}, kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 500, 0, types.HASH_SCHEME_BEP3, false},
+		{"invalid to address", binanceAddrs[0], sdk.AccAddress{}, kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 500, 0, types.HASH_SCHEME_BEP3, false},
+		{"invalid rand hash", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), "ff", timestampInt64, coinsSingle, 500, 0, types.HASH_SCHEME_BEP3, false},
+		{"time span with sha256", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 0, 3600, types.HASH_SCHEME_SHA256, true},
+		{"height span and time span", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 500, 3600, types.HASH_SCHEME_BEP3, false},
+		{"no height span or time span", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 0, 0, types.HASH_SCHEME_BEP3, false},
+		{"invalid hash scheme", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash.String(), timestampInt64, coinsSingle, 500, 0, types.HashScheme(5), false},
 	}
 
 	for i, tc := range tests {
@@ -75,6 +81,8 @@ func (suite *MsgTestSuite) TestMsgCreateAtomicSwap() {
 			tc.timestamp,
 			tc.amount,
 			tc.heightSpan,
+			tc.hashScheme,
+			tc.timeSpan,
 		}
 		if tc.expectPass {
 			suite.NoError(msg.ValidateBasic(), "test: %v", i)
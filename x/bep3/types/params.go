@@ -17,7 +17,8 @@ const (
 
 // Parameter keys
 var (
-	KeyAssetParams = []byte("AssetParams")
+	KeyAssetParams     = []byte("AssetParams")
+	KeyHTLCAssetParams = []byte("HTLCAssetParams")
 
 	DefaultBnbDeputyFixedFee sdkmath.Int = sdkmath.NewInt(1000) // 0.00001 BNB
 	DefaultMinAmount         sdkmath.Int = sdk.ZeroInt()
@@ -28,15 +29,16 @@ var (
 )
 
 // NewParams returns a new params object
-func NewParams(ap []AssetParam) Params {
+func NewParams(ap []AssetParam, htlcAp []HTLCAssetParam) Params {
 	return Params{
-		AssetParams: ap,
+		AssetParams:     ap,
+		HTLCAssetParams: htlcAp,
 	}
 }
 
 // DefaultParams returns default params for bep3 module
 func DefaultParams() Params {
-	return NewParams(AssetParams{})
+	return NewParams(AssetParams{}, HTLCAssetParams{})
 }
 
 // NewAssetParam returns a new AssetParam
@@ -62,6 +64,26 @@ func NewAssetParam(
 // AssetParams array of AssetParam
 type AssetParams []AssetParam
 
+// NewHTLCAssetParam returns a new HTLCAssetParam
+func NewHTLCAssetParam(
+	denom string, active bool, minSwapAmount sdkmath.Int, maxSwapAmount sdkmath.Int,
+	minBlockLock uint64, maxBlockLock uint64, minTimeLock time.Duration, maxTimeLock time.Duration,
+) HTLCAssetParam {
+	return HTLCAssetParam{
+		Denom:         denom,
+		Active:        active,
+		MinSwapAmount: minSwapAmount,
+		MaxSwapAmount: maxSwapAmount,
+		MinBlockLock:  minBlockLock,
+		MaxBlockLock:  maxBlockLock,
+		MinTimeLock:   minTimeLock,
+		MaxTimeLock:   maxTimeLock,
+	}
+}
+
+// HTLCAssetParams array of HTLCAssetParam
+type HTLCAssetParams []HTLCAssetParam
+
 // Equals returns true if two supply limits are equal
 func (sl SupplyLimit) Equals(sl2 SupplyLimit) bool {
 	return sl.Limit.Equal(sl2.Limit) && sl.TimeLimited == sl2.TimeLimited && sl.TimePeriod == sl2.TimePeriod && sl.TimeBasedLimit.Equal(sl2.TimeBasedLimit)
@@ -78,12 +100,30 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAssetParams, &p.AssetParams, validateAssetParams),
+		paramtypes.NewParamSetPair(KeyHTLCAssetParams, &p.HTLCAssetParams, validateHTLCAssetParams),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateAssetParams(p.AssetParams)
+	if err := validateAssetParams(p.AssetParams); err != nil {
+		return err
+	}
+	if err := validateHTLCAssetParams(p.HTLCAssetParams); err != nil {
+		return err
+	}
+
+	// deputy assets are minted and burned, so they cannot also be escrowed in htlc swaps
+	deputyDenoms := make(map[string]bool)
+	for _, asset := range p.AssetParams {
+		deputyDenoms[asset.Denom] = true
+	}
+	for _, asset := range p.HTLCAssetParams {
+		if deputyDenoms[asset.Denom] {
+			return fmt.Errorf("htlc asset %s cannot also be a deputy asset", asset.Denom)
+		}
+	}
+	return nil
 }
 
 func validateAssetParams(i interface{}) error {
@@ -148,3 +188,53 @@ func validateAssetParams(i interface{}) error {
 
 	return nil
 }
+
+func validateHTLCAssetParams(i interface{}) error {
+	assetParams, ok := i.(HTLCAssetParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	coinDenoms := make(map[string]bool)
+	for _, asset := range assetParams {
+		if err := sdk.ValidateDenom(asset.Denom); err != nil {
+			return fmt.Errorf("htlc asset denom invalid: %s", asset.Denom)
+		}
+
+		if coinDenoms[asset.Denom] {
+			return fmt.Errorf("htlc asset %s cannot have duplicate denom", asset.Denom)
+		}
+		coinDenoms[asset.Denom] = true
+
+		if asset.MinBlockLock == 0 {
+			return fmt.Errorf("htlc asset %s must have a positive minimum block lock", asset.Denom)
+		}
+
+		if asset.MinBlockLock > asset.MaxBlockLock {
+			return fmt.Errorf("htlc asset %s has minimum block lock > maximum block lock %d > %d", asset.Denom, asset.MinBlockLock, asset.MaxBlockLock)
+		}
+
+		// a zero time lock range disables time-based expiry for the asset
+		if asset.MinTimeLock < 0 {
+			return fmt.Errorf("htlc asset %s cannot have a negative minimum time lock", asset.Denom)
+		}
+
+		if asset.MinTimeLock > asset.MaxTimeLock {
+			return fmt.Errorf("htlc asset %s has minimum time lock > maximum time lock %s > %s", asset.Denom, asset.MinTimeLock, asset.MaxTimeLock)
+		}
+
+		if asset.MinSwapAmount.IsNil() || !asset.MinSwapAmount.IsPositive() {
+			return fmt.Errorf("htlc asset %s must have a positive minimum swap amount, got %s", asset.Denom, asset.MinSwapAmount)
+		}
+
+		if asset.MaxSwapAmount.IsNil() || !asset.MaxSwapAmount.IsPositive() {
+			return fmt.Errorf("htlc asset %s must have a positive maximum swap amount, got %s", asset.Denom, asset.MaxSwapAmount)
+		}
+
+		if asset.MinSwapAmount.GT(asset.MaxSwapAmount) {
+			return fmt.Errorf("htlc asset %s has minimum swap amount > maximum swap amount %s > %s", asset.Denom, asset.MinSwapAmount, asset.MaxSwapAmount)
+		}
+	}
+
+	return nil
+}
@@ -232,7 +232,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.assetParams)
+			params := types.NewParams(tc.args.assetParams, types.HTLCAssetParams{})
 			err := params.Validate()
 			if tc.expectPass {
 				suite.Require().NoError(err, tc.name)
@@ -244,6 +244,130 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestHTLCParamValidation() {
+	bnbAsset := types.NewAssetParam(
+		"bnb", 714, suite.supply[0], true,
+		suite.addr, sdkmath.NewInt(1000), sdkmath.NewInt(100000000), sdkmath.NewInt(100000000000),
+		types.DefaultMinBlockLock, types.DefaultMaxBlockLock)
+
+	testCases := []struct {
+		name            string
+		htlcAssetParams types.HTLCAssetParams
+		expectedErr     string
+	}{
+		{
+			name: "valid",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, time.Minute, time.Hour),
+			},
+		},
+		{
+			name: "invalid denom",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("UKAVA!", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, time.Minute, time.Hour),
+			},
+			expectedErr: "htlc asset denom invalid",
+		},
+		{
+			name: "duplicate denom",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, time.Minute, time.Hour),
+				types.NewHTLCAssetParam("ukava", false, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, time.Minute, time.Hour),
+			},
+			expectedErr: "duplicate denom",
+		},
+		{
+			name: "deputy asset denom",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("bnb", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, time.Minute, time.Hour),
+			},
+			expectedErr: "cannot also be a deputy asset",
+		},
+		{
+			name: "zero min block lock",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 0, 100, time.Minute, time.Hour),
+			},
+			expectedErr: "positive minimum block lock",
+		},
+		{
+			name: "min block lock > max block lock",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 101, 100, time.Minute, time.Hour),
+			},
+			expectedErr: "minimum block lock > maximum block lock",
+		},
+		{
+			name: "time-based expiry disabled",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, 0, 0),
+			},
+			expectedErr: "",
+		},
+		{
+			name: "negative min time lock",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, -time.Minute, time.Hour),
+			},
+			expectedErr: "negative minimum time lock",
+		},
+		{
+			name: "min time lock > max time lock",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, time.Hour, time.Minute),
+			},
+			expectedErr: "minimum time lock > maximum time lock",
+		},
+		{
+			name: "time-based expiry disabled",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, 0, 0),
+			},
+			expectedErr: "",
+		},
+		{
+			name: "negative min time lock",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, -time.Minute, time.Hour),
+			},
+			expectedErr: "negative minimum time lock",
+		},
+		{
+			name: "min time lock > max time lock",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1), sdkmath.NewInt(1000), 10, 100, time.Hour, time.Minute),
+			},
+			expectedErr: "minimum time lock > maximum time lock",
+		},
+		{
+			name: "zero min swap amount",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdk.ZeroInt(), sdkmath.NewInt(1000), 10, 100, time.Minute, time.Hour),
+			},
+			expectedErr: "positive minimum swap amount",
+		},
+		{
+			name: "min swap amount > max swap amount",
+			htlcAssetParams: types.HTLCAssetParams{
+				types.NewHTLCAssetParam("ukava", true, sdkmath.NewInt(1001), sdkmath.NewInt(1000), 10, 100, time.Minute, time.Hour),
+			},
+			expectedErr: "minimum swap amount > maximum swap amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(types.AssetParams{bnbAsset}, tc.htlcAssetParams)
+			err := params.Validate()
+			if tc.expectedErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
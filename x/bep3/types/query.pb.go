@@ -482,6 +482,12 @@ type AtomicSwapResponse struct {
 	CrossChain bool `protobuf:"varint,12,opt,name=cross_chain,json=crossChain,proto3" json:"cross_chain,omitempty"`
 	// direction identifies if the swap is incoming or outgoing
 	Direction SwapDirection `protobuf:"varint,13,opt,name=direction,proto3,enum=kava.bep3.v1beta1.SwapDirection" json:"direction,omitempty"`
+	// swap_type identifies if the swap is relayed by a deputy or is a generic htlc swap
+	SwapType SwapType `protobuf:"varint,14,opt,name=swap_type,json=swapType,proto3,enum=kava.bep3.v1beta1.SwapType" json:"swap_type,omitempty"`
+	// hash_scheme identifies how the random number is hashed to the random number hash
+	HashScheme HashScheme `protobuf:"varint,15,opt,name=hash_scheme,json=hashScheme,proto3,enum=kava.bep3.v1beta1.HashScheme" json:"hash_scheme,omitempty"`
+	// expire_timestamp represents the unix time when the swap expires, it is 0 for swaps that expire by height
+	ExpireTimestamp int64 `protobuf:"varint,16,opt,name=expire_timestamp,json=expireTimestamp,proto3" json:"expire_timestamp,omitempty"`
 }
 
 func (m *AtomicSwapResponse) Reset()         { *m = AtomicSwapResponse{} }
@@ -608,6 +614,27 @@ func (m *AtomicSwapResponse) GetDirection() SwapDirection {
 	return SWAP_DIRECTION_UNSPECIFIED
 }
 
+func (m *AtomicSwapResponse) GetSwapType() SwapType {
+	if m != nil {
+		return m.SwapType
+	}
+	return SWAP_TYPE_DEPUTY
+}
+
+func (m *AtomicSwapResponse) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HASH_SCHEME_BEP3
+}
+
+func (m *AtomicSwapResponse) GetExpireTimestamp() int64 {
+	if m != nil {
+		return m.ExpireTimestamp
+	}
+	return 0
+}
+
 // QueryAtomicSwapsRequest is the request type for the Query/AtomicSwaps RPC method.
 type QueryAtomicSwapsRequest struct {
 	// involve filters by address
@@ -726,81 +753,85 @@ func init() {
 func init() { proto.RegisterFile("kava/bep3/v1beta1/query.proto", fileDescriptor_a5e4082d53c18bf6) }
 
 var fileDescriptor_a5e4082d53c18bf6 = []byte{
-	// 1176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xb6, 0x9d, 0xc4, 0x8d, 0x5f, 0x3b, 0xf9, 0xfd, 0x98, 0x06, 0xba, 0x71, 0x5b, 0xdb, 0x5d,
-	0x54, 0xd7, 0x2d, 0x8d, 0xb7, 0x4d, 0x05, 0x08, 0x90, 0x10, 0x4d, 0x4a, 0x08, 0x52, 0x95, 0xc2,
-	0xe6, 0xc6, 0x81, 0xd5, 0x78, 0x77, 0x58, 0x8f, 0xe2, 0xdd, 0xd9, 0xec, 0xac, 0xd3, 0x86, 0xaa,
-	0x07, 0x38, 0x71, 0x42, 0x48, 0x20, 0x04, 0xb7, 0x9e, 0xb9, 0x82, 0xf8, 0x0c, 0x3d, 0x56, 0x70,
-	0xe1, 0x02, 0x45, 0x09, 0x07, 0x3e, 0x06, 0x9a, 0x3f, 0x6b, 0xaf, 0x63, 0x27, 0x71, 0x4e, 0xf6,
-	0xbe, 0xef, 0xfb, 0x3c, 0xef, 0x33, 0x33, 0xcf, 0xfc, 0x81, 0xcb, 0x3b, 0x78, 0x0f, 0x5b, 0x1d,
-	0x12, 0xdd, 0xb1, 0xf6, 0x6e, 0x77, 0x48, 0x82, 0x6f, 0x5b, 0xbb, 0x7d, 0x12, 0xef, 0xb7, 0xa3,
-	0x98, 0x25, 0x0c, 0xbd, 0x24, 0xd2, 0x6d, 0x91, 0x6e, 0xeb, 0x74, 0xf5, 0x86, 0xcb, 0x78, 0xc0,
-	0xb8, 0xd5, 0xc1, 0x9c, 0xa8, 0xda, 0x01, 0x32, 0xc2, 0x3e, 0x0d, 0x71, 0x42, 0x59, 0xa8, 0xe0,
-	0xd5, 0x5a, 0xb6, 0x36, 0xad, 0x72, 0x19, 0x4d, 0xf3, 0xcb, 0x2a, 0xef, 0xc8, 0x2f, 0x4b, 0x7d,
-	0xe8, 0xd4, 0x92, 0xcf, 0x7c, 0xa6, 0xe2, 0xe2, 0x9f, 0x8e, 0x5e, 0xf2, 0x19, 0xf3, 0x7b, 0xc4,
-	0xc2, 0x11, 0xb5, 0x70, 0x18, 0xb2, 0x44, 0x76, 0x4b, 0x31, 0x35, 0x9d, 0x95, 0x5f, 0x9d, 0xfe,
-	0x67, 0x96, 0xd7, 0x8f, 0xb3, 0x72, 0x2e, 0x8d, 0x0f, 0x56, 0x0e, 0x4d, 0x66, 0xcd, 0x25, 0x40,
-	0x1f, 0x8b, 0xe1, 0x7c, 0x84, 0x63, 0x1c, 0x70, 0x9b, 0xec, 0xf6, 0x09, 0x4f, 0xcc, 0x2d, 0x38,
-	0x3f, 0x12, 0xe5, 0x11, 0x0b, 0x39, 0x41, 0x6f, 0x42, 0x31, 0x92, 0x11, 0x23, 0xdf, 0xc8, 0xb7,
-	0xca, 0xab, 0xcb, 0xed, 0xb1, 0x99, 0x6a, 0x2b, 0xc8, 0xda, 0xec, 0xb3, 0xbf, 0xea, 0x39, 0x5b,
-	0x97, 0x9b, 0x6f, 0xc1, 0x05, 0xc9, 0x77, 0x97, 0x73, 0x92, 0x6c, 0xf7, 0xa3, 0xa8, 0xb7, 0xaf,
-	0x5b, 0xa1, 0x25, 0x98, 0xf3, 0x48, 0xc8, 0x02, 0x49, 0x59, 0xb2, 0xd5, 0xc7, 0xdb, 0xf3, 0x5f,
-	0x3d, 0xad, 0xe7, 0xfe, 0x7d, 0x5a, 0xcf, 0x99, 0x3f, 0xce, 0xc0, 0xf9, 0x11, 0x98, 0xd6, 0xb2,
-	0x09, 0xff, 0xa3, 0xa1, 0xcb, 0x02, 0x1a, 0xfa, 0x0e, 0x97, 0xa9, 0x81, 0x28, 0x3d, 0xa5, 0x62,
-	0xfe, 0x07, 0xb2, 0xd6, 0x19, 0x0d, 0xb5, 0xa8, 0xc5, 0x14, 0xa7, 0x18, 0x05, 0x13, 0xeb, 0x27,
-	0x3e, 0xcb, 0x30, 0x15, 0xa6, 0x64, 0x4a, 0x71, 0x9a, 0x69, 0x03, 0x16, 0xdd, 0x7e, 0x1c, 0x93,
-	0x30, 0x49, 0x89, 0x66, 0xa6, 0x23, 0x5a, 0xd0, 0x30, 0xcd, 0xf3, 0x29, 0x5c, 0x4c, 0x68, 0x40,
-	0x9c, 0x1e, 0x0d, 0x68, 0x42, 0x3c, 0xe7, 0x08, 0xe9, 0xec, 0x74, 0xa4, 0x86, 0xe0, 0xb8, 0xaf,
-	0x28, 0xd6, 0x47, 0xf8, 0x37, 0xa0, 0x22, 0xf9, 0x49, 0x0f, 0x47, 0x9c, 0x78, 0xc6, 0x9c, 0x26,
-	0x54, 0x4e, 0x6a, 0xa7, 0x4e, 0x6a, 0xdf, 0xd3, 0x4e, 0x5a, 0x9b, 0x17, 0x84, 0x3f, 0xbc, 0xa8,
-	0xe7, 0xed, 0xb2, 0x00, 0xbe, 0xaf, 0x70, 0xe6, 0x0e, 0x18, 0xe3, 0xcb, 0xaa, 0xd7, 0xe7, 0x01,
-	0x54, 0xb0, 0x08, 0x8f, 0x2e, 0x4e, 0x73, 0x82, 0x63, 0x26, 0xa0, 0xf5, 0x08, 0xca, 0x78, 0x98,
-	0x32, 0xaf, 0xc2, 0xf2, 0x91, 0x66, 0x94, 0xa4, 0x86, 0xcd, 0xf8, 0x65, 0x17, 0xaa, 0x93, 0xca,
-	0xb4, 0xaa, 0x6d, 0x58, 0xcc, 0xa8, 0xa2, 0x44, 0x38, 0x79, 0xe6, 0xcc, 0xba, 0x16, 0x70, 0x96,
-	0xdc, 0x7c, 0x07, 0x5e, 0x51, 0x2d, 0x13, 0x16, 0x50, 0x77, 0xfb, 0x21, 0x8e, 0x52, 0x73, 0x5f,
-	0x80, 0x73, 0xfc, 0x21, 0x8e, 0x1c, 0xea, 0x69, 0x7b, 0x17, 0xc5, 0xe7, 0x87, 0x5e, 0x46, 0xaf,
-	0x9f, 0x6e, 0x8d, 0x0c, 0x58, 0x8b, 0xbd, 0x0f, 0x65, 0x2c, 0xa3, 0x8e, 0x40, 0x69, 0x53, 0x5e,
-	0x9d, 0xa4, 0x74, 0x0c, 0xab, 0x85, 0x02, 0x1e, 0x64, 0xcc, 0x2f, 0xe6, 0x00, 0x4d, 0x68, 0xb2,
-	0x08, 0x85, 0x81, 0xba, 0x02, 0xf5, 0x90, 0x0b, 0x45, 0x1c, 0xb0, 0x7e, 0x98, 0x18, 0x05, 0x39,
-	0x33, 0x27, 0xd8, 0xec, 0x96, 0xe8, 0xf1, 0xd3, 0x8b, 0x7a, 0xcb, 0xa7, 0x49, 0xb7, 0xdf, 0x69,
-	0xbb, 0x2c, 0xd0, 0xc7, 0x99, 0xfe, 0x59, 0xe1, 0xde, 0x8e, 0x95, 0xec, 0x47, 0x84, 0x4b, 0x00,
-	0xb7, 0x35, 0x35, 0xba, 0x09, 0x28, 0xc6, 0xa1, 0xc7, 0x02, 0x27, 0xec, 0x07, 0x1d, 0x12, 0x3b,
-	0x5d, 0xcc, 0xbb, 0x72, 0xb3, 0x94, 0xec, 0xff, 0xab, 0xcc, 0x96, 0x4c, 0x6c, 0x62, 0xde, 0x45,
-	0xaf, 0xc2, 0x02, 0x79, 0x14, 0xd1, 0x98, 0x38, 0x5d, 0x42, 0xfd, 0x6e, 0x22, 0x37, 0xc0, 0xac,
-	0x5d, 0x51, 0xc1, 0x4d, 0x19, 0x43, 0x97, 0xa0, 0x24, 0xac, 0xc9, 0x13, 0x1c, 0x44, 0xd2, 0xd0,
-	0x33, 0xf6, 0x30, 0x80, 0x6e, 0x41, 0x91, 0x93, 0xd0, 0x23, 0xb1, 0x51, 0x14, 0x4d, 0xd6, 0x8c,
-	0xdf, 0x7e, 0x59, 0x59, 0xd2, 0x03, 0xbb, 0xeb, 0x79, 0x31, 0xe1, 0x7c, 0x3b, 0x89, 0x69, 0xe8,
-	0xdb, 0xba, 0x0e, 0xbd, 0x01, 0xa5, 0x98, 0xb8, 0x34, 0xa2, 0x24, 0x4c, 0x8c, 0x73, 0xa7, 0x80,
-	0x86, 0xa5, 0x62, 0x68, 0x8a, 0xc1, 0x61, 0x49, 0x97, 0xc4, 0x8e, 0xdb, 0xc5, 0x34, 0x34, 0xe6,
-	0xd5, 0xd0, 0x54, 0xe6, 0x81, 0x48, 0xac, 0x8b, 0x38, 0x5a, 0x85, 0x97, 0x07, 0xd0, 0x11, 0x40,
-	0x49, 0x02, 0xce, 0x0f, 0x92, 0x19, 0xcc, 0x15, 0xa8, 0xb8, 0x3d, 0xc6, 0x89, 0xe7, 0x74, 0x7a,
-	0xcc, 0xdd, 0x31, 0x40, 0x0e, 0xb6, 0xac, 0x62, 0x6b, 0x22, 0x84, 0x5e, 0x87, 0x22, 0x4f, 0x70,
-	0xd2, 0xe7, 0x46, 0xb9, 0x91, 0x6f, 0x2d, 0xae, 0x5e, 0x9e, 0x60, 0x1a, 0xe1, 0x82, 0x6d, 0x59,
-	0x64, 0xeb, 0x62, 0x54, 0x87, 0xb2, 0x1b, 0x33, 0xce, 0xb5, 0x86, 0x4a, 0x23, 0xdf, 0x9a, 0xb7,
-	0x41, 0x86, 0x54, 0xeb, 0x77, 0xa1, 0xe4, 0xd1, 0x98, 0xb8, 0xe2, 0x50, 0x30, 0x16, 0x24, 0x75,
-	0xe3, 0x18, 0xea, 0x7b, 0x69, 0x9d, 0x3d, 0x84, 0x98, 0xbf, 0x16, 0xc6, 0xdc, 0x9e, 0x6e, 0x61,
-	0xb4, 0x0a, 0xe7, 0x68, 0xb8, 0xc7, 0x7a, 0x7b, 0x44, 0xb9, 0xf1, 0x84, 0xe9, 0x4e, 0x0b, 0x51,
-	0x0d, 0x40, 0x9a, 0x40, 0x9e, 0x52, 0x72, 0x83, 0xcc, 0xda, 0x99, 0x48, 0x66, 0x1e, 0x66, 0xce,
-	0x32, 0x0f, 0x23, 0xc3, 0x9c, 0x3d, 0xf3, 0x30, 0xd1, 0x06, 0xc0, 0xf0, 0x55, 0xa0, 0x4f, 0xd7,
-	0xe6, 0xc8, 0x3e, 0x52, 0xcf, 0x8d, 0xe1, 0x9d, 0xe9, 0x13, 0x3d, 0x0d, 0x76, 0x06, 0x99, 0x39,
-	0x25, 0x7e, 0xce, 0xa7, 0x47, 0x6d, 0x76, 0xe2, 0xf4, 0x16, 0xde, 0x82, 0x4a, 0xe6, 0x9c, 0x48,
-	0x8f, 0xb4, 0x33, 0x1d, 0x14, 0xe5, 0xe1, 0x41, 0xc1, 0xd1, 0x07, 0x23, 0xf2, 0xd5, 0x15, 0x76,
-	0xed, 0x54, 0xf9, 0x8a, 0x2f, 0xab, 0x7f, 0xf5, 0xcf, 0x39, 0x98, 0x93, 0xaa, 0xd1, 0xe7, 0x50,
-	0x54, 0x0f, 0x03, 0x34, 0x49, 0xd6, 0xf8, 0x0b, 0xa4, 0xda, 0x3c, 0xad, 0x4c, 0xb5, 0x33, 0xaf,
-	0x7c, 0xf9, 0xfb, 0x3f, 0xdf, 0x16, 0x2e, 0xa2, 0x65, 0x6b, 0xfc, 0x99, 0xa3, 0x1e, 0x1f, 0xe8,
-	0xfb, 0x3c, 0x94, 0x33, 0x67, 0x39, 0xba, 0x71, 0x1c, 0xf5, 0xf8, 0xeb, 0xa4, 0xfa, 0xda, 0x54,
-	0xb5, 0x5a, 0x4b, 0x5b, 0x6a, 0x69, 0xa1, 0xe6, 0x04, 0x2d, 0xf2, 0xc6, 0x50, 0x57, 0xa1, 0xf5,
-	0x58, 0xbe, 0x71, 0x9e, 0x08, 0x61, 0x0b, 0x23, 0xd7, 0x14, 0xba, 0x79, 0x7a, 0xbb, 0xe1, 0xa5,
-	0x57, 0x5d, 0x99, 0xb2, 0x5a, 0xcb, 0x6b, 0x49, 0x79, 0x26, 0x6a, 0x9c, 0x28, 0x4f, 0xc8, 0xf8,
-	0x2e, 0x0f, 0x30, 0xb4, 0x0a, 0xba, 0x7e, 0x6c, 0x9f, 0xa3, 0x17, 0x5e, 0xf5, 0xc6, 0x34, 0xa5,
-	0x5a, 0x8f, 0x25, 0xf5, 0x5c, 0x47, 0xd7, 0x26, 0xe9, 0x91, 0xe5, 0xc2, 0xce, 0xd6, 0x63, 0x7d,
-	0x83, 0x3e, 0x41, 0x5f, 0x8b, 0x85, 0xcc, 0xf8, 0x74, 0x8a, 0x66, 0xfc, 0xf4, 0x85, 0x1c, 0xdf,
-	0x50, 0x66, 0x53, 0x2a, 0x6b, 0xa0, 0xda, 0x89, 0xca, 0xf8, 0xda, 0x7b, 0xcf, 0x0e, 0x6a, 0xf9,
-	0xe7, 0x07, 0xb5, 0xfc, 0xdf, 0x07, 0xb5, 0xfc, 0x37, 0x87, 0xb5, 0xdc, 0xf3, 0xc3, 0x5a, 0xee,
-	0x8f, 0xc3, 0x5a, 0xee, 0x93, 0x66, 0xe6, 0x4a, 0x14, 0x1c, 0x2b, 0x3d, 0xdc, 0xe1, 0x8a, 0xed,
-	0x91, 0xe2, 0x93, 0xd7, 0x62, 0xa7, 0x28, 0xdf, 0x5a, 0x77, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0xd4, 0xac, 0x57, 0x4a, 0x92, 0x0c, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x93, 0x13, 0xc5,
+	0x1b, 0xde, 0xd9, 0x8f, 0xb0, 0x79, 0x93, 0x0d, 0xfc, 0x9a, 0xfd, 0xc9, 0x6c, 0x80, 0x24, 0x8c,
+	0x45, 0x08, 0xc8, 0x66, 0x60, 0x29, 0xbf, 0xab, 0x28, 0x59, 0x10, 0xb1, 0x8a, 0x02, 0x9d, 0x70,
+	0xf2, 0xe0, 0x54, 0x67, 0xa6, 0x9d, 0x74, 0x6d, 0x66, 0x7a, 0x98, 0x9e, 0x2c, 0xac, 0x14, 0x17,
+	0x4f, 0x9e, 0x2c, 0xab, 0xb4, 0x2c, 0xbd, 0x71, 0xf2, 0xe0, 0x55, 0xcb, 0xbf, 0x81, 0x23, 0xa5,
+	0x17, 0x2f, 0x8a, 0x05, 0x1e, 0xfc, 0x33, 0xac, 0xfe, 0x98, 0x64, 0x42, 0xb2, 0x24, 0x7b, 0x4a,
+	0xe6, 0x7d, 0xdf, 0xe7, 0x79, 0x9f, 0xee, 0x7e, 0xfa, 0x03, 0x4e, 0xee, 0xe0, 0x5d, 0x6c, 0x77,
+	0x49, 0x7c, 0xc9, 0xde, 0xbd, 0xd8, 0x25, 0x29, 0xbe, 0x68, 0xdf, 0x1d, 0x90, 0x64, 0xaf, 0x1d,
+	0x27, 0x2c, 0x65, 0xe8, 0x7f, 0x22, 0xdd, 0x16, 0xe9, 0xb6, 0x4e, 0x57, 0xcf, 0x79, 0x8c, 0x87,
+	0x8c, 0xdb, 0x5d, 0xcc, 0x89, 0xaa, 0x1d, 0x22, 0x63, 0x1c, 0xd0, 0x08, 0xa7, 0x94, 0x45, 0x0a,
+	0x5e, 0xad, 0xe5, 0x6b, 0xb3, 0x2a, 0x8f, 0xd1, 0x2c, 0xbf, 0xa1, 0xf2, 0xae, 0xfc, 0xb2, 0xd5,
+	0x87, 0x4e, 0xad, 0x07, 0x2c, 0x60, 0x2a, 0x2e, 0xfe, 0xe9, 0xe8, 0x89, 0x80, 0xb1, 0xa0, 0x4f,
+	0x6c, 0x1c, 0x53, 0x1b, 0x47, 0x11, 0x4b, 0x65, 0xb7, 0x0c, 0x53, 0xd3, 0x59, 0xf9, 0xd5, 0x1d,
+	0x7c, 0x66, 0xfb, 0x83, 0x24, 0x2f, 0xe7, 0xc4, 0xe4, 0x60, 0xe5, 0xd0, 0x64, 0xd6, 0x5a, 0x07,
+	0xf4, 0xb1, 0x18, 0xce, 0x47, 0x38, 0xc1, 0x21, 0x77, 0xc8, 0xdd, 0x01, 0xe1, 0xa9, 0x75, 0x0b,
+	0x8e, 0x8e, 0x45, 0x79, 0xcc, 0x22, 0x4e, 0xd0, 0x9b, 0x50, 0x88, 0x65, 0xc4, 0x34, 0x1a, 0x46,
+	0xab, 0xb4, 0xb5, 0xd1, 0x9e, 0x98, 0xa9, 0xb6, 0x82, 0x6c, 0x2f, 0x3f, 0xfe, 0xab, 0xbe, 0xe0,
+	0xe8, 0x72, 0xeb, 0x6d, 0x38, 0x26, 0xf9, 0xae, 0x70, 0x4e, 0xd2, 0xce, 0x20, 0x8e, 0xfb, 0x7b,
+	0xba, 0x15, 0x5a, 0x87, 0x15, 0x9f, 0x44, 0x2c, 0x94, 0x94, 0x45, 0x47, 0x7d, 0xbc, 0xb3, 0xfa,
+	0xe5, 0xa3, 0xfa, 0xc2, 0xbf, 0x8f, 0xea, 0x0b, 0xd6, 0x0f, 0x4b, 0x70, 0x74, 0x0c, 0xa6, 0xb5,
+	0xdc, 0x80, 0xc3, 0x34, 0xf2, 0x58, 0x48, 0xa3, 0xc0, 0xe5, 0x32, 0x35, 0x14, 0xa5, 0xa7, 0x54,
+	0xcc, 0xff, 0x50, 0xd6, 0x55, 0x46, 0x23, 0x2d, 0xaa, 0x92, 0xe1, 0x14, 0xa3, 0x60, 0x62, 0x83,
+	0x34, 0x60, 0x39, 0xa6, 0xc5, 0x39, 0x99, 0x32, 0x9c, 0x66, 0xba, 0x0e, 0x15, 0x6f, 0x90, 0x24,
+	0x24, 0x4a, 0x33, 0xa2, 0xa5, 0xf9, 0x88, 0xd6, 0x34, 0x4c, 0xf3, 0x7c, 0x0a, 0xc7, 0x53, 0x1a,
+	0x12, 0xb7, 0x4f, 0x43, 0x9a, 0x12, 0xdf, 0x7d, 0x81, 0x74, 0x79, 0x3e, 0x52, 0x53, 0x70, 0xdc,
+	0x54, 0x14, 0x57, 0xc7, 0xf8, 0xaf, 0x43, 0x59, 0xf2, 0x93, 0x3e, 0x8e, 0x39, 0xf1, 0xcd, 0x15,
+	0x4d, 0xa8, 0x9c, 0xd4, 0xce, 0x9c, 0xd4, 0xbe, 0xa6, 0x9d, 0xb4, 0xbd, 0x2a, 0x08, 0xbf, 0x7f,
+	0x5a, 0x37, 0x9c, 0x92, 0x00, 0xbe, 0xaf, 0x70, 0xd6, 0x0e, 0x98, 0x93, 0xcb, 0xaa, 0xd7, 0xe7,
+	0x36, 0x94, 0xb1, 0x08, 0x8f, 0x2f, 0x4e, 0x73, 0x8a, 0x63, 0xa6, 0xa0, 0xf5, 0x08, 0x4a, 0x78,
+	0x94, 0xb2, 0x4e, 0xc3, 0xc6, 0x0b, 0xcd, 0x28, 0xc9, 0x0c, 0x9b, 0xf3, 0xcb, 0x5d, 0xa8, 0x4e,
+	0x2b, 0xd3, 0xaa, 0x3a, 0x50, 0xc9, 0xa9, 0xa2, 0x44, 0x38, 0x79, 0xe9, 0xc0, 0xba, 0xd6, 0x70,
+	0x9e, 0xdc, 0x7a, 0x17, 0x5e, 0x51, 0x2d, 0x53, 0x16, 0x52, 0xaf, 0x73, 0x0f, 0xc7, 0x99, 0xb9,
+	0x8f, 0xc1, 0x21, 0x7e, 0x0f, 0xc7, 0x2e, 0xf5, 0xb5, 0xbd, 0x0b, 0xe2, 0xf3, 0x43, 0x3f, 0xa7,
+	0x37, 0x80, 0x63, 0x13, 0x60, 0x2d, 0xf6, 0x26, 0x94, 0xb0, 0x8c, 0xba, 0x02, 0xa5, 0x4d, 0x79,
+	0x7a, 0x9a, 0xd2, 0x09, 0xac, 0x16, 0x0a, 0x78, 0x98, 0xb1, 0x7e, 0x2c, 0x00, 0x9a, 0xd2, 0xa4,
+	0x02, 0x8b, 0x43, 0x75, 0x8b, 0xd4, 0x47, 0x1e, 0x14, 0x70, 0xc8, 0x06, 0x51, 0x6a, 0x2e, 0x36,
+	0x96, 0x5e, 0x6e, 0xb3, 0x0b, 0xa2, 0xc7, 0x4f, 0x4f, 0xeb, 0xad, 0x80, 0xa6, 0xbd, 0x41, 0xb7,
+	0xed, 0xb1, 0x50, 0x1f, 0x67, 0xfa, 0x67, 0x93, 0xfb, 0x3b, 0x76, 0xba, 0x17, 0x13, 0x2e, 0x01,
+	0xdc, 0xd1, 0xd4, 0xe8, 0x3c, 0xa0, 0x04, 0x47, 0x3e, 0x0b, 0xdd, 0x68, 0x10, 0x76, 0x49, 0xe2,
+	0xf6, 0x30, 0xef, 0xc9, 0xcd, 0x52, 0x74, 0x8e, 0xa8, 0xcc, 0x2d, 0x99, 0xb8, 0x81, 0x79, 0x0f,
+	0xbd, 0x0a, 0x6b, 0xe4, 0x7e, 0x4c, 0x13, 0xe2, 0xf6, 0x08, 0x0d, 0x7a, 0xa9, 0xdc, 0x00, 0xcb,
+	0x4e, 0x59, 0x05, 0x6f, 0xc8, 0x18, 0x3a, 0x01, 0x45, 0x61, 0x4d, 0x9e, 0xe2, 0x30, 0x96, 0x86,
+	0x5e, 0x72, 0x46, 0x01, 0x74, 0x01, 0x0a, 0x9c, 0x44, 0x3e, 0x49, 0xcc, 0x82, 0x68, 0xb2, 0x6d,
+	0xfe, 0xf6, 0xcb, 0xe6, 0xba, 0x1e, 0xd8, 0x15, 0xdf, 0x4f, 0x08, 0xe7, 0x9d, 0x34, 0xa1, 0x51,
+	0xe0, 0xe8, 0x3a, 0xf4, 0x06, 0x14, 0x13, 0xe2, 0xd1, 0x98, 0x92, 0x28, 0x35, 0x0f, 0xcd, 0x00,
+	0x8d, 0x4a, 0xc5, 0xd0, 0x14, 0x83, 0xcb, 0xd2, 0x1e, 0x49, 0x5c, 0xaf, 0x87, 0x69, 0x64, 0xae,
+	0xaa, 0xa1, 0xa9, 0xcc, 0x6d, 0x91, 0xb8, 0x2a, 0xe2, 0x68, 0x0b, 0xfe, 0x3f, 0x84, 0x8e, 0x01,
+	0x8a, 0x12, 0x70, 0x74, 0x98, 0xcc, 0x61, 0x4e, 0x41, 0xd9, 0xeb, 0x33, 0x4e, 0x7c, 0xb7, 0xdb,
+	0x67, 0xde, 0x8e, 0x09, 0x72, 0xb0, 0x25, 0x15, 0xdb, 0x16, 0x21, 0xf4, 0x3a, 0x14, 0x78, 0x8a,
+	0xd3, 0x01, 0x37, 0x4b, 0x0d, 0xa3, 0x55, 0xd9, 0x3a, 0x39, 0xc5, 0x34, 0xc2, 0x05, 0x1d, 0x59,
+	0xe4, 0xe8, 0x62, 0x54, 0x87, 0x92, 0x97, 0x30, 0xce, 0xb5, 0x86, 0x72, 0xc3, 0x68, 0xad, 0x3a,
+	0x20, 0x43, 0xaa, 0xf5, 0x65, 0x28, 0xfa, 0x34, 0x21, 0x9e, 0x38, 0x14, 0xcc, 0x35, 0x49, 0xdd,
+	0xd8, 0x87, 0xfa, 0x5a, 0x56, 0xe7, 0x8c, 0x20, 0xe8, 0x2d, 0x28, 0xca, 0xfd, 0x20, 0x3c, 0x61,
+	0x56, 0x24, 0xfe, 0xf8, 0x3e, 0xf8, 0x3b, 0x7b, 0x31, 0x71, 0x56, 0xb9, 0xfe, 0x87, 0x2e, 0x43,
+	0x49, 0x78, 0xc4, 0xe5, 0x5e, 0x8f, 0x84, 0xc4, 0x3c, 0xbc, 0xef, 0xb0, 0x84, 0x63, 0x3a, 0xb2,
+	0xc8, 0x81, 0xde, 0xf0, 0x3f, 0x3a, 0x0b, 0x47, 0xb4, 0x87, 0x46, 0x2e, 0x39, 0x22, 0x27, 0xee,
+	0xb0, 0x8a, 0xdf, 0xc9, 0xc2, 0xd6, 0xaf, 0x8b, 0x13, 0x5b, 0x32, 0x3b, 0x67, 0xd0, 0x16, 0x1c,
+	0xa2, 0xd1, 0x2e, 0xeb, 0xef, 0x12, 0xd3, 0x98, 0xe1, 0x89, 0xac, 0x10, 0xd5, 0x00, 0x64, 0x0b,
+	0x79, 0x94, 0xca, 0x5d, 0xbc, 0xec, 0xe4, 0x22, 0xb9, 0xc5, 0x5a, 0x3a, 0xc8, 0x62, 0x8d, 0xad,
+	0xc5, 0xf2, 0xc1, 0xd7, 0xe2, 0x3a, 0xc0, 0xe8, 0xe9, 0xa2, 0xaf, 0x80, 0xe6, 0xd8, 0x66, 0x57,
+	0x6f, 0xa2, 0xd1, 0xc5, 0x1e, 0x10, 0x3d, 0x0d, 0x4e, 0x0e, 0x99, 0x3b, 0xca, 0x7e, 0x36, 0xc0,
+	0x9c, 0x9c, 0x38, 0x7d, 0xce, 0xdc, 0x82, 0x72, 0xee, 0x30, 0xcb, 0xce, 0xdd, 0x03, 0x9d, 0x66,
+	0xa5, 0xd1, 0x69, 0xc6, 0xd1, 0x07, 0x63, 0xf2, 0xd5, 0x3d, 0x7b, 0x66, 0xa6, 0x7c, 0xc5, 0x97,
+	0xd7, 0xbf, 0xf5, 0xe7, 0x0a, 0xac, 0x48, 0xd5, 0xe8, 0x73, 0x28, 0xa8, 0xd7, 0x0b, 0x9a, 0x26,
+	0x6b, 0xf2, 0x99, 0x54, 0x6d, 0xce, 0x2a, 0x53, 0xed, 0xac, 0x53, 0x5f, 0xfc, 0xfe, 0xcf, 0x37,
+	0x8b, 0xc7, 0xd1, 0x86, 0x3d, 0xf9, 0x16, 0x53, 0x2f, 0x24, 0xf4, 0x9d, 0x01, 0xa5, 0xdc, 0x85,
+	0x83, 0xce, 0xed, 0x47, 0x3d, 0xf9, 0x84, 0xaa, 0xbe, 0x36, 0x57, 0xad, 0xd6, 0xd2, 0x96, 0x5a,
+	0x5a, 0xa8, 0x39, 0x45, 0x8b, 0xbc, 0xd6, 0xd4, 0x7d, 0x6d, 0x3f, 0x90, 0x0f, 0xb1, 0x87, 0x42,
+	0xd8, 0xda, 0xd8, 0x5d, 0x8a, 0xce, 0xcf, 0x6e, 0x37, 0xba, 0x99, 0xab, 0x9b, 0x73, 0x56, 0x6b,
+	0x79, 0x2d, 0x29, 0xcf, 0x42, 0x8d, 0x97, 0xca, 0x13, 0x32, 0xbe, 0x35, 0x00, 0x46, 0x56, 0x41,
+	0x67, 0xf7, 0xed, 0xf3, 0xe2, 0xad, 0x5c, 0x3d, 0x37, 0x4f, 0xa9, 0xd6, 0x63, 0x4b, 0x3d, 0x67,
+	0xd1, 0x99, 0x69, 0x7a, 0x64, 0xb9, 0xb0, 0xb3, 0xfd, 0x40, 0x5f, 0xf3, 0x0f, 0xd1, 0x57, 0x62,
+	0x21, 0x73, 0x3e, 0x9d, 0xa3, 0x19, 0x9f, 0xbd, 0x90, 0x93, 0x1b, 0xca, 0x6a, 0x4a, 0x65, 0x0d,
+	0x54, 0x7b, 0xa9, 0x32, 0xbe, 0xfd, 0xde, 0xe3, 0x67, 0x35, 0xe3, 0xc9, 0xb3, 0x9a, 0xf1, 0xf7,
+	0xb3, 0x9a, 0xf1, 0xf5, 0xf3, 0xda, 0xc2, 0x93, 0xe7, 0xb5, 0x85, 0x3f, 0x9e, 0xd7, 0x16, 0x3e,
+	0x69, 0xe6, 0xee, 0x6d, 0xc1, 0xb1, 0xd9, 0xc7, 0x5d, 0xae, 0xd8, 0xee, 0x2b, 0x3e, 0x79, 0x77,
+	0x77, 0x0b, 0xf2, 0x41, 0x78, 0xe9, 0xbf, 0x01, 0x00, 0x8e, 0xf8, 0x08, 0x44, 0x37, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1370,6 +1401,23 @@ func (m *AtomicSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpireTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SwapType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SwapType))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.Direction != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
 		i--
@@ -1746,6 +1794,15 @@ func (m *AtomicSwapResponse) Size() (n int) {
 	if m.Direction != 0 {
 		n += 1 + sovQuery(uint64(m.Direction))
 	}
+	if m.SwapType != 0 {
+		n += 1 + sovQuery(uint64(m.SwapType))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovQuery(uint64(m.HashScheme))
+	}
+	if m.ExpireTimestamp != 0 {
+		n += 2 + sovQuery(uint64(m.ExpireTimestamp))
+	}
 	return n
 }
 
@@ -2982,6 +3039,63 @@ func (m *AtomicSwapResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapType", wireType)
+			}
+			m.SwapType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapType |= SwapType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTimestamp", wireType)
+			}
+			m.ExpireTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -16,7 +16,8 @@ import (
 // NewAtomicSwap returns a new AtomicSwap
 func NewAtomicSwap(amount sdk.Coins, randomNumberHash tmbytes.HexBytes, expireHeight uint64, timestamp int64,
 	sender, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string, closedBlock int64,
-	status SwapStatus, crossChain bool, direction SwapDirection,
+	status SwapStatus, crossChain bool, direction SwapDirection, swapType SwapType, hashScheme HashScheme,
+	expireTimestamp int64,
 ) AtomicSwap {
 	return AtomicSwap{
 		Amount:              amount,
@@ -31,6 +32,9 @@ func NewAtomicSwap(amount sdk.Coins, randomNumberHash tmbytes.HexBytes, expireHe
 		Status:              status,
 		CrossChain:          crossChain,
 		Direction:           direction,
+		SwapType:            swapType,
+		HashScheme:          hashScheme,
+		ExpireTimestamp:     expireTimestamp,
 	}
 }
 
@@ -55,9 +59,16 @@ func (a AtomicSwap) Validate() error {
 	if len(a.RandomNumberHash) != RandomNumberHashLength {
 		return fmt.Errorf("the length of random number hash should be %d", RandomNumberHashLength)
 	}
-	if a.ExpireHeight == 0 {
+	// a swap expires either by height or by time, never both
+	if a.ExpireHeight == 0 && a.ExpireTimestamp == 0 {
 		return errors.New("expire height cannot be 0")
 	}
+	if a.ExpireHeight != 0 && a.ExpireTimestamp != 0 {
+		return errors.New("swap cannot have both an expire height and an expire timestamp")
+	}
+	if a.ExpireTimestamp < 0 {
+		return errors.New("expire timestamp cannot be negative")
+	}
 	if a.Timestamp == 0 {
 		return errors.New("timestamp cannot be 0")
 	}
@@ -80,12 +91,33 @@ func (a AtomicSwap) Validate() error {
 	if a.Status == SWAP_STATUS_UNSPECIFIED || a.Status > 3 {
 		return errors.New("invalid swap status")
 	}
+	if !a.SwapType.IsValid() {
+		return errors.New("invalid swap type")
+	}
+	if !a.HashScheme.IsValid() {
+		return errors.New("invalid hash scheme")
+	}
+	// htlc swaps are not relayed by a deputy, so they have no direction
+	if a.SwapType == SWAP_TYPE_HTLC {
+		if a.Direction != SWAP_DIRECTION_UNSPECIFIED {
+			return errors.New("htlc swap cannot have a direction")
+		}
+		return nil
+	}
 	if a.Direction == SWAP_DIRECTION_UNSPECIFIED || a.Direction > 2 {
 		return errors.New("invalid swap direction")
 	}
+	if a.HashScheme != HASH_SCHEME_BEP3 || a.ExpireTimestamp != 0 {
+		return errors.New("deputy swap must use the bep3 hash scheme and expire by height")
+	}
 	return nil
 }
 
+// ExpiresByTime returns true if the swap expires at a unix timestamp rather than a block height
+func (a AtomicSwap) ExpiresByTime() bool {
+	return a.ExpireTimestamp != 0
+}
+
 // AtomicSwaps is a slice of AtomicSwap
 type AtomicSwaps []AtomicSwap
 
@@ -128,6 +160,30 @@ func (direction SwapDirection) IsValid() bool {
 		direction == SWAP_DIRECTION_OUTGOING
 }
 
+// IsValid returns true if the swap type is valid and false otherwise.
+func (swapType SwapType) IsValid() bool {
+	return swapType == SWAP_TYPE_DEPUTY ||
+		swapType == SWAP_TYPE_HTLC
+}
+
+// NewHashSchemeFromString converts string to HashScheme type
+func NewHashSchemeFromString(str string) (HashScheme, error) {
+	switch strings.ToLower(str) {
+	case "bep3":
+		return HASH_SCHEME_BEP3, nil
+	case "sha256":
+		return HASH_SCHEME_SHA256, nil
+	default:
+		return HASH_SCHEME_BEP3, fmt.Errorf("invalid hash scheme %s, must be bep3 or sha256", str)
+	}
+}
+
+// IsValid returns true if the hash scheme is valid and false otherwise.
+func (scheme HashScheme) IsValid() bool {
+	return scheme == HASH_SCHEME_BEP3 ||
+		scheme == HASH_SCHEME_SHA256
+}
+
 // LegacyAugmentedAtomicSwap defines an ID and AtomicSwap fields on the top level.
 // This should be removed when legacy REST endpoints are removed.
 type LegacyAugmentedAtomicSwap struct {
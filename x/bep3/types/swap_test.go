@@ -231,6 +231,154 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 			},
 			false,
 		},
+		{
+			"valid htlc swap",
+			types.AtomicSwap{
+				Amount:              cs(c("ukava", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+				SenderOtherChain:    "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+				ClosedBlock:         1,
+				Status:              types.SWAP_STATUS_OPEN,
+				CrossChain:          true,
+				SwapType:            types.SWAP_TYPE_HTLC,
+			},
+			true,
+		},
+		{
+			"htlc swap with direction",
+			types.AtomicSwap{
+				Amount:              cs(c("ukava", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+				SenderOtherChain:    "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+				ClosedBlock:         1,
+				Status:              types.SWAP_STATUS_OPEN,
+				Direction:           types.SWAP_DIRECTION_INCOMING,
+				SwapType:            types.SWAP_TYPE_HTLC,
+			},
+			false,
+		},
+		{
+			"valid time locked sha256 htlc swap",
+			types.AtomicSwap{
+				Amount:              cs(c("ukava", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireTimestamp:     suite.timestamps[0] + 3600,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+				SenderOtherChain:    "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+				ClosedBlock:         1,
+				Status:              types.SWAP_STATUS_OPEN,
+				CrossChain:          true,
+				SwapType:            types.SWAP_TYPE_HTLC,
+				HashScheme:          types.HASH_SCHEME_SHA256,
+			},
+			true,
+		},
+		{
+			"htlc swap with expire height and expire timestamp",
+			types.AtomicSwap{
+				Amount:              cs(c("ukava", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				ExpireTimestamp:     suite.timestamps[0] + 3600,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+				SenderOtherChain:    "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+				ClosedBlock:         1,
+				Status:              types.SWAP_STATUS_OPEN,
+				CrossChain:          true,
+				SwapType:            types.SWAP_TYPE_HTLC,
+			},
+			false,
+		},
+		{
+			"htlc swap with invalid hash scheme",
+			types.AtomicSwap{
+				Amount:              cs(c("ukava", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+				SenderOtherChain:    "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+				ClosedBlock:         1,
+				Status:              types.SWAP_STATUS_OPEN,
+				CrossChain:          true,
+				SwapType:            types.SWAP_TYPE_HTLC,
+				HashScheme:          types.HashScheme(5),
+			},
+			false,
+		},
+		{
+			"deputy swap with sha256 hash scheme",
+			types.AtomicSwap{
+				Amount:              cs(c("bnb", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+				SenderOtherChain:    "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+				ClosedBlock:         1,
+				Status:              types.SWAP_STATUS_OPEN,
+				CrossChain:          true,
+				Direction:           types.SWAP_DIRECTION_INCOMING,
+				HashScheme:          types.HASH_SCHEME_SHA256,
+			},
+			false,
+		},
+		{
+			"deputy swap with expire timestamp",
+			types.AtomicSwap{
+				Amount:              cs(c("bnb", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireTimestamp:     suite.timestamps[0] + 3600,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+				SenderOtherChain:    "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+				ClosedBlock:         1,
+				Status:              types.SWAP_STATUS_OPEN,
+				CrossChain:          true,
+				Direction:           types.SWAP_DIRECTION_INCOMING,
+			},
+			false,
+		},
+		{
+			"invalid swap type",
+			types.AtomicSwap{
+				Amount:              cs(c("bnb", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
+				ClosedBlock:         1,
+				Status:              types.SWAP_STATUS_OPEN,
+				Direction:           types.SWAP_DIRECTION_INCOMING,
+				SwapType:            2,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
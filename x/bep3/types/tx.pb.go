@@ -41,6 +41,10 @@ type MsgCreateAtomicSwap struct {
 	Timestamp           int64                                    `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Amount              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	HeightSpan          uint64                                   `protobuf:"varint,8,opt,name=height_span,json=heightSpan,proto3" json:"height_span,omitempty"`
+	// hash_scheme selects how random_number_hash was computed, only generic htlc swaps may use sha256
+	HashScheme HashScheme `protobuf:"varint,9,opt,name=hash_scheme,json=hashScheme,proto3,enum=kava.bep3.v1beta1.HashScheme" json:"hash_scheme,omitempty"`
+	// time_span is the number of seconds until the swap expires, set instead of height_span for time-based expiry
+	TimeSpan uint64 `protobuf:"varint,10,opt,name=time_span,json=timeSpan,proto3" json:"time_span,omitempty"`
 }
 
 func (m *MsgCreateAtomicSwap) Reset()      { *m = MsgCreateAtomicSwap{} }
@@ -275,44 +279,47 @@ func init() {
 func init() { proto.RegisterFile("kava/bep3/v1beta1/tx.proto", fileDescriptor_019a1c7100544f13) }
 
 var fileDescriptor_019a1c7100544f13 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x93, 0x92, 0xd2, 0x6b, 0x11, 0xe1, 0x5a, 0x24, 0xd7, 0x14, 0x3b, 0x4a, 0x45, 0xe5,
-	0xa1, 0xb1, 0x69, 0xba, 0x31, 0xd1, 0x84, 0x81, 0x0e, 0x05, 0xc9, 0xd9, 0x58, 0xac, 0xb3, 0x7d,
-	0xb5, 0xaf, 0xad, 0xef, 0x2c, 0xdf, 0x25, 0x2d, 0xff, 0x01, 0x23, 0x23, 0x62, 0xca, 0xcc, 0xc2,
-	0xc2, 0xdf, 0x80, 0x3a, 0x56, 0x4c, 0x4c, 0x05, 0x25, 0x0b, 0x7f, 0x06, 0xf2, 0x8f, 0x84, 0xe6,
-	0x97, 0xa8, 0x90, 0x98, 0x7c, 0xf7, 0xbe, 0xef, 0xbd, 0x7b, 0xf7, 0x7d, 0x7e, 0x07, 0xd4, 0x53,
-	0xd4, 0x43, 0x96, 0x8b, 0xe3, 0x7d, 0xab, 0xb7, 0xe7, 0x62, 0x81, 0xf6, 0x2c, 0x71, 0x61, 0xc6,
-	0x09, 0x13, 0x0c, 0x3e, 0x48, 0x31, 0x33, 0xc5, 0xcc, 0x02, 0x53, 0x35, 0x8f, 0xf1, 0x88, 0x71,
-	0xcb, 0x45, 0x1c, 0x8f, 0x13, 0x3c, 0x46, 0x68, 0x9e, 0xa2, 0x6e, 0xe6, 0xb8, 0x93, 0xed, 0xac,
-	0x7c, 0x53, 0x40, 0x1b, 0x01, 0x0b, 0x58, 0x1e, 0x4f, 0x57, 0x79, 0xb4, 0xfe, 0xb9, 0x0c, 0xd6,
-	0x8f, 0x78, 0xd0, 0x4e, 0x30, 0x12, 0xf8, 0x40, 0xb0, 0x88, 0x78, 0x9d, 0x73, 0x14, 0xc3, 0x5d,
-	0xb0, 0x74, 0x9c, 0xb0, 0x48, 0x91, 0x6b, 0xb2, 0xb1, 0xd2, 0x52, 0xbe, 0x7d, 0x69, 0x6c, 0x14,
-	0xd5, 0x0e, 0x7c, 0x3f, 0xc1, 0x9c, 0x77, 0x44, 0x42, 0x68, 0x60, 0x67, 0x2c, 0x68, 0x80, 0x92,
-	0x60, 0x4a, 0xe9, 0x2f, 0xdc, 0x92, 0x60, 0xb0, 0x09, 0x1e, 0x26, 0xd8, 0x23, 0x31, 0xc1, 0x54,
-	0x38, 0x4c, 0x84, 0x38, 0x71, 0xbc, 0x10, 0x11, 0xaa, 0x94, 0xd3, 0x64, 0x7b, 0x7d, 0x0c, 0xbe,
-	0x4e, 0xb1, 0x76, 0x0a, 0xc1, 0x5d, 0x00, 0x39, 0xa6, 0x3e, 0x4e, 0x26, 0x12, 0x96, 0xb2, 0x84,
-	0x6a, 0x8e, 0x4c, 0xb2, 0x13, 0x44, 0x7d, 0x16, 0x39, 0xb4, 0x1b, 0xb9, 0x38, 0x71, 0x42, 0xc4,
-	0x43, 0xe5, 0x4e, 0xce, 0xce, 0x91, 0x57, 0x19, 0xf0, 0x12, 0xf1, 0x10, 0x6e, 0x81, 0x15, 0x41,
-	0x22, 0xcc, 0x05, 0x8a, 0x62, 0xa5, 0x52, 0x93, 0x8d, 0xb2, 0xfd, 0x27, 0x00, 0x3d, 0x50, 0x41,
-	0x11, 0xeb, 0x52, 0xa1, 0x2c, 0xd7, 0xca, 0xc6, 0x6a, 0x73, 0xd3, 0x2c, 0x2e, 0x96, 0xea, 0x3f,
-	0x32, 0xc5, 0x6c, 0x33, 0x42, 0x5b, 0x4f, 0x2f, 0xaf, 0x75, 0xe9, 0xd3, 0x0f, 0xdd, 0x08, 0x88,
-	0x08, 0xbb, 0xae, 0xe9, 0xb1, 0xa8, 0xd0, 0xbf, 0xf8, 0x34, 0xb8, 0x7f, 0x6a, 0x89, 0xb7, 0x31,
-	0xe6, 0x59, 0x02, 0xb7, 0x8b, 0xd2, 0x50, 0x07, 0xab, 0x21, 0x26, 0x41, 0x28, 0x1c, 0x1e, 0x23,
-	0xaa, 0xdc, 0xad, 0xc9, 0xc6, 0x92, 0x0d, 0xf2, 0x50, 0x27, 0x46, 0xf4, 0xd9, 0xda, 0xbb, 0xbe,
-	0x2e, 0x7d, 0xe8, 0xeb, 0xd2, 0xaf, 0xbe, 0x2e, 0xd5, 0x1f, 0x83, 0x47, 0x73, 0x0c, 0xb3, 0x31,
-	0x8f, 0x19, 0xe5, 0xb8, 0xfe, 0x51, 0x06, 0x30, 0xc5, 0xcf, 0x10, 0x89, 0xfe, 0xd9, 0xcf, 0x6d,
-	0xb0, 0xcc, 0xcf, 0x51, 0xec, 0x10, 0xbf, 0x30, 0x15, 0x0c, 0xae, 0xf5, 0x4a, 0x5a, 0xe8, 0xf0,
-	0x85, 0x5d, 0x49, 0xa1, 0x43, 0x1f, 0x6e, 0x83, 0x7b, 0x13, 0x42, 0x17, 0x16, 0xae, 0xdd, 0xd4,
-	0x78, 0xaa, 0xf7, 0x2d, 0xa0, 0xce, 0xf6, 0x36, 0x6e, 0xbd, 0x97, 0xfd, 0x8a, 0x36, 0x3e, 0xee,
-	0x52, 0xff, 0xbf, 0xb6, 0x3e, 0x57, 0xd1, 0xe9, 0x73, 0x47, 0x6d, 0x35, 0xbf, 0x96, 0x40, 0xf9,
-	0x88, 0x07, 0xf0, 0x04, 0x54, 0x67, 0xc6, 0x64, 0xc7, 0x9c, 0x99, 0x51, 0x73, 0x8e, 0x3b, 0xaa,
-	0x79, 0x3b, 0xde, 0xe8, 0x4c, 0x18, 0x80, 0xfb, 0xd3, 0x0e, 0x3e, 0x59, 0x50, 0x62, 0x92, 0xa6,
-	0x36, 0x6e, 0x45, 0x1b, 0x1f, 0x74, 0x02, 0xaa, 0x33, 0x82, 0x2f, 0xb8, 0xd4, 0x34, 0x6f, 0xd1,
-	0xa5, 0x16, 0x09, 0xd9, 0x7a, 0x7e, 0x39, 0xd0, 0xe4, 0xab, 0x81, 0x26, 0xff, 0x1c, 0x68, 0xf2,
-	0xfb, 0xa1, 0x26, 0x5d, 0x0d, 0x35, 0xe9, 0xfb, 0x50, 0x93, 0xde, 0xec, 0xdc, 0x18, 0x9a, 0xb4,
-	0x66, 0xe3, 0x0c, 0xb9, 0x3c, 0x5b, 0x59, 0x17, 0xf9, 0xe3, 0x98, 0x0d, 0x8e, 0x5b, 0xc9, 0x1e,
-	0xad, 0xfd, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0xca, 0xe1, 0xe1, 0x36, 0x05, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x4f, 0xdc, 0x4e,
+	0x10, 0xb5, 0xef, 0xee, 0x77, 0xc0, 0xc2, 0x2f, 0x21, 0x0b, 0x91, 0x8c, 0x01, 0xfb, 0x04, 0x0a,
+	0x72, 0xc1, 0xd9, 0xe1, 0xe8, 0x52, 0x44, 0x01, 0x52, 0x84, 0x82, 0x44, 0xf2, 0x75, 0x69, 0xac,
+	0xb5, 0xbd, 0xd8, 0x06, 0xbc, 0x6b, 0x79, 0xf7, 0x80, 0x7c, 0x83, 0x14, 0x29, 0x52, 0x46, 0xa9,
+	0xa8, 0x53, 0xe7, 0x33, 0x44, 0x94, 0x28, 0x55, 0x2a, 0x12, 0x1d, 0x4d, 0x3e, 0x46, 0xb4, 0x6b,
+	0xdf, 0x85, 0xfb, 0x83, 0x72, 0x8a, 0x94, 0xca, 0xeb, 0x79, 0x6f, 0xe6, 0xcd, 0xf8, 0xcd, 0x1a,
+	0xe8, 0xc7, 0xe8, 0x14, 0x39, 0x3e, 0xce, 0xb6, 0x9d, 0xd3, 0x2d, 0x1f, 0x73, 0xb4, 0xe5, 0xf0,
+	0x73, 0x3b, 0xcb, 0x29, 0xa7, 0xf0, 0x81, 0xc0, 0x6c, 0x81, 0xd9, 0x25, 0xa6, 0x1b, 0x01, 0x65,
+	0x29, 0x65, 0x8e, 0x8f, 0x18, 0xee, 0x27, 0x04, 0x34, 0x21, 0x45, 0x8a, 0xbe, 0x54, 0xe0, 0x9e,
+	0x7c, 0x73, 0x8a, 0x97, 0x12, 0x5a, 0x8c, 0x68, 0x44, 0x8b, 0xb8, 0x38, 0x95, 0xd1, 0x95, 0x51,
+	0x7d, 0x29, 0x28, 0xd1, 0xb5, 0x77, 0x35, 0xb0, 0x70, 0xc0, 0xa2, 0xbd, 0x1c, 0x23, 0x8e, 0x77,
+	0x38, 0x4d, 0x93, 0xa0, 0x7d, 0x86, 0x32, 0xb8, 0x09, 0x6a, 0x87, 0x39, 0x4d, 0x35, 0xb5, 0xa1,
+	0x5a, 0x33, 0xbb, 0xda, 0xd7, 0xcf, 0xcd, 0xc5, 0x52, 0x6b, 0x27, 0x0c, 0x73, 0xcc, 0x58, 0x9b,
+	0xe7, 0x09, 0x89, 0x5c, 0xc9, 0x82, 0x16, 0xa8, 0x70, 0xaa, 0x55, 0xfe, 0xc0, 0xad, 0x70, 0x0a,
+	0x5b, 0xe0, 0x61, 0x8e, 0x83, 0x24, 0x4b, 0x30, 0xe1, 0x1e, 0xe5, 0x31, 0xce, 0xbd, 0x20, 0x46,
+	0x09, 0xd1, 0xaa, 0x22, 0xd9, 0x5d, 0xe8, 0x83, 0xaf, 0x04, 0xb6, 0x27, 0x20, 0xb8, 0x09, 0x20,
+	0xc3, 0x24, 0xc4, 0xf9, 0x40, 0x42, 0x4d, 0x26, 0xcc, 0x17, 0xc8, 0x20, 0x3b, 0x47, 0x24, 0xa4,
+	0xa9, 0x47, 0x3a, 0xa9, 0x8f, 0x73, 0x2f, 0x46, 0x2c, 0xd6, 0xfe, 0x2b, 0xd8, 0x05, 0xf2, 0x52,
+	0x02, 0x2f, 0x10, 0x8b, 0xe1, 0x0a, 0x98, 0xe1, 0x49, 0x8a, 0x19, 0x47, 0x69, 0xa6, 0xd5, 0x1b,
+	0xaa, 0x55, 0x75, 0x7f, 0x07, 0x60, 0x00, 0xea, 0x28, 0xa5, 0x1d, 0xc2, 0xb5, 0xa9, 0x46, 0xd5,
+	0x9a, 0x6d, 0x2d, 0xd9, 0xe5, 0x60, 0xc2, 0x9d, 0x9e, 0x65, 0xf6, 0x1e, 0x4d, 0xc8, 0xee, 0xe3,
+	0xcb, 0x6b, 0x53, 0xf9, 0xf4, 0xdd, 0xb4, 0xa2, 0x84, 0xc7, 0x1d, 0xdf, 0x0e, 0x68, 0x5a, 0xba,
+	0x53, 0x3e, 0x9a, 0x2c, 0x3c, 0x76, 0xf8, 0x9b, 0x0c, 0x33, 0x99, 0xc0, 0xdc, 0xb2, 0x34, 0x34,
+	0xc1, 0x6c, 0x8c, 0x93, 0x28, 0xe6, 0x1e, 0xcb, 0x10, 0xd1, 0xa6, 0x1b, 0xaa, 0x55, 0x73, 0x41,
+	0x11, 0x6a, 0x67, 0x88, 0xc0, 0xa7, 0x60, 0x56, 0xcc, 0xe0, 0xb1, 0x20, 0xc6, 0x29, 0xd6, 0x66,
+	0x1a, 0xaa, 0x75, 0xaf, 0xb5, 0x6a, 0x8f, 0xec, 0x8e, 0x2d, 0x26, 0x6a, 0x4b, 0x92, 0x0b, 0xe2,
+	0xfe, 0x19, 0x2e, 0x17, 0x33, 0x16, 0xe5, 0x81, 0x2c, 0x3f, 0x2d, 0x02, 0xa2, 0xf8, 0x93, 0xb9,
+	0xb7, 0x17, 0xa6, 0xf2, 0xe1, 0xc2, 0x54, 0x7e, 0x5e, 0x98, 0xca, 0xda, 0x2a, 0x58, 0x1e, 0xb3,
+	0x0d, 0x2e, 0x66, 0x19, 0x25, 0x0c, 0xaf, 0x7d, 0x54, 0x01, 0x14, 0xf8, 0x09, 0x4a, 0xd2, 0xbf,
+	0x5e, 0x96, 0x75, 0x30, 0xc5, 0xce, 0x50, 0xe6, 0x25, 0x61, 0xb9, 0x31, 0xa0, 0x7b, 0x6d, 0xd6,
+	0x45, 0xa1, 0xfd, 0xe7, 0x6e, 0x5d, 0x40, 0xfb, 0x21, 0x5c, 0x07, 0xff, 0x0f, 0xb8, 0x58, 0xee,
+	0xc7, 0xdc, 0x6d, 0x03, 0x87, 0x7a, 0x5f, 0x01, 0xfa, 0x68, 0x6f, 0xfd, 0xd6, 0x4f, 0xe5, 0x9e,
+	0xbb, 0xf8, 0xb0, 0x43, 0xc2, 0x7f, 0xda, 0xfa, 0xd8, 0x2f, 0x3a, 0xac, 0xdb, 0x6b, 0xab, 0xf5,
+	0xa5, 0x02, 0xaa, 0x07, 0x2c, 0x82, 0x47, 0x60, 0x7e, 0xe4, 0x0e, 0x6e, 0x8c, 0xb1, 0x78, 0x8c,
+	0x3b, 0xba, 0x3d, 0x19, 0xaf, 0xa7, 0x09, 0x23, 0x70, 0x7f, 0xd8, 0xc1, 0x47, 0x77, 0x94, 0x18,
+	0xa4, 0xe9, 0xcd, 0x89, 0x68, 0x7d, 0xa1, 0x23, 0x30, 0x3f, 0xf2, 0xc1, 0xef, 0x18, 0x6a, 0x98,
+	0xa7, 0xdb, 0x93, 0xf1, 0x7a, 0x5a, 0xbb, 0xcf, 0x2e, 0xbb, 0x86, 0x7a, 0xd5, 0x35, 0xd4, 0x1f,
+	0x5d, 0x43, 0x7d, 0x7f, 0x63, 0x28, 0x57, 0x37, 0x86, 0xf2, 0xed, 0xc6, 0x50, 0x5e, 0x6f, 0xdc,
+	0xba, 0x91, 0xa2, 0x66, 0xf3, 0x04, 0xf9, 0x4c, 0x9e, 0x9c, 0xf3, 0xe2, 0xbf, 0x28, 0x6f, 0xa5,
+	0x5f, 0x97, 0x7f, 0xc4, 0xed, 0x5f, 0x03, 0x00, 0x25, 0x79, 0xd2, 0x05, 0xb1, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -493,6 +500,16 @@ func (m *MsgCreateAtomicSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimeSpan != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeSpan))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.HeightSpan != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.HeightSpan))
 		i--
@@ -754,6 +771,12 @@ func (m *MsgCreateAtomicSwap) Size() (n int) {
 	if m.HeightSpan != 0 {
 		n += 1 + sovTx(uint64(m.HeightSpan))
 	}
+	if m.HashScheme != 0 {
+		n += 1 + sovTx(uint64(m.HashScheme))
+	}
+	if m.TimeSpan != 0 {
+		n += 1 + sovTx(uint64(m.TimeSpan))
+	}
 	return n
 }
 
@@ -1089,6 +1112,44 @@ func (m *MsgCreateAtomicSwap) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSpan", wireType)
+			}
+			m.TimeSpan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeSpan |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
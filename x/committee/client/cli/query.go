@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -32,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 		getCmdQueryNextProposalID(),
 		getCmdQueryProposal(),
 		getCmdQueryProposals(),
+		getCmdQueryQueuedProposal(),
+		getCmdQueryQueuedProposals(),
 		// votes
 		getCmdQueryVotes(),
 		// other
@@ -193,6 +196,85 @@ func getCmdQueryProposals() *cobra.Command {
 	}
 }
 
+// getCmdQueryQueuedProposal implements the query queued proposal command.
+func getCmdQueryQueuedProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:     "queued-proposal [proposal-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query details of a single passed proposal waiting to be enacted",
+		Example: fmt.Sprintf("%s query %s queued-proposal 2", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Prepare params for querier
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.QueuedProposal(context.Background(), &types.QueryQueuedProposalRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// getCmdQueryQueuedProposals implements a query queued proposals command.
+func getCmdQueryQueuedProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-proposals [committee-id]",
+		Short: "Query passed proposals waiting to be enacted, optionally for a single committee",
+		Args:  cobra.MaximumNArgs(1),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s query %s queued-proposals", version.AppName, types.ModuleName),
+			fmt.Sprintf("%s query %s queued-proposals 1", version.AppName, types.ModuleName),
+		}, "\n"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Prepare params for querier
+			var committeeID uint64
+			if len(args) > 0 {
+				committeeID, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("committee-id %s not a valid uint", args[0])
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.QueuedProposals(context.Background(), &types.QueryQueuedProposalsRequest{
+				CommitteeId: committeeID,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "queued-proposals")
+
+	return cmd
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
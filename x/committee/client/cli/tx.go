@@ -62,6 +62,15 @@ const COMMITTEE_DELETE_PROPOSAL_EXAMPLE = `
 }
 `
 
+const COMMITTEE_VETO_PROPOSAL_EXAMPLE = `
+{
+	"@type": "/kava.committee.v1beta1.CommitteeVetoProposal",
+  "title": "A Title",
+  "description": "A proposal description.",
+  "proposal_id": "1"
+}
+`
+
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -201,15 +210,18 @@ func GetGovCmdSubmitProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "committee [proposal-file] [deposit]",
 		Short: "Submit a governance proposal to change a committee.",
-		Long: fmt.Sprintf(`Submit a governance proposal to create, alter, or delete a committee.
+		Long: fmt.Sprintf(`Submit a governance proposal to create, alter, or delete a committee, or to veto a queued committee proposal.
 
 The proposal file must be the json encoded form of the proposal type you want to submit.
 For example, to create or update a committee:
 %s
 
-and to delete a committee:
+to delete a committee:
+%s
+
+and to veto a committee proposal waiting to be enacted:
 %s
-`, COMMITTEE_CHANGE_PROPOSAL_EXAMPLE, COMMITTEE_DELETE_PROPOSAL_EXAMPLE),
+`, COMMITTEE_CHANGE_PROPOSAL_EXAMPLE, COMMITTEE_DELETE_PROPOSAL_EXAMPLE, COMMITTEE_VETO_PROPOSAL_EXAMPLE),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -26,6 +26,9 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs *types.GenesisState)
 	for _, v := range gs.Votes {
 		keeper.SetVote(ctx, v)
 	}
+	for _, qp := range gs.QueuedProposals {
+		keeper.SetQueuedProposal(ctx, qp)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -38,10 +41,12 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
 
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
 	)
+	gs.QueuedProposals = keeper.GetQueuedProposals(ctx)
+	return gs
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// UpdateCommitteeMembers adds, reweights, and removes the members of a member committee.
+// Members in addMembers that are already on the committee have their weight replaced.
+func (k Keeper) UpdateCommitteeMembers(ctx sdk.Context, committeeID uint64, addMembers []types.MemberWeight, removeMembers []sdk.AccAddress) error {
+	committee, found := k.GetCommittee(ctx, committeeID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", committeeID)
+	}
+	com, ok := committee.(*types.MemberCommittee)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidCommittee, "committee %d is not a member committee", committeeID)
+	}
+
+	for _, m := range removeMembers {
+		if !com.HasMember(m) {
+			return errorsmod.Wrapf(types.ErrInvalidCommittee, "%s is not a member of committee %d", m, committeeID)
+		}
+		com.RemoveMember(m)
+	}
+	for _, mw := range addMembers {
+		if !com.HasMember(mw.Address) {
+			com.SetMembers(append(com.GetMembers(), mw.Address))
+		}
+		com.SetMemberWeight(mw.Address, mw.Weight)
+	}
+
+	if err := com.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidCommittee, err.Error())
+	}
+	k.SetCommittee(ctx, com)
+
+	attrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", committeeID))}
+	for _, mw := range addMembers {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyMemberAdded, mw.Address.String()))
+	}
+	for _, m := range removeMembers {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyMemberRemoved, m.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMembersUpdate, attrs...))
+
+	return nil
+}
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/stretchr/testify/suite"
 
@@ -48,6 +51,40 @@ func (suite *grpcQueryTestSuite) TestVote() {
 	suite.Require().Equal(vote.Voter.String(), queryRes.Votes[0].Voter)
 }
 
+func (suite *grpcQueryTestSuite) TestQueuedProposals() {
+	ctx, keeper, queryClient := suite.Ctx, suite.Keeper, suite.QueryClient
+	executionTime := time.Date(1998, time.January, 2, 0, 0, 0, 0, time.UTC)
+	queuedProposals := types.QueuedProposals{
+		types.NewQueuedProposal(types.MustNewProposal(
+			govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 1, 1, executionTime,
+		), executionTime),
+		types.NewQueuedProposal(types.MustNewProposal(
+			govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 2, 2, executionTime,
+		), executionTime),
+	}
+	for _, qp := range queuedProposals {
+		keeper.SetQueuedProposal(ctx, qp)
+	}
+
+	res, err := queryClient.QueuedProposal(context.Background(), &types.QueryQueuedProposalRequest{ProposalId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.ID)
+	suite.Require().Equal(uint64(2), res.CommitteeID)
+	suite.Require().Equal(executionTime, res.ExecutionTime)
+
+	_, err = queryClient.QueuedProposal(context.Background(), &types.QueryQueuedProposalRequest{ProposalId: 3})
+	suite.Require().Error(err)
+
+	queryRes, err := queryClient.QueuedProposals(context.Background(), &types.QueryQueuedProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.QueuedProposals, 2)
+
+	queryRes, err = queryClient.QueuedProposals(context.Background(), &types.QueryQueuedProposalsRequest{CommitteeId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.QueuedProposals, 1)
+	suite.Require().Equal(uint64(1), queryRes.QueuedProposals[0].ID)
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
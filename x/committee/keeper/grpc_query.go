@@ -171,6 +171,55 @@ func (s queryServer) Tally(c context.Context, req *types.QueryTallyRequest) (*ty
 	return tally, nil
 }
 
+// QueuedProposals implements the Query/QueuedProposals gRPC method
+func (s queryServer) QueuedProposals(c context.Context, req *types.QueryQueuedProposalsRequest) (*types.QueryQueuedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var queryResults []types.QueryQueuedProposalResponse
+	queuedProposalsStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.QueuedProposalKeyPrefix)
+	pageRes, err := query.FilteredPaginate(queuedProposalsStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var queuedProposal types.QueuedProposal
+		if err := s.keeper.cdc.Unmarshal(value, &queuedProposal); err != nil {
+			return false, err
+		}
+
+		if req.CommitteeId != 0 && queuedProposal.Proposal.CommitteeID != req.CommitteeId {
+			return false, nil
+		}
+		if accumulate {
+			queryResults = append(queryResults, s.queuedProposalResponseFromQueuedProposal(queuedProposal))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedProposalsResponse{
+		QueuedProposals: queryResults,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// QueuedProposal implements the Query/QueuedProposal gRPC method
+func (s queryServer) QueuedProposal(c context.Context, req *types.QueryQueuedProposalRequest) (*types.QueryQueuedProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	queuedProposal, found := s.keeper.GetQueuedProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "cannot find queued proposal: %v", req.ProposalId)
+	}
+	queuedProposalResp := s.queuedProposalResponseFromQueuedProposal(queuedProposal)
+	return &queuedProposalResp, nil
+}
+
 // RawParams implements the Query/RawParams gRPC method
 func (s queryServer) RawParams(c context.Context, req *types.QueryRawParamsRequest) (*types.QueryRawParamsResponse, error) {
 	if req == nil {
@@ -195,6 +244,15 @@ func (s queryServer) proposalResponseFromProposal(proposal types.Proposal) types
 	}
 }
 
+func (s queryServer) queuedProposalResponseFromQueuedProposal(queuedProposal types.QueuedProposal) types.QueryQueuedProposalResponse {
+	return types.QueryQueuedProposalResponse{
+		PubProposal:   queuedProposal.Proposal.Content,
+		ID:            queuedProposal.Proposal.ID,
+		CommitteeID:   queuedProposal.Proposal.CommitteeID,
+		ExecutionTime: queuedProposal.ExecutionTime,
+	}
+}
+
 func (s queryServer) votesResponseFromVote(vote types.Vote) types.QueryVoteResponse {
 	return types.QueryVoteResponse{
 		ProposalID: vote.ProposalID,
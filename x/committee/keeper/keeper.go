@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -23,15 +25,24 @@ type Keeper struct {
 
 	// Proposal router
 	router govv1beta1.Router
+	// Msg router used to execute the messages of ExecuteMsgsProposals
+	msgRouter *baseapp.MsgServiceRouter
+
+	// the address capable of executing committee management messages on behalf of any committee. Usually the gov module account.
+	authority sdk.AccAddress
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router,
-	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper,
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router, msgRouter *baseapp.MsgServiceRouter,
+	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper, authority sdk.AccAddress,
 ) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
 	router.Seal()
 
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
@@ -39,9 +50,16 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.
 		accountKeeper: ak,
 		bankKeeper:    sk,
 		router:        router,
+		msgRouter:     msgRouter,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/committee module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // ------------------------------------------
 //				Committees
 // ------------------------------------------
@@ -230,6 +248,72 @@ func (k Keeper) DeleteProposalAndVotes(ctx sdk.Context, proposalID uint64) {
 	}
 }
 
+// ------------------------------------------
+//				Queued Proposals
+// ------------------------------------------
+
+// GetQueuedProposal gets a queued proposal from the store.
+func (k Keeper) GetQueuedProposal(ctx sdk.Context, proposalID uint64) (types.QueuedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := store.Get(types.GetKeyFromID(proposalID))
+	if bz == nil {
+		return types.QueuedProposal{}, false
+	}
+	var queuedProposal types.QueuedProposal
+	k.cdc.MustUnmarshal(bz, &queuedProposal)
+	return queuedProposal, true
+}
+
+// SetQueuedProposal puts a queued proposal into the store.
+func (k Keeper) SetQueuedProposal(ctx sdk.Context, queuedProposal types.QueuedProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := k.cdc.MustMarshal(&queuedProposal)
+	store.Set(types.GetKeyFromID(queuedProposal.Proposal.ID), bz)
+}
+
+// DeleteQueuedProposal removes a queued proposal from the store.
+func (k Keeper) DeleteQueuedProposal(ctx sdk.Context, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	store.Delete(types.GetKeyFromID(proposalID))
+}
+
+// IterateQueuedProposals provides an iterator over all stored queued proposals.
+// For each queued proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateQueuedProposals(ctx sdk.Context, cb func(queuedProposal types.QueuedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedProposal types.QueuedProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &queuedProposal)
+		if cb(queuedProposal) {
+			break
+		}
+	}
+}
+
+// GetQueuedProposals returns all stored queued proposals.
+func (k Keeper) GetQueuedProposals(ctx sdk.Context) types.QueuedProposals {
+	results := types.QueuedProposals{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		results = append(results, qp)
+		return false
+	})
+	return results
+}
+
+// GetQueuedProposalsByCommittee returns all queued proposals for one committee.
+func (k Keeper) GetQueuedProposalsByCommittee(ctx sdk.Context, committeeID uint64) types.QueuedProposals {
+	results := types.QueuedProposals{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		if qp.Proposal.CommitteeID == committeeID {
+			results = append(results, qp)
+		}
+		return false
+	})
+	return results
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/committee/types"
 )
@@ -68,3 +70,25 @@ func (m msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 
 	return &types.MsgVoteResponse{}, nil
 }
+
+// UpdateCommitteeMembers handles MsgUpdateCommitteeMembers messages
+func (m msgServer) UpdateCommitteeMembers(goCtx context.Context, msg *types.MsgUpdateCommitteeMembers) (*types.MsgUpdateCommitteeMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only gov or the committee itself, executing one of its own proposals, can change its members
+	if msg.Authority != m.keeper.GetAuthority().String() && msg.Authority != types.GetCommitteeAddress(msg.CommitteeID).String() {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s or %s, got %s",
+			m.keeper.GetAuthority(),
+			types.GetCommitteeAddress(msg.CommitteeID),
+			msg.Authority,
+		)
+	}
+
+	if err := m.keeper.UpdateCommitteeMembers(ctx, msg.CommitteeID, msg.AddMembers, msg.RemoveMembers); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCommitteeMembersResponse{}, nil
+}
@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -190,6 +191,87 @@ func (suite *MsgServerTestSuite) TestSubmitProposalMsgAndVote() {
 	suite.Require().NoError(err)
 }
 
+func (suite *MsgServerTestSuite) TestUpdateCommitteeMembersMsg() {
+	testCases := []struct {
+		name          string
+		authority     sdk.AccAddress
+		addMembers    []types.MemberWeight
+		removeMembers []sdk.AccAddress
+		expectedErr   error
+		expectMembers []sdk.AccAddress
+		expectWeight  uint64
+	}{
+		{
+			name:          "gov adds weighted member",
+			authority:     suite.keeper.GetAuthority(),
+			addMembers:    []types.MemberWeight{types.NewMemberWeight(suite.addresses[3], 4)},
+			expectMembers: append(suite.addresses[:3:3], suite.addresses[3]),
+			expectWeight:  7,
+		},
+		{
+			name:          "committee rotates member",
+			authority:     types.GetCommitteeAddress(1),
+			addMembers:    []types.MemberWeight{types.NewMemberWeight(suite.addresses[3], 1)},
+			removeMembers: []sdk.AccAddress{suite.addresses[0]},
+			expectMembers: []sdk.AccAddress{suite.addresses[1], suite.addresses[2], suite.addresses[3]},
+			expectWeight:  3,
+		},
+		{
+			name:          "existing member reweighted",
+			authority:     suite.keeper.GetAuthority(),
+			addMembers:    []types.MemberWeight{types.NewMemberWeight(suite.addresses[0], 3)},
+			expectMembers: suite.addresses[:3],
+			expectWeight:  5,
+		},
+		{
+			name:        "other committee cannot update",
+			authority:   types.GetCommitteeAddress(2),
+			addMembers:  []types.MemberWeight{types.NewMemberWeight(suite.addresses[3], 1)},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:        "member account cannot update",
+			authority:   suite.addresses[0],
+			addMembers:  []types.MemberWeight{types.NewMemberWeight(suite.addresses[3], 1)},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:          "non-member cannot be removed",
+			authority:     suite.keeper.GetAuthority(),
+			removeMembers: []sdk.AccAddress{suite.addresses[4]},
+			expectedErr:   types.ErrInvalidCommittee,
+		},
+		{
+			name:          "all members cannot be removed",
+			authority:     suite.keeper.GetAuthority(),
+			removeMembers: suite.addresses[:3],
+			expectedErr:   types.ErrInvalidCommittee,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg := types.NewMsgUpdateCommitteeMembers(tc.authority, 1, tc.addMembers, tc.removeMembers)
+			_, err := suite.msgServer.UpdateCommitteeMembers(sdk.WrapSDKContext(suite.ctx), msg)
+
+			committee, found := suite.keeper.GetCommittee(suite.ctx, 1)
+			suite.Require().True(found)
+			com, ok := committee.(*types.MemberCommittee)
+			suite.Require().True(ok)
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Equal(suite.addresses[:3], com.GetMembers())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Equal(tc.expectMembers, com.GetMembers())
+			suite.Equal(sdkmath.NewIntFromUint64(tc.expectWeight), com.GetTotalWeight())
+		})
+	}
+}
+
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgServerTestSuite))
 }
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -22,7 +24,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	}
 
 	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, pubProposal) {
+	if !k.hasPermissionsFor(ctx, com, pubProposal) {
 		return 0, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -97,6 +99,20 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		return err
 	}
 
+	// Veto proposals are enacted by the keeper directly as the committee router does not contain a route for committee proposals.
+	if veto, ok := pubProposal.(*types.CommitteeVetoProposal); ok {
+		if _, found := k.GetQueuedProposal(ctx, veto.ProposalID); !found {
+			return errorsmod.Wrapf(types.ErrUnknownQueuedProposal, "%d", veto.ProposalID)
+		}
+		return nil
+	}
+
+	// Message proposals are executed through the msg router rather than the proposal router.
+	if emp, ok := pubProposal.(*types.ExecuteMsgsProposal); ok {
+		cacheCtx, _ := ctx.CacheContext()
+		return k.executeMsgs(cacheCtx, emp)
+	}
+
 	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
 		return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
 	}
@@ -133,7 +149,7 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 			if committee.GetTallyOption() == types.TALLY_OPTION_FIRST_PAST_THE_POST {
 				passed := k.GetProposalResult(ctx, proposal.ID, committee)
 				if passed {
-					outcome := k.attemptEnactProposal(ctx, proposal)
+					outcome := k.attemptEnactOrQueueProposal(ctx, proposal, committee)
 					k.CloseProposal(ctx, proposal, outcome)
 				}
 			}
@@ -141,7 +157,7 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 			passed := k.GetProposalResult(ctx, proposal.ID, committee)
 			outcome := types.Failed
 			if passed {
-				outcome = k.attemptEnactProposal(ctx, proposal)
+				outcome = k.attemptEnactOrQueueProposal(ctx, proposal, committee)
 			}
 			k.CloseProposal(ctx, proposal, outcome)
 		}
@@ -149,6 +165,68 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 	})
 }
 
+// ProcessQueuedProposals enacts queued proposals once their execution delay has elapsed.
+func (k Keeper) ProcessQueuedProposals(ctx sdk.Context) {
+	// Collect executable proposals first as enacting a veto proposal can remove other queued proposals.
+	executable := types.QueuedProposals{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		if qp.IsExecutableBy(ctx.BlockTime()) {
+			executable = append(executable, qp)
+		}
+		return false
+	})
+
+	for _, qp := range executable {
+		if _, found := k.GetQueuedProposal(ctx, qp.Proposal.ID); !found {
+			continue // vetoed by a proposal enacted earlier in this block
+		}
+		k.DeleteQueuedProposal(ctx, qp.Proposal.ID)
+		outcome := k.attemptEnactProposal(ctx, qp.Proposal)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalEnact,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", qp.Proposal.CommitteeID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", qp.Proposal.ID)),
+				sdk.NewAttribute(types.AttributeKeyProposalOutcome, outcome.String()),
+			),
+		)
+	}
+}
+
+// QueueProposal stores a passed proposal so that it is enacted once the execution delay has elapsed.
+func (k Keeper) QueueProposal(ctx sdk.Context, proposal types.Proposal, executionDelay time.Duration) {
+	executionTime := ctx.BlockTime().Add(executionDelay)
+	k.SetQueuedProposal(ctx, types.NewQueuedProposal(proposal, executionTime))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalQueue,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyExecutionTime, executionTime.String()),
+		),
+	)
+}
+
+// VetoQueuedProposal removes a queued proposal so that it is never enacted.
+func (k Keeper) VetoQueuedProposal(ctx sdk.Context, proposalID uint64) error {
+	qp, found := k.GetQueuedProposal(ctx, proposalID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownQueuedProposal, "%d", proposalID)
+	}
+	k.DeleteQueuedProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVeto,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", qp.Proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", qp.Proposal.ID)),
+		),
+	)
+	return nil
+}
+
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
 	switch com := committee.(type) {
 	case *types.MemberCommittee:
@@ -161,16 +239,22 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 }
 
 // GetMemberCommitteeProposalResult gets the result of a member committee proposal
-func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
-	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID)
-	possibleVotes := sdk.NewDec(int64(len(committee.GetMembers())))
+func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee *types.MemberCommittee) bool {
+	currVotes, possibleVotes := k.TallyMemberCommitteeVotes(ctx, proposalID, committee)
 	return currVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
 }
 
-// TallyMemberCommitteeVotes returns the polling status of a member committee vote
-func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64) (totalVotes sdk.Dec) {
+// TallyMemberCommitteeVotes returns the polling status of a member committee vote. Returns the summed weight of
+// members that have voted, and the total weight of all members.
+func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64, committee *types.MemberCommittee,
+) (totalVotes, possibleVotes sdk.Dec) {
+	votedWeight := sdkmath.ZeroInt()
 	votes := k.GetVotesByProposal(ctx, proposalID)
-	return sdk.NewDec(int64(len(votes)))
+	for _, vote := range votes {
+		// votes from removed members carry no weight
+		votedWeight = votedWeight.Add(sdkmath.NewIntFromUint64(committee.GetMemberWeight(vote.Voter)))
+	}
+	return sdk.NewDecFromInt(votedWeight), sdk.NewDecFromInt(committee.GetTotalWeight())
 }
 
 // GetTokenCommitteeProposalResult gets the result of a token committee proposal
@@ -214,6 +298,20 @@ func (k Keeper) TallyTokenCommitteeVotes(ctx sdk.Context, proposalID uint64,
 	return yesVotes, noVotes, totalVotes, sdk.NewDecFromInt(possibleVotesInt)
 }
 
+// attemptEnactOrQueueProposal enacts a passed proposal, or queues it when the committee has an execution delay.
+// Veto proposals are always enacted immediately, otherwise the vetoed proposal could be enacted before the veto.
+func (k Keeper) attemptEnactOrQueueProposal(ctx sdk.Context, proposal types.Proposal, committee types.Committee) types.ProposalOutcome {
+	if _, isVeto := proposal.GetContent().(*types.CommitteeVetoProposal); isVeto || committee.GetExecutionDelay() <= 0 {
+		return k.attemptEnactProposal(ctx, proposal)
+	}
+	// Proposals that cannot be enacted are not queued
+	if err := k.validateProposalEnactment(ctx, proposal); err != nil {
+		return types.Invalid
+	}
+	k.QueueProposal(ctx, proposal, committee.GetExecutionDelay())
+	return types.Queued
+}
+
 func (k Keeper) attemptEnactProposal(ctx sdk.Context, proposal types.Proposal) types.ProposalOutcome {
 	err := k.enactProposal(ctx, proposal)
 	if err != nil {
@@ -224,29 +322,104 @@ func (k Keeper) attemptEnactProposal(ctx sdk.Context, proposal types.Proposal) t
 
 // enactProposal makes the changes proposed in a proposal.
 func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
+	if err := k.validateProposalEnactment(ctx, proposal); err != nil {
+		return err
+	}
+
+	if veto, ok := proposal.GetContent().(*types.CommitteeVetoProposal); ok {
+		return k.VetoQueuedProposal(ctx, veto.ProposalID)
+	}
+
+	// Messages can fail against the current state even though they succeeded when the proposal was submitted,
+	// so they are executed in a cached context that is only written when all of them succeed.
+	if emp, ok := proposal.GetContent().(*types.ExecuteMsgsProposal); ok {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.executeMsgs(cacheCtx, emp); err != nil {
+			return err
+		}
+		writeCache()
+		return nil
+	}
+
+	// enact the proposal
+	handler := k.router.GetRoute(proposal.GetContent().ProposalRoute())
+	if err := handler(ctx, proposal.GetContent()); err != nil {
+		// the handler should not error as it was checked in ValidatePubProposal
+		panic(fmt.Sprintf("unexpected handler error: %s", err))
+	}
+	return nil
+}
+
+// validateProposalEnactment checks a proposal's committee still exists and has permissions for the proposal, and that the proposal is valid.
+func (k Keeper) validateProposalEnactment(ctx sdk.Context, proposal types.Proposal) error {
 	// Check committee still has permissions for the proposal
 	// Since the proposal was submitted params could have changed, invalidating the permission of the committee.
 	com, found := k.GetCommittee(ctx, proposal.CommitteeID)
 	if !found {
 		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
 	}
-	if !com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, proposal.GetContent()) {
+	if !k.hasPermissionsFor(ctx, com, proposal.GetContent()) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
-	if err := k.ValidatePubProposal(ctx, proposal.GetContent()); err != nil {
-		return err
+	return k.ValidatePubProposal(ctx, proposal.GetContent())
+}
+
+// executeMsgs runs each message of a proposal through its msg service handler, stopping at the first error.
+func (k Keeper) executeMsgs(ctx sdk.Context, proposal *types.ExecuteMsgsProposal) (returnErr error) {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
 	}
 
-	// enact the proposal
-	handler := k.router.GetRoute(proposal.GetContent().ProposalRoute())
-	if err := handler(ctx, proposal.GetContent()); err != nil {
-		// the handler should not error as it was checked in ValidatePubProposal
-		panic(fmt.Sprintf("unexpected handler error: %s", err))
+	// Message handlers may panic on unexpected input, convert these into a normal error.
+	defer func() {
+		if r := recover(); r != nil {
+			returnErr = errorsmod.Wrapf(types.ErrInvalidPubProposal, "message handler panicked: %s", r)
+		}
+	}()
+
+	for i, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "msg %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+		if _, err := handler(ctx, msg); err != nil {
+			return errorsmod.Wrapf(err, "msg %d", i)
+		}
 	}
 	return nil
 }
 
+// hasPermissionsFor returns whether a committee is authorized to enact a proposal.
+// Besides the committee's permissions, a committee is authorized to veto proposals queued by committees that designate it as their veto committee.
+// Message proposals may only contain messages signed by the committee's own address, so a committee can never act
+// as the gov authority or as another committee.
+func (k Keeper) hasPermissionsFor(ctx sdk.Context, com types.Committee, pubProposal types.PubProposal) bool {
+	if emp, ok := pubProposal.(*types.ExecuteMsgsProposal); ok {
+		msgs, err := emp.GetMsgs()
+		if err != nil {
+			return false
+		}
+		committeeAddr := types.GetCommitteeAddress(com.GetID())
+		for _, msg := range msgs {
+			signers := msg.GetSigners()
+			if len(signers) != 1 || !signers[0].Equals(committeeAddr) {
+				return false
+			}
+		}
+	}
+	if veto, ok := pubProposal.(*types.CommitteeVetoProposal); ok {
+		if qp, found := k.GetQueuedProposal(ctx, veto.ProposalID); found {
+			vetoedCom, found := k.GetCommittee(ctx, qp.Proposal.CommitteeID)
+			if found && vetoedCom.GetVetoCommitteeID() != 0 && vetoedCom.GetVetoCommitteeID() == com.GetID() {
+				return true
+			}
+		}
+	}
+	return com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, pubProposal)
+}
+
 // GetProposalTallyResponse returns the tally results of a proposal.
 func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*types.QueryTallyResponse, bool) {
 	proposal, found := k.GetProposal(ctx, proposalID)
@@ -260,8 +433,7 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 	var proposalTally types.QueryTallyResponse
 	switch com := committee.(type) {
 	case *types.MemberCommittee:
-		currVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID)
-		possibleVotes := sdk.NewDec(int64(len(com.Members)))
+		currVotes, possibleVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID, com)
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
 			YesVotes:      currVotes,
@@ -372,35 +372,76 @@ func (suite *keeperTestSuite) TestTallyMemberCommitteeVotes() {
 		time.Hour*24*7,
 		types.TALLY_OPTION_DEADLINE,
 	)
+	weightedCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:5],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+	)
+	weightedCom.MemberWeights = []types.MemberWeight{
+		types.NewMemberWeight(suite.Addresses[0], 5),
+		types.NewMemberWeight(suite.Addresses[1], 3),
+	}
 	var defaultProposalID uint64 = 1
 	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
 
 	testcases := []struct {
-		name              string
-		votes             []types.Vote
-		expectedVoteCount sdk.Dec
+		name                  string
+		committee             *types.MemberCommittee
+		votes                 []types.Vote
+		expectedVoteCount     sdk.Dec
+		expectedPossibleVotes sdk.Dec
 	}{
 		{
-			name:              "has 0 votes",
-			votes:             []types.Vote{},
-			expectedVoteCount: testutil.D("0"),
+			name:                  "has 0 votes",
+			committee:             memberCom,
+			votes:                 []types.Vote{},
+			expectedVoteCount:     testutil.D("0"),
+			expectedPossibleVotes: testutil.D("5"),
 		},
 		{
-			name: "has 1 vote",
+			name:      "has 1 vote",
+			committee: memberCom,
 			votes: []types.Vote{
 				{ProposalID: defaultProposalID, Voter: suite.Addresses[0], VoteType: types.VOTE_TYPE_YES},
 			},
-			expectedVoteCount: testutil.D("1"),
+			expectedVoteCount:     testutil.D("1"),
+			expectedPossibleVotes: testutil.D("5"),
 		},
 		{
-			name: "has multiple votes",
+			name:      "has multiple votes",
+			committee: memberCom,
 			votes: []types.Vote{
 				{ProposalID: defaultProposalID, Voter: suite.Addresses[0], VoteType: types.VOTE_TYPE_YES},
 				{ProposalID: defaultProposalID, Voter: suite.Addresses[1], VoteType: types.VOTE_TYPE_YES},
 				{ProposalID: defaultProposalID, Voter: suite.Addresses[2], VoteType: types.VOTE_TYPE_YES},
 				{ProposalID: defaultProposalID, Voter: suite.Addresses[3], VoteType: types.VOTE_TYPE_YES},
 			},
-			expectedVoteCount: testutil.D("4"),
+			expectedVoteCount:     testutil.D("4"),
+			expectedPossibleVotes: testutil.D("5"),
+		},
+		{
+			name:      "has weighted votes",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultProposalID, Voter: suite.Addresses[0], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultProposalID, Voter: suite.Addresses[2], VoteType: types.VOTE_TYPE_YES},
+			},
+			expectedVoteCount:     testutil.D("6"),
+			expectedPossibleVotes: testutil.D("11"),
+		},
+		{
+			name:      "ignores votes from non-members",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultProposalID, Voter: suite.Addresses[1], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultProposalID, Voter: suite.Addresses[6], VoteType: types.VOTE_TYPE_YES},
+			},
+			expectedVoteCount:     testutil.D("3"),
+			expectedPossibleVotes: testutil.D("11"),
 		},
 	}
 
@@ -414,11 +455,11 @@ func (suite *keeperTestSuite) TestTallyMemberCommitteeVotes() {
 		tApp.InitializeFromGenesisStates(
 			committeeGenState(
 				tApp.AppCodec(),
-				[]types.Committee{memberCom},
+				[]types.Committee{tc.committee},
 				[]types.Proposal{types.MustNewProposal(
 					govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
 					defaultProposalID,
-					memberCom.GetID(),
+					tc.committee.GetID(),
 					firstBlockTime.Add(time.Hour*24*7),
 				)},
 				tc.votes,
@@ -426,8 +467,9 @@ func (suite *keeperTestSuite) TestTallyMemberCommitteeVotes() {
 		)
 
 		// Check that all votes are counted
-		currentVotes := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID)
+		currentVotes, possibleVotes := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID, tc.committee)
 		suite.Equal(tc.expectedVoteCount, currentVotes)
+		suite.Equal(tc.expectedPossibleVotes, possibleVotes)
 	}
 }
 
@@ -589,12 +631,24 @@ func (suite *keeperTestSuite) TestGetMemberCommitteeProposalResult() {
 		time.Hour*24*7,
 		types.TALLY_OPTION_DEADLINE,
 	)
+	weightedCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:5],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+	)
+	weightedCom.MemberWeights = []types.MemberWeight{
+		types.NewMemberWeight(suite.Addresses[0], 10),
+	}
 	var defaultID uint64 = 1
 	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
 
 	testcases := []struct {
 		name           string
-		committee      types.Committee
+		committee      *types.MemberCommittee
 		votes          []types.Vote
 		proposalPasses bool
 	}{
@@ -617,6 +671,25 @@ func (suite *keeperTestSuite) TestGetMemberCommitteeProposalResult() {
 			},
 			proposalPasses: false,
 		},
+		{
+			name:      "enough weighted votes",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.Addresses[0], VoteType: types.VOTE_TYPE_YES},
+			},
+			proposalPasses: true,
+		},
+		{
+			name:      "not enough weighted votes",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.Addresses[1], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultID, Voter: suite.Addresses[2], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultID, Voter: suite.Addresses[3], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultID, Voter: suite.Addresses[4], VoteType: types.VOTE_TYPE_YES},
+			},
+			proposalPasses: false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -16,6 +16,8 @@ func NewProposalHandler(k keeper.Keeper) govv1beta1.Handler {
 			return handleCommitteeChangeProposal(ctx, k, c)
 		case *types.CommitteeDeleteProposal:
 			return handleCommitteeDeleteProposal(ctx, k, c)
+		case *types.CommitteeVetoProposal:
+			return handleCommitteeVetoProposal(ctx, k, c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
@@ -28,6 +30,12 @@ func handleCommitteeChangeProposal(ctx sdk.Context, k keeper.Keeper, committeePr
 		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
 	}
 
+	if vetoID := committeeProposal.GetNewCommittee().GetVetoCommitteeID(); vetoID != 0 {
+		if _, found := k.GetCommittee(ctx, vetoID); !found {
+			return errorsmod.Wrapf(types.ErrUnknownCommittee, "veto committee %d", vetoID)
+		}
+	}
+
 	// Remove all committee's ongoing proposals
 	proposals := k.GetProposalsByCommittee(ctx, committeeProposal.GetNewCommittee().GetID())
 	for _, p := range proposals {
@@ -50,6 +58,20 @@ func handleCommitteeDeleteProposal(ctx sdk.Context, k keeper.Keeper, committeePr
 		k.CloseProposal(ctx, p, types.Failed)
 	}
 
+	// Remove all committee's queued proposals
+	queuedProposals := k.GetQueuedProposalsByCommittee(ctx, committeeProposal.CommitteeID)
+	for _, qp := range queuedProposals {
+		k.DeleteQueuedProposal(ctx, qp.Proposal.ID)
+	}
+
 	k.DeleteCommittee(ctx, committeeProposal.CommitteeID)
 	return nil
 }
+
+func handleCommitteeVetoProposal(ctx sdk.Context, k keeper.Keeper, committeeProposal *types.CommitteeVetoProposal) error {
+	if err := committeeProposal.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+
+	return k.VetoQueuedProposal(ctx, committeeProposal.ProposalID)
+}
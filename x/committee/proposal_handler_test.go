@@ -137,6 +137,23 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_ChangeCommittee() {
 			),
 			expectPass: false,
 		},
+		{
+			name: "non existent veto committee",
+			proposal: func() types.CommitteeChangeProposal {
+				com := types.MustNewMemberCommittee(
+					34,
+					"member committee",
+					suite.addresses[:1],
+					[]types.Permission{},
+					testutil.D("1"),
+					time.Hour*24,
+					types.TALLY_OPTION_DEADLINE,
+				)
+				com.VetoCommitteeID = 47
+				return types.MustNewCommitteeChangeProposal("A Title", "A proposal description.", com)
+			}(),
+			expectPass: false,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
@@ -236,6 +253,66 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_DeleteCommittee() {
 	}
 }
 
+func (suite *ProposalHandlerTestSuite) TestProposalHandler_VetoQueuedProposal() {
+	queuedProposal := types.NewQueuedProposal(
+		types.MustNewProposal(
+			govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 2, 1, testTime,
+		),
+		testTime.Add(24*time.Hour),
+	)
+
+	testCases := []struct {
+		name       string
+		proposal   types.CommitteeVetoProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			proposal:   types.NewCommitteeVetoProposal("A Title", "A proposal description.", queuedProposal.Proposal.ID),
+			expectPass: true,
+		},
+		{
+			name:       "proposal not queued",
+			proposal:   types.NewCommitteeVetoProposal("A Title", "A proposal description.", 1),
+			expectPass: false,
+		},
+		{
+			name:       "invalid title",
+			proposal:   types.NewCommitteeVetoProposal("", "A proposal description.", queuedProposal.Proposal.ID),
+			expectPass: false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Setup
+			genState := *suite.testGenesis
+			genState.NextProposalID = 3
+			genState.QueuedProposals = types.QueuedProposals{queuedProposal}
+
+			suite.app = app.NewTestApp()
+			suite.keeper = suite.app.GetCommitteeKeeper()
+			suite.app = suite.app.InitializeFromGenesisStates(
+				NewCommitteeGenState(suite.app.AppCodec(), &genState),
+			)
+			suite.ctx = suite.app.NewContext(true, tmproto.Header{Height: 1, Time: testTime})
+			handler := committee.NewProposalHandler(suite.keeper)
+
+			// Run
+			err := handler(suite.ctx, &tc.proposal)
+
+			// Check
+			if tc.expectPass {
+				suite.NoError(err)
+				_, found := suite.keeper.GetQueuedProposal(suite.ctx, tc.proposal.ProposalID)
+				suite.False(found)
+			} else {
+				suite.Error(err)
+				testutil.AssertProtoMessageJSON(suite.T(), suite.app.AppCodec(), &genState, committee.ExportGenesis(suite.ctx, suite.keeper))
+			}
+		})
+	}
+}
+
 func TestProposalHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProposalHandlerTestSuite))
 }
@@ -14,6 +14,7 @@ import (
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	communitytypes "github.com/kava-labs/kava/x/community/types"
+	earntypes "github.com/kava-labs/kava/x/earn/types"
 	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
 )
 
@@ -52,7 +53,13 @@ func init() {
 	RegisterProposalTypeCodec(communitytypes.CommunityCDPRepayDebtProposal{}, "kava/CommunityCDPRepayDebtProposal")
 	RegisterProposalTypeCodec(communitytypes.CommunityCDPWithdrawCollateralProposal{}, "kava/CommunityCDPWithdrawCollateralProposal")
 	RegisterProposalTypeCodec(communitytypes.CommunityPoolLendWithdrawProposal{}, "kava/CommunityPoolLendWithdrawProposal")
+	RegisterProposalTypeCodec(communitytypes.CommunityPoolCancelPaymentStreamProposal{}, "kava/CommunityPoolCancelPaymentStreamProposal")
+	RegisterProposalTypeCodec(communitytypes.CommunityPoolSwapProposal{}, "kava/CommunityPoolSwapProposal")
+	RegisterProposalTypeCodec(communitytypes.CommunityPoolProvideLiquidityProposal{}, "kava/CommunityPoolProvideLiquidityProposal")
+	RegisterProposalTypeCodec(communitytypes.CommunityPoolWithdrawLiquidityProposal{}, "kava/CommunityPoolWithdrawLiquidityProposal")
 	RegisterProposalTypeCodec(kavadisttypes.CommunityPoolMultiSpendProposal{}, "kava/CommunityPoolMultiSpendProposal")
+	RegisterProposalTypeCodec(earntypes.CommunityPoolDepositProposal{}, "kava/CommunityPoolDepositProposal")
+	RegisterProposalTypeCodec(earntypes.CommunityPoolWithdrawProposal{}, "kava/CommunityPoolWithdrawProposal")
 }
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the module.
@@ -61,6 +68,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "kava/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(CommitteeVetoProposal{}, "kava/CommitteeVetoProposal", nil)
+	cdc.RegisterConcrete(ExecuteMsgsProposal{}, "kava/ExecuteMsgsProposal", nil)
 
 	// Committees
 	cdc.RegisterInterface((*Committee)(nil), nil)
@@ -77,10 +86,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(CommunityCDPRepayDebtPermission{}, "kava/CommunityCDPRepayDebtPermission", nil)
 	cdc.RegisterConcrete(CommunityCDPWithdrawCollateralPermission{}, "kava/CommunityCDPWithdrawCollateralPermission", nil)
 	cdc.RegisterConcrete(CommunityPoolLendWithdrawPermission{}, "kava/CommunityPoolLendWithdrawPermission", nil)
+	cdc.RegisterConcrete(CommunityPoolCancelPaymentStreamPermission{}, "kava/CommunityPoolCancelPaymentStreamPermission", nil)
+	cdc.RegisterConcrete(CommunityPoolSwapPermission{}, "kava/CommunityPoolSwapPermission", nil)
+	cdc.RegisterConcrete(CommunityPoolProvideLiquidityPermission{}, "kava/CommunityPoolProvideLiquidityPermission", nil)
+	cdc.RegisterConcrete(CommunityPoolWithdrawLiquidityPermission{}, "kava/CommunityPoolWithdrawLiquidityPermission", nil)
+	cdc.RegisterConcrete(EvmutilConversionPermission{}, "kava/EvmutilConversionPermission", nil)
+	cdc.RegisterConcrete(SwapAllowedPoolsPermission{}, "kava/SwapAllowedPoolsPermission", nil)
+	cdc.RegisterConcrete(EarnCommunityPoolPermission{}, "kava/EarnCommunityPoolPermission", nil)
+	cdc.RegisterConcrete(MsgPermission{}, "kava/MsgPermission", nil)
 
 	// Msgs
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "kava/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "kava/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateCommitteeMembers{}, "kava/MsgUpdateCommitteeMembers")
 }
 
 // RegisterProposalTypeCodec allows external modules to register their own pubproposal types on the
@@ -93,6 +111,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitProposal{},
 		&MsgVote{},
+		&MsgUpdateCommitteeMembers{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -115,6 +134,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&CommunityCDPRepayDebtPermission{},
 		&CommunityCDPWithdrawCollateralPermission{},
 		&CommunityPoolLendWithdrawPermission{},
+		&CommunityPoolCancelPaymentStreamPermission{},
+		&CommunityPoolSwapPermission{},
+		&CommunityPoolProvideLiquidityPermission{},
+		&CommunityPoolWithdrawLiquidityPermission{},
+		&EvmutilConversionPermission{},
+		&SwapAllowedPoolsPermission{},
+		&EarnCommunityPoolPermission{},
+		&MsgPermission{},
 	)
 
 	// Need to register PubProposal here since we use this as alias for the x/gov Content interface for all the proposal implementations used in this module.
@@ -132,11 +159,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&communitytypes.CommunityCDPRepayDebtProposal{},
 		&communitytypes.CommunityCDPWithdrawCollateralProposal{},
 		&communitytypes.CommunityPoolLendWithdrawProposal{},
+		&communitytypes.CommunityPoolCancelPaymentStreamProposal{},
+		&communitytypes.CommunityPoolSwapProposal{},
+		&communitytypes.CommunityPoolProvideLiquidityProposal{},
+		&communitytypes.CommunityPoolWithdrawLiquidityProposal{},
+		&earntypes.CommunityPoolDepositProposal{},
+		&earntypes.CommunityPoolWithdrawProposal{},
+		&CommitteeVetoProposal{},
+		&ExecuteMsgsProposal{},
 	)
 
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&CommitteeChangeProposal{},
 		&CommitteeDeleteProposal{},
+		&CommitteeVetoProposal{},
 	)
 }
@@ -4,6 +4,7 @@ import (
 	fmt "fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -56,6 +57,10 @@ type Committee interface {
 	SetVoteThreshold(sdk.Dec)
 
 	GetTallyOption() TallyOption
+
+	GetExecutionDelay() time.Duration
+	GetVetoCommitteeID() uint64
+
 	Validate() error
 
 	String() string
@@ -143,10 +148,12 @@ func (c BaseCommittee) String() string {
   	Permissions:               			%s
   	VoteThreshold:            		  %s
 	ProposalDuration:        						%s
-	TallyOption:   						%s`,
+	TallyOption:   						%s
+	ExecutionDelay:        						%s
+	VetoCommitteeID:   						%d`,
 		c.ID, c.Description, c.GetMembers(), c.Permissions,
 		c.VoteThreshold.String(), c.ProposalDuration.String(),
-		c.TallyOption.String(),
+		c.TallyOption.String(), c.ExecutionDelay.String(), c.VetoCommitteeID,
 	)
 }
 
@@ -169,6 +176,12 @@ func (c *BaseCommittee) SetProposalDuration(proposalDuration time.Duration) {
 // GetTallyOption is a getter for committee TallyOption
 func (c BaseCommittee) GetTallyOption() TallyOption { return c.TallyOption }
 
+// GetExecutionDelay is a getter for committee ExecutionDelay
+func (c BaseCommittee) GetExecutionDelay() time.Duration { return c.ExecutionDelay }
+
+// GetVetoCommitteeID is a getter for committee VetoCommitteeID
+func (c BaseCommittee) GetVetoCommitteeID() uint64 { return c.VetoCommitteeID }
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (c BaseCommittee) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, any := range c.Permissions {
@@ -228,6 +241,14 @@ func (c BaseCommittee) Validate() error {
 		return fmt.Errorf("invalid tally option: %d", c.TallyOption)
 	}
 
+	if c.ExecutionDelay < 0 {
+		return fmt.Errorf("invalid execution delay: %s", c.ExecutionDelay)
+	}
+
+	if c.VetoCommitteeID != 0 && c.VetoCommitteeID == c.ID {
+		return fmt.Errorf("committee cannot be its own veto committee")
+	}
+
 	return nil
 }
 
@@ -266,6 +287,106 @@ func MustNewMemberCommittee(id uint64, description string, members []sdk.AccAddr
 // GetType is a getter for committee type
 func (c MemberCommittee) GetType() string { return MemberCommitteeType }
 
+// GetMemberWeight returns the voting weight of a member. Members without an explicit weight have a weight of one,
+// and addresses that are not members have a weight of zero.
+func (c MemberCommittee) GetMemberWeight(addr sdk.AccAddress) uint64 {
+	if !c.HasMember(addr) {
+		return 0
+	}
+	for _, mw := range c.MemberWeights {
+		if mw.Address.Equals(addr) {
+			return mw.Weight
+		}
+	}
+	return 1
+}
+
+// GetTotalWeight returns the sum of the voting weights of all members.
+// The sum is an Int as the sum of uint64 weights may not fit in a uint64.
+func (c MemberCommittee) GetTotalWeight() sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	for _, m := range c.Members {
+		total = total.Add(sdkmath.NewIntFromUint64(c.GetMemberWeight(m)))
+	}
+	return total
+}
+
+// SetMemberWeight sets the voting weight of a member, replacing any existing weight.
+func (c *MemberCommittee) SetMemberWeight(addr sdk.AccAddress, weight uint64) {
+	for i, mw := range c.MemberWeights {
+		if mw.Address.Equals(addr) {
+			c.MemberWeights[i].Weight = weight
+			return
+		}
+	}
+	c.MemberWeights = append(c.MemberWeights, NewMemberWeight(addr, weight))
+}
+
+// RemoveMember removes a member and its voting weight from the committee.
+func (c *MemberCommittee) RemoveMember(addr sdk.AccAddress) {
+	members := make([]sdk.AccAddress, 0, len(c.Members))
+	for _, m := range c.Members {
+		if !m.Equals(addr) {
+			members = append(members, m)
+		}
+	}
+	c.Members = members
+
+	weights := make([]MemberWeight, 0, len(c.MemberWeights))
+	for _, mw := range c.MemberWeights {
+		if !mw.Address.Equals(addr) {
+			weights = append(weights, mw)
+		}
+	}
+	c.MemberWeights = weights
+}
+
+// String implements fmt.Stringer
+func (c MemberCommittee) String() string {
+	return fmt.Sprintf(`%s
+	MemberWeights:   %v`,
+		c.BaseCommittee.String(), c.MemberWeights,
+	)
+}
+
+// Validate validates the committee's fields
+func (c MemberCommittee) Validate() error {
+	seenWeights := make(map[string]bool, len(c.MemberWeights))
+	for _, mw := range c.MemberWeights {
+		if err := mw.Validate(); err != nil {
+			return err
+		}
+		if seenWeights[mw.Address.String()] {
+			return fmt.Errorf("committee cannot have duplicate member weights, %s", mw.Address)
+		}
+		seenWeights[mw.Address.String()] = true
+		if !c.HasMember(mw.Address) {
+			return fmt.Errorf("member weight set for non-member %s", mw.Address)
+		}
+	}
+
+	return c.BaseCommittee.Validate()
+}
+
+// NewMemberWeight returns a new MemberWeight
+func NewMemberWeight(addr sdk.AccAddress, weight uint64) MemberWeight {
+	return MemberWeight{
+		Address: addr,
+		Weight:  weight,
+	}
+}
+
+// Validate validates the member weight's fields
+func (mw MemberWeight) Validate() error {
+	if mw.Address.Empty() {
+		return fmt.Errorf("member weight cannot have empty address")
+	}
+	if mw.Weight == 0 {
+		return fmt.Errorf("member weight for %s must be positive", mw.Address)
+	}
+	return nil
+}
+
 // NewTokenCommittee instantiates a new instance of TokenCommittee
 func NewTokenCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission,
 	threshold sdk.Dec, duration time.Duration, tallyOption TallyOption, quorum sdk.Dec, tallyDenom string,
@@ -447,6 +568,44 @@ func (p Proposal) HasExpiredBy(time time.Time) bool {
 	return !time.Before(p.Deadline)
 }
 
+var _ codectypes.UnpackInterfacesMessage = QueuedProposals{}
+
+type QueuedProposals []QueuedProposal
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (qps QueuedProposals) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, qp := range qps {
+		if err := qp.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewQueuedProposal instantiates a new instance of QueuedProposal
+func NewQueuedProposal(proposal Proposal, executionTime time.Time) QueuedProposal {
+	return QueuedProposal{
+		Proposal:      proposal,
+		ExecutionTime: executionTime,
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (qp QueuedProposal) String() string {
+	bz, _ := yaml.Marshal(qp)
+	return string(bz)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (qp QueuedProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return qp.Proposal.UnpackInterfaces(unpacker)
+}
+
+// IsExecutableBy calculates if the execution delay of the proposal will have elapsed by a certain time.
+func (qp QueuedProposal) IsExecutableBy(time time.Time) bool {
+	return !time.Before(qp.ExecutionTime)
+}
+
 // NewVote instantiates a new instance of Vote
 func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
 	return Vote{
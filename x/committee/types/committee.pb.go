@@ -73,6 +73,10 @@ type BaseCommittee struct {
 	// The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
 	ProposalDuration time.Duration `protobuf:"bytes,6,opt,name=proposal_duration,json=proposalDuration,proto3,stdduration" json:"proposal_duration"`
 	TallyOption      TallyOption   `protobuf:"varint,7,opt,name=tally_option,json=tallyOption,proto3,enum=kava.committee.v1beta1.TallyOption" json:"tally_option,omitempty"`
+	// The length of time a passed proposal is queued for before it is enacted. Proposals are enacted immediately when zero.
+	ExecutionDelay time.Duration `protobuf:"bytes,8,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
+	// The committee allowed to veto this committee's queued proposals. Zero means no committee can veto them.
+	VetoCommitteeID uint64 `protobuf:"varint,9,opt,name=veto_committee_id,json=vetoCommitteeId,proto3" json:"veto_committee_id,omitempty"`
 }
 
 func (m *BaseCommittee) Reset()      { *m = BaseCommittee{} }
@@ -107,9 +111,11 @@ func (m *BaseCommittee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BaseCommittee proto.InternalMessageInfo
 
-// MemberCommittee is an alias of BaseCommittee
+// MemberCommittee supports voting on proposals by committee members, weighted by their voting power
 type MemberCommittee struct {
 	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
+	// Voting weights of committee members. Members without a weight have a weight of one.
+	MemberWeights []MemberWeight `protobuf:"bytes,2,rep,name=member_weights,json=memberWeights,proto3" json:"member_weights"`
 }
 
 func (m *MemberCommittee) Reset()      { *m = MemberCommittee{} }
@@ -144,6 +150,45 @@ func (m *MemberCommittee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MemberCommittee proto.InternalMessageInfo
 
+// MemberWeight defines the voting weight of a member committee member
+type MemberWeight struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Weight  uint64                                        `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *MemberWeight) Reset()         { *m = MemberWeight{} }
+func (m *MemberWeight) String() string { return proto.CompactTextString(m) }
+func (*MemberWeight) ProtoMessage()    {}
+func (*MemberWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2549fd9d70ca349, []int{2}
+}
+func (m *MemberWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberWeight.Merge(m, src)
+}
+func (m *MemberWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberWeight proto.InternalMessageInfo
+
 // TokenCommittee supports voting on proposals by token holders
 type TokenCommittee struct {
 	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
@@ -154,7 +199,7 @@ type TokenCommittee struct {
 func (m *TokenCommittee) Reset()      { *m = TokenCommittee{} }
 func (*TokenCommittee) ProtoMessage() {}
 func (*TokenCommittee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2549fd9d70ca349, []int{2}
+	return fileDescriptor_a2549fd9d70ca349, []int{3}
 }
 func (m *TokenCommittee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,6 +232,7 @@ func init() {
 	proto.RegisterEnum("kava.committee.v1beta1.TallyOption", TallyOption_name, TallyOption_value)
 	proto.RegisterType((*BaseCommittee)(nil), "kava.committee.v1beta1.BaseCommittee")
 	proto.RegisterType((*MemberCommittee)(nil), "kava.committee.v1beta1.MemberCommittee")
+	proto.RegisterType((*MemberWeight)(nil), "kava.committee.v1beta1.MemberWeight")
 	proto.RegisterType((*TokenCommittee)(nil), "kava.committee.v1beta1.TokenCommittee")
 }
 
@@ -195,48 +241,56 @@ func init() {
 }
 
 var fileDescriptor_a2549fd9d70ca349 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xb6, 0x93, 0x90, 0xd2, 0x75, 0x1b, 0xd2, 0xa5, 0x54, 0x4e, 0x85, 0x6c, 0xab, 0x40, 0x15,
-	0x81, 0x62, 0xab, 0xe1, 0xc6, 0x2d, 0xae, 0x13, 0xd5, 0x52, 0x69, 0x22, 0xc7, 0x3d, 0xc0, 0xc5,
-	0xb2, 0xe3, 0x25, 0xb5, 0x1a, 0x67, 0x83, 0x77, 0x53, 0x35, 0x6f, 0xc0, 0x91, 0x63, 0x8f, 0x48,
-	0xbc, 0x42, 0x1f, 0xa2, 0xea, 0xa9, 0xe2, 0x84, 0x38, 0x84, 0x92, 0x3e, 0x05, 0x9c, 0x90, 0xff,
-	0x1a, 0x17, 0x8a, 0x04, 0x07, 0x4e, 0xde, 0xfd, 0xe6, 0x9b, 0x99, 0xfd, 0x66, 0x3e, 0x19, 0x6c,
-	0x1e, 0xda, 0x47, 0xb6, 0xd2, 0xc3, 0xbe, 0xef, 0x51, 0x8a, 0x90, 0x72, 0xb4, 0xe5, 0x20, 0x6a,
-	0x6f, 0xcd, 0x11, 0x79, 0x14, 0x60, 0x8a, 0xe1, 0x5a, 0xc8, 0x93, 0xe7, 0x68, 0xc2, 0x5b, 0xaf,
-	0xf4, 0x30, 0xf1, 0x31, 0xb1, 0x22, 0x96, 0x12, 0x5f, 0xe2, 0x94, 0xf5, 0xd5, 0x3e, 0xee, 0xe3,
-	0x18, 0x0f, 0x4f, 0x09, 0x5a, 0xe9, 0x63, 0xdc, 0x1f, 0x20, 0x25, 0xba, 0x39, 0xe3, 0x37, 0x8a,
-	0x3d, 0x9c, 0x24, 0x21, 0xe1, 0xd7, 0x90, 0x3b, 0x0e, 0x6c, 0xea, 0xe1, 0x61, 0x1c, 0xdf, 0xf8,
-	0x9e, 0x07, 0xcb, 0xaa, 0x4d, 0xd0, 0x76, 0xfa, 0x0a, 0xb8, 0x06, 0x72, 0x9e, 0xcb, 0xb3, 0x12,
-	0x5b, 0x2d, 0xa8, 0xc5, 0xd9, 0x54, 0xcc, 0xe9, 0x9a, 0x91, 0xf3, 0x5c, 0x28, 0x01, 0xce, 0x45,
-	0xa4, 0x17, 0x78, 0xa3, 0x30, 0x9d, 0xcf, 0x49, 0x6c, 0x75, 0xd1, 0xc8, 0x42, 0xd0, 0x01, 0x0b,
-	0x3e, 0xf2, 0x1d, 0x14, 0x10, 0x3e, 0x2f, 0xe5, 0xab, 0x4b, 0xea, 0xce, 0x8f, 0xa9, 0x58, 0xeb,
-	0x7b, 0xf4, 0x60, 0xec, 0x84, 0x32, 0x13, 0x29, 0xc9, 0xa7, 0x46, 0xdc, 0x43, 0x85, 0x4e, 0x46,
-	0x88, 0xc8, 0x8d, 0x5e, 0xaf, 0xe1, 0xba, 0x01, 0x22, 0xe4, 0xd3, 0x69, 0xed, 0x7e, 0x22, 0x38,
-	0x41, 0xd4, 0x09, 0x45, 0xc4, 0x48, 0x0b, 0xc3, 0x16, 0xe0, 0x46, 0x28, 0xf0, 0x3d, 0x42, 0x3c,
-	0x3c, 0x24, 0x7c, 0x41, 0xca, 0x57, 0xb9, 0xfa, 0xaa, 0x1c, 0xab, 0x94, 0x53, 0x95, 0x72, 0x63,
-	0x38, 0x51, 0x4b, 0xe7, 0xa7, 0x35, 0xd0, 0xb9, 0x26, 0x1b, 0xd9, 0x44, 0xb8, 0x0f, 0x4a, 0x47,
-	0x98, 0x22, 0x8b, 0x1e, 0x04, 0x88, 0x1c, 0xe0, 0x81, 0xcb, 0xdf, 0x09, 0x05, 0xa9, 0xf2, 0xd9,
-	0x54, 0x64, 0xbe, 0x4c, 0xc5, 0xcd, 0xbf, 0x78, 0xb6, 0x86, 0x7a, 0xc6, 0x72, 0x58, 0xc5, 0x4c,
-	0x8b, 0xc0, 0x0e, 0x58, 0x19, 0x05, 0x78, 0x84, 0x89, 0x3d, 0xb0, 0xd2, 0x49, 0xf3, 0x45, 0x89,
-	0xad, 0x72, 0xf5, 0xca, 0x6f, 0x8f, 0xd4, 0x12, 0x82, 0x7a, 0x37, 0x6c, 0x7a, 0xf2, 0x55, 0x64,
-	0x8d, 0x72, 0x9a, 0x9d, 0xc6, 0x60, 0x0b, 0x2c, 0x51, 0x7b, 0x30, 0x98, 0x58, 0x38, 0x9e, 0xfb,
-	0x82, 0xc4, 0x56, 0x4b, 0xf5, 0x47, 0xf2, 0xed, 0xde, 0x91, 0xcd, 0x90, 0xdb, 0x8e, 0xa8, 0x06,
-	0x47, 0xe7, 0x97, 0x17, 0x2b, 0x27, 0x1f, 0x44, 0xe6, 0xfc, 0xb4, 0xb6, 0x78, 0xbd, 0xe9, 0x8d,
-	0x63, 0x70, 0xef, 0x65, 0x34, 0xd6, 0xf9, 0xf2, 0x0d, 0x50, 0x72, 0x6c, 0x82, 0xac, 0xeb, 0xc2,
-	0x91, 0x11, 0xb8, 0xfa, 0x93, 0x3f, 0xf5, 0xbb, 0xe1, 0x1d, 0xb5, 0x70, 0x31, 0x15, 0x59, 0x63,
-	0xd9, 0xc9, 0x82, 0xb7, 0x75, 0xbe, 0x64, 0x41, 0xc9, 0xc4, 0x87, 0x68, 0xf8, 0x5f, 0x3b, 0xc3,
-	0x16, 0x28, 0xbe, 0x1d, 0xe3, 0x60, 0xec, 0xc7, 0x6e, 0xfd, 0xe7, 0xe5, 0x26, 0xd9, 0x50, 0x04,
-	0xf1, 0x28, 0x2d, 0x17, 0x0d, 0xb1, 0xcf, 0xe7, 0x23, 0xeb, 0x83, 0x08, 0xd2, 0x42, 0xe4, 0x16,
-	0x89, 0x4f, 0x03, 0xc0, 0x65, 0x76, 0x01, 0x1f, 0x02, 0xde, 0x6c, 0xec, 0xee, 0xbe, 0xb2, 0xda,
-	0x1d, 0x53, 0x6f, 0xef, 0x59, 0xfb, 0x7b, 0xdd, 0x4e, 0x73, 0x5b, 0x6f, 0xe9, 0x4d, 0xad, 0xcc,
-	0xc0, 0xc7, 0x40, 0xba, 0x11, 0x6d, 0xe9, 0x46, 0xd7, 0xb4, 0x3a, 0x8d, 0xae, 0x69, 0x99, 0x3b,
-	0x4d, 0xab, 0xd3, 0xee, 0x9a, 0x65, 0x16, 0x56, 0xc0, 0x83, 0x1b, 0x2c, 0xad, 0xd9, 0xd0, 0x76,
-	0xf5, 0xbd, 0x66, 0x39, 0xb7, 0x5e, 0x78, 0xf7, 0x51, 0x60, 0x54, 0xfd, 0xec, 0x9b, 0xc0, 0x9c,
-	0xcd, 0x04, 0xf6, 0x62, 0x26, 0xb0, 0x97, 0x33, 0x81, 0x7d, 0x7f, 0x25, 0x30, 0x17, 0x57, 0x02,
-	0xf3, 0xf9, 0x4a, 0x60, 0x5e, 0x3f, 0xcb, 0xa8, 0x0e, 0x67, 0x5a, 0x1b, 0xd8, 0x0e, 0x89, 0x4e,
-	0xca, 0x71, 0xe6, 0x6f, 0x15, 0xc9, 0x77, 0x8a, 0x91, 0x4b, 0x9f, 0xff, 0x0c, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x0b, 0x28, 0x7d, 0xcc, 0x04, 0x00, 0x00,
+	// 769 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xda, 0x58,
+	0x14, 0xb6, 0x81, 0x90, 0xe4, 0x12, 0x7e, 0xe2, 0x64, 0x22, 0x13, 0x8d, 0x6c, 0x2b, 0x93, 0x89,
+	0xd0, 0x8c, 0x30, 0x0a, 0xb3, 0x9b, 0xcd, 0x08, 0xc7, 0xa0, 0x58, 0x62, 0x02, 0x35, 0x4e, 0xab,
+	0x76, 0x63, 0xd9, 0xf8, 0x16, 0xac, 0x60, 0x2e, 0xf5, 0x35, 0x34, 0xbc, 0x41, 0xd4, 0x55, 0x97,
+	0x59, 0x56, 0xea, 0x2b, 0xe4, 0x21, 0xa2, 0x74, 0x13, 0x75, 0x55, 0x75, 0x41, 0x53, 0xf2, 0x16,
+	0x5d, 0x55, 0xbe, 0x36, 0x7f, 0x6d, 0x22, 0x45, 0x55, 0xbb, 0xc2, 0xe7, 0x3b, 0xdf, 0x39, 0x3e,
+	0xdf, 0xe1, 0x3b, 0x00, 0xf6, 0x4e, 0x8c, 0x81, 0x51, 0x68, 0x22, 0xc7, 0xb1, 0x3d, 0x0f, 0xc2,
+	0xc2, 0x60, 0xdf, 0x84, 0x9e, 0xb1, 0x3f, 0x43, 0xc4, 0x9e, 0x8b, 0x3c, 0xc4, 0x6c, 0xf9, 0x3c,
+	0x71, 0x86, 0x86, 0xbc, 0xed, 0x6c, 0x13, 0x61, 0x07, 0x61, 0x9d, 0xb0, 0x0a, 0x41, 0x10, 0x94,
+	0x6c, 0x6f, 0xb6, 0x50, 0x0b, 0x05, 0xb8, 0xff, 0x14, 0xa2, 0xd9, 0x16, 0x42, 0xad, 0x0e, 0x2c,
+	0x90, 0xc8, 0xec, 0x3f, 0x2f, 0x18, 0xdd, 0x61, 0x98, 0xe2, 0xbe, 0x4d, 0x59, 0x7d, 0xd7, 0xf0,
+	0x6c, 0xd4, 0x0d, 0xf2, 0x3b, 0x67, 0x4b, 0x20, 0x29, 0x19, 0x18, 0x1e, 0x4c, 0xa6, 0x60, 0xb6,
+	0x40, 0xc4, 0xb6, 0x58, 0x5a, 0xa0, 0x73, 0x31, 0x29, 0x3e, 0x1e, 0xf1, 0x11, 0x45, 0x56, 0x23,
+	0xb6, 0xc5, 0x08, 0x20, 0x61, 0x41, 0xdc, 0x74, 0xed, 0x9e, 0x5f, 0xce, 0x46, 0x04, 0x3a, 0xb7,
+	0xaa, 0xce, 0x43, 0x8c, 0x09, 0x96, 0x1d, 0xe8, 0x98, 0xd0, 0xc5, 0x6c, 0x54, 0x88, 0xe6, 0xd6,
+	0xa4, 0xc3, 0x2f, 0x23, 0x3e, 0xdf, 0xb2, 0xbd, 0x76, 0xdf, 0xf4, 0x65, 0x86, 0x52, 0xc2, 0x8f,
+	0x3c, 0xb6, 0x4e, 0x0a, 0xde, 0xb0, 0x07, 0xb1, 0x58, 0x6a, 0x36, 0x4b, 0x96, 0xe5, 0x42, 0x8c,
+	0xdf, 0x5f, 0xe4, 0x37, 0x42, 0xc1, 0x21, 0x22, 0x0d, 0x3d, 0x88, 0xd5, 0x49, 0x63, 0xa6, 0x02,
+	0x12, 0x3d, 0xe8, 0x3a, 0x36, 0xc6, 0x36, 0xea, 0x62, 0x36, 0x26, 0x44, 0x73, 0x89, 0xe2, 0xa6,
+	0x18, 0xa8, 0x14, 0x27, 0x2a, 0xc5, 0x52, 0x77, 0x28, 0xa5, 0xae, 0x2e, 0xf2, 0xa0, 0x3e, 0x25,
+	0xab, 0xf3, 0x85, 0xcc, 0x31, 0x48, 0x0d, 0x90, 0x07, 0x75, 0xaf, 0xed, 0x42, 0xdc, 0x46, 0x1d,
+	0x8b, 0x5d, 0xf2, 0x05, 0x49, 0xe2, 0xe5, 0x88, 0xa7, 0x3e, 0x8e, 0xf8, 0xbd, 0x07, 0x8c, 0x2d,
+	0xc3, 0xa6, 0x9a, 0xf4, 0xbb, 0x68, 0x93, 0x26, 0x4c, 0x1d, 0xac, 0xf7, 0x5c, 0xd4, 0x43, 0xd8,
+	0xe8, 0xe8, 0x93, 0x4d, 0xb3, 0x71, 0x81, 0xce, 0x25, 0x8a, 0xd9, 0xef, 0x86, 0x94, 0x43, 0x82,
+	0xb4, 0xe2, 0xbf, 0xf4, 0xfc, 0x13, 0x4f, 0xab, 0x99, 0x49, 0xf5, 0x24, 0xc7, 0x54, 0xc0, 0x9a,
+	0x67, 0x74, 0x3a, 0x43, 0x1d, 0x05, 0x7b, 0x5f, 0x16, 0xe8, 0x5c, 0xaa, 0xf8, 0x87, 0x78, 0xb7,
+	0x77, 0x44, 0xcd, 0xe7, 0xd6, 0x08, 0x55, 0x4d, 0x78, 0xb3, 0x80, 0xa9, 0x82, 0x34, 0x3c, 0x85,
+	0xcd, 0xbe, 0x1f, 0xe8, 0x16, 0xec, 0x18, 0x43, 0x76, 0xe5, 0xe1, 0x73, 0xa5, 0xa6, 0xb5, 0xb2,
+	0x5f, 0xca, 0xfc, 0x07, 0xd6, 0x07, 0xd0, 0x43, 0xfa, 0x74, 0x00, 0xdd, 0xb6, 0xd8, 0x55, 0xe2,
+	0x99, 0x8d, 0xf1, 0x88, 0x4f, 0x3f, 0x86, 0x1e, 0x9a, 0x5a, 0x4a, 0x91, 0xd5, 0xf4, 0x60, 0x01,
+	0xb0, 0xfe, 0x5d, 0x3f, 0x7f, 0xc3, 0x53, 0x57, 0x17, 0xf9, 0xd5, 0x29, 0xb8, 0xf3, 0x8e, 0x06,
+	0xe9, 0xff, 0xc9, 0xd7, 0x3c, 0x33, 0xa3, 0x0a, 0x52, 0xa6, 0x81, 0xe1, 0xec, 0x3d, 0xc4, 0x98,
+	0x89, 0xe2, 0x9f, 0xf7, 0xe9, 0x5f, 0xf0, 0xb2, 0x14, 0xbb, 0x1e, 0xf1, 0xb4, 0x9a, 0x34, 0x17,
+	0x0c, 0xfe, 0x08, 0xa4, 0x02, 0x37, 0xe9, 0x2f, 0xa1, 0xdd, 0x6a, 0x7b, 0x98, 0x8d, 0x10, 0x17,
+	0xed, 0xde, 0xd7, 0x33, 0x18, 0xea, 0x09, 0x21, 0x4b, 0x31, 0x7f, 0x27, 0x6a, 0xd2, 0x99, 0xc3,
+	0xf0, 0x5d, 0x6a, 0x5e, 0xd1, 0x60, 0x6d, 0xbe, 0xd0, 0xbf, 0x0e, 0x23, 0xb0, 0x34, 0xd1, 0xf0,
+	0x53, 0xaf, 0x23, 0x6c, 0xcc, 0x6c, 0x81, 0x78, 0xa0, 0x89, 0x9c, 0x67, 0x4c, 0x0d, 0xa3, 0x9d,
+	0x1b, 0x1a, 0xa4, 0x34, 0x74, 0x02, 0xbb, 0xbf, 0x76, 0xb3, 0x15, 0x10, 0x7f, 0xd1, 0x47, 0x6e,
+	0xdf, 0x61, 0x23, 0x3f, 0x74, 0x4c, 0x61, 0x35, 0xc3, 0x83, 0xc0, 0xba, 0xba, 0x05, 0xbb, 0xc8,
+	0x61, 0xa3, 0xe4, 0xa7, 0x06, 0x10, 0x48, 0xf6, 0x91, 0x3b, 0xf6, 0xfd, 0x97, 0x0b, 0x12, 0x73,
+	0xde, 0x67, 0x7e, 0x07, 0xac, 0x56, 0xaa, 0x56, 0x9f, 0xea, 0xb5, 0xba, 0xa6, 0xd4, 0x8e, 0xf4,
+	0xe3, 0xa3, 0x46, 0xbd, 0x7c, 0xa0, 0x54, 0x94, 0xb2, 0x9c, 0xa1, 0x98, 0x5d, 0x20, 0x2c, 0x64,
+	0x2b, 0x8a, 0xda, 0xd0, 0xf4, 0x7a, 0xa9, 0xa1, 0xe9, 0xda, 0x61, 0x59, 0xaf, 0xd7, 0x1a, 0x5a,
+	0x86, 0x66, 0xb2, 0xe0, 0xb7, 0x05, 0x96, 0x5c, 0x2e, 0xc9, 0x55, 0xe5, 0xa8, 0x9c, 0x89, 0x6c,
+	0xc7, 0xce, 0xde, 0x72, 0x94, 0xa4, 0x5c, 0x7e, 0xe6, 0xa8, 0xcb, 0x31, 0x47, 0x5f, 0x8f, 0x39,
+	0xfa, 0x66, 0xcc, 0xd1, 0xaf, 0x6f, 0x39, 0xea, 0xfa, 0x96, 0xa3, 0x3e, 0xdc, 0x72, 0xd4, 0xb3,
+	0xbf, 0xe7, 0x54, 0xfb, 0x3b, 0xcd, 0x77, 0x0c, 0x13, 0x93, 0xa7, 0xc2, 0xe9, 0xdc, 0xbf, 0x03,
+	0x91, 0x6f, 0xc6, 0xc9, 0xf5, 0xfd, 0xf3, 0x75, 0x00, 0x82, 0x7c, 0xe2, 0xc5, 0x3c, 0x06, 0x00,
+	0x00,
 }
 
 func (m *BaseCommittee) Marshal() (dAtA []byte, err error) {
@@ -259,18 +313,31 @@ func (m *BaseCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.TallyOption != 0 {
-		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
+	if m.VetoCommitteeID != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.VetoCommitteeID))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x48
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposalDuration):])
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintCommittee(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x42
+	if m.TallyOption != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposalDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCommittee(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.VoteThreshold.Size()
@@ -340,6 +407,20 @@ func (m *MemberCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MemberWeights) > 0 {
+		for iNdEx := len(m.MemberWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommittee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.BaseCommittee != nil {
 		{
 			size, err := m.BaseCommittee.MarshalToSizedBuffer(dAtA[:i])
@@ -355,6 +436,41 @@ func (m *MemberCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MemberWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCommittee(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TokenCommittee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -450,6 +566,11 @@ func (m *BaseCommittee) Size() (n int) {
 	if m.TallyOption != 0 {
 		n += 1 + sovCommittee(uint64(m.TallyOption))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 1 + l + sovCommittee(uint64(l))
+	if m.VetoCommitteeID != 0 {
+		n += 1 + sovCommittee(uint64(m.VetoCommitteeID))
+	}
 	return n
 }
 
@@ -463,6 +584,28 @@ func (m *MemberCommittee) Size() (n int) {
 		l = m.BaseCommittee.Size()
 		n += 1 + l + sovCommittee(uint64(l))
 	}
+	if len(m.MemberWeights) > 0 {
+		for _, e := range m.MemberWeights {
+			l = e.Size()
+			n += 1 + l + sovCommittee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCommittee(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovCommittee(uint64(m.Weight))
+	}
 	return n
 }
 
@@ -723,6 +866,58 @@ func (m *BaseCommittee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCommitteeID", wireType)
+			}
+			m.VetoCommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoCommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
@@ -809,6 +1004,143 @@ func (m *MemberCommittee) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberWeights = append(m.MemberWeights, MemberWeight{})
+			if err := m.MemberWeights[len(m.MemberWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommittee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommittee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
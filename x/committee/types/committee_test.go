@@ -2,9 +2,11 @@ package types_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -184,6 +186,67 @@ func TestBaseCommittee(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "execution delay and veto committee",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				com.ExecutionDelay = time.Hour * 24
+				com.VetoCommitteeID = 2
+				return com, nil
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative execution delay",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				com.ExecutionDelay = -time.Hour
+				return com, nil
+			},
+			expectPass: false,
+		},
+		{
+			name: "committee is its own veto committee",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				com.VetoCommitteeID = 1
+				return com, nil
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -230,6 +293,95 @@ func TestMemberCommittee(t *testing.T) {
 			},
 			expectPass: true,
 		},
+		{
+			name: "weighted members",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				com.MemberWeights = []types.MemberWeight{
+					types.NewMemberWeight(addresses[0], 3),
+				}
+				return com, nil
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero member weight",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				com.MemberWeights = []types.MemberWeight{
+					types.NewMemberWeight(addresses[0], 0),
+				}
+				return com, nil
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate member weight",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				com.MemberWeights = []types.MemberWeight{
+					types.NewMemberWeight(addresses[0], 2),
+					types.NewMemberWeight(addresses[0], 3),
+				}
+				return com, nil
+			},
+			expectPass: false,
+		},
+		{
+			name: "weight for non-member",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				com.MemberWeights = []types.MemberWeight{
+					types.NewMemberWeight(addresses[2], 2),
+				}
+				return com, nil
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -248,6 +400,44 @@ func TestMemberCommittee(t *testing.T) {
 	}
 }
 
+func TestMemberCommittee_Weights(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest3"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest4"))),
+	}
+	com := types.MustNewMemberCommittee(
+		1,
+		"This member committee is for testing.",
+		addresses[:3],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	require.Equal(t, uint64(1), com.GetMemberWeight(addresses[0]))
+	require.Equal(t, uint64(0), com.GetMemberWeight(addresses[3]))
+	require.Equal(t, sdkmath.NewInt(3), com.GetTotalWeight())
+
+	com.SetMemberWeight(addresses[0], 5)
+	com.SetMemberWeight(addresses[0], 4)
+	require.Len(t, com.MemberWeights, 1)
+	require.Equal(t, uint64(4), com.GetMemberWeight(addresses[0]))
+	require.Equal(t, sdkmath.NewInt(6), com.GetTotalWeight())
+
+	com.RemoveMember(addresses[0])
+	require.False(t, com.HasMember(addresses[0]))
+	require.Empty(t, com.MemberWeights)
+	require.Equal(t, sdkmath.NewInt(2), com.GetTotalWeight())
+
+	// the total weight does not overflow
+	com.SetMemberWeight(addresses[1], math.MaxUint64)
+	com.SetMemberWeight(addresses[2], math.MaxUint64)
+	expected := sdkmath.NewIntFromUint64(math.MaxUint64).MulRaw(2)
+	require.Equal(t, expected, com.GetTotalWeight())
+}
+
 // TestTokenCommittee tests unique TokenCommittee functionality
 func TestTokenCommittee(t *testing.T) {
 	addresses := []sdk.AccAddress{
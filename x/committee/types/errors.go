@@ -14,4 +14,5 @@ var (
 	ErrUnknownSubspace         = errorsmod.Register(ModuleName, 10, "subspace not found")
 	ErrInvalidVoteType         = errorsmod.Register(ModuleName, 11, "invalid vote type")
 	ErrNotFoundProposalTally   = errorsmod.Register(ModuleName, 12, "proposal tally not found")
+	ErrUnknownQueuedProposal   = errorsmod.Register(ModuleName, 13, "queued proposal not found")
 )
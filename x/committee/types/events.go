@@ -5,6 +5,10 @@ const (
 	EventTypeProposalSubmit = "proposal_submit"
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
+	EventTypeProposalQueue  = "proposal_queue"
+	EventTypeProposalEnact  = "proposal_enact"
+	EventTypeProposalVeto   = "proposal_veto"
+	EventTypeMembersUpdate  = "committee_members_update"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
@@ -15,4 +19,7 @@ const (
 	AttributeKeyVote                = "vote"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
+	AttributeKeyExecutionTime       = "execution_time"
+	AttributeKeyMemberAdded         = "member_added"
+	AttributeKeyMemberRemoved       = "member_removed"
 )
@@ -56,7 +56,7 @@ func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 			return err
 		}
 	}
-	return nil
+	return data.QueuedProposals.UnpackInterfaces(unpacker)
 }
 
 // Validate performs basic validation of genesis data.
@@ -79,6 +79,12 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+	for _, com := range committees {
+		// check veto committee exists
+		if vetoID := com.GetVetoCommitteeID(); vetoID != 0 && !committeeMap[vetoID] {
+			return fmt.Errorf("committee %d refers to non existent veto committee; committee id: %d", com.GetID(), vetoID)
+		}
+	}
 
 	// validate proposals
 	proposalMap := make(map[uint64]bool, len(gs.Proposals))
@@ -105,6 +111,31 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// validate queued proposals
+	queuedProposalMap := make(map[uint64]bool, len(gs.QueuedProposals))
+	for _, qp := range gs.QueuedProposals {
+		// check there are no duplicate IDs, including IDs of proposals still being voted on
+		if proposalMap[qp.Proposal.ID] || queuedProposalMap[qp.Proposal.ID] {
+			return fmt.Errorf("duplicate proposal ID found in genesis state; id: %d", qp.Proposal.ID)
+		}
+		queuedProposalMap[qp.Proposal.ID] = true
+
+		// validate next proposal ID
+		if qp.Proposal.ID >= gs.NextProposalID {
+			return fmt.Errorf("NextProposalID is not greater than all proposal IDs; id: %d", qp.Proposal.ID)
+		}
+
+		// check committee exists
+		if !committeeMap[qp.Proposal.CommitteeID] {
+			return fmt.Errorf("queued proposal refers to non existent committee; committee id: %d", qp.Proposal.CommitteeID)
+		}
+
+		// validate pubProposal
+		if err := qp.Proposal.ValidateBasic(); err != nil {
+			return fmt.Errorf("queued proposal %d invalid: %w", qp.Proposal.ID, err)
+		}
+	}
+
 	// validate votes
 	for _, v := range gs.Votes {
 		// validate committee
@@ -68,10 +68,11 @@ func (VoteType) EnumDescriptor() ([]byte, []int) {
 
 // GenesisState defines the committee module's genesis state.
 type GenesisState struct {
-	NextProposalID uint64       `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
-	Committees     []*types.Any `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
-	Proposals      Proposals    `protobuf:"bytes,3,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
-	Votes          []Vote       `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	NextProposalID  uint64          `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
+	Committees      []*types.Any    `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	Proposals       Proposals       `protobuf:"bytes,3,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
+	Votes           []Vote          `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	QueuedProposals QueuedProposals `protobuf:"bytes,5,rep,name=queued_proposals,json=queuedProposals,proto3,castrepeated=QueuedProposals" json:"queued_proposals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -147,6 +148,44 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// QueuedProposal is an internal record of a passed proposal waiting for its committee's execution delay to elapse.
+type QueuedProposal struct {
+	Proposal      Proposal  `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+	ExecutionTime time.Time `protobuf:"bytes,2,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+}
+
+func (m *QueuedProposal) Reset()      { *m = QueuedProposal{} }
+func (*QueuedProposal) ProtoMessage() {}
+func (*QueuedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_919b27ac60d8c5fd, []int{2}
+}
+func (m *QueuedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedProposal.Merge(m, src)
+}
+func (m *QueuedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedProposal proto.InternalMessageInfo
+
 // Vote is an internal record of a single governance vote.
 type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
@@ -158,7 +197,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_919b27ac60d8c5fd, []int{2}
+	return fileDescriptor_919b27ac60d8c5fd, []int{3}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,6 +230,7 @@ func init() {
 	proto.RegisterEnum("kava.committee.v1beta1.VoteType", VoteType_name, VoteType_value)
 	proto.RegisterType((*GenesisState)(nil), "kava.committee.v1beta1.GenesisState")
 	proto.RegisterType((*Proposal)(nil), "kava.committee.v1beta1.Proposal")
+	proto.RegisterType((*QueuedProposal)(nil), "kava.committee.v1beta1.QueuedProposal")
 	proto.RegisterType((*Vote)(nil), "kava.committee.v1beta1.Vote")
 }
 
@@ -199,48 +239,53 @@ func init() {
 }
 
 var fileDescriptor_919b27ac60d8c5fd = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x1d, 0x53, 0x92, 0x4b, 0x1a, 0xd2, 0xa3, 0xad, 0xd2, 0x08, 0xd9, 0x55, 0xc5, 0x50,
-	0x81, 0x62, 0xab, 0x65, 0x41, 0x15, 0x48, 0xc4, 0x49, 0x00, 0x2f, 0x69, 0x71, 0x42, 0xa5, 0x32,
-	0x10, 0x39, 0xf1, 0x61, 0xac, 0x26, 0xbe, 0x28, 0x77, 0x8d, 0x9a, 0x6f, 0xd0, 0xb1, 0x23, 0x23,
-	0x12, 0x4c, 0xcc, 0xfd, 0x10, 0x55, 0xa7, 0x8a, 0x89, 0x01, 0xb9, 0xc8, 0xfd, 0x06, 0x8c, 0x4c,
-	0xe8, 0xce, 0x7f, 0x12, 0x51, 0x3a, 0xf9, 0xdd, 0x7b, 0xbf, 0xf7, 0xe7, 0xf7, 0x7b, 0x4f, 0x06,
-	0x0f, 0x0f, 0xed, 0x89, 0xad, 0xf7, 0xf1, 0x70, 0xe8, 0x51, 0x8a, 0x90, 0x3e, 0xd9, 0xea, 0x21,
-	0x6a, 0x6f, 0xe9, 0x2e, 0xf2, 0x11, 0xf1, 0x88, 0x36, 0x1a, 0x63, 0x8a, 0xe1, 0x2a, 0x43, 0x69,
-	0x29, 0x4a, 0x8b, 0x51, 0x95, 0xb5, 0x3e, 0x26, 0x43, 0x4c, 0xba, 0x1c, 0xa5, 0x47, 0x8f, 0x28,
-	0xa5, 0xb2, 0xec, 0x62, 0x17, 0x47, 0x7e, 0x66, 0xc5, 0xde, 0x35, 0x17, 0x63, 0x77, 0x80, 0x74,
-	0xfe, 0xea, 0x1d, 0x7d, 0xd0, 0x6d, 0x7f, 0x1a, 0x87, 0xd4, 0x7f, 0x43, 0xd4, 0x1b, 0x22, 0x42,
-	0xed, 0xe1, 0x28, 0x02, 0x6c, 0x7c, 0x95, 0x40, 0xe1, 0x55, 0x34, 0x56, 0x9b, 0xda, 0x14, 0xc1,
-	0x67, 0xa0, 0xe4, 0xa3, 0x63, 0xca, 0xba, 0x8f, 0x30, 0xb1, 0x07, 0x5d, 0xcf, 0x29, 0x8b, 0xeb,
-	0xe2, 0xa6, 0x6c, 0xc0, 0x30, 0x50, 0x8b, 0x2d, 0x74, 0x4c, 0xf7, 0xe2, 0x90, 0xd9, 0xb0, 0x8a,
-	0xfe, 0xfc, 0xdb, 0x81, 0x75, 0x00, 0x52, 0x42, 0xa4, 0x2c, 0xad, 0x67, 0x36, 0xf3, 0xdb, 0xcb,
-	0x5a, 0x34, 0x84, 0x96, 0x0c, 0xa1, 0xd5, 0xfc, 0xa9, 0xb1, 0x78, 0x71, 0x56, 0xcd, 0xd5, 0x13,
-	0xac, 0x35, 0x97, 0x06, 0xdf, 0x80, 0x5c, 0xd2, 0x9d, 0x94, 0x33, 0xbc, 0xc6, 0xba, 0xf6, 0x7f,
-	0xb1, 0xb4, 0xa4, 0xb7, 0xb1, 0x74, 0x1e, 0xa8, 0xc2, 0xb7, 0x2b, 0x35, 0x97, 0x78, 0x88, 0x35,
-	0xab, 0x02, 0x9f, 0x82, 0x3b, 0x13, 0x4c, 0x11, 0x29, 0xcb, 0xbc, 0xdc, 0x83, 0xdb, 0xca, 0xed,
-	0x63, 0x8a, 0x0c, 0x99, 0x95, 0xb2, 0xa2, 0x84, 0x1d, 0xf9, 0xe4, 0xb3, 0x2a, 0x6c, 0xfc, 0x16,
-	0x41, 0x36, 0x29, 0x0c, 0x5b, 0xe0, 0x6e, 0x1f, 0xfb, 0x14, 0xf9, 0x94, 0x2b, 0x73, 0x1b, 0x43,
-	0xe5, 0xe2, 0xac, 0x5a, 0x89, 0xd7, 0xe7, 0xe2, 0x49, 0xda, 0xa3, 0x1e, 0xe5, 0x5a, 0x49, 0x11,
-	0xb8, 0x0a, 0x24, 0xcf, 0x29, 0x4b, 0x5c, 0xe4, 0x85, 0x30, 0x50, 0x25, 0xb3, 0x61, 0x49, 0x9e,
-	0x03, 0xb7, 0x41, 0x21, 0x9d, 0x90, 0xad, 0x21, 0xc3, 0x11, 0xf7, 0xc2, 0x40, 0xcd, 0xa7, 0xc2,
-	0x99, 0x0d, 0x2b, 0x9f, 0x82, 0x4c, 0x07, 0xbe, 0x00, 0x59, 0x07, 0xd9, 0xce, 0xc0, 0xf3, 0x51,
-	0x59, 0xe6, 0xc3, 0x55, 0x6e, 0x0c, 0xd7, 0x49, 0x6e, 0xc0, 0xc8, 0x32, 0xa6, 0xa7, 0x57, 0xaa,
-	0x68, 0xa5, 0x59, 0x3b, 0x59, 0x46, 0xf8, 0x13, 0x23, 0xfd, 0x53, 0x04, 0x32, 0x13, 0x04, 0xea,
-	0x20, 0x7f, 0xf3, 0x1c, 0x8a, 0x61, 0xa0, 0x82, 0xb9, 0x53, 0x00, 0xa3, 0xd9, 0x19, 0xbc, 0x8f,
-	0xe4, 0x1e, 0x73, 0x52, 0x05, 0xe3, 0xf5, 0x9f, 0x40, 0xad, 0xba, 0x1e, 0xfd, 0x78, 0xd4, 0x63,
-	0x9a, 0xc7, 0x37, 0x1d, 0x7f, 0xaa, 0xc4, 0x39, 0xd4, 0xe9, 0x74, 0x84, 0x88, 0x56, 0xeb, 0xf7,
-	0x6b, 0x8e, 0x33, 0x46, 0x84, 0x7c, 0x3f, 0xab, 0xde, 0x8f, 0xa5, 0x8b, 0x3d, 0xc6, 0x94, 0x22,
-	0x12, 0x2d, 0x65, 0x0c, 0x9f, 0x83, 0x1c, 0x33, 0xba, 0x2c, 0x8d, 0xcb, 0x52, 0xbc, 0xfd, 0x42,
-	0x18, 0x83, 0xce, 0x74, 0x84, 0xac, 0xec, 0x24, 0xb6, 0xa2, 0x9d, 0x3e, 0x72, 0x41, 0x36, 0x89,
-	0xc1, 0x35, 0xb0, 0xb2, 0xbf, 0xdb, 0x69, 0x76, 0x3b, 0x07, 0x7b, 0xcd, 0xee, 0xdb, 0x56, 0x7b,
-	0xaf, 0x59, 0x37, 0x5f, 0x9a, 0xcd, 0x46, 0x49, 0x80, 0x4b, 0x60, 0x71, 0x16, 0x3a, 0x68, 0xb6,
-	0x4b, 0x22, 0x2c, 0x81, 0xc2, 0xcc, 0xd5, 0xda, 0x2d, 0x49, 0x70, 0x05, 0x2c, 0xcd, 0x3c, 0x35,
-	0xa3, 0xdd, 0xa9, 0x99, 0xad, 0x52, 0xa6, 0x22, 0x9f, 0x7c, 0x51, 0x04, 0xa3, 0x79, 0x1e, 0x2a,
-	0xe2, 0x65, 0xa8, 0x88, 0xbf, 0x42, 0x45, 0x3c, 0xbd, 0x56, 0x84, 0xcb, 0x6b, 0x45, 0xf8, 0x71,
-	0xad, 0x08, 0xef, 0x1e, 0xcf, 0x89, 0xc2, 0xc6, 0xaf, 0x0e, 0xec, 0x1e, 0xe1, 0x96, 0x7e, 0x3c,
-	0xf7, 0xff, 0xe0, 0xea, 0xf4, 0x16, 0xf8, 0x02, 0x9f, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x6a,
-	0x30, 0x5b, 0x09, 0x5e, 0x04, 0x00, 0x00,
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
+	0x14, 0xc6, 0x40, 0x72, 0x61, 0x20, 0x84, 0xcc, 0x4d, 0x72, 0x09, 0xba, 0xb2, 0xa3, 0xe8, 0xea,
+	0x2a, 0x6a, 0x85, 0xad, 0xa4, 0x9b, 0x2a, 0x6a, 0xa5, 0x62, 0xa0, 0x2d, 0xaa, 0x44, 0x12, 0x43,
+	0x23, 0xa5, 0x8b, 0x5a, 0x06, 0x4f, 0x5d, 0x37, 0xe0, 0x21, 0xcc, 0x80, 0xe0, 0x0d, 0xb2, 0xcc,
+	0xb2, 0xcb, 0x4a, 0xdd, 0xb5, 0xdb, 0x3c, 0x44, 0x94, 0x55, 0xd4, 0x55, 0x17, 0x15, 0xa9, 0x9c,
+	0x37, 0xe8, 0xb2, 0xab, 0x6a, 0xc6, 0x3f, 0x90, 0xa6, 0xa8, 0xea, 0xca, 0x33, 0xdf, 0xf9, 0xce,
+	0x99, 0xef, 0xfc, 0x19, 0xfc, 0x77, 0x64, 0x0c, 0x0c, 0xa5, 0x85, 0x3b, 0x1d, 0x9b, 0x52, 0x84,
+	0x94, 0xc1, 0x56, 0x13, 0x51, 0x63, 0x4b, 0xb1, 0x90, 0x83, 0x88, 0x4d, 0xe4, 0x6e, 0x0f, 0x53,
+	0x0c, 0x57, 0x19, 0x4b, 0x0e, 0x59, 0xb2, 0xcf, 0xca, 0xaf, 0xb5, 0x30, 0xe9, 0x60, 0xa2, 0x73,
+	0x96, 0xe2, 0x5d, 0x3c, 0x97, 0xfc, 0xb2, 0x85, 0x2d, 0xec, 0xe1, 0xec, 0xe4, 0xa3, 0x6b, 0x16,
+	0xc6, 0x56, 0x1b, 0x29, 0xfc, 0xd6, 0xec, 0xbf, 0x52, 0x0c, 0x67, 0xe4, 0x9b, 0xa4, 0x9f, 0x4d,
+	0xd4, 0xee, 0x20, 0x42, 0x8d, 0x4e, 0xd7, 0x23, 0x6c, 0x9c, 0xc6, 0x40, 0xfa, 0x89, 0x27, 0xab,
+	0x4e, 0x0d, 0x8a, 0xe0, 0x03, 0x90, 0x75, 0xd0, 0x90, 0xb2, 0xd7, 0xbb, 0x98, 0x18, 0x6d, 0xdd,
+	0x36, 0x73, 0xc2, 0xba, 0xb0, 0x19, 0x57, 0xa1, 0x3b, 0x96, 0x32, 0x35, 0x34, 0xa4, 0x7b, 0xbe,
+	0xa9, 0x5a, 0xd6, 0x32, 0xce, 0xf4, 0xdd, 0x84, 0x25, 0x00, 0xc2, 0x84, 0x48, 0x2e, 0xba, 0x1e,
+	0xdb, 0x4c, 0x6d, 0x2f, 0xcb, 0x9e, 0x08, 0x39, 0x10, 0x21, 0x17, 0x9d, 0x91, 0xba, 0x70, 0x71,
+	0x56, 0x48, 0x96, 0x02, 0xae, 0x36, 0xe5, 0x06, 0xf7, 0x41, 0x32, 0x78, 0x9d, 0xe4, 0x62, 0x3c,
+	0xc6, 0xba, 0xfc, 0xeb, 0x62, 0xc9, 0xc1, 0xdb, 0xea, 0xd2, 0xf9, 0x58, 0x8a, 0x7c, 0xb8, 0x92,
+	0x92, 0x01, 0x42, 0xb4, 0x49, 0x14, 0x78, 0x1f, 0xcc, 0x0d, 0x30, 0x45, 0x24, 0x17, 0xe7, 0xe1,
+	0xfe, 0x9d, 0x15, 0xee, 0x00, 0x53, 0xa4, 0xc6, 0x59, 0x28, 0xcd, 0x73, 0x80, 0x6f, 0x40, 0xf6,
+	0xb8, 0x8f, 0xfa, 0xc8, 0xd4, 0x27, 0x9a, 0xe6, 0x78, 0x90, 0xff, 0x67, 0x05, 0xd9, 0xe7, 0xfc,
+	0x50, 0xd9, 0x3f, 0xbe, 0xb2, 0xc5, 0x9b, 0x38, 0xd1, 0x16, 0x8f, 0x6f, 0x02, 0x3b, 0xf1, 0x93,
+	0x77, 0x52, 0x64, 0xe3, 0x9b, 0x00, 0x12, 0x01, 0x06, 0x6b, 0xe0, 0xaf, 0x16, 0x76, 0x28, 0x72,
+	0x28, 0xef, 0xc2, 0xac, 0x6a, 0x8a, 0x17, 0x67, 0x85, 0xbc, 0x3f, 0x2a, 0x16, 0x1e, 0x84, 0x52,
+	0x4a, 0x9e, 0xaf, 0x16, 0x04, 0x81, 0xab, 0x20, 0x6a, 0x9b, 0xb9, 0x28, 0x6f, 0xe8, 0xbc, 0x3b,
+	0x96, 0xa2, 0xd5, 0xb2, 0x16, 0xb5, 0x4d, 0xb8, 0x0d, 0xd2, 0x61, 0x22, 0xac, 0xe5, 0x31, 0xce,
+	0x58, 0x74, 0xc7, 0x52, 0x2a, 0x6c, 0x52, 0xb5, 0xac, 0xa5, 0x42, 0x52, 0xd5, 0x84, 0x8f, 0x40,
+	0xc2, 0x44, 0x86, 0xd9, 0xb6, 0x1d, 0x94, 0x8b, 0x73, 0x71, 0xf9, 0x5b, 0xe2, 0x1a, 0xc1, 0xbc,
+	0xa9, 0x09, 0x56, 0x86, 0xd3, 0x2b, 0x49, 0xd0, 0x42, 0xaf, 0x9d, 0x04, 0x4b, 0xf8, 0x2d, 0x4b,
+	0xfa, 0xa3, 0x00, 0x32, 0x37, 0xeb, 0x03, 0x55, 0x90, 0x08, 0x4a, 0xee, 0xe7, 0xfe, 0xfb, 0x29,
+	0xf0, 0x5a, 0x17, 0xfa, 0xc1, 0x67, 0x20, 0x83, 0x86, 0xa8, 0xd5, 0xa7, 0x36, 0x76, 0x74, 0x36,
+	0xfb, 0xb9, 0xe8, 0x1f, 0x08, 0x5d, 0x08, 0x7d, 0x99, 0x75, 0x4a, 0xed, 0x17, 0x01, 0xc4, 0xd9,
+	0xa8, 0x40, 0x05, 0xa4, 0x6e, 0x2f, 0x4a, 0xc6, 0x1d, 0x4b, 0x60, 0x6a, 0x49, 0x40, 0x77, 0xb2,
+	0x20, 0x2f, 0xbd, 0x41, 0xec, 0x71, 0x1d, 0x69, 0xf5, 0xe9, 0xf7, 0xb1, 0x54, 0xb0, 0x6c, 0xfa,
+	0xba, 0xdf, 0x64, 0x69, 0xf9, 0xdb, 0xee, 0x7f, 0x0a, 0xc4, 0x3c, 0x52, 0xe8, 0xa8, 0x8b, 0x88,
+	0x5c, 0x6c, 0xb5, 0x8a, 0xa6, 0xd9, 0x43, 0x84, 0x7c, 0x3a, 0x2b, 0xfc, 0xed, 0x37, 0xda, 0x47,
+	0xd4, 0x11, 0x45, 0xc4, 0x1b, 0xd7, 0x1e, 0x7c, 0x08, 0x92, 0xec, 0xa0, 0x33, 0x37, 0xde, 0xc4,
+	0xcc, 0xec, 0xaa, 0xb1, 0x0c, 0x1a, 0xa3, 0x2e, 0xd2, 0x12, 0x03, 0xff, 0xe4, 0x4d, 0xe0, 0x1d,
+	0x0b, 0x24, 0x02, 0x1b, 0x5c, 0x03, 0x2b, 0x07, 0xbb, 0x8d, 0x8a, 0xde, 0x38, 0xdc, 0xab, 0xe8,
+	0xcf, 0x6b, 0xf5, 0xbd, 0x4a, 0xa9, 0xfa, 0xb8, 0x5a, 0x29, 0x67, 0x23, 0x70, 0x09, 0x2c, 0x4c,
+	0x4c, 0x87, 0x95, 0x7a, 0x56, 0x80, 0x59, 0x90, 0x9e, 0x40, 0xb5, 0xdd, 0x6c, 0x14, 0xae, 0x80,
+	0xa5, 0x09, 0x52, 0x54, 0xeb, 0x8d, 0x62, 0xb5, 0x96, 0x8d, 0xe5, 0xe3, 0x27, 0xef, 0xc5, 0x88,
+	0x5a, 0x39, 0x77, 0x45, 0xe1, 0xd2, 0x15, 0x85, 0xaf, 0xae, 0x28, 0x9c, 0x5e, 0x8b, 0x91, 0xcb,
+	0x6b, 0x31, 0xf2, 0xf9, 0x5a, 0x8c, 0xbc, 0xb8, 0x3b, 0x55, 0x14, 0x26, 0xbf, 0xd0, 0x36, 0x9a,
+	0x84, 0x9f, 0x94, 0xe1, 0xd4, 0x9f, 0x95, 0x57, 0xa7, 0x39, 0xcf, 0xbb, 0x78, 0xef, 0xc7, 0x00,
+	0xc1, 0x7f, 0x30, 0x8c, 0x78, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -263,6 +308,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueuedProposals) > 0 {
+		for iNdEx := len(m.QueuedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -366,6 +425,47 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueuedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -444,6 +544,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.QueuedProposals) > 0 {
+		for _, e := range m.QueuedProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -468,6 +574,19 @@ func (m *Proposal) Size() (n int) {
 	return n
 }
 
+func (m *QueuedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -643,6 +762,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedProposals = append(m.QueuedProposals, QueuedProposal{})
+			if err := m.QueuedProposals[len(m.QueuedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -821,6 +974,122 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueuedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
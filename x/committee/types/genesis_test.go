@@ -102,6 +102,34 @@ func TestGenesisState_Validate(t *testing.T) {
 			),
 			expectPass: false,
 		},
+		{
+			name: "veto committee",
+			genState: func() *types.GenesisState {
+				com := types.MustNewMemberCommittee(4, "This committee can be vetoed.", addresses[:3], nil, testutil.D("0.667"), time.Hour, types.TALLY_OPTION_FIRST_PAST_THE_POST)
+				com.VetoCommitteeID = 1
+				return types.NewGenesisState(
+					testGenesis.NextProposalID,
+					append(testGenesis.GetCommittees(), com),
+					testGenesis.Proposals,
+					testGenesis.Votes,
+				)
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "non existent veto committee",
+			genState: func() *types.GenesisState {
+				com := types.MustNewMemberCommittee(4, "This committee can be vetoed.", addresses[:3], nil, testutil.D("0.667"), time.Hour, types.TALLY_OPTION_FIRST_PAST_THE_POST)
+				com.VetoCommitteeID = 47
+				return types.NewGenesisState(
+					testGenesis.NextProposalID,
+					append(testGenesis.GetCommittees(), com),
+					testGenesis.Proposals,
+					testGenesis.Votes,
+				)
+			}(),
+			expectPass: false,
+		},
 		{
 			name: "duplicate proposal IDs",
 			genState: types.NewGenesisState(
@@ -169,6 +197,57 @@ func TestGenesisState_Validate(t *testing.T) {
 				append(testGenesis.Votes, types.Vote{}),
 			),
 			expectPass: false,
+		}, {
+			name: "queued proposal",
+			genState: func() *types.GenesisState {
+				gs := types.NewGenesisState(
+					testGenesis.NextProposalID+1,
+					testGenesis.GetCommittees(),
+					testGenesis.Proposals,
+					testGenesis.Votes,
+				)
+				gs.QueuedProposals = types.QueuedProposals{
+					types.NewQueuedProposal(types.MustNewProposal(
+						govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 2, 1, testTime,
+					), testTime.Add(24*time.Hour)),
+				}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "queued proposal with duplicate proposal ID",
+			genState: func() *types.GenesisState {
+				gs := types.NewGenesisState(
+					testGenesis.NextProposalID,
+					testGenesis.GetCommittees(),
+					testGenesis.Proposals,
+					testGenesis.Votes,
+				)
+				gs.QueuedProposals = types.QueuedProposals{
+					types.NewQueuedProposal(testGenesis.Proposals[0], testTime.Add(24*time.Hour)),
+				}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "queued proposal without committee",
+			genState: func() *types.GenesisState {
+				gs := types.NewGenesisState(
+					testGenesis.NextProposalID+1,
+					testGenesis.GetCommittees(),
+					testGenesis.Proposals,
+					testGenesis.Votes,
+				)
+				gs.QueuedProposals = types.QueuedProposals{
+					types.NewQueuedProposal(types.MustNewProposal(
+						govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 2, 47, testTime,
+					), testTime.Add(24*time.Hour)),
+				}
+				return gs
+			}(),
+			expectPass: false,
 		},
 	}
 
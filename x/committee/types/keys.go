@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -27,6 +28,8 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	QueuedProposalKeyPrefix = []byte{0x04} // prefix for keys that store passed proposals waiting to be enacted
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -38,6 +41,11 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+// GetCommitteeAddress returns the address a committee acts as when executing messages through its own proposals.
+func GetCommitteeAddress(committeeID uint64) sdk.AccAddress {
+	return address.Module(ModuleName, GetKeyFromID(committeeID))
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
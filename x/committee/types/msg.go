@@ -6,17 +6,19 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/gogoproto/proto"
 )
 
 const (
-	TypeMsgSubmitProposal = "commmittee_submit_proposal" // 'committee' prefix appended to avoid potential conflicts with gov msg types
-	TypeMsgVote           = "committee_vote"
+	TypeMsgSubmitProposal         = "commmittee_submit_proposal" // 'committee' prefix appended to avoid potential conflicts with gov msg types
+	TypeMsgVote                   = "committee_vote"
+	TypeMsgUpdateCommitteeMembers = "committee_update_committee_members"
 )
 
 var (
-	_, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}
-	_    types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}, &MsgUpdateCommitteeMembers{}
+	_       types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
@@ -151,3 +153,66 @@ func (msg MsgVote) GetVoter() sdk.AccAddress {
 	}
 	return address
 }
+
+// NewMsgUpdateCommitteeMembers creates a message to add, remove, or reweight the members of a member committee
+func NewMsgUpdateCommitteeMembers(authority sdk.AccAddress, committeeID uint64, addMembers []MemberWeight, removeMembers []sdk.AccAddress,
+) *MsgUpdateCommitteeMembers {
+	return &MsgUpdateCommitteeMembers{
+		Authority:     authority.String(),
+		CommitteeID:   committeeID,
+		AddMembers:    addMembers,
+		RemoveMembers: removeMembers,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgUpdateCommitteeMembers) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgUpdateCommitteeMembers) Type() string { return TypeMsgUpdateCommitteeMembers }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgUpdateCommitteeMembers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if len(msg.AddMembers) == 0 && len(msg.RemoveMembers) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no members to add or remove")
+	}
+
+	seen := make(map[string]bool, len(msg.AddMembers)+len(msg.RemoveMembers))
+	for _, mw := range msg.AddMembers {
+		if err := mw.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if seen[mw.Address.String()] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate member %s", mw.Address)
+		}
+		seen[mw.Address.String()] = true
+	}
+	for _, m := range msg.RemoveMembers {
+		if m.Empty() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "member address cannot be empty")
+		}
+		if seen[m.String()] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate member %s", m)
+		}
+		seen[m.String()] = true
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgUpdateCommitteeMembers) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgUpdateCommitteeMembers) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
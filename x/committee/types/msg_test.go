@@ -107,3 +107,61 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateCommitteeMembers_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1")))
+	member := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2")))
+	tests := []struct {
+		name       string
+		msg        *MsgUpdateCommitteeMembers
+		expectPass bool
+	}{
+		{
+			name:       "normal add",
+			msg:        NewMsgUpdateCommitteeMembers(authority, 1, []MemberWeight{NewMemberWeight(member, 2)}, nil),
+			expectPass: true,
+		},
+		{
+			name:       "normal remove",
+			msg:        NewMsgUpdateCommitteeMembers(authority, 1, nil, []sdk.AccAddress{member}),
+			expectPass: true,
+		},
+		{
+			name:       "empty authority",
+			msg:        NewMsgUpdateCommitteeMembers(nil, 1, []MemberWeight{NewMemberWeight(member, 2)}, nil),
+			expectPass: false,
+		},
+		{
+			name:       "no changes",
+			msg:        NewMsgUpdateCommitteeMembers(authority, 1, nil, nil),
+			expectPass: false,
+		},
+		{
+			name:       "zero weight",
+			msg:        NewMsgUpdateCommitteeMembers(authority, 1, []MemberWeight{NewMemberWeight(member, 0)}, nil),
+			expectPass: false,
+		},
+		{
+			name:       "member added and removed",
+			msg:        NewMsgUpdateCommitteeMembers(authority, 1, []MemberWeight{NewMemberWeight(member, 2)}, []sdk.AccAddress{member}),
+			expectPass: false,
+		},
+		{
+			name:       "empty removed member",
+			msg:        NewMsgUpdateCommitteeMembers(authority, 1, nil, []sdk.AccAddress{{}}),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
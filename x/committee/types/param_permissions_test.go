@@ -15,7 +15,10 @@ import (
 	"github.com/kava-labs/kava/app"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	types "github.com/kava-labs/kava/x/committee/types"
+	evmutiltestutil "github.com/kava-labs/kava/x/evmutil/testutil"
+	evmutiltypes "github.com/kava-labs/kava/x/evmutil/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 type ParamsChangeTestSuite struct {
@@ -832,6 +835,213 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_NoSubparamRequirement
 	}
 }
 
+func (s *ParamsChangeTestSuite) TestEvmutilConversionPermission() {
+	usdcPair := evmutiltypes.NewConversionPair(
+		evmutiltestutil.MustNewInternalEVMAddressFromString("0x15932E26f5BD4923d46a2b205191C4b5d5f43FE3"),
+		"erc20/usdc",
+	)
+	usdtPair := evmutiltypes.NewConversionPair(
+		evmutiltestutil.MustNewInternalEVMAddressFromString("0x919C1c267BC06a7039e03fcc2eF738525769109c"),
+		"erc20/usdt",
+	)
+	atomToken := evmutiltypes.NewAllowedCosmosCoinERC20Token("ibc/atom", "Kava-wrapped ATOM", "kATOM", 6)
+	hardToken := evmutiltypes.NewAllowedCosmosCoinERC20Token("hard", "Kava-wrapped HARD", "kHARD", 6)
+
+	permission := types.EvmutilConversionPermission{
+		AllowedCosmosDenoms:    []evmutiltypes.AllowedCosmosCoinERC20Token{atomToken},
+		AllowedConversionPairs: []evmutiltypes.ConversionPair{usdtPair},
+	}
+
+	testcases := []struct {
+		name         string
+		currentPairs evmutiltypes.ConversionPairs
+		changes      []paramsproposal.ParamChange
+		expected     bool
+	}{
+		{
+			name:         "allows enabling a permitted conversion pair",
+			currentPairs: evmutiltypes.NewConversionPairs(usdcPair),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: evmutiltypes.ModuleName,
+				Key:      string(evmutiltypes.KeyEnabledConversionPairs),
+				Value:    s.mustMarshalParamJSON(evmutiltypes.NewConversionPairs(usdcPair, usdtPair)),
+			}},
+			expected: true,
+		},
+		{
+			name:         "allows disabling a permitted conversion pair",
+			currentPairs: evmutiltypes.NewConversionPairs(usdcPair, usdtPair),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: evmutiltypes.ModuleName,
+				Key:      string(evmutiltypes.KeyEnabledConversionPairs),
+				Value:    s.mustMarshalParamJSON(evmutiltypes.NewConversionPairs(usdcPair)),
+			}},
+			expected: true,
+		},
+		{
+			name:         "rejects disabling a conversion pair that is not permitted",
+			currentPairs: evmutiltypes.NewConversionPairs(usdcPair, usdtPair),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: evmutiltypes.ModuleName,
+				Key:      string(evmutiltypes.KeyEnabledConversionPairs),
+				Value:    s.mustMarshalParamJSON(evmutiltypes.NewConversionPairs(usdtPair)),
+			}},
+			expected: false,
+		},
+		{
+			name:         "allows enabling a permitted cosmos denom",
+			currentPairs: evmutiltypes.NewConversionPairs(usdcPair),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: evmutiltypes.ModuleName,
+				Key:      string(evmutiltypes.KeyAllowedCosmosDenoms),
+				Value:    s.mustMarshalParamJSON(evmutiltypes.NewAllowedCosmosCoinERC20Tokens(atomToken)),
+			}},
+			expected: true,
+		},
+		{
+			name:         "rejects enabling a cosmos denom that is not permitted",
+			currentPairs: evmutiltypes.NewConversionPairs(usdcPair),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: evmutiltypes.ModuleName,
+				Key:      string(evmutiltypes.KeyAllowedCosmosDenoms),
+				Value:    s.mustMarshalParamJSON(evmutiltypes.NewAllowedCosmosCoinERC20Tokens(atomToken, hardToken)),
+			}},
+			expected: false,
+		},
+		{
+			name:         "rejects changing a permitted cosmos denom's metadata",
+			currentPairs: evmutiltypes.NewConversionPairs(usdcPair),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: evmutiltypes.ModuleName,
+				Key:      string(evmutiltypes.KeyAllowedCosmosDenoms),
+				Value: s.mustMarshalParamJSON(evmutiltypes.NewAllowedCosmosCoinERC20Tokens(
+					evmutiltypes.NewAllowedCosmosCoinERC20Token("ibc/atom", "Kava-wrapped ATOM", "kATOM", 18),
+				)),
+			}},
+			expected: false,
+		},
+		{
+			name:         "rejects changes to other params",
+			currentPairs: evmutiltypes.NewConversionPairs(usdcPair),
+			changes: []paramsproposal.ParamChange{
+				{
+					Subspace: evmutiltypes.ModuleName,
+					Key:      string(evmutiltypes.KeyEnabledConversionPairs),
+					Value:    s.mustMarshalParamJSON(evmutiltypes.NewConversionPairs(usdcPair, usdtPair)),
+				},
+				{
+					Subspace: cdptypes.ModuleName,
+					Key:      string(cdptypes.KeyDebtParam),
+					Value:    `{}`,
+				},
+			},
+			expected: false,
+		},
+		{
+			name:         "rejects invalid json",
+			currentPairs: evmutiltypes.NewConversionPairs(usdcPair),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: evmutiltypes.ModuleName,
+				Key:      string(evmutiltypes.KeyEnabledConversionPairs),
+				Value:    `{"denom": `,
+			}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			subspace, found := s.pk.GetSubspace(evmutiltypes.ModuleName)
+			s.Require().True(found)
+			subspace.Set(s.ctx, evmutiltypes.KeyEnabledConversionPairs, tc.currentPairs)
+			subspace.Set(s.ctx, evmutiltypes.KeyAllowedCosmosDenoms, evmutiltypes.NewAllowedCosmosCoinERC20Tokens())
+
+			proposal := paramsproposal.NewParameterChangeProposal("A Title", "A description of this proposal.", tc.changes)
+			s.Require().Equal(tc.expected, permission.Allows(s.ctx, s.pk, proposal))
+		})
+	}
+}
+
+func (s *ParamsChangeTestSuite) TestSwapAllowedPoolsPermission() {
+	kavaUsdx := swaptypes.NewAllowedPool("ukava", "usdx")
+	hardUsdx := swaptypes.NewAllowedPool("hard", "usdx")
+	swpUsdx := swaptypes.NewAllowedPool("swp", "usdx")
+
+	permission := types.SwapAllowedPoolsPermission{
+		AllowedPools: swaptypes.NewAllowedPools(hardUsdx, swpUsdx),
+	}
+
+	testcases := []struct {
+		name     string
+		current  swaptypes.AllowedPools
+		changes  []paramsproposal.ParamChange
+		expected bool
+	}{
+		{
+			name:    "allows adding a permitted pool",
+			current: swaptypes.NewAllowedPools(kavaUsdx),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: swaptypes.ModuleName,
+				Key:      string(swaptypes.KeyAllowedPools),
+				Value:    s.mustMarshalParamJSON(swaptypes.NewAllowedPools(kavaUsdx, hardUsdx)),
+			}},
+			expected: true,
+		},
+		{
+			name:    "allows pausing a permitted pool",
+			current: swaptypes.NewAllowedPools(kavaUsdx, hardUsdx, swpUsdx),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: swaptypes.ModuleName,
+				Key:      string(swaptypes.KeyAllowedPools),
+				Value:    s.mustMarshalParamJSON(swaptypes.NewAllowedPools(kavaUsdx, swpUsdx)),
+			}},
+			expected: true,
+		},
+		{
+			name:    "rejects pausing a pool that is not permitted",
+			current: swaptypes.NewAllowedPools(kavaUsdx, hardUsdx),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: swaptypes.ModuleName,
+				Key:      string(swaptypes.KeyAllowedPools),
+				Value:    s.mustMarshalParamJSON(swaptypes.NewAllowedPools(hardUsdx)),
+			}},
+			expected: false,
+		},
+		{
+			name:    "rejects changing the swap fee",
+			current: swaptypes.NewAllowedPools(kavaUsdx),
+			changes: []paramsproposal.ParamChange{{
+				Subspace: swaptypes.ModuleName,
+				Key:      string(swaptypes.KeySwapFee),
+				Value:    `"0.5"`,
+			}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			subspace, found := s.pk.GetSubspace(swaptypes.ModuleName)
+			s.Require().True(found)
+			subspace.Set(s.ctx, swaptypes.KeyAllowedPools, tc.current)
+
+			proposal := paramsproposal.NewParameterChangeProposal("A Title", "A description of this proposal.", tc.changes)
+			s.Require().Equal(tc.expected, permission.Allows(s.ctx, s.pk, proposal))
+		})
+	}
+}
+
+// mustMarshalParamJSON encodes a param value the same way the params module stores it.
+func (s *ParamsChangeTestSuite) mustMarshalParamJSON(value interface{}) string {
+	bz, err := app.MakeEncodingConfig().Amino.MarshalJSON(value)
+	s.Require().NoError(err)
+	return string(bz)
+}
+
 func TestParamsChangeTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsChangeTestSuite))
 }
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -13,6 +14,9 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	communitytypes "github.com/kava-labs/kava/x/community/types"
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	evmutiltypes "github.com/kava-labs/kava/x/evmutil/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -57,6 +61,14 @@ var (
 	_ Permission = CommunityCDPRepayDebtPermission{}
 	_ Permission = CommunityPoolLendWithdrawPermission{}
 	_ Permission = CommunityCDPWithdrawCollateralPermission{}
+	_ Permission = CommunityPoolCancelPaymentStreamPermission{}
+	_ Permission = CommunityPoolSwapPermission{}
+	_ Permission = CommunityPoolProvideLiquidityPermission{}
+	_ Permission = CommunityPoolWithdrawLiquidityPermission{}
+	_ Permission = EvmutilConversionPermission{}
+	_ Permission = SwapAllowedPoolsPermission{}
+	_ Permission = EarnCommunityPoolPermission{}
+	_ Permission = MsgPermission{}
 )
 
 // Allows implement permission interface for GodPermission.
@@ -92,6 +104,266 @@ func (CommunityPoolLendWithdrawPermission) Allows(_ sdk.Context, _ ParamKeeper,
 	return ok
 }
 
+// Allows implement permission interface for CommunityPoolCancelPaymentStreamPermission.
+func (CommunityPoolCancelPaymentStreamPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(*communitytypes.CommunityPoolCancelPaymentStreamProposal)
+	return ok
+}
+
+// Allows implement permission interface for CommunityPoolSwapPermission.
+// The swapped amount and slippage must be within the maximums.
+func (perm CommunityPoolSwapPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*communitytypes.CommunityPoolSwapProposal)
+	if !ok {
+		return false
+	}
+	if !isSlippageAllowed(proposal.Slippage, perm.MaxSlippage) {
+		return false
+	}
+	return proposal.ExactTokenA.Amount.LTE(perm.MaxAmount.AmountOf(proposal.ExactTokenA.Denom))
+}
+
+// Allows implement permission interface for CommunityPoolProvideLiquidityPermission.
+// Both tokens of the deposit and the slippage must be within the maximums.
+func (perm CommunityPoolProvideLiquidityPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*communitytypes.CommunityPoolProvideLiquidityProposal)
+	if !ok {
+		return false
+	}
+	if !isSlippageAllowed(proposal.Slippage, perm.MaxSlippage) {
+		return false
+	}
+	for _, token := range []sdk.Coin{proposal.TokenA, proposal.TokenB} {
+		if token.Amount.GT(perm.MaxAmount.AmountOf(token.Denom)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Allows implement permission interface for CommunityPoolWithdrawLiquidityPermission.
+// The withdrawn shares must be within the maximum, and both minimum token amounts must be positive.
+// An unset max shares allows no proposals.
+func (perm CommunityPoolWithdrawLiquidityPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*communitytypes.CommunityPoolWithdrawLiquidityProposal)
+	if !ok {
+		return false
+	}
+	if perm.MaxShares.IsNil() || proposal.Shares.IsNil() || proposal.Shares.GT(perm.MaxShares) {
+		return false
+	}
+	for _, token := range []sdk.Coin{proposal.MinTokenA, proposal.MinTokenB} {
+		if token.Amount.IsNil() || !token.Amount.IsPositive() {
+			return false
+		}
+	}
+	return true
+}
+
+// isSlippageAllowed returns true if a proposal's slippage is set and no more than the max slippage.
+// An unset max slippage allows no proposals.
+func isSlippageAllowed(slippage, maxSlippage sdk.Dec) bool {
+	if slippage.IsNil() || maxSlippage.IsNil() {
+		return false
+	}
+	return slippage.LTE(maxSlippage)
+}
+
+// Allows implement permission interface for EvmutilConversionPermission.
+// Only evmutil param changes that add or remove the permitted cosmos denoms and conversion pairs are allowed.
+func (perm EvmutilConversionPermission) Allows(ctx sdk.Context, pk ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*paramsproposal.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+
+	for _, change := range proposal.Changes {
+		if change.Subspace != evmutiltypes.ModuleName {
+			return false
+		}
+
+		var allowed bool
+		switch change.Key {
+		case string(evmutiltypes.KeyAllowedCosmosDenoms):
+			allowed = allowsParamListChange(ctx, pk, change, perm.AllowedCosmosDenoms,
+				func(a, b evmutiltypes.AllowedCosmosCoinERC20Token) bool { return a.Equal(b) },
+			)
+		case string(evmutiltypes.KeyEnabledConversionPairs):
+			allowed = allowsParamListChange(ctx, pk, change, perm.AllowedConversionPairs,
+				func(a, b evmutiltypes.ConversionPair) bool { return a.Equal(b) },
+			)
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Allows implement permission interface for SwapAllowedPoolsPermission.
+// Only swap param changes that add or remove the permitted pools are allowed.
+func (perm SwapAllowedPoolsPermission) Allows(ctx sdk.Context, pk ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*paramsproposal.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+
+	for _, change := range proposal.Changes {
+		if change.Subspace != swaptypes.ModuleName || change.Key != string(swaptypes.KeyAllowedPools) {
+			return false
+		}
+
+		allowed := allowsParamListChange(ctx, pk, change, perm.AllowedPools,
+			func(a, b swaptypes.AllowedPool) bool { return a.Name() == b.Name() },
+		)
+		if !allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Allows implement permission interface for EarnCommunityPoolPermission.
+func (perm EarnCommunityPoolPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	var amount sdk.Coin
+	switch proposal := p.(type) {
+	case *earntypes.CommunityPoolDepositProposal:
+		amount = proposal.Amount
+	case *earntypes.CommunityPoolWithdrawProposal:
+		amount = proposal.Amount
+	default:
+		return false
+	}
+
+	return amount.Amount.LTE(perm.MaxAmount.AmountOf(amount.Denom))
+}
+
+// Allows implement permission interface for MsgPermission.
+// Every message in the proposal must match an allowed message type whose field constraints it satisfies.
+func (perm MsgPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*ExecuteMsgsProposal)
+	if !ok {
+		return false
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return false
+	}
+
+	for _, msg := range msgs {
+		allowed := false
+		for _, am := range perm.AllowedMsgs {
+			if am.allowsMsg(msg) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allowsMsg returns true if the msg is of the allowed type and satisfies all the field constraints.
+func (am AllowedMsg) allowsMsg(msg sdk.Msg) bool {
+	if sdk.MsgTypeURL(msg) != am.TypeURL {
+		return false
+	}
+	if len(am.FieldConstraints) == 0 {
+		return true
+	}
+
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return false
+	}
+	var msgJSON map[string]interface{}
+	if err := json.Unmarshal(bz, &msgJSON); err != nil {
+		return false
+	}
+
+	for _, fc := range am.FieldConstraints {
+		if !fc.allowsValue(msgJSON) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsValue returns true if the constrained field in the JSON encoded msg has one of the allowed values.
+func (fc FieldConstraint) allowsValue(msgJSON map[string]interface{}) bool {
+	var value interface{} = msgJSON
+	for _, key := range strings.Split(fc.Field, ".") {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if value, ok = obj[key]; !ok {
+			return false
+		}
+	}
+
+	for _, av := range fc.AllowedValues {
+		var allowedValue interface{}
+		if err := json.Unmarshal([]byte(av), &allowedValue); err != nil {
+			continue
+		}
+		if reflect.DeepEqual(value, allowedValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsParamListChange returns true if every record the param change adds to or removes from the current param
+// list is in the allowed list. Records are unchanged if they are in both the current and the changed param list.
+func allowsParamListChange[T any](
+	ctx sdk.Context, pk ParamKeeper, change paramsproposal.ParamChange, allowed []T, equal func(a, b T) bool,
+) bool {
+	subspace, found := pk.GetSubspace(change.Subspace)
+	if !found {
+		return false
+	}
+
+	var current []T
+	if currentRaw := subspace.GetRaw(ctx, []byte(change.Key)); len(currentRaw) > 0 {
+		if err := amino.UnmarshalJSON(currentRaw, &current); err != nil {
+			panic(err)
+		}
+	}
+
+	var incoming []T
+	if err := amino.UnmarshalJSON([]byte(change.Value), &incoming); err != nil {
+		return false
+	}
+
+	contains := func(records []T, record T) bool {
+		for _, r := range records {
+			if equal(r, record) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, record := range incoming {
+		if !contains(current, record) && !contains(allowed, record) {
+			return false
+		}
+	}
+	for _, record := range current {
+		if !contains(incoming, record) && !contains(allowed, record) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Allows implement permission interface for ParamsChangePermission.
 func (perm ParamsChangePermission) Allows(ctx sdk.Context, pk ParamKeeper, p PubProposal) bool {
 	proposal, ok := p.(*paramsproposal.ParameterChangeProposal)
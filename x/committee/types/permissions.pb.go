@@ -4,10 +4,15 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/kava-labs/kava/x/evmutil/types"
+	types2 "github.com/kava-labs/kava/x/swap/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -248,6 +253,188 @@ func (m *CommunityPoolLendWithdrawPermission) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolLendWithdrawPermission proto.InternalMessageInfo
 
+// CommunityPoolCancelPaymentStreamPermission allows submission of CommunityPoolCancelPaymentStreamProposal
+type CommunityPoolCancelPaymentStreamPermission struct {
+}
+
+func (m *CommunityPoolCancelPaymentStreamPermission) Reset() {
+	*m = CommunityPoolCancelPaymentStreamPermission{}
+}
+func (m *CommunityPoolCancelPaymentStreamPermission) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CommunityPoolCancelPaymentStreamPermission) ProtoMessage() {}
+func (*CommunityPoolCancelPaymentStreamPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{6}
+}
+func (m *CommunityPoolCancelPaymentStreamPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolCancelPaymentStreamPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolCancelPaymentStreamPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolCancelPaymentStreamPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolCancelPaymentStreamPermission.Merge(m, src)
+}
+func (m *CommunityPoolCancelPaymentStreamPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolCancelPaymentStreamPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolCancelPaymentStreamPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolCancelPaymentStreamPermission proto.InternalMessageInfo
+
+// CommunityPoolSwapPermission allows submission of CommunityPoolSwapProposal up to a maximum amount and slippage.
+type CommunityPoolSwapPermission struct {
+	// The maximum amount of each denom that a single proposal can swap.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_amount,json=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amount"`
+	// The maximum slippage that a single proposal can allow.
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage"`
+}
+
+func (m *CommunityPoolSwapPermission) Reset()         { *m = CommunityPoolSwapPermission{} }
+func (m *CommunityPoolSwapPermission) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolSwapPermission) ProtoMessage()    {}
+func (*CommunityPoolSwapPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{7}
+}
+func (m *CommunityPoolSwapPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolSwapPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolSwapPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolSwapPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSwapPermission.Merge(m, src)
+}
+func (m *CommunityPoolSwapPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolSwapPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSwapPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolSwapPermission proto.InternalMessageInfo
+
+func (m *CommunityPoolSwapPermission) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+// CommunityPoolProvideLiquidityPermission allows submission of CommunityPoolProvideLiquidityProposal up to a maximum
+// amount and slippage.
+type CommunityPoolProvideLiquidityPermission struct {
+	// The maximum amount of each denom that a single proposal can deposit.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_amount,json=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amount"`
+	// The maximum slippage that a single proposal can allow.
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage"`
+}
+
+func (m *CommunityPoolProvideLiquidityPermission) Reset() {
+	*m = CommunityPoolProvideLiquidityPermission{}
+}
+func (m *CommunityPoolProvideLiquidityPermission) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolProvideLiquidityPermission) ProtoMessage()    {}
+func (*CommunityPoolProvideLiquidityPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{8}
+}
+func (m *CommunityPoolProvideLiquidityPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolProvideLiquidityPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolProvideLiquidityPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolProvideLiquidityPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolProvideLiquidityPermission.Merge(m, src)
+}
+func (m *CommunityPoolProvideLiquidityPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolProvideLiquidityPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolProvideLiquidityPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolProvideLiquidityPermission proto.InternalMessageInfo
+
+func (m *CommunityPoolProvideLiquidityPermission) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+// CommunityPoolWithdrawLiquidityPermission allows submission of CommunityPoolWithdrawLiquidityProposal up to a maximum
+// amount of shares, with a positive minimum amount of each token.
+type CommunityPoolWithdrawLiquidityPermission struct {
+	// The maximum amount of pool shares that a single proposal can withdraw.
+	MaxShares cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_shares,json=maxShares,proto3,customtype=cosmossdk.io/math.Int" json:"max_shares"`
+}
+
+func (m *CommunityPoolWithdrawLiquidityPermission) Reset() {
+	*m = CommunityPoolWithdrawLiquidityPermission{}
+}
+func (m *CommunityPoolWithdrawLiquidityPermission) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolWithdrawLiquidityPermission) ProtoMessage()    {}
+func (*CommunityPoolWithdrawLiquidityPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{9}
+}
+func (m *CommunityPoolWithdrawLiquidityPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolWithdrawLiquidityPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolWithdrawLiquidityPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolWithdrawLiquidityPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolWithdrawLiquidityPermission.Merge(m, src)
+}
+func (m *CommunityPoolWithdrawLiquidityPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolWithdrawLiquidityPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolWithdrawLiquidityPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolWithdrawLiquidityPermission proto.InternalMessageInfo
+
 // ParamsChangePermission allows any parameter or sub parameter change proposal.
 type ParamsChangePermission struct {
 	AllowedParamsChanges AllowedParamsChanges `protobuf:"bytes,1,rep,name=allowed_params_changes,json=allowedParamsChanges,proto3,castrepeated=AllowedParamsChanges" json:"allowed_params_changes"`
@@ -257,7 +444,7 @@ func (m *ParamsChangePermission) Reset()         { *m = ParamsChangePermission{}
 func (m *ParamsChangePermission) String() string { return proto.CompactTextString(m) }
 func (*ParamsChangePermission) ProtoMessage()    {}
 func (*ParamsChangePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{6}
+	return fileDescriptor_bdfaf7be16465ae4, []int{10}
 }
 func (m *ParamsChangePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +496,7 @@ func (m *AllowedParamsChange) Reset()         { *m = AllowedParamsChange{} }
 func (m *AllowedParamsChange) String() string { return proto.CompactTextString(m) }
 func (*AllowedParamsChange) ProtoMessage()    {}
 func (*AllowedParamsChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{7}
+	return fileDescriptor_bdfaf7be16465ae4, []int{11}
 }
 func (m *AllowedParamsChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +567,7 @@ func (m *SubparamRequirement) Reset()         { *m = SubparamRequirement{} }
 func (m *SubparamRequirement) String() string { return proto.CompactTextString(m) }
 func (*SubparamRequirement) ProtoMessage()    {}
 func (*SubparamRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{8}
+	return fileDescriptor_bdfaf7be16465ae4, []int{12}
 }
 func (m *SubparamRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,174 +617,405 @@ func (m *SubparamRequirement) GetAllowedSubparamAttrChanges() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GodPermission)(nil), "kava.committee.v1beta1.GodPermission")
-	proto.RegisterType((*SoftwareUpgradePermission)(nil), "kava.committee.v1beta1.SoftwareUpgradePermission")
-	proto.RegisterType((*TextPermission)(nil), "kava.committee.v1beta1.TextPermission")
-	proto.RegisterType((*CommunityCDPRepayDebtPermission)(nil), "kava.committee.v1beta1.CommunityCDPRepayDebtPermission")
-	proto.RegisterType((*CommunityCDPWithdrawCollateralPermission)(nil), "kava.committee.v1beta1.CommunityCDPWithdrawCollateralPermission")
-	proto.RegisterType((*CommunityPoolLendWithdrawPermission)(nil), "kava.committee.v1beta1.CommunityPoolLendWithdrawPermission")
-	proto.RegisterType((*ParamsChangePermission)(nil), "kava.committee.v1beta1.ParamsChangePermission")
-	proto.RegisterType((*AllowedParamsChange)(nil), "kava.committee.v1beta1.AllowedParamsChange")
-	proto.RegisterType((*SubparamRequirement)(nil), "kava.committee.v1beta1.SubparamRequirement")
+// EvmutilConversionPermission allows enabling and disabling the listed evmutil cosmos coin ERC20 tokens and conversion pairs.
+type EvmutilConversionPermission struct {
+	// Cosmos coin ERC20 tokens that can be added to or removed from the evmutil AllowedCosmosDenoms param.
+	AllowedCosmosDenoms []types1.AllowedCosmosCoinERC20Token `protobuf:"bytes,1,rep,name=allowed_cosmos_denoms,json=allowedCosmosDenoms,proto3" json:"allowed_cosmos_denoms"`
+	// Conversion pairs that can be added to or removed from the evmutil EnabledConversionPairs param.
+	AllowedConversionPairs []types1.ConversionPair `protobuf:"bytes,2,rep,name=allowed_conversion_pairs,json=allowedConversionPairs,proto3" json:"allowed_conversion_pairs"`
 }
 
-func init() {
-	proto.RegisterFile("kava/committee/v1beta1/permissions.proto", fileDescriptor_bdfaf7be16465ae4)
+func (m *EvmutilConversionPermission) Reset()         { *m = EvmutilConversionPermission{} }
+func (m *EvmutilConversionPermission) String() string { return proto.CompactTextString(m) }
+func (*EvmutilConversionPermission) ProtoMessage()    {}
+func (*EvmutilConversionPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{13}
 }
-
-var fileDescriptor_bdfaf7be16465ae4 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0x87, 0x1b, 0xb3, 0x88, 0x3b, 0xe2, 0xb2, 0x64, 0x4b, 0xc9, 0x86, 0x35, 0x2d, 0xf5, 0x12,
-	0x28, 0x9b, 0x50, 0xc5, 0xcb, 0xde, 0xda, 0xae, 0x78, 0xf1, 0x50, 0xb2, 0x8a, 0xe0, 0x25, 0x4c,
-	0x9a, 0x31, 0x0d, 0x3b, 0xc9, 0xc4, 0x79, 0x27, 0xed, 0x16, 0x04, 0xbf, 0x82, 0x5f, 0x43, 0xcf,
-	0x7e, 0x88, 0xc5, 0xd3, 0x1e, 0x3d, 0xa9, 0xb4, 0x1f, 0xc3, 0x8b, 0xe4, 0x6f, 0x03, 0x1b, 0x72,
-	0x9b, 0x79, 0xf3, 0xfc, 0xde, 0xc9, 0x33, 0x2f, 0x83, 0x8c, 0x6b, 0xbc, 0xc2, 0xd6, 0x82, 0x85,
-	0x61, 0x20, 0x04, 0x21, 0xd6, 0x6a, 0xec, 0x12, 0x81, 0xc7, 0x56, 0x4c, 0x78, 0x18, 0x00, 0x04,
-	0x2c, 0x02, 0x33, 0xe6, 0x4c, 0x30, 0xa5, 0x97, 0x92, 0x66, 0x45, 0x9a, 0x05, 0xa9, 0x9d, 0x2e,
-	0x18, 0x84, 0x0c, 0x9c, 0x8c, 0xb2, 0xf2, 0x4d, 0x1e, 0xd1, 0xba, 0x3e, 0xf3, 0x59, 0x5e, 0x4f,
-	0x57, 0x79, 0x75, 0xd8, 0x47, 0x4f, 0x5e, 0x33, 0x6f, 0x5e, 0x1d, 0x70, 0x71, 0xf4, 0xf3, 0xc7,
-	0x39, 0xda, 0xef, 0x87, 0x23, 0x74, 0x7a, 0xc5, 0x3e, 0x8a, 0x35, 0xe6, 0xe4, 0x5d, 0xec, 0x73,
-	0xec, 0x91, 0x16, 0x78, 0x80, 0x8e, 0xde, 0x92, 0x1b, 0xd1, 0x42, 0x8c, 0x51, 0x7f, 0xc6, 0xc2,
-	0x30, 0x89, 0x02, 0xb1, 0x99, 0x5d, 0xce, 0x6d, 0x12, 0xe3, 0xcd, 0x25, 0x71, 0xdb, 0x22, 0x17,
-	0xc8, 0xa8, 0x47, 0xde, 0x07, 0x62, 0xe9, 0x71, 0xbc, 0x9e, 0x31, 0x4a, 0xb1, 0x20, 0x1c, 0xd3,
-	0x96, 0xec, 0x4b, 0xf4, 0xac, 0xca, 0xce, 0x19, 0xa3, 0x6f, 0x48, 0xe4, 0x95, 0x0d, 0x5a, 0x62,
-	0xdf, 0x24, 0xd4, 0x9b, 0x63, 0x8e, 0x43, 0x98, 0x2d, 0x71, 0xe4, 0xd7, 0x94, 0x95, 0x2f, 0xa8,
-	0x87, 0x29, 0x65, 0x6b, 0xe2, 0x39, 0x71, 0x46, 0x38, 0x8b, 0x0c, 0x01, 0x55, 0x1a, 0xc8, 0xc6,
-	0xe3, 0xe7, 0x23, 0xb3, 0x79, 0x34, 0xe6, 0x24, 0x4f, 0xd5, 0xdb, 0x4e, 0xcf, 0x6e, 0x7f, 0xf7,
-	0x3b, 0xdf, 0xff, 0xf4, 0xbb, 0x0d, 0x1f, 0xc1, 0xee, 0xe2, 0x86, 0xea, 0xbd, 0x7f, 0xfd, 0x27,
-	0xa1, 0x93, 0x86, 0xb8, 0xa2, 0xa1, 0x47, 0x90, 0xb8, 0x10, 0xe3, 0x05, 0x51, 0xa5, 0x81, 0x64,
-	0x1c, 0xda, 0xd5, 0x5e, 0x39, 0x46, 0xf2, 0x35, 0xd9, 0xa8, 0x0f, 0xb2, 0x72, 0xba, 0x54, 0x26,
-	0xe8, 0x29, 0x04, 0x91, 0x4f, 0x89, 0x03, 0x89, 0x9b, 0x89, 0x39, 0xa5, 0x26, 0x16, 0x82, 0x83,
-	0x2a, 0x0f, 0x64, 0xe3, 0xd0, 0xd6, 0x72, 0xe8, 0xaa, 0x60, 0x8a, 0x73, 0x27, 0x29, 0xa1, 0x00,
-	0x3a, 0x0b, 0x13, 0x2a, 0x82, 0xaa, 0x03, 0x38, 0x9c, 0x7c, 0x4a, 0x02, 0x4e, 0x42, 0x12, 0x09,
-	0x50, 0x0f, 0xda, 0xef, 0xa7, 0xec, 0x69, 0xef, 0x33, 0xd3, 0x83, 0xf4, 0x7e, 0x6c, 0x2d, 0x6b,
-	0x5b, 0x7e, 0x87, 0x1a, 0x00, 0xc3, 0xcf, 0xe8, 0xa4, 0x21, 0x58, 0x0a, 0x4a, 0x7b, 0xc1, 0x63,
-	0x24, 0xaf, 0x30, 0x2d, 0x95, 0x57, 0x98, 0xa6, 0xca, 0xa5, 0xe2, 0xde, 0x59, 0x08, 0x5e, 0x0d,
-	0xb4, 0x50, 0x2e, 0xa0, 0xca, 0x59, 0x08, 0x5e, 0xcc, 0x62, 0xfa, 0xea, 0x76, 0xab, 0x4b, 0x77,
-	0x5b, 0x5d, 0xfa, 0xbb, 0xd5, 0xa5, 0xaf, 0x3b, 0xbd, 0x73, 0xb7, 0xd3, 0x3b, 0xbf, 0x76, 0x7a,
-	0xe7, 0xc3, 0xc8, 0x0f, 0xc4, 0x32, 0x71, 0x53, 0x4f, 0x2b, 0x15, 0x3e, 0xa7, 0xd8, 0x85, 0x6c,
-	0x65, 0xdd, 0xd4, 0x5e, 0xb8, 0xd8, 0xc4, 0x04, 0xdc, 0x87, 0xd9, 0x5b, 0x7c, 0xf1, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0xe8, 0xa8, 0x0a, 0x00, 0x04, 0x00, 0x00,
+func (m *EvmutilConversionPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GodPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EvmutilConversionPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmutilConversionPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GodPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EvmutilConversionPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmutilConversionPermission.Merge(m, src)
 }
-
-func (m *GodPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *EvmutilConversionPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmutilConversionPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmutilConversionPermission.DiscardUnknown(m)
 }
 
-func (m *SoftwareUpgradePermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EvmutilConversionPermission proto.InternalMessageInfo
+
+func (m *EvmutilConversionPermission) GetAllowedCosmosDenoms() []types1.AllowedCosmosCoinERC20Token {
+	if m != nil {
+		return m.AllowedCosmosDenoms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SoftwareUpgradePermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EvmutilConversionPermission) GetAllowedConversionPairs() []types1.ConversionPair {
+	if m != nil {
+		return m.AllowedConversionPairs
+	}
+	return nil
 }
 
-func (m *SoftwareUpgradePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// SwapAllowedPoolsPermission allows adding and pausing the listed swap pools.
+type SwapAllowedPoolsPermission struct {
+	// Pools that can be added to or removed from the swap AllowedPools param.
+	AllowedPools []types2.AllowedPool `protobuf:"bytes,1,rep,name=allowed_pools,json=allowedPools,proto3" json:"allowed_pools"`
 }
 
-func (m *TextPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SwapAllowedPoolsPermission) Reset()         { *m = SwapAllowedPoolsPermission{} }
+func (m *SwapAllowedPoolsPermission) String() string { return proto.CompactTextString(m) }
+func (*SwapAllowedPoolsPermission) ProtoMessage()    {}
+func (*SwapAllowedPoolsPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{14}
+}
+func (m *SwapAllowedPoolsPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAllowedPoolsPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAllowedPoolsPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *TextPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SwapAllowedPoolsPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAllowedPoolsPermission.Merge(m, src)
 }
-
-func (m *TextPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SwapAllowedPoolsPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAllowedPoolsPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAllowedPoolsPermission.DiscardUnknown(m)
 }
 
-func (m *CommunityCDPRepayDebtPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SwapAllowedPoolsPermission proto.InternalMessageInfo
+
+func (m *SwapAllowedPoolsPermission) GetAllowedPools() []types2.AllowedPool {
+	if m != nil {
+		return m.AllowedPools
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CommunityCDPRepayDebtPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EarnCommunityPoolPermission allows submission of earn CommunityPoolDepositProposal and CommunityPoolWithdrawProposal
+// up to a maximum amount.
+type EarnCommunityPoolPermission struct {
+	// The maximum amount of each denom that a single proposal can deposit or withdraw.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_amount,json=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amount"`
 }
 
-func (m *CommunityCDPRepayDebtPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *EarnCommunityPoolPermission) Reset()         { *m = EarnCommunityPoolPermission{} }
+func (m *EarnCommunityPoolPermission) String() string { return proto.CompactTextString(m) }
+func (*EarnCommunityPoolPermission) ProtoMessage()    {}
+func (*EarnCommunityPoolPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{15}
 }
-
-func (m *CommunityCDPWithdrawCollateralPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EarnCommunityPoolPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EarnCommunityPoolPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EarnCommunityPoolPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EarnCommunityPoolPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarnCommunityPoolPermission.Merge(m, src)
+}
+func (m *EarnCommunityPoolPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EarnCommunityPoolPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarnCommunityPoolPermission.DiscardUnknown(m)
 }
 
-func (m *CommunityCDPWithdrawCollateralPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EarnCommunityPoolPermission proto.InternalMessageInfo
+
+func (m *EarnCommunityPoolPermission) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
 }
 
-func (m *CommunityCDPWithdrawCollateralPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgPermission allows ExecuteMsgsProposals containing messages of the listed types, optionally restricted to certain field values.
+type MsgPermission struct {
+	AllowedMsgs []AllowedMsg `protobuf:"bytes,1,rep,name=allowed_msgs,json=allowedMsgs,proto3" json:"allowed_msgs"`
 }
 
-func (m *CommunityPoolLendWithdrawPermission) Marshal() (dAtA []byte, err error) {
+func (m *MsgPermission) Reset()         { *m = MsgPermission{} }
+func (m *MsgPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgPermission) ProtoMessage()    {}
+func (*MsgPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{16}
+}
+func (m *MsgPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPermission.Merge(m, src)
+}
+func (m *MsgPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPermission proto.InternalMessageInfo
+
+func (m *MsgPermission) GetAllowedMsgs() []AllowedMsg {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+// AllowedMsg defines a message type that a committee can execute, and the constraints its fields must satisfy.
+type AllowedMsg struct {
+	// The type URL of the message, for example "/kava.community.v1beta1.MsgUpdateParams".
+	TypeURL string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// Constraints on the message fields. All constraints must be satisfied for the message to be allowed.
+	FieldConstraints []FieldConstraint `protobuf:"bytes,2,rep,name=field_constraints,json=fieldConstraints,proto3" json:"field_constraints"`
+}
+
+func (m *AllowedMsg) Reset()         { *m = AllowedMsg{} }
+func (m *AllowedMsg) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsg) ProtoMessage()    {}
+func (*AllowedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{17}
+}
+func (m *AllowedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsg.Merge(m, src)
+}
+func (m *AllowedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsg proto.InternalMessageInfo
+
+func (m *AllowedMsg) GetTypeURL() string {
+	if m != nil {
+		return m.TypeURL
+	}
+	return ""
+}
+
+func (m *AllowedMsg) GetFieldConstraints() []FieldConstraint {
+	if m != nil {
+		return m.FieldConstraints
+	}
+	return nil
+}
+
+// FieldConstraint restricts a message field to a set of values.
+type FieldConstraint struct {
+	// The dot separated path of the field in the message's proto JSON encoding, for example "amount.denom".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The proto JSON encoded values the field is allowed to have, for example "\"ukava\"".
+	AllowedValues []string `protobuf:"bytes,2,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+}
+
+func (m *FieldConstraint) Reset()         { *m = FieldConstraint{} }
+func (m *FieldConstraint) String() string { return proto.CompactTextString(m) }
+func (*FieldConstraint) ProtoMessage()    {}
+func (*FieldConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{18}
+}
+func (m *FieldConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldConstraint.Merge(m, src)
+}
+func (m *FieldConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldConstraint proto.InternalMessageInfo
+
+func (m *FieldConstraint) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldConstraint) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GodPermission)(nil), "kava.committee.v1beta1.GodPermission")
+	proto.RegisterType((*SoftwareUpgradePermission)(nil), "kava.committee.v1beta1.SoftwareUpgradePermission")
+	proto.RegisterType((*TextPermission)(nil), "kava.committee.v1beta1.TextPermission")
+	proto.RegisterType((*CommunityCDPRepayDebtPermission)(nil), "kava.committee.v1beta1.CommunityCDPRepayDebtPermission")
+	proto.RegisterType((*CommunityCDPWithdrawCollateralPermission)(nil), "kava.committee.v1beta1.CommunityCDPWithdrawCollateralPermission")
+	proto.RegisterType((*CommunityPoolLendWithdrawPermission)(nil), "kava.committee.v1beta1.CommunityPoolLendWithdrawPermission")
+	proto.RegisterType((*CommunityPoolCancelPaymentStreamPermission)(nil), "kava.committee.v1beta1.CommunityPoolCancelPaymentStreamPermission")
+	proto.RegisterType((*CommunityPoolSwapPermission)(nil), "kava.committee.v1beta1.CommunityPoolSwapPermission")
+	proto.RegisterType((*CommunityPoolProvideLiquidityPermission)(nil), "kava.committee.v1beta1.CommunityPoolProvideLiquidityPermission")
+	proto.RegisterType((*CommunityPoolWithdrawLiquidityPermission)(nil), "kava.committee.v1beta1.CommunityPoolWithdrawLiquidityPermission")
+	proto.RegisterType((*ParamsChangePermission)(nil), "kava.committee.v1beta1.ParamsChangePermission")
+	proto.RegisterType((*AllowedParamsChange)(nil), "kava.committee.v1beta1.AllowedParamsChange")
+	proto.RegisterType((*SubparamRequirement)(nil), "kava.committee.v1beta1.SubparamRequirement")
+	proto.RegisterType((*EvmutilConversionPermission)(nil), "kava.committee.v1beta1.EvmutilConversionPermission")
+	proto.RegisterType((*SwapAllowedPoolsPermission)(nil), "kava.committee.v1beta1.SwapAllowedPoolsPermission")
+	proto.RegisterType((*EarnCommunityPoolPermission)(nil), "kava.committee.v1beta1.EarnCommunityPoolPermission")
+	proto.RegisterType((*MsgPermission)(nil), "kava.committee.v1beta1.MsgPermission")
+	proto.RegisterType((*AllowedMsg)(nil), "kava.committee.v1beta1.AllowedMsg")
+	proto.RegisterType((*FieldConstraint)(nil), "kava.committee.v1beta1.FieldConstraint")
+}
+
+func init() {
+	proto.RegisterFile("kava/committee/v1beta1/permissions.proto", fileDescriptor_bdfaf7be16465ae4)
+}
+
+var fileDescriptor_bdfaf7be16465ae4 = []byte{
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x36, 0x85, 0x36, 0x93, 0x26, 0x84, 0x4d, 0x1a, 0x39, 0x4e, 0xb0, 0x23, 0xf3, 0x51,
+	0xab, 0x51, 0xec, 0xa6, 0x88, 0x4b, 0xc5, 0x25, 0x71, 0x02, 0x0a, 0xa4, 0xc8, 0x5a, 0x27, 0x20,
+	0xf5, 0xb2, 0x7a, 0xed, 0x9d, 0x6c, 0x06, 0xcf, 0xee, 0x6c, 0x67, 0x66, 0xfd, 0x21, 0x21, 0xc1,
+	0x89, 0x73, 0xaf, 0xfc, 0x04, 0x38, 0xf7, 0x47, 0x54, 0x9c, 0x2a, 0x4e, 0x88, 0x43, 0x40, 0xc9,
+	0x0f, 0xe0, 0x07, 0x70, 0x41, 0x33, 0x3b, 0xbb, 0x5e, 0xc7, 0xc6, 0x1c, 0x91, 0x38, 0x79, 0x67,
+	0xe6, 0x79, 0xde, 0xf7, 0x79, 0xde, 0x79, 0x67, 0xc6, 0xa8, 0xda, 0x85, 0x1e, 0xd4, 0x3b, 0x2c,
+	0x08, 0x88, 0x94, 0x18, 0xd7, 0x7b, 0x7b, 0x6d, 0x2c, 0x61, 0xaf, 0x1e, 0x61, 0x1e, 0x10, 0x21,
+	0x08, 0x0b, 0x45, 0x2d, 0xe2, 0x4c, 0x32, 0x7b, 0x5d, 0x21, 0x6b, 0x19, 0xb2, 0x66, 0x90, 0xc5,
+	0x52, 0x87, 0x89, 0x80, 0x89, 0x7a, 0x1b, 0xc4, 0x88, 0xde, 0x61, 0x24, 0x4c, 0x78, 0xc5, 0x8d,
+	0x64, 0xdd, 0xd5, 0xa3, 0x7a, 0x32, 0x30, 0x4b, 0x6b, 0x3e, 0xf3, 0x59, 0x32, 0xaf, 0xbe, 0xcc,
+	0xec, 0x43, 0x2d, 0x09, 0xf7, 0x82, 0x58, 0x12, 0x9a, 0x8b, 0x18, 0xf6, 0x30, 0x57, 0x82, 0xdc,
+	0x08, 0x08, 0x37, 0xd8, 0x2d, 0x8d, 0x15, 0x7d, 0x88, 0x32, 0xa0, 0x1a, 0x24, 0xab, 0x95, 0x32,
+	0x5a, 0xfa, 0x94, 0x79, 0xcd, 0xcc, 0xca, 0x93, 0xe5, 0x9f, 0x5f, 0xee, 0xa2, 0xd1, 0xb8, 0xb2,
+	0x83, 0x36, 0x5a, 0xec, 0x5c, 0xf6, 0x81, 0xe3, 0xb3, 0xc8, 0xe7, 0xe0, 0xe1, 0x19, 0xe0, 0x6d,
+	0xb4, 0x7c, 0x8a, 0x07, 0x72, 0x06, 0x62, 0x0f, 0x95, 0x1b, 0x2c, 0x08, 0xe2, 0x90, 0xc8, 0x61,
+	0xe3, 0xb0, 0xe9, 0xe0, 0x08, 0x86, 0x87, 0xb8, 0x3d, 0x8b, 0xf2, 0x04, 0x55, 0xf3, 0x94, 0xaf,
+	0x88, 0xbc, 0xf0, 0x38, 0xf4, 0x1b, 0x8c, 0x52, 0x90, 0x98, 0x03, 0x9d, 0xc1, 0xfd, 0x08, 0xbd,
+	0x9b, 0x71, 0x9b, 0x8c, 0xd1, 0x13, 0x1c, 0x7a, 0x69, 0x80, 0x19, 0xb4, 0x8f, 0xd1, 0xc3, 0x31,
+	0x5a, 0x03, 0xc2, 0x0e, 0xa6, 0x4d, 0x18, 0x06, 0x38, 0x94, 0x2d, 0xc9, 0x31, 0x04, 0x33, 0xd8,
+	0x7f, 0x5a, 0x68, 0x73, 0x8c, 0xde, 0xea, 0x43, 0x34, 0x5a, 0xb7, 0xbf, 0x46, 0x28, 0x80, 0x81,
+	0x0b, 0x01, 0x8b, 0x43, 0x59, 0xb0, 0xb6, 0xe7, 0xab, 0x8b, 0x8f, 0x37, 0x6a, 0x66, 0xdb, 0x55,
+	0x8f, 0xa4, 0x8d, 0x53, 0x6b, 0x30, 0x12, 0x1e, 0x3c, 0x7a, 0x75, 0x59, 0x9e, 0xfb, 0xe9, 0xf7,
+	0x72, 0xd5, 0x27, 0xf2, 0x22, 0x6e, 0xab, 0xfe, 0x32, 0x3d, 0x62, 0x7e, 0x76, 0x85, 0xd7, 0xad,
+	0xcb, 0x61, 0x84, 0x85, 0x26, 0x08, 0x67, 0x21, 0x80, 0xc1, 0xbe, 0x8e, 0x6e, 0x9f, 0xa2, 0x7b,
+	0x2a, 0x97, 0xa0, 0x24, 0x8a, 0xc0, 0xc7, 0x85, 0x5b, 0xdb, 0x56, 0x75, 0xe1, 0x60, 0x4f, 0x85,
+	0xfc, 0xed, 0xb2, 0xbc, 0x99, 0x04, 0x10, 0x5e, 0xb7, 0x46, 0x58, 0x3d, 0x00, 0x79, 0x51, 0x3b,
+	0xc1, 0x3e, 0x74, 0x86, 0x87, 0xb8, 0xf3, 0xcb, 0xcb, 0x5d, 0x64, 0x34, 0x1d, 0xe2, 0x8e, 0xb3,
+	0x18, 0xc0, 0xa0, 0x65, 0xa2, 0x4c, 0x38, 0xfe, 0xcb, 0x42, 0x0f, 0xc6, 0x1c, 0x37, 0x39, 0xeb,
+	0x11, 0x0f, 0x9f, 0x90, 0xe7, 0x31, 0xf1, 0xd4, 0xdc, 0xff, 0xd9, 0xfd, 0xf7, 0x16, 0xaa, 0x8e,
+	0xb9, 0x4f, 0x3b, 0x6c, 0x9a, 0xfd, 0xcf, 0x12, 0xfb, 0xe2, 0x02, 0x38, 0x16, 0x05, 0x4b, 0x0b,
+	0xda, 0x31, 0x82, 0xee, 0x4f, 0x0a, 0x3a, 0x0e, 0x65, 0x4e, 0xca, 0x71, 0x28, 0xb5, 0xbd, 0x96,
+	0x66, 0x4f, 0x08, 0xf9, 0xd1, 0x42, 0xeb, 0x4d, 0xe0, 0x10, 0x88, 0xc6, 0x05, 0x84, 0x7e, 0xee,
+	0xa4, 0xda, 0xdf, 0xa2, 0x75, 0xa0, 0x94, 0xf5, 0xb1, 0xe7, 0x46, 0x1a, 0xe1, 0x76, 0x34, 0x44,
+	0x98, 0x1d, 0xd8, 0xa9, 0x4d, 0xbf, 0xbb, 0x6a, 0xfb, 0x09, 0x2b, 0x1f, 0xf6, 0x60, 0xcb, 0xec,
+	0xc9, 0xda, 0x94, 0x45, 0xe1, 0xac, 0xc1, 0x94, 0xd9, 0x69, 0x2d, 0xb3, 0x3a, 0x85, 0x6e, 0x17,
+	0xd1, 0x5d, 0x11, 0xb7, 0x45, 0x04, 0x1d, 0x9c, 0x54, 0xc7, 0xc9, 0xc6, 0xf6, 0x0a, 0x9a, 0xef,
+	0xe2, 0x61, 0xb2, 0x8b, 0x8e, 0xfa, 0xb4, 0xf7, 0xd1, 0x3b, 0x82, 0x84, 0x3e, 0xc5, 0xae, 0x88,
+	0xdb, 0xda, 0x98, 0x9b, 0xda, 0x04, 0x29, 0xb9, 0x28, 0xcc, 0x6f, 0xcf, 0x57, 0x17, 0x9c, 0x62,
+	0x02, 0x6a, 0x19, 0x8c, 0xc9, 0xbb, 0xaf, 0x10, 0xb6, 0x40, 0x5b, 0x41, 0x4c, 0x25, 0xc9, 0x22,
+	0x08, 0x97, 0xe3, 0xe7, 0x31, 0xe1, 0x58, 0x1d, 0x76, 0x51, 0xb8, 0x3d, 0xbb, 0x3e, 0x69, 0x4c,
+	0x67, 0xc4, 0x39, 0xb8, 0xad, 0xea, 0xe3, 0x14, 0x75, 0xd8, 0x74, 0x5d, 0xe4, 0x00, 0xa2, 0xf2,
+	0x0d, 0x5a, 0x9d, 0x42, 0x4c, 0x0d, 0x5a, 0x23, 0x83, 0x2b, 0x68, 0xbe, 0x07, 0x34, 0xb5, 0xdc,
+	0x03, 0xaa, 0x2c, 0xa7, 0x16, 0x47, 0x9e, 0xa5, 0xe4, 0xd9, 0x86, 0x1a, 0xcb, 0x06, 0x94, 0x79,
+	0x96, 0x92, 0x9b, 0xbd, 0xa8, 0x7c, 0x77, 0x0b, 0x6d, 0x1e, 0x25, 0x8f, 0x47, 0x23, 0x7b, 0x33,
+	0x72, 0xcd, 0xd2, 0x45, 0xf7, 0xd3, 0x14, 0xe6, 0x65, 0xf2, 0x70, 0xc8, 0x82, 0xb4, 0x57, 0xf6,
+	0x92, 0x5a, 0x98, 0xe7, 0xe7, 0x66, 0xa7, 0x34, 0x34, 0x43, 0x1d, 0xc5, 0x23, 0xa7, 0xf1, 0xf8,
+	0xd1, 0x29, 0xeb, 0xe2, 0xd0, 0x54, 0x64, 0x15, 0xf2, 0x90, 0x43, 0x1d, 0xd3, 0xf6, 0x50, 0x61,
+	0x94, 0x6c, 0xec, 0x01, 0x13, 0x85, 0x5b, 0x3a, 0xdf, 0x7b, 0xd3, 0xf3, 0xe5, 0xa4, 0x03, 0xe1,
+	0x26, 0xc5, 0x7a, 0x96, 0x22, 0xbf, 0x38, 0xd9, 0x7e, 0x7d, 0x54, 0x54, 0xb7, 0x72, 0xda, 0x81,
+	0x8c, 0x51, 0x91, 0x2b, 0xc0, 0x31, 0x5a, 0xca, 0x4e, 0x8b, 0x5a, 0x32, 0xc6, 0x4b, 0x89, 0x10,
+	0xfd, 0x7c, 0xde, 0x3c, 0x1f, 0x8c, 0x51, 0x23, 0xe1, 0x1e, 0xe4, 0x82, 0x4e, 0x24, 0xfe, 0xc1,
+	0x42, 0x9b, 0x47, 0xc0, 0xc3, 0xf1, 0xeb, 0xf2, 0x3f, 0xb9, 0x1e, 0x27, 0xb4, 0x51, 0xb4, 0xf4,
+	0x54, 0xf8, 0x39, 0x31, 0x9f, 0xa3, 0xd4, 0x8c, 0x1b, 0x08, 0x3f, 0x2d, 0x43, 0xe5, 0x5f, 0xee,
+	0x8a, 0xa7, 0xc2, 0x37, 0xa5, 0x58, 0x84, 0x6c, 0x66, 0xb2, 0x12, 0x2f, 0x2c, 0x84, 0x46, 0x0c,
+	0xfb, 0x03, 0x74, 0x57, 0xc9, 0x74, 0x63, 0x4e, 0xcd, 0xb5, 0xb8, 0x78, 0x75, 0x59, 0xbe, 0x73,
+	0x3a, 0x8c, 0xf0, 0x99, 0x73, 0xe2, 0xdc, 0x51, 0x8b, 0x67, 0x9c, 0xda, 0xcf, 0xd0, 0xdb, 0xe7,
+	0x04, 0x53, 0xdd, 0x2d, 0x42, 0x72, 0x20, 0xa1, 0x4c, 0x1b, 0xe5, 0xc1, 0x3f, 0x09, 0xfb, 0x44,
+	0x11, 0x1a, 0x19, 0xde, 0xa8, 0x5b, 0x39, 0x1f, 0x9f, 0x16, 0x95, 0x2f, 0xd0, 0x5b, 0x37, 0xa0,
+	0xf6, 0x1a, 0x7a, 0x43, 0xc3, 0xcc, 0xa1, 0x4c, 0x06, 0xf6, 0xfb, 0x68, 0x39, 0x2d, 0x4c, 0x0f,
+	0x68, 0x8c, 0x13, 0x05, 0x0b, 0x4e, 0xda, 0x36, 0x5f, 0xea, 0xc9, 0x83, 0xa3, 0x57, 0x57, 0x25,
+	0xeb, 0xf5, 0x55, 0xc9, 0xfa, 0xe3, 0xaa, 0x64, 0xbd, 0xb8, 0x2e, 0xcd, 0xbd, 0xbe, 0x2e, 0xcd,
+	0xfd, 0x7a, 0x5d, 0x9a, 0x7b, 0xb6, 0x93, 0xdb, 0x2f, 0x25, 0x7a, 0x97, 0x42, 0x5b, 0xe8, 0xaf,
+	0xfa, 0x20, 0xf7, 0x5f, 0x53, 0x6f, 0x5c, 0xfb, 0x4d, 0xfd, 0x5f, 0xed, 0xc3, 0xbf, 0x07, 0x00,
+	0xd9, 0xfa, 0xec, 0x6a, 0x8a, 0x0a, 0x00, 0x00,
+}
+
+func (m *GodPermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -607,12 +1025,12 @@ func (m *CommunityPoolLendWithdrawPermission) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *CommunityPoolLendWithdrawPermission) MarshalTo(dAtA []byte) (int, error) {
+func (m *GodPermission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityPoolLendWithdrawPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GodPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -620,7 +1038,7 @@ func (m *CommunityPoolLendWithdrawPermission) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsChangePermission) Marshal() (dAtA []byte, err error) {
+func (m *SoftwareUpgradePermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -630,34 +1048,20 @@ func (m *ParamsChangePermission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsChangePermission) MarshalTo(dAtA []byte) (int, error) {
+func (m *SoftwareUpgradePermission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsChangePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SoftwareUpgradePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AllowedParamsChanges) > 0 {
-		for iNdEx := len(m.AllowedParamsChanges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AllowedParamsChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPermissions(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllowedParamsChange) Marshal() (dAtA []byte, err error) {
+func (m *TextPermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -667,57 +1071,20 @@ func (m *AllowedParamsChange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllowedParamsChange) MarshalTo(dAtA []byte) (int, error) {
+func (m *TextPermission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllowedParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TextPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MultiSubparamsRequirements) > 0 {
-		for iNdEx := len(m.MultiSubparamsRequirements) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MultiSubparamsRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPermissions(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.SingleSubparamAllowedAttrs) > 0 {
-		for iNdEx := len(m.SingleSubparamAllowedAttrs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SingleSubparamAllowedAttrs[iNdEx])
-			copy(dAtA[i:], m.SingleSubparamAllowedAttrs[iNdEx])
-			i = encodeVarintPermissions(dAtA, i, uint64(len(m.SingleSubparamAllowedAttrs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Subspace) > 0 {
-		i -= len(m.Subspace)
-		copy(dAtA[i:], m.Subspace)
-		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Subspace)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubparamRequirement) Marshal() (dAtA []byte, err error) {
+func (m *CommunityCDPRepayDebtPermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -727,209 +1094,1632 @@ func (m *SubparamRequirement) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubparamRequirement) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommunityCDPRepayDebtPermission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubparamRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommunityCDPRepayDebtPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AllowedSubparamAttrChanges) > 0 {
-		for iNdEx := len(m.AllowedSubparamAttrChanges) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedSubparamAttrChanges[iNdEx])
-			copy(dAtA[i:], m.AllowedSubparamAttrChanges[iNdEx])
-			i = encodeVarintPermissions(dAtA, i, uint64(len(m.AllowedSubparamAttrChanges[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Val) > 0 {
-		i -= len(m.Val)
-		copy(dAtA[i:], m.Val)
-		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Val)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPermissions(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CommunityCDPWithdrawCollateralPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GodPermission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *CommunityCDPWithdrawCollateralPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SoftwareUpgradePermission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommunityCDPWithdrawCollateralPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TextPermission) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommunityPoolLendWithdrawPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommunityCDPRepayDebtPermission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *CommunityPoolLendWithdrawPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityCDPWithdrawCollateralPermission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommunityPoolLendWithdrawPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolLendWithdrawPermission) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommunityPoolCancelPaymentStreamPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolCancelPaymentStreamPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolCancelPaymentStreamPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsChangePermission) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommunityPoolSwapPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSwapPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSwapPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AllowedParamsChanges) > 0 {
-		for _, e := range m.AllowedParamsChanges {
-			l = e.Size()
-			n += 1 + l + sovPermissions(uint64(l))
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AllowedParamsChange) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommunityPoolProvideLiquidityPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolProvideLiquidityPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolProvideLiquidityPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Subspace)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
 	}
-	if len(m.SingleSubparamAllowedAttrs) > 0 {
-		for _, s := range m.SingleSubparamAllowedAttrs {
-			l = len(s)
-			n += 1 + l + sovPermissions(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.MultiSubparamsRequirements) > 0 {
-		for _, e := range m.MultiSubparamsRequirements {
-			l = e.Size()
-			n += 1 + l + sovPermissions(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolWithdrawLiquidityPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolWithdrawLiquidityPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolWithdrawLiquidityPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxShares.Size()
+		i -= size
+		if _, err := m.MaxShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SubparamRequirement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamsChangePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsChangePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsChangePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
+	if len(m.AllowedParamsChanges) > 0 {
+		for iNdEx := len(m.AllowedParamsChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedParamsChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.Val)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AllowedSubparamAttrChanges) > 0 {
-		for _, s := range m.AllowedSubparamAttrChanges {
-			l = len(s)
-			n += 1 + l + sovPermissions(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *AllowedParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MultiSubparamsRequirements) > 0 {
+		for iNdEx := len(m.MultiSubparamsRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiSubparamsRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
+	if len(m.SingleSubparamAllowedAttrs) > 0 {
+		for iNdEx := len(m.SingleSubparamAllowedAttrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SingleSubparamAllowedAttrs[iNdEx])
+			copy(dAtA[i:], m.SingleSubparamAllowedAttrs[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.SingleSubparamAllowedAttrs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovPermissions(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SubparamRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozPermissions(x uint64) (n int) {
-	return sovPermissions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SubparamRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *GodPermission) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *SubparamRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSubparamAttrChanges) > 0 {
+		for iNdEx := len(m.AllowedSubparamAttrChanges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSubparamAttrChanges[iNdEx])
+			copy(dAtA[i:], m.AllowedSubparamAttrChanges[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.AllowedSubparamAttrChanges[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Val) > 0 {
+		i -= len(m.Val)
+		copy(dAtA[i:], m.Val)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Val)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmutilConversionPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmutilConversionPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmutilConversionPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedConversionPairs) > 0 {
+		for iNdEx := len(m.AllowedConversionPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedConversionPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedCosmosDenoms) > 0 {
+		for iNdEx := len(m.AllowedCosmosDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedCosmosDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapAllowedPoolsPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAllowedPoolsPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAllowedPoolsPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedPools) > 0 {
+		for iNdEx := len(m.AllowedPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EarnCommunityPoolPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarnCommunityPoolPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarnCommunityPoolPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldConstraints) > 0 {
+		for iNdEx := len(m.FieldConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeURL) > 0 {
+		i -= len(m.TypeURL)
+		copy(dAtA[i:], m.TypeURL)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.TypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPermissions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GodPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SoftwareUpgradePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TextPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommunityCDPRepayDebtPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommunityCDPWithdrawCollateralPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommunityPoolLendWithdrawPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommunityPoolCancelPaymentStreamPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommunityPoolSwapPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	return n
+}
+
+func (m *CommunityPoolProvideLiquidityPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	return n
+}
+
+func (m *CommunityPoolWithdrawLiquidityPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxShares.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	return n
+}
+
+func (m *ParamsChangePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedParamsChanges) > 0 {
+		for _, e := range m.AllowedParamsChanges {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.SingleSubparamAllowedAttrs) > 0 {
+		for _, s := range m.SingleSubparamAllowedAttrs {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.MultiSubparamsRequirements) > 0 {
+		for _, e := range m.MultiSubparamsRequirements {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubparamRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Val)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.AllowedSubparamAttrChanges) > 0 {
+		for _, s := range m.AllowedSubparamAttrChanges {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvmutilConversionPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedCosmosDenoms) > 0 {
+		for _, e := range m.AllowedCosmosDenoms {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.AllowedConversionPairs) > 0 {
+		for _, e := range m.AllowedConversionPairs {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapAllowedPoolsPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedPools) > 0 {
+		for _, e := range m.AllowedPools {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EarnCommunityPoolPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for _, e := range m.AllowedMsgs {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeURL)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.FieldConstraints) > 0 {
+		for _, e := range m.FieldConstraints {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPermissions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPermissions(x uint64) (n int) {
+	return sovPermissions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GodPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GodPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GodPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SoftwareUpgradePermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SoftwareUpgradePermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SoftwareUpgradePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityCDPRepayDebtPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityCDPRepayDebtPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityCDPRepayDebtPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityCDPWithdrawCollateralPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityCDPWithdrawCollateralPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityCDPWithdrawCollateralPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolLendWithdrawPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolLendWithdrawPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolLendWithdrawPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolCancelPaymentStreamPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolCancelPaymentStreamPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolCancelPaymentStreamPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolSwapPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSwapPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSwapPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolProvideLiquidityPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolProvideLiquidityPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolProvideLiquidityPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolWithdrawLiquidityPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolWithdrawLiquidityPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolWithdrawLiquidityPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsChangePermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowPermissions
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsChangePermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsChangePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedParamsChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AllowedParamsChanges = append(m.AllowedParamsChanges, AllowedParamsChange{})
+			if err := m.AllowedParamsChanges[len(m.AllowedParamsChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GodPermission: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GodPermission: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -951,7 +2741,7 @@ func (m *GodPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SoftwareUpgradePermission) Unmarshal(dAtA []byte) error {
+func (m *AllowedParamsChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -974,12 +2764,142 @@ func (m *SoftwareUpgradePermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SoftwareUpgradePermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedParamsChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SoftwareUpgradePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleSubparamAllowedAttrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SingleSubparamAllowedAttrs = append(m.SingleSubparamAllowedAttrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSubparamsRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiSubparamsRequirements = append(m.MultiSubparamsRequirements, SubparamRequirement{})
+			if err := m.MultiSubparamsRequirements[len(m.MultiSubparamsRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -1001,7 +2921,7 @@ func (m *SoftwareUpgradePermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextPermission) Unmarshal(dAtA []byte) error {
+func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1024,12 +2944,108 @@ func (m *TextPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubparamRequirement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubparamRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSubparamAttrChanges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSubparamAttrChanges = append(m.AllowedSubparamAttrChanges, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -1051,7 +3067,7 @@ func (m *TextPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityCDPRepayDebtPermission) Unmarshal(dAtA []byte) error {
+func (m *EvmutilConversionPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1074,12 +3090,80 @@ func (m *CommunityCDPRepayDebtPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityCDPRepayDebtPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvmutilConversionPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityCDPRepayDebtPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvmutilConversionPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCosmosDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCosmosDenoms = append(m.AllowedCosmosDenoms, types1.AllowedCosmosCoinERC20Token{})
+			if err := m.AllowedCosmosDenoms[len(m.AllowedCosmosDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedConversionPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedConversionPairs = append(m.AllowedConversionPairs, types1.ConversionPair{})
+			if err := m.AllowedConversionPairs[len(m.AllowedConversionPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -1101,7 +3185,7 @@ func (m *CommunityCDPRepayDebtPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityCDPWithdrawCollateralPermission) Unmarshal(dAtA []byte) error {
+func (m *SwapAllowedPoolsPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1124,12 +3208,46 @@ func (m *CommunityCDPWithdrawCollateralPermission) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityCDPWithdrawCollateralPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapAllowedPoolsPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityCDPWithdrawCollateralPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapAllowedPoolsPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPools = append(m.AllowedPools, types2.AllowedPool{})
+			if err := m.AllowedPools[len(m.AllowedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -1151,7 +3269,7 @@ func (m *CommunityCDPWithdrawCollateralPermission) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *CommunityPoolLendWithdrawPermission) Unmarshal(dAtA []byte) error {
+func (m *EarnCommunityPoolPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1174,12 +3292,46 @@ func (m *CommunityPoolLendWithdrawPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolLendWithdrawPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: EarnCommunityPoolPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolLendWithdrawPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EarnCommunityPoolPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -1201,7 +3353,7 @@ func (m *CommunityPoolLendWithdrawPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsChangePermission) Unmarshal(dAtA []byte) error {
+func (m *MsgPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1224,15 +3376,15 @@ func (m *ParamsChangePermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsChangePermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsChangePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedParamsChanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1259,8 +3411,8 @@ func (m *ParamsChangePermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedParamsChanges = append(m.AllowedParamsChanges, AllowedParamsChange{})
-			if err := m.AllowedParamsChanges[len(m.AllowedParamsChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowedMsgs = append(m.AllowedMsgs, AllowedMsg{})
+			if err := m.AllowedMsgs[len(m.AllowedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1285,7 +3437,7 @@ func (m *ParamsChangePermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedParamsChange) Unmarshal(dAtA []byte) error {
+func (m *AllowedMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1308,15 +3460,15 @@ func (m *AllowedParamsChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedParamsChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1344,75 +3496,11 @@ func (m *AllowedParamsChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subspace = string(dAtA[iNdEx:postIndex])
+			m.TypeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPermissions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SingleSubparamAllowedAttrs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPermissions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SingleSubparamAllowedAttrs = append(m.SingleSubparamAllowedAttrs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiSubparamsRequirements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldConstraints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1439,8 +3527,8 @@ func (m *AllowedParamsChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultiSubparamsRequirements = append(m.MultiSubparamsRequirements, SubparamRequirement{})
-			if err := m.MultiSubparamsRequirements[len(m.MultiSubparamsRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FieldConstraints = append(m.FieldConstraints, FieldConstraint{})
+			if err := m.FieldConstraints[len(m.FieldConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1465,7 +3553,7 @@ func (m *AllowedParamsChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
+func (m *FieldConstraint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,15 +3576,15 @@ func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubparamRequirement: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldConstraint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubparamRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1524,43 +3612,11 @@ func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPermissions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Val = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSubparamAttrChanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1588,7 +3644,7 @@ func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedSubparamAttrChanges = append(m.AllowedSubparamAttrChanges, string(dAtA[iNdEx:postIndex]))
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,6 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/stretchr/testify/require"
 
@@ -12,6 +15,7 @@ import (
 
 	"github.com/kava-labs/kava/x/committee/types"
 	communitytypes "github.com/kava-labs/kava/x/community/types"
+	earntypes "github.com/kava-labs/kava/x/earn/types"
 )
 
 func TestPackPermissions_Success(t *testing.T) {
@@ -116,6 +120,240 @@ func TestCommunityPoolLendWithdrawPermission_Allows(t *testing.T) {
 	}
 }
 
+func TestCommunityPoolCancelPaymentStreamPermission_Allows(t *testing.T) {
+	permission := types.CommunityPoolCancelPaymentStreamPermission{}
+	testcases := []struct {
+		name     string
+		proposal types.PubProposal
+		allowed  bool
+	}{
+		{
+			name: "allowed for correct proposal",
+			proposal: communitytypes.NewCommunityPoolCancelPaymentStreamProposal(
+				"cancel payment stream",
+				"this fake proposal stops a community pool payment stream",
+				1,
+			),
+			allowed: true,
+		},
+		{
+			name:     "fails for nil proposal",
+			proposal: nil,
+			allowed:  false,
+		},
+		{
+			name: "fails for payment stream proposal",
+			proposal: communitytypes.NewCommunityPoolPaymentStreamProposal(
+				"create payment stream",
+				"this fake proposal creates a community pool payment stream",
+				sdk.AccAddress("recipient").String(),
+				sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1e10))),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+				nil,
+			),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func TestCommunityPoolSwapPermission_Allows(t *testing.T) {
+	permission := types.CommunityPoolSwapPermission{
+		MaxAmount:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1e10))),
+		MaxSlippage: sdk.MustNewDecFromStr("0.01"),
+	}
+	newSwapProposalWithSlippage := func(exactTokenA sdk.Coin, slippage sdk.Dec) types.PubProposal {
+		return communitytypes.NewCommunityPoolSwapProposal(
+			"swap", "swaps community pool funds", exactTokenA, sdk.NewCoin("usdx", sdk.NewInt(1e6)), slippage, 1e10,
+		)
+	}
+	newSwapProposal := func(exactTokenA sdk.Coin) types.PubProposal {
+		return newSwapProposalWithSlippage(exactTokenA, sdk.MustNewDecFromStr("0.01"))
+	}
+	testcases := []struct {
+		name     string
+		proposal types.PubProposal
+		allowed  bool
+	}{
+		{
+			name:     "allowed for swap up to max amount",
+			proposal: newSwapProposal(sdk.NewCoin("ukava", sdk.NewInt(1e10))),
+			allowed:  true,
+		},
+		{
+			name:     "fails for swap above max amount",
+			proposal: newSwapProposal(sdk.NewCoin("ukava", sdk.NewInt(1e10+1))),
+			allowed:  false,
+		},
+		{
+			name:     "fails for swap of denom without max amount",
+			proposal: newSwapProposal(sdk.NewCoin("hard", sdk.NewInt(1))),
+			allowed:  false,
+		},
+		{
+			name:     "fails for swap above max slippage",
+			proposal: newSwapProposalWithSlippage(sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.MustNewDecFromStr("0.011")),
+			allowed:  false,
+		},
+		{
+			name:     "fails for nil proposal",
+			proposal: nil,
+			allowed:  false,
+		},
+		{
+			name: "fails for wrong proposal",
+			proposal: communitytypes.NewCommunityPoolProvideLiquidityProposal(
+				"deposit", "deposits community pool funds", sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.NewInt(1)), sdk.MustNewDecFromStr("0.01"), 1e10,
+			),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func TestCommunityPoolProvideLiquidityPermission_Allows(t *testing.T) {
+	permission := types.CommunityPoolProvideLiquidityPermission{
+		MaxAmount:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1e10)), sdk.NewCoin("usdx", sdk.NewInt(1e9))),
+		MaxSlippage: sdk.MustNewDecFromStr("0.01"),
+	}
+	newDepositProposalWithSlippage := func(tokenA, tokenB sdk.Coin, slippage sdk.Dec) types.PubProposal {
+		return communitytypes.NewCommunityPoolProvideLiquidityProposal(
+			"deposit", "deposits community pool funds", tokenA, tokenB, slippage, 1e10,
+		)
+	}
+	newDepositProposal := func(tokenA, tokenB sdk.Coin) types.PubProposal {
+		return newDepositProposalWithSlippage(tokenA, tokenB, sdk.MustNewDecFromStr("0.01"))
+	}
+	testcases := []struct {
+		name     string
+		proposal types.PubProposal
+		allowed  bool
+	}{
+		{
+			name:     "allowed for deposit up to max amount",
+			proposal: newDepositProposal(sdk.NewCoin("ukava", sdk.NewInt(1e10)), sdk.NewCoin("usdx", sdk.NewInt(1e9))),
+			allowed:  true,
+		},
+		{
+			name:     "fails for token a above max amount",
+			proposal: newDepositProposal(sdk.NewCoin("ukava", sdk.NewInt(1e10+1)), sdk.NewCoin("usdx", sdk.NewInt(1))),
+			allowed:  false,
+		},
+		{
+			name:     "fails for token b above max amount",
+			proposal: newDepositProposal(sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.NewInt(1e9+1))),
+			allowed:  false,
+		},
+		{
+			name:     "fails for deposit of denom without max amount",
+			proposal: newDepositProposal(sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("hard", sdk.NewInt(1))),
+			allowed:  false,
+		},
+		{
+			name:     "fails for deposit above max slippage",
+			proposal: newDepositProposalWithSlippage(sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.NewInt(1)), sdk.MustNewDecFromStr("0.011")),
+			allowed:  false,
+		},
+		{
+			name:     "fails for nil proposal",
+			proposal: nil,
+			allowed:  false,
+		},
+		{
+			name: "fails for wrong proposal",
+			proposal: communitytypes.NewCommunityPoolSwapProposal(
+				"swap", "swaps community pool funds", sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.NewInt(1)), sdk.MustNewDecFromStr("0.01"), 1e10,
+			),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func TestCommunityPoolWithdrawLiquidityPermission_Allows(t *testing.T) {
+	permission := types.CommunityPoolWithdrawLiquidityPermission{
+		MaxShares: sdk.NewInt(1e6),
+	}
+	newWithdrawProposal := func(shares sdkmath.Int, minTokenA, minTokenB sdk.Coin) types.PubProposal {
+		return communitytypes.NewCommunityPoolWithdrawLiquidityProposal(
+			"withdraw", "withdraws community pool liquidity", shares, minTokenA, minTokenB, 1e10,
+		)
+	}
+	testcases := []struct {
+		name       string
+		permission types.CommunityPoolWithdrawLiquidityPermission
+		proposal   types.PubProposal
+		allowed    bool
+	}{
+		{
+			name:       "allowed for withdraw up to max shares",
+			permission: permission,
+			proposal:   newWithdrawProposal(sdk.NewInt(1e6), sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.NewInt(1))),
+			allowed:    true,
+		},
+		{
+			name:       "fails for shares above max shares",
+			permission: permission,
+			proposal:   newWithdrawProposal(sdk.NewInt(1e6+1), sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.NewInt(1))),
+			allowed:    false,
+		},
+		{
+			name:       "fails for zero min token a",
+			permission: permission,
+			proposal:   newWithdrawProposal(sdk.NewInt(1e6), sdk.NewCoin("ukava", sdk.ZeroInt()), sdk.NewCoin("usdx", sdk.NewInt(1))),
+			allowed:    false,
+		},
+		{
+			name:       "fails for zero min token b",
+			permission: permission,
+			proposal:   newWithdrawProposal(sdk.NewInt(1e6), sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.ZeroInt())),
+			allowed:    false,
+		},
+		{
+			name:       "fails for unset max shares",
+			permission: types.CommunityPoolWithdrawLiquidityPermission{},
+			proposal:   newWithdrawProposal(sdk.NewInt(1), sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.NewInt(1))),
+			allowed:    false,
+		},
+		{
+			name:       "fails for nil proposal",
+			permission: permission,
+			proposal:   nil,
+			allowed:    false,
+		},
+		{
+			name:       "fails for wrong proposal",
+			permission: permission,
+			proposal: communitytypes.NewCommunityPoolProvideLiquidityProposal(
+				"deposit", "deposits community pool funds", sdk.NewCoin("ukava", sdk.NewInt(1)), sdk.NewCoin("usdx", sdk.NewInt(1)), sdk.MustNewDecFromStr("0.01"), 1e10,
+			),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, tc.permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
 func TestCommunityCDPWithdrawCollateralPermission_Allows(t *testing.T) {
 	permission := types.CommunityCDPWithdrawCollateralPermission{}
 	testcases := []struct {
@@ -154,6 +392,161 @@ func TestCommunityCDPWithdrawCollateralPermission_Allows(t *testing.T) {
 	}
 }
 
+func TestEarnCommunityPoolPermission_Allows(t *testing.T) {
+	permission := types.EarnCommunityPoolPermission{
+		MaxAmount: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1e10))),
+	}
+	testcases := []struct {
+		name     string
+		proposal types.PubProposal
+		allowed  bool
+	}{
+		{
+			name: "allowed for deposit up to max amount",
+			proposal: earntypes.NewCommunityPoolDepositProposal(
+				"deposit", "deposits from the community pool", sdk.NewCoin("usdx", sdk.NewInt(1e10)),
+			),
+			allowed: true,
+		},
+		{
+			name: "allowed for withdraw below max amount",
+			proposal: earntypes.NewCommunityPoolWithdrawProposal(
+				"withdraw", "withdraws to the community pool", sdk.NewCoin("usdx", sdk.NewInt(1e9)),
+			),
+			allowed: true,
+		},
+		{
+			name: "fails for deposit above max amount",
+			proposal: earntypes.NewCommunityPoolDepositProposal(
+				"deposit", "deposits from the community pool", sdk.NewCoin("usdx", sdk.NewInt(1e10+1)),
+			),
+			allowed: false,
+		},
+		{
+			name: "fails for withdraw of denom without max amount",
+			proposal: earntypes.NewCommunityPoolWithdrawProposal(
+				"withdraw", "withdraws to the community pool", sdk.NewCoin("ukava", sdk.NewInt(1)),
+			),
+			allowed: false,
+		},
+		{
+			name:     "fails for nil proposal",
+			proposal: nil,
+			allowed:  false,
+		},
+		{
+			name: "fails for wrong proposal",
+			proposal: communitytypes.NewCommunityPoolLendWithdrawProposal(
+				"withdraw lend position",
+				"this fake proposal withdraws a lend position for the community pool",
+				sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1))),
+			),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func TestMsgPermission_Allows(t *testing.T) {
+	committeeAddr := types.GetCommitteeAddress(1)
+	updateMembersMsg := types.NewMsgUpdateCommitteeMembers(committeeAddr, 1, nil, []sdk.AccAddress{sdk.AccAddress("member1")})
+	voteMsg := types.NewMsgVote(committeeAddr, 1, types.VOTE_TYPE_YES)
+
+	newProposal := func(msgs ...sdk.Msg) types.PubProposal {
+		proposal := types.MustNewExecuteMsgsProposal("A Title", "A description of this proposal.", msgs)
+		return &proposal
+	}
+	updateMembersTypeURL := sdk.MsgTypeURL(&types.MsgUpdateCommitteeMembers{})
+
+	testcases := []struct {
+		name          string
+		permission    types.MsgPermission
+		pubProposal   types.PubProposal
+		expectAllowed bool
+	}{
+		{
+			name: "allowed (msg type)",
+			permission: types.MsgPermission{AllowedMsgs: []types.AllowedMsg{
+				{TypeURL: updateMembersTypeURL},
+			}},
+			pubProposal:   newProposal(updateMembersMsg),
+			expectAllowed: true,
+		},
+		{
+			name: "allowed (field constraint)",
+			permission: types.MsgPermission{AllowedMsgs: []types.AllowedMsg{
+				{
+					TypeURL: updateMembersTypeURL,
+					FieldConstraints: []types.FieldConstraint{
+						{Field: "committee_id", AllowedValues: []string{`"2"`, `"1"`}},
+					},
+				},
+			}},
+			pubProposal:   newProposal(updateMembersMsg),
+			expectAllowed: true,
+		},
+		{
+			name: "not allowed (field value)",
+			permission: types.MsgPermission{AllowedMsgs: []types.AllowedMsg{
+				{
+					TypeURL: updateMembersTypeURL,
+					FieldConstraints: []types.FieldConstraint{
+						{Field: "committee_id", AllowedValues: []string{`"2"`}},
+					},
+				},
+			}},
+			pubProposal:   newProposal(updateMembersMsg),
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (unknown field)",
+			permission: types.MsgPermission{AllowedMsgs: []types.AllowedMsg{
+				{
+					TypeURL: updateMembersTypeURL,
+					FieldConstraints: []types.FieldConstraint{
+						{Field: "committee_id.value", AllowedValues: []string{`"1"`}},
+					},
+				},
+			}},
+			pubProposal:   newProposal(updateMembersMsg),
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (one msg type not allowed)",
+			permission: types.MsgPermission{AllowedMsgs: []types.AllowedMsg{
+				{TypeURL: updateMembersTypeURL},
+			}},
+			pubProposal:   newProposal(updateMembersMsg, voteMsg),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (no allowed msgs)",
+			permission:    types.MsgPermission{},
+			pubProposal:   newProposal(updateMembersMsg),
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (mismatched pubproposal type)",
+			permission: types.MsgPermission{AllowedMsgs: []types.AllowedMsg{
+				{TypeURL: updateMembersTypeURL},
+			}},
+			pubProposal:   govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectAllowed, tc.permission.Allows(sdk.Context{}, nil, tc.pubProposal))
+		})
+	}
+}
+
 func TestParamsChangePermission_SimpleParamsChange_Allows(t *testing.T) {
 	testPermission := types.ParamsChangePermission{
 		AllowedParamsChanges: types.AllowedParamsChanges{
@@ -3,12 +3,16 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 const (
 	ProposalTypeCommitteeChange = "CommitteeChange"
 	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeCommitteeVeto   = "CommitteeVeto"
+	ProposalTypeExecuteMsgs     = "ExecuteMsgs"
 )
 
 // ProposalOutcome indicates the status of a proposal when it's closed and deleted from the store
@@ -21,12 +25,15 @@ const (
 	Failed
 	// Invalid indicates that proposal passed but an error occurred when attempting to enact it
 	Invalid
+	// Queued indicates that the proposal passed and will be enacted once the committee's execution delay has elapsed
+	Queued
 )
 
 var toString = map[ProposalOutcome]string{
 	Passed:  "Passed",
 	Failed:  "Failed",
 	Invalid: "Invalid",
+	Queued:  "Queued",
 }
 
 func (p ProposalOutcome) String() string {
@@ -34,16 +41,20 @@ func (p ProposalOutcome) String() string {
 }
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govv1beta1.Content = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}
-var _, _ PubProposal = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}
+var _, _, _, _ govv1beta1.Content = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}, &CommitteeVetoProposal{}, &ExecuteMsgsProposal{}
+var _, _, _, _ PubProposal = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}, &CommitteeVetoProposal{}, &ExecuteMsgsProposal{}
 
-// ensure CommitteeChangeProposal fulfill the codectypes.UnpackInterfacesMessage interface
-var _ codectypes.UnpackInterfacesMessage = &CommitteeChangeProposal{}
+// ensure CommitteeChangeProposal and ExecuteMsgsProposal fulfill the codectypes.UnpackInterfacesMessage interface
+var _, _ codectypes.UnpackInterfacesMessage = &CommitteeChangeProposal{}, &ExecuteMsgsProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
 	govv1beta1.RegisterProposalType(ProposalTypeCommitteeChange)
 	govv1beta1.RegisterProposalType(ProposalTypeCommitteeDelete)
+	govv1beta1.RegisterProposalType(ProposalTypeCommitteeVeto)
+	// ExecuteMsgsProposals are committee only, x/gov executes messages natively. The type is registered so
+	// ValidateAbstract accepts it.
+	govv1beta1.RegisterProposalType(ProposalTypeExecuteMsgs)
 }
 
 func NewCommitteeChangeProposal(title string, description string, newCommittee Committee) (CommitteeChangeProposal, error) {
@@ -132,3 +143,92 @@ func (cdp CommitteeDeleteProposal) ProposalType() string { return ProposalTypeCo
 func (cdp CommitteeDeleteProposal) ValidateBasic() error {
 	return govv1beta1.ValidateAbstract(&cdp)
 }
+
+func NewCommitteeVetoProposal(title string, description string, proposalID uint64) CommitteeVetoProposal {
+	return CommitteeVetoProposal{
+		Title:       title,
+		Description: description,
+		ProposalID:  proposalID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (cvp CommitteeVetoProposal) GetTitle() string { return cvp.Title }
+
+// GetDescription returns the description of the proposal.
+func (cvp CommitteeVetoProposal) GetDescription() string { return cvp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (cvp CommitteeVetoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (cvp CommitteeVetoProposal) ProposalType() string { return ProposalTypeCommitteeVeto }
+
+// ValidateBasic runs basic stateless validity checks
+func (cvp CommitteeVetoProposal) ValidateBasic() error {
+	return govv1beta1.ValidateAbstract(&cvp)
+}
+
+// NewExecuteMsgsProposal returns a new ExecuteMsgsProposal
+func NewExecuteMsgsProposal(title string, description string, msgs []sdk.Msg) (ExecuteMsgsProposal, error) {
+	msgsAny, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return ExecuteMsgsProposal{}, err
+	}
+	return ExecuteMsgsProposal{
+		Title:       title,
+		Description: description,
+		Messages:    msgsAny,
+	}, nil
+}
+
+// MustNewExecuteMsgsProposal returns a new ExecuteMsgsProposal and panics on error
+func MustNewExecuteMsgsProposal(title string, description string, msgs []sdk.Msg) ExecuteMsgsProposal {
+	proposal, err := NewExecuteMsgsProposal(title, description, msgs)
+	if err != nil {
+		panic(err)
+	}
+	return proposal
+}
+
+// GetTitle returns the title of the proposal.
+func (emp ExecuteMsgsProposal) GetTitle() string { return emp.Title }
+
+// GetDescription returns the description of the proposal.
+func (emp ExecuteMsgsProposal) GetDescription() string { return emp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (emp ExecuteMsgsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (emp ExecuteMsgsProposal) ProposalType() string { return ProposalTypeExecuteMsgs }
+
+// GetMsgs unpacks the proposal's messages.
+func (emp ExecuteMsgsProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(emp.Messages, "committee proposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (emp ExecuteMsgsProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, emp.Messages)
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (emp ExecuteMsgsProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(&emp); err != nil {
+		return err
+	}
+	if len(emp.Messages) == 0 {
+		return errorsmod.Wrap(ErrInvalidPubProposal, "proposal must contain at least one message")
+	}
+	msgs, err := emp.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPubProposal, "msg %d: %s", i, err)
+		}
+	}
+	return nil
+}
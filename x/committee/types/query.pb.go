@@ -659,6 +659,165 @@ func (m *QueryTallyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTallyResponse proto.InternalMessageInfo
 
+// QueryQueuedProposalsRequest defines the request type for querying x/committee queued proposals.
+type QueryQueuedProposalsRequest struct {
+	// committee_id filters the queued proposals by committee. Zero returns queued proposals of all committees.
+	CommitteeId uint64             `protobuf:"varint,1,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedProposalsRequest) Reset()         { *m = QueryQueuedProposalsRequest{} }
+func (m *QueryQueuedProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalsRequest) ProtoMessage()    {}
+func (*QueryQueuedProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{16}
+}
+func (m *QueryQueuedProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalsRequest.Merge(m, src)
+}
+func (m *QueryQueuedProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalsRequest proto.InternalMessageInfo
+
+// QueryQueuedProposalsResponse defines the response type for querying x/committee queued proposals.
+type QueryQueuedProposalsResponse struct {
+	QueuedProposals []QueryQueuedProposalResponse `protobuf:"bytes,1,rep,name=queued_proposals,json=queuedProposals,proto3" json:"queued_proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedProposalsResponse) Reset()         { *m = QueryQueuedProposalsResponse{} }
+func (m *QueryQueuedProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalsResponse) ProtoMessage()    {}
+func (*QueryQueuedProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{17}
+}
+func (m *QueryQueuedProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalsResponse.Merge(m, src)
+}
+func (m *QueryQueuedProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalsResponse proto.InternalMessageInfo
+
+// QueryQueuedProposalRequest defines the request type for querying x/committee queued proposal.
+type QueryQueuedProposalRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryQueuedProposalRequest) Reset()         { *m = QueryQueuedProposalRequest{} }
+func (m *QueryQueuedProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalRequest) ProtoMessage()    {}
+func (*QueryQueuedProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{18}
+}
+func (m *QueryQueuedProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalRequest.Merge(m, src)
+}
+func (m *QueryQueuedProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalRequest proto.InternalMessageInfo
+
+// QueryQueuedProposalResponse defines the response type for querying x/committee queued proposal.
+type QueryQueuedProposalResponse struct {
+	PubProposal   *types.Any `protobuf:"bytes,1,opt,name=pub_proposal,json=pubProposal,proto3" json:"pub_proposal,omitempty"`
+	ID            uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CommitteeID   uint64     `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	ExecutionTime time.Time  `protobuf:"bytes,4,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+}
+
+func (m *QueryQueuedProposalResponse) Reset()         { *m = QueryQueuedProposalResponse{} }
+func (m *QueryQueuedProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalResponse) ProtoMessage()    {}
+func (*QueryQueuedProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{19}
+}
+func (m *QueryQueuedProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalResponse.Merge(m, src)
+}
+func (m *QueryQueuedProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalResponse proto.InternalMessageInfo
+
 // QueryRawParamsRequest defines the request type for querying x/committee raw params.
 type QueryRawParamsRequest struct {
 	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
@@ -669,7 +828,7 @@ func (m *QueryRawParamsRequest) Reset()         { *m = QueryRawParamsRequest{} }
 func (m *QueryRawParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawParamsRequest) ProtoMessage()    {}
 func (*QueryRawParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{16}
+	return fileDescriptor_b81d271efeb6eee5, []int{20}
 }
 func (m *QueryRawParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +866,7 @@ func (m *QueryRawParamsResponse) Reset()         { *m = QueryRawParamsResponse{}
 func (m *QueryRawParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawParamsResponse) ProtoMessage()    {}
 func (*QueryRawParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{17}
+	return fileDescriptor_b81d271efeb6eee5, []int{21}
 }
 func (m *QueryRawParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,6 +912,10 @@ func init() {
 	proto.RegisterType((*QueryVoteResponse)(nil), "kava.committee.v1beta1.QueryVoteResponse")
 	proto.RegisterType((*QueryTallyRequest)(nil), "kava.committee.v1beta1.QueryTallyRequest")
 	proto.RegisterType((*QueryTallyResponse)(nil), "kava.committee.v1beta1.QueryTallyResponse")
+	proto.RegisterType((*QueryQueuedProposalsRequest)(nil), "kava.committee.v1beta1.QueryQueuedProposalsRequest")
+	proto.RegisterType((*QueryQueuedProposalsResponse)(nil), "kava.committee.v1beta1.QueryQueuedProposalsResponse")
+	proto.RegisterType((*QueryQueuedProposalRequest)(nil), "kava.committee.v1beta1.QueryQueuedProposalRequest")
+	proto.RegisterType((*QueryQueuedProposalResponse)(nil), "kava.committee.v1beta1.QueryQueuedProposalResponse")
 	proto.RegisterType((*QueryRawParamsRequest)(nil), "kava.committee.v1beta1.QueryRawParamsRequest")
 	proto.RegisterType((*QueryRawParamsResponse)(nil), "kava.committee.v1beta1.QueryRawParamsResponse")
 }
@@ -762,83 +925,91 @@ func init() {
 }
 
 var fileDescriptor_b81d271efeb6eee5 = []byte{
-	// 1211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xc1, 0x6f, 0xe3, 0xc4,
-	0x17, 0xc7, 0xeb, 0x34, 0xed, 0x26, 0x2f, 0xdb, 0xfe, 0xfa, 0x1b, 0x95, 0x92, 0x86, 0x55, 0xd2,
-	0x35, 0xab, 0xa5, 0x5b, 0x88, 0x4d, 0x53, 0x50, 0x05, 0xa2, 0x82, 0x4d, 0xdb, 0x45, 0x11, 0x12,
-	0xea, 0x9a, 0xc2, 0x81, 0x95, 0x88, 0x26, 0xf5, 0x6c, 0x6a, 0x35, 0xb1, 0x5d, 0x8f, 0xd3, 0x36,
-	0x2a, 0xbd, 0x70, 0x47, 0x5a, 0x09, 0x81, 0xb4, 0x07, 0x24, 0x84, 0x40, 0x42, 0xe2, 0x86, 0xf6,
-	0x8f, 0xa8, 0xf6, 0xb4, 0x12, 0x17, 0xc4, 0x21, 0x40, 0xca, 0x1f, 0x82, 0x3c, 0x33, 0x9e, 0xb8,
-	0x49, 0xdb, 0xb8, 0x39, 0x25, 0xb6, 0xdf, 0xfb, 0xce, 0x67, 0xde, 0xbc, 0x79, 0xef, 0x81, 0xba,
-	0x87, 0x0f, 0xb0, 0xbe, 0xe3, 0x34, 0x9b, 0x96, 0xef, 0x13, 0xa2, 0x1f, 0x2c, 0xd7, 0x88, 0x8f,
-	0x97, 0xf5, 0xfd, 0x16, 0xf1, 0xda, 0x9a, 0xeb, 0x39, 0xbe, 0x83, 0xe6, 0x02, 0x1b, 0x4d, 0xda,
-	0x68, 0xc2, 0x26, 0xb7, 0xb4, 0xe3, 0xd0, 0xa6, 0x43, 0xf5, 0x1a, 0xa6, 0x84, 0x3b, 0x48, 0x77,
-	0x17, 0xd7, 0x2d, 0x1b, 0xfb, 0x96, 0x63, 0x73, 0x8d, 0xdc, 0x3c, 0xb7, 0xad, 0xb2, 0x27, 0x9d,
-	0x3f, 0x88, 0x4f, 0xb3, 0x75, 0xa7, 0xee, 0xf0, 0xf7, 0xc1, 0x3f, 0xf1, 0xf6, 0x56, 0xdd, 0x71,
-	0xea, 0x0d, 0xa2, 0x63, 0xd7, 0xd2, 0xb1, 0x6d, 0x3b, 0x3e, 0x53, 0x0b, 0x7d, 0xe6, 0xc5, 0x57,
-	0xf6, 0x54, 0x6b, 0x3d, 0xd6, 0xb1, 0x2d, 0x68, 0x73, 0x85, 0xfe, 0x4f, 0xbe, 0xd5, 0x24, 0xd4,
-	0xc7, 0x4d, 0x57, 0x18, 0xdc, 0xb9, 0x64, 0xcb, 0x75, 0x62, 0x13, 0x6a, 0x89, 0x15, 0xd4, 0x2c,
-	0xcc, 0x3d, 0x0c, 0xb6, 0xb4, 0x1e, 0xda, 0x51, 0x83, 0xec, 0xb7, 0x08, 0xf5, 0xd5, 0x2f, 0xe0,
-	0xe5, 0x81, 0x2f, 0xd4, 0x75, 0x6c, 0x4a, 0xd0, 0x3a, 0x80, 0xd4, 0xa5, 0x59, 0x65, 0x61, 0x7c,
-	0x31, 0x53, 0x9a, 0xd5, 0x38, 0x90, 0x16, 0x02, 0x69, 0xf7, 0xed, 0x76, 0x79, 0xea, 0xf9, 0xb3,
-	0x62, 0x5a, 0x2a, 0x18, 0x11, 0x37, 0xf5, 0x5d, 0x78, 0xe9, 0xbc, 0xbe, 0x58, 0x18, 0xdd, 0x86,
-	0x9b, 0xd2, 0xac, 0x6a, 0x99, 0x59, 0x65, 0x41, 0x59, 0x4c, 0x1a, 0x19, 0xf9, 0xae, 0x62, 0xaa,
-	0x8f, 0xfa, 0xa9, 0x25, 0xda, 0x7d, 0x48, 0x4b, 0x43, 0xe6, 0x19, 0x93, 0xac, 0xe7, 0x25, 0xc1,
-	0xb6, 0x3c, 0xc7, 0x75, 0x28, 0x6e, 0xd0, 0x6b, 0x80, 0xed, 0x09, 0xb0, 0x88, 0xaf, 0x00, 0x7b,
-	0x08, 0x69, 0x37, 0x7c, 0x29, 0x42, 0x56, 0xd4, 0x2e, 0xce, 0x38, 0xed, 0x9c, 0x44, 0xa8, 0x50,
-	0x4e, 0x9e, 0x76, 0x0a, 0x63, 0x46, 0x4f, 0x45, 0x5d, 0x85, 0xd9, 0x3e, 0x4b, 0xce, 0x59, 0x80,
-	0x4c, 0x68, 0xd4, 0xc3, 0x84, 0xf0, 0x55, 0xc5, 0x54, 0xbf, 0x4e, 0xf4, 0x6d, 0x51, 0x52, 0x3e,
-	0x86, 0x9b, 0x6e, 0xab, 0x56, 0x0d, 0x6d, 0xaf, 0x8c, 0x60, 0xb1, 0xdb, 0x29, 0x64, 0xb6, 0x5a,
-	0xb5, 0x50, 0xe4, 0xf9, 0xb3, 0x62, 0x4e, 0x64, 0x7c, 0xdd, 0x39, 0x90, 0x9b, 0x59, 0x77, 0x6c,
-	0x9f, 0xd8, 0xbe, 0x91, 0x71, 0x7b, 0xa6, 0x68, 0x0e, 0x12, 0x96, 0x99, 0x4d, 0x04, 0x64, 0xe5,
-	0xc9, 0x6e, 0xa7, 0x90, 0xa8, 0x6c, 0x18, 0x09, 0xcb, 0x44, 0xa5, 0xbe, 0x10, 0x8f, 0x33, 0x8b,
-	0xff, 0x05, 0x2b, 0xc9, 0xb3, 0xaa, 0x6c, 0x9c, 0x8b, 0x39, 0xfa, 0x00, 0x52, 0x26, 0xc1, 0x66,
-	0xc3, 0xb2, 0x49, 0x36, 0xc9, 0x78, 0x73, 0x03, 0xbc, 0xdb, 0xe1, 0xe5, 0x28, 0xa7, 0x82, 0x28,
-	0x3e, 0xf9, 0xab, 0xa0, 0x18, 0xd2, 0x4b, 0xbd, 0x05, 0x39, 0x16, 0x8e, 0x8f, 0xc9, 0x91, 0x1f,
-	0x22, 0x56, 0x36, 0xc2, 0x8b, 0xf0, 0x08, 0x5e, 0xb9, 0xf0, 0xab, 0x08, 0xd9, 0x7b, 0x30, 0x63,
-	0x93, 0x23, 0xbf, 0x3a, 0x10, 0xf2, 0x32, 0xea, 0x76, 0x0a, 0xd3, 0x7d, 0x5e, 0xd3, 0x76, 0xf4,
-	0xd9, 0x54, 0xbf, 0x84, 0xff, 0x33, 0xf1, 0xcf, 0x1c, 0x5f, 0x5e, 0xbd, 0xa1, 0x07, 0x88, 0x1e,
-	0x00, 0xf4, 0x4a, 0x0f, 0x0b, 0x63, 0xa6, 0x74, 0x57, 0x13, 0xc1, 0x0f, 0xea, 0x94, 0xc6, 0x0b,
-	0x5b, 0x78, 0x06, 0x5b, 0xb8, 0x1e, 0x5e, 0x2f, 0x23, 0xe2, 0xa9, 0xfe, 0xa4, 0x00, 0x8a, 0x2e,
-	0x2f, 0xb6, 0xb4, 0x09, 0x13, 0x07, 0xc1, 0x0b, 0x91, 0xa7, 0xf7, 0xae, 0xcc, 0xd3, 0xc0, 0xb5,
-	0x2f, 0x47, 0xb9, 0x37, 0xfa, 0xf0, 0x02, 0xca, 0xd7, 0x86, 0x52, 0x72, 0xa5, 0x73, 0x98, 0x15,
-	0x98, 0x89, 0x2c, 0x15, 0x33, 0x46, 0xb3, 0x7c, 0x13, 0x1e, 0x5b, 0x38, 0xcd, 0x99, 0x3c, 0xf5,
-	0xa9, 0x12, 0x09, 0xb8, 0xdc, 0xb0, 0x7e, 0x81, 0x58, 0x79, 0xba, 0xdb, 0x29, 0x40, 0xe4, 0xe8,
-	0x86, 0x8a, 0xa3, 0x35, 0x48, 0x07, 0x7f, 0xaa, 0x7e, 0xdb, 0x25, 0x2c, 0x75, 0xa7, 0x4b, 0x0b,
-	0x97, 0xc5, 0x2e, 0x58, 0x7f, 0xbb, 0xed, 0x12, 0x23, 0x75, 0x20, 0xfe, 0xa9, 0x6f, 0x09, 0xb4,
-	0x6d, 0xdc, 0x68, 0xb4, 0x63, 0x5f, 0xe6, 0x5f, 0x92, 0xe2, 0x0c, 0x85, 0xdb, 0xa8, 0x5b, 0xfa,
-	0x08, 0xd2, 0x6d, 0x42, 0xab, 0xfc, 0xe0, 0xd9, 0xb6, 0xca, 0x5a, 0x70, 0x9a, 0x7f, 0x76, 0x0a,
-	0x77, 0xeb, 0x96, 0xbf, 0xdb, 0xaa, 0x05, 0xbb, 0x10, 0x3d, 0x4d, 0xfc, 0x14, 0xa9, 0xb9, 0xa7,
-	0x07, 0xbb, 0xa5, 0xda, 0x06, 0xd9, 0x31, 0x52, 0x6d, 0x42, 0x59, 0x26, 0xa1, 0x0a, 0xa4, 0x6c,
-	0x47, 0x68, 0x8d, 0x8f, 0xa4, 0x75, 0xc3, 0x76, 0xb8, 0xd4, 0x27, 0x30, 0xb5, 0xd3, 0xf2, 0x3c,
-	0x62, 0xfb, 0x42, 0x2f, 0x39, 0x92, 0xde, 0x4d, 0x21, 0xc2, 0x45, 0x3f, 0x85, 0x69, 0xd7, 0xa1,
-	0xd4, 0xaa, 0x35, 0x88, 0x50, 0x9d, 0x18, 0x49, 0x75, 0x2a, 0x54, 0x91, 0xb2, 0x3c, 0x01, 0x76,
-	0x3d, 0x42, 0x77, 0x9d, 0x86, 0x99, 0x9d, 0x1c, 0x4d, 0x96, 0xe5, 0x44, 0x28, 0x82, 0x1e, 0xc0,
-	0xe4, 0x7e, 0xcb, 0xf1, 0x5a, 0xcd, 0xec, 0x8d, 0x91, 0xe4, 0x84, 0xb7, 0xba, 0x29, 0xca, 0xbe,
-	0x81, 0x0f, 0xb7, 0xb0, 0x87, 0x9b, 0xb2, 0xe0, 0xe4, 0x20, 0x45, 0x5b, 0x35, 0xea, 0xe2, 0x1d,
-	0xde, 0x34, 0xd3, 0x86, 0x7c, 0x46, 0x33, 0x30, 0xbe, 0x47, 0xda, 0x22, 0xd1, 0x83, 0xbf, 0xea,
-	0x8a, 0x68, 0x72, 0x11, 0x19, 0x91, 0x74, 0xf3, 0x90, 0xf2, 0xf0, 0x61, 0xd5, 0xc4, 0x3e, 0x16,
-	0x3a, 0x37, 0x3c, 0x7c, 0xb8, 0x81, 0x7d, 0x5c, 0xfa, 0x2d, 0x03, 0x13, 0xcc, 0x0b, 0x3d, 0x55,
-	0x00, 0x7a, 0x43, 0x05, 0xd2, 0xae, 0xac, 0x2e, 0x03, 0x73, 0x49, 0x4e, 0x8f, 0x6d, 0xcf, 0xa1,
-	0xd4, 0xa5, 0xaf, 0x7e, 0xff, 0xf7, 0x9b, 0xc4, 0x1d, 0xa4, 0xea, 0x97, 0x4c, 0x44, 0xbd, 0xa1,
-	0x04, 0xfd, 0xac, 0x40, 0x6f, 0x28, 0x40, 0xc5, 0x78, 0x4b, 0x85, 0x64, 0x5a, 0x5c, 0x73, 0x01,
-	0xf6, 0x0e, 0x03, 0x5b, 0x41, 0xcb, 0xc3, 0xc1, 0xf4, 0xe3, 0x68, 0x5b, 0x3c, 0x41, 0xdf, 0x2a,
-	0x90, 0x96, 0x33, 0x06, 0x8a, 0x37, 0x48, 0xd0, 0x78, 0x9c, 0x03, 0xa3, 0x8b, 0x7a, 0x8f, 0x71,
-	0xbe, 0x8a, 0x6e, 0x5f, 0xc6, 0x29, 0x47, 0x12, 0xf4, 0x83, 0x02, 0x29, 0xd9, 0xe4, 0xdf, 0x88,
-	0x39, 0xdf, 0x70, 0xaa, 0xeb, 0x4d, 0x43, 0xea, 0x2a, 0x83, 0x5a, 0x46, 0xfa, 0x50, 0x28, 0xfd,
-	0x38, 0x52, 0x08, 0x4f, 0xd0, 0xaf, 0x0a, 0xf4, 0x35, 0x65, 0x54, 0xba, 0x72, 0xe9, 0x0b, 0xa7,
-	0x82, 0xdc, 0xca, 0xb5, 0x7c, 0x04, 0xf4, 0x9b, 0x0c, 0x7a, 0x09, 0x2d, 0x5e, 0x06, 0x1d, 0x4c,
-	0x07, 0xc5, 0x10, 0xb7, 0x68, 0x99, 0xe8, 0x7b, 0x05, 0x26, 0x78, 0x6d, 0x19, 0xde, 0x85, 0xe5,
-	0x01, 0x2f, 0xc5, 0x31, 0x15, 0x48, 0x6b, 0x0c, 0x69, 0x15, 0xbd, 0x7d, 0xcd, 0x38, 0xea, 0xbc,
-	0xc7, 0xff, 0xa8, 0x40, 0x32, 0x10, 0x44, 0x8b, 0x31, 0x86, 0x04, 0x4e, 0x17, 0x7f, 0x9c, 0x50,
-	0x37, 0x19, 0xdc, 0xfb, 0x68, 0x6d, 0x24, 0x38, 0xfd, 0x98, 0xb5, 0xe5, 0x13, 0x16, 0x44, 0xd6,
-	0x1d, 0x87, 0x04, 0x31, 0xda, 0x78, 0x87, 0x04, 0xf1, 0x5c, 0xb3, 0x1d, 0x3d, 0x88, 0x3e, 0xa3,
-	0xfa, 0x4e, 0x81, 0xb4, 0x2c, 0xa6, 0x43, 0x6e, 0x73, 0x7f, 0xed, 0x1e, 0x72, 0x9b, 0x07, 0x6a,
-	0xf4, 0xf0, 0x72, 0xe8, 0xe1, 0xc3, 0xa2, 0xcb, 0x7c, 0xca, 0x95, 0xd3, 0x7f, 0xf2, 0x63, 0xa7,
-	0xdd, 0xbc, 0xf2, 0xa2, 0x9b, 0x57, 0xfe, 0xee, 0xe6, 0x95, 0x27, 0x67, 0xf9, 0xb1, 0x17, 0x67,
-	0xf9, 0xb1, 0x3f, 0xce, 0xf2, 0x63, 0x9f, 0xbf, 0x1e, 0x69, 0x3f, 0x81, 0x56, 0xb1, 0x81, 0x6b,
-	0x94, 0xab, 0x1e, 0x45, 0x74, 0x59, 0x1f, 0xaa, 0x4d, 0xb2, 0x59, 0x7c, 0xe5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb4, 0xd1, 0x58, 0x0c, 0x8a, 0x0f, 0x00, 0x00,
+	// 1344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0x3f, 0x6a, 0xbf, 0x34, 0x6e, 0xbe, 0xa3, 0x34, 0x5f, 0x77, 0xa9, 0xec, 0x76,
+	0xa9, 0x4a, 0x1a, 0xf0, 0x2e, 0x49, 0x0a, 0x05, 0x44, 0x80, 0xba, 0x69, 0x91, 0x55, 0x09, 0xa5,
+	0xa6, 0x70, 0xa0, 0x12, 0xd6, 0xd8, 0x3b, 0x75, 0x57, 0xb1, 0x77, 0x37, 0x3b, 0xbb, 0x49, 0xac,
+	0xd2, 0x0b, 0x27, 0x2e, 0x48, 0x95, 0x10, 0x48, 0x3d, 0xf0, 0x43, 0x08, 0x24, 0x24, 0x24, 0x4e,
+	0xbd, 0xf0, 0x1f, 0x54, 0x95, 0x90, 0x2a, 0x71, 0x41, 0x1c, 0x0c, 0x38, 0xfc, 0x21, 0x68, 0x67,
+	0x66, 0xd7, 0xeb, 0x8d, 0x13, 0xaf, 0x4d, 0x2f, 0x9c, 0x76, 0x67, 0xe6, 0xbd, 0xcf, 0xfb, 0xbc,
+	0x37, 0x6f, 0xde, 0x7b, 0xa0, 0x6c, 0xe1, 0x1d, 0xac, 0xd5, 0xad, 0x56, 0xcb, 0x70, 0x5d, 0x42,
+	0xb4, 0x9d, 0x95, 0x1a, 0x71, 0xf1, 0x8a, 0xb6, 0xed, 0x11, 0xa7, 0xad, 0xda, 0x8e, 0xe5, 0x5a,
+	0x68, 0xd1, 0x97, 0x51, 0x43, 0x19, 0x55, 0xc8, 0xc8, 0xcb, 0x75, 0x8b, 0xb6, 0x2c, 0xaa, 0xd5,
+	0x30, 0x25, 0x5c, 0x21, 0x54, 0xb7, 0x71, 0xc3, 0x30, 0xb1, 0x6b, 0x58, 0x26, 0xc7, 0x90, 0x4f,
+	0x71, 0xd9, 0x2a, 0x5b, 0x69, 0x7c, 0x21, 0x8e, 0x16, 0x1a, 0x56, 0xc3, 0xe2, 0xfb, 0xfe, 0x9f,
+	0xd8, 0x3d, 0xdd, 0xb0, 0xac, 0x46, 0x93, 0x68, 0xd8, 0x36, 0x34, 0x6c, 0x9a, 0x96, 0xcb, 0xd0,
+	0x02, 0x9d, 0x53, 0xe2, 0x94, 0xad, 0x6a, 0xde, 0x6d, 0x0d, 0x9b, 0x82, 0xad, 0x5c, 0x88, 0x1f,
+	0xb9, 0x46, 0x8b, 0x50, 0x17, 0xb7, 0x6c, 0x21, 0x70, 0xee, 0x10, 0x97, 0x1b, 0xc4, 0x24, 0xd4,
+	0x10, 0x16, 0x94, 0x1c, 0x2c, 0xde, 0xf0, 0x5d, 0xba, 0x12, 0xc8, 0xd1, 0x0a, 0xd9, 0xf6, 0x08,
+	0x75, 0x95, 0x0f, 0xe1, 0xff, 0x07, 0x4e, 0xa8, 0x6d, 0x99, 0x94, 0xa0, 0x2b, 0x00, 0x21, 0x2e,
+	0xcd, 0x49, 0x67, 0x26, 0x97, 0x66, 0x57, 0x17, 0x54, 0x4e, 0x48, 0x0d, 0x08, 0xa9, 0x97, 0xcd,
+	0x76, 0x69, 0xee, 0xf1, 0xc3, 0x62, 0x26, 0x44, 0xa8, 0x44, 0xd4, 0x94, 0xd7, 0xe0, 0x64, 0x3f,
+	0xbe, 0x30, 0x8c, 0xce, 0xc2, 0xf1, 0x50, 0xac, 0x6a, 0xe8, 0x39, 0xe9, 0x8c, 0xb4, 0x34, 0x55,
+	0x99, 0x0d, 0xf7, 0xca, 0xba, 0x72, 0x2b, 0xce, 0x3a, 0xa4, 0x76, 0x19, 0x32, 0xa1, 0x20, 0xd3,
+	0x4c, 0xc8, 0xac, 0xa7, 0x15, 0x12, 0xdb, 0x74, 0x2c, 0xdb, 0xa2, 0xb8, 0x49, 0x47, 0x20, 0xb6,
+	0x05, 0x8b, 0x71, 0x5d, 0x41, 0xec, 0x06, 0x64, 0xec, 0x60, 0x53, 0x84, 0xac, 0xa8, 0x0e, 0xce,
+	0x38, 0xb5, 0x0f, 0x22, 0x40, 0x28, 0x4d, 0x3d, 0xea, 0x14, 0x26, 0x2a, 0x3d, 0x14, 0xe5, 0x12,
+	0x2c, 0xc4, 0x24, 0x39, 0xcf, 0x02, 0xcc, 0x06, 0x42, 0x3d, 0x9a, 0x10, 0x6c, 0x95, 0x75, 0xe5,
+	0xd3, 0x14, 0x9c, 0x1c, 0x68, 0x03, 0xdd, 0x86, 0xe3, 0xb6, 0x57, 0xab, 0x06, 0xb2, 0x47, 0x46,
+	0xb0, 0xd8, 0xed, 0x14, 0x66, 0x37, 0xbd, 0x5a, 0x00, 0xf2, 0xf8, 0x61, 0x51, 0x16, 0x19, 0xdf,
+	0xb0, 0x76, 0x42, 0x67, 0xae, 0x58, 0xa6, 0x4b, 0x4c, 0xb7, 0x32, 0x6b, 0xf7, 0x44, 0xd1, 0x22,
+	0xa4, 0x0c, 0x3d, 0x97, 0xf2, 0x99, 0x95, 0x66, 0xba, 0x9d, 0x42, 0xaa, 0xbc, 0x51, 0x49, 0x19,
+	0x3a, 0x5a, 0x8d, 0x85, 0x78, 0x92, 0x49, 0x9c, 0xf0, 0x2d, 0x85, 0x77, 0x55, 0xde, 0xe8, 0x8b,
+	0x39, 0x7a, 0x0b, 0xd2, 0x3a, 0xc1, 0x7a, 0xd3, 0x30, 0x49, 0x6e, 0x8a, 0xf1, 0x95, 0x0f, 0xf0,
+	0xbd, 0x19, 0x3c, 0x8e, 0x52, 0xda, 0x8f, 0xe2, 0xfd, 0x3f, 0x0a, 0x52, 0x25, 0xd4, 0x52, 0x4e,
+	0x83, 0xcc, 0xc2, 0xf1, 0x0e, 0xd9, 0x73, 0x03, 0x8a, 0xe5, 0x8d, 0xe0, 0x21, 0xdc, 0x82, 0x67,
+	0x06, 0x9e, 0x8a, 0x90, 0xbd, 0x0e, 0xf3, 0x26, 0xd9, 0x73, 0xab, 0x07, 0x42, 0x5e, 0x42, 0xdd,
+	0x4e, 0x21, 0x1b, 0xd3, 0xca, 0x9a, 0xd1, 0xb5, 0xae, 0x7c, 0x04, 0xff, 0x63, 0xe0, 0xef, 0x5b,
+	0x2e, 0xa1, 0x49, 0x2f, 0x10, 0x5d, 0x03, 0xe8, 0x95, 0x1e, 0x16, 0xc6, 0xd9, 0xd5, 0xf3, 0xaa,
+	0x08, 0xbe, 0x5f, 0xa7, 0x54, 0x5e, 0xd8, 0x82, 0x3b, 0xd8, 0xc4, 0x8d, 0xe0, 0x79, 0x55, 0x22,
+	0x9a, 0xca, 0x77, 0x12, 0xa0, 0xa8, 0x79, 0xe1, 0xd2, 0x55, 0x98, 0xde, 0xf1, 0x37, 0x44, 0x9e,
+	0x5e, 0x38, 0x32, 0x4f, 0x7d, 0xd5, 0x58, 0x8e, 0x72, 0x6d, 0xf4, 0xf6, 0x00, 0x96, 0xcf, 0x0d,
+	0x65, 0xc9, 0x91, 0xfa, 0x68, 0x96, 0x61, 0x3e, 0x62, 0x2a, 0x61, 0x8c, 0x16, 0xb8, 0x13, 0x0e,
+	0x33, 0x9c, 0xe1, 0x9c, 0x1c, 0xe5, 0x81, 0x14, 0x09, 0x78, 0xe8, 0xb0, 0x36, 0x00, 0xac, 0x94,
+	0xed, 0x76, 0x0a, 0x10, 0xb9, 0xba, 0xa1, 0xe0, 0x68, 0x1d, 0x32, 0xfe, 0x4f, 0xd5, 0x6d, 0xdb,
+	0x84, 0xa5, 0x6e, 0x76, 0xf5, 0xcc, 0x61, 0xb1, 0xf3, 0xed, 0xdf, 0x6c, 0xdb, 0xa4, 0x92, 0xde,
+	0x11, 0x7f, 0xca, 0x45, 0x41, 0xed, 0x26, 0x6e, 0x36, 0xdb, 0x89, 0x1f, 0xf3, 0x0f, 0x53, 0x80,
+	0xa2, 0x6a, 0xe3, 0xba, 0x74, 0x1d, 0x32, 0x6d, 0x42, 0xab, 0xfc, 0xe2, 0x99, 0x5b, 0x25, 0xd5,
+	0xbf, 0xcd, 0xdf, 0x3b, 0x85, 0xf3, 0x0d, 0xc3, 0xbd, 0xe3, 0xd5, 0x7c, 0x2f, 0x44, 0x4f, 0x13,
+	0x9f, 0x22, 0xd5, 0xb7, 0x34, 0xdf, 0x5b, 0xaa, 0x6e, 0x90, 0x7a, 0x25, 0xdd, 0x26, 0x94, 0x65,
+	0x12, 0x2a, 0x43, 0xda, 0xb4, 0x04, 0xd6, 0xe4, 0x58, 0x58, 0xc7, 0x4c, 0x8b, 0x43, 0xbd, 0x0b,
+	0x73, 0x75, 0xcf, 0x71, 0x88, 0xe9, 0x0a, 0xbc, 0xa9, 0xb1, 0xf0, 0x8e, 0x0b, 0x10, 0x0e, 0xfa,
+	0x1e, 0x64, 0x6d, 0x8b, 0x52, 0xa3, 0xd6, 0x24, 0x02, 0x75, 0x7a, 0x2c, 0xd4, 0xb9, 0x00, 0x25,
+	0x84, 0xe5, 0x09, 0x70, 0xc7, 0x21, 0xf4, 0x8e, 0xd5, 0xd4, 0x73, 0x33, 0xe3, 0xc1, 0xb2, 0x9c,
+	0x08, 0x40, 0xd0, 0x35, 0x98, 0xd9, 0xf6, 0x2c, 0xc7, 0x6b, 0xe5, 0x8e, 0x8d, 0x05, 0x27, 0xb4,
+	0x95, 0x4f, 0x24, 0x51, 0xca, 0x6e, 0x78, 0xc4, 0x23, 0xfa, 0x18, 0x0d, 0xee, 0xa9, 0x55, 0x9e,
+	0x5f, 0x24, 0x38, 0x3d, 0x98, 0x8a, 0xc8, 0x5f, 0x1d, 0xe6, 0xb7, 0xd9, 0x51, 0x35, 0xde, 0x36,
+	0xd7, 0x8e, 0x2c, 0x47, 0xfd, 0x78, 0xb1, 0xc2, 0x74, 0x62, 0xbb, 0xdf, 0xda, 0xd3, 0x2b, 0x51,
+	0xeb, 0x20, 0x0f, 0x34, 0x9f, 0xf0, 0x11, 0x7f, 0x95, 0x1a, 0x78, 0x33, 0xff, 0xe9, 0xbe, 0x7c,
+	0x1d, 0xb2, 0x64, 0x8f, 0xd4, 0x3d, 0x3f, 0x3e, 0x55, 0xd7, 0x68, 0x8d, 0xd6, 0x9d, 0xe7, 0x42,
+	0x5d, 0xff, 0x54, 0xb9, 0x2a, 0x26, 0x96, 0x0a, 0xde, 0xdd, 0xc4, 0x0e, 0x6e, 0x85, 0x39, 0x2b,
+	0x43, 0x9a, 0x7a, 0x35, 0x6a, 0xe3, 0x3a, 0x9f, 0xf7, 0x32, 0x95, 0x70, 0x8d, 0xe6, 0x61, 0x72,
+	0x8b, 0xb4, 0x45, 0x8d, 0xf6, 0x7f, 0x95, 0x35, 0x58, 0x8c, 0xc3, 0x88, 0x08, 0x9f, 0x82, 0xb4,
+	0x83, 0x77, 0xab, 0x3a, 0x76, 0xb1, 0xc0, 0x39, 0xe6, 0xe0, 0xdd, 0x0d, 0xec, 0xe2, 0xd5, 0xaf,
+	0xb3, 0x30, 0xcd, 0xb4, 0xd0, 0x03, 0x09, 0x20, 0xf4, 0x97, 0x22, 0xf5, 0xc8, 0x4c, 0x3c, 0x30,
+	0x52, 0xcb, 0x5a, 0x62, 0x79, 0x4e, 0x4a, 0x59, 0xfe, 0xf8, 0xd7, 0xbf, 0x3f, 0x4b, 0x9d, 0x43,
+	0x8a, 0x76, 0xc8, 0x30, 0x5f, 0xef, 0x91, 0xf9, 0x5e, 0x82, 0xde, 0x3c, 0x8b, 0x8a, 0xc9, 0x4c,
+	0x05, 0xcc, 0xd4, 0xa4, 0xe2, 0x82, 0xd8, 0xab, 0x8c, 0xd8, 0x1a, 0x5a, 0x19, 0x4e, 0x4c, 0xbb,
+	0x1b, 0xcd, 0x9c, 0x7b, 0xe8, 0x73, 0x09, 0x32, 0xbd, 0x07, 0x98, 0x6c, 0x06, 0xa6, 0xc9, 0x78,
+	0x1e, 0xa8, 0x22, 0xca, 0x05, 0xc6, 0xf3, 0x59, 0x74, 0xf6, 0x30, 0x9e, 0x61, 0x71, 0x41, 0xdf,
+	0x48, 0x90, 0x0e, 0xdf, 0xc1, 0x0b, 0x09, 0x47, 0x73, 0xce, 0x6a, 0xb4, 0x41, 0x5e, 0xb9, 0xc4,
+	0x48, 0xad, 0x20, 0x6d, 0x28, 0x29, 0xed, 0x6e, 0xa4, 0x6c, 0xdc, 0x43, 0x3f, 0x4a, 0x10, 0x9b,
+	0x27, 0xd1, 0xea, 0x91, 0xa6, 0x07, 0x0e, 0xb4, 0xf2, 0xda, 0x48, 0x3a, 0x82, 0xf4, 0x8b, 0x8c,
+	0xf4, 0x32, 0x5a, 0x3a, 0x8c, 0xb4, 0x3f, 0xd8, 0x16, 0x03, 0xba, 0x45, 0x43, 0x47, 0x5f, 0x4a,
+	0x30, 0xcd, 0xdb, 0xe2, 0xf0, 0x01, 0x32, 0xbc, 0xe0, 0xe5, 0x24, 0xa2, 0x82, 0xd2, 0x3a, 0xa3,
+	0x74, 0x09, 0xbd, 0x34, 0x62, 0x1c, 0x35, 0x3e, 0x9e, 0x7e, 0x2b, 0xc1, 0x94, 0x0f, 0x88, 0x96,
+	0x12, 0xcc, 0xb7, 0x9c, 0x5d, 0xf2, 0x49, 0x58, 0xb9, 0xca, 0xc8, 0xbd, 0x89, 0xd6, 0xc7, 0x22,
+	0xa7, 0xdd, 0xf5, 0x3f, 0xce, 0x3d, 0x16, 0x44, 0x36, 0xd8, 0x0d, 0x09, 0x62, 0x74, 0x66, 0x94,
+	0x97, 0x93, 0x88, 0xfe, 0xdb, 0x20, 0xba, 0x8c, 0xd5, 0x4f, 0x12, 0x9c, 0x88, 0xb5, 0x70, 0x34,
+	0x4a, 0x83, 0x0e, 0x2f, 0xfe, 0xe2, 0x68, 0x4a, 0x49, 0xb3, 0x92, 0x37, 0xfc, 0x62, 0xef, 0x99,
+	0xff, 0x2c, 0x41, 0xb6, 0x1f, 0x6d, 0xc8, 0x1b, 0x1a, 0xd8, 0xd1, 0xe5, 0x71, 0x86, 0x10, 0xe5,
+	0x0d, 0xc6, 0xf6, 0x15, 0xf4, 0x72, 0x52, 0xb6, 0xb1, 0xf7, 0xff, 0x85, 0x04, 0x99, 0xb0, 0x73,
+	0x0d, 0x29, 0x9d, 0xf1, 0x46, 0x29, 0xab, 0x49, 0xc5, 0x93, 0xf6, 0x1e, 0x07, 0xef, 0x16, 0x6d,
+	0xa6, 0x53, 0x2a, 0x3f, 0xfa, 0x2b, 0x3f, 0xf1, 0xa8, 0x9b, 0x97, 0x9e, 0x74, 0xf3, 0xd2, 0x9f,
+	0xdd, 0xbc, 0x74, 0x7f, 0x3f, 0x3f, 0xf1, 0x64, 0x3f, 0x3f, 0xf1, 0xdb, 0x7e, 0x7e, 0xe2, 0x83,
+	0xe7, 0x23, 0x63, 0xaa, 0x8f, 0x55, 0x6c, 0xe2, 0x1a, 0xe5, 0xa8, 0x7b, 0x11, 0x5c, 0x36, 0xaf,
+	0xd6, 0x66, 0xd8, 0x54, 0xb0, 0xf6, 0xcf, 0x00, 0x7e, 0x63, 0x47, 0xab, 0xb2, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -869,6 +1040,10 @@ type QueryClient interface {
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
 	// Tally queries the tally of a single proposal ID.
 	Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error)
+	// QueuedProposals queries passed proposals waiting to be enacted, optionally filtered by committee ID.
+	QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error)
+	// QueuedProposal queries a passed proposal waiting to be enacted based on proposal ID.
+	QueuedProposal(ctx context.Context, in *QueryQueuedProposalRequest, opts ...grpc.CallOption) (*QueryQueuedProposalResponse, error)
 	// RawParams queries the raw params data of any subspace and key.
 	RawParams(ctx context.Context, in *QueryRawParamsRequest, opts ...grpc.CallOption) (*QueryRawParamsResponse, error)
 }
@@ -953,6 +1128,24 @@ func (c *queryClient) Tally(ctx context.Context, in *QueryTallyRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error) {
+	out := new(QueryQueuedProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Query/QueuedProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedProposal(ctx context.Context, in *QueryQueuedProposalRequest, opts ...grpc.CallOption) (*QueryQueuedProposalResponse, error) {
+	out := new(QueryQueuedProposalResponse)
+	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Query/QueuedProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RawParams(ctx context.Context, in *QueryRawParamsRequest, opts ...grpc.CallOption) (*QueryRawParamsResponse, error) {
 	out := new(QueryRawParamsResponse)
 	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Query/RawParams", in, out, opts...)
@@ -980,6 +1173,10 @@ type QueryServer interface {
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
 	// Tally queries the tally of a single proposal ID.
 	Tally(context.Context, *QueryTallyRequest) (*QueryTallyResponse, error)
+	// QueuedProposals queries passed proposals waiting to be enacted, optionally filtered by committee ID.
+	QueuedProposals(context.Context, *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error)
+	// QueuedProposal queries a passed proposal waiting to be enacted based on proposal ID.
+	QueuedProposal(context.Context, *QueryQueuedProposalRequest) (*QueryQueuedProposalResponse, error)
 	// RawParams queries the raw params data of any subspace and key.
 	RawParams(context.Context, *QueryRawParamsRequest) (*QueryRawParamsResponse, error)
 }
@@ -1012,6 +1209,12 @@ func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest
 func (*UnimplementedQueryServer) Tally(ctx context.Context, req *QueryTallyRequest) (*QueryTallyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Tally not implemented")
 }
+func (*UnimplementedQueryServer) QueuedProposals(ctx context.Context, req *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedProposals not implemented")
+}
+func (*UnimplementedQueryServer) QueuedProposal(ctx context.Context, req *QueryQueuedProposalRequest) (*QueryQueuedProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedProposal not implemented")
+}
 func (*UnimplementedQueryServer) RawParams(ctx context.Context, req *QueryRawParamsRequest) (*QueryRawParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RawParams not implemented")
 }
@@ -1164,6 +1367,42 @@ func _Query_Tally_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueuedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.committee.v1beta1.Query/QueuedProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedProposals(ctx, req.(*QueryQueuedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.committee.v1beta1.Query/QueuedProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedProposal(ctx, req.(*QueryQueuedProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RawParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRawParamsRequest)
 	if err := dec(in); err != nil {
@@ -1218,6 +1457,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Tally",
 			Handler:    _Query_Tally_Handler,
 		},
+		{
+			MethodName: "QueuedProposals",
+			Handler:    _Query_QueuedProposals_Handler,
+		},
+		{
+			MethodName: "QueuedProposal",
+			Handler:    _Query_QueuedProposal_Handler,
+		},
 		{
 			MethodName: "RawParams",
 			Handler:    _Query_RawParams_Handler,
@@ -1827,7 +2074,7 @@ func (m *QueryTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryQueuedProposalsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1837,34 +2084,37 @@ func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryQueuedProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryQueuedProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Subspace) > 0 {
-		i -= len(m.Subspace)
-		copy(dAtA[i:], m.Subspace)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+	if m.CommitteeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommitteeId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryQueuedProposalsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1874,80 +2124,247 @@ func (m *QueryRawParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryQueuedProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryQueuedProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RawData) > 0 {
-		i -= len(m.RawData)
-		copy(dAtA[i:], m.RawData)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RawData)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedProposals) > 0 {
+		for iNdEx := len(m.QueuedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryQueuedProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCommitteesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryQueuedProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommitteesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryQueuedProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Committees) > 0 {
-		for _, e := range m.Committees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommitteeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CommitteeId != 0 {
-		n += 1 + sovQuery(uint64(m.CommitteeId))
+func (m *QueryQueuedProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCommitteeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryQueuedProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Committee != nil {
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if m.CommitteeID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommitteeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubProposal != nil {
+		{
+			size, err := m.PubProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawData) > 0 {
+		i -= len(m.RawData)
+		copy(dAtA[i:], m.RawData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RawData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCommitteesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommitteesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Committees) > 0 {
+		for _, e := range m.Committees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCommitteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeId != 0 {
+		n += 1 + sovQuery(uint64(m.CommitteeId))
+	}
+	return n
+}
+
+func (m *QueryCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Committee != nil {
 		l = m.Committee.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -2141,6 +2558,74 @@ func (m *QueryTallyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryQueuedProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeId != 0 {
+		n += 1 + sovQuery(uint64(m.CommitteeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedProposals) > 0 {
+		for _, e := range m.QueuedProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryQueuedProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubProposal != nil {
+		l = m.PubProposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	if m.CommitteeID != 0 {
+		n += 1 + sovQuery(uint64(m.CommitteeID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryRawParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3752,6 +4237,457 @@ func (m *QueryTallyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryQueuedProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeId", wireType)
+			}
+			m.CommitteeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedProposals = append(m.QueuedProposals, QueryQueuedProposalResponse{})
+			if err := m.QueuedProposals[len(m.QueuedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubProposal == nil {
+				m.PubProposal = &types.Any{}
+			}
+			if err := m.PubProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeID", wireType)
+			}
+			m.CommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryRawParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
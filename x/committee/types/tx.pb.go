@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -185,46 +186,141 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgUpdateCommitteeMembers updates the members of a member committee.
+// It can only be executed by x/gov or by the committee being updated.
+type MsgUpdateCommitteeMembers struct {
+	// authority is the address executing the update, either x/gov or the committee's own address.
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	CommitteeID uint64 `protobuf:"varint,2,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	// Members to add to the committee, or existing members to set a new weight for.
+	AddMembers []MemberWeight `protobuf:"bytes,3,rep,name=add_members,json=addMembers,proto3" json:"add_members"`
+	// Members to remove from the committee.
+	RemoveMembers []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=remove_members,json=removeMembers,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"remove_members,omitempty"`
+}
+
+func (m *MsgUpdateCommitteeMembers) Reset()         { *m = MsgUpdateCommitteeMembers{} }
+func (m *MsgUpdateCommitteeMembers) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCommitteeMembers) ProtoMessage()    {}
+func (*MsgUpdateCommitteeMembers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f3857845b071606, []int{4}
+}
+func (m *MsgUpdateCommitteeMembers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCommitteeMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCommitteeMembers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCommitteeMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCommitteeMembers.Merge(m, src)
+}
+func (m *MsgUpdateCommitteeMembers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCommitteeMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCommitteeMembers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCommitteeMembers proto.InternalMessageInfo
+
+// MsgUpdateCommitteeMembersResponse defines the UpdateCommitteeMembers response type
+type MsgUpdateCommitteeMembersResponse struct {
+}
+
+func (m *MsgUpdateCommitteeMembersResponse) Reset()         { *m = MsgUpdateCommitteeMembersResponse{} }
+func (m *MsgUpdateCommitteeMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCommitteeMembersResponse) ProtoMessage()    {}
+func (*MsgUpdateCommitteeMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f3857845b071606, []int{5}
+}
+func (m *MsgUpdateCommitteeMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCommitteeMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCommitteeMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCommitteeMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCommitteeMembersResponse.Merge(m, src)
+}
+func (m *MsgUpdateCommitteeMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCommitteeMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCommitteeMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCommitteeMembersResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "kava.committee.v1beta1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "kava.committee.v1beta1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "kava.committee.v1beta1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "kava.committee.v1beta1.MsgVoteResponse")
+	proto.RegisterType((*MsgUpdateCommitteeMembers)(nil), "kava.committee.v1beta1.MsgUpdateCommitteeMembers")
+	proto.RegisterType((*MsgUpdateCommitteeMembersResponse)(nil), "kava.committee.v1beta1.MsgUpdateCommitteeMembersResponse")
 }
 
 func init() { proto.RegisterFile("kava/committee/v1beta1/tx.proto", fileDescriptor_3f3857845b071606) }
 
 var fileDescriptor_3f3857845b071606 = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0xb4, 0x40, 0x3b, 0xae, 0x52, 0xd5, 0x8a, 0x50, 0xe2, 0x83, 0x13, 0x45, 0x48,
-	0x04, 0xa1, 0xee, 0x2a, 0xe1, 0xcc, 0x81, 0xb4, 0x17, 0x4b, 0x44, 0xaa, 0x0c, 0x02, 0x89, 0x4b,
-	0x64, 0x37, 0xcb, 0x62, 0x91, 0x78, 0xac, 0xec, 0xda, 0xaa, 0x9f, 0x02, 0x1e, 0x86, 0x23, 0x77,
-	0x2a, 0x4e, 0x3d, 0x72, 0xaa, 0xc0, 0x79, 0x11, 0xb4, 0xb6, 0xd7, 0x42, 0x94, 0xf0, 0xe7, 0x36,
-	0x33, 0xfe, 0xcd, 0x37, 0xdf, 0x8c, 0x17, 0xfa, 0xef, 0x82, 0x2c, 0x60, 0xe7, 0xb8, 0x5a, 0x45,
-	0x4a, 0x71, 0xce, 0xb2, 0x71, 0xc8, 0x55, 0x30, 0x66, 0xea, 0x82, 0x26, 0x6b, 0x54, 0x68, 0xdf,
-	0xd3, 0x00, 0x6d, 0x00, 0x5a, 0x03, 0x4e, 0xef, 0x1c, 0xe5, 0x0a, 0xe5, 0xbc, 0xa4, 0x58, 0x95,
-	0x54, 0x2d, 0x4e, 0x47, 0xa0, 0xc0, 0xaa, 0xae, 0xa3, 0xba, 0xda, 0x13, 0x88, 0x62, 0xc9, 0x59,
-	0x99, 0x85, 0xe9, 0x1b, 0x16, 0xc4, 0x79, 0xfd, 0xe9, 0xfe, 0x16, 0x13, 0x82, 0xc7, 0x5c, 0x46,
-	0xb5, 0xec, 0xf0, 0x13, 0x81, 0xa3, 0x99, 0x14, 0xcf, 0xd3, 0x70, 0x15, 0xa9, 0xb3, 0x35, 0x26,
-	0x28, 0x83, 0xa5, 0xfd, 0x0a, 0x0e, 0x92, 0x34, 0xd4, 0x36, 0xca, 0xbc, 0x4b, 0x06, 0x64, 0x64,
-	0x4d, 0x3a, 0xb4, 0x9a, 0x46, 0xcd, 0x34, 0xfa, 0x34, 0xce, 0xa7, 0xee, 0x97, 0x8f, 0xc7, 0x4e,
-	0x6d, 0x55, 0x60, 0x66, 0x76, 0xa1, 0x27, 0x18, 0x2b, 0x1e, 0x2b, 0xdf, 0x4a, 0xd2, 0xb0, 0x11,
-	0x76, 0x60, 0xaf, 0x12, 0xe5, 0xeb, 0xee, 0xad, 0x01, 0x19, 0xed, 0xfb, 0x4d, 0x6e, 0x4f, 0xe0,
-	0xa0, 0x71, 0x3b, 0x8f, 0x16, 0xdd, 0x9d, 0x01, 0x19, 0xed, 0x4e, 0x0f, 0x8b, 0xeb, 0xbe, 0x75,
-	0x62, 0xea, 0xde, 0xa9, 0x6f, 0x35, 0x90, 0xb7, 0x18, 0x3e, 0x83, 0xde, 0x0d, 0xf7, 0x3e, 0x97,
-	0x09, 0xc6, 0x92, 0xdb, 0x0c, 0x2c, 0xb3, 0x81, 0xd6, 0x23, 0xa5, 0x5e, 0xbb, 0xb8, 0xee, 0x83,
-	0x41, 0xbd, 0x53, 0x1f, 0x0c, 0xe2, 0x2d, 0x86, 0xef, 0x09, 0xdc, 0x9d, 0x49, 0xf1, 0x12, 0xd5,
-	0xff, 0x37, 0xdb, 0x1d, 0xb8, 0x9d, 0xa1, 0x6a, 0xf6, 0xaa, 0x12, 0xfb, 0x09, 0xec, 0xeb, 0x60,
-	0xae, 0xf2, 0x84, 0x97, 0x1b, 0xb5, 0x27, 0x03, 0xfa, 0xfb, 0xbf, 0x4f, 0xf5, 0xdc, 0x17, 0x79,
-	0xc2, 0xfd, 0xbd, 0xac, 0x8e, 0x86, 0x47, 0x70, 0x58, 0x1b, 0x32, 0x5b, 0x4d, 0x3e, 0x13, 0xd8,
-	0x99, 0x49, 0x61, 0xc7, 0xd0, 0xfe, 0xe5, 0xaf, 0x3d, 0xdc, 0x26, 0x7c, 0xe3, 0x44, 0xce, 0xf8,
-	0x9f, 0xd1, 0xe6, 0x9a, 0x67, 0xb0, 0x5b, 0x1e, 0xa6, 0xff, 0x87, 0x56, 0x0d, 0x38, 0x0f, 0xfe,
-	0x02, 0x18, 0xc5, 0xa9, 0x77, 0xf9, 0xdd, 0x6d, 0x5d, 0x16, 0x2e, 0xb9, 0x2a, 0x5c, 0xf2, 0xad,
-	0x70, 0xc9, 0x87, 0x8d, 0xdb, 0xba, 0xda, 0xb8, 0xad, 0xaf, 0x1b, 0xb7, 0xf5, 0xfa, 0x91, 0x88,
-	0xd4, 0xdb, 0x34, 0xd4, 0x3a, 0x4c, 0x0b, 0x1e, 0x2f, 0x83, 0x50, 0x96, 0x11, 0xbb, 0xf8, 0xe9,
-	0x59, 0xeb, 0xc3, 0xca, 0xf0, 0x4e, 0xf9, 0x24, 0x1f, 0xff, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x91, 0xbf, 0x3d, 0x7a, 0x03, 0x00, 0x00,
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x93, 0x00, 0xcd, 0xb9, 0xa4, 0xaa, 0x89, 0xaa, 0xd4, 0x83, 0x63, 0x4c, 0x05, 0x41,
+	0xc8, 0xb6, 0x1a, 0x24, 0x24, 0x06, 0x86, 0xba, 0x1d, 0x88, 0x20, 0x52, 0xe5, 0x02, 0x95, 0x58,
+	0x22, 0x3b, 0x3e, 0xae, 0x56, 0x6b, 0x9f, 0xe5, 0x3b, 0x5b, 0xf5, 0x1f, 0x60, 0x85, 0x1f, 0xd3,
+	0x91, 0x15, 0xa9, 0x62, 0xaa, 0x3a, 0x31, 0x45, 0x90, 0xfc, 0x0b, 0x26, 0x64, 0xfb, 0xec, 0x96,
+	0xb6, 0x6e, 0xe9, 0x94, 0xf7, 0x2e, 0xdf, 0xfb, 0xde, 0xf7, 0xbd, 0x7b, 0x3e, 0xd0, 0xdb, 0xb7,
+	0x62, 0x4b, 0x9f, 0x60, 0xcf, 0x73, 0x29, 0x85, 0x50, 0x8f, 0xd7, 0x6d, 0x48, 0xad, 0x75, 0x9d,
+	0x1e, 0x6a, 0x41, 0x88, 0x29, 0x16, 0x56, 0x52, 0x80, 0x56, 0x02, 0x34, 0x06, 0x10, 0x57, 0x27,
+	0x98, 0x78, 0x98, 0x8c, 0x33, 0x94, 0x9e, 0x27, 0x79, 0x89, 0xd8, 0x41, 0x18, 0xe1, 0xfc, 0x3c,
+	0x8d, 0xd8, 0xe9, 0x2a, 0xc2, 0x18, 0x1d, 0x40, 0x3d, 0xcb, 0xec, 0xe8, 0x93, 0x6e, 0xf9, 0x09,
+	0xfb, 0xeb, 0x71, 0x85, 0x88, 0xb3, 0xae, 0x39, 0x6e, 0xad, 0x02, 0x87, 0xa0, 0x0f, 0x89, 0xcb,
+	0xda, 0x2b, 0xdf, 0x38, 0xb0, 0x3c, 0x22, 0x68, 0x27, 0xb2, 0x3d, 0x97, 0x6e, 0x87, 0x38, 0xc0,
+	0xc4, 0x3a, 0x10, 0x76, 0xc1, 0x62, 0x10, 0xd9, 0xe3, 0x80, 0xe5, 0x5d, 0x4e, 0xe6, 0xfa, 0xfc,
+	0xa0, 0xa3, 0xe5, 0xaa, 0xb4, 0x42, 0x95, 0xb6, 0xe1, 0x27, 0x86, 0xf4, 0xe3, 0x48, 0x15, 0x99,
+	0x25, 0x84, 0xe3, 0xc2, 0xb3, 0xb6, 0x89, 0x7d, 0x0a, 0x7d, 0x6a, 0xf2, 0x41, 0x64, 0x97, 0xc4,
+	0x22, 0x58, 0xc8, 0x49, 0x61, 0xd8, 0xad, 0xcb, 0x5c, 0xbf, 0x65, 0x96, 0xb9, 0x30, 0x00, 0x8b,
+	0xa5, 0xda, 0xb1, 0xeb, 0x74, 0x1b, 0x32, 0xd7, 0x6f, 0x1a, 0x4b, 0xb3, 0x69, 0x8f, 0xdf, 0x2c,
+	0xce, 0x87, 0x5b, 0x26, 0x5f, 0x82, 0x86, 0x8e, 0xf2, 0x16, 0xac, 0x5e, 0x52, 0x6f, 0x42, 0x12,
+	0x60, 0x9f, 0x40, 0x41, 0x07, 0x7c, 0xe1, 0x20, 0xe5, 0xe3, 0x32, 0xbe, 0xf6, 0x6c, 0xda, 0x03,
+	0x05, 0x74, 0xb8, 0x65, 0x82, 0x02, 0x32, 0x74, 0x94, 0x2f, 0x1c, 0xb8, 0x37, 0x22, 0xe8, 0x03,
+	0xa6, 0xb7, 0x2f, 0x16, 0x3a, 0xe0, 0x4e, 0x8c, 0x69, 0xe9, 0x2b, 0x4f, 0x84, 0x57, 0xa0, 0x95,
+	0x06, 0x63, 0x9a, 0x04, 0x30, 0x73, 0xd4, 0x1e, 0xc8, 0xda, 0xd5, 0x5b, 0xa2, 0xa5, 0x7d, 0xdf,
+	0x25, 0x01, 0x34, 0x17, 0x62, 0x16, 0x29, 0xcb, 0x60, 0x89, 0x09, 0x2a, 0x5c, 0x29, 0xdf, 0xeb,
+	0x99, 0xe7, 0xf7, 0x81, 0x63, 0x51, 0x58, 0x0e, 0x66, 0x04, 0x3d, 0x1b, 0x86, 0x44, 0x78, 0x01,
+	0x5a, 0x56, 0x44, 0xf7, 0x70, 0xe8, 0xd2, 0x24, 0x13, 0xdd, 0x32, 0xba, 0xa7, 0x47, 0x6a, 0x87,
+	0x5d, 0xd0, 0x86, 0xe3, 0x84, 0x90, 0x90, 0x1d, 0x1a, 0xba, 0x3e, 0x32, 0xcf, 0xa0, 0x97, 0x86,
+	0x5f, 0xbf, 0x79, 0xf8, 0xc2, 0x1b, 0xc0, 0x5b, 0x8e, 0x33, 0xf6, 0xf2, 0xd6, 0xdd, 0x86, 0xdc,
+	0xe8, 0xf3, 0x83, 0xb5, 0x2a, 0x77, 0xb9, 0xc2, 0x5d, 0xe8, 0xa2, 0x3d, 0x6a, 0x34, 0x8f, 0xa7,
+	0xbd, 0x9a, 0x09, 0x2c, 0xc7, 0x29, 0x84, 0x63, 0xd0, 0x0e, 0xa1, 0x87, 0x63, 0x58, 0xf2, 0x35,
+	0xe5, 0x46, 0x7f, 0xd1, 0x78, 0xfd, 0x67, 0xda, 0x53, 0x91, 0x4b, 0xf7, 0x22, 0x3b, 0x25, 0x65,
+	0x1f, 0x0f, 0xfb, 0x51, 0x89, 0xb3, 0xaf, 0xa7, 0xa3, 0x25, 0xda, 0xc6, 0x64, 0xc2, 0xcc, 0x9d,
+	0x1e, 0xa9, 0x0f, 0xfe, 0xb5, 0x6b, 0x24, 0x14, 0x12, 0xf3, 0x7e, 0xce, 0xcf, 0x1a, 0x2a, 0x8f,
+	0xc0, 0xc3, 0xca, 0x31, 0x16, 0xc3, 0x1e, 0x9c, 0xd4, 0x41, 0x63, 0x44, 0x90, 0xe0, 0x83, 0xf6,
+	0x85, 0x4f, 0xe4, 0x69, 0xa5, 0xcf, 0x8b, 0xfb, 0x28, 0xae, 0xff, 0x37, 0xb4, 0x5c, 0xdd, 0x6d,
+	0xd0, 0xcc, 0xb6, 0xb0, 0x77, 0x4d, 0x69, 0x0a, 0x10, 0x9f, 0xdc, 0x00, 0x28, 0x19, 0x3f, 0x73,
+	0x60, 0xa5, 0x62, 0x67, 0xae, 0xd3, 0x77, 0x75, 0x89, 0xf8, 0xf2, 0xd6, 0x25, 0x85, 0x10, 0x63,
+	0x78, 0xfc, 0x5b, 0xaa, 0x1d, 0xcf, 0x24, 0xee, 0x64, 0x26, 0x71, 0xbf, 0x66, 0x12, 0xf7, 0x75,
+	0x2e, 0xd5, 0x4e, 0xe6, 0x52, 0xed, 0xe7, 0x5c, 0xaa, 0x7d, 0x7c, 0x76, 0xee, 0xaa, 0xd3, 0x16,
+	0xea, 0x81, 0x65, 0x93, 0x2c, 0xd2, 0x0f, 0xcf, 0x3d, 0x66, 0xd9, 0x9d, 0xdb, 0x77, 0xb3, 0x87,
+	0xe8, 0xf9, 0xdf, 0x01, 0x00, 0x81, 0x58, 0x69, 0xb9, 0x98, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -243,6 +339,8 @@ type MsgClient interface {
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method for voting on a proposal
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// UpdateCommitteeMembers defines a method for adding, removing, or reweighting the members of a member committee
+	UpdateCommitteeMembers(ctx context.Context, in *MsgUpdateCommitteeMembers, opts ...grpc.CallOption) (*MsgUpdateCommitteeMembersResponse, error)
 }
 
 type msgClient struct {
@@ -271,12 +369,23 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) UpdateCommitteeMembers(ctx context.Context, in *MsgUpdateCommitteeMembers, opts ...grpc.CallOption) (*MsgUpdateCommitteeMembersResponse, error) {
+	out := new(MsgUpdateCommitteeMembersResponse)
+	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Msg/UpdateCommitteeMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method for submitting a committee proposal
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method for voting on a proposal
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// UpdateCommitteeMembers defines a method for adding, removing, or reweighting the members of a member committee
+	UpdateCommitteeMembers(context.Context, *MsgUpdateCommitteeMembers) (*MsgUpdateCommitteeMembersResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -289,6 +398,9 @@ func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmi
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCommitteeMembers(ctx context.Context, req *MsgUpdateCommitteeMembers) (*MsgUpdateCommitteeMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommitteeMembers not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -330,6 +442,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCommitteeMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCommitteeMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCommitteeMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.committee.v1beta1.Msg/UpdateCommitteeMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCommitteeMembers(ctx, req.(*MsgUpdateCommitteeMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.committee.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -342,6 +472,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "UpdateCommitteeMembers",
+			Handler:    _Msg_UpdateCommitteeMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/committee/v1beta1/tx.proto",
@@ -485,6 +619,87 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateCommitteeMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommitteeMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommitteeMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveMembers) > 0 {
+		for iNdEx := len(m.RemoveMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveMembers[iNdEx])
+			copy(dAtA[i:], m.RemoveMembers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddMembers) > 0 {
+		for iNdEx := len(m.AddMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CommitteeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitteeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommitteeMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommitteeMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommitteeMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -556,6 +771,43 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateCommitteeMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommitteeID != 0 {
+		n += 1 + sovTx(uint64(m.CommitteeID))
+	}
+	if len(m.AddMembers) > 0 {
+		for _, e := range m.AddMembers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveMembers) > 0 {
+		for _, b := range m.RemoveMembers {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateCommitteeMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -938,6 +1190,223 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateCommitteeMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommitteeMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommitteeMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeID", wireType)
+			}
+			m.CommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddMembers = append(m.AddMembers, MemberWeight{})
+			if err := m.AddMembers[len(m.AddMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveMembers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveMembers = append(m.RemoveMembers, make([]byte, postIndex-iNdEx))
+			copy(m.RemoveMembers[len(m.RemoveMembers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCommitteeMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommitteeMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommitteeMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
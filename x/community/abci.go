@@ -17,4 +17,5 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// This exact call order is required to allow payout on the upgrade block
 	k.CheckAndDisableMintAndKavaDistInflation(ctx)
 	k.PayoutAccumulatedStakingRewards(ctx)
+	k.PayoutPaymentStreams(ctx)
 }
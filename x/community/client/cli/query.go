@@ -2,6 +2,8 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		getCmdQueryParams(),
 		getCmdQueryBalance(),
 		getCmdQueryAnnualizedRewards(),
+		getCmdQueryPaymentStreams(),
+		getCmdQueryPaymentStream(),
 	}
 
 	for _, cmd := range commands {
@@ -105,3 +109,54 @@ func getCmdQueryAnnualizedRewards() *cobra.Command {
 		},
 	}
 }
+
+// getCmdQueryPaymentStreams implements a command to return the community pool payment streams.
+func getCmdQueryPaymentStreams() *cobra.Command {
+	return &cobra.Command{
+		Use:   "payment-streams",
+		Short: "Query the community pool payment streams with their paid and remaining amounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PaymentStreams(cmd.Context(), &types.QueryPaymentStreamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// getCmdQueryPaymentStream implements a command to return a community pool payment stream by id.
+func getCmdQueryPaymentStream() *cobra.Command {
+	return &cobra.Command{
+		Use:   "payment-stream [stream-id]",
+		Short: "Query a community pool payment stream by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid stream id: %w", err)
+			}
+
+			res, err := queryClient.PaymentStream(cmd.Context(), &types.QueryPaymentStreamRequest{StreamId: streamID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
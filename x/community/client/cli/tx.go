@@ -193,6 +193,319 @@ Where proposal.json contains:
 	return cmd
 }
 
+// NewCmdSubmitCommunityPoolPaymentStreamProposal implements the command to submit a community-pool payment stream proposal
+func NewCmdSubmitCommunityPoolPaymentStreamProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-payment-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool payment stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool payment stream proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Note that --deposit below is the initial proposal deposit submitted along with the proposal.
+The amount is paid to the recipient continuously from the start to the end time. The cliff is optional,
+nothing is paid before it.
+Example:
+$ %s tx gov submit-proposal community-pool-payment-stream <path/to/proposal.json> --deposit 1000000000ukava --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Community Pool Payment Stream",
+  "description": "Pay a grantee some KAVA from community pool over a year!",
+  "recipient": "kava1...",
+  "amount": [
+    {
+      "denom": "ukava",
+      "amount": "100000000000"
+    }
+  ],
+  "start": "2030-01-01T00:00:00Z",
+  "end": "2031-01-01T00:00:00Z",
+  "cliff": "2030-04-01T00:00:00Z"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// parse proposal
+			proposal, err := utils.ParseCommunityPoolPaymentStreamProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := parseInitialDeposit(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+			msg, err := govv1beta1.NewMsgSubmitProposal(&proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "Initial deposit for the proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitCommunityPoolCancelPaymentStreamProposal implements the command to submit a community-pool cancel payment stream proposal
+func NewCmdSubmitCommunityPoolCancelPaymentStreamProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-cancel-payment-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool cancel payment stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool cancel payment stream proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Note that --deposit below is the initial proposal deposit submitted along with the proposal.
+Example:
+$ %s tx gov submit-proposal community-pool-cancel-payment-stream <path/to/proposal.json> --deposit 1000000000ukava --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Community Pool Cancel Payment Stream",
+  "description": "Stop paying the grantee!",
+  "stream_id": "1"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// parse proposal
+			proposal, err := utils.ParseCommunityPoolCancelPaymentStreamProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := parseInitialDeposit(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+			msg, err := govv1beta1.NewMsgSubmitProposal(&proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "Initial deposit for the proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitCommunityPoolSwapProposal implements the command to submit a community-pool swap proposal
+func NewCmdSubmitCommunityPoolSwapProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-swap [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool swap proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool swap proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Note that --deposit below is the initial proposal deposit submitted along with the proposal.
+Example:
+$ %s tx gov submit-proposal community-pool-swap <path/to/proposal.json> --deposit 1000000000ukava --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Community Pool Swap",
+  "description": "Swap some KAVA from community pool for USDX!",
+  "exact_token_a": {
+    "denom": "ukava",
+    "amount": "100000000000"
+  },
+  "token_b": {
+    "denom": "usdx",
+    "amount": "50000000000"
+  },
+  "slippage": "0.010000000000000000",
+  "deadline": "1893456000"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// parse proposal
+			proposal, err := utils.ParseCommunityPoolSwapProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := parseInitialDeposit(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+			msg, err := govv1beta1.NewMsgSubmitProposal(&proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "Initial deposit for the proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitCommunityPoolProvideLiquidityProposal implements the command to submit a community-pool provide liquidity proposal
+func NewCmdSubmitCommunityPoolProvideLiquidityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-provide-liquidity [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool provide liquidity proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool provide liquidity proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Note that --deposit below is the initial proposal deposit submitted along with the proposal.
+Example:
+$ %s tx gov submit-proposal community-pool-provide-liquidity <path/to/proposal.json> --deposit 1000000000ukava --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Community Pool Provide Liquidity",
+  "description": "Deposit some KAVA and USDX from community pool into the swap pool!",
+  "token_a": {
+    "denom": "ukava",
+    "amount": "100000000000"
+  },
+  "token_b": {
+    "denom": "usdx",
+    "amount": "50000000000"
+  },
+  "slippage": "0.010000000000000000",
+  "deadline": "1893456000"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// parse proposal
+			proposal, err := utils.ParseCommunityPoolProvideLiquidityProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := parseInitialDeposit(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+			msg, err := govv1beta1.NewMsgSubmitProposal(&proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "Initial deposit for the proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitCommunityPoolWithdrawLiquidityProposal implements the command to submit a community-pool withdraw liquidity proposal
+func NewCmdSubmitCommunityPoolWithdrawLiquidityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-withdraw-liquidity [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool withdraw liquidity proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool withdraw liquidity proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Note that --deposit below is the initial proposal deposit submitted along with the proposal.
+Example:
+$ %s tx gov submit-proposal community-pool-withdraw-liquidity <path/to/proposal.json> --deposit 1000000000ukava --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Community Pool Withdraw Liquidity",
+  "description": "Withdraw the community pool's KAVA and USDX from the swap pool!",
+  "shares": "70000000000",
+  "min_token_a": {
+    "denom": "ukava",
+    "amount": "99000000000"
+  },
+  "min_token_b": {
+    "denom": "usdx",
+    "amount": "49500000000"
+  },
+  "deadline": "1893456000"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// parse proposal
+			proposal, err := utils.ParseCommunityPoolWithdrawLiquidityProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := parseInitialDeposit(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+			msg, err := govv1beta1.NewMsgSubmitProposal(&proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "Initial deposit for the proposal")
+
+	return cmd
+}
+
 func parseInitialDeposit(cmd *cobra.Command) (sdk.Coins, error) {
 	// parse initial deposit
 	depositStr, err := cmd.Flags().GetString(flagDeposit)
@@ -6,7 +6,7 @@ import (
 	"github.com/kava-labs/kava/x/community/client/cli"
 )
 
-// community-pool deposit/withdraw lend proposal handlers
+// community-pool deposit/withdraw lend, payment stream, and swap proposal handlers
 var (
 	LendDepositProposalHandler = govclient.NewProposalHandler(
 		cli.NewCmdSubmitCommunityPoolLendDepositProposal,
@@ -14,4 +14,19 @@ var (
 	LendWithdrawProposalHandler = govclient.NewProposalHandler(
 		cli.NewCmdSubmitCommunityPoolLendWithdrawProposal,
 	)
+	PaymentStreamProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCommunityPoolPaymentStreamProposal,
+	)
+	CancelPaymentStreamProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCommunityPoolCancelPaymentStreamProposal,
+	)
+	SwapProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCommunityPoolSwapProposal,
+	)
+	ProvideLiquidityProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCommunityPoolProvideLiquidityProposal,
+	)
+	WithdrawLiquidityProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCommunityPoolWithdrawLiquidityProposal,
+	)
 )
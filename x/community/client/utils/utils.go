@@ -37,3 +37,78 @@ func ParseCommunityPoolLendWithdrawProposal(
 	err = cdc.UnmarshalJSON(contents, &proposal)
 	return proposal, err
 }
+
+// ParseCommunityPoolPaymentStreamProposal reads a JSON file and parses it to a CommunityPoolPaymentStreamProposal
+func ParseCommunityPoolPaymentStreamProposal(
+	cdc codec.JSONCodec,
+	proposalFile string,
+) (types.CommunityPoolPaymentStreamProposal, error) {
+	proposal := types.CommunityPoolPaymentStreamProposal{}
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return proposal, err
+}
+
+// ParseCommunityPoolCancelPaymentStreamProposal reads a JSON file and parses it to a CommunityPoolCancelPaymentStreamProposal
+func ParseCommunityPoolCancelPaymentStreamProposal(
+	cdc codec.JSONCodec,
+	proposalFile string,
+) (types.CommunityPoolCancelPaymentStreamProposal, error) {
+	proposal := types.CommunityPoolCancelPaymentStreamProposal{}
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return proposal, err
+}
+
+// ParseCommunityPoolSwapProposal reads a JSON file and parses it to a CommunityPoolSwapProposal
+func ParseCommunityPoolSwapProposal(
+	cdc codec.JSONCodec,
+	proposalFile string,
+) (types.CommunityPoolSwapProposal, error) {
+	proposal := types.CommunityPoolSwapProposal{}
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return proposal, err
+}
+
+// ParseCommunityPoolProvideLiquidityProposal reads a JSON file and parses it to a CommunityPoolProvideLiquidityProposal
+func ParseCommunityPoolProvideLiquidityProposal(
+	cdc codec.JSONCodec,
+	proposalFile string,
+) (types.CommunityPoolProvideLiquidityProposal, error) {
+	proposal := types.CommunityPoolProvideLiquidityProposal{}
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return proposal, err
+}
+
+// ParseCommunityPoolWithdrawLiquidityProposal reads a JSON file and parses it to a CommunityPoolWithdrawLiquidityProposal
+func ParseCommunityPoolWithdrawLiquidityProposal(
+	cdc codec.JSONCodec,
+	proposalFile string,
+) (types.CommunityPoolWithdrawLiquidityProposal, error) {
+	proposal := types.CommunityPoolWithdrawLiquidityProposal{}
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return proposal, err
+}
@@ -2,6 +2,7 @@ package utils_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -62,6 +63,144 @@ func TestParseWithdrawProposal(t *testing.T) {
 	require.Equal(t, expectedAmount, proposal.Amount)
 }
 
+func TestParsePaymentStreamProposal(t *testing.T) {
+	cdc := codec.NewAminoCodec(codec.NewLegacyAmino())
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Community Pool Payment Stream",
+  "description": "Pay a grantee some KAVA from community pool over a year!",
+  "recipient": "kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w",
+  "amount": [
+    {
+      "denom": "ukava",
+      "amount": "100000000000"
+    }
+  ],
+  "start": "2030-01-01T00:00:00Z",
+  "end": "2031-01-01T00:00:00Z",
+  "cliff": "2030-04-01T00:00:00Z"
+}
+`)
+	proposal, err := utils.ParseCommunityPoolPaymentStreamProposal(cdc, okJSON.Name())
+	require.NoError(t, err)
+
+	expectedAmount, err := sdk.ParseCoinsNormalized("100000000000ukava")
+	require.NoError(t, err)
+
+	require.Equal(t, "Community Pool Payment Stream", proposal.Title)
+	require.Equal(t, "Pay a grantee some KAVA from community pool over a year!", proposal.Description)
+	require.Equal(t, "kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w", proposal.Recipient)
+	require.Equal(t, expectedAmount, proposal.Amount)
+	require.True(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).Equal(proposal.Start))
+	require.True(t, time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC).Equal(proposal.End))
+	require.NotNil(t, proposal.Cliff)
+	require.True(t, time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC).Equal(*proposal.Cliff))
+}
+
+func TestParseCancelPaymentStreamProposal(t *testing.T) {
+	cdc := codec.NewAminoCodec(codec.NewLegacyAmino())
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Community Pool Cancel Payment Stream",
+  "description": "Stop paying the grantee!",
+  "stream_id": "1"
+}
+`)
+	proposal, err := utils.ParseCommunityPoolCancelPaymentStreamProposal(cdc, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Community Pool Cancel Payment Stream", proposal.Title)
+	require.Equal(t, "Stop paying the grantee!", proposal.Description)
+	require.Equal(t, uint64(1), proposal.StreamID)
+}
+
+func TestParseSwapProposal(t *testing.T) {
+	cdc := codec.NewAminoCodec(codec.NewLegacyAmino())
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Community Pool Swap",
+  "description": "Swap some KAVA from community pool for USDX!",
+  "exact_token_a": {
+    "denom": "ukava",
+    "amount": "100000000000"
+  },
+  "token_b": {
+    "denom": "usdx",
+    "amount": "50000000000"
+  },
+  "slippage": "0.010000000000000000",
+  "deadline": "1893456000"
+}
+`)
+	proposal, err := utils.ParseCommunityPoolSwapProposal(cdc, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Community Pool Swap", proposal.Title)
+	require.Equal(t, "Swap some KAVA from community pool for USDX!", proposal.Description)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 100000000000), proposal.ExactTokenA)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 50000000000), proposal.TokenB)
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), proposal.Slippage)
+	require.Equal(t, int64(1893456000), proposal.Deadline)
+}
+
+func TestParseProvideLiquidityProposal(t *testing.T) {
+	cdc := codec.NewAminoCodec(codec.NewLegacyAmino())
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Community Pool Provide Liquidity",
+  "description": "Deposit some KAVA and USDX from community pool into the swap pool!",
+  "token_a": {
+    "denom": "ukava",
+    "amount": "100000000000"
+  },
+  "token_b": {
+    "denom": "usdx",
+    "amount": "50000000000"
+  },
+  "slippage": "0.010000000000000000",
+  "deadline": "1893456000"
+}
+`)
+	proposal, err := utils.ParseCommunityPoolProvideLiquidityProposal(cdc, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Community Pool Provide Liquidity", proposal.Title)
+	require.Equal(t, "Deposit some KAVA and USDX from community pool into the swap pool!", proposal.Description)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 100000000000), proposal.TokenA)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 50000000000), proposal.TokenB)
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), proposal.Slippage)
+	require.Equal(t, int64(1893456000), proposal.Deadline)
+}
+
+func TestParseWithdrawLiquidityProposal(t *testing.T) {
+	cdc := codec.NewAminoCodec(codec.NewLegacyAmino())
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Community Pool Withdraw Liquidity",
+  "description": "Withdraw the community pool's KAVA and USDX from the swap pool!",
+  "shares": "70000000000",
+  "min_token_a": {
+    "denom": "ukava",
+    "amount": "99000000000"
+  },
+  "min_token_b": {
+    "denom": "usdx",
+    "amount": "49500000000"
+  },
+  "deadline": "1893456000"
+}
+`)
+	proposal, err := utils.ParseCommunityPoolWithdrawLiquidityProposal(cdc, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Community Pool Withdraw Liquidity", proposal.Title)
+	require.Equal(t, "Withdraw the community pool's KAVA and USDX from the swap pool!", proposal.Description)
+	require.Equal(t, sdk.NewInt(70000000000), proposal.Shares)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 99000000000), proposal.MinTokenA)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 49500000000), proposal.MinTokenB)
+	require.Equal(t, int64(1893456000), proposal.Deadline)
+}
+
 func TestParseFileNoExists(t *testing.T) {
 	cdc := codec.NewAminoCodec(codec.NewLegacyAmino())
 	_, err := utils.ParseCommunityPoolLendDepositProposal(cdc, "not-a-file.json")
@@ -19,6 +19,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 
 	k.SetParams(ctx, gs.Params)
 	k.SetStakingRewardsState(ctx, gs.StakingRewardsState)
+
+	for _, stream := range gs.PaymentStreams {
+		k.SetPaymentStream(ctx, stream)
+	}
+	k.SetNextPaymentStreamID(ctx, gs.NextPaymentStreamID)
 }
 
 // ExportGenesis exports the store to a genesis state
@@ -30,5 +35,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 
 	stakingRewardsState := k.GetStakingRewardsState(ctx)
 
-	return types.NewGenesisState(params, stakingRewardsState)
+	return types.NewGenesisState(
+		params,
+		stakingRewardsState,
+		k.GetAllPaymentStreams(ctx),
+		k.GetNextPaymentStreamID(ctx),
+	)
 }
@@ -7,8 +7,10 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/community"
 	"github.com/kava-labs/kava/x/community/testutil"
 	"github.com/kava-labs/kava/x/community/types"
@@ -40,6 +42,8 @@ func (suite *genesisTestSuite) TestInitGenesis() {
 			time.Date(1997, 1, 1, 0, 0, 0, 0, time.UTC),
 			sdkmath.LegacyMustNewDecFromStr("0.100000000000000000"),
 		),
+		types.PaymentStreams{},
+		types.DefaultNextPaymentStreamID,
 	)
 
 	suite.NotPanics(func() {
@@ -59,6 +63,9 @@ func (suite *genesisTestSuite) TestInitGenesis() {
 
 	stakingRewardsState := keeper.GetStakingRewardsState(suite.Ctx)
 	suite.Equal(genesisState.StakingRewardsState, stakingRewardsState)
+
+	suite.Empty(keeper.GetAllPaymentStreams(suite.Ctx))
+	suite.Equal(genesisState.NextPaymentStreamID, keeper.GetNextPaymentStreamID(suite.Ctx))
 }
 
 func (suite *genesisTestSuite) TestExportGenesis() {
@@ -82,6 +89,7 @@ func (suite *genesisTestSuite) TestExportGenesis() {
 }
 
 func (suite *genesisTestSuite) TestInitExportIsLossless() {
+	cliff := time.Date(1998, 6, 1, 0, 0, 0, 0, time.UTC)
 	genesisState := types.NewGenesisState(
 		types.NewParams(
 			time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC),
@@ -92,6 +100,18 @@ func (suite *genesisTestSuite) TestInitExportIsLossless() {
 			time.Date(1997, 1, 1, 0, 0, 0, 0, time.UTC),
 			sdkmath.LegacyMustNewDecFromStr("0.100000000000000000"),
 		),
+		types.PaymentStreams{
+			{
+				ID:        2,
+				Recipient: app.RandomAddress().String(),
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e10)),
+				Paid:      sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e9)),
+				Start:     time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC),
+				End:       time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC),
+				Cliff:     &cliff,
+			},
+		},
+		3,
 	)
 
 	community.InitGenesis(suite.Ctx, suite.Keeper, suite.App.GetAccountKeeper(), genesisState)
@@ -22,6 +22,16 @@ func NewCommunityPoolProposalHandler(k keeper.Keeper) govv1beta1.Handler {
 			return keeper.HandleCommunityPoolLendDepositProposal(ctx, k, c)
 		case *types.CommunityPoolLendWithdrawProposal:
 			return keeper.HandleCommunityPoolLendWithdrawProposal(ctx, k, c)
+		case *types.CommunityPoolPaymentStreamProposal:
+			return keeper.HandleCommunityPoolPaymentStreamProposal(ctx, k, c)
+		case *types.CommunityPoolCancelPaymentStreamProposal:
+			return keeper.HandleCommunityPoolCancelPaymentStreamProposal(ctx, k, c)
+		case *types.CommunityPoolSwapProposal:
+			return keeper.HandleCommunityPoolSwapProposal(ctx, k, c)
+		case *types.CommunityPoolProvideLiquidityProposal:
+			return keeper.HandleCommunityPoolProvideLiquidityProposal(ctx, k, c)
+		case *types.CommunityPoolWithdrawLiquidityProposal:
+			return keeper.HandleCommunityPoolWithdrawLiquidityProposal(ctx, k, c)
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized community proposal content type: %T", c)
 		}
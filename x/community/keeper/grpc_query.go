@@ -90,6 +90,51 @@ func (s queryServer) AnnualizedRewards(
 	}, nil
 }
 
+// PaymentStreams queries the community pool payment streams with their paid and remaining amounts.
+func (s queryServer) PaymentStreams(
+	c context.Context,
+	req *types.QueryPaymentStreamsRequest,
+) (*types.QueryPaymentStreamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	streams := []types.PaymentStreamResponse{}
+	s.keeper.IteratePaymentStreams(ctx, func(stream types.PaymentStream) (stop bool) {
+		streams = append(streams, newPaymentStreamResponse(stream))
+		return false
+	})
+
+	return &types.QueryPaymentStreamsResponse{
+		PaymentStreams: streams,
+	}, nil
+}
+
+// PaymentStream queries a community pool payment stream by id.
+func (s queryServer) PaymentStream(
+	c context.Context,
+	req *types.QueryPaymentStreamRequest,
+) (*types.QueryPaymentStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stream, found := s.keeper.GetPaymentStream(ctx, req.StreamId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "payment stream %d not found", req.StreamId)
+	}
+
+	return &types.QueryPaymentStreamResponse{
+		PaymentStream: newPaymentStreamResponse(stream),
+	}, nil
+}
+
+func newPaymentStreamResponse(stream types.PaymentStream) types.PaymentStreamResponse {
+	return types.PaymentStreamResponse{
+		Stream:    stream,
+		Remaining: stream.Remaining(),
+	}
+}
+
 // convertDecToLegacyDec is a helper method for converting between new and old Dec types
 // current version of cosmos-sdk in this repo uses sdk.Dec
 // this module uses sdkmath.LegacyDec in its parameters
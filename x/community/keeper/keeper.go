@@ -25,6 +25,7 @@ type Keeper struct {
 	mintKeeper     types.MintKeeper
 	kavadistKeeper types.KavadistKeeper
 	stakingKeeper  types.StakingKeeper
+	swapKeeper     types.SwapKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -45,6 +46,7 @@ func NewKeeper(
 	mk types.MintKeeper,
 	kk types.KavadistKeeper,
 	sk types.StakingKeeper,
+	swk types.SwapKeeper,
 	authority sdk.AccAddress,
 ) Keeper {
 	// ensure community module account is set
@@ -72,6 +74,7 @@ func NewKeeper(
 		mintKeeper:     mk,
 		kavadistKeeper: kk,
 		stakingKeeper:  sk,
+		swapKeeper:     swk,
 		moduleAddress:  addr,
 
 		authority:                  authority,
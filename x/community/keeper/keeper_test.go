@@ -118,6 +118,7 @@ func (suite *KeeperTestSuite) TestGetAuthority_Any() {
 		},
 	}
 
+	swapKeeper := suite.App.GetSwapKeeper()
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
 			suite.NotPanics(func() {
@@ -132,6 +133,7 @@ func (suite *KeeperTestSuite) TestGetAuthority_Any() {
 					suite.App.GetMintKeeper(),
 					suite.App.GetKavadistKeeper(),
 					suite.App.GetStakingKeeper(),
+					&swapKeeper,
 					tc.authority,
 				)
 			})
@@ -164,6 +166,7 @@ func (suite *KeeperTestSuite) TestNewKeeper_InvalidAuthority() {
 		},
 	}
 
+	swapKeeper := suite.App.GetSwapKeeper()
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
 			suite.PanicsWithValue(
@@ -180,6 +183,7 @@ func (suite *KeeperTestSuite) TestNewKeeper_InvalidAuthority() {
 						suite.App.GetMintKeeper(),
 						suite.App.GetKavadistKeeper(),
 						suite.App.GetStakingKeeper(),
+						&swapKeeper,
 						tc.authority,
 					)
 				})
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/community/types"
+)
+
+// GetPaymentStream returns a payment stream by id
+func (k Keeper) GetPaymentStream(ctx sdk.Context, id uint64) (types.PaymentStream, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamKeyPrefix)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return types.PaymentStream{}, false
+	}
+	var stream types.PaymentStream
+	k.cdc.MustUnmarshal(bz, &stream)
+	return stream, true
+}
+
+// SetPaymentStream sets a payment stream in the store
+func (k Keeper) SetPaymentStream(ctx sdk.Context, stream types.PaymentStream) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamKeyPrefix)
+	bz := k.cdc.MustMarshal(&stream)
+	store.Set(sdk.Uint64ToBigEndian(stream.ID), bz)
+}
+
+// DeletePaymentStream deletes a payment stream from the store
+func (k Keeper) DeletePaymentStream(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamKeyPrefix)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// IteratePaymentStreams iterates over all payment streams in id order and performs a callback function
+func (k Keeper) IteratePaymentStreams(ctx sdk.Context, cb func(stream types.PaymentStream) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.PaymentStream
+		k.cdc.MustUnmarshal(iterator.Value(), &stream)
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+// GetAllPaymentStreams returns all payment streams
+func (k Keeper) GetAllPaymentStreams(ctx sdk.Context) types.PaymentStreams {
+	streams := types.PaymentStreams{}
+	k.IteratePaymentStreams(ctx, func(stream types.PaymentStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// GetNextPaymentStreamID returns the id to give the next payment stream
+func (k Keeper) GetNextPaymentStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextPaymentStreamIDKey)
+	if bz == nil {
+		return types.DefaultNextPaymentStreamID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextPaymentStreamID sets the id to give the next payment stream
+func (k Keeper) SetNextPaymentStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextPaymentStreamIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// CreatePaymentStream creates a stream paying a recipient from the community pool between the start and end times.
+// Funds are not reserved, each payment is made from the community module account balance when it accrues.
+func (k Keeper) CreatePaymentStream(
+	ctx sdk.Context,
+	recipient sdk.AccAddress,
+	amount sdk.Coins,
+	start, end time.Time,
+	cliff *time.Time,
+) (uint64, error) {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+	if !end.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPaymentStream, "end %s must be after the block time %s", end, ctx.BlockTime())
+	}
+
+	id := k.GetNextPaymentStreamID(ctx)
+	stream := types.NewPaymentStream(id, recipient.String(), amount, start, end, cliff)
+	if err := stream.Validate(); err != nil {
+		return 0, err
+	}
+	k.SetPaymentStream(ctx, stream)
+	k.SetNextPaymentStreamID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePaymentStreamCreated,
+			sdk.NewAttribute(types.AttributeKeyPaymentStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return id, nil
+}
+
+// CancelPaymentStream stops a payment stream. Amounts that have not been paid are left in the community pool.
+func (k Keeper) CancelPaymentStream(ctx sdk.Context, id uint64) error {
+	stream, found := k.GetPaymentStream(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPaymentStreamNotFound, "%d", id)
+	}
+	k.DeletePaymentStream(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePaymentStreamCancelled,
+			sdk.NewAttribute(types.AttributeKeyPaymentStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(types.AttributeKeyRemaining, stream.Remaining().String()),
+		),
+	)
+	return nil
+}
+
+// PayoutPaymentStreams pays the amounts the payment streams have accrued since they were last paid, and deletes the
+// streams that have paid their whole amount. If the community pool cannot cover a payment it is retried next block.
+func (k Keeper) PayoutPaymentStreams(ctx sdk.Context) {
+	for _, stream := range k.GetAllPaymentStreams(ctx) {
+		payable := stream.Payable(ctx.BlockTime())
+		if payable.IsZero() {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(stream.Recipient)
+		if err != nil {
+			// streams are validated when they are stored so this can only occur in an invalid state
+			panic(err)
+		}
+
+		// pay in a cache context so a failed payment does not leave a partial transfer
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleAccountName, recipient, payable); err != nil {
+			k.Logger(ctx).Info("payment stream could not be paid", "id", stream.ID, "amount", payable, "err", err)
+			continue
+		}
+		writeCache()
+
+		stream.Paid = stream.Paid.Add(payable...)
+		if stream.IsComplete() {
+			k.DeletePaymentStream(ctx, stream.ID)
+		} else {
+			k.SetPaymentStream(ctx, stream)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePaymentStreamPaid,
+				sdk.NewAttribute(types.AttributeKeyPaymentStreamID, fmt.Sprintf("%d", stream.ID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+				sdk.NewAttribute(types.AttributeKeyAmount, payable.String()),
+				sdk.NewAttribute(types.AttributeKeyRemaining, stream.Remaining().String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/community/keeper"
+	"github.com/kava-labs/kava/x/community/types"
+)
+
+func (suite *proposalTestSuite) FundCommunityModuleAccount(coins sdk.Coins) {
+	// mint to ephemeral account
+	ephemeralAcc := app.RandomAddress()
+	suite.NoError(suite.App.FundAccount(suite.Ctx, ephemeralAcc, coins))
+	// payment streams are paid from the x/community module account
+	suite.NoError(suite.Keeper.FundCommunityPool(suite.Ctx, ephemeralAcc, coins))
+}
+
+func (suite *proposalTestSuite) PayoutPaymentStreamsAt(blockTime time.Time) {
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime)
+	suite.Keeper.PayoutPaymentStreams(suite.Ctx)
+}
+
+func (suite *proposalTestSuite) CheckRecipientBalance(recipient sdk.AccAddress, expected sdk.Coins) {
+	actual := suite.App.GetBankKeeper().GetAllBalances(suite.Ctx, recipient)
+	suite.Truef(expected.IsEqual(actual), "unexpected recipient balance\nexpected: %s\nactual: %s", expected, actual)
+}
+
+func (suite *proposalTestSuite) TestCommunityPoolPaymentStreamProposal() {
+	recipient := app.RandomAddress()
+	start := suite.Ctx.BlockTime()
+	end := start.Add(100 * time.Second)
+	cliff := start.Add(30 * time.Second)
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	suite.FundCommunityModuleAccount(ukava(1e6))
+
+	proposal := types.NewCommunityPoolPaymentStreamProposal(
+		"payment stream", "pays a grantee", recipient.String(), ukava(1e6), start, end, &cliff,
+	)
+	suite.Require().NoError(proposal.ValidateBasic())
+	suite.Require().NoError(keeper.HandleCommunityPoolPaymentStreamProposal(suite.Ctx, suite.Keeper, proposal))
+
+	// nothing is paid before the cliff
+	suite.PayoutPaymentStreamsAt(start.Add(20 * time.Second))
+	suite.CheckRecipientBalance(recipient, sdk.NewCoins())
+
+	// the amount accrued before the cliff is paid at the cliff
+	suite.PayoutPaymentStreamsAt(cliff)
+	suite.CheckRecipientBalance(recipient, ukava(3e5))
+
+	// the stream is paid each block through the begin blocker
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(54 * time.Second))
+	suite.NextBlock()
+	suite.CheckRecipientBalance(recipient, ukava(6e5))
+
+	res, err := queryServer.PaymentStreams(sdk.WrapSDKContext(suite.Ctx), &types.QueryPaymentStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.PaymentStreams, 1)
+	suite.Equal(uint64(1), res.PaymentStreams[0].Stream.ID)
+	suite.Equal(ukava(6e5), res.PaymentStreams[0].Stream.Paid)
+	suite.Equal(ukava(4e5), res.PaymentStreams[0].Remaining)
+
+	// the whole amount is paid at the end and the stream is removed
+	suite.PayoutPaymentStreamsAt(end.Add(10 * time.Second))
+	suite.CheckRecipientBalance(recipient, ukava(1e6))
+	suite.Empty(suite.Keeper.GetAllPaymentStreams(suite.Ctx))
+
+	_, err = queryServer.PaymentStream(sdk.WrapSDKContext(suite.Ctx), &types.QueryPaymentStreamRequest{StreamId: 1})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *proposalTestSuite) TestCommunityPoolPaymentStreamProposal_Invalid() {
+	start := suite.Ctx.BlockTime()
+
+	testCases := []struct {
+		name        string
+		proposal    *types.CommunityPoolPaymentStreamProposal
+		expectedErr error
+	}{
+		{
+			name: "recipient is blocked",
+			proposal: types.NewCommunityPoolPaymentStreamProposal(
+				"payment stream", "pays a module",
+				suite.App.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName).String(),
+				ukava(1e6), start, start.Add(100*time.Second), nil,
+			),
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "stream has ended",
+			proposal: types.NewCommunityPoolPaymentStreamProposal(
+				"payment stream", "pays a grantee", app.RandomAddress().String(),
+				ukava(1e6), start.Add(-100*time.Second), start, nil,
+			),
+			expectedErr: types.ErrInvalidPaymentStream,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := keeper.HandleCommunityPoolPaymentStreamProposal(suite.Ctx, suite.Keeper, tc.proposal)
+			suite.ErrorIs(err, tc.expectedErr)
+		})
+	}
+
+	suite.Empty(suite.Keeper.GetAllPaymentStreams(suite.Ctx))
+	suite.Equal(types.DefaultNextPaymentStreamID, suite.Keeper.GetNextPaymentStreamID(suite.Ctx))
+}
+
+func (suite *proposalTestSuite) TestPayoutPaymentStreams_InsufficientFunds() {
+	recipient := app.RandomAddress()
+	start := suite.Ctx.BlockTime()
+	end := start.Add(100 * time.Second)
+
+	suite.FundCommunityModuleAccount(otherdenom(1e10))
+
+	_, err := suite.Keeper.CreatePaymentStream(suite.Ctx, recipient, otherdenom(2e10), start, end, nil)
+	suite.Require().NoError(err)
+
+	suite.PayoutPaymentStreamsAt(start.Add(40 * time.Second))
+	suite.CheckRecipientBalance(recipient, otherdenom(8e9))
+
+	// the community pool cannot cover the payment so it is not paid
+	suite.PayoutPaymentStreamsAt(start.Add(60 * time.Second))
+	suite.CheckRecipientBalance(recipient, otherdenom(8e9))
+	stream, found := suite.Keeper.GetPaymentStream(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Equal(otherdenom(8e9), stream.Paid)
+
+	// the payment is made once the community pool is funded
+	suite.FundCommunityModuleAccount(otherdenom(1e10))
+	suite.PayoutPaymentStreamsAt(start.Add(60 * time.Second))
+	suite.CheckRecipientBalance(recipient, otherdenom(12e9))
+}
+
+func (suite *proposalTestSuite) TestCommunityPoolCancelPaymentStreamProposal() {
+	recipient := app.RandomAddress()
+	start := suite.Ctx.BlockTime()
+	end := start.Add(100 * time.Second)
+
+	suite.FundCommunityModuleAccount(ukava(1e6))
+
+	id, err := suite.Keeper.CreatePaymentStream(suite.Ctx, recipient, ukava(1e6), start, end, nil)
+	suite.Require().NoError(err)
+
+	suite.PayoutPaymentStreamsAt(start.Add(50 * time.Second))
+	suite.CheckRecipientBalance(recipient, ukava(5e5))
+
+	proposal := types.NewCommunityPoolCancelPaymentStreamProposal("cancel payment stream", "stops paying a grantee", id)
+	suite.Require().NoError(proposal.ValidateBasic())
+	suite.Require().NoError(keeper.HandleCommunityPoolCancelPaymentStreamProposal(suite.Ctx, suite.Keeper, proposal))
+
+	// the stream pays nothing more
+	suite.PayoutPaymentStreamsAt(end)
+	suite.CheckRecipientBalance(recipient, ukava(5e5))
+	suite.Empty(suite.Keeper.GetAllPaymentStreams(suite.Ctx))
+
+	err = keeper.HandleCommunityPoolCancelPaymentStreamProposal(suite.Ctx, suite.Keeper, proposal)
+	suite.ErrorIs(err, types.ErrPaymentStreamNotFound)
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/community/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // HandleCommunityPoolLendDepositProposal is a handler for executing a passed community pool lend deposit proposal.
@@ -39,3 +41,73 @@ func HandleCommunityCDPWithdrawCollateralProposal(
 	// withdraw collateral
 	return k.cdpKeeper.WithdrawCollateral(ctx, k.moduleAddress, k.moduleAddress, p.Collateral, p.CollateralType)
 }
+
+// HandleCommunityPoolPaymentStreamProposal is a handler for executing a passed community pool payment stream proposal.
+func HandleCommunityPoolPaymentStreamProposal(
+	ctx sdk.Context,
+	k Keeper,
+	p *types.CommunityPoolPaymentStreamProposal,
+) error {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return err
+	}
+	// create the stream, payments are made in the begin blocker as they accrue
+	_, err = k.CreatePaymentStream(ctx, recipient, p.Amount, p.Start, p.End, p.Cliff)
+	return err
+}
+
+// HandleCommunityPoolCancelPaymentStreamProposal is a handler for executing a
+// passed community pool cancel payment stream proposal.
+func HandleCommunityPoolCancelPaymentStreamProposal(
+	ctx sdk.Context,
+	k Keeper,
+	p *types.CommunityPoolCancelPaymentStreamProposal,
+) error {
+	return k.CancelPaymentStream(ctx, p.StreamID)
+}
+
+// HandleCommunityPoolSwapProposal is a handler for executing a passed community pool swap proposal.
+func HandleCommunityPoolSwapProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolSwapProposal) error {
+	if err := checkProposalDeadline(ctx, p.Deadline); err != nil {
+		return err
+	}
+	// swap funds held by this module account, the swapped funds are returned to it
+	return k.swapKeeper.SwapExactForTokens(ctx, k.moduleAddress, p.ExactTokenA, p.TokenB, p.Slippage)
+}
+
+// HandleCommunityPoolProvideLiquidityProposal is a handler for executing a
+// passed community pool provide liquidity proposal.
+func HandleCommunityPoolProvideLiquidityProposal(
+	ctx sdk.Context,
+	k Keeper,
+	p *types.CommunityPoolProvideLiquidityProposal,
+) error {
+	if err := checkProposalDeadline(ctx, p.Deadline); err != nil {
+		return err
+	}
+	// deposit funds held by this module account so the pool shares are owned by it
+	return k.swapKeeper.Deposit(ctx, k.moduleAddress, p.TokenA, p.TokenB, p.Slippage)
+}
+
+// HandleCommunityPoolWithdrawLiquidityProposal is a handler for executing a
+// passed community pool withdraw liquidity proposal.
+func HandleCommunityPoolWithdrawLiquidityProposal(
+	ctx sdk.Context,
+	k Keeper,
+	p *types.CommunityPoolWithdrawLiquidityProposal,
+) error {
+	if err := checkProposalDeadline(ctx, p.Deadline); err != nil {
+		return err
+	}
+	// withdraw pool shares owned by this module account, the withdrawn funds are returned to it
+	return k.swapKeeper.Withdraw(ctx, k.moduleAddress, p.Shares, p.MinTokenA, p.MinTokenB)
+}
+
+// checkProposalDeadline returns an error if the block time exceeds a proposal's unix deadline
+func checkProposalDeadline(ctx sdk.Context, deadline int64) error {
+	if ctx.BlockTime().Unix() >= deadline {
+		return errorsmod.Wrapf(swaptypes.ErrDeadlineExceeded, "block time %d >= deadline %d", ctx.BlockTime().Unix(), deadline)
+	}
+	return nil
+}
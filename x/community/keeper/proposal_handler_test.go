@@ -10,6 +10,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/kava-labs/kava/app"
@@ -20,6 +21,7 @@ import (
 	hardkeeper "github.com/kava-labs/kava/x/hard/keeper"
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 const chainID = app.TestChainId
@@ -76,7 +78,12 @@ func (suite *proposalTestSuite) SetupTest() {
 		UpgradeTimeDisableInflation: time.Now().Add(100000 * time.Hour),
 		StakingRewardsPerSecond:     sdkmath.LegacyNewDec(0),
 	}
-	communityGs := types.NewGenesisState(params, types.DefaultStakingRewardsState())
+	communityGs := types.NewGenesisState(
+		params,
+		types.DefaultStakingRewardsState(),
+		types.PaymentStreams{},
+		types.DefaultNextPaymentStreamID,
+	)
 
 	tApp.InitializeFromGenesisStatesWithTimeAndChainID(
 		genTime, chainID,
@@ -560,3 +567,241 @@ func (suite *proposalTestSuite) TestCommunityCDPWithdrawCollateralProposal() {
 		})
 	}
 }
+
+// setupSwapPool creates a ukava:usdx swap pool with equal reserves and no swap fee
+func (suite *proposalTestSuite) setupSwapPool(reserves sdkmath.Int) {
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ukava", "usdx")),
+		sdk.ZeroDec(),
+	))
+
+	depositor := app.RandomAddress()
+	tokenA, tokenB := sdk.NewCoin("ukava", reserves), sdk.NewCoin("usdx", reserves)
+	suite.NoError(suite.App.FundAccount(suite.Ctx, depositor, sdk.NewCoins(tokenA, tokenB)))
+	suite.NoError(swapKeeper.Deposit(suite.Ctx, depositor, tokenA, tokenB, sdk.MustNewDecFromStr("0.01")))
+}
+
+// expectation: funds in the community module are swapped and the swapped funds stay in the community module.
+func (suite *proposalTestSuite) TestCommunityPoolSwapProposal() {
+	initialModuleFunds := ukava(1e9)
+	testcases := []struct {
+		name        string
+		proposal    func(blockTime time.Time) *types.CommunityPoolSwapProposal
+		expectedErr error
+	}{
+		{
+			name: "valid - swaps within slippage",
+			proposal: func(blockTime time.Time) *types.CommunityPoolSwapProposal {
+				return types.NewCommunityPoolSwapProposal(
+					"swap", "diversify", c("ukava", 1e8), c("usdx", 1e8), sdk.MustNewDecFromStr("0.05"), blockTime.Add(time.Hour).Unix(),
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "invalid - deadline exceeded",
+			proposal: func(blockTime time.Time) *types.CommunityPoolSwapProposal {
+				return types.NewCommunityPoolSwapProposal(
+					"swap", "diversify", c("ukava", 1e8), c("usdx", 1e8), sdk.MustNewDecFromStr("0.05"), blockTime.Unix(),
+				)
+			},
+			expectedErr: swaptypes.ErrDeadlineExceeded,
+		},
+		{
+			name: "invalid - slippage exceeded",
+			proposal: func(blockTime time.Time) *types.CommunityPoolSwapProposal {
+				return types.NewCommunityPoolSwapProposal(
+					"swap", "diversify", c("ukava", 1e8), c("usdx", 1e8), sdk.MustNewDecFromStr("0.001"), blockTime.Add(time.Hour).Unix(),
+				)
+			},
+			expectedErr: swaptypes.ErrSlippageExceeded,
+		},
+		{
+			name: "invalid - insufficient funds",
+			proposal: func(blockTime time.Time) *types.CommunityPoolSwapProposal {
+				return types.NewCommunityPoolSwapProposal(
+					"swap", "diversify", c("ukava", 1e10), c("usdx", 1e9), sdk.MustNewDecFromStr("0.5"), blockTime.Add(time.Hour).Unix(),
+				)
+			},
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupSwapPool(sdkmath.NewInt(1e10))
+
+			err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleAccountName, initialModuleFunds)
+			suite.NoError(err, "failed to initially fund module account")
+
+			proposal := tc.proposal(suite.Ctx.BlockTime())
+			suite.NoError(proposal.ValidateBasic())
+
+			err = keeper.HandleCommunityPoolSwapProposal(suite.Ctx, suite.Keeper, proposal)
+			moduleBalance := suite.Keeper.GetModuleAccountBalance(suite.Ctx)
+			if tc.expectedErr != nil {
+				suite.ErrorIs(err, tc.expectedErr)
+				suite.True(initialModuleFunds.IsEqual(moduleBalance), "module balance changed unexpectedly")
+				return
+			}
+
+			suite.NoError(err)
+			// the exact amount is swapped, and the output stays in the module account
+			suite.Equal(initialModuleFunds.Sub(proposal.ExactTokenA).AmountOf("ukava"), moduleBalance.AmountOf("ukava"))
+			suite.True(moduleBalance.AmountOf("usdx").GTE(sdkmath.NewInt(95e6)), "unexpected swap output %s", moduleBalance)
+		})
+	}
+}
+
+// expectation: funds in the community module are deposited into a swap pool and the pool shares are owned by the
+// community module.
+func (suite *proposalTestSuite) TestCommunityPoolProvideLiquidityProposal() {
+	initialModuleFunds := ukava(1e9).Add(usdx(1e9)...)
+	testcases := []struct {
+		name        string
+		proposal    func(blockTime time.Time) *types.CommunityPoolProvideLiquidityProposal
+		expectedErr error
+	}{
+		{
+			name: "valid - deposits into pool",
+			proposal: func(blockTime time.Time) *types.CommunityPoolProvideLiquidityProposal {
+				return types.NewCommunityPoolProvideLiquidityProposal(
+					"provide liquidity", "earn swap fees", c("ukava", 1e8), c("usdx", 1e8), sdk.MustNewDecFromStr("0.01"), blockTime.Add(time.Hour).Unix(),
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "invalid - deadline exceeded",
+			proposal: func(blockTime time.Time) *types.CommunityPoolProvideLiquidityProposal {
+				return types.NewCommunityPoolProvideLiquidityProposal(
+					"provide liquidity", "earn swap fees", c("ukava", 1e8), c("usdx", 1e8), sdk.MustNewDecFromStr("0.01"), blockTime.Add(-time.Hour).Unix(),
+				)
+			},
+			expectedErr: swaptypes.ErrDeadlineExceeded,
+		},
+		{
+			name: "invalid - slippage exceeded",
+			proposal: func(blockTime time.Time) *types.CommunityPoolProvideLiquidityProposal {
+				return types.NewCommunityPoolProvideLiquidityProposal(
+					"provide liquidity", "earn swap fees", c("ukava", 1e8), c("usdx", 2e8), sdk.MustNewDecFromStr("0.01"), blockTime.Add(time.Hour).Unix(),
+				)
+			},
+			expectedErr: swaptypes.ErrSlippageExceeded,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupSwapPool(sdkmath.NewInt(1e10))
+
+			err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleAccountName, initialModuleFunds)
+			suite.NoError(err, "failed to initially fund module account")
+
+			proposal := tc.proposal(suite.Ctx.BlockTime())
+			suite.NoError(proposal.ValidateBasic())
+
+			err = keeper.HandleCommunityPoolProvideLiquidityProposal(suite.Ctx, suite.Keeper, proposal)
+			moduleBalance := suite.Keeper.GetModuleAccountBalance(suite.Ctx)
+			shares, found := suite.App.GetSwapKeeper().GetDepositorSharesAmount(suite.Ctx, suite.MaccAddress, "ukava:usdx")
+			if tc.expectedErr != nil {
+				suite.ErrorIs(err, tc.expectedErr)
+				suite.True(initialModuleFunds.IsEqual(moduleBalance), "module balance changed unexpectedly")
+				suite.False(found, "expected no pool shares")
+				return
+			}
+
+			suite.NoError(err)
+			expectedBalance := initialModuleFunds.Sub(proposal.TokenA, proposal.TokenB)
+			suite.True(expectedBalance.IsEqual(moduleBalance), "expected balance %s, got %s", expectedBalance, moduleBalance)
+			suite.True(found, "expected community module to own pool shares")
+			suite.True(shares.IsPositive())
+		})
+	}
+}
+
+// expectation: pool shares owned by the community module are withdrawn and the withdrawn funds are returned to the
+// community module.
+func (suite *proposalTestSuite) TestCommunityPoolWithdrawLiquidityProposal() {
+	initialModuleFunds := ukava(1e9).Add(usdx(1e9)...)
+	testcases := []struct {
+		name        string
+		proposal    func(blockTime time.Time, shares sdkmath.Int) *types.CommunityPoolWithdrawLiquidityProposal
+		expectedErr error
+	}{
+		{
+			name: "valid - withdraws all shares",
+			proposal: func(blockTime time.Time, shares sdkmath.Int) *types.CommunityPoolWithdrawLiquidityProposal {
+				return types.NewCommunityPoolWithdrawLiquidityProposal(
+					"withdraw liquidity", "exit the pool", shares, c("ukava", 99e6), c("usdx", 99e6), blockTime.Add(time.Hour).Unix(),
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "invalid - deadline exceeded",
+			proposal: func(blockTime time.Time, shares sdkmath.Int) *types.CommunityPoolWithdrawLiquidityProposal {
+				return types.NewCommunityPoolWithdrawLiquidityProposal(
+					"withdraw liquidity", "exit the pool", shares, c("ukava", 99e6), c("usdx", 99e6), blockTime.Unix(),
+				)
+			},
+			expectedErr: swaptypes.ErrDeadlineExceeded,
+		},
+		{
+			name: "invalid - slippage exceeded",
+			proposal: func(blockTime time.Time, shares sdkmath.Int) *types.CommunityPoolWithdrawLiquidityProposal {
+				return types.NewCommunityPoolWithdrawLiquidityProposal(
+					"withdraw liquidity", "exit the pool", shares, c("ukava", 1e8+1), c("usdx", 99e6), blockTime.Add(time.Hour).Unix(),
+				)
+			},
+			expectedErr: swaptypes.ErrSlippageExceeded,
+		},
+		{
+			name: "invalid - more than owned shares",
+			proposal: func(blockTime time.Time, shares sdkmath.Int) *types.CommunityPoolWithdrawLiquidityProposal {
+				return types.NewCommunityPoolWithdrawLiquidityProposal(
+					"withdraw liquidity", "exit the pool", shares.AddRaw(1), c("ukava", 99e6), c("usdx", 99e6), blockTime.Add(time.Hour).Unix(),
+				)
+			},
+			expectedErr: swaptypes.ErrInvalidShares,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupSwapPool(sdkmath.NewInt(1e10))
+
+			err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleAccountName, initialModuleFunds)
+			suite.NoError(err, "failed to initially fund module account")
+
+			// provide liquidity from the community pool so the module owns shares to withdraw
+			err = keeper.HandleCommunityPoolProvideLiquidityProposal(suite.Ctx, suite.Keeper, types.NewCommunityPoolProvideLiquidityProposal(
+				"provide liquidity", "earn swap fees", c("ukava", 1e8), c("usdx", 1e8), sdk.MustNewDecFromStr("0.01"), suite.Ctx.BlockTime().Add(time.Hour).Unix(),
+			))
+			suite.NoError(err, "failed to provide liquidity")
+			shares, found := suite.App.GetSwapKeeper().GetDepositorSharesAmount(suite.Ctx, suite.MaccAddress, "ukava:usdx")
+			suite.Require().True(found)
+			balanceBefore := suite.Keeper.GetModuleAccountBalance(suite.Ctx)
+
+			proposal := tc.proposal(suite.Ctx.BlockTime(), shares)
+			suite.NoError(proposal.ValidateBasic())
+
+			err = keeper.HandleCommunityPoolWithdrawLiquidityProposal(suite.Ctx, suite.Keeper, proposal)
+			moduleBalance := suite.Keeper.GetModuleAccountBalance(suite.Ctx)
+			if tc.expectedErr != nil {
+				suite.ErrorIs(err, tc.expectedErr)
+				suite.True(balanceBefore.IsEqual(moduleBalance), "module balance changed unexpectedly")
+				return
+			}
+
+			suite.NoError(err)
+			suite.True(initialModuleFunds.IsEqual(moduleBalance), "expected balance %s, got %s", initialModuleFunds, moduleBalance)
+			_, found = suite.App.GetSwapKeeper().GetDepositorSharesAmount(suite.Ctx, suite.MaccAddress, "ukava:usdx")
+			suite.False(found, "expected community module to own no pool shares")
+		})
+	}
+}
@@ -20,6 +20,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&CommunityPoolLendWithdrawProposal{}, "kava/CommunityPoolLendWithdrawProposal", nil)
 	cdc.RegisterConcrete(&CommunityCDPRepayDebtProposal{}, "kava/CommunityCDPRepayDebtProposal", nil)
 	cdc.RegisterConcrete(&CommunityCDPWithdrawCollateralProposal{}, "kava/CommunityCDPWithdrawCollateralProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolPaymentStreamProposal{}, "kava/CommunityPoolPaymentStreamProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolCancelPaymentStreamProposal{}, "kava/CommunityPoolCancelPaymentStreamProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolSwapProposal{}, "kava/CommunityPoolSwapProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolProvideLiquidityProposal{}, "kava/CommunityPoolProvideLiquidityProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolWithdrawLiquidityProposal{}, "kava/CommunityPoolWithdrawLiquidityProposal", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -34,6 +39,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&CommunityPoolLendWithdrawProposal{},
 		&CommunityCDPRepayDebtProposal{},
 		&CommunityCDPWithdrawCollateralProposal{},
+		&CommunityPoolPaymentStreamProposal{},
+		&CommunityPoolCancelPaymentStreamProposal{},
+		&CommunityPoolSwapProposal{},
+		&CommunityPoolProvideLiquidityProposal{},
+		&CommunityPoolWithdrawLiquidityProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
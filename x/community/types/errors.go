@@ -2,4 +2,8 @@ package types
 
 import errorsmod "cosmossdk.io/errors"
 
-var ErrInvalidParams = errorsmod.Register(ModuleName, 1, "invalid params")
+var (
+	ErrInvalidParams         = errorsmod.Register(ModuleName, 1, "invalid params")
+	ErrInvalidPaymentStream  = errorsmod.Register(ModuleName, 2, "invalid payment stream")
+	ErrPaymentStreamNotFound = errorsmod.Register(ModuleName, 3, "payment stream not found")
+)
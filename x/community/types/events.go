@@ -2,11 +2,18 @@ package types
 
 // Community module event types
 const (
-	EventTypeInflationStop      = "inflation_stop"
-	EventTypeStakingRewardsPaid = "staking_rewards_paid"
+	EventTypeInflationStop          = "inflation_stop"
+	EventTypeStakingRewardsPaid     = "staking_rewards_paid"
+	EventTypePaymentStreamCreated   = "payment_stream_created"
+	EventTypePaymentStreamPaid      = "payment_stream_paid"
+	EventTypePaymentStreamCancelled = "payment_stream_cancelled"
 
 	AttributeKeyStakingRewardAmount  = "staking_reward_amount"
 	AttributeKeyInflationDisableTime = "inflation_disable_time"
+	AttributeKeyPaymentStreamID      = "payment_stream_id"
+	AttributeKeyRecipient            = "recipient"
+	AttributeKeyAmount               = "amount"
+	AttributeKeyRemaining            = "remaining"
 
 	AttributeValueFundCommunityPool = "fund_community_pool"
 	AttributeValueCategory          = ModuleName
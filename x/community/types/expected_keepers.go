@@ -25,6 +25,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // CdpKeeper defines the contract needed to be fulfilled for cdp dependencies.
@@ -39,6 +40,13 @@ type HardKeeper interface {
 	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 }
 
+// SwapKeeper defines the contract needed to be fulfilled for swap dependencies.
+type SwapKeeper interface {
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coin, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.Int, minCoinA, minCoinB sdk.Coin) error
+}
+
 // DistributionKeeper defines the contract needed to be fulfilled for distribution dependencies.
 type DistributionKeeper interface {
 	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
@@ -1,10 +1,19 @@
 package types
 
+import "fmt"
+
 // NewGenesisState returns a new genesis state object
-func NewGenesisState(params Params, stakingRewardsState StakingRewardsState) GenesisState {
+func NewGenesisState(
+	params Params,
+	stakingRewardsState StakingRewardsState,
+	paymentStreams PaymentStreams,
+	nextPaymentStreamID uint64,
+) GenesisState {
 	return GenesisState{
 		Params:              params,
 		StakingRewardsState: stakingRewardsState,
+		PaymentStreams:      paymentStreams,
+		NextPaymentStreamID: nextPaymentStreamID,
 	}
 }
 
@@ -13,6 +22,8 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		DefaultStakingRewardsState(),
+		PaymentStreams{},
+		DefaultNextPaymentStreamID,
 	)
 }
 
@@ -22,5 +33,18 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.StakingRewardsState.Validate()
+	if err := gs.StakingRewardsState.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PaymentStreams.Validate(); err != nil {
+		return err
+	}
+	for _, stream := range gs.PaymentStreams {
+		if stream.ID >= gs.NextPaymentStreamID {
+			return fmt.Errorf("payment stream id %d must be less than the next payment stream id %d", stream.ID, gs.NextPaymentStreamID)
+		}
+	}
+
+	return nil
 }
@@ -30,6 +30,10 @@ type GenesisState struct {
 	// StakingRewardsState stores the internal staking reward data required to
 	// track staking rewards across blocks
 	StakingRewardsState StakingRewardsState `protobuf:"bytes,2,opt,name=staking_rewards_state,json=stakingRewardsState,proto3" json:"staking_rewards_state"`
+	// payment_streams are the community pool payment streams that have not finished paying
+	PaymentStreams PaymentStreams `protobuf:"bytes,3,rep,name=payment_streams,json=paymentStreams,proto3,castrepeated=PaymentStreams" json:"payment_streams"`
+	// next_payment_stream_id is the id given to the next payment stream created
+	NextPaymentStreamID uint64 `protobuf:"varint,4,opt,name=next_payment_stream_id,json=nextPaymentStreamId,proto3" json:"next_payment_stream_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,20 @@ func (m *GenesisState) GetStakingRewardsState() StakingRewardsState {
 	return StakingRewardsState{}
 }
 
+func (m *GenesisState) GetPaymentStreams() PaymentStreams {
+	if m != nil {
+		return m.PaymentStreams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPaymentStreamID() uint64 {
+	if m != nil {
+		return m.NextPaymentStreamID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.community.v1beta1.GenesisState")
 }
@@ -88,23 +106,29 @@ func init() {
 }
 
 var fileDescriptor_ccf84d82ea3861e0 = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0xce, 0xcf, 0xcd, 0x2d, 0xcd, 0xcb, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x71, 0x98, 0x59, 0x90, 0x58, 0x94, 0x98,
-	0x0b, 0x35, 0x52, 0x0a, 0x97, 0xc5, 0xc5, 0x25, 0x89, 0xd9, 0x99, 0x79, 0xe9, 0x10, 0x55, 0x4a,
-	0x9b, 0x19, 0xb9, 0x78, 0xdc, 0x21, 0x4e, 0x09, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe1, 0x62,
-	0x83, 0x18, 0x23, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa7, 0x87, 0xdd, 0x69, 0x7a, 0x01,
-	0x60, 0x55, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xf5, 0x08, 0xa5, 0x72, 0x89, 0x42,
-	0xcd, 0x8f, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0x8e, 0x2f, 0x06, 0x19, 0x2b, 0xc1, 0x04,
-	0x36, 0x4c, 0x1b, 0x97, 0x61, 0xc1, 0x10, 0x4d, 0x41, 0x10, 0x3d, 0x60, 0x97, 0x40, 0x4d, 0x16,
-	0x2e, 0xc6, 0x22, 0xe5, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xda,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x20, 0x2b, 0xf4, 0x41, 0x76, 0xe9, 0xe6, 0x24, 0x26, 0x15,
-	0x83, 0x59, 0xfa, 0x15, 0x48, 0x81, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x03,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x84, 0x31, 0x9f, 0xa4, 0x01, 0x00, 0x00,
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x93, 0xb6, 0x74, 0x91, 0x7e, 0xf4, 0x83, 0x54, 0x6b, 0xe8, 0x62, 0x5a, 0xfc, 0x81,
+	0x42, 0x31, 0xa1, 0x75, 0xeb, 0x2a, 0x28, 0x22, 0x88, 0x48, 0xba, 0x73, 0x13, 0x26, 0x76, 0x8c,
+	0xa1, 0x66, 0x12, 0x32, 0xa7, 0xb5, 0xbd, 0x0b, 0xaf, 0xc3, 0x2b, 0xe9, 0xb2, 0x4b, 0x57, 0x55,
+	0xd2, 0x95, 0x77, 0x21, 0xf3, 0x83, 0x18, 0x6a, 0x76, 0x93, 0x93, 0xe7, 0x3c, 0xe7, 0x3d, 0x1c,
+	0xe3, 0x78, 0x8a, 0xe7, 0xd8, 0x79, 0x48, 0xe2, 0x78, 0x46, 0x23, 0x58, 0x3a, 0xf3, 0x61, 0x40,
+	0x00, 0x0f, 0x9d, 0x90, 0x50, 0xc2, 0x22, 0x66, 0xa7, 0x59, 0x02, 0x89, 0xd9, 0xe6, 0x94, 0xfd,
+	0x43, 0xd9, 0x8a, 0xea, 0xec, 0x85, 0x49, 0x98, 0x08, 0xc4, 0xe1, 0x2f, 0x49, 0x77, 0x8e, 0x4a,
+	0x9c, 0x29, 0xce, 0x70, 0xac, 0x94, 0x9d, 0x41, 0x29, 0xb4, 0x8c, 0x09, 0x05, 0x9f, 0x41, 0x46,
+	0x70, 0xac, 0xe0, 0xb2, 0x94, 0x0c, 0xf0, 0x34, 0xa2, 0xa1, 0xa4, 0x0e, 0xbf, 0x2a, 0xc6, 0xbf,
+	0x2b, 0x99, 0x7b, 0x0c, 0x18, 0x88, 0x79, 0x6e, 0xd4, 0xe5, 0x4c, 0x4b, 0xef, 0xe9, 0xfd, 0xc6,
+	0x08, 0xd9, 0x7f, 0xef, 0x61, 0xdf, 0x09, 0xca, 0xad, 0xad, 0x36, 0x5d, 0xcd, 0x53, 0x3d, 0x26,
+	0x31, 0xf6, 0x95, 0xdf, 0xcf, 0xc8, 0x0b, 0xce, 0x26, 0xcc, 0x67, 0x5c, 0x6b, 0x55, 0x84, 0x6c,
+	0x50, 0x26, 0x1b, 0xcb, 0x26, 0x4f, 0xf6, 0x88, 0x24, 0xca, 0xdc, 0x62, 0xbb, 0xbf, 0xcc, 0x47,
+	0xe3, 0x7f, 0x71, 0x67, 0x66, 0x55, 0x7b, 0xd5, 0x7e, 0x63, 0x74, 0x52, 0x9e, 0x56, 0xe0, 0x63,
+	0x41, 0xbb, 0x6d, 0xae, 0x7e, 0xfb, 0xe8, 0x36, 0x0b, 0x65, 0xe6, 0x35, 0xd3, 0xc2, 0xb7, 0x79,
+	0x63, 0xb4, 0x29, 0x59, 0x80, 0x5f, 0x1c, 0xe6, 0x47, 0x13, 0xab, 0xd6, 0xd3, 0xfb, 0x35, 0xf7,
+	0x20, 0xdf, 0x74, 0x5b, 0xb7, 0x64, 0x01, 0x05, 0xcf, 0xf5, 0x85, 0xd7, 0xa2, 0x3b, 0xc5, 0x89,
+	0x7b, 0xb9, 0xca, 0x91, 0xbe, 0xce, 0x91, 0xfe, 0x99, 0x23, 0xfd, 0x75, 0x8b, 0xb4, 0xf5, 0x16,
+	0x69, 0xef, 0x5b, 0xa4, 0xdd, 0x0f, 0xc2, 0x08, 0x9e, 0x66, 0x01, 0xcf, 0xed, 0xf0, 0x05, 0x4e,
+	0x9f, 0x71, 0xc0, 0xc4, 0xcb, 0x59, 0xfc, 0x3a, 0x21, 0x2c, 0x53, 0xc2, 0x82, 0xba, 0xb8, 0xdc,
+	0xd9, 0xf7, 0x00, 0x65, 0x57, 0x21, 0x32, 0x87, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +151,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextPaymentStreamID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPaymentStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.StakingRewardsState.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -171,6 +214,15 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.StakingRewardsState.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPaymentStreamID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPaymentStreamID))
+	}
 	return n
 }
 
@@ -275,6 +327,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentStreamID", wireType)
+			}
+			m.NextPaymentStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPaymentStreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/require"
 
@@ -51,3 +54,65 @@ func TestGenesisState_ValidateStakingRewardsState(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidatePaymentStreams(t *testing.T) {
+	validStream := types.NewPaymentStream(
+		1,
+		sdk.AccAddress("recipient").String(),
+		sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)),
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+		nil,
+	)
+	overpaidStream := validStream
+	overpaidStream.Paid = sdk.NewCoins(sdk.NewInt64Coin("ukava", 2e6))
+
+	testCases := []struct {
+		name                string
+		paymentStreams      types.PaymentStreams
+		nextPaymentStreamID uint64
+		expectedErr         string
+	}{
+		{
+			name:                "valid",
+			paymentStreams:      types.PaymentStreams{validStream},
+			nextPaymentStreamID: 2,
+			expectedErr:         "",
+		},
+		{
+			name:                "invalid - stream paid more than its amount",
+			paymentStreams:      types.PaymentStreams{overpaidStream},
+			nextPaymentStreamID: 2,
+			expectedErr:         "is greater than amount",
+		},
+		{
+			name:                "invalid - duplicate stream id",
+			paymentStreams:      types.PaymentStreams{validStream, validStream},
+			nextPaymentStreamID: 2,
+			expectedErr:         "duplicate payment stream id",
+		},
+		{
+			name:                "invalid - stream id not less than next stream id",
+			paymentStreams:      types.PaymentStreams{validStream},
+			nextPaymentStreamID: 1,
+			expectedErr:         "must be less than the next payment stream id",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.PaymentStreams = tc.paymentStreams
+			genState.NextPaymentStreamID = tc.nextPaymentStreamID
+
+			err := genState.Validate()
+
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
@@ -25,4 +25,6 @@ const (
 var (
 	ParamsKey              = []byte{0x01}
 	StakingRewardsStateKey = []byte{0x02}
+	PaymentStreamKeyPrefix = []byte{0x03}
+	NextPaymentStreamIDKey = []byte{0x04}
 )
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultNextPaymentStreamID is the id given to the first payment stream
+const DefaultNextPaymentStreamID uint64 = 1
+
+// NewPaymentStream returns a new payment stream that has paid nothing
+func NewPaymentStream(
+	id uint64,
+	recipient string,
+	amount sdk.Coins,
+	start, end time.Time,
+	cliff *time.Time,
+) PaymentStream {
+	return PaymentStream{
+		ID:        id,
+		Recipient: recipient,
+		Amount:    amount,
+		Paid:      sdk.NewCoins(),
+		Start:     start,
+		End:       end,
+		Cliff:     cliff,
+	}
+}
+
+// Validate checks the payment stream is valid
+func (s PaymentStream) Validate() error {
+	if s.ID == 0 {
+		return errorsmod.Wrap(ErrInvalidPaymentStream, "id cannot be 0")
+	}
+	if err := validatePaymentStreamSchedule(s.Recipient, s.Amount, s.Start, s.End, s.Cliff); err != nil {
+		return err
+	}
+	if !s.Paid.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "paid amount %s", s.Paid)
+	}
+	if !s.Paid.IsAllLTE(s.Amount) {
+		return errorsmod.Wrapf(ErrInvalidPaymentStream, "paid amount %s is greater than amount %s", s.Paid, s.Amount)
+	}
+	return nil
+}
+
+// Accrued returns the amount the stream has accrued by a time. The amount accrues linearly from the start to the end,
+// but nothing is accrued before the cliff.
+func (s PaymentStream) Accrued(blockTime time.Time) sdk.Coins {
+	if blockTime.Before(s.Start) || (s.Cliff != nil && blockTime.Before(*s.Cliff)) {
+		return sdk.NewCoins()
+	}
+	if !blockTime.Before(s.End) {
+		return s.Amount
+	}
+
+	elapsed := sdkmath.NewInt(blockTime.Sub(s.Start).Nanoseconds())
+	duration := sdkmath.NewInt(s.End.Sub(s.Start).Nanoseconds())
+
+	accrued := sdk.NewCoins()
+	for _, coin := range s.Amount {
+		accrued = accrued.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return accrued
+}
+
+// Payable returns the amount the stream has accrued by a time but not yet paid
+func (s PaymentStream) Payable(blockTime time.Time) sdk.Coins {
+	return s.Accrued(blockTime).Sub(s.Paid...)
+}
+
+// Remaining returns the amount the stream has not yet paid
+func (s PaymentStream) Remaining() sdk.Coins {
+	return s.Amount.Sub(s.Paid...)
+}
+
+// IsComplete returns true if the stream has paid its whole amount
+func (s PaymentStream) IsComplete() bool {
+	return s.Remaining().IsZero()
+}
+
+// PaymentStreams is a slice of PaymentStream
+type PaymentStreams []PaymentStream
+
+// Validate checks all the payment streams are valid and there are no duplicate ids
+func (ps PaymentStreams) Validate() error {
+	seen := make(map[uint64]bool)
+	for _, s := range ps {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if seen[s.ID] {
+			return fmt.Errorf("duplicate payment stream id %d", s.ID)
+		}
+		seen[s.ID] = true
+	}
+	return nil
+}
+
+// validatePaymentStreamSchedule checks the fields shared by payment streams and the proposals that create them
+func validatePaymentStreamSchedule(recipient string, amount sdk.Coins, start, end time.Time, cliff *time.Time) error {
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !amount.IsValid() || amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "stream amount %s", amount)
+	}
+	if start.IsZero() {
+		return errors.New("stream start cannot be zero")
+	}
+	if !end.After(start) {
+		return errorsmod.Wrapf(ErrInvalidPaymentStream, "end %s must be after start %s", end, start)
+	}
+	if cliff != nil && (cliff.Before(start) || cliff.After(end)) {
+		return errorsmod.Wrapf(ErrInvalidPaymentStream, "cliff %s must be between start %s and end %s", cliff, start, end)
+	}
+	return nil
+}
@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/community/v1beta1/payment_stream.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PaymentStream pays an amount from the community pool to a recipient continuously between a start and end time.
+type PaymentStream struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address the stream pays to
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the total amount the stream pays
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// paid is the amount the stream has paid so far
+	Paid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+	// start is the time the stream starts accruing payments
+	Start time.Time `protobuf:"bytes,5,opt,name=start,proto3,stdtime" json:"start"`
+	// end is the time the whole amount has accrued
+	End time.Time `protobuf:"bytes,6,opt,name=end,proto3,stdtime" json:"end"`
+	// cliff is an optional time before which nothing is paid. Payments accrued before the cliff are paid at the cliff.
+	Cliff *time.Time `protobuf:"bytes,7,opt,name=cliff,proto3,stdtime" json:"cliff,omitempty"`
+}
+
+func (m *PaymentStream) Reset()         { *m = PaymentStream{} }
+func (m *PaymentStream) String() string { return proto.CompactTextString(m) }
+func (*PaymentStream) ProtoMessage()    {}
+func (*PaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b73ba9434111924, []int{0}
+}
+func (m *PaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentStream.Merge(m, src)
+}
+func (m *PaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentStream proto.InternalMessageInfo
+
+func (m *PaymentStream) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *PaymentStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *PaymentStream) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+func (m *PaymentStream) GetCliff() *time.Time {
+	if m != nil {
+		return m.Cliff
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PaymentStream)(nil), "kava.community.v1beta1.PaymentStream")
+}
+
+func init() {
+	proto.RegisterFile("kava/community/v1beta1/payment_stream.proto", fileDescriptor_7b73ba9434111924)
+}
+
+var fileDescriptor_7b73ba9434111924 = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x3f, 0x6f, 0xd4, 0x30,
+	0x1c, 0x3d, 0xdf, 0x5d, 0x0f, 0xea, 0x8a, 0x25, 0xaa, 0x2a, 0xf7, 0x86, 0x24, 0x62, 0x8a, 0x54,
+	0x9d, 0x4d, 0x8b, 0xd4, 0x81, 0x8d, 0x00, 0x03, 0x1b, 0x4a, 0x99, 0x58, 0x4e, 0x4e, 0xe2, 0x0b,
+	0x56, 0xcf, 0x76, 0x14, 0x3b, 0x15, 0xf7, 0x2d, 0xfa, 0x39, 0x98, 0xf9, 0x10, 0x1d, 0x2b, 0x24,
+	0x24, 0xa6, 0x16, 0xe5, 0xbe, 0x08, 0xf2, 0x9f, 0x2b, 0x1d, 0x41, 0x62, 0xb2, 0x7f, 0xf6, 0x7b,
+	0xbf, 0xf7, 0x7b, 0x4f, 0x3f, 0x78, 0x72, 0x49, 0xaf, 0x28, 0xa9, 0x94, 0x10, 0xbd, 0xe4, 0x66,
+	0x43, 0xae, 0x4e, 0x4b, 0x66, 0xe8, 0x29, 0x69, 0xe9, 0x46, 0x30, 0x69, 0x96, 0xda, 0x74, 0x8c,
+	0x0a, 0xdc, 0x76, 0xca, 0xa8, 0xe8, 0xc8, 0x82, 0xf1, 0x03, 0x18, 0x07, 0xf0, 0x3c, 0xae, 0x94,
+	0x16, 0x4a, 0x93, 0x92, 0x6a, 0xf6, 0xd0, 0xa1, 0x52, 0x5c, 0x7a, 0xde, 0xfc, 0xd8, 0xff, 0x2f,
+	0x5d, 0x45, 0x7c, 0x11, 0xbe, 0x0e, 0x1b, 0xd5, 0x28, 0xff, 0x6e, 0x6f, 0xe1, 0x35, 0x69, 0x94,
+	0x6a, 0xd6, 0x8c, 0xb8, 0xaa, 0xec, 0x57, 0xc4, 0x70, 0xc1, 0xb4, 0xa1, 0xa2, 0xf5, 0x80, 0xe7,
+	0x3f, 0x26, 0xf0, 0xd9, 0x07, 0x3f, 0xe2, 0x85, 0x9b, 0x30, 0x3a, 0x82, 0x63, 0x5e, 0x23, 0x90,
+	0x82, 0x6c, 0x9a, 0xcf, 0x86, 0xbb, 0x64, 0xfc, 0xfe, 0x6d, 0x31, 0xe6, 0x75, 0x74, 0x0e, 0xf7,
+	0x3b, 0x56, 0xf1, 0x96, 0x33, 0x69, 0xd0, 0x38, 0x05, 0xd9, 0x7e, 0x8e, 0xbe, 0x7f, 0x5b, 0x1c,
+	0x86, 0x29, 0x5e, 0xd7, 0x75, 0xc7, 0xb4, 0xbe, 0x30, 0x1d, 0x97, 0x4d, 0xf1, 0x07, 0x1a, 0x55,
+	0x70, 0x46, 0x85, 0xea, 0xa5, 0x41, 0x93, 0x74, 0x92, 0x1d, 0x9c, 0x1d, 0xe3, 0xc0, 0xb0, 0x26,
+	0x77, 0xce, 0xf1, 0x1b, 0xc5, 0x65, 0xfe, 0xe2, 0xe6, 0x2e, 0x19, 0x7d, 0xbd, 0x4f, 0xb2, 0x86,
+	0x9b, 0xcf, 0x7d, 0x69, 0x03, 0x0a, 0x26, 0xc3, 0xb1, 0xd0, 0xf5, 0x25, 0x31, 0x9b, 0x96, 0x69,
+	0x47, 0xd0, 0x45, 0x68, 0x1d, 0x2d, 0xe1, 0xb4, 0xa5, 0xbc, 0x46, 0xd3, 0xff, 0x2f, 0xe1, 0x1a,
+	0x47, 0xaf, 0xe0, 0x9e, 0x36, 0xb4, 0x33, 0x68, 0x2f, 0x05, 0xd9, 0xc1, 0xd9, 0x1c, 0xfb, 0x60,
+	0xf1, 0x2e, 0x58, 0xfc, 0x71, 0x17, 0x6c, 0xfe, 0xd4, 0x4a, 0x5c, 0xdf, 0x27, 0xa0, 0xf0, 0x94,
+	0xe8, 0x1c, 0x4e, 0x98, 0xac, 0xd1, 0xec, 0x1f, 0x98, 0x96, 0x60, 0x35, 0xab, 0x35, 0x5f, 0xad,
+	0xd0, 0x93, 0xbf, 0x62, 0x02, 0xaf, 0xe9, 0x28, 0xf9, 0xbb, 0x9b, 0x21, 0x06, 0xb7, 0x43, 0x0c,
+	0x7e, 0x0d, 0x31, 0xb8, 0xde, 0xc6, 0xa3, 0xdb, 0x6d, 0x3c, 0xfa, 0xb9, 0x8d, 0x47, 0x9f, 0x4e,
+	0x1e, 0x39, 0xb7, 0x6b, 0xb8, 0x58, 0xd3, 0x52, 0xbb, 0x1b, 0xf9, 0xf2, 0x68, 0x7f, 0x5d, 0x04,
+	0xe5, 0xcc, 0x69, 0xbd, 0xfc, 0x3d, 0x00, 0xc8, 0x39, 0xda, 0x2a, 0xde, 0x02, 0x00, 0x00,
+}
+
+func (m *PaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cliff != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Cliff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Cliff):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintPaymentStream(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPaymentStream(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPaymentStream(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaymentStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaymentStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintPaymentStream(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintPaymentStream(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPaymentStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPaymentStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovPaymentStream(uint64(m.ID))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovPaymentStream(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovPaymentStream(uint64(l))
+		}
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovPaymentStream(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovPaymentStream(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovPaymentStream(uint64(l))
+	if m.Cliff != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Cliff)
+		n += 1 + l + sovPaymentStream(uint64(l))
+	}
+	return n
+}
+
+func sovPaymentStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPaymentStream(x uint64) (n int) {
+	return sovPaymentStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PaymentStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymentStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cliff == nil {
+				m.Cliff = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Cliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymentStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymentStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPaymentStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPaymentStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaymentStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPaymentStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPaymentStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPaymentStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPaymentStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPaymentStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPaymentStream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/community/types"
+)
+
+func TestPaymentStream_Accrued(t *testing.T) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(100 * time.Second)
+	cliff := start.Add(25 * time.Second)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000), sdk.NewInt64Coin("usdx", 3))
+
+	testCases := []struct {
+		name      string
+		cliff     *time.Time
+		blockTime time.Time
+		expected  sdk.Coins
+	}{
+		{
+			name:      "nothing accrues before the start",
+			blockTime: start.Add(-time.Second),
+			expected:  sdk.NewCoins(),
+		},
+		{
+			name:      "accrues linearly and truncates",
+			blockTime: start.Add(50 * time.Second),
+			expected:  sdk.NewCoins(sdk.NewInt64Coin("ukava", 500), sdk.NewInt64Coin("usdx", 1)),
+		},
+		{
+			name:      "nothing accrues before the cliff",
+			cliff:     &cliff,
+			blockTime: start.Add(24 * time.Second),
+			expected:  sdk.NewCoins(),
+		},
+		{
+			name:      "accrues from the start at the cliff",
+			cliff:     &cliff,
+			blockTime: cliff,
+			expected:  sdk.NewCoins(sdk.NewInt64Coin("ukava", 250)),
+		},
+		{
+			name:      "whole amount accrues at the end",
+			blockTime: end,
+			expected:  amount,
+		},
+		{
+			name:      "accrues no more than the amount after the end",
+			blockTime: end.Add(time.Hour),
+			expected:  amount,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := types.NewPaymentStream(1, sdk.AccAddress("recipient").String(), amount, start, end, tc.cliff)
+			require.Equal(t, tc.expected, stream.Accrued(tc.blockTime))
+		})
+	}
+}
+
+func TestPaymentStream_Payable(t *testing.T) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	stream := types.NewPaymentStream(
+		1,
+		sdk.AccAddress("recipient").String(),
+		sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)),
+		start,
+		start.Add(100*time.Second),
+		nil,
+	)
+	stream.Paid = sdk.NewCoins(sdk.NewInt64Coin("ukava", 400))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 200)), stream.Payable(start.Add(60*time.Second)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 600)), stream.Remaining())
+	require.False(t, stream.IsComplete())
+
+	stream.Paid = stream.Amount
+	require.True(t, stream.IsComplete())
+}
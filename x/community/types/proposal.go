@@ -4,12 +4,16 @@ import (
 	"errors"
 	fmt "fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 const (
@@ -21,6 +25,16 @@ const (
 	ProposalTypeCommunityCDPRepayDebt = "CommunityCDPRepayDebt"
 	// ProposalTypeCommunityCDPWithdrawCollateral defines the type for a CommunityCDPWithdrawCollateralProposal
 	ProposalTypeCommunityCDPWithdrawCollateral = "CommunityCDPWithdrawCollateral"
+	// ProposalTypeCommunityPoolPaymentStream defines the type for a CommunityPoolPaymentStreamProposal
+	ProposalTypeCommunityPoolPaymentStream = "CommunityPoolPaymentStream"
+	// ProposalTypeCommunityPoolCancelPaymentStream defines the type for a CommunityPoolCancelPaymentStreamProposal
+	ProposalTypeCommunityPoolCancelPaymentStream = "CommunityPoolCancelPaymentStream"
+	// ProposalTypeCommunityPoolSwap defines the type for a CommunityPoolSwapProposal
+	ProposalTypeCommunityPoolSwap = "CommunityPoolSwap"
+	// ProposalTypeCommunityPoolProvideLiquidity defines the type for a CommunityPoolProvideLiquidityProposal
+	ProposalTypeCommunityPoolProvideLiquidity = "CommunityPoolProvideLiquidity"
+	// ProposalTypeCommunityPoolWithdrawLiquidity defines the type for a CommunityPoolWithdrawLiquidityProposal
+	ProposalTypeCommunityPoolWithdrawLiquidity = "CommunityPoolWithdrawLiquidity"
 )
 
 // Assert CommunityPoolLendDepositProposal implements govtypes.Content at compile-time
@@ -29,6 +43,11 @@ var (
 	_ govv1beta1.Content = &CommunityPoolLendWithdrawProposal{}
 	_ govv1beta1.Content = &CommunityCDPRepayDebtProposal{}
 	_ govv1beta1.Content = &CommunityCDPWithdrawCollateralProposal{}
+	_ govv1beta1.Content = &CommunityPoolPaymentStreamProposal{}
+	_ govv1beta1.Content = &CommunityPoolCancelPaymentStreamProposal{}
+	_ govv1beta1.Content = &CommunityPoolSwapProposal{}
+	_ govv1beta1.Content = &CommunityPoolProvideLiquidityProposal{}
+	_ govv1beta1.Content = &CommunityPoolWithdrawLiquidityProposal{}
 )
 
 func init() {
@@ -40,6 +59,16 @@ func init() {
 	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityCDPRepayDebtProposal{}, "kava/CommunityCDPRepayDebtProposal", nil)
 	govv1beta1.RegisterProposalType(ProposalTypeCommunityCDPWithdrawCollateral)
 	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityCDPWithdrawCollateralProposal{}, "kava/CommunityCDPWithdrawCollateralProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolPaymentStream)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityPoolPaymentStreamProposal{}, "kava/CommunityPoolPaymentStreamProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolCancelPaymentStream)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityPoolCancelPaymentStreamProposal{}, "kava/CommunityPoolCancelPaymentStreamProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolSwap)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityPoolSwapProposal{}, "kava/CommunityPoolSwapProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolProvideLiquidity)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityPoolProvideLiquidityProposal{}, "kava/CommunityPoolProvideLiquidityProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolWithdrawLiquidity)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityPoolWithdrawLiquidityProposal{}, "kava/CommunityPoolWithdrawLiquidityProposal", nil)
 }
 
 //////////////////
@@ -257,3 +286,338 @@ func (p *CommunityCDPWithdrawCollateralProposal) ValidateBasic() error {
 	}
 	return nil
 }
+
+///////////////////////////
+// Payment Stream Proposals
+///////////////////////////
+
+// NewCommunityPoolPaymentStreamProposal creates a new community pool payment stream proposal.
+func NewCommunityPoolPaymentStreamProposal(
+	title string,
+	description string,
+	recipient string,
+	amount sdk.Coins,
+	start time.Time,
+	end time.Time,
+	cliff *time.Time,
+) *CommunityPoolPaymentStreamProposal {
+	return &CommunityPoolPaymentStreamProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient,
+		Amount:      amount,
+		Start:       start,
+		End:         end,
+		Cliff:       cliff,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *CommunityPoolPaymentStreamProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *CommunityPoolPaymentStreamProposal) GetDescription() string { return p.Description }
+
+// GetDescription returns the routing key of the proposal.
+func (p *CommunityPoolPaymentStreamProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType returns the type of the proposal.
+func (p *CommunityPoolPaymentStreamProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolPaymentStream
+}
+
+// String implements fmt.Stringer
+func (p *CommunityPoolPaymentStreamProposal) String() string {
+	cliff := "none"
+	if p.Cliff != nil {
+		cliff = p.Cliff.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Payment Stream Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Start:       %s
+  End:         %s
+  Cliff:       %s
+`, p.Title, p.Description, p.Recipient, p.Amount, p.Start, p.End, cliff))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of the proposal.
+func (p *CommunityPoolPaymentStreamProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validatePaymentStreamSchedule(p.Recipient, p.Amount, p.Start, p.End, p.Cliff)
+}
+
+// NewCommunityPoolCancelPaymentStreamProposal creates a new community pool cancel payment stream proposal.
+func NewCommunityPoolCancelPaymentStreamProposal(
+	title string,
+	description string,
+	streamID uint64,
+) *CommunityPoolCancelPaymentStreamProposal {
+	return &CommunityPoolCancelPaymentStreamProposal{
+		Title:       title,
+		Description: description,
+		StreamID:    streamID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *CommunityPoolCancelPaymentStreamProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *CommunityPoolCancelPaymentStreamProposal) GetDescription() string { return p.Description }
+
+// GetDescription returns the routing key of the proposal.
+func (p *CommunityPoolCancelPaymentStreamProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType returns the type of the proposal.
+func (p *CommunityPoolCancelPaymentStreamProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolCancelPaymentStream
+}
+
+// String implements fmt.Stringer
+func (p *CommunityPoolCancelPaymentStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Cancel Payment Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, p.Title, p.Description, p.StreamID))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of the proposal.
+func (p *CommunityPoolCancelPaymentStreamProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.StreamID == 0 {
+		return errors.New("payment stream id cannot be 0")
+	}
+	return nil
+}
+
+//////////////////
+// Swap Proposals
+//////////////////
+
+// NewCommunityPoolSwapProposal creates a new community pool swap proposal.
+func NewCommunityPoolSwapProposal(
+	title string,
+	description string,
+	exactTokenA sdk.Coin,
+	tokenB sdk.Coin,
+	slippage sdk.Dec,
+	deadline int64,
+) *CommunityPoolSwapProposal {
+	return &CommunityPoolSwapProposal{
+		Title:       title,
+		Description: description,
+		ExactTokenA: exactTokenA,
+		TokenB:      tokenB,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *CommunityPoolSwapProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *CommunityPoolSwapProposal) GetDescription() string { return p.Description }
+
+// GetDescription returns the routing key of the proposal.
+func (p *CommunityPoolSwapProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType returns the type of the proposal.
+func (p *CommunityPoolSwapProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolSwap
+}
+
+// String implements fmt.Stringer
+func (p *CommunityPoolSwapProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Swap Proposal:
+  Title:         %s
+  Description:   %s
+  Exact Token A: %s
+  Token B:       %s
+  Slippage:      %s
+  Deadline:      %d
+`, p.Title, p.Description, p.ExactTokenA, p.TokenB, p.Slippage, p.Deadline))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of the proposal.
+func (p *CommunityPoolSwapProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if !p.ExactTokenA.IsValid() || p.ExactTokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "exact token a amount %s", p.ExactTokenA)
+	}
+	if !p.TokenB.IsValid() || p.TokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token b amount %s", p.TokenB)
+	}
+	if p.ExactTokenA.Denom == p.TokenB.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+	return validateSlippageAndDeadline(p.Slippage, p.Deadline)
+}
+
+// NewCommunityPoolProvideLiquidityProposal creates a new community pool provide liquidity proposal.
+func NewCommunityPoolProvideLiquidityProposal(
+	title string,
+	description string,
+	tokenA sdk.Coin,
+	tokenB sdk.Coin,
+	slippage sdk.Dec,
+	deadline int64,
+) *CommunityPoolProvideLiquidityProposal {
+	return &CommunityPoolProvideLiquidityProposal{
+		Title:       title,
+		Description: description,
+		TokenA:      tokenA,
+		TokenB:      tokenB,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *CommunityPoolProvideLiquidityProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *CommunityPoolProvideLiquidityProposal) GetDescription() string { return p.Description }
+
+// GetDescription returns the routing key of the proposal.
+func (p *CommunityPoolProvideLiquidityProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType returns the type of the proposal.
+func (p *CommunityPoolProvideLiquidityProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolProvideLiquidity
+}
+
+// String implements fmt.Stringer
+func (p *CommunityPoolProvideLiquidityProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Provide Liquidity Proposal:
+  Title:       %s
+  Description: %s
+  Token A:     %s
+  Token B:     %s
+  Slippage:    %s
+  Deadline:    %d
+`, p.Title, p.Description, p.TokenA, p.TokenB, p.Slippage, p.Deadline))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of the proposal.
+func (p *CommunityPoolProvideLiquidityProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if !p.TokenA.IsValid() || p.TokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token a deposit amount %s", p.TokenA)
+	}
+	if !p.TokenB.IsValid() || p.TokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token b deposit amount %s", p.TokenB)
+	}
+	if p.TokenA.Denom == p.TokenB.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+	return validateSlippageAndDeadline(p.Slippage, p.Deadline)
+}
+
+// NewCommunityPoolWithdrawLiquidityProposal creates a new community pool withdraw liquidity proposal.
+func NewCommunityPoolWithdrawLiquidityProposal(
+	title string,
+	description string,
+	shares sdkmath.Int,
+	minTokenA sdk.Coin,
+	minTokenB sdk.Coin,
+	deadline int64,
+) *CommunityPoolWithdrawLiquidityProposal {
+	return &CommunityPoolWithdrawLiquidityProposal{
+		Title:       title,
+		Description: description,
+		Shares:      shares,
+		MinTokenA:   minTokenA,
+		MinTokenB:   minTokenB,
+		Deadline:    deadline,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *CommunityPoolWithdrawLiquidityProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *CommunityPoolWithdrawLiquidityProposal) GetDescription() string { return p.Description }
+
+// GetDescription returns the routing key of the proposal.
+func (p *CommunityPoolWithdrawLiquidityProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType returns the type of the proposal.
+func (p *CommunityPoolWithdrawLiquidityProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolWithdrawLiquidity
+}
+
+// String implements fmt.Stringer
+func (p *CommunityPoolWithdrawLiquidityProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Withdraw Liquidity Proposal:
+  Title:       %s
+  Description: %s
+  Shares:      %s
+  Min Token A: %s
+  Min Token B: %s
+  Deadline:    %d
+`, p.Title, p.Description, p.Shares, p.MinTokenA, p.MinTokenB, p.Deadline))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of the proposal.
+func (p *CommunityPoolWithdrawLiquidityProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.Shares.IsNil() || !p.Shares.IsPositive() {
+		return errorsmod.Wrapf(swaptypes.ErrInvalidShares, "shares %s", p.Shares)
+	}
+	if !p.MinTokenA.IsValid() || p.MinTokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "min token a amount %s", p.MinTokenA)
+	}
+	if !p.MinTokenB.IsValid() || p.MinTokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "min token b amount %s", p.MinTokenB)
+	}
+	if p.MinTokenA.Denom == p.MinTokenB.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+	if p.Deadline <= 0 {
+		return errorsmod.Wrapf(swaptypes.ErrInvalidDeadline, "deadline %d", p.Deadline)
+	}
+	return nil
+}
+
+// validateSlippageAndDeadline checks the swap limits of a proposal are set.
+// Slippage must be less than 1, otherwise it places no limit on the price of a swap or deposit.
+func validateSlippageAndDeadline(slippage sdk.Dec, deadline int64) error {
+	if slippage.IsNil() {
+		return errorsmod.Wrapf(swaptypes.ErrInvalidSlippage, "slippage must be set")
+	}
+	if slippage.IsNegative() {
+		return errorsmod.Wrapf(swaptypes.ErrInvalidSlippage, "slippage can not be negative")
+	}
+	if slippage.GTE(sdk.OneDec()) {
+		return errorsmod.Wrapf(swaptypes.ErrInvalidSlippage, "slippage must be less than 1: %s", slippage)
+	}
+	if deadline <= 0 {
+		return errorsmod.Wrapf(swaptypes.ErrInvalidDeadline, "deadline %d", deadline)
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -328,3 +330,490 @@ func TestCommunityCDPWithdrawCollateralProposal_Stringer(t *testing.T) {
   Collateral:      42ukava
 `, proposal.String())
 }
+
+func TestCommunityPoolPaymentStreamProposal_ValidateBasic(t *testing.T) {
+	recipient := sdk.AccAddress("recipient").String()
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(365 * 24 * time.Hour)
+	cliff := start.Add(90 * 24 * time.Hour)
+	lateCliff := end.Add(time.Hour)
+
+	type proposalData struct {
+		Title       string
+		Description string
+		Recipient   string
+		Amount      sdk.Coins
+		Start       time.Time
+		End         time.Time
+		Cliff       *time.Time
+	}
+	testCases := []struct {
+		name        string
+		proposal    proposalData
+		expectedErr string
+	}{
+		{
+			name: "valid proposal",
+			proposal: proposalData{
+				Title:       "Pay me plz",
+				Description: "I build things",
+				Recipient:   recipient,
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)),
+				Start:       start,
+				End:         end,
+				Cliff:       &cliff,
+			},
+			expectedErr: "",
+		},
+		{
+			name: "valid proposal without cliff",
+			proposal: proposalData{
+				Title:       "Pay me plz",
+				Description: "I build things",
+				Recipient:   recipient,
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)),
+				Start:       start,
+				End:         end,
+			},
+			expectedErr: "",
+		},
+		{
+			name: "invalid - fails gov validation",
+			proposal: proposalData{
+				Description: "I have no title.",
+			},
+			expectedErr: "invalid proposal content",
+		},
+		{
+			name: "invalid - bad recipient",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My recipient is invalid",
+				Recipient:   "not-an-address",
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)),
+				Start:       start,
+				End:         end,
+			},
+			expectedErr: "invalid address",
+		},
+		{
+			name: "invalid - empty coins",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My coins are empty",
+				Recipient:   recipient,
+				Amount:      sdk.NewCoins(),
+				Start:       start,
+				End:         end,
+			},
+			expectedErr: "invalid coins",
+		},
+		{
+			name: "invalid - end before start",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "I end before I start",
+				Recipient:   recipient,
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)),
+				Start:       end,
+				End:         start,
+			},
+			expectedErr: "must be after start",
+		},
+		{
+			name: "invalid - cliff after end",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My cliff is too late",
+				Recipient:   recipient,
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)),
+				Start:       start,
+				End:         end,
+				Cliff:       &lateCliff,
+			},
+			expectedErr: "cliff",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paymentStream := types.NewCommunityPoolPaymentStreamProposal(
+				tc.proposal.Title,
+				tc.proposal.Description,
+				tc.proposal.Recipient,
+				tc.proposal.Amount,
+				tc.proposal.Start,
+				tc.proposal.End,
+				tc.proposal.Cliff,
+			)
+			err := paymentStream.ValidateBasic()
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, paymentStream.Title, paymentStream.GetTitle())
+			require.Equal(t, paymentStream.Description, paymentStream.GetDescription())
+			require.Equal(t, types.ModuleName, paymentStream.ProposalRoute())
+			require.Equal(t, types.ProposalTypeCommunityPoolPaymentStream, paymentStream.ProposalType())
+		})
+	}
+}
+
+func TestCommunityPoolPaymentStreamProposal_Stringer(t *testing.T) {
+	proposal := types.NewCommunityPoolPaymentStreamProposal(
+		"title",
+		"description",
+		"kava1recipient",
+		sdk.NewCoins(sdk.NewInt64Coin("ukava", 42)),
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+		nil,
+	)
+	require.Equal(t, `Community Pool Payment Stream Proposal:
+  Title:       title
+  Description: description
+  Recipient:   kava1recipient
+  Amount:      42ukava
+  Start:       2030-01-01 00:00:00 +0000 UTC
+  End:         2031-01-01 00:00:00 +0000 UTC
+  Cliff:       none
+`, proposal.String())
+}
+
+func TestCommunityPoolCancelPaymentStreamProposal_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name        string
+		proposal    *types.CommunityPoolCancelPaymentStreamProposal
+		expectedErr string
+	}{
+		{
+			name:        "valid proposal",
+			proposal:    types.NewCommunityPoolCancelPaymentStreamProposal("Stop paying", "They stopped building", 1),
+			expectedErr: "",
+		},
+		{
+			name:        "invalid - fails gov validation",
+			proposal:    types.NewCommunityPoolCancelPaymentStreamProposal("", "I have no title.", 1),
+			expectedErr: "invalid proposal content",
+		},
+		{
+			name:        "invalid - zero stream id",
+			proposal:    types.NewCommunityPoolCancelPaymentStreamProposal("Stop paying", "Which stream?", 0),
+			expectedErr: "payment stream id cannot be 0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, types.ModuleName, tc.proposal.ProposalRoute())
+			require.Equal(t, types.ProposalTypeCommunityPoolCancelPaymentStream, tc.proposal.ProposalType())
+		})
+	}
+}
+
+func TestCommunityPoolCancelPaymentStreamProposal_Stringer(t *testing.T) {
+	proposal := types.NewCommunityPoolCancelPaymentStreamProposal("title", "description", 7)
+	require.Equal(t, `Community Pool Cancel Payment Stream Proposal:
+  Title:       title
+  Description: description
+  Stream ID:   7
+`, proposal.String())
+}
+
+func TestCommunityPoolSwapProposals_ValidateBasic(t *testing.T) {
+	// each proposalData is tested with Swap and ProvideLiquidity proposals
+	type proposalData struct {
+		Title       string
+		Description string
+		TokenA      sdk.Coin
+		TokenB      sdk.Coin
+		Slippage    sdk.Dec
+		Deadline    int64
+	}
+	testCases := []struct {
+		name        string
+		proposal    proposalData
+		expectedErr string
+	}{
+		{
+			name: "valid proposal",
+			proposal: proposalData{
+				Title:       "Diversify plz",
+				Description: "I interact with swap",
+				TokenA:      sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+				Deadline:    1e10,
+			},
+			expectedErr: "",
+		},
+		{
+			name: "invalid - fails gov validation",
+			proposal: proposalData{
+				Description: "I have no title.",
+			},
+			expectedErr: "invalid proposal content",
+		},
+		{
+			name: "invalid - zero token a",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My token a is zero",
+				TokenA:      sdk.NewInt64Coin("ukava", 0),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+				Deadline:    1e10,
+			},
+			expectedErr: "invalid coins",
+		},
+		{
+			name: "invalid - empty token b",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My token b is empty",
+				TokenA:      sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.Coin{},
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+				Deadline:    1e10,
+			},
+			expectedErr: "invalid coins",
+		},
+		{
+			name: "invalid - equal denoms",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My tokens are the same",
+				TokenA:      sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("ukava", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+				Deadline:    1e10,
+			},
+			expectedErr: "denominations can not be equal",
+		},
+		{
+			name: "invalid - unset slippage",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My slippage is not set",
+				TokenA:      sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Deadline:    1e10,
+			},
+			expectedErr: "slippage must be set",
+		},
+		{
+			name: "invalid - negative slippage",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My slippage is negative",
+				TokenA:      sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("-0.01"),
+				Deadline:    1e10,
+			},
+			expectedErr: "slippage can not be negative",
+		},
+		{
+			name: "invalid - slippage of one",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My slippage allows any price",
+				TokenA:      sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Slippage:    sdk.OneDec(),
+				Deadline:    1e10,
+			},
+			expectedErr: "slippage must be less than 1",
+		},
+		{
+			name: "invalid - zero deadline",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My deadline is not set",
+				TokenA:      sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+			},
+			expectedErr: "invalid deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			swap := types.NewCommunityPoolSwapProposal(
+				tc.proposal.Title,
+				tc.proposal.Description,
+				tc.proposal.TokenA,
+				tc.proposal.TokenB,
+				tc.proposal.Slippage,
+				tc.proposal.Deadline,
+			)
+			provideLiquidity := types.NewCommunityPoolProvideLiquidityProposal(
+				tc.proposal.Title,
+				tc.proposal.Description,
+				tc.proposal.TokenA,
+				tc.proposal.TokenB,
+				tc.proposal.Slippage,
+				tc.proposal.Deadline,
+			)
+			swapErr := swap.ValidateBasic()
+			provideLiquidityErr := provideLiquidity.ValidateBasic()
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, swapErr, tc.expectedErr)
+				require.ErrorContains(t, provideLiquidityErr, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, swapErr)
+			require.Equal(t, types.ModuleName, swap.ProposalRoute())
+			require.Equal(t, types.ProposalTypeCommunityPoolSwap, swap.ProposalType())
+
+			require.NoError(t, provideLiquidityErr)
+			require.Equal(t, types.ModuleName, provideLiquidity.ProposalRoute())
+			require.Equal(t, types.ProposalTypeCommunityPoolProvideLiquidity, provideLiquidity.ProposalType())
+		})
+	}
+}
+
+func TestCommunityPoolSwapProposal_Stringer(t *testing.T) {
+	proposal := types.NewCommunityPoolSwapProposal(
+		"title",
+		"description",
+		sdk.NewInt64Coin("ukava", 42),
+		sdk.NewInt64Coin("usdx", 21),
+		sdk.MustNewDecFromStr("0.01"),
+		1893456000,
+	)
+	require.Equal(t, `Community Pool Swap Proposal:
+  Title:         title
+  Description:   description
+  Exact Token A: 42ukava
+  Token B:       21usdx
+  Slippage:      0.010000000000000000
+  Deadline:      1893456000
+`, proposal.String())
+}
+
+func TestCommunityPoolProvideLiquidityProposal_Stringer(t *testing.T) {
+	proposal := types.NewCommunityPoolProvideLiquidityProposal(
+		"title",
+		"description",
+		sdk.NewInt64Coin("ukava", 42),
+		sdk.NewInt64Coin("usdx", 21),
+		sdk.MustNewDecFromStr("0.01"),
+		1893456000,
+	)
+	require.Equal(t, `Community Pool Provide Liquidity Proposal:
+  Title:       title
+  Description: description
+  Token A:     42ukava
+  Token B:     21usdx
+  Slippage:    0.010000000000000000
+  Deadline:    1893456000
+`, proposal.String())
+}
+
+func TestCommunityPoolWithdrawLiquidityProposal_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name        string
+		proposal    *types.CommunityPoolWithdrawLiquidityProposal
+		expectedErr string
+	}{
+		{
+			name: "valid proposal",
+			proposal: types.NewCommunityPoolWithdrawLiquidityProposal(
+				"Undiversify plz", "I withdraw from swap", sdkmath.NewInt(1e6), sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 1e6), 1e10,
+			),
+			expectedErr: "",
+		},
+		{
+			name:        "invalid - fails gov validation",
+			proposal:    types.NewCommunityPoolWithdrawLiquidityProposal("", "I have no title.", sdkmath.NewInt(1e6), sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 1e6), 1e10),
+			expectedErr: "invalid proposal content",
+		},
+		{
+			name: "invalid - unset shares",
+			proposal: types.NewCommunityPoolWithdrawLiquidityProposal(
+				"Error profoundly", "My shares are not set", sdkmath.Int{}, sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 1e6), 1e10,
+			),
+			expectedErr: "invalid shares",
+		},
+		{
+			name: "invalid - zero shares",
+			proposal: types.NewCommunityPoolWithdrawLiquidityProposal(
+				"Error profoundly", "My shares are zero", sdkmath.ZeroInt(), sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 1e6), 1e10,
+			),
+			expectedErr: "invalid shares",
+		},
+		{
+			name: "invalid - zero min token a",
+			proposal: types.NewCommunityPoolWithdrawLiquidityProposal(
+				"Error profoundly", "My min token a is zero", sdkmath.NewInt(1e6), sdk.NewInt64Coin("ukava", 0), sdk.NewInt64Coin("usdx", 1e6), 1e10,
+			),
+			expectedErr: "invalid coins",
+		},
+		{
+			name: "invalid - empty min token b",
+			proposal: types.NewCommunityPoolWithdrawLiquidityProposal(
+				"Error profoundly", "My min token b is empty", sdkmath.NewInt(1e6), sdk.NewInt64Coin("ukava", 1e6), sdk.Coin{}, 1e10,
+			),
+			expectedErr: "invalid coins",
+		},
+		{
+			name: "invalid - equal denoms",
+			proposal: types.NewCommunityPoolWithdrawLiquidityProposal(
+				"Error profoundly", "My tokens are the same", sdkmath.NewInt(1e6), sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("ukava", 1e6), 1e10,
+			),
+			expectedErr: "denominations can not be equal",
+		},
+		{
+			name: "invalid - zero deadline",
+			proposal: types.NewCommunityPoolWithdrawLiquidityProposal(
+				"Error profoundly", "My deadline is not set", sdkmath.NewInt(1e6), sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 1e6), 0,
+			),
+			expectedErr: "invalid deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, types.ModuleName, tc.proposal.ProposalRoute())
+			require.Equal(t, types.ProposalTypeCommunityPoolWithdrawLiquidity, tc.proposal.ProposalType())
+		})
+	}
+}
+
+func TestCommunityPoolWithdrawLiquidityProposal_Stringer(t *testing.T) {
+	proposal := types.NewCommunityPoolWithdrawLiquidityProposal(
+		"title",
+		"description",
+		sdkmath.NewInt(30),
+		sdk.NewInt64Coin("ukava", 42),
+		sdk.NewInt64Coin("usdx", 21),
+		1893456000,
+	)
+	require.Equal(t, `Community Pool Withdraw Liquidity Proposal:
+  Title:       title
+  Description: description
+  Shares:      30
+  Min Token A: 42ukava
+  Min Token B: 21usdx
+  Deadline:    1893456000
+`, proposal.String())
+}
@@ -358,6 +358,232 @@ func (m *QueryAnnualizedRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualizedRewardsResponse proto.InternalMessageInfo
 
+// QueryPaymentStreamsRequest defines the request type for querying community pool payment streams.
+type QueryPaymentStreamsRequest struct {
+}
+
+func (m *QueryPaymentStreamsRequest) Reset()         { *m = QueryPaymentStreamsRequest{} }
+func (m *QueryPaymentStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{8}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamsRequest proto.InternalMessageInfo
+
+// QueryPaymentStreamsResponse defines the response type for querying community pool payment streams.
+type QueryPaymentStreamsResponse struct {
+	PaymentStreams []PaymentStreamResponse `protobuf:"bytes,1,rep,name=payment_streams,json=paymentStreams,proto3" json:"payment_streams"`
+}
+
+func (m *QueryPaymentStreamsResponse) Reset()         { *m = QueryPaymentStreamsResponse{} }
+func (m *QueryPaymentStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{9}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsResponse) GetPaymentStreams() []PaymentStreamResponse {
+	if m != nil {
+		return m.PaymentStreams
+	}
+	return nil
+}
+
+// QueryPaymentStreamRequest defines the request type for querying a community pool payment stream.
+type QueryPaymentStreamRequest struct {
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryPaymentStreamRequest) Reset()         { *m = QueryPaymentStreamRequest{} }
+func (m *QueryPaymentStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{10}
+}
+func (m *QueryPaymentStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryPaymentStreamResponse defines the response type for querying a community pool payment stream.
+type QueryPaymentStreamResponse struct {
+	PaymentStream PaymentStreamResponse `protobuf:"bytes,1,opt,name=payment_stream,json=paymentStream,proto3" json:"payment_stream"`
+}
+
+func (m *QueryPaymentStreamResponse) Reset()         { *m = QueryPaymentStreamResponse{} }
+func (m *QueryPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{11}
+}
+func (m *QueryPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamResponse) GetPaymentStream() PaymentStreamResponse {
+	if m != nil {
+		return m.PaymentStream
+	}
+	return PaymentStreamResponse{}
+}
+
+// PaymentStreamResponse is a payment stream with the amount it has left to pay.
+type PaymentStreamResponse struct {
+	Stream PaymentStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+	// remaining is the amount the stream has not yet paid
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+}
+
+func (m *PaymentStreamResponse) Reset()         { *m = PaymentStreamResponse{} }
+func (m *PaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*PaymentStreamResponse) ProtoMessage()    {}
+func (*PaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{12}
+}
+func (m *PaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentStreamResponse.Merge(m, src)
+}
+func (m *PaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentStreamResponse proto.InternalMessageInfo
+
+func (m *PaymentStreamResponse) GetStream() PaymentStream {
+	if m != nil {
+		return m.Stream
+	}
+	return PaymentStream{}
+}
+
+func (m *PaymentStreamResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.community.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.community.v1beta1.QueryParamsResponse")
@@ -367,6 +593,11 @@ func init() {
 	proto.RegisterType((*QueryTotalBalanceResponse)(nil), "kava.community.v1beta1.QueryTotalBalanceResponse")
 	proto.RegisterType((*QueryAnnualizedRewardsRequest)(nil), "kava.community.v1beta1.QueryAnnualizedRewardsRequest")
 	proto.RegisterType((*QueryAnnualizedRewardsResponse)(nil), "kava.community.v1beta1.QueryAnnualizedRewardsResponse")
+	proto.RegisterType((*QueryPaymentStreamsRequest)(nil), "kava.community.v1beta1.QueryPaymentStreamsRequest")
+	proto.RegisterType((*QueryPaymentStreamsResponse)(nil), "kava.community.v1beta1.QueryPaymentStreamsResponse")
+	proto.RegisterType((*QueryPaymentStreamRequest)(nil), "kava.community.v1beta1.QueryPaymentStreamRequest")
+	proto.RegisterType((*QueryPaymentStreamResponse)(nil), "kava.community.v1beta1.QueryPaymentStreamResponse")
+	proto.RegisterType((*PaymentStreamResponse)(nil), "kava.community.v1beta1.PaymentStreamResponse")
 }
 
 func init() {
@@ -374,45 +605,58 @@ func init() {
 }
 
 var fileDescriptor_f236f06c43149273 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x68, 0x8b, 0xb8, 0x22, 0x10, 0x47, 0x40, 0x8d, 0x29, 0x4e, 0x31, 0x82, 0x46,
-	0x6d, 0x63, 0x37, 0xa9, 0x60, 0x62, 0x21, 0x84, 0x8d, 0x01, 0x0c, 0x53, 0x97, 0xe8, 0xec, 0x9c,
-	0x5c, 0x2b, 0x8e, 0xcf, 0xcd, 0x5d, 0x0a, 0x41, 0x2c, 0x74, 0x63, 0x40, 0x42, 0xe2, 0x1b, 0x30,
-	0x32, 0x23, 0x3e, 0x43, 0xc7, 0x0a, 0x16, 0xc4, 0x50, 0x50, 0xc2, 0x07, 0x41, 0x77, 0xf7, 0x12,
-	0x25, 0x34, 0x8e, 0xd2, 0x29, 0xf1, 0xbb, 0xf7, 0x7f, 0xff, 0xdf, 0xbd, 0xf7, 0x6c, 0x64, 0xb7,
-	0xc8, 0x01, 0x71, 0x03, 0xd6, 0x6e, 0x77, 0x93, 0x48, 0xf4, 0xdc, 0x83, 0x8a, 0x4f, 0x05, 0xa9,
-	0xb8, 0xfb, 0x5d, 0xda, 0xe9, 0x39, 0x69, 0x87, 0x09, 0x86, 0x6f, 0xc8, 0x1c, 0x67, 0x94, 0xe3,
-	0x40, 0x8e, 0x69, 0x05, 0x8c, 0xb7, 0x19, 0x77, 0x7d, 0xc2, 0xe9, 0x48, 0x18, 0xb0, 0x28, 0xd1,
-	0x3a, 0xb3, 0xa0, 0xcf, 0x1b, 0xea, 0xc9, 0xd5, 0x0f, 0x70, 0x94, 0x0f, 0x59, 0xc8, 0x74, 0x5c,
-	0xfe, 0x83, 0xe8, 0x6a, 0xc8, 0x58, 0x18, 0x53, 0x97, 0xa4, 0x91, 0x4b, 0x92, 0x84, 0x09, 0x22,
-	0x22, 0x96, 0x0c, 0x35, 0x77, 0x32, 0x50, 0x53, 0xd2, 0x21, 0x6d, 0x48, 0xb2, 0xf3, 0x08, 0x3f,
-	0x97, 0xe8, 0xcf, 0x54, 0xd0, 0xa3, 0xfb, 0x5d, 0xca, 0x85, 0xfd, 0x02, 0x5d, 0x9b, 0x88, 0xf2,
-	0x94, 0x25, 0x9c, 0xe2, 0x87, 0x68, 0x49, 0x8b, 0x57, 0x8c, 0x35, 0xa3, 0xb4, 0x5c, 0xb5, 0x9c,
-	0xe9, 0x37, 0x75, 0xb4, 0xae, 0xb6, 0x70, 0x74, 0x52, 0xcc, 0x79, 0xa0, 0xb1, 0xaf, 0x43, 0xd1,
-	0x1a, 0x89, 0x49, 0x12, 0xd0, 0xa1, 0x57, 0x0f, 0xe5, 0x27, 0xc3, 0x60, 0x46, 0xd0, 0xa2, 0xec,
-	0x8d, 0xf4, 0x3a, 0x5f, 0x5a, 0xae, 0x16, 0x1c, 0x68, 0x88, 0xec, 0xde, 0xc8, 0xe8, 0x31, 0x8b,
-	0x92, 0xda, 0xb6, 0xb4, 0xf9, 0xf2, 0xbb, 0x58, 0x0a, 0x23, 0xb1, 0xd7, 0xf5, 0x25, 0x0f, 0x74,
-	0x0f, 0x7e, 0xca, 0xbc, 0xd9, 0x72, 0x45, 0x2f, 0xa5, 0x5c, 0x09, 0xb8, 0xa7, 0x2b, 0xdb, 0x26,
-	0x5a, 0x51, 0xd6, 0x2f, 0x99, 0x20, 0xf1, 0x7f, 0x58, 0x87, 0x06, 0x2a, 0x4c, 0x39, 0x04, 0x38,
-	0x8a, 0x16, 0x52, 0xc6, 0x62, 0x60, 0x5b, 0x9d, 0xca, 0x56, 0xa7, 0x81, 0xc2, 0xdb, 0x01, 0xbc,
-	0xcd, 0x39, 0xf0, 0x40, 0xc3, 0x3d, 0x55, 0xde, 0x2e, 0xa2, 0x5b, 0x8a, 0xe1, 0x51, 0x92, 0x74,
-	0x49, 0x1c, 0xbd, 0xa1, 0x4d, 0x8f, 0xbe, 0x22, 0x9d, 0xe6, 0x68, 0x50, 0x6f, 0x91, 0x95, 0x95,
-	0x00, 0xa4, 0xbb, 0xe8, 0x0a, 0x17, 0xa4, 0x15, 0x25, 0x61, 0xa3, 0xa3, 0x8f, 0xd4, 0xf0, 0x2e,
-	0xd6, 0x2a, 0x12, 0xeb, 0xd7, 0x49, 0xf1, 0xa6, 0x86, 0xe0, 0xcd, 0x96, 0x13, 0x31, 0xb7, 0x4d,
-	0xc4, 0x9e, 0xf3, 0x94, 0x86, 0x24, 0xe8, 0xd5, 0x69, 0xf0, 0xfd, 0x6b, 0x19, 0xc1, 0xd5, 0xea,
-	0x34, 0xf0, 0x2e, 0x43, 0x25, 0xf0, 0xa8, 0xbe, 0x5b, 0x44, 0x8b, 0xca, 0x1e, 0xbf, 0x37, 0xd0,
-	0x92, 0x1e, 0x3a, 0xde, 0xc8, 0x5a, 0x8a, 0xd3, 0x7b, 0x66, 0x6e, 0xce, 0x95, 0xab, 0x6f, 0x62,
-	0xdf, 0x3b, 0xfc, 0xf1, 0xf7, 0xd3, 0xb9, 0x35, 0x6c, 0xb9, 0x33, 0x17, 0x1b, 0x7f, 0x30, 0xd0,
-	0x05, 0x98, 0x17, 0x9e, 0x6d, 0x30, 0x39, 0x72, 0x73, 0x6b, 0xbe, 0x64, 0xc0, 0x59, 0x57, 0x38,
-	0xb7, 0x71, 0x31, 0x0b, 0xc7, 0x07, 0x86, 0xcf, 0x06, 0xba, 0x34, 0xbe, 0x44, 0x78, 0x7b, 0xa6,
-	0xcf, 0x94, 0x65, 0x34, 0x2b, 0x67, 0x50, 0x00, 0x5e, 0x59, 0xe1, 0xad, 0xe3, 0xbb, 0x59, 0x78,
-	0x42, 0xaa, 0x1a, 0x43, 0xc8, 0x6f, 0x06, 0xba, 0x7a, 0x6a, 0x89, 0xf0, 0xfd, 0x99, 0xbe, 0x59,
-	0x5b, 0x69, 0x3e, 0x38, 0xab, 0x0c, 0x98, 0xab, 0x8a, 0x79, 0x0b, 0x6f, 0x64, 0x31, 0x93, 0x91,
-	0x74, 0xb8, 0xcc, 0xb5, 0x27, 0x47, 0x7d, 0xcb, 0x38, 0xee, 0x5b, 0xc6, 0x9f, 0xbe, 0x65, 0x7c,
-	0x1c, 0x58, 0xb9, 0xe3, 0x81, 0x95, 0xfb, 0x39, 0xb0, 0x72, 0xbb, 0xe3, 0xef, 0x9b, 0xac, 0x57,
-	0x8e, 0x89, 0xcf, 0x75, 0xe5, 0xd7, 0x63, 0xb5, 0xd5, 0x8b, 0xe7, 0x2f, 0xa9, 0xcf, 0xe1, 0xce,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0xb3, 0x12, 0x9f, 0xe0, 0x05, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x4f, 0x13, 0x5b,
+	0x14, 0xc7, 0x3b, 0x3c, 0x28, 0x8f, 0xcb, 0xa3, 0xe4, 0xdd, 0x07, 0x2f, 0x30, 0xe0, 0x14, 0xc7,
+	0x20, 0x08, 0x74, 0x86, 0xb6, 0xc1, 0x98, 0xe8, 0xc6, 0x82, 0x0b, 0x13, 0x17, 0x5a, 0x5c, 0x11,
+	0x93, 0xe6, 0x76, 0x7a, 0x33, 0x4c, 0xda, 0x99, 0x5b, 0x3a, 0x53, 0xa4, 0xa2, 0x1b, 0x76, 0x2e,
+	0x4c, 0x4c, 0xfc, 0x06, 0x2e, 0xd9, 0x6a, 0xfc, 0x0a, 0xb2, 0x24, 0xba, 0x31, 0x2e, 0xd0, 0x00,
+	0x1f, 0xc4, 0xcc, 0xbd, 0x67, 0x6a, 0x07, 0x66, 0x6a, 0x6b, 0x5c, 0xc1, 0xdc, 0x7b, 0xfe, 0xe7,
+	0xff, 0x9b, 0x73, 0xcf, 0x3d, 0x53, 0xa4, 0x56, 0xc9, 0x2e, 0xd1, 0x0d, 0x66, 0xdb, 0x4d, 0xc7,
+	0xf2, 0x5a, 0xfa, 0x6e, 0xb6, 0x4c, 0x3d, 0x92, 0xd5, 0x77, 0x9a, 0xb4, 0xd1, 0xd2, 0xea, 0x0d,
+	0xe6, 0x31, 0xfc, 0xbf, 0x1f, 0xa3, 0xb5, 0x63, 0x34, 0x88, 0x91, 0x15, 0x83, 0xb9, 0x36, 0x73,
+	0xf5, 0x32, 0x71, 0x69, 0x5b, 0x68, 0x30, 0xcb, 0x11, 0x3a, 0x79, 0x5a, 0xec, 0x97, 0xf8, 0x93,
+	0x2e, 0x1e, 0x60, 0x6b, 0xc2, 0x64, 0x26, 0x13, 0xeb, 0xfe, 0x7f, 0xb0, 0x3a, 0x6b, 0x32, 0x66,
+	0xd6, 0xa8, 0x4e, 0xea, 0x96, 0x4e, 0x1c, 0x87, 0x79, 0xc4, 0xb3, 0x98, 0x13, 0x68, 0xae, 0xc5,
+	0xa0, 0xd6, 0x49, 0x83, 0xd8, 0x41, 0xd0, 0x72, 0x6c, 0x50, 0xcb, 0xa6, 0x8e, 0x57, 0x72, 0xbd,
+	0x06, 0x25, 0xb6, 0x08, 0x56, 0x27, 0x10, 0x7e, 0xe4, 0xbf, 0xe7, 0x43, 0x9e, 0xa1, 0x48, 0x77,
+	0x9a, 0xd4, 0xf5, 0xd4, 0x4d, 0xf4, 0x5f, 0x68, 0xd5, 0xad, 0x33, 0xc7, 0xa5, 0xf8, 0x0e, 0x4a,
+	0x0a, 0xa7, 0x29, 0x69, 0x4e, 0x5a, 0x1c, 0xcd, 0x29, 0x5a, 0x74, 0x59, 0x34, 0xa1, 0x2b, 0x0c,
+	0x1e, 0x9d, 0xa4, 0x13, 0x45, 0xd0, 0xa8, 0x93, 0x90, 0xb4, 0x40, 0x6a, 0xc4, 0x31, 0x68, 0xe0,
+	0xd5, 0x42, 0x13, 0xe1, 0x65, 0x30, 0x23, 0x68, 0xc8, 0x2f, 0xa4, 0xef, 0xf5, 0xd7, 0xe2, 0x68,
+	0x6e, 0x5a, 0x83, 0xea, 0xf9, 0xa5, 0x6e, 0x1b, 0xad, 0x33, 0xcb, 0x29, 0xac, 0xfa, 0x36, 0x87,
+	0xdf, 0xd2, 0x8b, 0xa6, 0xe5, 0x6d, 0x37, 0xcb, 0x3e, 0x0f, 0x94, 0x1a, 0xfe, 0x64, 0xdc, 0x4a,
+	0x55, 0xf7, 0x5a, 0x75, 0xea, 0x72, 0x81, 0x5b, 0x14, 0x99, 0x55, 0x19, 0x4d, 0x71, 0xeb, 0xc7,
+	0xcc, 0x23, 0xb5, 0x0b, 0x58, 0x07, 0x12, 0x9a, 0x8e, 0xd8, 0x04, 0x38, 0x8a, 0x06, 0xeb, 0x8c,
+	0xd5, 0x80, 0x6d, 0x36, 0x92, 0x6d, 0x83, 0x1a, 0x1c, 0x2f, 0x0f, 0x78, 0xcb, 0x3d, 0xe0, 0x81,
+	0xc6, 0x2d, 0xf2, 0xf4, 0x6a, 0x1a, 0x5d, 0xe1, 0x0c, 0x77, 0x1d, 0xa7, 0x49, 0x6a, 0xd6, 0x33,
+	0x5a, 0x29, 0xd2, 0xa7, 0xa4, 0x51, 0x69, 0x1f, 0xd4, 0x73, 0xa4, 0xc4, 0x05, 0x00, 0xe9, 0x16,
+	0x1a, 0x77, 0x3d, 0x52, 0xb5, 0x1c, 0xb3, 0xd4, 0x10, 0x5b, 0xfc, 0xf0, 0x46, 0x0a, 0x59, 0x1f,
+	0xeb, 0xeb, 0x49, 0x7a, 0x46, 0x40, 0xb8, 0x95, 0xaa, 0x66, 0x31, 0xdd, 0x26, 0xde, 0xb6, 0xf6,
+	0x80, 0x9a, 0xc4, 0x68, 0x6d, 0x50, 0xe3, 0xd3, 0xfb, 0x0c, 0x82, 0x57, 0xdb, 0xa0, 0x46, 0x31,
+	0x05, 0x99, 0xc0, 0x43, 0x9d, 0x45, 0x32, 0xb4, 0x09, 0xef, 0xac, 0x4d, 0xde, 0x58, 0x6d, 0xb6,
+	0x7d, 0x34, 0x13, 0xb9, 0x0b, 0x60, 0x4f, 0xd0, 0x78, 0xb8, 0x23, 0x83, 0x93, 0xce, 0xc4, 0x77,
+	0x55, 0x47, 0xa2, 0x20, 0x0f, 0x34, 0x59, 0xaa, 0x1e, 0x72, 0x51, 0x6f, 0xc1, 0xe9, 0x5d, 0xd0,
+	0x70, 0x32, 0x3c, 0x83, 0x46, 0x84, 0x65, 0xc9, 0xaa, 0xf0, 0x6a, 0x0c, 0x16, 0xff, 0x16, 0x0b,
+	0xf7, 0x2b, 0xea, 0x5e, 0xd4, 0x4b, 0x75, 0x94, 0x33, 0x15, 0xa6, 0x86, 0xab, 0xf0, 0x5b, 0xd0,
+	0x63, 0x21, 0x68, 0xf5, 0xa3, 0x84, 0x26, 0xa3, 0x5d, 0xd7, 0x51, 0x32, 0xe4, 0x36, 0xdf, 0x93,
+	0x5b, 0x70, 0xff, 0x84, 0x14, 0x5b, 0x68, 0xa4, 0x41, 0x6d, 0x62, 0x39, 0x96, 0x63, 0x4e, 0x0d,
+	0xfc, 0xf9, 0x4b, 0xf5, 0x33, 0x7b, 0xee, 0x7c, 0x18, 0x0d, 0xf1, 0x22, 0xe2, 0x97, 0x12, 0x4a,
+	0x8a, 0x69, 0x80, 0x97, 0xe2, 0xa0, 0x2f, 0x0f, 0x20, 0x79, 0xb9, 0xa7, 0x58, 0x51, 0x1d, 0xf5,
+	0xfa, 0xc1, 0xe7, 0xf3, 0x37, 0x03, 0x73, 0x58, 0xd1, 0xbb, 0x8e, 0x47, 0xfc, 0x4a, 0x42, 0xc3,
+	0x70, 0x91, 0x71, 0x77, 0x83, 0xf0, 0x2c, 0x90, 0x57, 0x7a, 0x0b, 0x06, 0x9c, 0x05, 0x8e, 0x73,
+	0x15, 0xa7, 0xe3, 0x70, 0xca, 0xc0, 0xf0, 0x56, 0x42, 0xff, 0x74, 0x4e, 0x17, 0xbc, 0xda, 0xd5,
+	0x27, 0x62, 0x4a, 0xc9, 0xd9, 0x3e, 0x14, 0x80, 0x97, 0xe1, 0x78, 0x0b, 0x78, 0x3e, 0x0e, 0xcf,
+	0xf3, 0x55, 0xa5, 0x00, 0xf2, 0x83, 0x84, 0xfe, 0xbd, 0x34, 0x5d, 0xf0, 0x5a, 0x57, 0xdf, 0xb8,
+	0x71, 0x25, 0xdf, 0xec, 0x57, 0x06, 0xcc, 0x39, 0xce, 0xbc, 0x82, 0x97, 0xe2, 0x98, 0x49, 0x5b,
+	0x1a, 0x4c, 0x39, 0x7c, 0x28, 0xa1, 0x54, 0x78, 0xf4, 0xe0, 0xdc, 0x2f, 0xba, 0x2a, 0x62, 0x8a,
+	0xc9, 0xf9, 0xbe, 0x34, 0xc0, 0xab, 0x73, 0xde, 0x1b, 0x78, 0x41, 0xef, 0xe9, 0x5b, 0xec, 0xe2,
+	0x77, 0x12, 0x1a, 0x0b, 0xe5, 0xc2, 0xd9, 0xde, 0x7d, 0x03, 0xd4, 0x5c, 0x3f, 0x12, 0x20, 0xbd,
+	0xcd, 0x49, 0xd7, 0x70, 0xbe, 0x47, 0x52, 0x7d, 0xbf, 0x3d, 0x39, 0x5f, 0x14, 0xee, 0x1d, 0x9d,
+	0x2a, 0xd2, 0xf1, 0xa9, 0x22, 0x7d, 0x3f, 0x55, 0xa4, 0xd7, 0x67, 0x4a, 0xe2, 0xf8, 0x4c, 0x49,
+	0x7c, 0x39, 0x53, 0x12, 0x5b, 0x9d, 0xdf, 0x3a, 0x3f, 0x71, 0xa6, 0x46, 0xca, 0xae, 0xb0, 0xd8,
+	0xeb, 0x30, 0xe1, 0xe3, 0xa3, 0x9c, 0xe4, 0x3f, 0x45, 0xf2, 0x3f, 0x06, 0x00, 0x2f, 0xe3, 0x62,
+	0x0e, 0x89, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -437,6 +681,10 @@ type QueryClient interface {
 	// AnnualizedRewards calculates and returns the current annualized reward percentages,
 	// like staking rewards, for the chain.
 	AnnualizedRewards(ctx context.Context, in *QueryAnnualizedRewardsRequest, opts ...grpc.CallOption) (*QueryAnnualizedRewardsResponse, error)
+	// PaymentStreams queries the community pool payment streams with their paid and remaining amounts.
+	PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error)
+	// PaymentStream queries a community pool payment stream by id.
+	PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error)
 }
 
 type queryClient struct {
@@ -483,6 +731,24 @@ func (c *queryClient) AnnualizedRewards(ctx context.Context, in *QueryAnnualized
 	return out, nil
 }
 
+func (c *queryClient) PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error) {
+	out := new(QueryPaymentStreamsResponse)
+	err := c.cc.Invoke(ctx, "/kava.community.v1beta1.Query/PaymentStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error) {
+	out := new(QueryPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/kava.community.v1beta1.Query/PaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queires the module params.
@@ -495,6 +761,10 @@ type QueryServer interface {
 	// AnnualizedRewards calculates and returns the current annualized reward percentages,
 	// like staking rewards, for the chain.
 	AnnualizedRewards(context.Context, *QueryAnnualizedRewardsRequest) (*QueryAnnualizedRewardsResponse, error)
+	// PaymentStreams queries the community pool payment streams with their paid and remaining amounts.
+	PaymentStreams(context.Context, *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error)
+	// PaymentStream queries a community pool payment stream by id.
+	PaymentStream(context.Context, *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -513,6 +783,12 @@ func (*UnimplementedQueryServer) TotalBalance(ctx context.Context, req *QueryTot
 func (*UnimplementedQueryServer) AnnualizedRewards(ctx context.Context, req *QueryAnnualizedRewardsRequest) (*QueryAnnualizedRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualizedRewards not implemented")
 }
+func (*UnimplementedQueryServer) PaymentStreams(ctx context.Context, req *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStreams not implemented")
+}
+func (*UnimplementedQueryServer) PaymentStream(ctx context.Context, req *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStream not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -590,6 +866,42 @@ func _Query_AnnualizedRewards_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PaymentStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.community.v1beta1.Query/PaymentStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStreams(ctx, req.(*QueryPaymentStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.community.v1beta1.Query/PaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStream(ctx, req.(*QueryPaymentStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.community.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -610,6 +922,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualizedRewards",
 			Handler:    _Query_AnnualizedRewards_Handler,
 		},
+		{
+			MethodName: "PaymentStreams",
+			Handler:    _Query_PaymentStreams_Handler,
+		},
+		{
+			MethodName: "PaymentStream",
+			Handler:    _Query_PaymentStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/community/v1beta1/query.proto",
@@ -847,71 +1167,239 @@ func (m *QueryAnnualizedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPaymentStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryPaymentStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalBalanceResponse) Size() (n int) {
+func (m *QueryPaymentStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PaymentStream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalBalanceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -923,36 +1411,367 @@ func (m *QueryTotalBalanceResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryAnnualizedRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	return n
+}
+
+func (m *QueryAnnualizedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualizedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPaymentStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentStream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAnnualizedRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.StakingRewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -975,10 +1794,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1002,7 +1821,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,15 +1844,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1060,7 +1879,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pool = append(m.Pool, types.DecCoin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1085,7 +1905,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAnnualizedRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1108,10 +1928,10 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAnnualizedRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAnnualizedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1135,7 +1955,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAnnualizedRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1158,17 +1978,17 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAnnualizedRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAnnualizedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1178,23 +1998,23 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1219,7 +2039,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1242,10 +2062,10 @@ func (m *QueryTotalBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1269,7 +2089,7 @@ func (m *QueryTotalBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1292,15 +2112,15 @@ func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1327,8 +2147,8 @@ func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, types.DecCoin{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStreamResponse{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1353,7 +2173,7 @@ func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualizedRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,12 +2196,114 @@ func (m *QueryAnnualizedRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualizedRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualizedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1403,7 +2325,7 @@ func (m *QueryAnnualizedRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualizedRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *PaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1426,17 +2348,17 @@ func (m *QueryAnnualizedRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualizedRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualizedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1446,23 +2368,56 @@ func (m *QueryAnnualizedRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
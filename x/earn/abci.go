@@ -0,0 +1,21 @@
+package earn
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/keeper"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// BeginBlocker deleverages CDP mint strategy positions, accrues vault fees and
+// processes queued withdrawals
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.DeleverageCDPStrategy(ctx)
+	k.AccrueAllVaultFees(ctx)
+	k.ProcessWithdrawalQueue(ctx)
+}
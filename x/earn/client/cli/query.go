@@ -36,6 +36,8 @@ func GetQueryCmd() *cobra.Command {
 		queryVaultCmd(),
 		queryDepositsCmd(),
 		queryTotalSupplyCmd(),
+		queryWithdrawalRequestsCmd(),
+		queryPendingWithdrawalsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -206,3 +208,83 @@ func queryTotalSupplyCmd() *cobra.Command {
 		},
 	}
 }
+
+func queryWithdrawalRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal-requests",
+		Short: "get queued earn vault withdrawal requests",
+		Long:  "Get queued earn vault withdrawal requests and their position in the queue, for all or specific accounts and vaults.",
+		Args:  cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s q %[2]s withdrawal-requests
+%[1]s q %[2]s withdrawal-requests --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --denom usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WithdrawalRequests(context.Background(), &types.QueryWithdrawalRequestsRequest{
+				Owner: ownerBech,
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "(optional) filter for withdrawal requests by owner address")
+	cmd.Flags().String(flagDenom, "", "(optional) filter for withdrawal requests by vault denom")
+
+	return cmd
+}
+
+func queryPendingWithdrawalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-withdrawals",
+		Short: "get the total shares and value queued for withdrawal",
+		Long:  "Get the total shares and value queued for withdrawal for all or a specific vault.",
+		Args:  cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s q %[2]s pending-withdrawals
+%[1]s q %[2]s pending-withdrawals --denom usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingWithdrawals(context.Background(), &types.QueryPendingWithdrawalsRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "(optional) filter for pending withdrawals by vault denom")
+
+	return cmd
+}
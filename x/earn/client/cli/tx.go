@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,8 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdRequestWithdrawal(),
+		getCmdCancelWithdrawal(),
 	}
 
 	for _, cmd := range cmds {
@@ -112,6 +115,68 @@ func getCmdWithdraw() *cobra.Command {
 	}
 }
 
+func getCmdRequestWithdrawal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "request-withdrawal [amount]",
+		Short: "queue a withdrawal from an earn vault that is processed once the vault has enough liquidity",
+		Example: fmt.Sprintf(
+			`%s tx %s request-withdrawal 10000000usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgRequestWithdrawal(fromAddr.String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdCancelWithdrawal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-withdrawal [id]",
+		Short: "cancel a queued earn vault withdrawal and return its shares",
+		Example: fmt.Sprintf(
+			`%s tx %s cancel-withdrawal 1 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid withdrawal request id: %w", err)
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgCancelWithdrawal(fromAddr.String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 // GetCmdSubmitCommunityPoolDepositProposal implements the command to submit a community-pool deposit proposal
 func GetCmdSubmitCommunityPoolDepositProposal() *cobra.Command {
 	cmd := &cobra.Command{
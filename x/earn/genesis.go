@@ -33,6 +33,15 @@ func InitGenesis(
 		k.SetVaultShareRecord(ctx, vaultShareRecord)
 	}
 
+	// Queued withdrawal requests hold shares removed from vault share records
+	for _, request := range gs.WithdrawalRequests {
+		vaultTotalShares = vaultTotalShares.Add(request.Shares)
+
+		k.SetWithdrawalRequest(ctx, request)
+	}
+
+	k.SetNextWithdrawalRequestID(ctx, gs.NextWithdrawalRequestID)
+
 	for _, vaultRecord := range gs.VaultRecords {
 		if err := vaultRecord.Validate(); err != nil {
 			panic(fmt.Sprintf("invalid vault record: %s", err))
@@ -58,6 +67,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	vaultRecords := k.GetAllVaultRecords(ctx)
 	vaultShareRecords := k.GetAllVaultShareRecords(ctx)
+	withdrawalRequests := k.GetAllWithdrawalRequests(ctx)
+	nextWithdrawalRequestID := k.GetNextWithdrawalRequestID(ctx)
 
-	return types.NewGenesisState(params, vaultRecords, vaultShareRecords)
+	return types.NewGenesisState(
+		params,
+		vaultRecords,
+		vaultShareRecords,
+		withdrawalRequests,
+		nextWithdrawalRequestID,
+	)
 }
@@ -2,6 +2,7 @@ package earn_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/earn"
@@ -35,6 +36,8 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 			},
 		},
 		types.VaultShareRecords{},
+		types.WithdrawalRequests{},
+		types.DefaultNextWithdrawalRequestID,
 	)
 
 	suite.Panics(func() {
@@ -68,10 +71,14 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 		},
 		types.VaultRecords{
 			types.VaultRecord{
-				TotalShares: types.NewVaultShare("ukava", sdk.NewDec(3800000)),
+				TotalShares:   types.NewVaultShare("ukava", sdk.NewDec(3800000)),
+				HighWaterMark: sdk.OneDec(),
+				Buffer:        sdk.ZeroInt(),
 			},
 			types.VaultRecord{
-				TotalShares: types.NewVaultShare("usdx", sdk.NewDec(1000000)),
+				TotalShares:   types.NewVaultShare("usdx", sdk.NewDec(1100000)),
+				HighWaterMark: sdk.OneDec(),
+				Buffer:        sdk.NewInt(1000),
 			},
 		},
 		types.VaultShareRecords{
@@ -90,6 +97,15 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 				),
 			},
 		},
+		types.WithdrawalRequests{
+			types.NewWithdrawalRequest(
+				1,
+				depositor_1,
+				types.NewVaultShare("usdx", sdk.NewDec(100000)),
+				time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+		},
+		2,
 	)
 
 	earn.InitGenesis(suite.Ctx, suite.Keeper, suite.AccountKeeper, state)
@@ -136,10 +152,14 @@ func (suite *genesisTestSuite) Test_Marshall() {
 		},
 		types.VaultRecords{
 			types.VaultRecord{
-				TotalShares: types.NewVaultShare("ukava", sdk.NewDec(3800000)),
+				TotalShares:   types.NewVaultShare("ukava", sdk.NewDec(3800000)),
+				HighWaterMark: sdk.OneDec(),
+				Buffer:        sdk.ZeroInt(),
 			},
 			types.VaultRecord{
-				TotalShares: types.NewVaultShare("usdx", sdk.NewDec(1000000)),
+				TotalShares:   types.NewVaultShare("usdx", sdk.NewDec(1100000)),
+				HighWaterMark: sdk.OneDec(),
+				Buffer:        sdk.NewInt(1000),
 			},
 		},
 		types.VaultShareRecords{
@@ -158,6 +178,15 @@ func (suite *genesisTestSuite) Test_Marshall() {
 				),
 			},
 		},
+		types.WithdrawalRequests{
+			types.NewWithdrawalRequest(
+				1,
+				depositor_1,
+				types.NewVaultShare("usdx", sdk.NewDec(100000)),
+				time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+		},
+		2,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -0,0 +1,324 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// GetVaultStrategyValues returns the value of denom held by each strategy of
+// the vault, in the same order as the vault's Strategies.
+func (k *Keeper) GetVaultStrategyValues(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	denom string,
+) ([]sdkmath.Int, error) {
+	values := make([]sdkmath.Int, len(allowedVault.Strategies))
+	for i, strategyType := range allowedVault.Strategies {
+		strategy, err := k.GetStrategy(strategyType)
+		if err != nil {
+			return nil, types.ErrInvalidVaultStrategy
+		}
+
+		value, err := strategy.GetEstimatedTotalAssets(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = value.Amount
+	}
+
+	return values, nil
+}
+
+// RebalanceVault withdraws funds from the strategies of a vault that are above
+// their target weight and deposits them to the strategies below their target
+// weight. The vault's liquidity buffer is refilled to its target first.
+func (k *Keeper) RebalanceVault(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	values, err := k.GetVaultStrategyValues(ctx, allowedVault, denom)
+	if err != nil {
+		return err
+	}
+
+	// A vault without a record has no deposits and so no buffer to manage
+	vaultRecord, hasRecord := k.GetVaultRecord(ctx, denom)
+	buffer := vaultRecord.GetBuffer()
+
+	total := buffer
+	for _, value := range values {
+		total = total.Add(value)
+	}
+
+	bufferTarget := sdk.ZeroInt()
+	if hasRecord {
+		bufferTarget = sdk.NewDecFromInt(total).Mul(allowedVault.GetLiquidityBuffer()).TruncateInt()
+	}
+
+	targets := splitByWeights(total.Sub(bufferTarget), allowedVault.GetStrategyWeights())
+
+	// Withdraw excess first so the module account holds the funds to deposit
+	moved := sdk.ZeroInt()
+	for i, strategyType := range allowedVault.Strategies {
+		excess := values[i].Sub(targets[i])
+		if !excess.IsPositive() {
+			continue
+		}
+
+		strategy, err := k.GetStrategy(strategyType)
+		if err != nil {
+			return types.ErrInvalidVaultStrategy
+		}
+
+		if err := strategy.Withdraw(ctx, sdk.NewCoin(denom, excess)); err != nil {
+			return errorsmod.Wrapf(err, "failed to withdraw from strategy %s", strategyType)
+		}
+
+		moved = moved.Add(excess)
+	}
+
+	// Excess buffer is moved to strategies, and a buffer deficit is refilled
+	// before depositing to strategies
+	available := moved
+	if buffer.GT(bufferTarget) {
+		available = available.Add(buffer.Sub(bufferTarget))
+		moved = moved.Add(buffer.Sub(bufferTarget))
+		buffer = bufferTarget
+	}
+
+	refill := sdk.MinInt(bufferTarget.Sub(buffer), available)
+	buffer = buffer.Add(refill)
+	available = available.Sub(refill)
+
+	for i, strategyType := range allowedVault.Strategies {
+		deficit := sdk.MinInt(targets[i].Sub(values[i]), available)
+		if !deficit.IsPositive() {
+			continue
+		}
+
+		strategy, err := k.GetStrategy(strategyType)
+		if err != nil {
+			return types.ErrInvalidVaultStrategy
+		}
+
+		if err := strategy.Deposit(ctx, sdk.NewCoin(denom, deficit)); err != nil {
+			return errorsmod.Wrapf(err, "failed to deposit to strategy %s", strategyType)
+		}
+
+		available = available.Sub(deficit)
+	}
+
+	// Any remainder from rounding stays idle in the buffer, along with any
+	// amounts the strategies added to the buffer while rebalancing
+	if hasRecord {
+		updated, _ := k.GetVaultRecord(ctx, denom)
+		strategyAdjustment := updated.GetBuffer().Sub(vaultRecord.GetBuffer())
+
+		updated.Buffer = buffer.Add(available).Add(strategyAdjustment)
+		k.SetVaultRecord(ctx, updated)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultRebalance,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, moved.String()),
+		),
+	)
+
+	return nil
+}
+
+// depositToVault holds part of amount in the module account to fill the
+// vault's liquidity buffer to its target and deposits the rest to the vault's
+// strategies. The vault record is updated with the new buffer.
+func (k *Keeper) depositToVault(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	vaultRecord *types.VaultRecord,
+	amount sdk.Coin,
+) error {
+	bufferAmount := sdk.ZeroInt()
+	if liquidityBuffer := allowedVault.GetLiquidityBuffer(); liquidityBuffer.IsPositive() {
+		totalValue, err := k.GetVaultTotalValue(ctx, amount.Denom)
+		if err != nil {
+			return err
+		}
+
+		target := sdk.NewDecFromInt(totalValue.Amount.Add(amount.Amount)).Mul(liquidityBuffer).TruncateInt()
+		bufferAmount = sdk.MinInt(sdk.MaxInt(target.Sub(vaultRecord.GetBuffer()), sdk.ZeroInt()), amount.Amount)
+	}
+
+	vaultRecord.Buffer = vaultRecord.GetBuffer().Add(bufferAmount)
+	k.SetVaultRecord(ctx, *vaultRecord)
+
+	if err := k.depositToStrategies(ctx, allowedVault, amount.SubAmount(bufferAmount)); err != nil {
+		return err
+	}
+
+	// Reload the record as strategies may add remainders to the buffer
+	*vaultRecord, _ = k.GetVaultRecord(ctx, amount.Denom)
+	return nil
+}
+
+// withdrawFromVault withdraws amount into the module account, first from the
+// vault's liquidity buffer and then from its strategies. The vault record is
+// updated with the new buffer.
+func (k *Keeper) withdrawFromVault(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	vaultRecord *types.VaultRecord,
+	amount sdk.Coin,
+) error {
+	fromBuffer := sdk.MinInt(vaultRecord.GetBuffer(), amount.Amount)
+	vaultRecord.Buffer = vaultRecord.GetBuffer().Sub(fromBuffer)
+	k.SetVaultRecord(ctx, *vaultRecord)
+
+	fromStrategies := amount.SubAmount(fromBuffer)
+	if fromStrategies.IsZero() {
+		return nil
+	}
+
+	if err := k.withdrawFromStrategies(ctx, allowedVault, fromStrategies); err != nil {
+		return err
+	}
+
+	// Reload the record as strategies may adjust the buffer by the difference
+	// between the amount withdrawn and the amount requested
+	*vaultRecord, _ = k.GetVaultRecord(ctx, amount.Denom)
+	return nil
+}
+
+// adjustVaultBuffer adds amount, which may be negative, to the liquidity
+// buffer of the vault for denom. This is used by strategies to account for
+// coins left in the module account after depositing or withdrawing.
+func (k *Keeper) adjustVaultBuffer(ctx sdk.Context, denom string, amount sdkmath.Int) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	vaultRecord, found := k.GetVaultRecord(ctx, denom)
+	if !found {
+		return types.ErrVaultRecordNotFound
+	}
+
+	buffer := vaultRecord.GetBuffer().Add(amount)
+	if buffer.IsNegative() {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"vault buffer %s less than %s",
+			sdk.NewCoin(denom, vaultRecord.GetBuffer()),
+			sdk.NewCoin(denom, amount.Neg()),
+		)
+	}
+
+	vaultRecord.Buffer = buffer
+	k.SetVaultRecord(ctx, vaultRecord)
+
+	return nil
+}
+
+// depositToStrategies splits amount between the strategies of a vault by
+// their target weights and deposits each part.
+func (k *Keeper) depositToStrategies(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	amount sdk.Coin,
+) error {
+	parts := splitByWeights(amount.Amount, allowedVault.GetStrategyWeights())
+
+	for i, strategyType := range allowedVault.Strategies {
+		if !parts[i].IsPositive() {
+			continue
+		}
+
+		strategy, err := k.GetStrategy(strategyType)
+		if err != nil {
+			return err
+		}
+
+		if err := strategy.Deposit(ctx, sdk.NewCoin(amount.Denom, parts[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withdrawFromStrategies withdraws amount from the strategies of a vault in
+// the order they are listed, taking as much as possible from each strategy
+// before moving to the next.
+func (k *Keeper) withdrawFromStrategies(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	amount sdk.Coin,
+) error {
+	values, err := k.GetVaultStrategyValues(ctx, allowedVault, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	remaining := amount.Amount
+	for i, strategyType := range allowedVault.Strategies {
+		if remaining.IsZero() {
+			break
+		}
+
+		withdrawAmount := sdk.MinInt(remaining, values[i])
+		if !withdrawAmount.IsPositive() {
+			continue
+		}
+
+		strategy, err := k.GetStrategy(strategyType)
+		if err != nil {
+			return err
+		}
+
+		if err := strategy.Withdraw(ctx, sdk.NewCoin(amount.Denom, withdrawAmount)); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(withdrawAmount)
+	}
+
+	if remaining.IsPositive() {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"vault strategies hold %s less than withdraw amount %s",
+			sdk.NewCoin(amount.Denom, remaining),
+			amount,
+		)
+	}
+
+	return nil
+}
+
+// splitByWeights splits amount into parts proportional to weights. The
+// remainder from truncation is added to the part with the largest weight.
+func splitByWeights(amount sdkmath.Int, weights []sdk.Dec) []sdkmath.Int {
+	parts := make([]sdkmath.Int, len(weights))
+	if len(weights) == 0 {
+		return parts
+	}
+
+	remaining := amount
+	largest := 0
+	for i, weight := range weights {
+		parts[i] = sdk.NewDecFromInt(amount).Mul(weight).TruncateInt()
+		remaining = remaining.Sub(parts[i])
+
+		if weight.GT(weights[largest]) {
+			largest = i
+		}
+	}
+
+	parts[largest] = parts[largest].Add(remaining)
+
+	return parts
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const allocationVaultDenom = "usdx"
+
+type allocationTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *allocationTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.setStrategyWeights("0.6", "0.4")
+}
+
+func TestAllocationTestSuite(t *testing.T) {
+	suite.Run(t, new(allocationTestSuite))
+}
+
+// setStrategyWeights sets a usdx vault with the hard and savings strategies
+// and the given weights.
+func (suite *allocationTestSuite) setStrategyWeights(hardWeight, savingsWeight string) {
+	vault := types.NewAllowedVault(
+		allocationVaultDenom,
+		types.StrategyTypes{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+		false,
+		nil,
+	)
+	vault.StrategyWeights = []sdk.Dec{
+		sdk.MustNewDecFromStr(hardWeight),
+		sdk.MustNewDecFromStr(savingsWeight),
+	}
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func (suite *allocationTestSuite) strategyValuesEqual(expected ...int64) {
+	vault, found := suite.Keeper.GetAllowedVault(suite.Ctx, allocationVaultDenom)
+	suite.Require().True(found)
+
+	values, err := suite.Keeper.GetVaultStrategyValues(suite.Ctx, vault, allocationVaultDenom)
+	suite.Require().NoError(err)
+
+	expectedValues := make([]sdkmath.Int, len(expected))
+	for i, amount := range expected {
+		expectedValues[i] = sdkmath.NewInt(amount)
+	}
+
+	suite.Equal(expectedValues, values)
+}
+
+func (suite *allocationTestSuite) TestDeposit_SplitByWeights() {
+	depositAmount := sdk.NewInt64Coin(allocationVaultDenom, 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	// Either strategy of the vault can be specified
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(allocationVaultDenom, 600)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(allocationVaultDenom, 400)))
+	suite.strategyValuesEqual(600, 400)
+
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, allocationVaultDenom)
+	suite.Require().NoError(err)
+	suite.Equal(depositAmount, totalValue)
+}
+
+func (suite *allocationTestSuite) TestDeposit_RemainderToLargestWeight() {
+	depositAmount := sdk.NewInt64Coin(allocationVaultDenom, 999)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.strategyValuesEqual(600, 399)
+}
+
+func (suite *allocationTestSuite) TestDeposit_ZeroWeight() {
+	suite.setStrategyWeights("1", "0")
+
+	depositAmount := sdk.NewInt64Coin(allocationVaultDenom, 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.strategyValuesEqual(1000, 0)
+}
+
+func (suite *allocationTestSuite) TestWithdraw_InStrategyOrder() {
+	depositAmount := sdk.NewInt64Coin(allocationVaultDenom, 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// Less than the first strategy only withdraws from the first strategy
+	withdrawAmount := sdk.NewInt64Coin(allocationVaultDenom, 100)
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.strategyValuesEqual(500, 400)
+
+	// More than the first strategy empties it and withdraws the rest from the
+	// next strategy
+	withdrawAmount = sdk.NewInt64Coin(allocationVaultDenom, 700)
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.strategyValuesEqual(0, 200)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(allocationVaultDenom, 800)))
+
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, allocationVaultDenom)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(allocationVaultDenom, 200), totalValue)
+}
+
+func (suite *allocationTestSuite) TestRebalanceVault() {
+	depositAmount := sdk.NewInt64Coin(allocationVaultDenom, 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.setStrategyWeights("0.2", "0.8")
+
+	err = suite.Keeper.RebalanceVault(suite.Ctx, allocationVaultDenom)
+	suite.Require().NoError(err)
+
+	suite.strategyValuesEqual(200, 800)
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			types.EventTypeVaultRebalance,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, allocationVaultDenom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "400"),
+		),
+	)
+
+	// Total value is unchanged
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, allocationVaultDenom)
+	suite.Require().NoError(err)
+	suite.Equal(depositAmount, totalValue)
+
+	// Rebalancing a balanced vault moves nothing
+	err = suite.Keeper.RebalanceVault(suite.Ctx, allocationVaultDenom)
+	suite.Require().NoError(err)
+
+	suite.strategyValuesEqual(200, 800)
+}
+
+func (suite *allocationTestSuite) TestRebalanceVault_VaultNotFound() {
+	err := suite.Keeper.RebalanceVault(suite.Ctx, "busd")
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+}
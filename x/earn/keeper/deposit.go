@@ -37,21 +37,19 @@ func (k *Keeper) Deposit(
 		return types.ErrAccountDepositNotAllowed
 	}
 
+	// Accrue fees so the deposit is priced after fees are charged
+	if err := k.AccrueVaultFees(ctx, amount.Denom); err != nil {
+		return err
+	}
+
 	// Check if VaultRecord exists, create if not exist
 	vaultRecord, found := k.GetVaultRecord(ctx, amount.Denom)
 	if !found {
-		// Create a new VaultRecord with 0 supply
+		// Create a new VaultRecord with 0 supply. Shares are issued 1:1 for
+		// the first deposit, so fees accrue from a share price of 1.
 		vaultRecord = types.NewVaultRecord(amount.Denom, sdk.ZeroDec())
-	}
-
-	// Get the strategy for the vault
-	// NOTE: Currently always uses the first one, AllowedVaults are currently
-	// only valid with 1 and only 1 strategy so this is safe.
-	// If/When multiple strategies are supported and users can specify specific
-	// strategies, shares should be issued per-strategy instead of per-vault.
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
-	if err != nil {
-		return err
+		vaultRecord.HighWaterMark = sdk.OneDec()
+		vaultRecord.LastFeeAccrualTime = ctx.BlockTime()
 	}
 
 	// Transfer amount to module account
@@ -96,8 +94,10 @@ func (k *Keeper) Deposit(
 		k.AfterVaultDepositCreated(ctx, amount.Denom, depositor, shares.Amount)
 	}
 
-	// Deposit to the strategy
-	if err := strategy.Deposit(ctx, amount); err != nil {
+	// Fill the liquidity buffer and deposit the rest to the strategies, split
+	// by their target weights. Shares are issued per-vault, so the strategy in
+	// the message only needs to be allowed by the vault.
+	if err := k.depositToVault(ctx, allowedVault, &vaultRecord, amount); err != nil {
 		return err
 	}
 
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+const secondsPerYear = 31536000
+
+// AccrueVaultFees mints vault shares to the vault's fee recipient for the
+// management fee since the last accrual and the performance fee on share price
+// growth above the high-water mark. Fees are charged by diluting existing
+// shares, so no funds leave the vault strategies.
+func (k *Keeper) AccrueVaultFees(ctx sdk.Context, vaultDenom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, vaultDenom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	vaultRecord, found := k.GetVaultRecord(ctx, vaultDenom)
+	if !found || vaultRecord.TotalShares.Amount.IsZero() {
+		// No shares to charge fees on
+		return nil
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, vaultDenom)
+	if err != nil {
+		return err
+	}
+
+	value := sdk.NewDecFromInt(totalValue.Amount)
+	totalShares := vaultRecord.TotalShares.Amount
+	sharePrice := value.Quo(totalShares)
+
+	// Records from before fees were supported start accruing from now
+	if vaultRecord.LastFeeAccrualTime.IsZero() || vaultRecord.HighWaterMark.IsNil() {
+		vaultRecord.HighWaterMark = sharePrice
+		vaultRecord.LastFeeAccrualTime = ctx.BlockTime()
+		k.SetVaultRecord(ctx, vaultRecord)
+
+		return nil
+	}
+
+	elapsed := ctx.BlockTime().Sub(vaultRecord.LastFeeAccrualTime)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	// managementFee = totalValue * annualFee * elapsedSeconds / secondsPerYear
+	managementFee := value.
+		Mul(allowedVault.GetManagementFee()).
+		MulInt64(int64(elapsed / time.Second)).
+		QuoInt64(secondsPerYear)
+
+	// performanceFee = (sharePrice - highWaterMark) * totalShares * fee
+	performanceFee := sdk.ZeroDec()
+	if sharePrice.GT(vaultRecord.HighWaterMark) {
+		performanceFee = sharePrice.
+			Sub(vaultRecord.HighWaterMark).
+			Mul(totalShares).
+			Mul(allowedVault.GetPerformanceFee())
+	}
+
+	vaultRecord.LastFeeAccrualTime = ctx.BlockTime()
+
+	// Shares are minted so the recipient owns the fee value after dilution:
+	// feeShares / (totalShares + feeShares) = fee / totalValue
+	// feeShares = fee * totalShares / (totalValue - fee)
+	fee := managementFee.Add(performanceFee)
+	feeShares := sdk.ZeroDec()
+	if fee.IsPositive() && fee.LT(value) && !allowedVault.FeeRecipient.Empty() {
+		feeShares = fee.Mul(totalShares).QuoTruncate(value.Sub(fee))
+	}
+
+	// The high-water mark only increases, to the share price after fees
+	if priceAfterFees := value.Quo(totalShares.Add(feeShares)); priceAfterFees.GT(vaultRecord.HighWaterMark) {
+		vaultRecord.HighWaterMark = priceAfterFees
+	}
+
+	if !feeShares.IsPositive() {
+		k.SetVaultRecord(ctx, vaultRecord)
+		return nil
+	}
+
+	k.mintFeeShares(ctx, vaultRecord, allowedVault.FeeRecipient, types.NewVaultShare(vaultDenom, feeShares))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultFee,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+			sdk.NewAttribute(types.AttributeKeyRecipient, allowedVault.FeeRecipient.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, feeShares.String()),
+			sdk.NewAttribute(types.AttributeKeyManagement, managementFee.TruncateInt().String()),
+			sdk.NewAttribute(types.AttributeKeyPerformance, performanceFee.TruncateInt().String()),
+		),
+	)
+
+	return nil
+}
+
+// AccrueAllVaultFees accrues fees for all vaults that have not accrued fees
+// within the FeeAccrualInterval. Errors are logged and do not stop other
+// vaults from accruing.
+func (k *Keeper) AccrueAllVaultFees(ctx sdk.Context) {
+	var denoms []string
+	k.IterateVaultRecords(ctx, func(record types.VaultRecord) bool {
+		if ctx.BlockTime().Sub(record.LastFeeAccrualTime) >= types.FeeAccrualInterval {
+			denoms = append(denoms, record.TotalShares.Denom)
+		}
+
+		return false
+	})
+
+	for _, denom := range denoms {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.AccrueVaultFees(cacheCtx, denom); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to accrue fees for vault %s: %s", denom, err))
+			continue
+		}
+
+		writeCache()
+	}
+}
+
+// mintFeeShares adds shares to the vault record and the recipient's share
+// record.
+func (k *Keeper) mintFeeShares(
+	ctx sdk.Context,
+	vaultRecord types.VaultRecord,
+	recipient sdk.AccAddress,
+	shares types.VaultShare,
+) {
+	vaultRecord.TotalShares = vaultRecord.TotalShares.Add(shares)
+	k.SetVaultRecord(ctx, vaultRecord)
+
+	k.addAccountShares(ctx, recipient, shares)
+}
+
+// addAccountShares adds shares to an account's share record, calling the
+// deposit hooks the same as a deposit would. The vault record total shares are
+// not modified.
+func (k *Keeper) addAccountShares(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	shares types.VaultShare,
+) {
+	vaultShareRecord, found := k.GetVaultShareRecord(ctx, owner)
+	if !found {
+		vaultShareRecord = types.NewVaultShareRecord(owner, types.NewVaultShares())
+	}
+
+	currentShares := vaultShareRecord.Shares.AmountOf(shares.Denom)
+	isNew := currentShares.IsZero()
+	if !isNew {
+		k.BeforeVaultDepositModified(ctx, shares.Denom, owner, currentShares)
+	}
+
+	vaultShareRecord.Shares = vaultShareRecord.Shares.Add(shares)
+	k.SetVaultShareRecord(ctx, vaultShareRecord)
+
+	if isNew {
+		k.AfterVaultDepositCreated(ctx, shares.Denom, owner, shares.Amount)
+	}
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const feeVaultDenom = "usdx"
+
+type feesTestSuite struct {
+	testutil.Suite
+
+	recipient sdk.AccAddress
+}
+
+func (suite *feesTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.recipient = sdk.AccAddress("fee_recipient_______")
+}
+
+func TestFeesTestSuite(t *testing.T) {
+	suite.Run(t, new(feesTestSuite))
+}
+
+func (suite *feesTestSuite) setFees(performanceFee, managementFee string, recipient sdk.AccAddress) {
+	vault := types.NewAllowedVault(feeVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	vault.PerformanceFee = sdk.MustNewDecFromStr(performanceFee)
+	vault.ManagementFee = sdk.MustNewDecFromStr(managementFee)
+	vault.FeeRecipient = recipient
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func (suite *feesTestSuite) deposit(amount int64) sdk.AccAddress {
+	depositAmount := sdk.NewInt64Coin(feeVaultDenom, amount)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	return acc.GetAddress()
+}
+
+func (suite *feesTestSuite) recipientShares() sdk.Dec {
+	record, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, suite.recipient)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	return record.Shares.AmountOf(feeVaultDenom)
+}
+
+func (suite *feesTestSuite) TestDeposit_InitializesFeeState() {
+	suite.setFees("0.2", "0.1", suite.recipient)
+	suite.deposit(1000)
+
+	record, found := suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.OneDec(), record.HighWaterMark)
+	suite.Equal(suite.Ctx.BlockTime(), record.LastFeeAccrualTime)
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_Management() {
+	suite.setFees("0", "0.1", suite.recipient)
+	suite.deposit(1000)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+
+	err := suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	// 10% of 1000 for a year is 100, minted as shares that dilute the
+	// existing 1000 shares: 100 * 1000 / (1000 - 100)
+	expectedShares := sdk.MustNewDecFromStr("111.111111111111111111")
+	suite.Equal(expectedShares, suite.recipientShares())
+
+	value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, feeVaultDenom, suite.recipient)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(feeVaultDenom, 99), value, "fee value should be 100 less truncation")
+
+	record, found := suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(1000).Add(expectedShares), record.TotalShares.Amount)
+	suite.Equal(suite.Ctx.BlockTime(), record.LastFeeAccrualTime)
+	// Share price dropped from fees, high water mark is unchanged
+	suite.Equal(sdk.OneDec(), record.HighWaterMark)
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			types.EventTypeVaultFee,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, feeVaultDenom),
+			sdk.NewAttribute(types.AttributeKeyRecipient, suite.recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, expectedShares.String()),
+			sdk.NewAttribute(types.AttributeKeyManagement, "100"),
+			sdk.NewAttribute(types.AttributeKeyPerformance, "0"),
+		),
+	)
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_Performance() {
+	suite.setFees("0.2", "0", suite.recipient)
+	suite.deposit(1000)
+
+	// Share price of 1 is a gain of 0.5 per share above the high water mark
+	record, found := suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	record.HighWaterMark = sdk.MustNewDecFromStr("0.5")
+	suite.Keeper.SetVaultRecord(suite.Ctx, record)
+
+	err := suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	// 20% of a 500 gain is 100: 100 * 1000 / (1000 - 100)
+	expectedShares := sdk.MustNewDecFromStr("111.111111111111111111")
+	suite.Equal(expectedShares, suite.recipientShares())
+
+	// High water mark is the share price after fees, 1000 / 1111.11
+	record, found = suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.MustNewDecFromStr("0.900000000000000000"), record.HighWaterMark)
+
+	// No further fee without growth above the new high water mark
+	err = suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+	suite.Equal(expectedShares, suite.recipientShares())
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_NoFees() {
+	suite.setFees("0", "0", nil)
+	suite.deposit(1000)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+
+	err := suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	record, found := suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(1000), record.TotalShares.Amount)
+	suite.Equal(suite.Ctx.BlockTime(), record.LastFeeAccrualTime)
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_InitializesExistingRecord() {
+	suite.setFees("0.2", "0.1", suite.recipient)
+	suite.deposit(1000)
+
+	// Records from before fees were supported have no fee state
+	record, found := suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Keeper.SetVaultRecord(suite.Ctx, types.NewVaultRecord(feeVaultDenom, record.TotalShares.Amount))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+
+	err := suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	suite.True(suite.recipientShares().IsZero(), "no fees should be charged before fee state is set")
+
+	record, found = suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.OneDec(), record.HighWaterMark)
+	suite.Equal(suite.Ctx.BlockTime(), record.LastFeeAccrualTime)
+}
+
+func (suite *feesTestSuite) TestWithdraw_AccruesFees() {
+	suite.setFees("0", "0.1", suite.recipient)
+	depositor := suite.deposit(1000)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+
+	// The depositor only owns 900 after the management fee is charged
+	_, err := suite.Keeper.Withdraw(
+		suite.Ctx,
+		depositor,
+		sdk.NewInt64Coin(feeVaultDenom, 1000),
+		types.STRATEGY_TYPE_SAVINGS,
+	)
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, feeVaultDenom, depositor)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(feeVaultDenom, 900), value)
+
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, depositor, value, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+	// Withdrawn amount is truncated by the share conversion
+	suite.True(value.Sub(withdrawn).Amount.LTE(sdk.OneInt()), "withdrawn %s", withdrawn)
+}
+
+func (suite *feesTestSuite) TestAccrueAllVaultFees_Interval() {
+	suite.setFees("0", "0.1", suite.recipient)
+	suite.deposit(1000)
+
+	startTime := suite.Ctx.BlockTime()
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.FeeAccrualInterval - time.Second))
+	suite.Keeper.AccrueAllVaultFees(suite.Ctx)
+	suite.True(suite.recipientShares().IsZero(), "fees should not accrue before the interval")
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.FeeAccrualInterval))
+	suite.Keeper.AccrueAllVaultFees(suite.Ctx)
+	suite.True(suite.recipientShares().IsPositive(), "fees should accrue after the interval")
+
+	record, found := suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockTime(), record.LastFeeAccrualTime)
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/earn/types"
@@ -84,6 +85,12 @@ func (s queryServer) Vaults(
 			return true
 		}
 
+		strategyValues, err := s.keeper.GetVaultStrategyValues(sdkCtx, allowedVault, record.TotalShares.Denom)
+		if err != nil {
+			vaultRecordsErr = err
+			return true
+		}
+
 		vaults = append(vaults, types.VaultResponse{
 			Denom:             record.TotalShares.Denom,
 			Strategies:        allowedVault.Strategies,
@@ -91,6 +98,8 @@ func (s queryServer) Vaults(
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 			TotalShares:       record.TotalShares.Amount.String(),
 			TotalValue:        totalValue.Amount,
+			StrategyWeights:   allowedVault.GetStrategyWeights(),
+			StrategyValues:    strategyValues,
 		})
 
 		// Mark this allowed vault as visited
@@ -122,8 +131,10 @@ func (s queryServer) Vaults(
 			IsPrivateVault:    allowedVault.IsPrivateVault,
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 			// No shares, no value
-			TotalShares: sdk.ZeroDec().String(),
-			TotalValue:  sdk.ZeroInt(),
+			TotalShares:     sdk.ZeroDec().String(),
+			TotalValue:      sdk.ZeroInt(),
+			StrategyWeights: allowedVault.GetStrategyWeights(),
+			StrategyValues:  zeroStrategyValues(len(allowedVault.Strategies)),
 		})
 	}
 
@@ -172,6 +183,11 @@ func (s queryServer) Vault(
 		return nil, err
 	}
 
+	strategyValues, err := s.keeper.GetVaultStrategyValues(sdkCtx, allowedVault, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
 	vault := types.VaultResponse{
 		// VaultRecord denom instead of AllowedVault.Denom for full bkava denom
 		Denom:             vaultRecord.TotalShares.Denom,
@@ -180,6 +196,8 @@ func (s queryServer) Vault(
 		AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 		TotalShares:       vaultRecord.TotalShares.Amount.String(),
 		TotalValue:        totalValue.Amount,
+		StrategyWeights:   allowedVault.GetStrategyWeights(),
+		StrategyValues:    strategyValues,
 	}
 
 	return &types.QueryVaultResponse{
@@ -231,6 +249,9 @@ func (s queryServer) getAggregateBkavaVault(
 			// Empty for shares, as adding up all shares is not useful information
 			TotalShares: "0",
 			TotalValue:  vaultValue.Amount,
+			// Strategy values are omitted as they are not aggregated across
+			// bkava denoms
+			StrategyWeights: allowedVault.GetStrategyWeights(),
 		},
 	}, nil
 }
@@ -336,6 +357,108 @@ func (s queryServer) TotalSupply(
 	}, vaultRecordErr
 }
 
+// WithdrawalRequests implements the gRPC service handler for querying x/earn
+// queued withdrawal requests.
+func (s queryServer) WithdrawalRequests(
+	ctx context.Context,
+	req *types.QueryWithdrawalRequestsRequest,
+) (*types.QueryWithdrawalRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var owner sdk.AccAddress
+	if req.Owner != "" {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid address")
+		}
+	}
+
+	// Positions are counted per vault, so all requests are iterated even when
+	// filtering
+	positions := make(map[string]uint64)
+	requests := []types.WithdrawalRequestResponse{}
+
+	var iterErr error
+	s.keeper.IterateWithdrawalRequests(sdkCtx, func(request types.WithdrawalRequest) bool {
+		denom := request.Shares.Denom
+		positions[denom]++
+
+		if owner != nil && !request.Owner.Equals(owner) {
+			return false
+		}
+
+		if req.Denom != "" && req.Denom != denom {
+			return false
+		}
+
+		value, err := s.keeper.ConvertToAssets(sdkCtx, request.Shares)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		requests = append(requests, types.WithdrawalRequestResponse{
+			ID:       request.ID,
+			Owner:    request.Owner.String(),
+			Shares:   request.Shares,
+			Value:    value,
+			Position: positions[denom],
+		})
+
+		return false
+	})
+
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	return &types.QueryWithdrawalRequestsResponse{
+		Requests: requests,
+	}, nil
+}
+
+// PendingWithdrawals implements the gRPC service handler for querying the
+// total queued withdrawals of x/earn vaults.
+func (s queryServer) PendingWithdrawals(
+	ctx context.Context,
+	req *types.QueryPendingWithdrawalsRequest,
+) (*types.QueryPendingWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	shares := types.NewVaultShares()
+	s.keeper.IterateWithdrawalRequests(sdkCtx, func(request types.WithdrawalRequest) bool {
+		if req.Denom == "" || req.Denom == request.Shares.Denom {
+			shares = shares.Add(request.Shares)
+		}
+
+		return false
+	})
+
+	value := sdk.NewCoins()
+	for _, share := range shares {
+		shareValue, err := s.keeper.ConvertToAssets(sdkCtx, share)
+		if err != nil {
+			return nil, err
+		}
+
+		value = value.Add(shareValue)
+	}
+
+	return &types.QueryPendingWithdrawalsResponse{
+		Shares: shares,
+		Value:  value,
+	}, nil
+}
+
 // getOneAccountOneVaultDeposit returns deposits for a specific vault and a specific
 // account
 func (s queryServer) getOneAccountOneVaultDeposit(
@@ -567,3 +690,13 @@ func addressSliceToStringSlice(addresses []sdk.AccAddress) []string {
 
 	return strings
 }
+
+// zeroStrategyValues returns a zero value for each of count strategies.
+func zeroStrategyValues(count int) []sdkmath.Int {
+	values := make([]sdkmath.Int, count)
+	for i := range values {
+		values[i] = sdk.ZeroInt()
+	}
+
+	return values
+}
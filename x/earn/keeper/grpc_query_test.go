@@ -81,6 +81,8 @@ func (suite *grpcQueryTestSuite) TestVaults_ZeroSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.NewDec(0).String(),
 				TotalValue:        sdkmath.NewInt(0),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				StrategyValues:    []sdkmath.Int{sdkmath.NewInt(0)},
 			},
 			res.Vault,
 		)
@@ -97,6 +99,8 @@ func (suite *grpcQueryTestSuite) TestVaults_ZeroSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.ZeroDec().String(),
 				TotalValue:        sdk.ZeroInt(),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				StrategyValues:    []sdkmath.Int{sdk.ZeroInt()},
 			},
 			{
 				Denom:             "busd",
@@ -105,6 +109,8 @@ func (suite *grpcQueryTestSuite) TestVaults_ZeroSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.ZeroDec().String(),
 				TotalValue:        sdk.ZeroInt(),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				StrategyValues:    []sdkmath.Int{sdk.ZeroInt()},
 			},
 		},
 			res.Vaults,
@@ -145,6 +151,8 @@ func (suite *grpcQueryTestSuite) TestVaults_WithSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.NewDecFromInt(depositAmount.Amount).String(),
 				TotalValue:        depositAmount.Amount,
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				StrategyValues:    []sdkmath.Int{depositAmount.Amount},
 			},
 			{
 				Denom:             vault2Denom,
@@ -153,6 +161,8 @@ func (suite *grpcQueryTestSuite) TestVaults_WithSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.NewDecFromInt(deposit2Amount.Amount).String(),
 				TotalValue:        deposit2Amount.Amount,
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				StrategyValues:    []sdkmath.Int{deposit2Amount.Amount},
 			},
 		},
 		res.Vaults,
@@ -191,6 +201,8 @@ func (suite *grpcQueryTestSuite) TestVaults_MixedSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.ZeroDec().String(),
 				TotalValue:        sdk.ZeroInt(),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				StrategyValues:    []sdkmath.Int{sdk.ZeroInt()},
 			},
 			{
 				Denom:             vault2Denom,
@@ -199,6 +211,8 @@ func (suite *grpcQueryTestSuite) TestVaults_MixedSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.ZeroDec().String(),
 				TotalValue:        sdk.ZeroInt(),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				StrategyValues:    []sdkmath.Int{sdk.ZeroInt()},
 			},
 			{
 				Denom:             vault3Denom,
@@ -207,6 +221,8 @@ func (suite *grpcQueryTestSuite) TestVaults_MixedSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.NewDecFromInt(depositAmount.Amount).String(),
 				TotalValue:        depositAmount.Amount,
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				StrategyValues:    []sdkmath.Int{depositAmount.Amount},
 			},
 		},
 		res.Vaults,
@@ -630,6 +646,8 @@ func (suite *grpcQueryTestSuite) TestVault_bKava_Single() {
 			AllowedDepositors: []string(nil),
 			TotalShares:       "100.000000000000000000",
 			TotalValue:        sdkmath.NewInt(100),
+			StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+			StrategyValues:    []sdkmath.Int{sdkmath.NewInt(100)},
 		},
 		res.Vault,
 	)
@@ -683,8 +701,9 @@ func (suite *grpcQueryTestSuite) TestVault_bKava_Aggregate() {
 			IsPrivateVault:    false,
 			AllowedDepositors: []string(nil),
 			// No shares for aggregate
-			TotalShares: "0",
-			TotalValue:  expectedValue,
+			TotalShares:     "0",
+			TotalValue:      expectedValue,
+			StrategyWeights: []sdk.Dec{sdk.OneDec()},
 		},
 		res.Vault,
 	)
@@ -830,6 +849,160 @@ func (suite *grpcQueryTestSuite) TestTotalSupply() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestWithdrawalRequests() {
+	vault1Denom := "usdx"
+	vault2Denom := "busd"
+
+	suite.CreateVault(vault1Denom, types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	suite.CreateVault(vault2Denom, types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+
+	startBalance := sdk.NewCoins(
+		sdk.NewInt64Coin(vault1Denom, 1000),
+		sdk.NewInt64Coin(vault2Denom, 1000),
+	)
+	acc1 := suite.CreateAccount(startBalance, 0).GetAddress()
+	acc2 := suite.CreateAccount(startBalance, 1).GetAddress()
+
+	for _, acc := range []sdk.AccAddress{acc1, acc2} {
+		for _, denom := range []string{vault1Denom, vault2Denom} {
+			err := suite.Keeper.Deposit(suite.Ctx, acc, sdk.NewInt64Coin(denom, 1000), types.STRATEGY_TYPE_SAVINGS)
+			suite.Require().NoError(err)
+		}
+	}
+
+	for _, request := range []struct {
+		acc    sdk.AccAddress
+		amount sdk.Coin
+	}{
+		{acc1, sdk.NewInt64Coin(vault1Denom, 100)},
+		{acc2, sdk.NewInt64Coin(vault1Denom, 200)},
+		{acc2, sdk.NewInt64Coin(vault2Denom, 300)},
+	} {
+		_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, request.acc, request.amount)
+		suite.Require().NoError(err)
+	}
+
+	request1 := types.WithdrawalRequestResponse{
+		ID:       1,
+		Owner:    acc1.String(),
+		Shares:   types.NewVaultShare(vault1Denom, sdk.NewDec(100)),
+		Value:    sdk.NewInt64Coin(vault1Denom, 100),
+		Position: 1,
+	}
+	request2 := types.WithdrawalRequestResponse{
+		ID:       2,
+		Owner:    acc2.String(),
+		Shares:   types.NewVaultShare(vault1Denom, sdk.NewDec(200)),
+		Value:    sdk.NewInt64Coin(vault1Denom, 200),
+		Position: 2,
+	}
+	request3 := types.WithdrawalRequestResponse{
+		ID:       3,
+		Owner:    acc2.String(),
+		Shares:   types.NewVaultShare(vault2Denom, sdk.NewDec(300)),
+		Value:    sdk.NewInt64Coin(vault2Denom, 300),
+		Position: 1,
+	}
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryWithdrawalRequestsRequest
+		expected []types.WithdrawalRequestResponse
+	}{
+		{
+			name:     "all requests",
+			req:      &types.QueryWithdrawalRequestsRequest{},
+			expected: []types.WithdrawalRequestResponse{request1, request2, request3},
+		},
+		{
+			name:     "owner",
+			req:      &types.QueryWithdrawalRequestsRequest{Owner: acc2.String()},
+			expected: []types.WithdrawalRequestResponse{request2, request3},
+		},
+		{
+			name:     "denom",
+			req:      &types.QueryWithdrawalRequestsRequest{Denom: vault1Denom},
+			expected: []types.WithdrawalRequestResponse{request1, request2},
+		},
+		{
+			name:     "owner and denom",
+			req:      &types.QueryWithdrawalRequestsRequest{Owner: acc2.String(), Denom: vault2Denom},
+			expected: []types.WithdrawalRequestResponse{request3},
+		},
+		{
+			name:     "no requests",
+			req:      &types.QueryWithdrawalRequestsRequest{Denom: "ukava"},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.WithdrawalRequests(sdk.WrapSDKContext(suite.Ctx), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, res.Requests)
+		})
+	}
+
+	_, err := suite.queryClient.WithdrawalRequests(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryWithdrawalRequestsRequest{Owner: "invalid address"},
+	)
+	suite.Require().Error(err)
+	suite.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "Invalid address"))
+}
+
+func (suite *grpcQueryTestSuite) TestPendingWithdrawals() {
+	vault1Denom := "usdx"
+	vault2Denom := "busd"
+
+	suite.CreateVault(vault1Denom, types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	suite.CreateVault(vault2Denom, types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(
+		sdk.NewInt64Coin(vault1Denom, 1000),
+		sdk.NewInt64Coin(vault2Denom, 1000),
+	), 0).GetAddress()
+
+	for _, denom := range []string{vault1Denom, vault2Denom} {
+		err := suite.Keeper.Deposit(suite.Ctx, acc, sdk.NewInt64Coin(denom, 1000), types.STRATEGY_TYPE_SAVINGS)
+		suite.Require().NoError(err)
+	}
+
+	for _, amount := range []sdk.Coin{
+		sdk.NewInt64Coin(vault1Denom, 100),
+		sdk.NewInt64Coin(vault1Denom, 200),
+		sdk.NewInt64Coin(vault2Denom, 300),
+	} {
+		_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc, amount)
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryClient.PendingWithdrawals(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryPendingWithdrawalsRequest{},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewVaultShares(
+		types.NewVaultShare(vault2Denom, sdk.NewDec(300)),
+		types.NewVaultShare(vault1Denom, sdk.NewDec(300)),
+	), res.Shares)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewInt64Coin(vault2Denom, 300),
+		sdk.NewInt64Coin(vault1Denom, 300),
+	), res.Value)
+
+	res, err = suite.queryClient.PendingWithdrawals(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryPendingWithdrawalsRequest{Denom: vault1Denom},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewVaultShares(
+		types.NewVaultShare(vault1Denom, sdk.NewDec(300)),
+	), res.Shares)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(vault1Denom, 300)), res.Value)
+}
+
 // createUnbondedValidator creates an unbonded validator with the given amount of self-delegation.
 func (suite *grpcQueryTestSuite) createUnbondedValidator(address sdk.ValAddress, selfDelegation sdk.Coin, minSelfDelegation sdkmath.Int) error {
 	msg, err := stakingtypes.NewMsgCreateValidator(
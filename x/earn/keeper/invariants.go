@@ -103,6 +103,21 @@ func VaultSharesInvariant(k Keeper) sdk.Invariant {
 			return false
 		})
 
+		// Shares of queued withdrawals are held by the requests
+		k.IterateWithdrawalRequests(ctx, func(request types.WithdrawalRequest) bool {
+			if shares, found := totalShares[request.Shares.Denom]; found {
+				shares.totalSharesOwned = shares.totalSharesOwned.Add(request.Shares)
+				totalShares[request.Shares.Denom] = shares
+			} else {
+				totalShares[request.Shares.Denom] = vaultShares{
+					totalShares:      types.NewVaultShare(request.Shares.Denom, sdk.ZeroDec()),
+					totalSharesOwned: request.Shares,
+				}
+			}
+
+			return false
+		})
+
 		for _, share := range totalShares {
 			if !share.totalShares.Amount.Equal(share.totalSharesOwned.Amount) {
 				broken = true
@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/earn/types"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -19,11 +23,18 @@ type Keeper struct {
 	liquidKeeper  types.LiquidKeeper
 
 	// Keepers used for strategies
-	hardKeeper    types.HardKeeper
-	savingsKeeper types.SavingsKeeper
+	hardKeeper      types.HardKeeper
+	savingsKeeper   types.SavingsKeeper
+	swapKeeper      types.SwapKeeper
+	cdpKeeper       types.CdpKeeper
+	pricefeedKeeper types.PricefeedKeeper
 
 	// Keeper for community pool transfers
 	distKeeper types.DistributionKeeper
+
+	// the address capable of executing a MsgRebalanceVault message. Typically,
+	// this should be the x/gov module account.
+	authority sdk.AccAddress
 }
 
 // NewKeeper creates a new keeper
@@ -36,25 +47,41 @@ func NewKeeper(
 	liquidKeeper types.LiquidKeeper,
 	hardKeeper types.HardKeeper,
 	savingsKeeper types.SavingsKeeper,
+	swapKeeper types.SwapKeeper,
+	cdpKeeper types.CdpKeeper,
+	pricefeedKeeper types.PricefeedKeeper,
 	distKeeper types.DistributionKeeper,
+	authority sdk.AccAddress,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
 
 	return Keeper{
-		key:           key,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		liquidKeeper:  liquidKeeper,
-		hardKeeper:    hardKeeper,
-		savingsKeeper: savingsKeeper,
-		distKeeper:    distKeeper,
+		key:             key,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		liquidKeeper:    liquidKeeper,
+		hardKeeper:      hardKeeper,
+		savingsKeeper:   savingsKeeper,
+		swapKeeper:      swapKeeper,
+		cdpKeeper:       cdpKeeper,
+		pricefeedKeeper: pricefeedKeeper,
+		distKeeper:      distKeeper,
+		authority:       authority,
 	}
 }
 
+// GetAuthority returns the x/earn module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // SetHooks adds hooks to the keeper.
 func (k *Keeper) SetHooks(sh types.EarnHooks) *Keeper {
 	if k.hooks != nil {
@@ -68,3 +95,8 @@ func (k *Keeper) SetHooks(sh types.EarnHooks) *Keeper {
 func (k *Keeper) ClearHooks() {
 	k.hooks = nil
 }
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
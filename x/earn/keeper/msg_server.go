@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/earn/types"
 )
@@ -68,3 +70,83 @@ func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 
 	return &types.MsgWithdrawResponse{}, nil
 }
+
+// RebalanceVault handles MsgRebalanceVault messages
+func (m msgServer) RebalanceVault(goCtx context.Context, msg *types.MsgRebalanceVault) (*types.MsgRebalanceVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.keeper.GetAuthority().String() != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			m.keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	if err := m.keeper.RebalanceVault(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgRebalanceVaultResponse{}, nil
+}
+
+// RequestWithdrawal handles MsgRequestWithdrawal messages
+func (m msgServer) RequestWithdrawal(goCtx context.Context, msg *types.MsgRequestWithdrawal) (*types.MsgRequestWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := m.keeper.RequestWithdrawal(ctx, from, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+
+	return &types.MsgRequestWithdrawalResponse{
+		ID:     request.ID,
+		Shares: request.Shares,
+	}, nil
+}
+
+// CancelWithdrawal handles MsgCancelWithdrawal messages
+func (m msgServer) CancelWithdrawal(goCtx context.Context, msg *types.MsgCancelWithdrawal) (*types.MsgCancelWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.CancelWithdrawal(ctx, from, msg.ID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+
+	return &types.MsgCancelWithdrawalResponse{}, nil
+}
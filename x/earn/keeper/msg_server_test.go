@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/kava-labs/kava/x/earn/keeper"
@@ -137,3 +138,109 @@ func (suite *msgServerTestSuite) TestWithdraw() {
 		),
 	)
 }
+
+func (suite *msgServerTestSuite) TestRebalanceVault() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	authority := suite.Keeper.GetAuthority().String()
+
+	msg := types.NewMsgRebalanceVault(authority, vaultDenom)
+	_, err := suite.msgServer.RebalanceVault(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	// Keeper RebalanceVault()
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			types.EventTypeVaultRebalance,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "0"),
+		),
+	)
+
+	// Msg server module
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, authority),
+		),
+	)
+
+	msg = types.NewMsgRebalanceVault(authority, "busd")
+	_, err = suite.msgServer.RebalanceVault(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	// Accounts other than the authority cannot rebalance
+	acc := suite.CreateAccount(sdk.NewCoins(), 0)
+	msg = types.NewMsgRebalanceVault(acc.GetAddress().String(), vaultDenom)
+	_, err = suite.msgServer.RebalanceVault(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+}
+
+func (suite *msgServerTestSuite) TestRequestAndCancelWithdrawal() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+	requestAmount := sdk.NewInt64Coin(vaultDenom, 40)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	_, err := suite.msgServer.Deposit(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewMsgDeposit(acc.GetAddress().String(), depositAmount, types.STRATEGY_TYPE_HARD),
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.RequestWithdrawal(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewMsgRequestWithdrawal(acc.GetAddress().String(), requestAmount),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgRequestWithdrawalResponse{
+		ID:     1,
+		Shares: types.NewVaultShare(vaultDenom, sdk.NewDec(40)),
+	}, res)
+
+	// Keeper RequestWithdrawal()
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			types.EventTypeWithdrawalRequest,
+			sdk.NewAttribute(types.AttributeKeyRequestID, "1"),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+			sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(40).String()),
+		),
+	)
+
+	// Msg server module
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, acc.GetAddress().String()),
+		),
+	)
+
+	_, err = suite.msgServer.CancelWithdrawal(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewMsgCancelWithdrawal(acc.GetAddress().String(), 1),
+	)
+	suite.Require().NoError(err)
+
+	suite.VaultTotalSharesEqual(types.NewVaultShares(
+		types.NewVaultShare(vaultDenom, sdk.NewDec(100)),
+	))
+
+	_, err = suite.msgServer.CancelWithdrawal(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewMsgCancelWithdrawal(acc.GetAddress().String(), 1),
+	)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalRequestNotFound)
+}
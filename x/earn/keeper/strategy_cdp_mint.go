@@ -0,0 +1,448 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/earn/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// CDPMintStrategy defines the strategy that deposits assets as collateral in
+// a CDP, mints USDX against it and lends the USDX in Hard. The CDP and Hard
+// deposit are owned by the CDP strategy module account.
+//
+// Debt is minted up to CDPStrategyTargetRatioMultiplier times the collateral
+// type's liquidation ratio, and is repaid back to that target in the begin
+// blocker when the position falls below CDPStrategyDeleverageRatioMultiplier
+// times the liquidation ratio. Collateral that is not enough to mint the debt
+// floor is held in the strategy account until more is deposited.
+type CDPMintStrategy Keeper
+
+var _ Strategy = (*CDPMintStrategy)(nil)
+
+// GetStrategyType returns the strategy type
+func (s *CDPMintStrategy) GetStrategyType() types.StrategyType {
+	return types.STRATEGY_TYPE_CDP_MINT
+}
+
+// GetEstimatedTotalAssets returns the strategy collateral plus the value of
+// lent and held USDX in excess of the CDP debt, converted to the collateral
+// denom at the liquidation market price.
+func (s *CDPMintStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	cp, debtParam, err := s.getCDPParams(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	addr := s.getStrategyAddress(ctx)
+
+	collateral := s.bankKeeper.GetBalance(ctx, addr, denom).Amount
+	net := s.bankKeeper.GetBalance(ctx, addr, debtParam.Denom).Amount.Add(s.getLentAmount(ctx, debtParam.Denom))
+
+	cdp, found := s.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, addr, cp.Type)
+	if found {
+		collateral = collateral.Add(cdp.Collateral.Amount)
+		net = net.Sub(s.getTotalDebt(ctx, cdp).Amount)
+	}
+
+	if net.IsZero() {
+		return sdk.NewCoin(denom, collateral), nil
+	}
+
+	price, err := s.getCollateralPrice(ctx, cp, debtParam.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	total := collateral.Add(sdk.NewDecFromInt(net).Quo(price).TruncateInt())
+	if total.IsNegative() {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	return sdk.NewCoin(denom, total), nil
+}
+
+// Deposit moves amount to the strategy account, adds it to the CDP as
+// collateral and mints and lends USDX up to the target collateralization.
+func (s *CDPMintStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	cp, debtParam, err := s.getCDPParams(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	if err := s.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		types.ModuleName,
+		types.CDPStrategyAccountName,
+		sdk.NewCoins(amount),
+	); err != nil {
+		return err
+	}
+
+	return s.deployCollateral(ctx, cp, debtParam)
+}
+
+// Withdraw repays enough debt to keep the CDP at the target collateralization
+// after removing amount of collateral, then moves amount back to the module
+// account. If the remaining debt would be below the debt floor, the CDP is
+// closed and any remaining collateral is redeployed.
+func (s *CDPMintStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	cp, debtParam, err := s.getCDPParams(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	addr := s.getStrategyAddress(ctx)
+	idle := s.bankKeeper.GetBalance(ctx, addr, amount.Denom)
+
+	cdp, found := s.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, addr, cp.Type)
+	if !found || idle.IsGTE(amount) {
+		if idle.IsLT(amount) {
+			return errorsmod.Wrapf(types.ErrInsufficientValue, "cdp strategy holds less than %s", amount)
+		}
+
+		return s.bankKeeper.SendCoinsFromModuleToModule(ctx, types.CDPStrategyAccountName, types.ModuleName, sdk.NewCoins(amount))
+	}
+
+	needed := amount.Sub(idle)
+	remaining := cdp.Collateral.Amount.Sub(needed.Amount)
+
+	price, err := s.getCollateralPrice(ctx, cp, debtParam.Denom)
+	if err != nil {
+		return err
+	}
+
+	closed := false
+	newTarget := s.getTargetDebt(cp, remaining, price)
+	if remaining.IsPositive() && newTarget.GTE(debtParam.DebtFloor) {
+		repay := s.getTotalDebt(ctx, cdp).Amount.Sub(newTarget)
+		if repay.IsPositive() {
+			if err := s.repayDebt(ctx, cp, sdk.NewCoin(debtParam.Denom, repay)); err != nil {
+				return err
+			}
+		}
+
+		if err := s.cdpKeeper.WithdrawCollateral(ctx, addr, addr, needed, cp.Type); err != nil {
+			return err
+		}
+	} else {
+		if err := s.closeCDP(ctx, cp, debtParam, cdp); err != nil {
+			return err
+		}
+
+		closed = true
+	}
+
+	if s.bankKeeper.GetBalance(ctx, addr, amount.Denom).IsLT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "cdp strategy holds less than %s", amount)
+	}
+
+	if err := s.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		types.CDPStrategyAccountName,
+		types.ModuleName,
+		sdk.NewCoins(amount),
+	); err != nil {
+		return err
+	}
+
+	if closed {
+		return s.deployCollateral(ctx, cp, debtParam)
+	}
+
+	return nil
+}
+
+// DeleverageCDPStrategy repays debt of CDP mint strategy positions that are
+// below the deleverage ratio back to the target ratio. Positions that fail to
+// deleverage are skipped and logged.
+func (k *Keeper) DeleverageCDPStrategy(ctx sdk.Context) {
+	for _, vault := range k.GetAllowedVaults(ctx) {
+		if !vault.IsStrategyAllowed(types.STRATEGY_TYPE_CDP_MINT) {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		repaid, err := (*CDPMintStrategy)(k).deleverage(cacheCtx, vault.Denom)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to deleverage cdp strategy for vault %s: %s", vault.Denom, err))
+			continue
+		}
+
+		if repaid.IsZero() {
+			continue
+		}
+
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCDPDeleverage,
+				sdk.NewAttribute(types.AttributeKeyVaultDenom, vault.Denom),
+				sdk.NewAttribute(types.AttributeKeyRepaid, repaid.String()),
+			),
+		)
+	}
+}
+
+// deleverage repays debt of the vault's CDP back to the target ratio if it is
+// below the deleverage ratio, returning the amount repaid.
+func (s *CDPMintStrategy) deleverage(ctx sdk.Context, denom string) (sdkmath.Int, error) {
+	cp, debtParam, err := s.getCDPParams(ctx, denom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	cdp, found := s.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, s.getStrategyAddress(ctx), cp.Type)
+	if !found {
+		return sdk.ZeroInt(), nil
+	}
+
+	price, err := s.getCollateralPrice(ctx, cp, debtParam.Denom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	debt := s.getTotalDebt(ctx, cdp)
+	ratio := sdk.NewDecFromInt(cdp.Collateral.Amount).Mul(price).Quo(sdk.NewDecFromInt(debt.Amount))
+	if ratio.GTE(cp.LiquidationRatio.Mul(types.CDPStrategyDeleverageRatioMultiplier)) {
+		return sdk.ZeroInt(), nil
+	}
+
+	target := s.getTargetDebt(cp, cdp.Collateral.Amount, price)
+	if target.LT(debtParam.DebtFloor) {
+		if err := s.closeCDP(ctx, cp, debtParam, cdp); err != nil {
+			return sdkmath.Int{}, err
+		}
+
+		return debt.Amount, nil
+	}
+
+	repay := debt.Amount.Sub(target)
+	if err := s.repayDebt(ctx, cp, sdk.NewCoin(debtParam.Denom, repay)); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return repay, nil
+}
+
+// deployCollateral adds the collateral held by the strategy account to the
+// CDP, opening one if there is enough collateral to mint the debt floor, then
+// mints up to the target debt and lends all held USDX in Hard.
+func (s *CDPMintStrategy) deployCollateral(
+	ctx sdk.Context,
+	cp cdptypes.CollateralParam,
+	debtParam cdptypes.DebtParam,
+) error {
+	addr := s.getStrategyAddress(ctx)
+	idle := s.bankKeeper.GetBalance(ctx, addr, cp.Denom)
+
+	price, err := s.getCollateralPrice(ctx, cp, debtParam.Denom)
+	if err != nil {
+		return err
+	}
+
+	cdp, found := s.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, addr, cp.Type)
+	if !found {
+		target := s.getTargetDebt(cp, idle.Amount, price)
+		if target.LT(debtParam.DebtFloor) {
+			// Hold collateral until there is enough to open a CDP
+			return nil
+		}
+
+		if err := s.cdpKeeper.AddCdp(ctx, addr, idle, sdk.NewCoin(debtParam.Denom, target), cp.Type); err != nil {
+			return err
+		}
+
+		return s.lendDebt(ctx, debtParam.Denom)
+	}
+
+	if idle.IsPositive() {
+		if err := s.cdpKeeper.DepositCollateral(ctx, addr, addr, idle, cp.Type); err != nil {
+			return err
+		}
+	}
+
+	target := s.getTargetDebt(cp, cdp.Collateral.Amount.Add(idle.Amount), price)
+	draw := target.Sub(s.getTotalDebt(ctx, cdp).Amount)
+	if draw.IsPositive() {
+		if err := s.cdpKeeper.AddPrincipal(ctx, addr, cp.Type, sdk.NewCoin(debtParam.Denom, draw)); err != nil {
+			return err
+		}
+	}
+
+	return s.lendDebt(ctx, debtParam.Denom)
+}
+
+// closeCDP withdraws all lent USDX, repays the full CDP debt which returns
+// the collateral to the strategy account, and swaps any surplus USDX for the
+// collateral denom if a swap pool exists.
+func (s *CDPMintStrategy) closeCDP(
+	ctx sdk.Context,
+	cp cdptypes.CollateralParam,
+	debtParam cdptypes.DebtParam,
+	cdp cdptypes.CDP,
+) error {
+	addr := s.getStrategyAddress(ctx)
+
+	lent := s.getLentAmount(ctx, debtParam.Denom)
+	if lent.IsPositive() {
+		if err := s.hardKeeper.Withdraw(ctx, addr, sdk.NewCoins(sdk.NewCoin(debtParam.Denom, lent))); err != nil {
+			return err
+		}
+	}
+
+	debt := s.getTotalDebt(ctx, cdp)
+	balance := s.bankKeeper.GetBalance(ctx, addr, debtParam.Denom)
+	if balance.IsLT(debt) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"cdp strategy cannot repay debt %s with %s", debt, balance,
+		)
+	}
+
+	if err := s.cdpKeeper.RepayPrincipal(ctx, addr, cp.Type, debt); err != nil {
+		return err
+	}
+
+	surplus := s.bankKeeper.GetBalance(ctx, addr, debtParam.Denom)
+	if !surplus.IsPositive() {
+		return nil
+	}
+
+	record, found := s.swapKeeper.GetPool(ctx, swaptypes.PoolID(cp.Denom, debtParam.Denom))
+	if !found {
+		// Surplus is held and counted in the estimated total assets
+		return nil
+	}
+
+	pool, err := swaptypes.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	if err != nil {
+		return err
+	}
+
+	swapOut, _ := pool.SwapWithExactInput(surplus, s.swapKeeper.GetSwapFee(ctx))
+	if !swapOut.IsPositive() {
+		return nil
+	}
+
+	return s.swapKeeper.SwapExactForTokens(ctx, addr, surplus, swapOut, types.SwapStrategySlippageLimit)
+}
+
+// repayDebt repays the payment of CDP debt, using held USDX first and
+// withdrawing the rest from Hard.
+func (s *CDPMintStrategy) repayDebt(ctx sdk.Context, cp cdptypes.CollateralParam, payment sdk.Coin) error {
+	addr := s.getStrategyAddress(ctx)
+
+	shortfall := payment.Amount.Sub(s.bankKeeper.GetBalance(ctx, addr, payment.Denom).Amount)
+	if shortfall.IsPositive() {
+		withdrawAmount := sdk.MinInt(shortfall, s.getLentAmount(ctx, payment.Denom))
+		if withdrawAmount.IsPositive() {
+			if err := s.hardKeeper.Withdraw(ctx, addr, sdk.NewCoins(sdk.NewCoin(payment.Denom, withdrawAmount))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.cdpKeeper.RepayPrincipal(ctx, addr, cp.Type, payment)
+}
+
+// lendDebt deposits all USDX held by the strategy account to Hard.
+func (s *CDPMintStrategy) lendDebt(ctx sdk.Context, debtDenom string) error {
+	addr := s.getStrategyAddress(ctx)
+
+	balance := s.bankKeeper.GetBalance(ctx, addr, debtDenom)
+	if !balance.IsPositive() {
+		return nil
+	}
+
+	return s.hardKeeper.Deposit(ctx, addr, sdk.NewCoins(balance))
+}
+
+// getCDPParams returns the collateral param of the vault for denom and the
+// CDP debt param.
+func (s *CDPMintStrategy) getCDPParams(
+	ctx sdk.Context,
+	denom string,
+) (cdptypes.CollateralParam, cdptypes.DebtParam, error) {
+	allowedVault, found := (*Keeper)(s).GetAllowedVault(ctx, denom)
+	if !found {
+		return cdptypes.CollateralParam{}, cdptypes.DebtParam{}, types.ErrInvalidVaultDenom
+	}
+
+	cp, found := s.cdpKeeper.GetCollateral(ctx, allowedVault.CdpCollateralType)
+	if !found {
+		return cdptypes.CollateralParam{}, cdptypes.DebtParam{}, fmt.Errorf(
+			"cdp collateral type %s not found", allowedVault.CdpCollateralType,
+		)
+	}
+
+	if cp.Denom != denom {
+		return cdptypes.CollateralParam{}, cdptypes.DebtParam{}, fmt.Errorf(
+			"cdp collateral type %s denom %s does not match vault denom %s", cp.Type, cp.Denom, denom,
+		)
+	}
+
+	return cp, s.cdpKeeper.GetParams(ctx).DebtParam, nil
+}
+
+// getStrategyAddress returns the address of the CDP strategy module account,
+// creating the account if it does not exist.
+func (s *CDPMintStrategy) getStrategyAddress(ctx sdk.Context) sdk.AccAddress {
+	return s.accountKeeper.GetModuleAccount(ctx, types.CDPStrategyAccountName).GetAddress()
+}
+
+// getCollateralPrice returns the value of one unit of collateral in units of
+// the debt denom, using the collateral type's liquidation market price.
+func (s *CDPMintStrategy) getCollateralPrice(
+	ctx sdk.Context,
+	cp cdptypes.CollateralParam,
+	debtDenom string,
+) (sdk.Dec, error) {
+	price, err := s.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	unitRatio := s.cdpKeeper.CalculateCollateralToDebtRatio(
+		ctx,
+		sdk.NewCoin(cp.Denom, sdk.OneInt()),
+		cp.Type,
+		sdk.NewCoin(debtDenom, sdk.OneInt()),
+	)
+
+	collateralPrice := unitRatio.Mul(price.Price)
+	if !collateralPrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("invalid collateral price for %s", cp.Type)
+	}
+
+	return collateralPrice, nil
+}
+
+// getTargetDebt returns the debt for the collateral amount at the target
+// collateralization ratio.
+func (s *CDPMintStrategy) getTargetDebt(cp cdptypes.CollateralParam, collateral sdkmath.Int, price sdk.Dec) sdkmath.Int {
+	targetRatio := cp.LiquidationRatio.Mul(types.CDPStrategyTargetRatioMultiplier)
+	return sdk.NewDecFromInt(collateral).Mul(price).Quo(targetRatio).TruncateInt()
+}
+
+// getTotalDebt returns the CDP principal, fees and interest not yet synced.
+func (s *CDPMintStrategy) getTotalDebt(ctx sdk.Context, cdp cdptypes.CDP) sdk.Coin {
+	return cdp.GetTotalPrincipal().Add(s.cdpKeeper.CalculateNewInterest(ctx, cdp))
+}
+
+// getLentAmount returns the amount of debt denom lent in Hard by the strategy
+// account.
+func (s *CDPMintStrategy) getLentAmount(ctx sdk.Context, debtDenom string) sdkmath.Int {
+	deposit, found := s.hardKeeper.GetSyncedDeposit(ctx, s.getStrategyAddress(ctx))
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return deposit.Amount.AmountOf(debtDenom)
+}
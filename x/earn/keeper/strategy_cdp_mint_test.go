@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn"
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type strategyCDPMintTestSuite struct {
+	testutil.Suite
+
+	vaultDenom string
+}
+
+func (suite *strategyCDPMintTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	suite.vaultDenom = "bnb"
+
+	vault := types.NewAllowedVault(suite.vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_CDP_MINT}, false, nil)
+	vault.CdpCollateralType = "bnb-a"
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func TestStrategyCDPMintTestSuite(t *testing.T) {
+	suite.Run(t, new(strategyCDPMintTestSuite))
+}
+
+func (suite *strategyCDPMintTestSuite) strategyAddress() sdk.AccAddress {
+	return suite.AccountKeeper.GetModuleAddress(types.CDPStrategyAccountName)
+}
+
+func (suite *strategyCDPMintTestSuite) setBnbPrice(price sdk.Dec) {
+	pricefeedKeeper := suite.App.GetPriceFeedKeeper()
+	_, err := pricefeedKeeper.SetPrice(suite.Ctx, sdk.AccAddress{}, "bnb:usd", price, suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	err = pricefeedKeeper.SetCurrentPrices(suite.Ctx, "bnb:usd")
+	suite.Require().NoError(err)
+}
+
+func (suite *strategyCDPMintTestSuite) TestGetStrategyType() {
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	suite.Equal(types.STRATEGY_TYPE_CDP_MINT, strategy.GetStrategyType())
+}
+
+func (suite *strategyCDPMintTestSuite) TestDeposit() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	// 10 bnb at $10 with a target ratio of 1.5 * 1.5 = 2.25 mints 44.44 usdx
+	expectedDebt := sdk.NewInt64Coin("usdx", 44_444_444)
+
+	cdp, found := suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.Require().True(found)
+	suite.Equal(depositAmount, cdp.Collateral)
+	suite.Equal(expectedDebt, cdp.Principal)
+
+	deposit, found := suite.HardKeeper.GetSyncedDeposit(suite.Ctx, suite.strategyAddress())
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(expectedDebt), deposit.Amount)
+
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+}
+
+func (suite *strategyCDPMintTestSuite) TestDeposit_BelowDebtFloor() {
+	// 2 bnb at $10 mints 8.88 usdx, below the 10 usdx debt floor
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 2_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount.Add(depositAmount)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	_, found := suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.False(found, "cdp should not be opened below the debt floor")
+	suite.AccountBalanceEqual(suite.strategyAddress(), sdk.NewCoins(depositAmount))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+
+	// Second deposit has enough collateral to open the cdp
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	cdp, found := suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.Require().True(found)
+	suite.Equal(depositAmount.Add(depositAmount), cdp.Collateral)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount.Add(depositAmount)))
+}
+
+func (suite *strategyCDPMintTestSuite) TestDeposit_InvalidCollateralType() {
+	vault := types.NewAllowedVault(suite.vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_CDP_MINT}, false, nil)
+	vault.CdpCollateralType = "xrp-a"
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().ErrorContains(err, "cdp collateral type xrp-a not found")
+}
+
+func (suite *strategyCDPMintTestSuite) TestWithdraw_Partial() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	withdrawAmount := sdk.NewInt64Coin(suite.vaultDenom, 5_000_000)
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(withdrawAmount))
+
+	cdp, found := suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.Require().True(found)
+	suite.Equal(withdrawAmount, cdp.Collateral)
+	suite.Equal(sdk.NewInt64Coin("usdx", 22_222_222), cdp.Principal)
+
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount.Sub(withdrawAmount)))
+}
+
+func (suite *strategyCDPMintTestSuite) TestWithdraw_Full() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(depositAmount))
+
+	_, found := suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.False(found, "cdp should be closed after full withdraw")
+	suite.AccountBalanceEqual(suite.strategyAddress(), sdk.NewCoins())
+}
+
+func (suite *strategyCDPMintTestSuite) TestWithdraw_ClosesBelowDebtFloor() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 3_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	// Remaining 2 bnb is below the collateral needed for the debt floor, so
+	// the cdp is closed and the remainder held by the strategy account
+	withdrawAmount := sdk.NewInt64Coin(suite.vaultDenom, 1_000_000)
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	_, found := suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.False(found, "cdp should be closed")
+	suite.AccountBalanceEqual(suite.strategyAddress(), sdk.NewCoins(depositAmount.Sub(withdrawAmount)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount.Sub(withdrawAmount)))
+}
+
+func (suite *strategyCDPMintTestSuite) TestBeginBlocker_Deleverage() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	// Price drop above the deleverage ratio does not repay debt
+	suite.setBnbPrice(sdk.MustNewDecFromStr("9.00"))
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	cdp, found := suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt64Coin("usdx", 44_444_444), cdp.Principal)
+
+	// 10 bnb at $6 is a 1.35 ratio, below the 1.875 deleverage ratio
+	suite.setBnbPrice(sdk.MustNewDecFromStr("6.00"))
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	cdp, found = suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt64Coin("usdx", 26_666_666), cdp.Principal)
+
+	deposit, found := suite.HardKeeper.GetSyncedDeposit(suite.Ctx, suite.strategyAddress())
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("usdx", 26_666_666)), deposit.Amount)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeCDPDeleverage,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, suite.vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyRepaid, sdkmath.NewInt(44_444_444-26_666_666).String()),
+	))
+}
+
+func (suite *strategyCDPMintTestSuite) TestBeginBlocker_PriceDown() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_MINT)
+	suite.Require().NoError(err)
+
+	// Expire prices so the pricefeed has no current price
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(200 * time.Hour))
+	suite.App.GetPriceFeedKeeper().SetCurrentPricesForAllMarkets(suite.Ctx)
+
+	suite.NotPanics(func() {
+		earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	})
+
+	cdp, found := suite.CdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, suite.strategyAddress(), "bnb-a")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt64Coin("usdx", 44_444_444), cdp.Principal)
+}
@@ -0,0 +1,388 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// SwapLPStrategy defines the strategy that provides liquidity to a Swap pool.
+// Deposits swap part of the vault denom for the vault's swap pair denom and
+// deposit both to the pool, withdrawals remove liquidity and swap the pair
+// denom back to the vault denom.
+type SwapLPStrategy Keeper
+
+var _ Strategy = (*SwapLPStrategy)(nil)
+
+// GetStrategyType returns the strategy type
+func (s *SwapLPStrategy) GetStrategyType() types.StrategyType {
+	return types.STRATEGY_TYPE_SWAP_LP
+}
+
+// GetEstimatedTotalAssets returns the value of the module account's pool
+// shares in the vault denom. The shares are valued as if the pool reserves
+// were at the pricefeed price, so the value cannot be moved by swapping
+// against the pool, with the pair denom side valued at the amount received
+// when swapping it back to the vault denom.
+func (s *SwapLPStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	vault, err := s.getSwapVault(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	poolID := swaptypes.PoolID(denom, vault.SwapPairDenom)
+
+	shares, found := s.swapKeeper.GetDepositorSharesAmount(ctx, macc.GetAddress(), poolID)
+	if !found || shares.IsZero() {
+		// Return 0 if no deposit exists for module account
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	pool, err := s.loadPool(ctx, poolID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	price, err := s.getSwapPairPrice(ctx, vault)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	fairPool, err := s.fairPool(pool, denom, vault.SwapPairDenom, price)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return s.simulateWithdraw(ctx, fairPool, shares, denom, vault.SwapPairDenom), nil
+}
+
+// Deposit swaps the portion of amount that balances the pool reserves into
+// the pair denom, then deposits both coins to the pool. Any pair denom not
+// accepted by the pool is swapped back, and the vault denom remainder is
+// added to the vault's liquidity buffer.
+func (s *SwapLPStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	vault, err := s.getSwapVault(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	pairDenom := vault.SwapPairDenom
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	poolID := swaptypes.PoolID(amount.Denom, pairDenom)
+
+	pool, err := s.loadPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkPoolPrice(ctx, pool, vault); err != nil {
+		return err
+	}
+
+	fee := s.swapKeeper.GetSwapFee(ctx)
+	swapIn := sdk.NewCoin(
+		amount.Denom,
+		calculateSwapAmountForDeposit(amount.Amount, pool.Reserves().AmountOf(amount.Denom), fee),
+	)
+	if !swapIn.IsPositive() || swapIn.Amount.GTE(amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientAmount, "deposit %s too small to provide liquidity", amount)
+	}
+
+	// Simulate the swap to get the exact expected output
+	swapOut, _ := pool.SwapWithExactInput(swapIn, fee)
+	if !swapOut.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInsufficientAmount, "deposit %s too small to provide liquidity", amount)
+	}
+
+	balanceBefore := s.bankKeeper.GetBalance(ctx, macc.GetAddress(), amount.Denom)
+	pairBalanceBefore := s.bankKeeper.GetBalance(ctx, macc.GetAddress(), pairDenom)
+
+	if err := s.swapKeeper.SwapExactForTokens(
+		ctx,
+		macc.GetAddress(),
+		swapIn,
+		swapOut,
+		types.SwapStrategySlippageLimit,
+	); err != nil {
+		return err
+	}
+
+	if err := s.swapKeeper.Deposit(
+		ctx,
+		macc.GetAddress(),
+		amount.Sub(swapIn),
+		swapOut,
+		types.SwapStrategySlippageLimit,
+	); err != nil {
+		return err
+	}
+
+	pairLeft := s.bankKeeper.GetBalance(ctx, macc.GetAddress(), pairDenom).Sub(pairBalanceBefore)
+	if err := s.swapToVaultDenom(ctx, poolID, pairLeft); err != nil {
+		return err
+	}
+
+	spent := balanceBefore.Sub(s.bankKeeper.GetBalance(ctx, macc.GetAddress(), amount.Denom))
+	return (*Keeper)(s).adjustVaultBuffer(ctx, amount.Denom, amount.Amount.Sub(spent.Amount))
+}
+
+// Withdraw removes the fewest pool shares that return at least amount after
+// swapping the pair denom back to the vault denom. Any excess is added to the
+// vault's liquidity buffer. If all shares return less than amount, such as
+// when the pool is below the pricefeed price, all shares are removed and the
+// shortfall is taken from the liquidity buffer.
+func (s *SwapLPStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	vault, err := s.getSwapVault(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	pairDenom := vault.SwapPairDenom
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	poolID := swaptypes.PoolID(amount.Denom, pairDenom)
+
+	owned, found := s.swapKeeper.GetDepositorSharesAmount(ctx, macc.GetAddress(), poolID)
+	if !found || owned.IsZero() {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "no %s liquidity to withdraw", poolID)
+	}
+
+	pool, err := s.loadPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkPoolPrice(ctx, pool, vault); err != nil {
+		return err
+	}
+
+	shares := owned
+	if !s.simulateWithdraw(ctx, pool, owned, amount.Denom, pairDenom).IsLT(amount) {
+		// Binary search for the fewest shares that cover the amount
+		low, high := sdk.OneInt(), owned
+		for low.LT(high) {
+			mid := low.Add(high).QuoRaw(2)
+			if s.simulateWithdraw(ctx, pool, mid, amount.Denom, pairDenom).IsLT(amount) {
+				low = mid.AddRaw(1)
+			} else {
+				high = mid
+			}
+		}
+		shares = low
+	}
+
+	balanceBefore := s.bankKeeper.GetBalance(ctx, macc.GetAddress(), amount.Denom)
+	pairBalanceBefore := s.bankKeeper.GetBalance(ctx, macc.GetAddress(), pairDenom)
+
+	withdrawn := s.copyPool(pool).ShareValue(shares)
+	if err := s.swapKeeper.Withdraw(
+		ctx,
+		macc.GetAddress(),
+		shares,
+		sdk.NewCoin(amount.Denom, withdrawn.AmountOf(amount.Denom)),
+		sdk.NewCoin(pairDenom, withdrawn.AmountOf(pairDenom)),
+	); err != nil {
+		return err
+	}
+
+	// Swap the withdrawn pair denom back to the vault denom
+	pairReceived := s.bankKeeper.GetBalance(ctx, macc.GetAddress(), pairDenom).Sub(pairBalanceBefore)
+	if err := s.swapToVaultDenom(ctx, poolID, pairReceived); err != nil {
+		return err
+	}
+
+	received := s.bankKeeper.GetBalance(ctx, macc.GetAddress(), amount.Denom).Sub(balanceBefore)
+	return (*Keeper)(s).adjustVaultBuffer(ctx, amount.Denom, received.Amount.Sub(amount.Amount))
+}
+
+// swapToVaultDenom swaps coin from the module account for the other denom of
+// the pool. Amounts too small to return any coins are left as dust.
+func (s *SwapLPStrategy) swapToVaultDenom(ctx sdk.Context, poolID string, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return nil
+	}
+
+	pool, err := s.loadPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	swapOut, _ := pool.SwapWithExactInput(coin, s.swapKeeper.GetSwapFee(ctx))
+	if !swapOut.IsPositive() {
+		return nil
+	}
+
+	return s.swapKeeper.SwapExactForTokens(
+		ctx,
+		s.accountKeeper.GetModuleAddress(types.ModuleName),
+		coin,
+		swapOut,
+		types.SwapStrategySlippageLimit,
+	)
+}
+
+// getSwapVault returns the allowed vault for denom, which must have a swap
+// pair denom.
+func (s *SwapLPStrategy) getSwapVault(ctx sdk.Context, denom string) (types.AllowedVault, error) {
+	allowedVault, found := (*Keeper)(s).GetAllowedVault(ctx, denom)
+	if !found {
+		return types.AllowedVault{}, types.ErrInvalidVaultDenom
+	}
+
+	if allowedVault.SwapPairDenom == "" {
+		return types.AllowedVault{}, fmt.Errorf("vault %s has no swap pair denom", denom)
+	}
+
+	return allowedVault, nil
+}
+
+// getSwapPairPrice returns the value of one unit of the swap pair denom in
+// units of the vault denom, from the pricefeed prices of the vault's swap
+// markets.
+func (s *SwapLPStrategy) getSwapPairPrice(ctx sdk.Context, vault types.AllowedVault) (sdk.Dec, error) {
+	denomPrice, err := s.pricefeedKeeper.GetCurrentPrice(ctx, vault.SwapDenomMarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	pairPrice, err := s.pricefeedKeeper.GetCurrentPrice(ctx, vault.SwapPairMarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if !denomPrice.Price.IsPositive() || !pairPrice.Price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("invalid swap market prices for vault %s", vault.Denom)
+	}
+
+	return pairPrice.Price.Quo(denomPrice.Price), nil
+}
+
+// checkPoolPrice returns an error if the pool price of the swap pair denom
+// differs from the pricefeed price by more than the strategy slippage limit,
+// so deposits and withdrawals are not made against a manipulated pool.
+func (s *SwapLPStrategy) checkPoolPrice(ctx sdk.Context, pool *swaptypes.DenominatedPool, vault types.AllowedVault) error {
+	price, err := s.getSwapPairPrice(ctx, vault)
+	if err != nil {
+		return err
+	}
+
+	reserves := pool.Reserves()
+	poolPrice := sdk.NewDecFromInt(reserves.AmountOf(vault.Denom)).
+		Quo(sdk.NewDecFromInt(reserves.AmountOf(vault.SwapPairDenom)))
+
+	if poolPrice.Sub(price).Abs().Quo(price).GT(types.SwapStrategySlippageLimit) {
+		return errorsmod.Wrapf(
+			swaptypes.ErrSlippageExceeded,
+			"pool price %s of %s differs from pricefeed price %s", poolPrice, vault.SwapPairDenom, price,
+		)
+	}
+
+	return nil
+}
+
+// fairPool returns a copy of the pool with the reserves it would have at the
+// given price of the pair denom in units of denom, keeping the product of the
+// reserves constant. Swaps cannot change the product of the reserves except
+// by adding fees, so the returned pool cannot be manipulated.
+func (s *SwapLPStrategy) fairPool(
+	pool *swaptypes.DenominatedPool,
+	denom string,
+	pairDenom string,
+	price sdk.Dec,
+) (*swaptypes.DenominatedPool, error) {
+	reserves := pool.Reserves()
+
+	// R_denom = sqrt(R_denom * R_pair * price), R_pair = R_denom / price
+	sqrtDenom, err := sdk.NewDecFromInt(reserves.AmountOf(denom)).ApproxSqrt()
+	if err != nil {
+		return nil, err
+	}
+	sqrtPair, err := sdk.NewDecFromInt(reserves.AmountOf(pairDenom)).Mul(price).ApproxSqrt()
+	if err != nil {
+		return nil, err
+	}
+
+	denomReserve := sqrtDenom.Mul(sqrtPair)
+	fairReserves := sdk.NewCoins(
+		sdk.NewCoin(denom, denomReserve.TruncateInt()),
+		sdk.NewCoin(pairDenom, denomReserve.Quo(price).TruncateInt()),
+	)
+
+	return swaptypes.NewDenominatedPoolWithExistingShares(fairReserves, pool.TotalShares())
+}
+
+// loadPool returns the swap pool with the given ID.
+func (s *SwapLPStrategy) loadPool(ctx sdk.Context, poolID string) (*swaptypes.DenominatedPool, error) {
+	record, found := s.swapKeeper.GetPool(ctx, poolID)
+	if !found {
+		return nil, errorsmod.Wrapf(swaptypes.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	return swaptypes.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+}
+
+// copyPool returns a copy of the pool that can be modified without changing
+// the original.
+func (s *SwapLPStrategy) copyPool(pool *swaptypes.DenominatedPool) *swaptypes.DenominatedPool {
+	poolCopy, err := swaptypes.NewDenominatedPoolWithExistingShares(pool.Reserves(), pool.TotalShares())
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool copy: %s", err))
+	}
+
+	return poolCopy
+}
+
+// simulateWithdraw returns the amount of denom received from removing shares
+// from the pool and swapping the withdrawn pair denom for denom. If no
+// liquidity would remain in the pool, the pair denom cannot be swapped and is
+// not counted.
+func (s *SwapLPStrategy) simulateWithdraw(
+	ctx sdk.Context,
+	pool *swaptypes.DenominatedPool,
+	shares sdkmath.Int,
+	denom string,
+	pairDenom string,
+) sdk.Coin {
+	poolCopy := s.copyPool(pool)
+	withdrawn := poolCopy.RemoveLiquidity(shares)
+
+	total := sdk.NewCoin(denom, withdrawn.AmountOf(denom))
+	pairAmount := withdrawn.AmountOf(pairDenom)
+	if poolCopy.IsEmpty() || !pairAmount.IsPositive() {
+		return total
+	}
+
+	swapOut, _ := poolCopy.SwapWithExactInput(sdk.NewCoin(pairDenom, pairAmount), s.swapKeeper.GetSwapFee(ctx))
+	return total.Add(swapOut)
+}
+
+// calculateSwapAmountForDeposit returns the amount of a single sided deposit
+// to swap so the remainder and swap output match the pool reserve ratio
+// after the swap. This solves the constant product swap for s:
+//
+//	s = (sqrt(((2-f)*R)^2 + 4*(1-f)*a*R) - (2-f)*R) / (2*(1-f))
+//
+// where a is the deposit amount, R the pool reserves of the deposit denom and
+// f the swap fee.
+func calculateSwapAmountForDeposit(amount sdkmath.Int, reserves sdkmath.Int, fee sdk.Dec) sdkmath.Int {
+	r := sdk.NewDecFromInt(reserves)
+	a := sdk.NewDecFromInt(amount)
+
+	twoMinusFee := sdk.NewDec(2).Sub(fee)
+	oneMinusFee := sdk.OneDec().Sub(fee)
+
+	b := twoMinusFee.Mul(r)
+	discriminant := b.Mul(b).Add(sdk.NewDec(4).Mul(oneMinusFee).Mul(a).Mul(r))
+
+	root, err := discriminant.ApproxSqrt()
+	if err != nil {
+		panic(fmt.Sprintf("failed to calculate swap amount: %s", err))
+	}
+
+	return root.Sub(b).Quo(sdk.NewDec(2).Mul(oneMinusFee)).TruncateInt()
+}
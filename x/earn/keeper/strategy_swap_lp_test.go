@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type strategySwapLPTestSuite struct {
+	testutil.Suite
+
+	vaultDenom string
+	pairDenom  string
+}
+
+func (suite *strategySwapLPTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	suite.vaultDenom = "ukava"
+	suite.pairDenom = "usdx"
+
+	vault := types.NewAllowedVault(suite.vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_SWAP_LP}, false, nil)
+	vault.SwapPairDenom = suite.pairDenom
+	vault.SwapDenomMarketID = "kava:usd"
+	vault.SwapPairMarketID = "usdx:usd"
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+
+	// Initialize pool with a 1:2 price, matching the pricefeed prices
+	reserves := sdk.NewCoins(
+		sdk.NewInt64Coin(suite.vaultDenom, 1_000_000_000),
+		sdk.NewInt64Coin(suite.pairDenom, 2_000_000_000),
+	)
+	poolCreator := suite.CreateAccount(reserves, 10)
+	err := suite.SwapKeeper.Deposit(
+		suite.Ctx,
+		poolCreator.GetAddress(),
+		reserves[0],
+		reserves[1],
+		sdk.ZeroDec(),
+	)
+	suite.Require().NoError(err)
+}
+
+func TestStrategySwapLPTestSuite(t *testing.T) {
+	suite.Run(t, new(strategySwapLPTestSuite))
+}
+
+func (suite *strategySwapLPTestSuite) TestGetStrategyType() {
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	suite.Equal(types.STRATEGY_TYPE_SWAP_LP, strategy.GetStrategyType())
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	macc := suite.AccountKeeper.GetModuleAddress(types.ModuleName)
+	shares, found := suite.SwapKeeper.GetDepositorSharesAmount(
+		suite.Ctx,
+		macc,
+		swaptypes.PoolID(suite.vaultDenom, suite.pairDenom),
+	)
+	suite.Require().True(found)
+	suite.True(shares.IsPositive())
+
+	// Value is reduced by swap fees and price impact of the zap, but by less
+	// than the slippage limit
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, suite.vaultDenom)
+	suite.Require().NoError(err)
+	suite.True(totalValue.IsLT(depositAmount), "value %s should be less than deposit", totalValue)
+	suite.True(
+		sdk.NewDecFromInt(totalValue.Amount).GT(sdk.NewDecFromInt(depositAmount.Amount).Mul(sdk.MustNewDecFromStr("0.99"))),
+		"value %s should be within 1%% of deposit", totalValue,
+	)
+
+	// Coins not accepted by the pool are held in the vault's buffer
+	vaultRecord, found := suite.Keeper.GetVaultRecord(suite.Ctx, suite.vaultDenom)
+	suite.Require().True(found)
+	balance := suite.BankKeeper.GetAllBalances(suite.Ctx, macc)
+	suite.Equal(vaultRecord.GetBuffer(), balance.AmountOf(suite.vaultDenom), "module balance %s", balance)
+	suite.True(balance.AmountOf(suite.pairDenom).IsZero(), "module balance %s", balance)
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit_PoolPriceDeviates() {
+	suite.swapPool(sdk.NewInt64Coin(suite.vaultDenom, 100_000_000))
+
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, swaptypes.ErrSlippageExceeded)
+}
+
+func (suite *strategySwapLPTestSuite) TestGetEstimatedTotalAssets_ManipulatedPool() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	valueBefore, err := strategy.GetEstimatedTotalAssets(suite.Ctx, suite.vaultDenom)
+	suite.Require().NoError(err)
+
+	// Moving the pool price in either direction only adds swap fees to the
+	// pool, so the value cannot be decreased
+	suite.swapPool(sdk.NewInt64Coin(suite.pairDenom, 1_000_000_000))
+	valueAfter, err := strategy.GetEstimatedTotalAssets(suite.Ctx, suite.vaultDenom)
+	suite.Require().NoError(err)
+	suite.True(valueAfter.IsGTE(valueBefore), "value %s should not be less than %s", valueAfter, valueBefore)
+	suite.True(
+		sdk.NewDecFromInt(valueAfter.Amount).LT(sdk.NewDecFromInt(valueBefore.Amount).Mul(sdk.MustNewDecFromStr("1.01"))),
+		"value %s should not be inflated from %s", valueAfter, valueBefore,
+	)
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit_PoolNotFound() {
+	depositAmount := sdk.NewInt64Coin("bnb", 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	vault := types.NewAllowedVault("bnb", types.StrategyTypes{types.STRATEGY_TYPE_SWAP_LP}, false, nil)
+	vault.SwapPairDenom = "busd"
+	vault.SwapDenomMarketID = "bnb:usd"
+	vault.SwapPairMarketID = "usdx:usd"
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, swaptypes.ErrInvalidPool)
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw_Partial() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	valueBefore, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, suite.vaultDenom)
+	suite.Require().NoError(err)
+
+	withdrawAmount := sdk.NewInt64Coin(suite.vaultDenom, 4_000_000)
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	// Withdrawn amount is truncated by the share conversion
+	suite.True(withdrawAmount.Sub(withdrawn).Amount.LTE(sdk.OneInt()), "withdrawn %s", withdrawn)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(withdrawn))
+
+	// The value is reduced by the withdrawn amount, within the fees paid for
+	// swapping the withdrawn pair denom back
+	valueAfter, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, suite.vaultDenom)
+	suite.Require().NoError(err)
+	expected := valueBefore.Sub(withdrawn)
+	suite.True(
+		sdk.NewDecFromInt(valueAfter.Sub(expected).Amount.Abs()).LTE(sdk.NewDecFromInt(withdrawn.Amount).Mul(sdk.MustNewDecFromStr("0.01"))),
+		"value %s should be close to %s", valueAfter, expected,
+	)
+
+	// Coins withdrawn above the requested amount are held in the vault's buffer
+	vaultRecord, found := suite.Keeper.GetVaultRecord(suite.Ctx, suite.vaultDenom)
+	suite.Require().True(found)
+	balance := suite.BankKeeper.GetAllBalances(suite.Ctx, suite.AccountKeeper.GetModuleAddress(types.ModuleName))
+	suite.Equal(vaultRecord.GetBuffer(), balance.AmountOf(suite.vaultDenom), "module balance %s", balance)
+	suite.True(balance.AmountOf(suite.pairDenom).IsZero(), "module balance %s", balance)
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw_Full() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	accValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, suite.vaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), accValue, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	suite.Equal(accValue, withdrawn)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(accValue))
+
+	_, found := suite.Keeper.GetVaultRecord(suite.Ctx, suite.vaultDenom)
+	suite.False(found, "vault record should be removed after full withdraw")
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw_Insufficient() {
+	depositAmount := sdk.NewInt64Coin(suite.vaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+}
+
+// swapPool swaps coin for the other denom of the vault pool from a new account.
+func (suite *strategySwapLPTestSuite) swapPool(coin sdk.Coin) {
+	acc := suite.CreateAccount(sdk.NewCoins(coin), 0)
+
+	outDenom := suite.pairDenom
+	if coin.Denom == suite.pairDenom {
+		outDenom = suite.vaultDenom
+	}
+
+	err := suite.SwapKeeper.SwapExactForTokens(suite.Ctx, acc.GetAddress(), coin, sdk.NewInt64Coin(outDenom, 1), sdk.OneDec())
+	suite.Require().NoError(err)
+}
@@ -21,9 +21,11 @@ func (k *Keeper) GetVaultTotalShares(
 }
 
 // GetVaultTotalValue returns the total value of a vault, i.e. the realizable
-// total value if the vault were to liquidate its entire strategies.
+// total value if the vault were to liquidate its entire strategies. This is the
+// sum of the estimated total assets of each strategy and the vault's liquidity
+// buffer.
 //
-// **Note:** This does not include the tokens held in bank by the module
+// **Note:** This does not include other tokens held in bank by the module
 // account. If it were to be included, also note that the module account is
 // unblocked and can receive funds from bank sends.
 func (k *Keeper) GetVaultTotalValue(
@@ -35,13 +37,22 @@ func (k *Keeper) GetVaultTotalValue(
 		return sdk.Coin{}, types.ErrVaultRecordNotFound
 	}
 
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
+	// Denom can be different from allowedVault.Denom for bkava
+	values, err := k.GetVaultStrategyValues(ctx, allowedVault, denom)
 	if err != nil {
-		return sdk.Coin{}, types.ErrInvalidVaultStrategy
+		return sdk.Coin{}, err
 	}
 
-	// Denom can be different from allowedVault.Denom for bkava
-	return strategy.GetEstimatedTotalAssets(ctx, denom)
+	total := sdk.NewCoin(denom, sdk.ZeroInt())
+	for _, value := range values {
+		total = total.AddAmount(value)
+	}
+
+	if vaultRecord, found := k.GetVaultRecord(ctx, denom); found {
+		total = total.AddAmount(vaultRecord.GetBuffer())
+	}
+
+	return total, nil
 }
 
 // GetVaultAccountShares returns the shares for a single address for all vaults.
@@ -32,6 +32,20 @@ func (k *Keeper) Withdraw(
 		return sdk.Coin{}, types.ErrInvalidVaultStrategy
 	}
 
+	// Queued withdrawals are served first, so withdrawals cannot bypass the
+	// queue while it is waiting for liquidity
+	if k.HasVaultWithdrawalRequests(ctx, wantAmount.Denom) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrWithdrawalQueueNotEmpty,
+			"use a withdrawal request to withdraw from vault %s", wantAmount.Denom,
+		)
+	}
+
+	// Accrue fees so the withdraw is priced after fees are charged
+	if err := k.AccrueVaultFees(ctx, wantAmount.Denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	// Check if VaultRecord exists
 	vaultRecord, found := k.GetVaultRecord(ctx, wantAmount.Denom)
 	if !found {
@@ -83,17 +97,12 @@ func (k *Keeper) Withdraw(
 		)
 	}
 
-	// Get the strategy for the vault
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
-	if err != nil {
-		return sdk.Coin{}, err
-	}
-
 	// Not necessary to check if amount denom is allowed for the strategy, as
 	// there would be no vault record if it weren't allowed.
 
-	// Withdraw the withdrawAmount from the strategy
-	if err := strategy.Withdraw(ctx, withdrawAmount); err != nil {
+	// Withdraw the withdrawAmount from the liquidity buffer, then from the
+	// strategies in order
+	if err := k.withdrawFromVault(ctx, allowedVault, &vaultRecord, withdrawAmount); err != nil {
 		return sdk.Coin{}, fmt.Errorf("failed to withdraw from strategy: %w", err)
 	}
 
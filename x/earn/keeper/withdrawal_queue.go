@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// RequestWithdrawal queues a withdrawal of amount from a vault. The shares for
+// the amount are removed from the account and held by the request until it is
+// processed by ProcessWithdrawalQueue or cancelled.
+func (k *Keeper) RequestWithdrawal(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	amount sdk.Coin,
+) (types.WithdrawalRequest, error) {
+	allowedVault, found := k.GetAllowedVault(ctx, amount.Denom)
+	if !found {
+		return types.WithdrawalRequest{}, types.ErrInvalidVaultDenom
+	}
+
+	if amount.IsZero() {
+		return types.WithdrawalRequest{}, types.ErrInsufficientAmount
+	}
+
+	// A minimum size prevents the queue from being filled with requests that
+	// are cheap to create
+	if amount.Amount.LT(allowedVault.GetMinWithdrawalRequest()) {
+		return types.WithdrawalRequest{}, errorsmod.Wrapf(
+			types.ErrInsufficientAmount,
+			"withdrawal request %s less than minimum %s",
+			amount,
+			sdk.NewCoin(amount.Denom, allowedVault.GetMinWithdrawalRequest()),
+		)
+	}
+
+	// Accrue fees so the shares are priced after fees are charged
+	if err := k.AccrueVaultFees(ctx, amount.Denom); err != nil {
+		return types.WithdrawalRequest{}, err
+	}
+
+	if _, found := k.GetVaultRecord(ctx, amount.Denom); !found {
+		return types.WithdrawalRequest{}, types.ErrVaultRecordNotFound
+	}
+
+	vaultShareRecord, found := k.GetVaultShareRecord(ctx, from)
+	if !found {
+		return types.WithdrawalRequest{}, types.ErrVaultShareRecordNotFound
+	}
+
+	shares, err := k.ConvertToShares(ctx, amount)
+	if err != nil {
+		return types.WithdrawalRequest{}, fmt.Errorf("failed to convert assets to shares: %w", err)
+	}
+
+	accCurrentShares := vaultShareRecord.Shares.AmountOf(amount.Denom)
+	if accCurrentShares.LT(shares.Amount) {
+		return types.WithdrawalRequest{}, errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"account has less %s vault shares than withdraw shares, %s < %s",
+			amount.Denom,
+			accCurrentShares,
+			shares.Amount,
+		)
+	}
+
+	// Queue the remaining shares too if they would be left as dust
+	isDust, err := k.ShareIsDust(ctx, vaultShareRecord.Shares.GetShare(amount.Denom).Sub(shares))
+	if err != nil {
+		return types.WithdrawalRequest{}, err
+	}
+
+	if isDust {
+		shares = vaultShareRecord.Shares.GetShare(amount.Denom)
+	}
+
+	k.BeforeVaultDepositModified(ctx, amount.Denom, from, accCurrentShares)
+
+	vaultShareRecord.Shares = vaultShareRecord.Shares.Sub(shares)
+	k.UpdateVaultShareRecord(ctx, vaultShareRecord)
+
+	id := k.GetNextWithdrawalRequestID(ctx)
+	request := types.NewWithdrawalRequest(id, from, shares, ctx.BlockTime())
+	k.SetWithdrawalRequest(ctx, request)
+	k.SetNextWithdrawalRequestID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalRequest,
+			sdk.NewAttribute(types.AttributeKeyRequestID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.Amount.String()),
+		),
+	)
+
+	return request, nil
+}
+
+// CancelWithdrawal removes a queued withdrawal request and returns its shares
+// to the owner.
+func (k *Keeper) CancelWithdrawal(ctx sdk.Context, from sdk.AccAddress, id uint64) error {
+	request, found := k.GetWithdrawalRequest(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrWithdrawalRequestNotFound, "id %d", id)
+	}
+
+	if !request.Owner.Equals(from) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of withdrawal request %d", from, id)
+	}
+
+	k.addAccountShares(ctx, request.Owner, request.Shares)
+	k.DeleteWithdrawalRequest(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalCancel,
+			sdk.NewAttribute(types.AttributeKeyRequestID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, request.Shares.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, request.Shares.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ProcessWithdrawalQueue pays out queued withdrawal requests of each vault in
+// FIFO order. Once a request for a vault cannot be paid, the remaining requests
+// for that vault wait for a later block so they are served in order. At most
+// MaxWithdrawalRequestsPerBlock requests are attempted each block.
+//
+// Vaults are read from the vault records rather than the allowed vaults, as a
+// single bkava allowed vault has a vault record for each bkava-<valoper> denom.
+func (k *Keeper) ProcessWithdrawalQueue(ctx sdk.Context) {
+	var vaultDenoms []string
+	k.IterateVaultRecords(ctx, func(record types.VaultRecord) bool {
+		vaultDenoms = append(vaultDenoms, record.TotalShares.Denom)
+		return false
+	})
+
+	attempted := 0
+
+	for _, vaultDenom := range vaultDenoms {
+		var requests []types.WithdrawalRequest
+		k.IterateVaultWithdrawalRequests(ctx, vaultDenom, func(request types.WithdrawalRequest) bool {
+			requests = append(requests, request)
+			return attempted+len(requests) >= types.MaxWithdrawalRequestsPerBlock
+		})
+
+		for _, request := range requests {
+			attempted++
+
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err := k.processWithdrawalRequest(cacheCtx, request); err != nil {
+				k.Logger(ctx).Debug(fmt.Sprintf("withdrawal request %d not processed: %s", request.ID, err))
+				break
+			}
+
+			writeCache()
+		}
+
+		if attempted >= types.MaxWithdrawalRequestsPerBlock {
+			return
+		}
+	}
+}
+
+// processWithdrawalRequest withdraws the value of the request shares from the
+// vault and sends it to the request owner.
+func (k *Keeper) processWithdrawalRequest(ctx sdk.Context, request types.WithdrawalRequest) error {
+	denom := request.Shares.Denom
+
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	if err := k.AccrueVaultFees(ctx, denom); err != nil {
+		return err
+	}
+
+	amount, err := k.ConvertToAssets(ctx, request.Shares)
+	if err != nil {
+		return fmt.Errorf("failed to convert shares to assets: %w", err)
+	}
+
+	vaultRecord, found := k.GetVaultRecord(ctx, denom)
+	if !found {
+		return types.ErrVaultRecordNotFound
+	}
+
+	if amount.IsPositive() {
+		if err := k.withdrawFromVault(ctx, allowedVault, &vaultRecord, amount); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ModuleName,
+			request.Owner,
+			sdk.NewCoins(amount),
+		); err != nil {
+			return err
+		}
+	}
+
+	vaultRecord.TotalShares = vaultRecord.TotalShares.Sub(request.Shares)
+	k.UpdateVaultRecord(ctx, vaultRecord)
+	k.DeleteWithdrawalRequest(ctx, request.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalProcessed,
+			sdk.NewAttribute(types.AttributeKeyRequestID, strconv.FormatUint(request.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, request.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, request.Shares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.Amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,325 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const queueVaultDenom = "usdx"
+
+type withdrawalQueueTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *withdrawalQueueTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.setLiquidityBuffer("0")
+}
+
+func TestWithdrawalQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(withdrawalQueueTestSuite))
+}
+
+func (suite *withdrawalQueueTestSuite) setLiquidityBuffer(buffer string) {
+	vault := types.NewAllowedVault(queueVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	vault.LiquidityBuffer = sdk.MustNewDecFromStr(buffer)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func (suite *withdrawalQueueTestSuite) deposit(amount int64, index int) sdk.AccAddress {
+	depositAmount := sdk.NewInt64Coin(queueVaultDenom, amount)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), index)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	return acc.GetAddress()
+}
+
+func (suite *withdrawalQueueTestSuite) vaultBufferEqual(expected int64) {
+	record, found := suite.Keeper.GetVaultRecord(suite.Ctx, queueVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(expected), record.GetBuffer())
+}
+
+func (suite *withdrawalQueueTestSuite) TestRequestWithdrawal() {
+	acc := suite.deposit(1000, 0)
+
+	request, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 400))
+	suite.Require().NoError(err)
+
+	expectedRequest := types.NewWithdrawalRequest(
+		1,
+		acc,
+		types.NewVaultShare(queueVaultDenom, sdk.NewDec(400)),
+		suite.Ctx.BlockTime(),
+	)
+	suite.Equal(expectedRequest, request)
+
+	stored, found := suite.Keeper.GetWithdrawalRequest(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Equal(expectedRequest, stored)
+	suite.Equal(uint64(2), suite.Keeper.GetNextWithdrawalRequestID(suite.Ctx))
+
+	// Shares are held by the request, vault total shares are unchanged
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(600), shares.AmountOf(queueVaultDenom))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(
+		types.NewVaultShare(queueVaultDenom, sdk.NewDec(1000)),
+	))
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			types.EventTypeWithdrawalRequest,
+			sdk.NewAttribute(types.AttributeKeyRequestID, "1"),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, queueVaultDenom),
+			sdk.NewAttribute(types.AttributeKeyOwner, acc.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(400).String()),
+		),
+	)
+}
+
+func (suite *withdrawalQueueTestSuite) TestRequestWithdrawal_Dust() {
+	acc := suite.deposit(1000, 0)
+
+	// A remaining 0.5 shares is dust so all shares are queued
+	_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 1000))
+	suite.Require().NoError(err)
+
+	request, found := suite.Keeper.GetWithdrawalRequest(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Equal(types.NewVaultShare(queueVaultDenom, sdk.NewDec(1000)), request.Shares)
+
+	_, found = suite.Keeper.GetVaultShareRecord(suite.Ctx, acc)
+	suite.False(found, "empty share record should be deleted")
+}
+
+func (suite *withdrawalQueueTestSuite) TestRequestWithdrawal_Invalid() {
+	acc := suite.deposit(1000, 0)
+
+	_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 1001))
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin("busd", 100))
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 0))
+	suite.Require().ErrorIs(err, types.ErrInsufficientAmount)
+
+	other := suite.CreateAccount(sdk.NewCoins(), 1)
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, other.GetAddress(), sdk.NewInt64Coin(queueVaultDenom, 100))
+	suite.Require().ErrorIs(err, types.ErrVaultShareRecordNotFound)
+}
+
+func (suite *withdrawalQueueTestSuite) TestRequestWithdrawal_Minimum() {
+	vault := types.NewAllowedVault(queueVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	vault.MinWithdrawalRequest = sdk.NewInt(100)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+
+	acc := suite.deposit(1000, 0)
+
+	_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 99))
+	suite.Require().ErrorIs(err, types.ErrInsufficientAmount)
+
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 100))
+	suite.Require().NoError(err)
+}
+
+func (suite *withdrawalQueueTestSuite) TestCancelWithdrawal() {
+	acc := suite.deposit(1000, 0)
+	other := suite.deposit(1000, 1)
+
+	_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 400))
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.CancelWithdrawal(suite.Ctx, acc, 2)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalRequestNotFound)
+
+	err = suite.Keeper.CancelWithdrawal(suite.Ctx, other, 1)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = suite.Keeper.CancelWithdrawal(suite.Ctx, acc, 1)
+	suite.Require().NoError(err)
+
+	_, found := suite.Keeper.GetWithdrawalRequest(suite.Ctx, 1)
+	suite.False(found)
+
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(1000), shares.AmountOf(queueVaultDenom))
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			types.EventTypeWithdrawalCancel,
+			sdk.NewAttribute(types.AttributeKeyRequestID, "1"),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, queueVaultDenom),
+			sdk.NewAttribute(types.AttributeKeyOwner, acc.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(400).String()),
+		),
+	)
+}
+
+func (suite *withdrawalQueueTestSuite) TestProcessWithdrawalQueue() {
+	acc1 := suite.deposit(1000, 0)
+	acc2 := suite.deposit(1000, 1)
+
+	_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc1, sdk.NewInt64Coin(queueVaultDenom, 400))
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc2, sdk.NewInt64Coin(queueVaultDenom, 1000))
+	suite.Require().NoError(err)
+
+	suite.Keeper.ProcessWithdrawalQueue(suite.Ctx)
+
+	suite.Empty(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+	suite.AccountBalanceEqual(acc1, sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 400)))
+	suite.AccountBalanceEqual(acc2, sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1000)))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(
+		types.NewVaultShare(queueVaultDenom, sdk.NewDec(600)),
+	))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 600)))
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			types.EventTypeWithdrawalProcessed,
+			sdk.NewAttribute(types.AttributeKeyRequestID, "1"),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, queueVaultDenom),
+			sdk.NewAttribute(types.AttributeKeyOwner, acc1.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(400).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "400"),
+		),
+	)
+}
+
+func (suite *withdrawalQueueTestSuite) TestProcessWithdrawalQueue_Blocked() {
+	acc1 := suite.deposit(1000, 0)
+	acc2 := suite.deposit(1000, 1)
+
+	_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc1, sdk.NewInt64Coin(queueVaultDenom, 400))
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc2, sdk.NewInt64Coin(queueVaultDenom, 100))
+	suite.Require().NoError(err)
+
+	// Make the first request fail so the later request waits behind it
+	request, found := suite.Keeper.GetWithdrawalRequest(suite.Ctx, 1)
+	suite.Require().True(found)
+	request.Shares = types.NewVaultShare(queueVaultDenom, sdk.NewDec(5000))
+	suite.Keeper.SetWithdrawalRequest(suite.Ctx, request)
+
+	suite.Keeper.ProcessWithdrawalQueue(suite.Ctx)
+
+	suite.Len(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx), 2)
+	suite.AccountBalanceEqual(acc2, sdk.NewCoins())
+
+	request.Shares = types.NewVaultShare(queueVaultDenom, sdk.NewDec(400))
+	suite.Keeper.SetWithdrawalRequest(suite.Ctx, request)
+
+	suite.Keeper.ProcessWithdrawalQueue(suite.Ctx)
+
+	suite.Empty(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+	suite.AccountBalanceEqual(acc1, sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 400)))
+	suite.AccountBalanceEqual(acc2, sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 100)))
+}
+
+func (suite *withdrawalQueueTestSuite) TestProcessWithdrawalQueue_MaxPerBlock() {
+	acc := suite.deposit(1000, 0)
+
+	for i := 0; i < types.MaxWithdrawalRequestsPerBlock+1; i++ {
+		_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 1))
+		suite.Require().NoError(err)
+	}
+
+	suite.Keeper.ProcessWithdrawalQueue(suite.Ctx)
+
+	// The last request is processed in the next block
+	requests := suite.Keeper.GetAllWithdrawalRequests(suite.Ctx)
+	suite.Require().Len(requests, 1)
+	suite.Equal(uint64(types.MaxWithdrawalRequestsPerBlock+1), requests[0].ID)
+
+	suite.Keeper.ProcessWithdrawalQueue(suite.Ctx)
+
+	suite.Empty(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+	suite.AccountBalanceEqual(acc, sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, types.MaxWithdrawalRequestsPerBlock+1)))
+}
+
+func (suite *withdrawalQueueTestSuite) TestProcessWithdrawalQueue_bKava() {
+	vaultDenom := testutil.TestBkavaDenoms[0]
+	suite.CreateVault("bkava", types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	// Requests are stored under the bkava-<valoper> vault denom, not the bkava allowed vault
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+
+	suite.Keeper.ProcessWithdrawalQueue(suite.Ctx)
+
+	suite.Empty(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 400)))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(
+		types.NewVaultShare(vaultDenom, sdk.NewDec(600)),
+	))
+}
+
+func (suite *withdrawalQueueTestSuite) TestWithdraw_QueueNotEmpty() {
+	acc1 := suite.deposit(1000, 0)
+	acc2 := suite.deposit(1000, 1)
+
+	_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc1, sdk.NewInt64Coin(queueVaultDenom, 400))
+	suite.Require().NoError(err)
+
+	// Withdrawals cannot skip ahead of queued requests
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc2, sdk.NewInt64Coin(queueVaultDenom, 100), types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalQueueNotEmpty)
+
+	suite.Keeper.ProcessWithdrawalQueue(suite.Ctx)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc2, sdk.NewInt64Coin(queueVaultDenom, 100), types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+}
+
+func (suite *withdrawalQueueTestSuite) TestLiquidityBuffer() {
+	suite.setLiquidityBuffer("0.1")
+
+	acc := suite.deposit(1000, 0)
+
+	suite.vaultBufferEqual(100)
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 900)))
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 100)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1000)))
+
+	// Withdrawals are paid from the buffer first
+	_, err := suite.Keeper.Withdraw(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 50), types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.vaultBufferEqual(50)
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 900)))
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc, sdk.NewInt64Coin(queueVaultDenom, 100), types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.vaultBufferEqual(0)
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 850)))
+
+	// Rebalancing refills the buffer from the strategies
+	err = suite.Keeper.RebalanceVault(suite.Ctx, queueVaultDenom)
+	suite.Require().NoError(err)
+
+	suite.vaultBufferEqual(85)
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 765)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 850)))
+}
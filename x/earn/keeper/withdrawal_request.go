@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// WithdrawalRequest -- queued withdrawals
+
+// GetWithdrawalRequest returns the withdrawal request with the given ID.
+func (k *Keeper) GetWithdrawalRequest(
+	ctx sdk.Context,
+	id uint64,
+) (types.WithdrawalRequest, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestKeyPrefix)
+
+	bz := store.Get(types.WithdrawalRequestKey(id))
+	if bz == nil {
+		return types.WithdrawalRequest{}, false
+	}
+
+	var request types.WithdrawalRequest
+	k.cdc.MustUnmarshal(bz, &request)
+
+	return request, true
+}
+
+// SetWithdrawalRequest sets the withdrawal request for its ID.
+func (k *Keeper) SetWithdrawalRequest(ctx sdk.Context, request types.WithdrawalRequest) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestKeyPrefix)
+	bz := k.cdc.MustMarshal(&request)
+	store.Set(types.WithdrawalRequestKey(request.ID), bz)
+
+	vaultStore := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestByVaultKeyPrefix)
+	vaultStore.Set(
+		types.VaultWithdrawalRequestKey(request.Shares.Denom, request.ID),
+		types.GetWithdrawalRequestIDBytes(request.ID),
+	)
+}
+
+// DeleteWithdrawalRequest deletes the withdrawal request with the given ID.
+func (k *Keeper) DeleteWithdrawalRequest(ctx sdk.Context, id uint64) {
+	request, found := k.GetWithdrawalRequest(ctx, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestKeyPrefix)
+	store.Delete(types.WithdrawalRequestKey(id))
+
+	vaultStore := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestByVaultKeyPrefix)
+	vaultStore.Delete(types.VaultWithdrawalRequestKey(request.Shares.Denom, id))
+}
+
+// IterateWithdrawalRequests iterates over all withdrawal requests in queue
+// order and performs a callback function.
+func (k Keeper) IterateWithdrawalRequests(
+	ctx sdk.Context,
+	cb func(request types.WithdrawalRequest) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.WithdrawalRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &request)
+		if cb(request) {
+			break
+		}
+	}
+}
+
+// IterateVaultWithdrawalRequests iterates over the withdrawal requests of a
+// vault in queue order and performs a callback function.
+func (k Keeper) IterateVaultWithdrawalRequests(
+	ctx sdk.Context,
+	denom string,
+	cb func(request types.WithdrawalRequest) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestByVaultKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.VaultWithdrawalRequestsKey(denom))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		request, found := k.GetWithdrawalRequest(ctx, types.GetWithdrawalRequestIDFromBytes(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("withdrawal request %d in vault index not found", types.GetWithdrawalRequestIDFromBytes(iterator.Value())))
+		}
+
+		if cb(request) {
+			break
+		}
+	}
+}
+
+// HasVaultWithdrawalRequests returns true if the vault has any queued
+// withdrawal requests.
+func (k Keeper) HasVaultWithdrawalRequests(ctx sdk.Context, denom string) bool {
+	found := false
+	k.IterateVaultWithdrawalRequests(ctx, denom, func(_ types.WithdrawalRequest) bool {
+		found = true
+		return true
+	})
+
+	return found
+}
+
+// GetAllWithdrawalRequests returns all withdrawal requests in queue order.
+func (k Keeper) GetAllWithdrawalRequests(ctx sdk.Context) types.WithdrawalRequests {
+	var requests types.WithdrawalRequests
+
+	k.IterateWithdrawalRequests(ctx, func(request types.WithdrawalRequest) bool {
+		requests = append(requests, request)
+		return false
+	})
+
+	return requests
+}
+
+// GetNextWithdrawalRequestID returns the ID of the next withdrawal request.
+func (k Keeper) GetNextWithdrawalRequestID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(types.NextWithdrawalRequestIDKey)
+	if bz == nil {
+		return types.DefaultNextWithdrawalRequestID
+	}
+
+	return types.GetWithdrawalRequestIDFromBytes(bz)
+}
+
+// SetNextWithdrawalRequestID sets the ID of the next withdrawal request.
+func (k Keeper) SetNextWithdrawalRequestID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextWithdrawalRequestIDKey, types.GetWithdrawalRequestIDBytes(id))
+}
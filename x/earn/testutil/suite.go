@@ -8,15 +8,20 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp"
 	"github.com/kava-labs/kava/x/earn/keeper"
 	"github.com/kava-labs/kava/x/earn/types"
 	"github.com/kava-labs/kava/x/hard"
 
+	cdpkeeper "github.com/kava-labs/kava/x/cdp/keeper"
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	hardkeeper "github.com/kava-labs/kava/x/hard/keeper"
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 	savingskeeper "github.com/kava-labs/kava/x/savings/keeper"
 	savingstypes "github.com/kava-labs/kava/x/savings/types"
+	swapkeeper "github.com/kava-labs/kava/x/swap/keeper"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -50,6 +55,8 @@ type Suite struct {
 	// Strategy Keepers
 	HardKeeper    hardkeeper.Keeper
 	SavingsKeeper savingskeeper.Keeper
+	SwapKeeper    swapkeeper.Keeper
+	CdpKeeper     cdpkeeper.Keeper
 }
 
 // SetupTest instantiates a new app, keepers, and sets suite state
@@ -166,6 +173,62 @@ func (suite *Suite) SetupTest() {
 		nil,
 	)
 
+	// Swap and CDP required for the swap LP and CDP mint strategies
+	swapGS := swaptypes.NewGenesisState(
+		swaptypes.NewParams(
+			swaptypes.NewAllowedPools(
+				swaptypes.NewAllowedPool("ukava", "usdx"),
+				swaptypes.NewAllowedPool("bnb", "usdx"),
+			),
+			sdk.MustNewDecFromStr("0.003"),
+		),
+		swaptypes.DefaultPoolRecords,
+		swaptypes.DefaultShareRecords,
+	)
+
+	cdpGS := cdptypes.GenesisState{
+		Params: cdptypes.Params{
+			GlobalDebtLimit:          sdk.NewInt64Coin("usdx", 1000000000000),
+			SurplusAuctionThreshold:  cdptypes.DefaultSurplusThreshold,
+			SurplusAuctionLot:        cdptypes.DefaultSurplusLot,
+			DebtAuctionThreshold:     cdptypes.DefaultDebtThreshold,
+			DebtAuctionLot:           cdptypes.DefaultDebtLot,
+			LiquidationBlockInterval: cdptypes.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralParams: cdptypes.CollateralParams{
+				{
+					Denom:                            "bnb",
+					Type:                             "bnb-a",
+					LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+					DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+					StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"), // 5% apr
+					LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+					AuctionSize:                      sdkmath.NewInt(100),
+					SpotMarketID:                     "bnb:usd",
+					LiquidationMarketID:              "bnb:usd",
+					KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+					CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					ConversionFactor:                 sdkmath.NewInt(6),
+				},
+			},
+			DebtParam: cdptypes.DebtParam{
+				Denom:            "usdx",
+				ReferenceAsset:   "usd",
+				ConversionFactor: sdkmath.NewInt(6),
+				DebtFloor:        sdkmath.NewInt(10000000),
+			},
+		},
+		StartingCdpID: cdptypes.DefaultCdpStartingID,
+		DebtDenom:     cdptypes.DefaultDebtDenom,
+		GovDenom:      cdptypes.DefaultGovDenom,
+		CDPs:          cdptypes.CDPs{},
+		PreviousAccumulationTimes: cdptypes.GenesisAccumulationTimes{
+			cdptypes.NewGenesisAccumulationTime("bnb-a", time.Time{}, sdk.OneDec()),
+		},
+		TotalPrincipals: cdptypes.GenesisTotalPrincipals{
+			cdptypes.NewGenesisTotalPrincipal("bnb-a", sdk.ZeroInt()),
+		},
+	}
+
 	stakingParams := stakingtypes.DefaultParams()
 	stakingParams.BondDenom = "ukava"
 
@@ -180,6 +243,8 @@ func (suite *Suite) SetupTest() {
 			pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS),
 			hardtypes.ModuleName:      tApp.AppCodec().MustMarshalJSON(&hardGS),
 			savingstypes.ModuleName:   tApp.AppCodec().MustMarshalJSON(&savingsGS),
+			swaptypes.ModuleName:      tApp.AppCodec().MustMarshalJSON(&swapGS),
+			cdptypes.ModuleName:       tApp.AppCodec().MustMarshalJSON(&cdpGS),
 			stakingtypes.ModuleName:   tApp.AppCodec().MustMarshalJSON(&stakingGs),
 		},
 	)
@@ -194,8 +259,12 @@ func (suite *Suite) SetupTest() {
 
 	suite.HardKeeper = tApp.GetHardKeeper()
 	suite.SavingsKeeper = tApp.GetSavingsKeeper()
+	suite.SwapKeeper = tApp.GetSwapKeeper()
+	suite.CdpKeeper = tApp.GetCDPKeeper()
 
 	hard.BeginBlocker(suite.Ctx, suite.HardKeeper)
+	// Sets the bnb:usd market status for cdp creation
+	cdp.BeginBlocker(suite.Ctx, abci.RequestBeginBlock{}, suite.CdpKeeper)
 }
 
 // GetEvents returns emitted events on the sdk context
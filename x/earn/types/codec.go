@@ -15,6 +15,9 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "earn/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "earn/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgRebalanceVault{}, "earn/MsgRebalanceVault", nil)
+	cdc.RegisterConcrete(&MsgRequestWithdrawal{}, "earn/MsgRequestWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgCancelWithdrawal{}, "earn/MsgCancelWithdrawal", nil)
 	cdc.RegisterConcrete(&CommunityPoolDepositProposal{}, "kava/CommunityPoolDepositProposal", nil)
 	cdc.RegisterConcrete(&CommunityPoolWithdrawProposal{}, "kava/CommunityPoolWithdrawProposal", nil)
 }
@@ -25,6 +28,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeposit{},
 		&MsgWithdraw{},
+		&MsgRebalanceVault{},
+		&MsgRequestWithdrawal{},
+		&MsgCancelWithdrawal{},
 	)
 	registry.RegisterImplementations((*govv1beta1.Content)(nil),
 		&CommunityPoolDepositProposal{},
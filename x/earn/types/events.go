@@ -2,11 +2,22 @@ package types
 
 // Event types for earn module
 const (
-	AttributeValueCategory = ModuleName
-	EventTypeVaultDeposit  = "vault_deposit"
-	EventTypeVaultWithdraw = "vault_withdraw"
-	AttributeKeyVaultDenom = "vault_denom"
-	AttributeKeyDepositor  = "depositor"
-	AttributeKeyShares     = "shares"
-	AttributeKeyOwner      = "owner"
+	AttributeValueCategory       = ModuleName
+	EventTypeVaultDeposit        = "vault_deposit"
+	EventTypeVaultWithdraw       = "vault_withdraw"
+	EventTypeCDPDeleverage       = "cdp_strategy_deleverage"
+	EventTypeVaultRebalance      = "vault_rebalance"
+	EventTypeVaultFee            = "vault_fee"
+	EventTypeWithdrawalRequest   = "withdrawal_request"
+	EventTypeWithdrawalCancel    = "withdrawal_cancel"
+	EventTypeWithdrawalProcessed = "withdrawal_processed"
+	AttributeKeyRequestID        = "request_id"
+	AttributeKeyVaultDenom       = "vault_denom"
+	AttributeKeyRepaid           = "repaid"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyManagement       = "management_fee"
+	AttributeKeyPerformance      = "performance_fee"
+	AttributeKeyDepositor        = "depositor"
+	AttributeKeyShares           = "shares"
+	AttributeKeyOwner            = "owner"
 )
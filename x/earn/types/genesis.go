@@ -1,15 +1,21 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
 	params Params,
 	vaultRecords VaultRecords,
 	vaultShareRecords VaultShareRecords,
+	withdrawalRequests WithdrawalRequests,
+	nextWithdrawalRequestID uint64,
 ) GenesisState {
 	return GenesisState{
-		Params:            params,
-		VaultRecords:      vaultRecords,
-		VaultShareRecords: vaultShareRecords,
+		Params:                  params,
+		VaultRecords:            vaultRecords,
+		VaultShareRecords:       vaultShareRecords,
+		WithdrawalRequests:      withdrawalRequests,
+		NextWithdrawalRequestID: nextWithdrawalRequestID,
 	}
 }
 
@@ -27,6 +33,19 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.WithdrawalRequests.Validate(); err != nil {
+		return err
+	}
+
+	for _, request := range gs.WithdrawalRequests {
+		if request.ID >= gs.NextWithdrawalRequestID {
+			return fmt.Errorf(
+				"withdrawal request id %d must be less than next withdrawal request id %d",
+				request.ID, gs.NextWithdrawalRequestID,
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -36,5 +55,7 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		VaultRecords{},
 		VaultShareRecords{},
+		WithdrawalRequests{},
+		DefaultNextWithdrawalRequestID,
 	)
 }
@@ -31,6 +31,10 @@ type GenesisState struct {
 	VaultRecords VaultRecords `protobuf:"bytes,2,rep,name=vault_records,json=vaultRecords,proto3,castrepeated=VaultRecords" json:"vault_records"`
 	// share_records defines the owned shares of each vault
 	VaultShareRecords VaultShareRecords `protobuf:"bytes,3,rep,name=vault_share_records,json=vaultShareRecords,proto3,castrepeated=VaultShareRecords" json:"vault_share_records"`
+	// withdrawal_requests defines the queued withdrawal requests
+	WithdrawalRequests WithdrawalRequests `protobuf:"bytes,4,rep,name=withdrawal_requests,json=withdrawalRequests,proto3,castrepeated=WithdrawalRequests" json:"withdrawal_requests"`
+	// next_withdrawal_request_id defines the ID of the next withdrawal request
+	NextWithdrawalRequestID uint64 `protobuf:"varint,5,opt,name=next_withdrawal_request_id,json=nextWithdrawalRequestId,proto3" json:"next_withdrawal_request_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetVaultShareRecords() VaultShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetWithdrawalRequests() WithdrawalRequests {
+	if m != nil {
+		return m.WithdrawalRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextWithdrawalRequestID() uint64 {
+	if m != nil {
+		return m.NextWithdrawalRequestID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.earn.v1beta1.GenesisState")
 }
@@ -94,26 +112,31 @@ func init() {
 func init() { proto.RegisterFile("kava/earn/v1beta1/genesis.proto", fileDescriptor_514fe130cb964f8c) }
 
 var fileDescriptor_514fe130cb964f8c = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0x4d, 0x2c, 0xca, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x92, 0x92, 0xc5,
-	0x94, 0x2f, 0x4b, 0x2c, 0xcd, 0x29, 0x81, 0x48, 0x2b, 0x4d, 0x62, 0xe2, 0xe2, 0x71, 0x87, 0xd8,
-	0x1c, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xce, 0xc5, 0x06, 0xd1, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x6d, 0x24, 0xa9, 0x87, 0xe1, 0x12, 0xbd, 0x00, 0xb0, 0x02, 0x27, 0x96, 0x13, 0xf7, 0xe4,
-	0x19, 0x82, 0xa0, 0xca, 0x85, 0x22, 0xb9, 0x78, 0xc1, 0x06, 0xc7, 0x17, 0xa5, 0x26, 0xe7, 0x17,
-	0xa5, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0x61, 0xd1, 0x1f, 0x06, 0x52, 0x17,
-	0x04, 0x56, 0xe6, 0x24, 0x02, 0x32, 0x64, 0xd5, 0x7d, 0x79, 0x1e, 0x24, 0xc1, 0xe2, 0x20, 0x9e,
-	0x32, 0x24, 0x9e, 0x50, 0x1e, 0x97, 0x30, 0xc4, 0xe8, 0xe2, 0x8c, 0xc4, 0xa2, 0x54, 0xb8, 0x05,
-	0xcc, 0x60, 0x0b, 0x94, 0x71, 0x59, 0x10, 0x0c, 0x52, 0x0c, 0xb5, 0x45, 0x12, 0x6a, 0x8b, 0x20,
-	0xba, 0x4c, 0x71, 0x90, 0x60, 0x19, 0xba, 0x90, 0x93, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x83, 0xac, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x06, 0xb3, 0xf4, 0x2b, 0x20, 0x81, 0x5c, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x5d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x54,
-	0x3b, 0x56, 0xe8, 0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x6a, 0xea, 0x40,
+	0x18, 0x86, 0x93, 0xa3, 0xc7, 0x45, 0xb4, 0x0b, 0x47, 0xc1, 0x98, 0xd2, 0x89, 0xb4, 0xa5, 0xb8,
+	0x69, 0x82, 0x76, 0xd1, 0x6d, 0x09, 0x85, 0xd2, 0x4d, 0x29, 0x11, 0xfa, 0xb7, 0x09, 0x13, 0x33,
+	0xc4, 0x50, 0x4d, 0x74, 0x66, 0x8c, 0xf6, 0x2e, 0x7a, 0x1d, 0xbd, 0x12, 0x97, 0x2e, 0xbb, 0xd2,
+	0x12, 0x6f, 0xa4, 0x64, 0x12, 0x44, 0x1c, 0xbb, 0x9b, 0x7c, 0xef, 0x33, 0xef, 0x93, 0x81, 0x4f,
+	0xd1, 0xdf, 0x51, 0x8c, 0x4c, 0x8c, 0x48, 0x68, 0xc6, 0x1d, 0x17, 0x33, 0xd4, 0x31, 0x7d, 0x1c,
+	0x62, 0x1a, 0x50, 0x63, 0x4c, 0x22, 0x16, 0x81, 0x6a, 0x0a, 0x18, 0x29, 0x60, 0xe4, 0x80, 0x56,
+	0xf7, 0x23, 0x3f, 0xe2, 0xa9, 0x99, 0x9e, 0x32, 0x50, 0x83, 0x62, 0xd3, 0x18, 0x11, 0x34, 0xca,
+	0x8b, 0xb4, 0x13, 0x31, 0x8f, 0xd1, 0x74, 0xc8, 0xb2, 0xf8, 0x74, 0x5d, 0x50, 0x2a, 0x77, 0x99,
+	0xb9, 0xc7, 0x10, 0xc3, 0xe0, 0x5a, 0x29, 0x65, 0xf7, 0x55, 0xb9, 0x25, 0xb7, 0xcb, 0xdd, 0xa6,
+	0x21, 0xfc, 0x89, 0xf1, 0xc8, 0x01, 0xab, 0xb8, 0x58, 0xe9, 0x92, 0x9d, 0xe3, 0xe0, 0x55, 0x39,
+	0xe2, 0xc5, 0x0e, 0xc1, 0xfd, 0x88, 0x78, 0x54, 0xfd, 0xd7, 0x2a, 0xb4, 0xcb, 0x5d, 0x78, 0xe0,
+	0xfe, 0x53, 0xca, 0xd9, 0x1c, 0xb3, 0xea, 0x69, 0xc9, 0xd7, 0x5a, 0xaf, 0xec, 0x0c, 0xa9, 0x5d,
+	0x89, 0x77, 0xbe, 0x40, 0xa8, 0xd4, 0xb2, 0x6a, 0x3a, 0x40, 0x04, 0x6f, 0x05, 0x05, 0x2e, 0x38,
+	0xfb, 0x4b, 0xd0, 0x4b, 0xe1, 0xdc, 0xd2, 0xcc, 0x2d, 0xd5, 0xfd, 0x84, 0xda, 0xd5, 0x78, 0x7f,
+	0x04, 0x26, 0x4a, 0x6d, 0x16, 0xb0, 0x81, 0x47, 0xd0, 0x0c, 0x0d, 0x1d, 0x82, 0x27, 0x53, 0x4c,
+	0x19, 0x55, 0x8b, 0xdc, 0x77, 0x7e, 0xc0, 0xf7, 0xbc, 0xa5, 0xed, 0x0c, 0xb6, 0xb4, 0x5c, 0x08,
+	0x84, 0x88, 0xda, 0x60, 0x26, 0xcc, 0xc0, 0x8b, 0xa2, 0x85, 0x78, 0xce, 0x1c, 0xd1, 0xeb, 0x04,
+	0x9e, 0xfa, 0xbf, 0x25, 0xb7, 0x8b, 0xd6, 0x71, 0xb2, 0xd2, 0x1b, 0x0f, 0x78, 0xce, 0x84, 0xce,
+	0xfb, 0x5b, 0xbb, 0x11, 0x1e, 0x0c, 0x3c, 0xeb, 0x66, 0x91, 0x40, 0x79, 0x99, 0x40, 0xf9, 0x27,
+	0x81, 0xf2, 0xe7, 0x06, 0x4a, 0xcb, 0x0d, 0x94, 0xbe, 0x37, 0x50, 0x7a, 0xbb, 0xf0, 0x03, 0x36,
+	0x98, 0xba, 0x46, 0x3f, 0x1a, 0x99, 0xe9, 0x9b, 0x2e, 0x87, 0xc8, 0xa5, 0xfc, 0x64, 0xce, 0xb3,
+	0x8d, 0x61, 0x1f, 0x63, 0x4c, 0xdd, 0x12, 0x5f, 0x95, 0xab, 0xdf, 0x01, 0x00, 0x0c, 0x33, 0x62,
+	0xeb, 0xb5, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +159,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextWithdrawalRequestID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextWithdrawalRequestID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WithdrawalRequests) > 0 {
+		for iNdEx := len(m.WithdrawalRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.VaultShareRecords) > 0 {
 		for iNdEx := len(m.VaultShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +250,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.WithdrawalRequests) > 0 {
+		for _, e := range m.WithdrawalRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextWithdrawalRequestID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextWithdrawalRequestID))
+	}
 	return n
 }
 
@@ -347,6 +398,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalRequests = append(m.WithdrawalRequests, WithdrawalRequest{})
+			if err := m.WithdrawalRequests[len(m.WithdrawalRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWithdrawalRequestID", wireType)
+			}
+			m.NextWithdrawalRequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWithdrawalRequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName name that will be used throughout the module
@@ -9,6 +14,11 @@ const (
 	// ModuleAccountName name of module account used to hold liquidity
 	ModuleAccountName = "earn"
 
+	// CDPStrategyAccountName name of module account used by the CDP mint
+	// strategy to own CDPs and Hard deposits, kept separate from the module
+	// account so minted USDX is not mixed with USDX vault deposits.
+	CDPStrategyAccountName = "earn_cdp_strategy"
+
 	// StoreKey Top level store key where all module items will be stored
 	StoreKey = ModuleName
 
@@ -19,10 +29,17 @@ const (
 	DefaultParamspace = ModuleName
 )
 
+// DefaultNextWithdrawalRequestID is the first withdrawal request ID
+const DefaultNextWithdrawalRequestID uint64 = 1
+
 // key prefixes for store
 var (
-	VaultRecordKeyPrefix      = []byte{0x01} // denom -> vault
-	VaultShareRecordKeyPrefix = []byte{0x02} // depositor address -> vault shares
+	VaultRecordKeyPrefix       = []byte{0x01} // denom -> vault
+	VaultShareRecordKeyPrefix  = []byte{0x02} // depositor address -> vault shares
+	WithdrawalRequestKeyPrefix = []byte{0x03} // request id -> withdrawal request
+	NextWithdrawalRequestIDKey = []byte{0x04} // next withdrawal request id
+
+	WithdrawalRequestByVaultKeyPrefix = []byte{0x05} // vault denom + request id -> request id
 )
 
 // VaultKey returns a key generated from a vault denom
@@ -34,3 +51,34 @@ func VaultKey(denom string) []byte {
 func DepositorVaultSharesKey(depositor sdk.AccAddress) []byte {
 	return depositor.Bytes()
 }
+
+// WithdrawalRequestKey returns a key from a withdrawal request ID. IDs are big
+// endian so requests are iterated in queue order.
+func WithdrawalRequestKey(id uint64) []byte {
+	return GetWithdrawalRequestIDBytes(id)
+}
+
+// VaultWithdrawalRequestsKey returns a key prefix for the withdrawal requests
+// of a vault. The denom is length prefixed so one denom cannot be a prefix of
+// another.
+func VaultWithdrawalRequestsKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// VaultWithdrawalRequestKey returns a key from a vault denom and withdrawal
+// request ID, so the requests of a vault are iterated in queue order.
+func VaultWithdrawalRequestKey(denom string, id uint64) []byte {
+	return append(VaultWithdrawalRequestsKey(denom), GetWithdrawalRequestIDBytes(id)...)
+}
+
+// GetWithdrawalRequestIDBytes returns the bytes of a withdrawal request ID.
+func GetWithdrawalRequestIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetWithdrawalRequestIDFromBytes returns a withdrawal request ID from bytes.
+func GetWithdrawalRequestIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
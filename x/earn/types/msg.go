@@ -10,14 +10,24 @@ import (
 var (
 	_ sdk.Msg            = &MsgDeposit{}
 	_ sdk.Msg            = &MsgWithdraw{}
+	_ sdk.Msg            = &MsgRebalanceVault{}
+	_ sdk.Msg            = &MsgRequestWithdrawal{}
+	_ sdk.Msg            = &MsgCancelWithdrawal{}
 	_ legacytx.LegacyMsg = &MsgDeposit{}
 	_ legacytx.LegacyMsg = &MsgWithdraw{}
+	_ legacytx.LegacyMsg = &MsgRebalanceVault{}
+	_ legacytx.LegacyMsg = &MsgRequestWithdrawal{}
+	_ legacytx.LegacyMsg = &MsgCancelWithdrawal{}
 )
 
 // legacy message types
 const (
-	TypeMsgDeposit  = "earn_msg_deposit"
-	TypeMsgWithdraw = "earn_msg_withdraw"
+	TypeMsgDeposit        = "earn_msg_deposit"
+	TypeMsgWithdraw       = "earn_msg_withdraw"
+	TypeMsgRebalanceVault = "earn_msg_rebalance_vault"
+
+	TypeMsgRequestWithdrawal = "earn_msg_request_withdrawal"
+	TypeMsgCancelWithdrawal  = "earn_msg_cancel_withdrawal"
 )
 
 // NewMsgDeposit returns a new MsgDeposit.
@@ -123,3 +133,144 @@ func (msg MsgWithdraw) Route() string {
 func (msg MsgWithdraw) Type() string {
 	return TypeMsgWithdraw
 }
+
+// NewMsgRebalanceVault returns a new MsgRebalanceVault.
+func NewMsgRebalanceVault(authority string, denom string) *MsgRebalanceVault {
+	return &MsgRebalanceVault{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRebalanceVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRebalanceVault) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRebalanceVault) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{authority}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRebalanceVault) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRebalanceVault) Type() string {
+	return TypeMsgRebalanceVault
+}
+
+// NewMsgRequestWithdrawal returns a new MsgRequestWithdrawal.
+func NewMsgRequestWithdrawal(from string, amount sdk.Coin) *MsgRequestWithdrawal {
+	return &MsgRequestWithdrawal{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRequestWithdrawal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if msg.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRequestWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRequestWithdrawal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRequestWithdrawal) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRequestWithdrawal) Type() string {
+	return TypeMsgRequestWithdrawal
+}
+
+// NewMsgCancelWithdrawal returns a new MsgCancelWithdrawal.
+func NewMsgCancelWithdrawal(from string, id uint64) *MsgCancelWithdrawal {
+	return &MsgCancelWithdrawal{
+		From: from,
+		ID:   id,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCancelWithdrawal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelWithdrawal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCancelWithdrawal) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCancelWithdrawal) Type() string {
+	return TypeMsgCancelWithdrawal
+}
@@ -3,11 +3,31 @@ package types
 import (
 	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// SwapStrategySlippageLimit is the maximum slippage allowed for swaps and
+	// deposits made by the swap LP strategy.
+	SwapStrategySlippageLimit = sdk.MustNewDecFromStr("0.01")
+	// CDPStrategyTargetRatioMultiplier is the multiple of the collateral type's
+	// liquidation ratio the CDP mint strategy targets when minting.
+	CDPStrategyTargetRatioMultiplier = sdk.MustNewDecFromStr("1.5")
+	// CDPStrategyDeleverageRatioMultiplier is the multiple of the collateral
+	// type's liquidation ratio below which the CDP mint strategy repays debt
+	// back to the target ratio.
+	CDPStrategyDeleverageRatioMultiplier = sdk.MustNewDecFromStr("1.25")
 )
 
 // IsValid returns true if the StrategyType status is valid and false otherwise.
 func (s StrategyType) IsValid() bool {
-	return s == STRATEGY_TYPE_HARD || s == STRATEGY_TYPE_SAVINGS
+	switch s {
+	case STRATEGY_TYPE_HARD, STRATEGY_TYPE_SAVINGS, STRATEGY_TYPE_SWAP_LP, STRATEGY_TYPE_CDP_MINT:
+		return true
+	default:
+		return false
+	}
 }
 
 // Validate returns an error if the StrategyType is invalid.
@@ -26,6 +46,10 @@ func NewStrategyTypeFromString(str string) StrategyType {
 		return STRATEGY_TYPE_HARD
 	case "savings":
 		return STRATEGY_TYPE_SAVINGS
+	case "swap-lp":
+		return STRATEGY_TYPE_SWAP_LP
+	case "cdp-mint":
+		return STRATEGY_TYPE_CDP_MINT
 	default:
 		return STRATEGY_TYPE_UNSPECIFIED
 	}
@@ -40,10 +64,6 @@ func (strategies StrategyTypes) Validate() error {
 		return fmt.Errorf("empty StrategyTypes")
 	}
 
-	if len(strategies) != 1 {
-		return fmt.Errorf("must have exactly one strategy type, multiple strategies are not supported")
-	}
-
 	uniqueStrategies := make(map[StrategyType]bool)
 
 	for _, strategy := range strategies {
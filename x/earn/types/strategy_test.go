@@ -23,6 +23,16 @@ func TestNewStrategyTypeFromString(t *testing.T) {
 			strategy: "savings",
 			expected: types.STRATEGY_TYPE_SAVINGS,
 		},
+		{
+			name:     "swap lp",
+			strategy: "swap-lp",
+			expected: types.STRATEGY_TYPE_SWAP_LP,
+		},
+		{
+			name:     "cdp mint",
+			strategy: "cdp-mint",
+			expected: types.STRATEGY_TYPE_CDP_MINT,
+		},
 		{
 			name:     "unspecified",
 			strategy: "not a valid strategy name",
@@ -73,8 +83,7 @@ func TestValidateStrategyTypes(t *testing.T) {
 			},
 			errArgs: errArgs{
 				expectPass: false,
-				// This will change to duplicate error if multiple strategies are supported
-				contains: "must have exactly one strategy type, multiple strategies are not supported",
+				contains:   "duplicate strategy STRATEGY_TYPE_SAVINGS",
 			},
 		},
 		{
@@ -94,14 +103,13 @@ func TestValidateStrategyTypes(t *testing.T) {
 			},
 		},
 		{
-			name: "invalid - more than 1",
+			name: "valid - more than 1",
 			strategies: types.StrategyTypes{
 				types.STRATEGY_TYPE_HARD,
 				types.STRATEGY_TYPE_SAVINGS,
 			},
 			errArgs: errArgs{
-				expectPass: false,
-				contains:   "must have exactly one strategy type, multiple strategies are not supported",
+				expectPass: true,
 			},
 		},
 	}
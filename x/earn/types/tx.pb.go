@@ -208,45 +208,321 @@ func (m *MsgWithdrawResponse) GetShares() VaultShare {
 	return VaultShare{}
 }
 
+// MsgRebalanceVault represents a message for moving a vault's funds between
+// its strategies towards their target weights. Rebalancing swaps and moves
+// funds at current prices, so it can only be executed by the module authority,
+// either through governance or a committee.
+type MsgRebalanceVault struct {
+	// authority is the address of the module authority, typically the x/gov
+	// module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom is the denom of the vault to rebalance.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRebalanceVault) Reset()         { *m = MsgRebalanceVault{} }
+func (m *MsgRebalanceVault) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceVault) ProtoMessage()    {}
+func (*MsgRebalanceVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9dcf48a3fa0009, []int{4}
+}
+func (m *MsgRebalanceVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceVault.Merge(m, src)
+}
+func (m *MsgRebalanceVault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceVault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceVault proto.InternalMessageInfo
+
+// MsgRebalanceVaultResponse defines the Msg/RebalanceVault response type.
+type MsgRebalanceVaultResponse struct {
+}
+
+func (m *MsgRebalanceVaultResponse) Reset()         { *m = MsgRebalanceVaultResponse{} }
+func (m *MsgRebalanceVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceVaultResponse) ProtoMessage()    {}
+func (*MsgRebalanceVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9dcf48a3fa0009, []int{5}
+}
+func (m *MsgRebalanceVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceVaultResponse.Merge(m, src)
+}
+func (m *MsgRebalanceVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceVaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceVaultResponse proto.InternalMessageInfo
+
+// MsgRequestWithdrawal represents a message for queueing a withdrawal from a
+// vault. The shares for the amount are held by the queue until the request is
+// processed or cancelled.
+type MsgRequestWithdrawal struct {
+	// from represents the address we are withdrawing for
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Amount represents the token to withdraw. The vault corresponds to the denom
+	// of the amount coin.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRequestWithdrawal) Reset()         { *m = MsgRequestWithdrawal{} }
+func (m *MsgRequestWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestWithdrawal) ProtoMessage()    {}
+func (*MsgRequestWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9dcf48a3fa0009, []int{6}
+}
+func (m *MsgRequestWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestWithdrawal.Merge(m, src)
+}
+func (m *MsgRequestWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestWithdrawal proto.InternalMessageInfo
+
+// MsgRequestWithdrawalResponse defines the Msg/RequestWithdrawal response type.
+type MsgRequestWithdrawalResponse struct {
+	// ID is the identifier of the queued withdrawal request.
+	ID     uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Shares VaultShare `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
+}
+
+func (m *MsgRequestWithdrawalResponse) Reset()         { *m = MsgRequestWithdrawalResponse{} }
+func (m *MsgRequestWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestWithdrawalResponse) ProtoMessage()    {}
+func (*MsgRequestWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9dcf48a3fa0009, []int{7}
+}
+func (m *MsgRequestWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgRequestWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestWithdrawalResponse proto.InternalMessageInfo
+
+func (m *MsgRequestWithdrawalResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgRequestWithdrawalResponse) GetShares() VaultShare {
+	if m != nil {
+		return m.Shares
+	}
+	return VaultShare{}
+}
+
+// MsgCancelWithdrawal represents a message for cancelling a queued withdrawal
+// and returning its shares to the owner.
+type MsgCancelWithdrawal struct {
+	// from represents the owner of the withdrawal request
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// ID is the identifier of the withdrawal request to cancel.
+	ID uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelWithdrawal) Reset()         { *m = MsgCancelWithdrawal{} }
+func (m *MsgCancelWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawal) ProtoMessage()    {}
+func (*MsgCancelWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9dcf48a3fa0009, []int{8}
+}
+func (m *MsgCancelWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawal.Merge(m, src)
+}
+func (m *MsgCancelWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelWithdrawal proto.InternalMessageInfo
+
+// MsgCancelWithdrawalResponse defines the Msg/CancelWithdrawal response type.
+type MsgCancelWithdrawalResponse struct {
+}
+
+func (m *MsgCancelWithdrawalResponse) Reset()         { *m = MsgCancelWithdrawalResponse{} }
+func (m *MsgCancelWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCancelWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e9dcf48a3fa0009, []int{9}
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelWithdrawalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "kava.earn.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "kava.earn.v1beta1.MsgDepositResponse")
 	proto.RegisterType((*MsgWithdraw)(nil), "kava.earn.v1beta1.MsgWithdraw")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "kava.earn.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgRebalanceVault)(nil), "kava.earn.v1beta1.MsgRebalanceVault")
+	proto.RegisterType((*MsgRebalanceVaultResponse)(nil), "kava.earn.v1beta1.MsgRebalanceVaultResponse")
+	proto.RegisterType((*MsgRequestWithdrawal)(nil), "kava.earn.v1beta1.MsgRequestWithdrawal")
+	proto.RegisterType((*MsgRequestWithdrawalResponse)(nil), "kava.earn.v1beta1.MsgRequestWithdrawalResponse")
+	proto.RegisterType((*MsgCancelWithdrawal)(nil), "kava.earn.v1beta1.MsgCancelWithdrawal")
+	proto.RegisterType((*MsgCancelWithdrawalResponse)(nil), "kava.earn.v1beta1.MsgCancelWithdrawalResponse")
 }
 
 func init() { proto.RegisterFile("kava/earn/v1beta1/tx.proto", fileDescriptor_2e9dcf48a3fa0009) }
 
 var fileDescriptor_2e9dcf48a3fa0009 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0xb1, 0x21, 0xb6, 0x13, 0x10, 0x1c, 0x7b, 0x48, 0x17, 0x3a, 0x09, 0x01, 0x4b, 0x0e,
-	0x76, 0x96, 0x46, 0x50, 0xb0, 0x17, 0x8d, 0x5e, 0x83, 0xb8, 0x11, 0x05, 0x2f, 0x32, 0x9b, 0x1d,
-	0x27, 0x8b, 0xdd, 0x9d, 0x65, 0xde, 0x24, 0x36, 0xff, 0xc0, 0xa3, 0x3f, 0xc1, 0xb3, 0x67, 0xc1,
-	0xab, 0xc7, 0x1e, 0x8b, 0x27, 0x4f, 0x22, 0xc9, 0x1f, 0x91, 0xdd, 0x99, 0xdd, 0x08, 0x09, 0xf5,
-	0x22, 0xf4, 0xf6, 0x66, 0xbe, 0xef, 0x7b, 0xfb, 0xbd, 0x6f, 0xe7, 0x61, 0xff, 0x3d, 0x9f, 0xf3,
-	0x40, 0x70, 0x9d, 0x05, 0xf3, 0x93, 0x48, 0x18, 0x7e, 0x12, 0x98, 0x73, 0x96, 0x6b, 0x65, 0x14,
-	0xb9, 0x5d, 0x60, 0xac, 0xc0, 0x98, 0xc3, 0x7c, 0x3a, 0x51, 0x90, 0x2a, 0x08, 0x22, 0x0e, 0xa2,
-	0x16, 0x4c, 0x54, 0x92, 0x59, 0x89, 0x7f, 0x60, 0xf1, 0xb7, 0xe5, 0x29, 0xb0, 0x07, 0x07, 0xed,
-	0x4b, 0x25, 0x95, 0xbd, 0x2f, 0x2a, 0x77, 0xdb, 0xdd, 0xfc, 0x3e, 0x18, 0xcd, 0x8d, 0x90, 0x0b,
-	0xc7, 0x38, 0xdc, 0x64, 0xcc, 0xf9, 0xec, 0xcc, 0x58, 0xb8, 0xf7, 0x1d, 0x61, 0x3c, 0x02, 0xf9,
-	0x4c, 0xe4, 0x0a, 0x12, 0x43, 0x1e, 0xe0, 0xbd, 0xd8, 0x96, 0x4a, 0xb7, 0x51, 0x17, 0xf5, 0xf7,
-	0x86, 0xed, 0x1f, 0x5f, 0x8f, 0xf7, 0x9d, 0x95, 0x27, 0x71, 0xac, 0x05, 0xc0, 0xd8, 0xe8, 0x24,
-	0x93, 0xe1, 0x9a, 0x4a, 0x1e, 0xe2, 0x26, 0x4f, 0xd5, 0x2c, 0x33, 0xed, 0x1b, 0x5d, 0xd4, 0x6f,
-	0x0d, 0x0e, 0x98, 0x53, 0x14, 0x93, 0x56, 0xe3, 0xb3, 0xa7, 0x2a, 0xc9, 0x86, 0x8d, 0x8b, 0x5f,
-	0x1d, 0x2f, 0x74, 0x74, 0x72, 0x8a, 0x77, 0x2b, 0xc3, 0xed, 0x9d, 0x2e, 0xea, 0xdf, 0x1a, 0x74,
-	0xd8, 0x46, 0x6e, 0x6c, 0xec, 0x28, 0x2f, 0x17, 0xb9, 0x08, 0x6b, 0xc1, 0xa3, 0xc6, 0xc7, 0xcf,
-	0x1d, 0xaf, 0xf7, 0x02, 0x93, 0xf5, 0x04, 0xa1, 0x80, 0x5c, 0x65, 0x20, 0xc8, 0x29, 0x6e, 0xc2,
-	0x94, 0x6b, 0x01, 0xe5, 0x18, 0xad, 0xc1, 0xe1, 0x96, 0xb6, 0xaf, 0x8a, 0x20, 0xc6, 0x05, 0xab,
-	0x72, 0x65, 0x25, 0xbd, 0x6f, 0x08, 0xb7, 0x46, 0x20, 0x5f, 0x27, 0x66, 0x1a, 0x6b, 0xfe, 0x81,
-	0xdc, 0xc3, 0x8d, 0x77, 0x5a, 0xa5, 0xff, 0x4c, 0xa4, 0x64, 0x5d, 0x6b, 0x18, 0x21, 0xbe, 0xf3,
-	0x97, 0xf1, 0xff, 0x92, 0xc6, 0xe0, 0x0b, 0xc2, 0x3b, 0x23, 0x90, 0xe4, 0x39, 0xbe, 0x59, 0xbd,
-	0x93, 0x6d, 0xfa, 0xf5, 0x4f, 0xf0, 0xef, 0x5e, 0x09, 0xd7, 0xae, 0x42, 0xbc, 0x5b, 0x47, 0x4c,
-	0xb7, 0x4b, 0x2a, 0xdc, 0x3f, 0xba, 0x1a, 0xaf, 0x7a, 0x0e, 0x1f, 0x5f, 0x2c, 0x29, 0xba, 0x5c,
-	0x52, 0xf4, 0x7b, 0x49, 0xd1, 0xa7, 0x15, 0xf5, 0x2e, 0x57, 0xd4, 0xfb, 0xb9, 0xa2, 0xde, 0x9b,
-	0x23, 0x99, 0x98, 0xe9, 0x2c, 0x62, 0x13, 0x95, 0x06, 0x45, 0xaf, 0xe3, 0x33, 0x1e, 0x41, 0x59,
-	0x05, 0xe7, 0x76, 0x41, 0xcc, 0x22, 0x17, 0x10, 0x35, 0xcb, 0xcd, 0xb8, 0xff, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x9c, 0x47, 0x8e, 0xc7, 0xdc, 0x03, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0xdd, 0x10, 0x9a, 0x2f, 0x52, 0x45, 0x4c, 0x84, 0x12, 0x97, 0x38, 0x51, 0x04, 0x25,
+	0x43, 0x6b, 0xab, 0x41, 0x02, 0x89, 0x2e, 0x90, 0x76, 0x61, 0x88, 0x10, 0x0e, 0x02, 0x89, 0x05,
+	0x9d, 0xe3, 0xc3, 0x31, 0xc4, 0xbe, 0xe0, 0x3b, 0x87, 0x66, 0x66, 0x61, 0xe4, 0x27, 0xf0, 0x23,
+	0x90, 0x58, 0x19, 0x3b, 0x56, 0x4c, 0x4c, 0x15, 0x4a, 0x46, 0xfe, 0x04, 0xb2, 0x7d, 0x76, 0x4a,
+	0xec, 0xb6, 0x01, 0x21, 0xd8, 0xee, 0xfc, 0xde, 0x77, 0xdf, 0x7b, 0xef, 0x3e, 0xdb, 0x20, 0xbf,
+	0x46, 0x13, 0xa4, 0x61, 0xe4, 0xb9, 0xda, 0x64, 0xd7, 0xc0, 0x0c, 0xed, 0x6a, 0xec, 0x50, 0x1d,
+	0x7b, 0x84, 0x11, 0xa9, 0x1c, 0x60, 0x6a, 0x80, 0xa9, 0x1c, 0x93, 0x95, 0x01, 0xa1, 0x0e, 0xa1,
+	0x9a, 0x81, 0x28, 0x4e, 0x0a, 0x06, 0xc4, 0x76, 0xa3, 0x12, 0xb9, 0x16, 0xe1, 0x2f, 0xc2, 0x9d,
+	0x16, 0x6d, 0x38, 0x54, 0xb1, 0x88, 0x45, 0xa2, 0xe7, 0xc1, 0x8a, 0x3f, 0x6d, 0xa6, 0xfb, 0x53,
+	0xe6, 0x21, 0x86, 0xad, 0x29, 0x67, 0xd4, 0xd3, 0x8c, 0x09, 0xf2, 0x47, 0x2c, 0x82, 0x5b, 0x5f,
+	0x04, 0x80, 0x1e, 0xb5, 0x0e, 0xf0, 0x98, 0x50, 0x9b, 0x49, 0x77, 0xa0, 0x68, 0x46, 0x4b, 0xe2,
+	0x55, 0x85, 0xa6, 0xd0, 0x2e, 0x76, 0xab, 0x5f, 0x3f, 0xed, 0x54, 0xb8, 0x94, 0x07, 0xa6, 0xe9,
+	0x61, 0x4a, 0xfb, 0xcc, 0xb3, 0x5d, 0x4b, 0x5f, 0x50, 0xa5, 0xbb, 0x50, 0x40, 0x0e, 0xf1, 0x5d,
+	0x56, 0x15, 0x9b, 0x42, 0xbb, 0xd4, 0xa9, 0xa9, 0xbc, 0x22, 0x70, 0x1a, 0xdb, 0x57, 0xf7, 0x89,
+	0xed, 0x76, 0xf3, 0x47, 0x27, 0x8d, 0x9c, 0xce, 0xe9, 0xd2, 0x1e, 0xac, 0xc7, 0x82, 0xab, 0x6b,
+	0x4d, 0xa1, 0xbd, 0xd1, 0x69, 0xa8, 0xa9, 0xdc, 0xd4, 0x3e, 0xa7, 0x3c, 0x99, 0x8e, 0xb1, 0x9e,
+	0x14, 0xdc, 0xcb, 0xbf, 0xff, 0xd8, 0xc8, 0xb5, 0x1e, 0x83, 0xb4, 0x70, 0xa0, 0x63, 0x3a, 0x26,
+	0x2e, 0xc5, 0xd2, 0x1e, 0x14, 0xe8, 0x10, 0x79, 0x98, 0x86, 0x36, 0x4a, 0x9d, 0x7a, 0xc6, 0xb1,
+	0x4f, 0x83, 0x20, 0xfa, 0x01, 0x2b, 0x56, 0x15, 0x95, 0xb4, 0x3e, 0x0b, 0x50, 0xea, 0x51, 0xeb,
+	0x99, 0xcd, 0x86, 0xa6, 0x87, 0xde, 0x4a, 0xdb, 0x90, 0x7f, 0xe9, 0x11, 0xe7, 0xc2, 0x44, 0x42,
+	0xd6, 0x7f, 0x0d, 0x43, 0x87, 0xab, 0xa7, 0x84, 0xff, 0x9d, 0x34, 0x2c, 0x28, 0xf7, 0xa8, 0xa5,
+	0x63, 0x03, 0x8d, 0x90, 0x3b, 0xc0, 0x21, 0x2f, 0x98, 0x14, 0xe4, 0xb3, 0x21, 0xf1, 0x6c, 0x36,
+	0xbd, 0x78, 0x52, 0x12, 0xaa, 0x54, 0x81, 0x4b, 0x26, 0x76, 0x89, 0x13, 0x66, 0x53, 0xd4, 0xa3,
+	0x0d, 0x17, 0xbf, 0x09, 0xb5, 0x54, 0xa3, 0xd8, 0x42, 0xeb, 0x9d, 0x00, 0x95, 0x10, 0x7d, 0xe3,
+	0x63, 0xca, 0x62, 0x87, 0x68, 0xf4, 0x8f, 0x2e, 0x87, 0x4b, 0xa4, 0x70, 0x3d, 0x4b, 0x44, 0x12,
+	0xf4, 0x35, 0x10, 0x6d, 0x33, 0x94, 0x92, 0xef, 0x16, 0x66, 0x27, 0x0d, 0xf1, 0xe1, 0x81, 0x2e,
+	0xda, 0xe6, 0xa9, 0x0b, 0x10, 0x7f, 0xff, 0x02, 0x50, 0x78, 0xa9, 0xfb, 0x41, 0x26, 0xa3, 0x3f,
+	0x36, 0x1e, 0x29, 0x13, 0x97, 0x95, 0x71, 0x5f, 0x75, 0xd8, 0xcc, 0x68, 0x11, 0xdb, 0xea, 0xfc,
+	0x58, 0x83, 0xb5, 0x1e, 0xb5, 0xa4, 0x47, 0x70, 0x39, 0xfe, 0x54, 0x64, 0x39, 0x58, 0xbc, 0x87,
+	0xf2, 0xcd, 0x73, 0xe1, 0x24, 0x2f, 0x1d, 0xd6, 0x93, 0xb7, 0x4c, 0xc9, 0x2e, 0x89, 0x71, 0x79,
+	0xeb, 0x7c, 0x3c, 0x39, 0xd3, 0x84, 0x8d, 0xa5, 0x61, 0xbd, 0x91, 0x5d, 0xf9, 0x2b, 0x4b, 0xde,
+	0x5e, 0x85, 0x95, 0x74, 0x71, 0xa0, 0x9c, 0x9e, 0xc5, 0x5b, 0x67, 0x1d, 0xb1, 0x44, 0x94, 0xb5,
+	0x15, 0x89, 0x49, 0xbb, 0x57, 0x70, 0x25, 0x35, 0x00, 0x67, 0x04, 0xb2, 0xcc, 0x93, 0xd5, 0xd5,
+	0x78, 0x71, 0xaf, 0xee, 0xfd, 0xa3, 0x99, 0x22, 0x1c, 0xcf, 0x14, 0xe1, 0xfb, 0x4c, 0x11, 0x3e,
+	0xcc, 0x95, 0xdc, 0xf1, 0x5c, 0xc9, 0x7d, 0x9b, 0x2b, 0xb9, 0xe7, 0x5b, 0x96, 0xcd, 0x86, 0xbe,
+	0xa1, 0x0e, 0x88, 0xa3, 0x05, 0x67, 0xee, 0x8c, 0x90, 0x41, 0xc3, 0x95, 0x76, 0x18, 0xfd, 0x64,
+	0xd8, 0x74, 0x8c, 0xa9, 0x51, 0x08, 0xff, 0x2e, 0xb7, 0x7f, 0x0e, 0x00, 0xbd, 0x94, 0xa1, 0x24,
+	0x20, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -265,6 +541,14 @@ type MsgClient interface {
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 	// Withdraw defines a method for withdrawing assets into a vault
 	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// RebalanceVault defines a governance operation for moving a vault's funds
+	// between its strategies towards their target weights
+	RebalanceVault(ctx context.Context, in *MsgRebalanceVault, opts ...grpc.CallOption) (*MsgRebalanceVaultResponse, error)
+	// RequestWithdrawal defines a method for queueing a withdrawal from a vault
+	// that is processed once the vault has enough liquidity
+	RequestWithdrawal(ctx context.Context, in *MsgRequestWithdrawal, opts ...grpc.CallOption) (*MsgRequestWithdrawalResponse, error)
+	// CancelWithdrawal defines a method for cancelling a queued withdrawal
+	CancelWithdrawal(ctx context.Context, in *MsgCancelWithdrawal, opts ...grpc.CallOption) (*MsgCancelWithdrawalResponse, error)
 }
 
 type msgClient struct {
@@ -293,12 +577,47 @@ func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) RebalanceVault(ctx context.Context, in *MsgRebalanceVault, opts ...grpc.CallOption) (*MsgRebalanceVaultResponse, error) {
+	out := new(MsgRebalanceVaultResponse)
+	err := c.cc.Invoke(ctx, "/kava.earn.v1beta1.Msg/RebalanceVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestWithdrawal(ctx context.Context, in *MsgRequestWithdrawal, opts ...grpc.CallOption) (*MsgRequestWithdrawalResponse, error) {
+	out := new(MsgRequestWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/kava.earn.v1beta1.Msg/RequestWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelWithdrawal(ctx context.Context, in *MsgCancelWithdrawal, opts ...grpc.CallOption) (*MsgCancelWithdrawalResponse, error) {
+	out := new(MsgCancelWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/kava.earn.v1beta1.Msg/CancelWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing assets into a vault
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 	// Withdraw defines a method for withdrawing assets into a vault
 	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// RebalanceVault defines a governance operation for moving a vault's funds
+	// between its strategies towards their target weights
+	RebalanceVault(context.Context, *MsgRebalanceVault) (*MsgRebalanceVaultResponse, error)
+	// RequestWithdrawal defines a method for queueing a withdrawal from a vault
+	// that is processed once the vault has enough liquidity
+	RequestWithdrawal(context.Context, *MsgRequestWithdrawal) (*MsgRequestWithdrawalResponse, error)
+	// CancelWithdrawal defines a method for cancelling a queued withdrawal
+	CancelWithdrawal(context.Context, *MsgCancelWithdrawal) (*MsgCancelWithdrawalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -311,6 +630,15 @@ func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*M
 func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (*UnimplementedMsgServer) RebalanceVault(ctx context.Context, req *MsgRebalanceVault) (*MsgRebalanceVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceVault not implemented")
+}
+func (*UnimplementedMsgServer) RequestWithdrawal(ctx context.Context, req *MsgRequestWithdrawal) (*MsgRequestWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) CancelWithdrawal(ctx context.Context, req *MsgCancelWithdrawal) (*MsgCancelWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWithdrawal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -352,6 +680,60 @@ func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RebalanceVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.earn.v1beta1.Msg/RebalanceVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceVault(ctx, req.(*MsgRebalanceVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.earn.v1beta1.Msg/RequestWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestWithdrawal(ctx, req.(*MsgRequestWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.earn.v1beta1.Msg/CancelWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelWithdrawal(ctx, req.(*MsgCancelWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.earn.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -364,6 +746,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _Msg_Withdraw_Handler,
 		},
+		{
+			MethodName: "RebalanceVault",
+			Handler:    _Msg_RebalanceVault_Handler,
+		},
+		{
+			MethodName: "RequestWithdrawal",
+			Handler:    _Msg_RequestWithdrawal_Handler,
+		},
+		{
+			MethodName: "CancelWithdrawal",
+			Handler:    _Msg_CancelWithdrawal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/earn/v1beta1/tx.proto",
@@ -525,41 +919,237 @@ func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRebalanceVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRebalanceVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Strategy != 0 {
-		n += 1 + sovTx(uint64(m.Strategy))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRebalanceVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Strategy != 0 {
+		n += 1 + sovTx(uint64(m.Strategy))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.Shares.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
@@ -587,20 +1177,534 @@ func (m *MsgWithdrawResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.Shares.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRebalanceVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRebalanceVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgCancelWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= StrategyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= StrategyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgRebalanceVault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -623,15 +1727,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebalanceVault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebalanceVault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -659,13 +1763,13 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -675,44 +1779,24 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
-			}
-			m.Strategy = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Strategy |= StrategyType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -734,7 +1818,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRebalanceVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -757,45 +1841,12 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebalanceVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebalanceVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -817,7 +1868,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -840,10 +1891,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -911,11 +1962,61 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Strategy = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -925,11 +2026,44 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Strategy |= StrategyType(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -951,7 +2085,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -974,17 +2108,17 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -994,25 +2128,93 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2,23 +2,55 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
+// FeeAccrualInterval is the minimum time between vault fee accruals in the
+// BeginBlocker. Fees are also accrued on every deposit and withdraw.
+var FeeAccrualInterval = time.Hour
+
+// MaxWithdrawalRequestsPerBlock is the maximum number of queued withdrawal
+// requests processed in the BeginBlocker, bounding the work done per block.
+const MaxWithdrawalRequestsPerBlock = 100
+
 // NewVaultRecord returns a new VaultRecord with 0 supply.
 func NewVaultRecord(vaultDenom string, amount sdk.Dec) VaultRecord {
 	return VaultRecord{
-		TotalShares: NewVaultShare(vaultDenom, amount),
+		TotalShares:   NewVaultShare(vaultDenom, amount),
+		HighWaterMark: sdk.ZeroDec(),
+		Buffer:        sdk.ZeroInt(),
 	}
 }
 
 // Validate returns an error if a VaultRecord is invalid.
 func (vr *VaultRecord) Validate() error {
+	if !vr.HighWaterMark.IsNil() && vr.HighWaterMark.IsNegative() {
+		return fmt.Errorf("vault high water mark is negative: %s", vr.HighWaterMark)
+	}
+
+	if vr.GetBuffer().IsNegative() {
+		return fmt.Errorf("vault buffer is negative: %s", vr.Buffer)
+	}
+
 	return vr.TotalShares.Validate()
 }
 
+// GetBuffer returns the liquidity buffer amount of the vault, zero if unset.
+func (vr *VaultRecord) GetBuffer() sdkmath.Int {
+	if vr.Buffer.IsNil() {
+		return sdk.ZeroInt()
+	}
+
+	return vr.Buffer
+}
+
 // VaultRecords is a slice of VaultRecord.
 type VaultRecords []VaultRecord
 
@@ -93,10 +125,14 @@ func NewAllowedVault(
 	allowedDepositors []sdk.AccAddress,
 ) AllowedVault {
 	return AllowedVault{
-		Denom:             denom,
-		Strategies:        strategyTypes,
-		IsPrivateVault:    isPrivateVault,
-		AllowedDepositors: allowedDepositors,
+		Denom:                denom,
+		Strategies:           strategyTypes,
+		IsPrivateVault:       isPrivateVault,
+		AllowedDepositors:    allowedDepositors,
+		PerformanceFee:       sdk.ZeroDec(),
+		ManagementFee:        sdk.ZeroDec(),
+		LiquidityBuffer:      sdk.ZeroDec(),
+		MinWithdrawalRequest: sdk.ZeroInt(),
 	}
 }
 
@@ -116,7 +152,164 @@ func (a *AllowedVault) Validate() error {
 		return fmt.Errorf("non-private vaults cannot have any AllowedDepositors")
 	}
 
-	return a.Strategies.Validate()
+	if err := a.Strategies.Validate(); err != nil {
+		return err
+	}
+
+	if err := a.validateStrategyWeights(); err != nil {
+		return err
+	}
+
+	if err := a.validateFees(); err != nil {
+		return err
+	}
+
+	liquidityBuffer := a.GetLiquidityBuffer()
+	if liquidityBuffer.IsNegative() || liquidityBuffer.GTE(sdk.OneDec()) {
+		return fmt.Errorf("liquidity buffer must be within [0, 1), got %s", liquidityBuffer)
+	}
+
+	if a.GetMinWithdrawalRequest().IsNegative() {
+		return fmt.Errorf("min withdrawal request cannot be negative, got %s", a.MinWithdrawalRequest)
+	}
+
+	if a.IsStrategyAllowed(STRATEGY_TYPE_SWAP_LP) {
+		if err := sdk.ValidateDenom(a.SwapPairDenom); err != nil {
+			return fmt.Errorf("invalid swap pair denom for swap LP strategy: %w", err)
+		}
+
+		if a.SwapPairDenom == a.Denom {
+			return fmt.Errorf("swap pair denom cannot be the same as vault denom %s", a.Denom)
+		}
+
+		if strings.TrimSpace(a.SwapDenomMarketID) == "" || strings.TrimSpace(a.SwapPairMarketID) == "" {
+			return fmt.Errorf("swap market IDs cannot be empty for swap LP strategy")
+		}
+	} else if a.SwapPairDenom != "" || a.SwapDenomMarketID != "" || a.SwapPairMarketID != "" {
+		return fmt.Errorf("swap pair denom and market IDs can only be set for vaults with the swap LP strategy")
+	}
+
+	if a.IsStrategyAllowed(STRATEGY_TYPE_CDP_MINT) {
+		if strings.TrimSpace(a.CdpCollateralType) == "" {
+			return fmt.Errorf("cdp collateral type cannot be empty for CDP mint strategy")
+		}
+	} else if a.CdpCollateralType != "" {
+		return fmt.Errorf("cdp collateral type can only be set for vaults with the CDP mint strategy")
+	}
+
+	return nil
+}
+
+// validateStrategyWeights returns an error if the strategy weights do not
+// match the strategies or do not sum to 1.
+func (a *AllowedVault) validateStrategyWeights() error {
+	if len(a.StrategyWeights) == 0 {
+		if len(a.Strategies) != 1 {
+			return fmt.Errorf("strategy weights are required for vaults with multiple strategies")
+		}
+
+		return nil
+	}
+
+	if len(a.StrategyWeights) != len(a.Strategies) {
+		return fmt.Errorf(
+			"number of strategy weights %d does not match number of strategies %d",
+			len(a.StrategyWeights), len(a.Strategies),
+		)
+	}
+
+	total := sdk.ZeroDec()
+	for i, weight := range a.StrategyWeights {
+		if weight.IsNil() || weight.IsNegative() {
+			return fmt.Errorf("invalid weight %s for strategy %s", weight, a.Strategies[i])
+		}
+
+		total = total.Add(weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("strategy weights must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// validateFees returns an error if the fees are not within [0, 1) or are set
+// without a fee recipient.
+func (a *AllowedVault) validateFees() error {
+	performanceFee := a.GetPerformanceFee()
+	if performanceFee.IsNegative() || performanceFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("performance fee must be within [0, 1), got %s", performanceFee)
+	}
+
+	managementFee := a.GetManagementFee()
+	if managementFee.IsNegative() || managementFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("management fee must be within [0, 1), got %s", managementFee)
+	}
+
+	if a.FeeRecipient.Empty() {
+		if performanceFee.IsPositive() || managementFee.IsPositive() {
+			return fmt.Errorf("fee recipient cannot be empty when vault fees are set")
+		}
+
+		return nil
+	}
+
+	if err := sdk.VerifyAddressFormat(a.FeeRecipient); err != nil {
+		return fmt.Errorf("invalid fee recipient: %w", err)
+	}
+
+	return nil
+}
+
+// GetPerformanceFee returns the performance fee of the vault, zero if unset.
+func (a *AllowedVault) GetPerformanceFee() sdk.Dec {
+	if a.PerformanceFee.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	return a.PerformanceFee
+}
+
+// GetManagementFee returns the annual management fee of the vault, zero if
+// unset.
+func (a *AllowedVault) GetManagementFee() sdk.Dec {
+	if a.ManagementFee.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	return a.ManagementFee
+}
+
+// GetLiquidityBuffer returns the fraction of the vault held idle as a
+// liquidity buffer, zero if unset.
+func (a *AllowedVault) GetLiquidityBuffer() sdk.Dec {
+	if a.LiquidityBuffer.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	return a.LiquidityBuffer
+}
+
+// GetMinWithdrawalRequest returns the smallest amount that can be queued in a
+// withdrawal request, zero if unset.
+func (a *AllowedVault) GetMinWithdrawalRequest() sdkmath.Int {
+	if a.MinWithdrawalRequest.IsNil() {
+		return sdk.ZeroInt()
+	}
+
+	return a.MinWithdrawalRequest
+}
+
+// GetStrategyWeights returns the target weight of each strategy, in the same
+// order as Strategies. A vault with a single strategy and no weights
+// allocates everything to that strategy.
+func (a *AllowedVault) GetStrategyWeights() []sdk.Dec {
+	if len(a.StrategyWeights) == 0 && len(a.Strategies) == 1 {
+		return []sdk.Dec{sdk.OneDec()}
+	}
+
+	return a.StrategyWeights
 }
 
 // IsStrategyAllowed returns true if the given strategy type is allowed for the
@@ -154,6 +347,8 @@ type AllowedVaults []AllowedVault
 // Validate returns an error if the AllowedVaults is invalid.
 func (a AllowedVaults) Validate() error {
 	denoms := make(map[string]bool)
+	swapPools := make(map[string]bool)
+	cdpVaults := 0
 
 	for _, v := range a {
 		if err := v.Validate(); err != nil {
@@ -165,7 +360,91 @@ func (a AllowedVaults) Validate() error {
 		}
 
 		denoms[v.Denom] = true
+
+		// Swap shares are owned by the module account, so vaults cannot share
+		// a pool or each would count the other's liquidity.
+		if v.IsStrategyAllowed(STRATEGY_TYPE_SWAP_LP) {
+			poolID := swaptypes.PoolID(v.Denom, v.SwapPairDenom)
+			if swapPools[poolID] {
+				return fmt.Errorf("duplicate swap LP strategy pool %s", poolID)
+			}
+
+			swapPools[poolID] = true
+		}
+
+		// The CDP mint strategy account holds a single position of minted
+		// USDX, which cannot be attributed to more than one vault.
+		if v.IsStrategyAllowed(STRATEGY_TYPE_CDP_MINT) {
+			cdpVaults++
+			if cdpVaults > 1 {
+				return fmt.Errorf("only one vault can use the CDP mint strategy")
+			}
+		}
 	}
 
 	return nil
 }
+
+// NewWithdrawalRequest returns a new WithdrawalRequest.
+func NewWithdrawalRequest(
+	id uint64,
+	owner sdk.AccAddress,
+	shares VaultShare,
+	requestTime time.Time,
+) WithdrawalRequest {
+	return WithdrawalRequest{
+		ID:          id,
+		Owner:       owner,
+		Shares:      shares,
+		RequestTime: requestTime,
+	}
+}
+
+// Validate returns an error if a WithdrawalRequest is invalid.
+func (wr WithdrawalRequest) Validate() error {
+	if wr.Owner.Empty() {
+		return fmt.Errorf("withdrawal request owner is empty")
+	}
+
+	if err := wr.Shares.Validate(); err != nil {
+		return fmt.Errorf("invalid withdrawal request shares: %w", err)
+	}
+
+	if !wr.Shares.Amount.IsPositive() {
+		return fmt.Errorf("withdrawal request shares must be positive")
+	}
+
+	return nil
+}
+
+// WithdrawalRequests is a slice of WithdrawalRequest.
+type WithdrawalRequests []WithdrawalRequest
+
+// Validate returns an error if a slice of WithdrawalRequests is invalid.
+func (wrs WithdrawalRequests) Validate() error {
+	ids := make(map[uint64]bool)
+
+	for _, wr := range wrs {
+		if err := wr.Validate(); err != nil {
+			return err
+		}
+
+		if ids[wr.ID] {
+			return fmt.Errorf("duplicate withdrawal request id %d", wr.ID)
+		}
+
+		ids[wr.ID] = true
+	}
+
+	return nil
+}
+
+// TotalShares returns the sum of the shares of all requests.
+func (wrs WithdrawalRequests) TotalShares() VaultShares {
+	total := NewVaultShares()
+	for _, wr := range wrs {
+		total = total.Add(wr.Shares)
+	}
+
+	return total
+}
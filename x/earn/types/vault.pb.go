@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,42 @@ type AllowedVault struct {
 	// are not allowed to deposit into this vault. If IsPrivateVault is false,
 	// this should be empty and ignored.
 	AllowedDepositors []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=allowed_depositors,json=allowedDepositors,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"allowed_depositors,omitempty"`
+	// SwapPairDenom is the denom paired with the vault denom in the swap pool
+	// used by the swap LP strategy. It must be set if the vault allows the swap
+	// LP strategy.
+	SwapPairDenom string `protobuf:"bytes,5,opt,name=swap_pair_denom,json=swapPairDenom,proto3" json:"swap_pair_denom,omitempty"`
+	// CdpCollateralType is the CDP collateral type used by the CDP mint
+	// strategy. It must be set if the vault allows the CDP mint strategy.
+	CdpCollateralType string `protobuf:"bytes,6,opt,name=cdp_collateral_type,json=cdpCollateralType,proto3" json:"cdp_collateral_type,omitempty"`
+	// StrategyWeights are the target allocations of the vault's value to each
+	// strategy, in the same order as Strategies. They must sum to 1. It may be
+	// empty for vaults with a single strategy, which receives all funds.
+	StrategyWeights []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,rep,name=strategy_weights,json=strategyWeights,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"strategy_weights"`
+	// PerformanceFee is the fraction of the growth in share price above the
+	// high-water mark that is charged as a fee. Zero disables the fee.
+	PerformanceFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=performance_fee,json=performanceFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"performance_fee"`
+	// ManagementFee is the fraction of the vault's total value that is charged
+	// as a fee per year. Zero disables the fee.
+	ManagementFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=management_fee,json=managementFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"management_fee"`
+	// FeeRecipient is the address that receives vault shares minted for fees.
+	// It must be set if either fee is non-zero.
+	FeeRecipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,10,opt,name=fee_recipient,json=feeRecipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"fee_recipient,omitempty"`
+	// LiquidityBuffer is the fraction of the vault's total value held idle in
+	// the earn module account instead of being deposited to strategies, so
+	// withdrawals can be served when strategies lack liquidity.
+	LiquidityBuffer github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=liquidity_buffer,json=liquidityBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_buffer"`
+	// SwapDenomMarketID is the pricefeed market for the vault denom used by the
+	// swap LP strategy to value its pool shares. It must be set if the vault
+	// allows the swap LP strategy.
+	SwapDenomMarketID string `protobuf:"bytes,12,opt,name=swap_denom_market_id,json=swapDenomMarketId,proto3" json:"swap_denom_market_id,omitempty"`
+	// SwapPairMarketID is the pricefeed market for the swap pair denom used by
+	// the swap LP strategy to value its pool shares. It must have the same quote
+	// asset as SwapDenomMarketID, and must be set if the vault allows the swap
+	// LP strategy.
+	SwapPairMarketID string `protobuf:"bytes,13,opt,name=swap_pair_market_id,json=swapPairMarketId,proto3" json:"swap_pair_market_id,omitempty"`
+	// MinWithdrawalRequest is the smallest amount of the vault denom that can be
+	// queued in a single withdrawal request.
+	MinWithdrawalRequest github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=min_withdrawal_request,json=minWithdrawalRequest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_withdrawal_request"`
 }
 
 func (m *AllowedVault) Reset()         { *m = AllowedVault{} }
@@ -103,10 +143,53 @@ func (m *AllowedVault) GetAllowedDepositors() []github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *AllowedVault) GetSwapPairDenom() string {
+	if m != nil {
+		return m.SwapPairDenom
+	}
+	return ""
+}
+
+func (m *AllowedVault) GetCdpCollateralType() string {
+	if m != nil {
+		return m.CdpCollateralType
+	}
+	return ""
+}
+
+func (m *AllowedVault) GetFeeRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return nil
+}
+
+func (m *AllowedVault) GetSwapDenomMarketID() string {
+	if m != nil {
+		return m.SwapDenomMarketID
+	}
+	return ""
+}
+
+func (m *AllowedVault) GetSwapPairMarketID() string {
+	if m != nil {
+		return m.SwapPairMarketID
+	}
+	return ""
+}
+
 // VaultRecord is the state of a vault.
 type VaultRecord struct {
 	// TotalShares is the total distributed number of shares in the vault.
 	TotalShares VaultShare `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares"`
+	// HighWaterMark is the highest share price, in vault denom per share, that
+	// performance fees have been charged up to.
+	HighWaterMark github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=high_water_mark,json=highWaterMark,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high_water_mark"`
+	// LastFeeAccrualTime is the time fees were last accrued for the vault.
+	LastFeeAccrualTime time.Time `protobuf:"bytes,3,opt,name=last_fee_accrual_time,json=lastFeeAccrualTime,proto3,stdtime" json:"last_fee_accrual_time"`
+	// Buffer is the amount of the vault denom held idle in the earn module
+	// account as the vault's liquidity buffer.
+	Buffer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=buffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"buffer"`
 }
 
 func (m *VaultRecord) Reset()         { *m = VaultRecord{} }
@@ -149,6 +232,13 @@ func (m *VaultRecord) GetTotalShares() VaultShare {
 	return VaultShare{}
 }
 
+func (m *VaultRecord) GetLastFeeAccrualTime() time.Time {
+	if m != nil {
+		return m.LastFeeAccrualTime
+	}
+	return time.Time{}
+}
+
 // VaultShareRecord defines the vault shares owned by a depositor.
 type VaultShareRecord struct {
 	// Depositor represents the owner of the shares
@@ -249,48 +339,152 @@ func (m *VaultShare) GetDenom() string {
 	return ""
 }
 
+// WithdrawalRequest is a queued withdrawal of vault shares. The shares are
+// removed from the owner's VaultShareRecord while queued and are paid out at
+// their value when the request is processed.
+type WithdrawalRequest struct {
+	// ID is the unique identifier of the request, in queue order.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner is the address that receives the withdrawn funds.
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// Shares are the vault shares to withdraw.
+	Shares VaultShare `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares"`
+	// RequestTime is the time the request was queued.
+	RequestTime time.Time `protobuf:"bytes,4,opt,name=request_time,json=requestTime,proto3,stdtime" json:"request_time"`
+}
+
+func (m *WithdrawalRequest) Reset()         { *m = WithdrawalRequest{} }
+func (m *WithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalRequest) ProtoMessage()    {}
+func (*WithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_884eb89509fbdc04, []int{4}
+}
+func (m *WithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalRequest.Merge(m, src)
+}
+func (m *WithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalRequest proto.InternalMessageInfo
+
+func (m *WithdrawalRequest) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *WithdrawalRequest) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *WithdrawalRequest) GetShares() VaultShare {
+	if m != nil {
+		return m.Shares
+	}
+	return VaultShare{}
+}
+
+func (m *WithdrawalRequest) GetRequestTime() time.Time {
+	if m != nil {
+		return m.RequestTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*AllowedVault)(nil), "kava.earn.v1beta1.AllowedVault")
 	proto.RegisterType((*VaultRecord)(nil), "kava.earn.v1beta1.VaultRecord")
 	proto.RegisterType((*VaultShareRecord)(nil), "kava.earn.v1beta1.VaultShareRecord")
 	proto.RegisterType((*VaultShare)(nil), "kava.earn.v1beta1.VaultShare")
+	proto.RegisterType((*WithdrawalRequest)(nil), "kava.earn.v1beta1.WithdrawalRequest")
 }
 
 func init() { proto.RegisterFile("kava/earn/v1beta1/vault.proto", fileDescriptor_884eb89509fbdc04) }
 
 var fileDescriptor_884eb89509fbdc04 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x93, 0x10, 0xd1, 0x4b, 0xa8, 0x1a, 0xb7, 0x83, 0xa9, 0x54, 0xdb, 0xca, 0x80, 0xbc,
-	0xd8, 0x56, 0xcb, 0x86, 0x18, 0x88, 0x15, 0x21, 0xc4, 0x84, 0xae, 0x85, 0x81, 0x25, 0xba, 0xd8,
-	0x47, 0x6a, 0xd5, 0xc9, 0x45, 0x7e, 0x17, 0x97, 0x2c, 0x7c, 0x06, 0x46, 0x24, 0x16, 0xe6, 0xce,
-	0xfd, 0x0c, 0xa8, 0x63, 0xd5, 0x09, 0x31, 0xa4, 0x28, 0xf9, 0x16, 0x4c, 0xe8, 0xfe, 0xa8, 0x8e,
-	0x14, 0x10, 0x0c, 0x4c, 0xb9, 0xfb, 0xbd, 0xf7, 0x7e, 0x7f, 0x5e, 0x7c, 0xe8, 0xe0, 0x8c, 0x94,
-	0x24, 0xa2, 0xa4, 0x98, 0x44, 0xe5, 0xe1, 0x90, 0x72, 0x72, 0x18, 0x95, 0x64, 0x96, 0xf3, 0x70,
-	0x5a, 0x30, 0xce, 0xac, 0x8e, 0x28, 0x87, 0xa2, 0x1c, 0xea, 0xf2, 0xfe, 0xc3, 0x84, 0xc1, 0x98,
-	0xc1, 0x40, 0x36, 0x44, 0xea, 0xa2, 0xba, 0xf7, 0xf7, 0x46, 0x6c, 0xc4, 0x14, 0x2e, 0x4e, 0x1a,
-	0xf5, 0x36, 0x25, 0x80, 0x17, 0x84, 0xd3, 0xd1, 0x5c, 0x75, 0x74, 0x3f, 0xd7, 0x50, 0xbb, 0x97,
-	0xe7, 0xec, 0x9c, 0xa6, 0x6f, 0x84, 0xb8, 0xb5, 0x87, 0xee, 0xa5, 0x74, 0xc2, 0xc6, 0xb6, 0xe9,
-	0x99, 0xfe, 0x16, 0x56, 0x17, 0x0b, 0x23, 0xa4, 0x07, 0x33, 0x0a, 0x76, 0xcd, 0xab, 0xfb, 0xdb,
-	0x47, 0x6e, 0xb8, 0xe1, 0x30, 0x3c, 0xd6, 0xec, 0x27, 0xf3, 0x29, 0x8d, 0x3b, 0x17, 0xb7, 0xee,
-	0x83, 0x75, 0x04, 0xf0, 0x1a, 0x8b, 0xe5, 0xa3, 0x9d, 0x4c, 0x64, 0xc9, 0x4a, 0xc2, 0xe9, 0x40,
-	0x46, 0xb7, 0xeb, 0x9e, 0xe9, 0xdf, 0xc7, 0xdb, 0x19, 0xbc, 0x52, 0xb0, 0xf2, 0x74, 0x8e, 0x2c,
-	0xa2, 0x3c, 0x0e, 0x52, 0x3a, 0x65, 0x90, 0x71, 0x56, 0x80, 0xdd, 0xf0, 0xea, 0x7e, 0x3b, 0x7e,
-	0xf1, 0x73, 0xe1, 0x06, 0xa3, 0x8c, 0x9f, 0xce, 0x86, 0x61, 0xc2, 0xc6, 0x7a, 0x2b, 0xfa, 0x27,
-	0x80, 0xf4, 0x2c, 0xe2, 0x42, 0x39, 0xec, 0x25, 0x49, 0x2f, 0x4d, 0x0b, 0x0a, 0x70, 0x73, 0x19,
-	0xec, 0xea, 0xdd, 0x69, 0x24, 0x9e, 0x73, 0x0a, 0xb8, 0xa3, 0x35, 0xfa, 0x77, 0x12, 0xdd, 0xd7,
-	0xa8, 0x25, 0x1d, 0x60, 0x9a, 0xb0, 0x22, 0xb5, 0x9e, 0xa3, 0x36, 0x67, 0x9c, 0xe4, 0x03, 0x38,
-	0x25, 0x05, 0x05, 0xb9, 0xa2, 0xd6, 0xd1, 0xc1, 0x6f, 0xf6, 0x20, 0xa7, 0x8e, 0x45, 0x57, 0xdc,
-	0xb8, 0x5a, 0xb8, 0x06, 0x6e, 0xc9, 0x41, 0x89, 0x40, 0xf7, 0xab, 0x89, 0x76, 0xaa, 0x0e, 0x4d,
-	0xfe, 0x0e, 0x6d, 0xdd, 0x85, 0x93, 0xcc, 0xff, 0x33, 0x5b, 0x45, 0x6d, 0xbd, 0x44, 0x4d, 0x6d,
-	0x5f, 0xfc, 0x8d, 0x7f, 0xb5, 0xbf, 0x2b, 0xec, 0x5f, 0xdc, 0xba, 0xad, 0x0a, 0x03, 0xac, 0x19,
-	0xba, 0x1f, 0x10, 0xaa, 0xe0, 0x3f, 0x7c, 0x3a, 0x27, 0xa8, 0x49, 0xc6, 0x6c, 0x36, 0xe1, 0x76,
-	0x4d, 0xc0, 0xf1, 0x53, 0x41, 0xf8, 0x7d, 0xe1, 0x3e, 0xfa, 0x87, 0x60, 0x7d, 0x9a, 0xdc, 0x5c,
-	0x06, 0x48, 0x27, 0xea, 0xd3, 0x04, 0x6b, 0xae, 0x27, 0x8d, 0x4f, 0x5f, 0x5c, 0x23, 0x7e, 0x76,
-	0xb5, 0x74, 0xcc, 0xeb, 0xa5, 0x63, 0xfe, 0x58, 0x3a, 0xe6, 0xc7, 0x95, 0x63, 0x5c, 0xaf, 0x1c,
-	0xe3, 0xdb, 0xca, 0x31, 0xde, 0xae, 0xb3, 0x8b, 0x7c, 0x41, 0x4e, 0x86, 0x20, 0x4f, 0xd1, 0x7b,
-	0xf5, 0x20, 0xa4, 0xc2, 0xb0, 0x29, 0x9f, 0xc1, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa0,
-	0xa9, 0xba, 0x64, 0x8d, 0x03, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3d, 0x6f, 0x1b, 0x47,
+	0x13, 0xd6, 0x91, 0x14, 0x5f, 0x71, 0xf9, 0xbd, 0xa2, 0x8d, 0x7b, 0x05, 0x98, 0x47, 0xb0, 0x30,
+	0xd8, 0xf0, 0x08, 0x2b, 0x5d, 0x92, 0x22, 0xa2, 0x09, 0x25, 0x0a, 0x10, 0xc0, 0x38, 0x09, 0x11,
+	0x90, 0x22, 0x87, 0xe5, 0xdd, 0xf0, 0xb8, 0xd0, 0x7d, 0x79, 0x77, 0x29, 0x46, 0x4d, 0x80, 0xfc,
+	0x03, 0xa7, 0x4b, 0x99, 0xda, 0xb5, 0x7f, 0x43, 0xe0, 0xd2, 0x70, 0x15, 0xa4, 0xa0, 0x02, 0xaa,
+	0xcb, 0x4f, 0x48, 0x15, 0xec, 0xde, 0xf2, 0x23, 0x51, 0x82, 0xd8, 0x08, 0x2b, 0xde, 0xcd, 0xcc,
+	0x3e, 0x33, 0xf3, 0xec, 0x33, 0x73, 0x44, 0x8f, 0xae, 0xc8, 0x35, 0x19, 0x00, 0x61, 0xf1, 0xe0,
+	0xfa, 0xc9, 0x18, 0x04, 0x79, 0x32, 0xb8, 0x26, 0xb3, 0x50, 0xd8, 0x29, 0x4b, 0x44, 0x82, 0x9b,
+	0xd2, 0x6d, 0x4b, 0xb7, 0xad, 0xdd, 0x47, 0xff, 0xf7, 0x12, 0x1e, 0x25, 0xdc, 0x55, 0x01, 0x83,
+	0xec, 0x25, 0x8b, 0x3e, 0x6a, 0x05, 0x49, 0x90, 0x64, 0x76, 0xf9, 0xa4, 0xad, 0x56, 0x90, 0x24,
+	0x41, 0x08, 0x03, 0xf5, 0x36, 0x9e, 0x4d, 0x06, 0x82, 0x46, 0xc0, 0x05, 0x89, 0x52, 0x1d, 0xd0,
+	0xb9, 0x5f, 0x03, 0x17, 0x8c, 0x08, 0x08, 0x6e, 0xb2, 0x88, 0xee, 0x77, 0x25, 0x54, 0x39, 0x09,
+	0xc3, 0x64, 0x0e, 0xfe, 0x97, 0xb2, 0x3a, 0xdc, 0x42, 0xfb, 0x3e, 0xc4, 0x49, 0x64, 0x1a, 0x1d,
+	0xa3, 0x57, 0x72, 0xb2, 0x17, 0xec, 0x20, 0xa4, 0x0f, 0x52, 0xe0, 0x66, 0xae, 0x93, 0xef, 0xd5,
+	0x8e, 0x2d, 0xfb, 0x5e, 0x0b, 0xf6, 0xb9, 0x46, 0xbf, 0xb8, 0x49, 0x61, 0xd8, 0x7c, 0x79, 0x6b,
+	0x55, 0xb7, 0x2d, 0xdc, 0xd9, 0x42, 0xc1, 0x3d, 0xd4, 0xa0, 0xb2, 0x59, 0x7a, 0x4d, 0x04, 0xb8,
+	0x8a, 0x1b, 0x33, 0xdf, 0x31, 0x7a, 0x07, 0x4e, 0x8d, 0xf2, 0x67, 0x99, 0x39, 0xab, 0x69, 0x8e,
+	0x30, 0xc9, 0x6a, 0x74, 0x7d, 0x48, 0x13, 0x4e, 0x45, 0xc2, 0xb8, 0x59, 0xe8, 0xe4, 0x7b, 0x95,
+	0xe1, 0x67, 0xbf, 0x2f, 0xac, 0x7e, 0x40, 0xc5, 0x74, 0x36, 0xb6, 0xbd, 0x24, 0xd2, 0xb4, 0xe9,
+	0x9f, 0x3e, 0xf7, 0xaf, 0x06, 0x42, 0x66, 0xb6, 0x4f, 0x3c, 0xef, 0xc4, 0xf7, 0x19, 0x70, 0xfe,
+	0xf6, 0x55, 0xff, 0x50, 0x93, 0xab, 0x2d, 0xc3, 0x1b, 0x01, 0xdc, 0x69, 0xea, 0x1c, 0xa3, 0x75,
+	0x0a, 0xfc, 0x18, 0xd5, 0xf9, 0x9c, 0xa4, 0x6e, 0x4a, 0x28, 0x73, 0x33, 0x5a, 0xf6, 0x15, 0x2d,
+	0x55, 0x69, 0x7e, 0x46, 0x28, 0x1b, 0x29, 0x7a, 0x6c, 0x74, 0xe8, 0xf9, 0xa9, 0xeb, 0x25, 0x61,
+	0x48, 0x04, 0x30, 0x12, 0xba, 0x32, 0xa9, 0x59, 0x54, 0xb1, 0x4d, 0xcf, 0x4f, 0x9f, 0xae, 0x3d,
+	0x92, 0x07, 0x1c, 0xa0, 0xc6, 0xea, 0x1e, 0xdc, 0x39, 0xd0, 0x60, 0x2a, 0xb8, 0xf9, 0xbf, 0x4e,
+	0xbe, 0x57, 0x1a, 0x7e, 0xfc, 0x7a, 0x61, 0xed, 0xfd, 0xb2, 0xb0, 0x1e, 0xbf, 0x43, 0x4b, 0x23,
+	0xf0, 0xde, 0xbe, 0xea, 0x23, 0xdd, 0xcb, 0x08, 0x3c, 0xa7, 0xbe, 0x42, 0xbd, 0xcc, 0x40, 0x31,
+	0xa0, 0x7a, 0x0a, 0x6c, 0x92, 0xb0, 0x88, 0xc4, 0x1e, 0xb8, 0x13, 0x00, 0xf3, 0xa0, 0x63, 0xfc,
+	0xe7, 0x3c, 0xb5, 0x2d, 0xd0, 0x53, 0x00, 0xec, 0xa1, 0x5a, 0x44, 0x62, 0x12, 0x40, 0x04, 0xb1,
+	0x50, 0x59, 0x4a, 0x3b, 0xc8, 0x52, 0xdd, 0x60, 0xca, 0x24, 0x11, 0xaa, 0x4e, 0x00, 0x5c, 0x06,
+	0x1e, 0x4d, 0x29, 0xc4, 0xc2, 0x44, 0x1d, 0x63, 0xa7, 0x02, 0xa8, 0x4c, 0x00, 0x9c, 0x15, 0xba,
+	0xbc, 0xa3, 0x90, 0x3e, 0x9f, 0x51, 0x9f, 0x8a, 0x1b, 0x77, 0x3c, 0x9b, 0x4c, 0x80, 0x99, 0xe5,
+	0x1d, 0x74, 0x55, 0x5f, 0xa3, 0x0e, 0x15, 0x28, 0x3e, 0x45, 0x2d, 0x25, 0x32, 0xa5, 0x2f, 0x37,
+	0x22, 0xec, 0x0a, 0x84, 0x4b, 0x7d, 0xb3, 0xa2, 0x92, 0x3d, 0x58, 0x2e, 0xac, 0xe6, 0xf9, 0x9c,
+	0xa4, 0x4a, 0x69, 0x5f, 0x28, 0xef, 0xd9, 0xc8, 0x69, 0xf2, 0xbf, 0x98, 0x7c, 0xfc, 0x14, 0x1d,
+	0x6e, 0xc4, 0xba, 0x81, 0xa9, 0x2a, 0x98, 0xd6, 0x72, 0x61, 0x35, 0xce, 0xb5, 0x68, 0xd7, 0x28,
+	0x0d, 0xfe, 0x67, 0x8b, 0x8f, 0x19, 0x7a, 0x18, 0xd1, 0xd8, 0x9d, 0x53, 0x31, 0xf5, 0x19, 0x99,
+	0x93, 0xd0, 0x65, 0xf0, 0x7c, 0x06, 0x5c, 0x98, 0xb5, 0xf7, 0xee, 0xfd, 0x2c, 0x16, 0x5b, 0xbd,
+	0x9f, 0xc5, 0xc2, 0x69, 0x45, 0x34, 0xbe, 0x5c, 0x43, 0x3b, 0x19, 0x72, 0xf7, 0xb7, 0x1c, 0x2a,
+	0xab, 0x41, 0x77, 0xc0, 0x4b, 0x98, 0x8f, 0x4f, 0x51, 0x45, 0x24, 0x82, 0x84, 0x2e, 0x9f, 0x12,
+	0x06, 0x5c, 0x6d, 0xa2, 0xf2, 0xf1, 0xa3, 0xbf, 0x59, 0x37, 0xea, 0xd4, 0xb9, 0x8c, 0x1a, 0x16,
+	0x64, 0x61, 0x4e, 0x59, 0x1d, 0x54, 0x16, 0x8e, 0x7d, 0x54, 0x9f, 0xd2, 0x60, 0xea, 0xce, 0xe5,
+	0xe4, 0x29, 0x46, 0xcc, 0xdc, 0x2e, 0x64, 0x29, 0x41, 0x2f, 0x25, 0xa6, 0x64, 0x0d, 0x5f, 0xa2,
+	0x07, 0x21, 0xe1, 0x4a, 0xf5, 0x2e, 0xf1, 0x3c, 0x36, 0x93, 0xd3, 0x4f, 0x23, 0x50, 0xbb, 0xac,
+	0x7c, 0x7c, 0x64, 0x67, 0x4b, 0xda, 0x5e, 0x2d, 0x69, 0xfb, 0x62, 0xb5, 0xa4, 0x87, 0x07, 0xb2,
+	0x8e, 0x17, 0xb7, 0x96, 0xe1, 0x60, 0x09, 0x71, 0x0a, 0x70, 0x92, 0x01, 0xc8, 0x10, 0x7c, 0x81,
+	0x8a, 0x5a, 0x76, 0x85, 0x1d, 0x50, 0xaf, 0xb1, 0xba, 0x3f, 0x19, 0xa8, 0xb1, 0xa1, 0x4d, 0x33,
+	0x3e, 0x41, 0xa5, 0xf5, 0x62, 0x35, 0x8d, 0x1d, 0x8f, 0xd5, 0x06, 0x1a, 0x7f, 0x8e, 0x8a, 0xfa,
+	0x4e, 0xe5, 0x27, 0xe4, 0x5f, 0xef, 0xf4, 0x50, 0x76, 0xfc, 0xf2, 0xd6, 0x2a, 0x6f, 0x6c, 0xdc,
+	0xd1, 0x08, 0xdd, 0x6f, 0x11, 0xda, 0x98, 0xff, 0xe1, 0xb3, 0x75, 0x81, 0x8a, 0x24, 0x4a, 0x66,
+	0xb1, 0xd8, 0xc9, 0xc5, 0x6b, 0xac, 0x0f, 0x0b, 0x3f, 0xfc, 0x68, 0xed, 0x75, 0xbf, 0xcf, 0xa1,
+	0xe6, 0x3d, 0x2d, 0xe3, 0x87, 0x28, 0x47, 0x7d, 0x55, 0x44, 0x61, 0x58, 0x5c, 0x2e, 0xac, 0xdc,
+	0xd9, 0xc8, 0xc9, 0x51, 0x1f, 0x7f, 0x8d, 0xf6, 0x93, 0x79, 0x0c, 0xcc, 0xcc, 0xed, 0x98, 0xdd,
+	0x0c, 0x16, 0x7f, 0xb4, 0x66, 0x36, 0xff, 0xee, 0xd3, 0xa2, 0x8f, 0xe0, 0x4f, 0x51, 0x45, 0x4f,
+	0x79, 0xa6, 0xdc, 0xc2, 0x7b, 0x28, 0xb7, 0xac, 0x4f, 0x4a, 0xdf, 0xf0, 0x93, 0xd7, 0xcb, 0xb6,
+	0xf1, 0x66, 0xd9, 0x36, 0x7e, 0x5d, 0xb6, 0x8d, 0x17, 0x77, 0xed, 0xbd, 0x37, 0x77, 0xed, 0xbd,
+	0x9f, 0xef, 0xda, 0x7b, 0x5f, 0x6d, 0x33, 0x2e, 0x2b, 0xeb, 0x87, 0x64, 0xcc, 0xd5, 0xd3, 0xe0,
+	0x9b, 0xec, 0x0f, 0x8a, 0x6a, 0x78, 0x5c, 0x54, 0xc9, 0x3e, 0xf8, 0x63, 0x00, 0x55, 0x40, 0x5d,
+	0xf2, 0x3e, 0x09, 0x00, 0x00,
 }
 
 func (m *AllowedVault) Marshal() (dAtA []byte, err error) {
@@ -313,6 +507,95 @@ func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinWithdrawalRequest.Size()
+		i -= size
+		if _, err := m.MinWithdrawalRequest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.SwapPairMarketID) > 0 {
+		i -= len(m.SwapPairMarketID)
+		copy(dAtA[i:], m.SwapPairMarketID)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.SwapPairMarketID)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.SwapDenomMarketID) > 0 {
+		i -= len(m.SwapDenomMarketID)
+		copy(dAtA[i:], m.SwapDenomMarketID)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.SwapDenomMarketID)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.LiquidityBuffer.Size()
+		i -= size
+		if _, err := m.LiquidityBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.ManagementFee.Size()
+		i -= size
+		if _, err := m.ManagementFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.PerformanceFee.Size()
+		i -= size
+		if _, err := m.PerformanceFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.StrategyWeights) > 0 {
+		for iNdEx := len(m.StrategyWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.StrategyWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.StrategyWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CdpCollateralType) > 0 {
+		i -= len(m.CdpCollateralType)
+		copy(dAtA[i:], m.CdpCollateralType)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.CdpCollateralType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SwapPairDenom) > 0 {
+		i -= len(m.SwapPairDenom)
+		copy(dAtA[i:], m.SwapPairDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.SwapPairDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.AllowedDepositors) > 0 {
 		for iNdEx := len(m.AllowedDepositors) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedDepositors[iNdEx])
@@ -380,6 +663,34 @@ func (m *VaultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Buffer.Size()
+		i -= size
+		if _, err := m.Buffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastFeeAccrualTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastFeeAccrualTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintVault(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HighWaterMark.Size()
+		i -= size
+		if _, err := m.HighWaterMark.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -477,6 +788,59 @@ func (m *VaultShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RequestTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintVault(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVault(v)
 	base := offset
@@ -514,6 +878,40 @@ func (m *AllowedVault) Size() (n int) {
 			n += 1 + l + sovVault(uint64(l))
 		}
 	}
+	l = len(m.SwapPairDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.CdpCollateralType)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.StrategyWeights) > 0 {
+		for _, e := range m.StrategyWeights {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	l = m.PerformanceFee.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.ManagementFee.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.LiquidityBuffer.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.SwapDenomMarketID)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.SwapPairMarketID)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.MinWithdrawalRequest.Size()
+	n += 1 + l + sovVault(uint64(l))
 	return n
 }
 
@@ -525,6 +923,12 @@ func (m *VaultRecord) Size() (n int) {
 	_ = l
 	l = m.TotalShares.Size()
 	n += 1 + l + sovVault(uint64(l))
+	l = m.HighWaterMark.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastFeeAccrualTime)
+	n += 1 + l + sovVault(uint64(l))
+	l = m.Buffer.Size()
+	n += 1 + l + sovVault(uint64(l))
 	return n
 }
 
@@ -562,6 +966,26 @@ func (m *VaultShare) Size() (n int) {
 	return n
 }
 
+func (m *WithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovVault(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestTime)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
 func sovVault(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -750,35 +1174,369 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 			m.AllowedDepositors = append(m.AllowedDepositors, make([]byte, postIndex-iNdEx))
 			copy(m.AllowedDepositors[len(m.AllowedDepositors)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipVault(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPairDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VaultRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+			m.SwapPairDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpCollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CdpCollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.StrategyWeights = append(m.StrategyWeights, v)
+			if err := m.StrategyWeights[len(m.StrategyWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerformanceFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagementFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ManagementFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = append(m.FeeRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeRecipient == nil {
+				m.FeeRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBuffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapDenomMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapDenomMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPairMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapPairMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWithdrawalRequest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWithdrawalRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowVault
 			}
 			if iNdEx >= l {
@@ -833,6 +1591,107 @@ func (m *VaultRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWaterMark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighWaterMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFeeAccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastFeeAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVault(dAtA[iNdEx:])
@@ -1088,6 +1947,175 @@ func (m *VaultShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RequestTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVault(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
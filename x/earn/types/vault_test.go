@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -75,6 +76,19 @@ func TestVaultRecordValidate(t *testing.T) {
 				contains:   "vault share amount -5.000000000000000000 is negative",
 			},
 		},
+		{
+			name: "invalid - negative buffer",
+			vaultRecords: types.VaultRecords{
+				{
+					TotalShares: types.NewVaultShare("usdx", sdk.NewDec(5)),
+					Buffer:      sdk.NewInt(-1),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "vault buffer is negative: -1",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -308,6 +322,318 @@ func TestAllowedVaultsValidate(t *testing.T) {
 				contains:   "non-private vaults cannot have any AllowedDepositors",
 			},
 		},
+		{
+			name: "valid - swap LP and CDP mint strategies",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "ukava",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapPairDenom:     "usdx",
+					SwapDenomMarketID: "kava:usd",
+					SwapPairMarketID:  "usdx:usd",
+				},
+				{
+					Denom:             "bnb",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_CDP_MINT},
+					CdpCollateralType: "bnb-a",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - swap LP without pair denom",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "ukava",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid swap pair denom for swap LP strategy",
+			},
+		},
+		{
+			name: "invalid - swap LP pair denom same as vault denom",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:         "ukava",
+					Strategies:    []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapPairDenom: "ukava",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "swap pair denom cannot be the same as vault denom ukava",
+			},
+		},
+		{
+			name: "invalid - swap pair denom without swap LP",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:         "usdx",
+					Strategies:    []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					SwapPairDenom: "ukava",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "swap pair denom and market IDs can only be set for vaults with the swap LP strategy",
+			},
+		},
+		{
+			name: "invalid - swap LP without market IDs",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:         "ukava",
+					Strategies:    []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapPairDenom: "usdx",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "swap market IDs cannot be empty for swap LP strategy",
+			},
+		},
+		{
+			name: "invalid - duplicate swap LP pool",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "ukava",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapPairDenom:     "usdx",
+					SwapDenomMarketID: "kava:usd",
+					SwapPairMarketID:  "usdx:usd",
+				},
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapPairDenom:     "ukava",
+					SwapDenomMarketID: "usdx:usd",
+					SwapPairMarketID:  "kava:usd",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate swap LP strategy pool ukava:usdx",
+			},
+		},
+		{
+			name: "invalid - CDP mint without collateral type",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "bnb",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_CDP_MINT},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "cdp collateral type cannot be empty for CDP mint strategy",
+			},
+		},
+		{
+			name: "invalid - collateral type without CDP mint",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "bnb",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					CdpCollateralType: "bnb-a",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "cdp collateral type can only be set for vaults with the CDP mint strategy",
+			},
+		},
+		{
+			name: "invalid - multiple CDP mint vaults",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "bnb",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_CDP_MINT},
+					CdpCollateralType: "bnb-a",
+				},
+				{
+					Denom:             "btcb",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_CDP_MINT},
+					CdpCollateralType: "btcb-a",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "only one vault can use the CDP mint strategy",
+			},
+		},
+		{
+			name: "valid - multiple strategies with weights",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyWeights: []sdk.Dec{sdk.MustNewDecFromStr("0.6"), sdk.MustNewDecFromStr("0.4")},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - multiple strategies without weights",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "strategy weights are required for vaults with multiple strategies",
+			},
+		},
+		{
+			name: "invalid - weights length mismatch",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyWeights: []sdk.Dec{sdk.OneDec()},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "number of strategy weights 1 does not match number of strategies 2",
+			},
+		},
+		{
+			name: "invalid - negative weight",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyWeights: []sdk.Dec{sdk.MustNewDecFromStr("1.5"), sdk.MustNewDecFromStr("-0.5")},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid weight -0.500000000000000000 for strategy STRATEGY_TYPE_SAVINGS",
+			},
+		},
+		{
+			name: "invalid - weights do not sum to 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyWeights: []sdk.Dec{sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.4")},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "strategy weights must sum to 1, got 0.900000000000000000",
+			},
+		},
+		{
+			name: "valid - vault fees",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:          "usdx",
+					Strategies:     []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					PerformanceFee: sdk.MustNewDecFromStr("0.1"),
+					ManagementFee:  sdk.MustNewDecFromStr("0.02"),
+					FeeRecipient:   sdk.AccAddress("fee_recipient_______"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - performance fee of 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:          "usdx",
+					Strategies:     []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					PerformanceFee: sdk.OneDec(),
+					FeeRecipient:   sdk.AccAddress("fee_recipient_______"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "performance fee must be within [0, 1), got 1.000000000000000000",
+			},
+		},
+		{
+			name: "invalid - negative management fee",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:         "usdx",
+					Strategies:    []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					ManagementFee: sdk.MustNewDecFromStr("-0.01"),
+					FeeRecipient:  sdk.AccAddress("fee_recipient_______"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "management fee must be within [0, 1), got -0.010000000000000000",
+			},
+		},
+		{
+			name: "invalid - fees without recipient",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:         "usdx",
+					Strategies:    []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					ManagementFee: sdk.MustNewDecFromStr("0.02"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "fee recipient cannot be empty when vault fees are set",
+			},
+		},
+		{
+			name: "valid - liquidity buffer",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					LiquidityBuffer: sdk.MustNewDecFromStr("0.1"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - liquidity buffer of 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					LiquidityBuffer: sdk.OneDec(),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "liquidity buffer must be within [0, 1), got 1.000000000000000000",
+			},
+		},
+		{
+			name: "invalid - negative min withdrawal request",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:                "usdx",
+					Strategies:           []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					MinWithdrawalRequest: sdk.NewInt(-1),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "min withdrawal request cannot be negative, got -1",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -324,6 +650,77 @@ func TestAllowedVaultsValidate(t *testing.T) {
 	}
 }
 
+func TestWithdrawalRequestsValidate(t *testing.T) {
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+
+	addr := sdk.AccAddress("addr1")
+	requestTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		requests types.WithdrawalRequests
+		errArgs  errArgs
+	}{
+		{
+			name: "valid withdrawal requests",
+			requests: types.WithdrawalRequests{
+				types.NewWithdrawalRequest(1, addr, types.NewVaultShare("usdx", sdk.NewDec(5)), requestTime),
+				types.NewWithdrawalRequest(2, addr, types.NewVaultShare("ukava", sdk.NewDec(5)), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - duplicate id",
+			requests: types.WithdrawalRequests{
+				types.NewWithdrawalRequest(1, addr, types.NewVaultShare("usdx", sdk.NewDec(5)), requestTime),
+				types.NewWithdrawalRequest(1, addr, types.NewVaultShare("ukava", sdk.NewDec(5)), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate withdrawal request id 1",
+			},
+		},
+		{
+			name: "invalid - empty owner",
+			requests: types.WithdrawalRequests{
+				types.NewWithdrawalRequest(1, nil, types.NewVaultShare("usdx", sdk.NewDec(5)), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "withdrawal request owner is empty",
+			},
+		},
+		{
+			name: "invalid - zero shares",
+			requests: types.WithdrawalRequests{
+				types.NewWithdrawalRequest(1, addr, types.NewVaultShare("usdx", sdk.ZeroDec()), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "withdrawal request shares must be positive",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.requests.Validate()
+
+			if test.errArgs.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.errArgs.contains)
+			}
+		})
+	}
+}
+
 func TestIsStrategyAllowed(t *testing.T) {
 	vault := types.NewAllowedVault(
 		"usdx",
@@ -40,4 +40,15 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(fmt.Sprintf("failed to accumulate earn rewards: %s", err))
 		}
 	}
+	for _, sourceRewardPeriods := range params.SourceRewardPeriods {
+		for _, rp := range sourceRewardPeriods.RewardPeriods {
+			k.AccumulateSourceRewards(ctx, sourceRewardPeriods.SourceType, rp)
+		}
+	}
+	k.AccumulateCampaignRewards(ctx)
+
+	// Locks are ended after accumulating, so rewards up to this block use the boosts that applied until now
+	k.EndBoostLocks(ctx)
+
+	k.ProcessAutoCompounding(ctx)
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -17,10 +18,12 @@ import (
 )
 
 const (
-	flagOwner    = "owner"
-	flagType     = "type"
-	flagUnsynced = "unsynced"
-	flagDenom    = "denom"
+	flagOwner      = "owner"
+	flagType       = "type"
+	flagUnsynced   = "unsynced"
+	flagDenom      = "denom"
+	flagSourceType = "source-type"
+	flagSourceID   = "source-id"
 )
 
 var rewardTypes = []string{
@@ -44,6 +47,10 @@ func GetQueryCmd() *cobra.Command {
 		queryRewardsCmd(),
 		queryRewardFactorsCmd(),
 		queryApyCmd(),
+		queryAccountRewardRatesCmd(),
+		queryBoostLockCmd(),
+		queryCampaignsCmd(),
+		queryCampaignCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -109,7 +116,7 @@ func queryRewardsCmd() *cobra.Command {
 		},
 	}
 	cmd.Flags().String(flagOwner, "", "(optional) filter by owner address")
-	cmd.Flags().String(flagType, "", fmt.Sprintf("(optional) filter by a reward type: %s", strings.Join(rewardTypes, "|")))
+	cmd.Flags().String(flagType, "", fmt.Sprintf("(optional) filter by a reward type: %s, or a registered reward source type", strings.Join(rewardTypes, "|")))
 	cmd.Flags().Bool(flagUnsynced, false, "(optional) get unsynced claims")
 	cmd.Flags().Int(flags.FlagPage, 1, "pagination page rewards of to query for")
 	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of rewards to query for")
@@ -183,3 +190,131 @@ func queryApyCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+func queryAccountRewardRatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-reward-rates [owner]",
+		Short: "queries the rewards an account earns per second from each of its rewarded positions",
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s query %s account-reward-rates kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw`, version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.AccountRewardRates(context.Background(), &types.QueryAccountRewardRatesRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	return cmd
+}
+
+func queryBoostLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "boost-lock [owner]",
+		Short: "queries an account's boost lock and the shares it adds to registered reward sources",
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s query %s boost-lock kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw`, version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.BoostLock(context.Background(), &types.QueryBoostLockRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	return cmd
+}
+
+func queryCampaignsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaigns",
+		Short: "queries incentive campaigns that have not yet ended",
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s query %s campaigns`, version.AppName, types.ModuleName),
+			fmt.Sprintf(`  $ %s query %s campaigns --source-type swap --source-id busd:ukava`, version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sourceType, _ := cmd.Flags().GetString(flagSourceType)
+			sourceID, _ := cmd.Flags().GetString(flagSourceID)
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Campaigns(context.Background(), &types.QueryCampaignsRequest{
+				SourceType: sourceType,
+				SourceID:   sourceID,
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagSourceType, "", "(optional) filter campaigns by source type")
+	cmd.Flags().String(flagSourceID, "", "(optional) filter campaigns by source id")
+	return cmd
+}
+
+func queryCampaignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaign [campaign-id]",
+		Short: "queries an incentive campaign by id",
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s query %s campaign 1`, version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("campaign id %s not a valid uint, please input a valid campaign id", args[0])
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Campaign(context.Background(), &types.QueryCampaignRequest{
+				CampaignId: campaignID,
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	return cmd
+}
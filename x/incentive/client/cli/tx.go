@@ -3,12 +3,14 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/incentive/types"
@@ -17,6 +19,8 @@ import (
 const (
 	multiplierFlag      = "multiplier"
 	multiplierFlagShort = "m"
+	receiverFlag        = "receiver"
+	validatorFlag       = "validator"
 )
 
 // GetTxCmd returns the transaction cli commands for the incentive module
@@ -33,6 +37,13 @@ func GetTxCmd() *cobra.Command {
 		getCmdClaimSwap(),
 		getCmdClaimSavings(),
 		getCmdClaimEarn(),
+		getCmdClaimAll(),
+		getCmdSetAutoCompound(),
+		getCmdRemoveAutoCompound(),
+		getCmdLock(),
+		getCmdExtendLock(),
+		getCmdWithdrawLock(),
+		getCmdCreateCampaign(),
 	}
 
 	for _, cmd := range cmds {
@@ -242,3 +253,247 @@ func getCmdClaimEarn() *cobra.Command {
 	}
 	return cmd
 }
+
+func getCmdClaimAll() *cobra.Command {
+	var denomsToClaim map[string]string
+	var receiver string
+
+	cmd := &cobra.Command{
+		Use:   "claim-all",
+		Short: "claim sender's rewards from all sources using given multipliers",
+		Long: `Claim sender's outstanding rewards from USDX minting, hard, delegator, swap, and earn in one transaction.
+Each reward denom is claimed with the multiplier given for it. Rewards are sent to the sender unless a receiver is given.`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=large --%s ukava=large`, version.AppName, types.ModuleName, multiplierFlag, multiplierFlag),
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=large,ukava=small --%s kava1...`, version.AppName, types.ModuleName, multiplierFlag, receiverFlag),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			if receiver == "" {
+				receiver = sender.String()
+			}
+			selections := types.NewSelectionsFromMap(denomsToClaim)
+
+			msg := types.NewMsgClaimAllRewards(sender.String(), receiver, selections)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringToStringVarP(&denomsToClaim, multiplierFlag, multiplierFlagShort, nil, "specify the denoms to claim, each with a multiplier lockup")
+	cmd.Flags().StringVar(&receiver, receiverFlag, "", "address to receive the rewards, defaults to the sender")
+	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func getCmdSetAutoCompound() *cobra.Command {
+	var denomsToClaim map[string]string
+	var validator string
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [claim-type] [target]",
+		Short: "automatically claim sender's rewards and deposit them into hard or earn",
+		Long: `Set the sender's rewards of a claim type to be claimed and deposited into a position once per day.
+Claim types are usdx_minting, hard_liquidity_provider, delegator_claim, swap, and earn. Targets are hard and earn.
+Only multipliers without a lockup can be used. A validator can be given with the earn target to compound the
+bond denom into a staking derivative of that validator.`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s tx %s set-auto-compound swap hard --%s ukava=none`, version.AppName, types.ModuleName, multiplierFlag),
+			fmt.Sprintf(`  $ %s tx %s set-auto-compound delegator_claim earn --%s ukava=none --%s kavavaloper1...`, version.AppName, types.ModuleName, multiplierFlag, validatorFlag),
+		}, "\n"),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := parseAutoCompoundTarget(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			selections := types.NewSelectionsFromMap(denomsToClaim)
+
+			msg := types.NewMsgSetAutoCompound(sender.String(), args[0], selections, target, validator)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringToStringVarP(&denomsToClaim, multiplierFlag, multiplierFlagShort, nil, "specify the denoms to claim, each with a multiplier lockup")
+	cmd.Flags().StringVar(&validator, validatorFlag, "", "validator to delegate the bond denom to when compounding into earn")
+	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func getCmdRemoveAutoCompound() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove-auto-compound [claim-type]",
+		Short:   "stop automatically compounding sender's rewards of a claim type",
+		Example: fmt.Sprintf(`  $ %s tx %s remove-auto-compound swap`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgRemoveAutoCompound(sender.String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdLock() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock [amount] [duration]",
+		Short: "lock KAVA or bkava to boost sender's rewards from registered reward sources",
+		Long: `Lock KAVA or bkava until the duration has passed to boost rewards from registered reward sources.
+When adding to an existing lock, the duration is ignored and the lock keeps its end time.`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s tx %s lock 1000000ukava 8760h`, version.AppName, types.ModuleName),
+			fmt.Sprintf(`  $ %s tx %s lock 1000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd 0s`, version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLock(sender.String(), amount, duration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdExtendLock() *cobra.Command {
+	return &cobra.Command{
+		Use:     "extend-lock [duration]",
+		Short:   "extend sender's boost lock to end after the duration from now",
+		Example: fmt.Sprintf(`  $ %s tx %s extend-lock 17520h`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			duration, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExtendLock(sender.String(), duration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdWithdrawLock() *cobra.Command {
+	return &cobra.Command{
+		Use:     "withdraw-lock",
+		Short:   "withdraw the coins of sender's ended boost lock",
+		Example: fmt.Sprintf(`  $ %s tx %s withdraw-lock`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgWithdrawLock(sender.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdCreateCampaign() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-campaign [source-type] [source-id] [amount] [start] [end]",
+		Short: "escrow rewards for a swap pool, earn vault, or registered reward source",
+		Long: `Escrow rewards from the sender to be streamed to the owners of a swap pool, earn vault, or registered reward
+source between the start and end times, given in RFC3339 format. Rewards not distributed by the end are refunded.`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s tx %s create-campaign swap busd:ukava 1000000000hard 2024-01-01T00:00:00Z 2024-02-01T00:00:00Z`, version.AppName, types.ModuleName),
+			fmt.Sprintf(`  $ %s tx %s create-campaign earn usdx 1000000000hard,1000000000ukava 2024-01-01T00:00:00Z 2024-02-01T00:00:00Z`, version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			start, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+			end, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateIncentiveCampaign(sender.String(), args[0], args[1], amount, start, end)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// parseAutoCompoundTarget converts a short target name such as "hard" into an AutoCompoundTarget.
+func parseAutoCompoundTarget(name string) (types.AutoCompoundTarget, error) {
+	target, found := types.AutoCompoundTarget_value["AUTO_COMPOUND_TARGET_"+strings.ToUpper(name)]
+	if !found || !types.AutoCompoundTarget(target).IsValid() {
+		return types.AUTO_COMPOUND_TARGET_UNSPECIFIED, fmt.Errorf("invalid auto-compound target '%s', expected hard or earn", name)
+	}
+	return types.AutoCompoundTarget(target), nil
+}
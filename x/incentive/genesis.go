@@ -19,11 +19,19 @@ func InitGenesis(
 	cdpKeeper types.CdpKeeper,
 	gs types.GenesisState,
 ) {
-	// check if the module account exists
+	// check if the module accounts exist
 	moduleAcc := accountKeeper.GetModuleAccount(ctx, types.IncentiveMacc)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.IncentiveMacc))
 	}
+	boostLockAcc := accountKeeper.GetModuleAccount(ctx, types.BoostLockAccountName)
+	if boostLockAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.BoostLockAccountName))
+	}
+	campaignAcc := accountKeeper.GetModuleAccount(ctx, types.CampaignAccountName)
+	if campaignAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.CampaignAccountName))
+	}
 
 	if err := gs.Validate(); err != nil {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
@@ -38,101 +46,71 @@ func InitGenesis(
 
 	k.SetParams(ctx, gs.Params)
 
-	// USDX Minting
-	for _, claim := range gs.USDXMintingClaims {
-		k.SetUSDXMintingClaim(ctx, claim)
-	}
-	for _, gat := range gs.USDXRewardState.AccumulationTimes {
-		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
-			panic(err.Error())
-		}
-		k.SetPreviousUSDXMintingAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
-	}
+	// Built in reward sources
 	for _, mri := range gs.USDXRewardState.MultiRewardIndexes {
-		factor, found := mri.RewardIndexes.Get(types.USDXMintingRewardDenom)
-		if !found || len(mri.RewardIndexes) != 1 {
+		if _, found := mri.RewardIndexes.Get(types.USDXMintingRewardDenom); !found || len(mri.RewardIndexes) != 1 {
 			panic(fmt.Sprintf("USDX Minting reward factors must only have denom %s", types.USDXMintingRewardDenom))
 		}
-		k.SetUSDXMintingRewardFactor(ctx, mri.CollateralType, factor)
 	}
+	setSourceGenesisRewardState(ctx, k, types.USDXMintingRewardSourceType, gs.USDXRewardState)
+	setSourceGenesisRewardState(ctx, k, types.HardSupplyRewardSourceType, gs.HardSupplyRewardState)
+	setSourceGenesisRewardState(ctx, k, types.HardBorrowRewardSourceType, gs.HardBorrowRewardState)
+	setSourceGenesisRewardState(ctx, k, types.DelegatorRewardSourceType, gs.DelegatorRewardState)
+	setSourceGenesisRewardState(ctx, k, types.SwapRewardSourceType, gs.SwapRewardState)
+	setSourceGenesisRewardState(ctx, k, types.SavingsRewardSourceType, gs.SavingsRewardState)
+	setSourceGenesisRewardState(ctx, k, types.EarnRewardSourceType, gs.EarnRewardState)
 
-	// Hard Supply / Borrow
-	for _, claim := range gs.HardLiquidityProviderClaims {
-		k.SetHardLiquidityProviderClaim(ctx, claim)
-	}
-	for _, gat := range gs.HardSupplyRewardState.AccumulationTimes {
-		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
-			panic(err.Error())
-		}
-		k.SetPreviousHardSupplyRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
-	}
-	for _, mri := range gs.HardSupplyRewardState.MultiRewardIndexes {
-		k.SetHardSupplyRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
-	}
-	for _, gat := range gs.HardBorrowRewardState.AccumulationTimes {
-		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
-			panic(err.Error())
-		}
-		k.SetPreviousHardBorrowRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
-	}
-	for _, mri := range gs.HardBorrowRewardState.MultiRewardIndexes {
-		k.SetHardBorrowRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	// Registered reward sources
+	for _, state := range gs.SourceRewardStates {
+		setSourceGenesisRewardState(ctx, k, state.SourceType, state.RewardState)
 	}
 
-	// Delegator
-	for _, claim := range gs.DelegatorClaims {
-		k.SetDelegatorClaim(ctx, claim)
+	// Claims of all reward sources, including claims exported before claims were stored per source
+	for _, claim := range gs.GetSourceClaims() {
+		k.SetSourceClaim(ctx, claim)
 	}
-	for _, gat := range gs.DelegatorRewardState.AccumulationTimes {
-		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
-			panic(err.Error())
-		}
-		k.SetPreviousDelegatorRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
-	}
-	for _, mri := range gs.DelegatorRewardState.MultiRewardIndexes {
-		k.SetDelegatorRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+
+	// Auto-compounding
+	for _, setting := range gs.AutoCompoundSettings {
+		k.SetAutoCompoundSetting(ctx, setting)
 	}
 
-	// Swap
-	for _, claim := range gs.SwapClaims {
-		k.SetSwapClaim(ctx, claim)
+	// Boosts
+	k.SetTotalBoostWeight(ctx, sdk.ZeroDec())
+	lockedCoins := sdk.NewCoins()
+	for _, lock := range gs.BoostLocks {
+		k.SetBoostLock(ctx, lock)
+		lockedCoins = lockedCoins.Add(lock.Amount)
 	}
-	for _, gat := range gs.SwapRewardState.AccumulationTimes {
-		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
-			panic(err.Error())
-		}
-		k.SetSwapRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
+	if balance := bankKeeper.GetAllBalances(ctx, boostLockAcc.GetAddress()); !balance.IsAllGTE(lockedCoins) {
+		panic(fmt.Sprintf("%s module account balance %s is less than the locked coins %s", types.BoostLockAccountName, balance, lockedCoins))
 	}
-	for _, mri := range gs.SwapRewardState.MultiRewardIndexes {
-		k.SetSwapRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	for _, bs := range gs.BoostedShares {
+		k.SetBoostedShares(ctx, bs)
 	}
 
-	// Savings
-	for _, claim := range gs.SavingsClaims {
-		k.SetSavingsClaim(ctx, claim)
-	}
-	for _, gat := range gs.SavingsRewardState.AccumulationTimes {
-		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
-			panic(err.Error())
-		}
-		k.SetSavingsRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
+	// Incentive campaigns
+	escrowedCoins := sdk.NewCoins()
+	for _, campaign := range gs.Campaigns {
+		k.SetIncentiveCampaign(ctx, campaign)
+		escrowedCoins = escrowedCoins.Add(campaign.Escrowed()...)
 	}
-	for _, mri := range gs.SavingsRewardState.MultiRewardIndexes {
-		k.SetSavingsRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	if balance := bankKeeper.GetAllBalances(ctx, campaignAcc.GetAddress()); !balance.IsAllGTE(escrowedCoins) {
+		panic(fmt.Sprintf("%s module account balance %s is less than the escrowed coins %s", types.CampaignAccountName, balance, escrowedCoins))
 	}
+	k.SetNextCampaignID(ctx, gs.NextCampaignID)
+}
 
-	// Earn
-	for _, claim := range gs.EarnClaims {
-		k.SetEarnClaim(ctx, claim)
-	}
-	for _, gat := range gs.EarnRewardState.AccumulationTimes {
+// setSourceGenesisRewardState sets the global reward state of a source type from genesis
+func setSourceGenesisRewardState(ctx sdk.Context, k keeper.Keeper, sourceType string, state types.GenesisRewardState) {
+	for _, gat := range state.AccumulationTimes {
 		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
 			panic(err.Error())
 		}
-		k.SetEarnRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
+		k.SetSourceRewardAccrualTime(ctx, sourceType, gat.CollateralType, gat.PreviousAccumulationTime)
 	}
-	for _, mri := range gs.EarnRewardState.MultiRewardIndexes {
-		k.SetEarnRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	for _, mri := range state.MultiRewardIndexes {
+		k.SetSourceRewardIndexes(ctx, sourceType, mri.CollateralType, mri.RewardIndexes)
 	}
 }
 
@@ -140,146 +118,58 @@ func InitGenesis(
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 
-	usdxClaims := k.GetAllUSDXMintingClaims(ctx)
-	usdxRewardState := getUSDXMintingGenesisRewardState(ctx, k)
-
-	hardClaims := k.GetAllHardLiquidityProviderClaims(ctx)
-	hardSupplyRewardState := getHardSupplyGenesisRewardState(ctx, k)
-	hardBorrowRewardState := getHardBorrowGenesisRewardState(ctx, k)
-
-	delegatorClaims := k.GetAllDelegatorClaims(ctx)
-	delegatorRewardState := getDelegatorGenesisRewardState(ctx, k)
+	usdxRewardState := getSourceGenesisRewardState(ctx, k, types.USDXMintingRewardSourceType)
+	hardSupplyRewardState := getSourceGenesisRewardState(ctx, k, types.HardSupplyRewardSourceType)
+	hardBorrowRewardState := getSourceGenesisRewardState(ctx, k, types.HardBorrowRewardSourceType)
+	delegatorRewardState := getSourceGenesisRewardState(ctx, k, types.DelegatorRewardSourceType)
+	swapRewardState := getSourceGenesisRewardState(ctx, k, types.SwapRewardSourceType)
+	savingsRewardState := getSourceGenesisRewardState(ctx, k, types.SavingsRewardSourceType)
+	earnRewardState := getSourceGenesisRewardState(ctx, k, types.EarnRewardSourceType)
+
+	sourceClaims := k.GetAllSourceClaims(ctx)
+	sourceRewardStates := types.TypedGenesisRewardStates{}
+	for _, sourceType := range k.GetSourceTypesWithRewardState(ctx) {
+		if types.IsBuiltInSourceType(sourceType) {
+			continue
+		}
+		sourceRewardStates = append(
+			sourceRewardStates,
+			types.NewTypedGenesisRewardState(sourceType, getSourceGenesisRewardState(ctx, k, sourceType)),
+		)
+	}
 
-	swapClaims := k.GetAllSwapClaims(ctx)
-	swapRewardState := getSwapGenesisRewardState(ctx, k)
+	autoCompoundSettings := k.GetAllAutoCompoundSettings(ctx)
 
-	savingsClaims := k.GetAllSavingsClaims(ctx)
-	savingsRewardState := getSavingsGenesisRewardState(ctx, k)
+	boostLocks := k.GetAllBoostLocks(ctx)
+	boostedShares := k.GetAllBoostedShares(ctx)
 
-	earnClaims := k.GetAllEarnClaims(ctx)
-	earnRewardState := getEarnGenesisRewardState(ctx, k)
+	campaigns := k.GetAllIncentiveCampaigns(ctx)
+	nextCampaignID := k.GetNextCampaignID(ctx)
 
 	return types.NewGenesisState(
 		params,
 		// Reward states
 		usdxRewardState, hardSupplyRewardState, hardBorrowRewardState, delegatorRewardState, swapRewardState, savingsRewardState, earnRewardState,
-		// Claims
-		usdxClaims, hardClaims, delegatorClaims, swapClaims, savingsClaims, earnClaims,
+		autoCompoundSettings,
+		// Registered reward sources, and the claims of all sources
+		sourceRewardStates, sourceClaims,
+		// Boosts
+		boostLocks, boostedShares,
+		// Incentive campaigns
+		campaigns, nextCampaignID,
 	)
 }
 
-func getUSDXMintingGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.GenesisRewardState {
-	var ats types.AccumulationTimes
-	keeper.IterateUSDXMintingAccrualTimes(ctx, func(ctype string, accTime time.Time) bool {
-		ats = append(ats, types.NewAccumulationTime(ctype, accTime))
-		return false
-	})
-
-	var mris types.MultiRewardIndexes
-	keeper.IterateUSDXMintingRewardFactors(ctx, func(ctype string, factor sdk.Dec) bool {
-		mris = append(
-			mris,
-			types.NewMultiRewardIndex(
-				ctype,
-				types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, factor)},
-			),
-		)
-		return false
-	})
-
-	return types.NewGenesisRewardState(ats, mris)
-}
-
-func getHardSupplyGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.GenesisRewardState {
-	var ats types.AccumulationTimes
-	keeper.IterateHardSupplyRewardAccrualTimes(ctx, func(ctype string, accTime time.Time) bool {
-		ats = append(ats, types.NewAccumulationTime(ctype, accTime))
-		return false
-	})
-
-	var mris types.MultiRewardIndexes
-	keeper.IterateHardSupplyRewardIndexes(ctx, func(ctype string, indexes types.RewardIndexes) bool {
-		mris = append(mris, types.NewMultiRewardIndex(ctype, indexes))
-		return false
-	})
-
-	return types.NewGenesisRewardState(ats, mris)
-}
-
-func getHardBorrowGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.GenesisRewardState {
-	var ats types.AccumulationTimes
-	keeper.IterateHardBorrowRewardAccrualTimes(ctx, func(ctype string, accTime time.Time) bool {
-		ats = append(ats, types.NewAccumulationTime(ctype, accTime))
-		return false
-	})
-
-	var mris types.MultiRewardIndexes
-	keeper.IterateHardBorrowRewardIndexes(ctx, func(ctype string, indexes types.RewardIndexes) bool {
-		mris = append(mris, types.NewMultiRewardIndex(ctype, indexes))
-		return false
-	})
-
-	return types.NewGenesisRewardState(ats, mris)
-}
-
-func getDelegatorGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.GenesisRewardState {
-	var ats types.AccumulationTimes
-	keeper.IterateDelegatorRewardAccrualTimes(ctx, func(ctype string, accTime time.Time) bool {
-		ats = append(ats, types.NewAccumulationTime(ctype, accTime))
-		return false
-	})
-
-	var mris types.MultiRewardIndexes
-	keeper.IterateDelegatorRewardIndexes(ctx, func(ctype string, indexes types.RewardIndexes) bool {
-		mris = append(mris, types.NewMultiRewardIndex(ctype, indexes))
-		return false
-	})
-
-	return types.NewGenesisRewardState(ats, mris)
-}
-
-func getSwapGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.GenesisRewardState {
-	var ats types.AccumulationTimes
-	keeper.IterateSwapRewardAccrualTimes(ctx, func(ctype string, accTime time.Time) bool {
-		ats = append(ats, types.NewAccumulationTime(ctype, accTime))
-		return false
-	})
-
-	var mris types.MultiRewardIndexes
-	keeper.IterateSwapRewardIndexes(ctx, func(ctype string, indexes types.RewardIndexes) bool {
-		mris = append(mris, types.NewMultiRewardIndex(ctype, indexes))
-		return false
-	})
-
-	return types.NewGenesisRewardState(ats, mris)
-}
-
-func getSavingsGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.GenesisRewardState {
-	var ats types.AccumulationTimes
-	keeper.IterateSavingsRewardAccrualTimes(ctx, func(ctype string, accTime time.Time) bool {
-		ats = append(ats, types.NewAccumulationTime(ctype, accTime))
-		return false
-	})
-
-	var mris types.MultiRewardIndexes
-	keeper.IterateSavingsRewardIndexes(ctx, func(ctype string, indexes types.RewardIndexes) bool {
-		mris = append(mris, types.NewMultiRewardIndex(ctype, indexes))
-		return false
-	})
-
-	return types.NewGenesisRewardState(ats, mris)
-}
-
-func getEarnGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.GenesisRewardState {
+func getSourceGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper, sourceType string) types.GenesisRewardState {
 	var ats types.AccumulationTimes
-	keeper.IterateEarnRewardAccrualTimes(ctx, func(ctype string, accTime time.Time) bool {
-		ats = append(ats, types.NewAccumulationTime(ctype, accTime))
+	keeper.IterateSourceRewardAccrualTimes(ctx, sourceType, func(sourceID string, accTime time.Time) bool {
+		ats = append(ats, types.NewAccumulationTime(sourceID, accTime))
 		return false
 	})
 
 	var mris types.MultiRewardIndexes
-	keeper.IterateEarnRewardIndexes(ctx, func(ctype string, indexes types.RewardIndexes) bool {
-		mris = append(mris, types.NewMultiRewardIndex(ctype, indexes))
+	keeper.IterateSourceRewardIndexes(ctx, sourceType, func(sourceID string, indexes types.RewardIndexes) bool {
+		mris = append(mris, types.NewMultiRewardIndex(sourceID, indexes))
 		return false
 	})
 
@@ -72,6 +72,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "btcb/usdx", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), cs(c("swp", 122354)))},
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "ukava", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), cs(c("hard", 122354)))},
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "ukava", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), cs(c("hard", 122354)))},
+			types.DefaultSourceRewardPeriods,
 			types.MultipliersPerDenoms{
 				{
 					Denom: "ukava",
@@ -95,6 +96,8 @@ func (suite *GenesisTestSuite) SetupTest() {
 				},
 			},
 			suite.genesisTime.Add(5*oneYear),
+			types.DefaultBoostParams,
+			types.DefaultCampaignParams,
 		),
 		types.DefaultGenesisRewardState,
 		types.DefaultGenesisRewardState,
@@ -103,12 +106,13 @@ func (suite *GenesisTestSuite) SetupTest() {
 		types.DefaultGenesisRewardState,
 		types.DefaultGenesisRewardState,
 		types.DefaultGenesisRewardState,
-		types.DefaultUSDXClaims,
-		types.DefaultHardClaims,
-		types.DefaultDelegatorClaims,
-		types.DefaultSwapClaims,
-		types.DefaultSavingsClaims,
-		types.DefaultEarnClaims,
+		types.DefaultAutoCompoundSettings,
+		types.DefaultSourceRewardStates,
+		types.DefaultSourceClaims,
+		types.DefaultBoostLocks,
+		types.DefaultBoostedSharesList,
+		types.DefaultCampaigns,
+		types.DefaultNextCampaignID,
 	)
 
 	cdc := suite.app.AppCodec()
@@ -140,6 +144,7 @@ func (suite *GenesisTestSuite) TestExportedGenesisMatchesImported() {
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "btcb/usdx", genesisTime.Add(-1*oneYear), genesisTime.Add(oneYear), cs(c("swp", 122354)))},
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "ukava", genesisTime.Add(-1*oneYear), genesisTime.Add(oneYear), cs(c("hard", 122354)))},
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "ukava", genesisTime.Add(-1*oneYear), genesisTime.Add(oneYear), cs(c("hard", 122354)))},
+			types.TypedMultiRewardPeriods{types.NewTypedMultiRewardPeriod("vault", types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "pool-1", genesisTime.Add(-1*oneYear), genesisTime.Add(oneYear), cs(c("hard", 122354)))})},
 			types.MultipliersPerDenoms{
 				{
 					Denom: "ukava",
@@ -163,6 +168,8 @@ func (suite *GenesisTestSuite) TestExportedGenesisMatchesImported() {
 				},
 			},
 			genesisTime.Add(5*oneYear),
+			types.NewBoostParams(types.DefaultBoostMaxLockDuration, types.DefaultBoostMaxFactor, []string{"vault"}),
+			types.DefaultCampaignParams,
 		),
 		types.NewGenesisRewardState(
 			types.AccumulationTimes{
@@ -220,60 +227,102 @@ func (suite *GenesisTestSuite) TestExportedGenesisMatchesImported() {
 				types.NewMultiRewardIndex("usdx", types.RewardIndexes{{CollateralType: "usdx", RewardFactor: d("0.2")}}),
 			},
 		),
-		types.USDXMintingClaims{
-			types.NewUSDXMintingClaim(
-				suite.addrs[0],
-				c("ukava", 1e9),
-				types.RewardIndexes{{CollateralType: "bnb-a", RewardFactor: d("0.3")}},
-			),
-			types.NewUSDXMintingClaim(
-				suite.addrs[1],
-				c("ukava", 1),
-				types.RewardIndexes{{CollateralType: "bnb-a", RewardFactor: d("0.001")}},
+		types.AutoCompoundSettings{
+			{
+				Owner:            suite.addrs[0],
+				ClaimType:        types.HardLiquidityProviderClaimType,
+				DenomsToClaim:    types.Selections{types.NewSelection("hard", "small")},
+				Target:           types.AUTO_COMPOUND_TARGET_HARD,
+				LastCompoundTime: genesisTime,
+			},
+		},
+		types.TypedGenesisRewardStates{
+			types.NewTypedGenesisRewardState(
+				"vault",
+				types.NewGenesisRewardState(
+					types.AccumulationTimes{types.NewAccumulationTime("pool-1", genesisTime.Add(-1*time.Hour))},
+					types.MultiRewardIndexes{{CollateralType: "pool-1", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}}}},
+				),
 			),
 		},
-		types.HardLiquidityProviderClaims{
-			types.NewHardLiquidityProviderClaim(
-				suite.addrs[0],
-				cs(c("ukava", 1e9), c("hard", 1e9)),
-				types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.01")}}}},
-				types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.0")}}}},
+		types.SourceClaims{
+			types.NewSourceClaim(
+				types.EarnRewardSourceType,
+				"usdx",
+				suite.addrs[3],
+				nil,
+				types.RewardIndexes{{CollateralType: "earn", RewardFactor: d("0.0")}},
 			),
-			types.NewHardLiquidityProviderClaim(
-				suite.addrs[1],
-				cs(c("hard", 1)),
-				types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}}}},
-				types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.0")}}}},
+			types.NewSourceClaim(
+				types.SwapRewardSourceType,
+				"btcb/usdx",
+				suite.addrs[3],
+				nil,
+				types.RewardIndexes{{CollateralType: "swap", RewardFactor: d("0.0")}},
 			),
-		},
-		types.DelegatorClaims{
-			types.NewDelegatorClaim(
+			types.NewSourceClaim(
+				"vault",
+				"pool-1",
 				suite.addrs[2],
 				cs(c("hard", 5)),
-				types.MultiRewardIndexes{{CollateralType: "ukava", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.2")}}}},
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}},
 			),
-		},
-		types.SwapClaims{
-			types.NewSwapClaim(
+			types.NewSourceClaim(
+				types.SavingsRewardSourceType,
+				"ukava",
 				suite.addrs[3],
 				nil,
-				types.MultiRewardIndexes{{CollateralType: "btcb/usdx", RewardIndexes: types.RewardIndexes{{CollateralType: "swap", RewardFactor: d("0.0")}}}},
+				types.RewardIndexes{{CollateralType: "ukava", RewardFactor: d("0.0")}},
 			),
-		},
-		types.SavingsClaims{
-			types.NewSavingsClaim(
-				suite.addrs[3],
-				nil,
-				types.MultiRewardIndexes{{CollateralType: "ukava", RewardIndexes: types.RewardIndexes{{CollateralType: "ukava", RewardFactor: d("0.0")}}}},
+			types.NewSourceClaim(
+				types.DelegatorRewardSourceType,
+				"ukava",
+				suite.addrs[2],
+				cs(c("hard", 5)),
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.2")}},
 			),
-		},
-		types.EarnClaims{
-			types.NewEarnClaim(
-				suite.addrs[3],
+			types.NewSourceClaim(
+				types.HardBorrowRewardSourceType,
+				"bnb",
+				suite.addrs[0],
 				nil,
-				types.MultiRewardIndexes{{CollateralType: "usdx", RewardIndexes: types.RewardIndexes{{CollateralType: "earn", RewardFactor: d("0.0")}}}},
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.0")}},
+			),
+			types.NewSourceClaim(
+				types.HardSupplyRewardSourceType,
+				"bnb",
+				suite.addrs[0],
+				cs(c("ukava", 1e9), c("hard", 1e9)),
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.01")}},
+			),
+			types.NewSourceClaim(
+				types.USDXMintingRewardSourceType,
+				"bnb-a",
+				suite.addrs[0],
+				cs(c("ukava", 1e9)),
+				types.RewardIndexes{{CollateralType: "ukava", RewardFactor: d("0.3")}},
 			),
 		},
+		types.BoostLocks{
+			types.NewBoostLock(suite.addrs[2], c("ukava", 1000), genesisTime.Add(-1*oneYear), genesisTime.Add(oneYear), d("500")),
+		},
+		types.BoostedSharesList{
+			types.NewBoostedShares("vault", "pool-1", suite.addrs[2], d("1.5")),
+		},
+		types.IncentiveCampaigns{
+			{
+				ID:                  1,
+				Creator:             suite.addrs[3],
+				SourceType:          types.SwapRewardSourceType,
+				SourceID:            "busd:ukava",
+				Amount:              cs(c("hard", 1000)),
+				Start:               genesisTime.Add(-1 * oneYear),
+				End:                 genesisTime.Add(oneYear),
+				Distributed:         sdk.NewDecCoins(sdk.NewDecCoinFromDec("hard", d("100.5"))),
+				PreviousAccrualTime: genesisTime,
+			},
+		},
+		2,
 	)
 
 	tApp := app.NewTestApp()
@@ -289,7 +338,12 @@ func (suite *GenesisTestSuite) TestExportedGenesisMatchesImported() {
 	// Clear genesis validator and genesis delegator incentive state to start empty.
 	ik := tApp.GetIncentiveKeeper()
 	suite.app.DeleteGenesisValidator(suite.T(), suite.ctx)
-	ik.DeleteDelegatorClaim(ctx, tApp.GenesisAddrs[0])
+	ik.DeleteSourceClaim(ctx, types.DelegatorRewardSourceType, types.BondDenom, tApp.GenesisAddrs[0])
+
+	// Locked coins are held by the boost lock module account
+	suite.Require().NoError(tApp.FundModuleAccount(ctx, types.BoostLockAccountName, cs(c("ukava", 1000))))
+	// Undistributed campaign rewards are held by the campaign module account
+	suite.Require().NoError(tApp.FundModuleAccount(ctx, types.CampaignAccountName, cs(c("hard", 899))))
 
 	incentive.InitGenesis(
 		ctx,
@@ -317,7 +371,9 @@ func (suite *GenesisTestSuite) TestInitGenesisPanicsWhenAccumulationTimesTooLong
 		types.MultiRewardIndexes{},
 	)
 	minimalParams := types.Params{
-		ClaimEnd: genesisTime.Add(5 * oneYear),
+		ClaimEnd:       genesisTime.Add(5 * oneYear),
+		BoostParams:    types.DefaultBoostParams,
+		CampaignParams: types.DefaultCampaignParams,
 	}
 
 	testCases := []struct {
@@ -387,6 +443,72 @@ func (suite *GenesisTestSuite) TestInitGenesisPanicsWhenAccumulationTimesTooLong
 	}
 }
 
+func (suite *GenesisTestSuite) TestInitGenesisImportsDeprecatedClaims() {
+	genesisTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	genesisState := types.DefaultGenesisState()
+	genesisState.USDXMintingClaims = types.USDXMintingClaims{
+		types.NewUSDXMintingClaim(addrs[0], c("ukava", 5), types.RewardIndexes{types.NewRewardIndex("bnb-a", d("0.5"))}),
+	}
+	genesisState.HardLiquidityProviderClaims = types.HardLiquidityProviderClaims{
+		types.NewHardLiquidityProviderClaim(
+			addrs[0], cs(c("hard", 10)),
+			types.MultiRewardIndexes{types.NewMultiRewardIndex("bnb", types.RewardIndexes{types.NewRewardIndex("hard", d("0.1"))})},
+			types.MultiRewardIndexes{types.NewMultiRewardIndex("ukava", types.RewardIndexes{types.NewRewardIndex("hard", d("0.2"))})},
+		),
+	}
+	genesisState.SwapClaims = types.SwapClaims{
+		types.NewSwapClaim(addrs[1], cs(c("swp", 3)), nil),
+	}
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 0, Time: genesisTime})
+	tApp.InitializeFromGenesisStates(
+		NewCDPGenStateMulti(tApp.AppCodec()),
+		NewPricefeedGenStateMultiFromTime(tApp.AppCodec(), genesisTime),
+	)
+
+	incentive.InitGenesis(
+		ctx,
+		tApp.GetIncentiveKeeper(),
+		tApp.GetAccountKeeper(),
+		tApp.GetBankKeeper(),
+		tApp.GetCDPKeeper(),
+		genesisState,
+	)
+
+	// deprecated claims are split into source claims, with the reward held by the first of them
+	expected := types.SourceClaims{
+		types.NewSourceClaim(
+			types.USDXMintingRewardSourceType, "bnb-a", addrs[0], cs(c("ukava", 5)),
+			types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, d("0.5"))},
+		),
+		types.NewSourceClaim(
+			types.HardSupplyRewardSourceType, "bnb", addrs[0], cs(c("hard", 10)),
+			types.RewardIndexes{types.NewRewardIndex("hard", d("0.1"))},
+		),
+		types.NewSourceClaim(
+			types.HardBorrowRewardSourceType, "ukava", addrs[0], nil,
+			types.RewardIndexes{types.NewRewardIndex("hard", d("0.2"))},
+		),
+		types.NewSourceClaim(types.SwapRewardSourceType, "swp", addrs[1], cs(c("swp", 3)), nil),
+	}
+	ik := tApp.GetIncentiveKeeper()
+	for _, claim := range expected {
+		stored, found := ik.GetSourceClaim(ctx, claim.SourceType, claim.SourceID, claim.Owner)
+		suite.Require().True(found)
+		suite.Equal(claim.Reward, stored.Reward)
+		suite.Equal(claim.RewardIndexes, stored.RewardIndexes)
+	}
+
+	// claims are only exported as source claims
+	exported := incentive.ExportGenesis(ctx, ik)
+	suite.Empty(exported.USDXMintingClaims)
+	suite.Empty(exported.HardLiquidityProviderClaims)
+	suite.Empty(exported.SwapClaims)
+}
+
 func (suite *GenesisTestSuite) TestValidateAccumulationTime() {
 	// valid when set
 	accTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// GetAutoCompoundSetting returns an owner's auto-compound setting for a claim type
+func (k Keeper) GetAutoCompoundSetting(ctx sdk.Context, owner sdk.AccAddress, claimType string) (types.AutoCompoundSetting, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+	bz := store.Get(types.AutoCompoundSettingKey(owner, claimType))
+	if bz == nil {
+		return types.AutoCompoundSetting{}, false
+	}
+	var setting types.AutoCompoundSetting
+	k.cdc.MustUnmarshal(bz, &setting)
+	return setting, true
+}
+
+// SetAutoCompoundSetting sets an auto-compound setting in the store
+func (k Keeper) SetAutoCompoundSetting(ctx sdk.Context, setting types.AutoCompoundSetting) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+	bz := k.cdc.MustMarshal(&setting)
+	store.Set(types.AutoCompoundSettingKey(setting.Owner, setting.ClaimType), bz)
+}
+
+// DeleteAutoCompoundSetting deletes an owner's auto-compound setting for a claim type
+func (k Keeper) DeleteAutoCompoundSetting(ctx sdk.Context, owner sdk.AccAddress, claimType string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+	store.Delete(types.AutoCompoundSettingKey(owner, claimType))
+}
+
+// IterateAutoCompoundSettings iterates over all auto-compound settings and performs a callback function
+func (k Keeper) IterateAutoCompoundSettings(ctx sdk.Context, cb func(setting types.AutoCompoundSetting) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var setting types.AutoCompoundSetting
+		k.cdc.MustUnmarshal(iterator.Value(), &setting)
+		if cb(setting) {
+			break
+		}
+	}
+}
+
+// GetAllAutoCompoundSettings returns all auto-compound settings
+func (k Keeper) GetAllAutoCompoundSettings(ctx sdk.Context) types.AutoCompoundSettings {
+	settings := types.AutoCompoundSettings{}
+	k.IterateAutoCompoundSettings(ctx, func(setting types.AutoCompoundSetting) (stop bool) {
+		settings = append(settings, setting)
+		return false
+	})
+	return settings
+}
+
+// SetAutoCompound opts an owner in to compounding the rewards of a claim type into a target position.
+// Compounded rewards are deposited as soon as they are claimed, so they can only be claimed with multipliers without
+// a lockup.
+func (k Keeper) SetAutoCompound(
+	ctx sdk.Context, owner sdk.AccAddress, claimType string, denomsToClaim types.Selections,
+	target types.AutoCompoundTarget, validator string,
+) error {
+	setting := types.NewAutoCompoundSetting(owner, claimType, denomsToClaim, target, validator)
+	if err := setting.Validate(); err != nil {
+		return err
+	}
+
+	for _, selection := range denomsToClaim {
+		multiplier, found := k.GetMultiplierByDenom(ctx, selection.Denom, selection.MultiplierName)
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", selection.Denom, selection.MultiplierName)
+		}
+		if multiplier.MonthsLockup != 0 {
+			return errorsmod.Wrapf(
+				types.ErrInvalidMultiplier,
+				"cannot auto-compound denom '%s' with multiplier '%s' as it has a lockup", selection.Denom, selection.MultiplierName,
+			)
+		}
+	}
+
+	if validator != "" {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return err
+		}
+		if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+			return errorsmod.Wrapf(stakingtypes.ErrNoValidatorFound, "%s", validator)
+		}
+	}
+
+	// Keep the last compound time so updating a setting does not compound early
+	if existing, found := k.GetAutoCompoundSetting(ctx, owner, claimType); found {
+		setting.LastCompoundTime = existing.LastCompoundTime
+	}
+
+	k.SetAutoCompoundSetting(ctx, setting)
+	return nil
+}
+
+// RemoveAutoCompound stops compounding the rewards of a claim type for an owner.
+func (k Keeper) RemoveAutoCompound(ctx sdk.Context, owner sdk.AccAddress, claimType string) error {
+	if _, found := k.GetAutoCompoundSetting(ctx, owner, claimType); !found {
+		return errorsmod.Wrapf(types.ErrAutoCompoundSettingNotFound, "owner %s, claim type %s", owner, claimType)
+	}
+	k.DeleteAutoCompoundSetting(ctx, owner, claimType)
+	return nil
+}
+
+// CompoundRewards claims the rewards of an auto-compound setting and deposits them into the setting's target.
+// It returns the rewards compounded.
+func (k Keeper) CompoundRewards(ctx sdk.Context, setting types.AutoCompoundSetting) (sdk.Coins, error) {
+	claim, found := k.getClaimFunc(setting.ClaimType)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidClaimType, "%s", setting.ClaimType)
+	}
+
+	rewards, err := k.claimSelections(ctx, claim, setting.Owner, setting.Owner, setting.DenomsToClaim)
+	if err != nil {
+		return nil, err
+	}
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	switch setting.Target {
+	case types.AUTO_COMPOUND_TARGET_HARD:
+		if err := k.hardKeeper.Deposit(ctx, setting.Owner, rewards); err != nil {
+			return nil, err
+		}
+	case types.AUTO_COMPOUND_TARGET_EARN:
+		for _, reward := range rewards {
+			if err := k.depositEarn(ctx, setting.Owner, reward, setting.Validator); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidAutoCompoundTarget, "%s", setting.Target)
+	}
+
+	return rewards, nil
+}
+
+// depositEarn deposits coins into the earn vault for their denom. Staking denom coins are delegated to the validator
+// and minted into staking derivatives first, if a validator is given.
+func (k Keeper) depositEarn(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coin, validator string) error {
+	if validator != "" && amount.Denom == k.stakingKeeper.BondDenom(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return err
+		}
+		val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return errorsmod.Wrapf(stakingtypes.ErrNoValidatorFound, "%s", validator)
+		}
+		if _, err := k.stakingKeeper.Delegate(ctx, owner, amount.Amount, stakingtypes.Unbonded, val, true); err != nil {
+			return err
+		}
+		amount, err = k.liquidKeeper.MintDerivative(ctx, owner, valAddr, amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	vault, found := k.earnKeeper.GetAllowedVault(ctx, amount.Denom)
+	if !found || len(vault.Strategies) == 0 {
+		return errorsmod.Wrapf(earntypes.ErrInvalidVaultDenom, "%s", amount.Denom)
+	}
+	return k.earnKeeper.Deposit(ctx, owner, amount, vault.Strategies[0])
+}
+
+// ProcessAutoCompounding compounds the rewards of auto-compound settings that have not been compounded for the
+// auto-compound interval.
+// The gas spent is bounded: each setting can use up to AutoCompoundMaxGasPerSetting gas, and at most
+// AutoCompoundMaxGasPerBlock gas worth of settings are compounded each block. Settings are scanned in order,
+// continuing from where the previous block stopped.
+func (k Keeper) ProcessAutoCompounding(ctx sdk.Context) {
+	maxSettings := types.AutoCompoundMaxGasPerBlock / types.AutoCompoundMaxGasPerSetting
+
+	due, next := k.getDueAutoCompoundSettings(ctx, maxSettings)
+
+	for _, setting := range due {
+		k.compoundSetting(ctx, setting)
+	}
+
+	store := ctx.KVStore(k.key)
+	if next == nil {
+		store.Delete(types.AutoCompoundCursorKey)
+	} else {
+		store.Set(types.AutoCompoundCursorKey, next)
+	}
+}
+
+// getDueAutoCompoundSettings returns up to a limit of settings that are due to be compounded, starting from the
+// stored cursor, and the key to continue from in the next block. At most AutoCompoundMaxScannedPerBlock settings are
+// read. The key is nil once all settings have been scanned.
+func (k Keeper) getDueAutoCompoundSettings(ctx sdk.Context, limit int) (types.AutoCompoundSettings, []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+	cursor := ctx.KVStore(k.key).Get(types.AutoCompoundCursorKey)
+
+	iterator := store.Iterator(cursor, nil)
+	defer iterator.Close()
+
+	due := types.AutoCompoundSettings{}
+	for scanned := 0; iterator.Valid(); iterator.Next() {
+		if len(due) >= limit || scanned >= types.AutoCompoundMaxScannedPerBlock {
+			return due, iterator.Key()
+		}
+		scanned++
+
+		var setting types.AutoCompoundSetting
+		k.cdc.MustUnmarshal(iterator.Value(), &setting)
+
+		if ctx.BlockTime().Before(setting.LastCompoundTime.Add(types.AutoCompoundInterval)) {
+			continue
+		}
+		due = append(due, setting)
+	}
+	return due, nil
+}
+
+// compoundSetting compounds the rewards of a setting with a limited amount of gas. State changes are discarded and
+// an event with the error is emitted if compounding fails. The setting is not retried until the next interval.
+func (k Keeper) compoundSetting(ctx sdk.Context, setting types.AutoCompoundSetting) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(types.AutoCompoundMaxGasPerSetting))
+
+	rewards, err := k.compoundRewardsWithGasLimit(cacheCtx, setting)
+	if err == nil {
+		writeCache()
+	}
+
+	setting.LastCompoundTime = ctx.BlockTime()
+	k.SetAutoCompoundSetting(ctx, setting)
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyOwner, setting.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyClaimType, setting.ClaimType),
+		sdk.NewAttribute(types.AttributeKeyTarget, setting.Target.String()),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAmount, rewards.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAutoCompound, attributes...))
+}
+
+// compoundRewardsWithGasLimit compounds rewards, returning an error if the context's gas limit is exceeded.
+func (k Keeper) compoundRewardsWithGasLimit(ctx sdk.Context, setting types.AutoCompoundSetting) (rewards sdk.Coins, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrap(sdkerrors.ErrOutOfGas, fmt.Sprintf("auto-compound out of gas in location: %s", outOfGas.Descriptor))
+		}
+	}()
+
+	return k.CompoundRewards(ctx, setting)
+}
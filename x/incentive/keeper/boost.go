@@ -0,0 +1,480 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// GetBoostLock returns an owner's boost lock
+func (k Keeper) GetBoostLock(ctx sdk.Context, owner sdk.AccAddress) (types.BoostLock, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostLockKeyPrefix)
+	bz := store.Get(owner)
+	if bz == nil {
+		return types.BoostLock{}, false
+	}
+	var lock types.BoostLock
+	k.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// SetBoostLock sets a boost lock in the store, updating the total boost weight and the end time index.
+// Locks are only kept in the end time index while they have weight.
+func (k Keeper) SetBoostLock(ctx sdk.Context, lock types.BoostLock) {
+	totalWeight := k.GetTotalBoostWeight(ctx)
+	if existing, found := k.GetBoostLock(ctx, lock.Owner); found {
+		totalWeight = totalWeight.Sub(existing.Weight)
+		k.deleteBoostLockByEnd(ctx, existing.End, existing.Owner)
+	}
+	k.SetTotalBoostWeight(ctx, totalWeight.Add(lock.Weight))
+	if lock.Weight.IsPositive() {
+		k.setBoostLockByEnd(ctx, lock.End, lock.Owner)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostLockKeyPrefix)
+	bz := k.cdc.MustMarshal(&lock)
+	store.Set(lock.Owner, bz)
+}
+
+// DeleteBoostLock deletes an owner's boost lock, removing its weight from the total boost weight
+func (k Keeper) DeleteBoostLock(ctx sdk.Context, owner sdk.AccAddress) {
+	existing, found := k.GetBoostLock(ctx, owner)
+	if !found {
+		return
+	}
+	k.SetTotalBoostWeight(ctx, k.GetTotalBoostWeight(ctx).Sub(existing.Weight))
+	k.deleteBoostLockByEnd(ctx, existing.End, existing.Owner)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostLockKeyPrefix)
+	store.Delete(owner)
+}
+
+// IterateBoostLocks iterates over all boost locks and performs a callback function
+func (k Keeper) IterateBoostLocks(ctx sdk.Context, cb func(lock types.BoostLock) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostLockKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.BoostLock
+		k.cdc.MustUnmarshal(iterator.Value(), &lock)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// GetAllBoostLocks returns all boost locks
+func (k Keeper) GetAllBoostLocks(ctx sdk.Context) types.BoostLocks {
+	locks := types.BoostLocks{}
+	k.IterateBoostLocks(ctx, func(lock types.BoostLock) (stop bool) {
+		locks = append(locks, lock)
+		return false
+	})
+	return locks
+}
+
+func (k Keeper) setBoostLockByEnd(ctx sdk.Context, end time.Time, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostLockByEndKeyPrefix)
+	store.Set(types.BoostLockByEndKey(end, owner), owner)
+}
+
+func (k Keeper) deleteBoostLockByEnd(ctx sdk.Context, end time.Time, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostLockByEndKeyPrefix)
+	store.Delete(types.BoostLockByEndKey(end, owner))
+}
+
+// getBoostLockOwnersEndedBy returns the owners of locks with weight that end at or before a time
+func (k Keeper) getBoostLockOwnersEndedBy(ctx sdk.Context, t time.Time) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostLockByEndKeyPrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(t)))
+	defer iterator.Close()
+
+	var owners []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		owners = append(owners, sdk.AccAddress(iterator.Value()))
+	}
+	return owners
+}
+
+// GetTotalBoostWeight returns the sum of the weights of all boost locks
+func (k Keeper) GetTotalBoostWeight(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.TotalBoostWeightKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var weight sdk.Dec
+	if err := weight.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return weight
+}
+
+// SetTotalBoostWeight sets the sum of the weights of all boost locks
+func (k Keeper) SetTotalBoostWeight(ctx sdk.Context, weight sdk.Dec) {
+	store := ctx.KVStore(k.key)
+	bz, err := weight.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.TotalBoostWeightKey, bz)
+}
+
+// GetBoostedShares returns the shares an owner's boost adds to a source
+func (k Keeper) GetBoostedShares(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostedSharesKeyPrefix)
+	bz := store.Get(types.SourceClaimKey(sourceType, owner, sourceID))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var bs types.BoostedShares
+	k.cdc.MustUnmarshal(bz, &bs)
+	return bs.Shares
+}
+
+// SetBoostedShares sets the shares an owner's boost adds to a source, updating the source's total boosted shares.
+// Zero shares are removed from the store.
+func (k Keeper) SetBoostedShares(ctx sdk.Context, bs types.BoostedShares) {
+	previous := k.GetBoostedShares(ctx, bs.SourceType, bs.SourceID, bs.Owner)
+	total := k.GetTotalBoostedShares(ctx, bs.SourceType, bs.SourceID).Sub(previous).Add(bs.Shares)
+	k.setTotalBoostedShares(ctx, bs.SourceType, bs.SourceID, total)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostedSharesKeyPrefix)
+	key := types.SourceClaimKey(bs.SourceType, bs.Owner, bs.SourceID)
+	if !bs.Shares.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	bz := k.cdc.MustMarshal(&bs)
+	store.Set(key, bz)
+}
+
+// IterateBoostedSharesByOwner iterates over the shares an owner's boost adds to sources of a source type
+func (k Keeper) IterateBoostedSharesByOwner(
+	ctx sdk.Context, sourceType string, owner sdk.AccAddress, cb func(bs types.BoostedShares) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostedSharesKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.SourceClaimOwnerKey(sourceType, owner))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bs types.BoostedShares
+		k.cdc.MustUnmarshal(iterator.Value(), &bs)
+		if cb(bs) {
+			break
+		}
+	}
+}
+
+// IterateBoostedShares iterates over the shares boosts add to all owners in all sources
+func (k Keeper) IterateBoostedShares(ctx sdk.Context, cb func(bs types.BoostedShares) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BoostedSharesKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bs types.BoostedShares
+		k.cdc.MustUnmarshal(iterator.Value(), &bs)
+		if cb(bs) {
+			break
+		}
+	}
+}
+
+// GetAllBoostedShares returns the shares boosts add to all owners in all sources
+func (k Keeper) GetAllBoostedShares(ctx sdk.Context) types.BoostedSharesList {
+	list := types.BoostedSharesList{}
+	k.IterateBoostedShares(ctx, func(bs types.BoostedShares) (stop bool) {
+		list = append(list, bs)
+		return false
+	})
+	return list
+}
+
+// GetBoostedSharesByOwner returns the shares an owner's boost adds to sources of all registered source types
+func (k Keeper) GetBoostedSharesByOwner(ctx sdk.Context, owner sdk.AccAddress) types.BoostedSharesList {
+	list := types.BoostedSharesList{}
+	for _, sourceType := range k.GetRewardSourceTypes() {
+		k.IterateBoostedSharesByOwner(ctx, sourceType, owner, func(bs types.BoostedShares) (stop bool) {
+			list = append(list, bs)
+			return false
+		})
+	}
+	return list
+}
+
+// GetTotalBoostedShares returns the sum of the shares boosts add to all owners in a source
+func (k Keeper) GetTotalBoostedShares(ctx sdk.Context, sourceType, sourceID string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalBoostedSharesKeyPrefix)
+	bz := store.Get(types.SourceRewardKey(sourceType, sourceID))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var total sdk.Dec
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return total
+}
+
+func (k Keeper) setTotalBoostedShares(ctx sdk.Context, sourceType, sourceID string, total sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalBoostedSharesKeyPrefix)
+	key := types.SourceRewardKey(sourceType, sourceID)
+	if !total.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// calculateBoostedShares returns the shares an owner's boost adds to their shares in a source, from the owner's current
+// lock weight and the source's current total shares. Sources of source types that are not boosted get no boost.
+func (k Keeper) calculateBoostedShares(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress, shares sdk.Dec) sdk.Dec {
+	params := k.GetParams(ctx).BoostParams
+	source, found := k.GetRewardSource(sourceType)
+	if !found || !params.IsBoostedSourceType(sourceType) {
+		return sdk.ZeroDec()
+	}
+	lock, found := k.GetBoostLock(ctx, owner)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return types.CalculateBoostedShares(
+		shares,
+		source.GetTotalShares(ctx, sourceID),
+		lock.Weight,
+		k.GetTotalBoostWeight(ctx),
+		params.MaxBoostFactor,
+	)
+}
+
+// updateBoostedShares recomputes the shares an owner's boost adds to a source from their current shares in it, and
+// stores them so rewards accumulated from now on are shared over them. The owner's claim must be synchronized first,
+// as the rewards since it was last synchronized were shared over the previous boosted shares.
+func (k Keeper) updateBoostedShares(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress) {
+	shares := sdk.ZeroDec()
+	source, found := k.GetRewardSource(sourceType)
+	if _, lockFound := k.GetBoostLock(ctx, owner); found && lockFound {
+		shares = k.calculateBoostedShares(ctx, sourceType, sourceID, owner, source.GetShares(ctx, sourceID, owner))
+	}
+	if shares.IsZero() && k.GetBoostedShares(ctx, sourceType, sourceID, owner).IsZero() {
+		// most owners have no boost, so skip writing to the store
+		return
+	}
+	k.SetBoostedShares(ctx, types.NewBoostedShares(sourceType, sourceID, owner, shares))
+}
+
+// updateOwnerBoostedShares updates an owner's boosted shares in all rewarded sources of the boosted source types, and
+// removes any boosted shares they have in source types that are no longer boosted.
+// Rewarded sources are those with a reward period or that have accumulated rewards.
+func (k Keeper) updateOwnerBoostedShares(ctx sdk.Context, owner sdk.AccAddress) {
+	params := k.GetParams(ctx)
+
+	for _, sourceType := range k.GetRewardSourceTypes() {
+		var sourceIDs []string
+		seen := make(map[string]bool)
+		add := func(sourceID string) {
+			if !seen[sourceID] {
+				sourceIDs = append(sourceIDs, sourceID)
+				seen[sourceID] = true
+			}
+		}
+
+		k.IterateBoostedSharesByOwner(ctx, sourceType, owner, func(bs types.BoostedShares) (stop bool) {
+			add(bs.SourceID)
+			return false
+		})
+		if params.BoostParams.IsBoostedSourceType(sourceType) {
+			for _, sourcePeriods := range params.SourceRewardPeriods {
+				if sourcePeriods.SourceType != sourceType {
+					continue
+				}
+				for _, period := range sourcePeriods.RewardPeriods {
+					add(period.CollateralType)
+				}
+			}
+			k.IterateSourceRewardIndexes(ctx, sourceType, func(sourceID string, _ types.RewardIndexes) (stop bool) {
+				add(sourceID)
+				return false
+			})
+		}
+
+		for _, sourceID := range sourceIDs {
+			k.SynchronizeSourceReward(ctx, sourceType, sourceID, owner)
+			k.updateBoostedShares(ctx, sourceType, sourceID, owner)
+		}
+	}
+}
+
+// Lock locks an owner's KAVA or bkava to boost their shares in sources of the boosted source types. Coins added to
+// an existing lock keep its end time, and the duration is ignored.
+func (k Keeper) Lock(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coin, duration time.Duration) error {
+	params := k.GetParams(ctx).BoostParams
+
+	if amount.Denom != types.BondDenom && !k.liquidKeeper.IsDerivativeDenom(ctx, amount.Denom) {
+		return errorsmod.Wrapf(types.ErrInvalidLockDenom, "cannot lock %s, only %s and liquid staking derivatives", amount.Denom, types.BondDenom)
+	}
+
+	lock, found := k.GetBoostLock(ctx, owner)
+	if found {
+		if !ctx.BlockTime().Before(lock.End) {
+			return errorsmod.Wrap(types.ErrBoostLockEnded, "withdraw the ended lock before locking again")
+		}
+		if lock.Amount.Denom != amount.Denom {
+			return errorsmod.Wrapf(types.ErrInvalidLockDenom, "cannot add %s to a lock of %s", amount.Denom, lock.Amount.Denom)
+		}
+		lock.Amount = lock.Amount.Add(amount)
+	} else {
+		if err := validateLockDuration(duration, params); err != nil {
+			return err
+		}
+		lock = types.NewBoostLock(owner, amount, ctx.BlockTime(), ctx.BlockTime().Add(duration), sdk.ZeroDec())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.BoostLockAccountName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	lock, err := k.updateBoostLockWeight(ctx, lock)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBoostLock,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEnd, lock.End.String()),
+			sdk.NewAttribute(types.AttributeKeyWeight, lock.Weight.String()),
+		),
+	)
+	return nil
+}
+
+// ExtendLock moves the end of an owner's boost lock to the duration from the current block time.
+func (k Keeper) ExtendLock(ctx sdk.Context, owner sdk.AccAddress, duration time.Duration) error {
+	lock, found := k.GetBoostLock(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrBoostLockNotFound, "owner %s", owner)
+	}
+	if !ctx.BlockTime().Before(lock.End) {
+		return errorsmod.Wrap(types.ErrBoostLockEnded, "ended locks cannot be extended")
+	}
+	if err := validateLockDuration(duration, k.GetParams(ctx).BoostParams); err != nil {
+		return err
+	}
+	end := ctx.BlockTime().Add(duration)
+	if !end.After(lock.End) {
+		return errorsmod.Wrapf(types.ErrInvalidLockDuration, "new end %s must be after current end %s", end, lock.End)
+	}
+	lock.End = end
+
+	lock, err := k.updateBoostLockWeight(ctx, lock)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExtendBoostLock,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyEnd, lock.End.String()),
+			sdk.NewAttribute(types.AttributeKeyWeight, lock.Weight.String()),
+		),
+	)
+	return nil
+}
+
+// WithdrawLock returns the coins of an owner's ended boost lock and deletes the lock.
+func (k Keeper) WithdrawLock(ctx sdk.Context, owner sdk.AccAddress) error {
+	lock, found := k.GetBoostLock(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrBoostLockNotFound, "owner %s", owner)
+	}
+	if ctx.BlockTime().Before(lock.End) {
+		return errorsmod.Wrapf(types.ErrBoostLockNotEnded, "lock ends at %s", lock.End)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.BoostLockAccountName, owner, sdk.NewCoins(lock.Amount)); err != nil {
+		return err
+	}
+
+	k.DeleteBoostLock(ctx, owner)
+	if lock.Weight.IsPositive() {
+		k.updateOwnerBoostedShares(ctx, owner)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawBoostLock,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, lock.Amount.String()),
+		),
+	)
+	return nil
+}
+
+// EndBoostLocks removes the weight of all boost locks that have ended by the current block time, and updates their
+// owners' boosted shares. The coins stay locked until they are withdrawn.
+func (k Keeper) EndBoostLocks(ctx sdk.Context) {
+	for _, owner := range k.getBoostLockOwnersEndedBy(ctx, ctx.BlockTime()) {
+		lock, found := k.GetBoostLock(ctx, owner)
+		if !found {
+			panic("boost lock in end time index not found")
+		}
+		lock.Weight = sdk.ZeroDec()
+		k.SetBoostLock(ctx, lock)
+		k.updateOwnerBoostedShares(ctx, owner)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBoostLockEnd,
+				sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, lock.Amount.String()),
+			),
+		)
+	}
+}
+
+// updateBoostLockWeight sets a lock's weight from its value and the time remaining until it ends, stores it, and
+// updates the owner's boosted shares.
+func (k Keeper) updateBoostLockWeight(ctx sdk.Context, lock types.BoostLock) (types.BoostLock, error) {
+	value, err := k.getBoostLockValue(ctx, lock.Amount)
+	if err != nil {
+		return types.BoostLock{}, err
+	}
+	lock.Weight = types.CalculateBoostWeight(value, lock.End.Sub(ctx.BlockTime()), k.GetParams(ctx).BoostParams.MaxLockDuration)
+
+	k.SetBoostLock(ctx, lock)
+	k.updateOwnerBoostedShares(ctx, lock.Owner)
+	return lock, nil
+}
+
+// getBoostLockValue returns the value of locked coins in ukava
+func (k Keeper) getBoostLockValue(ctx sdk.Context, amount sdk.Coin) (sdk.Dec, error) {
+	if amount.Denom == types.BondDenom {
+		return sdk.NewDecFromInt(amount.Amount), nil
+	}
+	value, err := k.liquidKeeper.GetStakedTokensForDerivatives(ctx, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return sdk.NewDecFromInt(value.Amount), nil
+}
+
+func validateLockDuration(duration time.Duration, params types.BoostParams) error {
+	if duration <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidLockDuration, "duration must be positive: %s", duration)
+	}
+	if duration > params.MaxLockDuration {
+		return errorsmod.Wrapf(types.ErrInvalidLockDuration, "duration %s is longer than the max %s", duration, params.MaxLockDuration)
+	}
+	return nil
+}
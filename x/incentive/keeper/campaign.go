@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// GetIncentiveCampaign returns an incentive campaign by id
+func (k Keeper) GetIncentiveCampaign(ctx sdk.Context, id uint64) (types.IncentiveCampaign, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CampaignKeyPrefix)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return types.IncentiveCampaign{}, false
+	}
+	var campaign types.IncentiveCampaign
+	k.cdc.MustUnmarshal(bz, &campaign)
+	return campaign, true
+}
+
+// SetIncentiveCampaign sets an incentive campaign in the store, adding new campaigns to the start time index
+func (k Keeper) SetIncentiveCampaign(ctx sdk.Context, campaign types.IncentiveCampaign) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CampaignKeyPrefix)
+	key := sdk.Uint64ToBigEndian(campaign.ID)
+	if !store.Has(key) {
+		k.setCampaignByStart(ctx, campaign.Start, campaign.ID)
+	}
+	bz := k.cdc.MustMarshal(&campaign)
+	store.Set(key, bz)
+}
+
+// DeleteIncentiveCampaign deletes an incentive campaign from the store and the start time index
+func (k Keeper) DeleteIncentiveCampaign(ctx sdk.Context, id uint64) {
+	existing, found := k.GetIncentiveCampaign(ctx, id)
+	if !found {
+		return
+	}
+	k.deleteCampaignByStart(ctx, existing.Start, existing.ID)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CampaignKeyPrefix)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) setCampaignByStart(ctx sdk.Context, start time.Time, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CampaignByStartKeyPrefix)
+	store.Set(types.CampaignByStartKey(start, id), sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) deleteCampaignByStart(ctx sdk.Context, start time.Time, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CampaignByStartKeyPrefix)
+	store.Delete(types.CampaignByStartKey(start, id))
+}
+
+// getCampaignIDsStartedBefore returns the ids of campaigns that start before a time, in start time order
+func (k Keeper) getCampaignIDsStartedBefore(ctx sdk.Context, t time.Time) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CampaignByStartKeyPrefix)
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(t))
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	return ids
+}
+
+// IterateIncentiveCampaigns iterates over all incentive campaigns in id order and performs a callback function
+func (k Keeper) IterateIncentiveCampaigns(ctx sdk.Context, cb func(campaign types.IncentiveCampaign) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CampaignKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var campaign types.IncentiveCampaign
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+		if cb(campaign) {
+			break
+		}
+	}
+}
+
+// GetAllIncentiveCampaigns returns all incentive campaigns
+func (k Keeper) GetAllIncentiveCampaigns(ctx sdk.Context) types.IncentiveCampaigns {
+	campaigns := types.IncentiveCampaigns{}
+	k.IterateIncentiveCampaigns(ctx, func(campaign types.IncentiveCampaign) (stop bool) {
+		campaigns = append(campaigns, campaign)
+		return false
+	})
+	return campaigns
+}
+
+// GetNextCampaignID returns the id to give the next incentive campaign
+func (k Keeper) GetNextCampaignID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextCampaignIDKey)
+	if bz == nil {
+		return types.DefaultNextCampaignID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextCampaignID sets the id to give the next incentive campaign
+func (k Keeper) SetNextCampaignID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextCampaignIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// CreateIncentiveCampaign escrows rewards from a creator to be streamed to the owners of a swap pool, earn vault, or
+// registered reward source between the start and end times. It returns the id of the new campaign.
+func (k Keeper) CreateIncentiveCampaign(
+	ctx sdk.Context, creator sdk.AccAddress, sourceType, sourceID string, amount sdk.Coins, start, end time.Time,
+) (uint64, error) {
+	if k.bankKeeper.BlockedAddr(creator) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to create campaigns", creator)
+	}
+	if start.Before(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidCampaign, "start %s cannot be before the block time %s", start, ctx.BlockTime())
+	}
+	params := k.GetParams(ctx)
+	if maxEnd := ctx.BlockTime().Add(params.CampaignParams.MaxDuration); end.After(maxEnd) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidCampaign, "end %s cannot be after %s", end, maxEnd)
+	}
+	if err := k.validateCampaignSource(ctx, sourceType, sourceID); err != nil {
+		return 0, err
+	}
+	for _, coin := range amount {
+		if !hasClaimMultipliers(params, coin.Denom) {
+			return 0, errorsmod.Wrapf(types.ErrInvalidCampaign, "rewards in %s cannot be claimed, it has no claim multipliers", coin.Denom)
+		}
+	}
+
+	if fee := params.CampaignParams.CreationFee; !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, communitytypes.ModuleAccountName, fee); err != nil {
+			return 0, err
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.CampaignAccountName, amount); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextCampaignID(ctx)
+	k.SetNextCampaignID(ctx, id+1)
+
+	campaign := types.NewIncentiveCampaign(id, creator, sourceType, sourceID, amount, start, end, ctx.BlockTime())
+	k.SetIncentiveCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateIncentiveCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeySourceType, sourceType),
+			sdk.NewAttribute(types.AttributeKeySourceID, sourceID),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyStart, start.String()),
+			sdk.NewAttribute(types.AttributeKeyEnd, end.String()),
+		),
+	)
+	return id, nil
+}
+
+// AccumulateCampaignRewards distributes the rewards of all started incentive campaigns since they last accrued, and
+// refunds the undistributed rewards of campaigns that have ended. Campaigns that have not started are not loaded.
+func (k Keeper) AccumulateCampaignRewards(ctx sdk.Context) {
+	for _, id := range k.getCampaignIDsStartedBefore(ctx, ctx.BlockTime()) {
+		campaign, found := k.GetIncentiveCampaign(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("incentive campaign %d in start time index not found", id))
+		}
+		campaign = k.accumulateCampaignRewards(ctx, campaign)
+		if campaign.IsEnded() {
+			k.refundIncentiveCampaign(ctx, campaign)
+			continue
+		}
+		k.SetIncentiveCampaign(ctx, campaign)
+	}
+}
+
+// accumulateCampaignRewards adds a campaign's rewards since it last accrued to the reward indexes of its source, and
+// moves the coins needed to pay them out of escrow. Rewards for times the source has no shares are not distributed.
+func (k Keeper) accumulateCampaignRewards(ctx sdk.Context, campaign types.IncentiveCampaign) types.IncentiveCampaign {
+	rewards, accumulatedTo := types.CalculatePerSecondRewards(
+		campaign.Start,
+		campaign.End,
+		campaign.RewardsPerSecond(),
+		campaign.PreviousAccrualTime,
+		ctx.BlockTime(),
+	)
+	campaign.PreviousAccrualTime = accumulatedTo
+
+	// Rounding the accrual durations to whole seconds can add up to more than the campaign's amount
+	rewards = rewards.Intersect(campaign.Undistributed())
+	if rewards.IsZero() {
+		return campaign
+	}
+
+	totalShares, found := k.getCampaignTotalShares(ctx, campaign.SourceType, campaign.SourceID)
+	if !found || !totalShares.IsPositive() {
+		return campaign
+	}
+
+	indexes, found := k.GetSourceRewardIndexes(ctx, campaign.SourceType, campaign.SourceID)
+	if !found {
+		indexes = types.RewardIndexes{}
+	}
+	increment := types.NewRewardIndexesFromCoins(rewards).Quo(totalShares)
+	k.SetSourceRewardIndexes(ctx, campaign.SourceType, campaign.SourceID, indexes.Add(increment))
+
+	previouslyDistributed := campaign.DistributedCoins()
+	campaign.Distributed = campaign.Distributed.Add(rewards...)
+	payable := campaign.DistributedCoins().Sub(previouslyDistributed...)
+	if !payable.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.CampaignAccountName, types.IncentiveMacc, payable); err != nil {
+			panic(fmt.Sprintf("failed to pay out rewards of incentive campaign %d: %s", campaign.ID, err))
+		}
+	}
+	return campaign
+}
+
+// refundIncentiveCampaign returns the undistributed rewards of an ended campaign to its creator and deletes it
+func (k Keeper) refundIncentiveCampaign(ctx sdk.Context, campaign types.IncentiveCampaign) {
+	refund := campaign.Escrowed()
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CampaignAccountName, campaign.Creator, refund); err != nil {
+			panic(fmt.Sprintf("failed to refund incentive campaign %d: %s", campaign.ID, err))
+		}
+	}
+	k.DeleteIncentiveCampaign(ctx, campaign.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundIncentiveCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, fmt.Sprintf("%d", campaign.ID)),
+			sdk.NewAttribute(types.AttributeKeyCreator, campaign.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, refund.String()),
+		),
+	)
+}
+
+// validateCampaignSource checks a campaign can pay rewards to a source. The source must exist, which for swap pools,
+// earn vaults and other registered sources means it has shares.
+func (k Keeper) validateCampaignSource(ctx sdk.Context, sourceType, sourceID string) error {
+	if sourceType == types.EarnRewardSourceType && sourceID == "bkava" {
+		// bkava rewards are split between the bkava vaults, so campaigns must pay a single vault
+		return errorsmod.Wrap(types.ErrInvalidCampaign, "bkava is not a vault, use the vault of a single bkava denom")
+	}
+	source, found := k.GetRewardSource(sourceType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidCampaign, "source type %s is not registered", sourceType)
+	}
+	if !source.GetTotalShares(ctx, sourceID).IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidCampaign, "%s source %s does not exist", sourceType, sourceID)
+	}
+	return nil
+}
+
+// getCampaignTotalShares returns the shares a campaign's rewards are shared over, including the shares added by
+// owners' boosts.
+func (k Keeper) getCampaignTotalShares(ctx sdk.Context, sourceType, sourceID string) (sdk.Dec, bool) {
+	if _, found := k.GetRewardSource(sourceType); !found {
+		return sdk.ZeroDec(), false
+	}
+	return k.getSourceTotalShares(ctx, sourceType, sourceID), true
+}
+
+// hasClaimMultipliers returns true if rewards of a denom have multipliers they can be claimed with
+func hasClaimMultipliers(params types.Params, denom string) bool {
+	for _, dm := range params.ClaimMultipliers {
+		if dm.Denom == denom {
+			return len(dm.Multipliers) > 0
+		}
+	}
+	return false
+}
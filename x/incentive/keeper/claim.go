@@ -1,306 +1,148 @@
 package keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/incentive/types"
 )
 
-// ClaimUSDXMintingReward pays out funds from a claim to a receiver account.
+// ClaimUSDXMintingReward pays out USDX minting rewards from all of an owner's cdps to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
 func (k Keeper) ClaimUSDXMintingReward(ctx sdk.Context, owner, receiver sdk.AccAddress, multiplierName string) error {
-	claim, found := k.GetUSDXMintingClaim(ctx, owner)
-	if !found {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
-	}
-
-	multiplier, found := k.GetMultiplierByDenom(ctx, types.USDXMintingRewardDenom, multiplierName)
-	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", types.USDXMintingRewardDenom, multiplierName)
-	}
-
-	claimEnd := k.GetClaimEnd(ctx)
-
-	if ctx.BlockTime().After(claimEnd) {
-		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
-	}
-
-	claim, err := k.SynchronizeUSDXMintingClaim(ctx, claim)
-	if err != nil {
-		return err
-	}
-
-	rewardAmount := sdk.NewDecFromInt(claim.Reward.Amount).Mul(multiplier.Factor).RoundInt()
-	if rewardAmount.IsZero() {
-		return types.ErrZeroClaim
-	}
-	rewardCoin := sdk.NewCoin(claim.Reward.Denom, rewardAmount)
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
-
-	err = k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, sdk.NewCoins(rewardCoin), length)
-	if err != nil {
-		return err
-	}
-
-	k.ZeroUSDXMintingClaim(ctx, claim)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeClaim,
-			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimAmount, claim.Reward.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimType, claim.GetType()),
-		),
-	)
-	return nil
+	return k.ClaimReward(ctx, types.USDXMintingClaimType, owner, receiver, types.USDXMintingRewardDenom, multiplierName)
 }
 
-// ClaimHardReward pays out funds from a claim to a receiver account.
+// ClaimHardReward pays out hard supply and borrow rewards of a denom to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
 func (k Keeper) ClaimHardReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
-	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
-	}
-
-	claimEnd := k.GetClaimEnd(ctx)
-
-	if ctx.BlockTime().After(claimEnd) {
-		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
-	}
-
-	k.SynchronizeHardLiquidityProviderClaim(ctx, owner)
-
-	syncedClaim, found := k.GetHardLiquidityProviderClaim(ctx, owner)
-	if !found {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
-	}
-
-	amt := syncedClaim.Reward.AmountOf(denom)
-
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
-	if rewardCoins.IsZero() {
-		return types.ErrZeroClaim
-	}
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
-
-	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
-		return err
-	}
-
-	// remove claimed coins (NOT reward coins)
-	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetHardLiquidityProviderClaim(ctx, syncedClaim)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeClaim,
-			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
-		),
-	)
-	return nil
+	return k.ClaimReward(ctx, types.HardLiquidityProviderClaimType, owner, receiver, denom, multiplierName)
 }
 
-// ClaimDelegatorReward pays out funds from a claim to a receiver account.
+// ClaimDelegatorReward pays out delegator rewards of a denom to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
 func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	claim, found := k.GetDelegatorClaim(ctx, owner)
-	if !found {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
-	}
-
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
-	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
-	}
-
-	claimEnd := k.GetClaimEnd(ctx)
-
-	if ctx.BlockTime().After(claimEnd) {
-		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
-	}
-
-	syncedClaim, err := k.SynchronizeDelegatorClaim(ctx, claim)
-	if err != nil {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
-	}
-
-	amt := syncedClaim.Reward.AmountOf(denom)
-
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
-	if rewardCoins.IsZero() {
-		return types.ErrZeroClaim
-	}
-
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
-
-	err = k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
-		return err
-	}
-
-	// remove claimed coins (NOT reward coins)
-	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetDelegatorClaim(ctx, syncedClaim)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeClaim,
-			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
-		),
-	)
-	return nil
+	return k.ClaimReward(ctx, types.DelegatorClaimType, owner, receiver, denom, multiplierName)
 }
 
-// ClaimSwapReward pays out funds from a claim to a receiver account.
+// ClaimSwapReward pays out swap rewards of a denom from all pools to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
 func (k Keeper) ClaimSwapReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
-	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
-	}
-
-	claimEnd := k.GetClaimEnd(ctx)
-
-	if ctx.BlockTime().After(claimEnd) {
-		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
-	}
-
-	syncedClaim, found := k.GetSynchronizedSwapClaim(ctx, owner)
-	if !found {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
-	}
-
-	amt := syncedClaim.Reward.AmountOf(denom)
-
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
-	if rewardCoins.IsZero() {
-		return types.ErrZeroClaim
-	}
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
-
-	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
-		return err
-	}
-
-	// remove claimed coins (NOT reward coins)
-	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetSwapClaim(ctx, syncedClaim)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeClaim,
-			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
-		),
-	)
-	return nil
+	return k.ClaimReward(ctx, types.SwapClaimType, owner, receiver, denom, multiplierName)
 }
 
-// ClaimSavingsReward is a stub method for MsgServer interface compliance
+// ClaimSavingsReward pays out savings rewards of a denom to a receiver account.
+// Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
 func (k Keeper) ClaimSavingsReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
-	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
-	}
-
-	claimEnd := k.GetClaimEnd(ctx)
+	return k.ClaimReward(ctx, types.SavingsClaimType, owner, receiver, denom, multiplierName)
+}
 
-	if ctx.BlockTime().After(claimEnd) {
-		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
-	}
+// ClaimEarnReward pays out earn rewards of a denom from all vaults to a receiver account.
+// Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
+func (k Keeper) ClaimEarnReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
+	return k.ClaimReward(ctx, types.EarnClaimType, owner, receiver, denom, multiplierName)
+}
 
-	k.SynchronizeSavingsClaim(ctx, owner)
+// claimFunc pays out the rewards of a single denom from a claim to a receiver account.
+type claimFunc func(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error
 
-	syncedClaim, found := k.GetSavingsClaim(ctx, owner)
-	if !found {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
-	}
+// claimSource is a claim type and the function used to claim its rewards.
+type claimSource struct {
+	claimType string
+	claim     claimFunc
+}
 
-	amt := syncedClaim.Reward.AmountOf(denom)
+// claimSources returns the claim types that rewards can be claimed from, in the order they are claimed.
+// Savings claims are not included as they are disabled. Reward sources registered by other modules are claimed last,
+// using their source type as the claim type.
+func (k Keeper) claimSources() []claimSource {
+	sources := []claimSource{
+		{types.USDXMintingClaimType, k.claimUSDXMintingRewardDenom},
+		{types.HardLiquidityProviderClaimType, k.ClaimHardReward},
+		{types.DelegatorClaimType, k.ClaimDelegatorReward},
+		{types.SwapClaimType, k.ClaimSwapReward},
+		{types.EarnClaimType, k.ClaimEarnReward},
+	}
+	for _, sourceType := range k.GetRewardSourceTypes() {
+		if types.IsBuiltInSourceType(sourceType) {
+			continue
+		}
+		sourceType := sourceType
+		sources = append(sources, claimSource{
+			sourceType,
+			func(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
+				return k.ClaimSourceReward(ctx, sourceType, owner, receiver, denom, multiplierName)
+			},
+		})
+	}
+	return sources
+}
 
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
-	if rewardCoins.IsZero() {
-		return types.ErrZeroClaim
+// getClaimFunc returns the function used to claim rewards of a claim type.
+func (k Keeper) getClaimFunc(claimType string) (claimFunc, bool) {
+	for _, source := range k.claimSources() {
+		if source.claimType == claimType {
+			return source.claim, true
+		}
 	}
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
+	return nil, false
+}
 
-	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
-		return err
-	}
+// claimSelections claims each selected denom from a claim, skipping denoms with nothing to claim, and returns the
+// rewards paid to the receiver.
+func (k Keeper) claimSelections(
+	ctx sdk.Context, claim claimFunc, owner, receiver sdk.AccAddress, selections types.Selections,
+) (sdk.Coins, error) {
+	balanceBefore := k.bankKeeper.GetAllBalances(ctx, receiver)
 
-	// remove claimed coins (NOT reward coins)
-	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetSavingsClaim(ctx, syncedClaim)
+	for _, selection := range selections {
+		err := claim(ctx, owner, receiver, selection.Denom, selection.MultiplierName)
+		if errors.Is(err, types.ErrClaimNotFound) || errors.Is(err, types.ErrZeroClaim) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeClaim,
-			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
-		),
-	)
-	return nil
+	return k.bankKeeper.GetAllBalances(ctx, receiver).Sub(balanceBefore...), nil
 }
 
-// ClaimEarnReward pays out funds from a claim to a receiver account.
-// Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
-func (k Keeper) ClaimEarnReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
-	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
-	}
-
-	claimEnd := k.GetClaimEnd(ctx)
+// ClaimAllRewards pays out the rewards of every claim type the owner holds to a receiver account, using the multiplier
+// selected for each reward denom. Reward denoms without a selection are left in the claims. Savings rewards are not
+// paid out as savings claims are disabled.
+// Either all claims are paid out or, if any claim fails, none are.
+func (k Keeper) ClaimAllRewards(
+	ctx sdk.Context, owner, receiver sdk.AccAddress, selections types.Selections,
+) (types.ClaimedRewards, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
 
-	if ctx.BlockTime().After(claimEnd) {
-		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
+	claimed := types.ClaimedRewards{}
+	for _, source := range k.claimSources() {
+		paid, err := k.claimSelections(cacheCtx, source.claim, owner, receiver, selections)
+		if err != nil {
+			return nil, err
+		}
+		if !paid.IsZero() {
+			claimed = append(claimed, types.NewClaimedReward(source.claimType, paid))
+		}
 	}
 
-	syncedClaim, found := k.GetSynchronizedEarnClaim(ctx, owner)
-	if !found {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+	if len(claimed) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrZeroClaim, "no rewards to claim for address: %s", owner)
 	}
 
-	amt := syncedClaim.Reward.AmountOf(denom)
+	writeCache()
 
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
-	if rewardCoins.IsZero() {
-		return types.ErrZeroClaim
-	}
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
+	return claimed, nil
+}
 
-	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
-		return err
+// claimUSDXMintingRewardDenom claims USDX minting rewards if the denom is the USDX minting reward denom.
+func (k Keeper) claimUSDXMintingRewardDenom(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
+	if denom != types.USDXMintingRewardDenom {
+		return nil
 	}
-
-	// remove claimed coins (NOT reward coins)
-	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetEarnClaim(ctx, syncedClaim)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeClaim,
-			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
-		),
-	)
-	return nil
+	return k.ClaimUSDXMintingReward(ctx, owner, receiver, multiplierName)
 }
@@ -38,19 +38,15 @@ func (suite *ClaimTests) TestCannotClaimWhenMultiplierNotRecognised() {
 	}
 	suite.keeper = suite.NewKeeper(subspace, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	claim := types.DelegatorClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-	}
-	suite.storeDelegatorClaim(claim)
+	owner := arbitraryAddress()
+	suite.storeSourceClaims(types.NewSourceClaim(types.DelegatorRewardSourceType, types.BondDenom, owner, nil, nil))
 
 	// multiplier not in params
-	err := suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, "hard", "large")
+	err := suite.keeper.ClaimDelegatorReward(suite.ctx, owner, owner, "hard", "large")
 	suite.ErrorIs(err, types.ErrInvalidMultiplier)
 
 	// invalid multiplier name
-	err = suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, "hard", "")
+	err = suite.keeper.ClaimDelegatorReward(suite.ctx, owner, owner, "hard", "")
 	suite.ErrorIs(err, types.ErrInvalidMultiplier)
 }
 
@@ -74,13 +70,9 @@ func (suite *ClaimTests) TestCannotClaimAfterEndTime() {
 
 	suite.ctx = suite.ctx.WithBlockTime(endTime.Add(time.Nanosecond))
 
-	claim := types.DelegatorClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-	}
-	suite.storeDelegatorClaim(claim)
+	owner := arbitraryAddress()
+	suite.storeSourceClaims(types.NewSourceClaim(types.DelegatorRewardSourceType, types.BondDenom, owner, nil, nil))
 
-	err := suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, "hard", "small")
+	err := suite.keeper.ClaimDelegatorReward(suite.ctx, owner, owner, "hard", "small")
 	suite.ErrorIs(err, types.ErrClaimExpired)
 }
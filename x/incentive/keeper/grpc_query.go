@@ -73,15 +73,18 @@ func (s queryServer) Rewards(
 		owner = addr
 	}
 
-	if err := s.queryRewards(sdkCtx, &res, owner, hasOwner, req.RewardType); err != nil {
+	claims, err := s.queryRewards(sdkCtx, owner, hasOwner, req.RewardType, !req.Unsynchronized)
+	if err != nil {
 		return nil, err
 	}
+	res.Claims = claims
 
-	if !req.Unsynchronized {
-		if err := s.synchronizeRewards(sdkCtx, &res); err != nil {
-			return nil, err
-		}
-	}
+	res.USDXMintingClaims = types.NewUSDXMintingClaims(claims)
+	res.HardLiquidityProviderClaims = types.NewHardLiquidityProviderClaims(claims)
+	res.DelegatorClaims = types.NewDelegatorClaims(claims)
+	res.SwapClaims = types.NewSwapClaims(claims)
+	res.SavingsClaims = types.NewSavingsClaims(claims)
+	res.EarnClaims = types.NewEarnClaims(claims)
 
 	return &res, nil
 }
@@ -195,148 +198,166 @@ func (s queryServer) Apy(
 	}, nil
 }
 
-// queryRewards queries the rewards for a given owner and reward type, updating
-// the response with the results in place.
+// queryRewards returns the claims of an owner, or of all owners, in the sources of a reward type. Claims are
+// synchronized with the global reward indexes if synchronize is set.
 func (s queryServer) queryRewards(
 	ctx sdk.Context,
-	res *types.QueryRewardsResponse,
 	owner sdk.AccAddress,
 	hasOwner bool,
 	rewardType string,
-) error {
-	rewardType = strings.ToLower(rewardType)
-	isAllRewards := rewardType == ""
-
-	if !rewardTypeIsValid(rewardType) {
-		return status.Errorf(codes.InvalidArgument, "invalid reward type for owner %s: %s", owner, rewardType)
+	synchronize bool,
+) (types.SourceClaims, error) {
+	sourceTypes, ok := s.getRewardTypeSourceTypes(strings.ToLower(rewardType))
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reward type for owner %s: %s", owner, rewardType)
 	}
 
-	if isAllRewards || rewardType == RewardTypeUSDXMinting {
-		if hasOwner {
-			usdxMintingClaim, foundUsdxMintingClaim := s.keeper.GetUSDXMintingClaim(ctx, owner)
-			if foundUsdxMintingClaim {
-				res.USDXMintingClaims = append(res.USDXMintingClaims, usdxMintingClaim)
+	claims := types.SourceClaims{}
+	if hasOwner {
+		for _, sourceType := range sourceTypes {
+			if synchronize {
+				claims = append(claims, s.keeper.GetSynchronizedSourceClaims(ctx, sourceType, owner)...)
+				continue
 			}
-		} else {
-			usdxMintingClaims := s.keeper.GetAllUSDXMintingClaims(ctx)
-			res.USDXMintingClaims = append(res.USDXMintingClaims, usdxMintingClaims...)
+			s.keeper.IterateSourceClaimsByOwner(ctx, sourceType, owner, func(c types.SourceClaim) bool {
+				claims = append(claims, c)
+				return false
+			})
 		}
+		return claims, nil
 	}
 
-	if isAllRewards || rewardType == RewardTypeHard {
-		if hasOwner {
-			hardClaim, foundHardClaim := s.keeper.GetHardLiquidityProviderClaim(ctx, owner)
-			if foundHardClaim {
-				res.HardLiquidityProviderClaims = append(res.HardLiquidityProviderClaims, hardClaim)
-			}
-		} else {
-			hardClaims := s.keeper.GetAllHardLiquidityProviderClaims(ctx)
-			res.HardLiquidityProviderClaims = append(res.HardLiquidityProviderClaims, hardClaims...)
-		}
+	isQueried := make(map[string]bool)
+	for _, sourceType := range sourceTypes {
+		isQueried[sourceType] = true
 	}
-
-	if isAllRewards || rewardType == RewardTypeDelegator {
-		if hasOwner {
-			delegatorClaim, foundDelegatorClaim := s.keeper.GetDelegatorClaim(ctx, owner)
-			if foundDelegatorClaim {
-				res.DelegatorClaims = append(res.DelegatorClaims, delegatorClaim)
-			}
-		} else {
-			delegatorClaims := s.keeper.GetAllDelegatorClaims(ctx)
-			res.DelegatorClaims = append(res.DelegatorClaims, delegatorClaims...)
+	s.keeper.IterateSourceClaims(ctx, func(c types.SourceClaim) bool {
+		if !isQueried[c.SourceType] {
+			return false
 		}
+		if synchronize {
+			c, _ = s.keeper.synchronizeSourceReward(ctx, c.SourceType, c.SourceID, c.Owner)
+		}
+		claims = append(claims, c)
+		return false
+	})
+	return claims, nil
+}
+
+// getRewardTypeSourceTypes returns the reward source types queried by a reward type. An empty reward type queries all
+// registered source types.
+func (s queryServer) getRewardTypeSourceTypes(rewardType string) ([]string, bool) {
+	switch rewardType {
+	case "":
+		return s.keeper.GetRewardSourceTypes(), true
+	case RewardTypeHard:
+		return []string{types.HardSupplyRewardSourceType, types.HardBorrowRewardSourceType}, true
+	case RewardTypeUSDXMinting:
+		return []string{types.USDXMintingRewardSourceType}, true
+	case RewardTypeDelegator:
+		return []string{types.DelegatorRewardSourceType}, true
+	case RewardTypeSwap:
+		return []string{types.SwapRewardSourceType}, true
+	case RewardTypeSavings:
+		return []string{types.SavingsRewardSourceType}, true
+	case RewardTypeEarn:
+		return []string{types.EarnRewardSourceType}, true
+	}
+	if _, found := s.keeper.GetRewardSource(rewardType); found {
+		return []string{rewardType}, true
 	}
+	return nil, false
+}
 
-	if isAllRewards || rewardType == RewardTypeSwap {
-		if hasOwner {
-			swapClaim, foundSwapClaim := s.keeper.GetSwapClaim(ctx, owner)
-			if foundSwapClaim {
-				res.SwapClaims = append(res.SwapClaims, swapClaim)
-			}
-		} else {
-			swapClaims := s.keeper.GetAllSwapClaims(ctx)
-			res.SwapClaims = append(res.SwapClaims, swapClaims...)
-		}
+func (s queryServer) AccountRewardRates(
+	ctx context.Context,
+	req *types.QueryAccountRewardRatesRequest,
+) (*types.QueryAccountRewardRatesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
-	if isAllRewards || rewardType == RewardTypeSavings {
-		if hasOwner {
-			savingsClaim, foundSavingsClaim := s.keeper.GetSavingsClaim(ctx, owner)
-			if foundSavingsClaim {
-				res.SavingsClaims = append(res.SavingsClaims, savingsClaim)
-			}
-		} else {
-			savingsClaims := s.keeper.GetAllSavingsClaims(ctx)
-			res.SavingsClaims = append(res.SavingsClaims, savingsClaims...)
-		}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
 	}
 
-	if isAllRewards || rewardType == RewardTypeEarn {
-		if hasOwner {
-			earnClaim, foundEarnClaim := s.keeper.GetEarnClaim(ctx, owner)
-			if foundEarnClaim {
-				res.EarnClaims = append(res.EarnClaims, earnClaim)
-			}
-		} else {
-			earnClaims := s.keeper.GetAllEarnClaims(ctx)
-			res.EarnClaims = append(res.EarnClaims, earnClaims...)
-		}
+	rates, err := s.keeper.GetAccountRewardRates(sdkCtx, owner)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &types.QueryAccountRewardRatesResponse{
+		RewardRates: rates,
+	}, nil
 }
 
-// synchronizeRewards synchronizes all non-empty rewards in place.
-func (s queryServer) synchronizeRewards(
-	ctx sdk.Context,
-	res *types.QueryRewardsResponse,
-) error {
-	// Synchronize all non-empty rewards
-	for i, claim := range res.USDXMintingClaims {
-		res.USDXMintingClaims[i] = s.keeper.SimulateUSDXMintingSynchronization(ctx, claim)
+func (s queryServer) BoostLock(
+	ctx context.Context,
+	req *types.QueryBoostLockRequest,
+) (*types.QueryBoostLockResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
-	for i, claim := range res.HardLiquidityProviderClaims {
-		res.HardLiquidityProviderClaims[i] = s.keeper.SimulateHardSynchronization(ctx, claim)
-	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	for i, claim := range res.DelegatorClaims {
-		res.DelegatorClaims[i] = s.keeper.SimulateDelegatorSynchronization(ctx, claim)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
 	}
 
-	for i, claim := range res.SwapClaims {
-		syncedClaim, found := s.keeper.GetSynchronizedSwapClaim(ctx, claim.Owner)
-		if !found {
-			return status.Errorf(codes.Internal, "previously found swap claim for owner %s should still be found", claim.Owner)
-		}
-		res.SwapClaims[i] = syncedClaim
+	res := &types.QueryBoostLockResponse{
+		BoostedShares: s.keeper.GetBoostedSharesByOwner(sdkCtx, owner),
+		TotalWeight:   s.keeper.GetTotalBoostWeight(sdkCtx),
+	}
+	if lock, found := s.keeper.GetBoostLock(sdkCtx, owner); found {
+		res.Lock = &lock
 	}
+	return res, nil
+}
 
-	for i, claim := range res.SavingsClaims {
-		syncedClaim, found := s.keeper.GetSynchronizedSavingsClaim(ctx, claim.Owner)
-		if !found {
-			return status.Errorf(codes.Internal, "previously found savings claim for owner %s should still be found", claim.Owner)
-		}
-		res.SavingsClaims[i] = syncedClaim
+func (s queryServer) Campaigns(
+	ctx context.Context,
+	req *types.QueryCampaignsRequest,
+) (*types.QueryCampaignsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
-	for i, claim := range res.EarnClaims {
-		syncedClaim, found := s.keeper.GetSynchronizedEarnClaim(ctx, claim.Owner)
-		if !found {
-			return status.Errorf(codes.Internal, "previously found earn claim for owner %s should still be found", claim.Owner)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	campaigns := types.IncentiveCampaigns{}
+	s.keeper.IterateIncentiveCampaigns(sdkCtx, func(campaign types.IncentiveCampaign) (stop bool) {
+		if req.SourceType != "" && campaign.SourceType != req.SourceType {
+			return false
 		}
-		res.EarnClaims[i] = syncedClaim
-	}
+		if req.SourceID != "" && campaign.SourceID != req.SourceID {
+			return false
+		}
+		campaigns = append(campaigns, campaign)
+		return false
+	})
 
-	return nil
+	return &types.QueryCampaignsResponse{Campaigns: campaigns}, nil
 }
 
-func rewardTypeIsValid(rewardType string) bool {
-	return rewardType == "" ||
-		rewardType == RewardTypeHard ||
-		rewardType == RewardTypeUSDXMinting ||
-		rewardType == RewardTypeDelegator ||
-		rewardType == RewardTypeSwap ||
-		rewardType == RewardTypeSavings ||
-		rewardType == RewardTypeEarn
+func (s queryServer) Campaign(
+	ctx context.Context,
+	req *types.QueryCampaignRequest,
+) (*types.QueryCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	campaign, found := s.keeper.GetIncentiveCampaign(sdkCtx, req.CampaignId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "campaign %d not found", req.CampaignId)
+	}
+
+	return &types.QueryCampaignResponse{Campaign: campaign}, nil
 }
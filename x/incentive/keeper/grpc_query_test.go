@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -77,6 +78,7 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "btcb/usdx", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), cs(c("swp", 122354)))},
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "ukava", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), cs(c("hard", 122354)))},
 			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "ukava", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), cs(c("hard", 122354)))},
+			types.DefaultSourceRewardPeriods,
 			types.MultipliersPerDenoms{
 				{
 					Denom: "ukava",
@@ -100,6 +102,8 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 				},
 			},
 			suite.genesisTime.Add(5*oneYear),
+			types.DefaultBoostParams,
+			types.DefaultCampaignParams,
 		),
 		types.NewGenesisRewardState(
 			types.AccumulationTimes{
@@ -157,60 +161,64 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 				types.NewMultiRewardIndex("usdx", types.RewardIndexes{{CollateralType: "usdx", RewardFactor: d("0.2")}}),
 			},
 		),
-		types.USDXMintingClaims{
-			types.NewUSDXMintingClaim(
-				suite.addrs[0],
-				c("ukava", 1e9),
-				types.RewardIndexes{{CollateralType: "bnb-a", RewardFactor: d("0.3")}},
-			),
-			types.NewUSDXMintingClaim(
-				suite.addrs[1],
-				c("ukava", 1),
-				types.RewardIndexes{{CollateralType: "bnb-a", RewardFactor: d("0.001")}},
+		types.DefaultAutoCompoundSettings,
+		types.DefaultSourceRewardStates,
+		types.SourceClaims{
+			types.NewSourceClaim(
+				types.EarnRewardSourceType, "usdx", suite.addrs[3],
+				nil,
+				types.RewardIndexes{{CollateralType: "usdx", RewardFactor: d("0.0")}},
 			),
-		},
-		types.HardLiquidityProviderClaims{
-			types.NewHardLiquidityProviderClaim(
-				suite.addrs[0],
-				cs(c("ukava", 1e9), c("hard", 1e9)),
-				types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.01")}}}},
-				types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.0")}}}},
+			types.NewSourceClaim(
+				types.SwapRewardSourceType, "btcb/usdx", suite.addrs[3],
+				nil,
+				types.RewardIndexes{{CollateralType: "swap", RewardFactor: d("0.0")}},
 			),
-			types.NewHardLiquidityProviderClaim(
-				suite.addrs[1],
-				cs(c("hard", 1)),
-				types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}}}},
-				types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.0")}}}},
+			types.NewSourceClaim(
+				types.SavingsRewardSourceType, "ukava", suite.addrs[3],
+				nil,
+				types.RewardIndexes{{CollateralType: "ukava", RewardFactor: d("0.0")}},
 			),
-		},
-		types.DelegatorClaims{
-			types.NewDelegatorClaim(
-				suite.addrs[2],
+			types.NewSourceClaim(
+				types.DelegatorRewardSourceType, "ukava", suite.addrs[2],
 				cs(c("hard", 5)),
-				types.MultiRewardIndexes{{CollateralType: "ukava", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.2")}}}},
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.2")}},
 			),
-		},
-		types.SwapClaims{
-			types.NewSwapClaim(
-				suite.addrs[3],
+			types.NewSourceClaim(
+				types.HardBorrowRewardSourceType, "bnb", suite.addrs[0],
 				nil,
-				types.MultiRewardIndexes{{CollateralType: "btcb/usdx", RewardIndexes: types.RewardIndexes{{CollateralType: "swap", RewardFactor: d("0.0")}}}},
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.0")}},
 			),
-		},
-		types.SavingsClaims{
-			types.NewSavingsClaim(
-				suite.addrs[3],
+			types.NewSourceClaim(
+				types.HardBorrowRewardSourceType, "bnb", suite.addrs[1],
 				nil,
-				types.MultiRewardIndexes{{CollateralType: "ukava", RewardIndexes: types.RewardIndexes{{CollateralType: "ukava", RewardFactor: d("0.0")}}}},
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.0")}},
 			),
-		},
-		types.EarnClaims{
-			types.NewEarnClaim(
-				suite.addrs[3],
-				nil,
-				types.MultiRewardIndexes{{CollateralType: "usdx", RewardIndexes: types.RewardIndexes{{CollateralType: "usdx", RewardFactor: d("0.0")}}}},
+			types.NewSourceClaim(
+				types.HardSupplyRewardSourceType, "bnb", suite.addrs[0],
+				cs(c("ukava", 1e9), c("hard", 1e9)),
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.01")}},
+			),
+			types.NewSourceClaim(
+				types.HardSupplyRewardSourceType, "bnb", suite.addrs[1],
+				cs(c("hard", 1)),
+				types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}},
+			),
+			types.NewSourceClaim(
+				types.USDXMintingRewardSourceType, "bnb-a", suite.addrs[0],
+				cs(c("ukava", 1e9)),
+				types.RewardIndexes{{CollateralType: "ukava", RewardFactor: d("0.3")}},
+			),
+			types.NewSourceClaim(
+				types.USDXMintingRewardSourceType, "bnb-a", suite.addrs[1],
+				cs(c("ukava", 1)),
+				types.RewardIndexes{{CollateralType: "ukava", RewardFactor: d("0.001")}},
 			),
 		},
+		types.DefaultBoostLocks,
+		types.DefaultBoostedSharesList,
+		types.DefaultCampaigns,
+		types.DefaultNextCampaignID,
 	)
 
 	err := suite.genesisState.Validate()
@@ -225,11 +233,10 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 	)
 
 	suite.tApp.DeleteGenesisValidator(suite.T(), suite.ctx)
-	claims := suite.keeper.GetAllDelegatorClaims(suite.ctx)
-	for _, claim := range claims {
+	for _, claim := range suite.keeper.GetAllSourceClaims(suite.ctx) {
 		// Delete the InitGenesis validator's claim
-		if !claim.Owner.Equals(suite.addrs[2]) {
-			suite.keeper.DeleteDelegatorClaim(suite.ctx, claim.Owner)
+		if claim.SourceType == types.DelegatorRewardSourceType && !claim.Owner.Equals(suite.addrs[2]) {
+			suite.keeper.DeleteSourceClaim(suite.ctx, claim.SourceType, claim.SourceID, claim.Owner)
 		}
 	}
 }
@@ -249,12 +256,7 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewards() {
 	})
 	suite.Require().NoError(err)
 
-	suite.Equal(suite.genesisState.USDXMintingClaims, res.USDXMintingClaims)
-	suite.Equal(suite.genesisState.HardLiquidityProviderClaims, res.HardLiquidityProviderClaims)
-	suite.Equal(suite.genesisState.DelegatorClaims, res.DelegatorClaims)
-	suite.Equal(suite.genesisState.SwapClaims, res.SwapClaims)
-	suite.Equal(suite.genesisState.SavingsClaims, res.SavingsClaims)
-	suite.Equal(suite.genesisState.EarnClaims, res.EarnClaims)
+	suite.ElementsMatch(suite.genesisState.SourceClaims, res.Claims)
 }
 
 func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_Owner() {
@@ -263,17 +265,8 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_Owner() {
 	})
 	suite.Require().NoError(err)
 
-	suite.Len(res.USDXMintingClaims, 1)
-	suite.Len(res.HardLiquidityProviderClaims, 1)
-
-	suite.Equal(suite.genesisState.USDXMintingClaims[0], res.USDXMintingClaims[0])
-	suite.Equal(suite.genesisState.HardLiquidityProviderClaims[0], res.HardLiquidityProviderClaims[0])
-
-	// No other claims - owner has none
-	suite.Empty(res.DelegatorClaims)
-	suite.Empty(res.SwapClaims)
-	suite.Empty(res.SavingsClaims)
-	suite.Empty(res.EarnClaims)
+	// Only the owner's claims are returned
+	suite.ElementsMatch(withoutIndexes(suite.filterGenesisClaims(suite.addrs[0])), withoutIndexes(res.Claims))
 }
 
 func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_RewardType() {
@@ -283,14 +276,11 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_RewardType() {
 	})
 	suite.Require().NoError(err)
 
-	suite.Equal(suite.genesisState.HardLiquidityProviderClaims, res.HardLiquidityProviderClaims)
-
 	// No other reward types when specifying rewardType
-	suite.Empty(res.USDXMintingClaims)
-	suite.Empty(res.DelegatorClaims)
-	suite.Empty(res.SwapClaims)
-	suite.Empty(res.SavingsClaims)
-	suite.Empty(res.EarnClaims)
+	suite.ElementsMatch(
+		suite.filterGenesisClaims(nil, types.HardSupplyRewardSourceType, types.HardBorrowRewardSourceType),
+		res.Claims,
+	)
 }
 
 func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_RewardType_and_Owner() {
@@ -300,8 +290,27 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_RewardType_and_Owner() {
 	})
 	suite.Require().NoError(err)
 
-	suite.Len(res.HardLiquidityProviderClaims, 1)
-	suite.Equal(suite.genesisState.HardLiquidityProviderClaims[0], res.HardLiquidityProviderClaims[0])
+	suite.ElementsMatch(
+		withoutIndexes(suite.filterGenesisClaims(suite.addrs[0], types.HardSupplyRewardSourceType, types.HardBorrowRewardSourceType)),
+		withoutIndexes(res.Claims),
+	)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_DeprecatedClaims() {
+	res, err := suite.queryClient.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{
+		RewardType:     keeper.RewardTypeHard,
+		Unsynchronized: true,
+	})
+	suite.Require().NoError(err)
+
+	// The deprecated claims group the same claims by owner
+	suite.Require().NotEmpty(res.HardLiquidityProviderClaims)
+	var claims types.SourceClaims
+	for _, claim := range res.HardLiquidityProviderClaims {
+		claims = append(claims, claim.SourceClaims()...)
+	}
+	suite.ElementsMatch(withoutRewards(res.Claims), withoutRewards(claims))
+	suite.Equal(totalReward(res.Claims), totalReward(claims))
 
 	suite.Empty(res.USDXMintingClaims)
 	suite.Empty(res.DelegatorClaims)
@@ -310,6 +319,41 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_RewardType_and_Owner() {
 	suite.Empty(res.EarnClaims)
 }
 
+// withoutRewards returns claims with their rewards removed
+func withoutRewards(claims types.SourceClaims) types.SourceClaims {
+	stripped := make(types.SourceClaims, len(claims))
+	for i, claim := range claims {
+		claim.Reward = nil
+		stripped[i] = claim
+	}
+	return stripped
+}
+
+// totalReward returns the sum of the rewards of claims
+func totalReward(claims types.SourceClaims) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, claim := range claims {
+		total = total.Add(claim.Reward...)
+	}
+	return total
+}
+
+// filterGenesisClaims returns the genesis claims of an owner and of source types, where a nil owner or no source types
+// matches all.
+func (suite *grpcQueryTestSuite) filterGenesisClaims(owner sdk.AccAddress, sourceTypes ...string) types.SourceClaims {
+	var claims types.SourceClaims
+	for _, claim := range suite.genesisState.SourceClaims {
+		if owner != nil && !claim.Owner.Equals(owner) {
+			continue
+		}
+		if len(sourceTypes) > 0 && !slices.Contains(sourceTypes, claim.SourceType) {
+			continue
+		}
+		claims = append(claims, claim)
+	}
+	return claims
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryRewardFactors() {
 	res, err := suite.queryClient.RewardFactors(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardFactorsRequest{})
 	suite.Require().NoError(err)
@@ -323,6 +367,17 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewardFactors() {
 	suite.NotEmpty(res.EarnRewardFactors)
 }
 
+// withoutIndexes removes the reward indexes from claims. Synchronized claims have their indexes updated to the global
+// indexes, but the owner has no shares so their rewards are unchanged.
+func withoutIndexes(claims types.SourceClaims) types.SourceClaims {
+	var stripped types.SourceClaims
+	for _, claim := range claims {
+		claim.RewardIndexes = nil
+		stripped = append(stripped, claim)
+	}
+	return stripped
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
@@ -33,7 +33,7 @@ func (k Keeper) Hooks() Hooks { return Hooks{k} }
 
 // AfterCDPCreated function that runs after a cdp is created
 func (h Hooks) AfterCDPCreated(ctx sdk.Context, cdp cdptypes.CDP) {
-	h.k.InitializeUSDXMintingClaim(ctx, cdp)
+	h.k.InitializeUSDXMintingReward(ctx, cdp)
 }
 
 // BeforeCDPModified function that runs before a cdp is modified
@@ -57,7 +57,7 @@ func (h Hooks) BeforeDepositModified(ctx sdk.Context, deposit hardtypes.Deposit)
 
 // AfterDepositModified function that runs after a deposit is modified
 func (h Hooks) AfterDepositModified(ctx sdk.Context, deposit hardtypes.Deposit) {
-	h.k.UpdateHardSupplyIndexDenoms(ctx, deposit)
+	h.k.InitializeHardSupplyReward(ctx, deposit)
 }
 
 // AfterBorrowCreated function that runs after a borrow is created
@@ -72,7 +72,7 @@ func (h Hooks) BeforeBorrowModified(ctx sdk.Context, borrow hardtypes.Borrow) {
 
 // AfterBorrowModified function that runs after a borrow is modified
 func (h Hooks) AfterBorrowModified(ctx sdk.Context, borrow hardtypes.Borrow) {
-	h.k.UpdateHardBorrowIndexDenoms(ctx, borrow)
+	h.k.InitializeHardBorrowReward(ctx, borrow)
 }
 
 /* ------------------- Staking Module Hooks -------------------
@@ -98,7 +98,7 @@ When delegated tokens (to bonded validators) are changed:
 // BeforeDelegationCreated runs before a delegation is created
 func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	// Add a claim if one doesn't exist, otherwise sync the existing.
-	h.k.InitializeDelegatorReward(ctx, delAddr)
+	h.k.SynchronizeDelegatorRewards(ctx, delAddr, nil, false)
 
 	return nil
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -30,6 +32,10 @@ type Keeper struct {
 	mintKeeper      types.MintKeeper
 	distrKeeper     types.DistrKeeper
 	pricefeedKeeper types.PricefeedKeeper
+
+	// rewardSources are the registered reward source types, including the built in ones. The map is shared between
+	// copies of the keeper.
+	rewardSources map[string]types.RewardSource
 }
 
 // NewKeeper creates a new keeper
@@ -60,169 +66,72 @@ func NewKeeper(
 		mintKeeper:      mk,
 		distrKeeper:     dk,
 		pricefeedKeeper: pfk,
-	}
-}
-
-// GetUSDXMintingClaim returns the claim in the store corresponding the input address collateral type and id and a boolean for if the claim was found
-func (k Keeper) GetUSDXMintingClaim(ctx sdk.Context, addr sdk.AccAddress) (types.USDXMintingClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
-		return types.USDXMintingClaim{}, false
-	}
-	var c types.USDXMintingClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
-}
-
-// SetUSDXMintingClaim sets the claim in the store corresponding to the input address, collateral type, and id
-func (k Keeper) SetUSDXMintingClaim(ctx sdk.Context, c types.USDXMintingClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
-}
-
-// DeleteUSDXMintingClaim deletes the claim in the store corresponding to the input address, collateral type, and id
-func (k Keeper) DeleteUSDXMintingClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
-	store.Delete(owner)
-}
 
-// IterateUSDXMintingClaims iterates over all claim  objects in the store and preforms a callback function
-func (k Keeper) IterateUSDXMintingClaims(ctx sdk.Context, cb func(c types.USDXMintingClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var c types.USDXMintingClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
-			break
-		}
+		rewardSources: make(map[string]types.RewardSource),
 	}
 }
 
-// GetAllUSDXMintingClaims returns all Claim objects in the store
-func (k Keeper) GetAllUSDXMintingClaims(ctx sdk.Context) types.USDXMintingClaims {
-	cs := types.USDXMintingClaims{}
-	k.IterateUSDXMintingClaims(ctx, func(c types.USDXMintingClaim) (stop bool) {
-		cs = append(cs, c)
-		return false
-	})
-	return cs
-}
-
-// GetPreviousUSDXMintingAccrualTime returns the last time a collateral type accrued USDX minting rewards
-func (k Keeper) GetPreviousUSDXMintingAccrualTime(ctx sdk.Context, ctype string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(ctype))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
-}
-
-// SetPreviousUSDXMintingAccrualTime sets the last time a collateral type accrued USDX minting rewards
-func (k Keeper) SetPreviousUSDXMintingAccrualTime(ctx sdk.Context, ctype string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
+// RegisterRewardSource registers a reward source type, allowing the shares it provides to be rewarded. The built in
+// source types are rewarded through their own params, while any other is rewarded through the source reward periods
+// param. It panics if the source type is invalid or already registered.
+func (k Keeper) RegisterRewardSource(sourceType string, source types.RewardSource) {
+	if err := types.ValidateRewardSourceType(sourceType); err != nil {
 		panic(err)
 	}
-	store.Set([]byte(ctype), bz)
-}
-
-// IterateUSDXMintingAccrualTimes iterates over all previous USDX minting accrual times and preforms a callback function
-func (k Keeper) IterateUSDXMintingAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		denom := string(iterator.Key())
-		if cb(denom, accrualTime) {
-			break
-		}
-	}
-}
-
-// GetUSDXMintingRewardFactor returns the current reward factor for an individual collateral type
-func (k Keeper) GetUSDXMintingRewardFactor(ctx sdk.Context, ctype string) (factor sdk.Dec, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingRewardFactorKeyPrefix)
-	bz := store.Get([]byte(ctype))
-	if bz == nil {
-		return sdk.ZeroDec(), false
-	}
-	if err := factor.Unmarshal(bz); err != nil {
-		panic(err)
+	if _, found := k.rewardSources[sourceType]; found {
+		panic(fmt.Sprintf("reward source type %s already registered", sourceType))
 	}
-	return factor, true
+	k.rewardSources[sourceType] = source
 }
 
-// SetUSDXMintingRewardFactor sets the current reward factor for an individual collateral type
-func (k Keeper) SetUSDXMintingRewardFactor(ctx sdk.Context, ctype string, factor sdk.Dec) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingRewardFactorKeyPrefix)
-	bz, err := factor.Marshal()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(ctype), bz)
+// GetRewardSource returns the reward source registered for a source type
+func (k Keeper) GetRewardSource(sourceType string) (types.RewardSource, bool) {
+	source, found := k.rewardSources[sourceType]
+	return source, found
 }
 
-// IterateUSDXMintingRewardFactors iterates over all USDX Minting reward factor objects in the store and preforms a callback function
-func (k Keeper) IterateUSDXMintingRewardFactors(ctx sdk.Context, cb func(denom string, factor sdk.Dec) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingRewardFactorKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var factor sdk.Dec
-		if err := factor.Unmarshal(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(string(iterator.Key()), factor) {
-			break
-		}
+// GetRewardSourceTypes returns the registered reward source types in sorted order
+func (k Keeper) GetRewardSourceTypes() []string {
+	sourceTypes := make([]string, 0, len(k.rewardSources))
+	for sourceType := range k.rewardSources {
+		sourceTypes = append(sourceTypes, sourceType)
 	}
+	sort.Strings(sourceTypes)
+	return sourceTypes
 }
 
-// GetHardLiquidityProviderClaim returns the claim in the store corresponding the input address collateral type and id and a boolean for if the claim was found
-func (k Keeper) GetHardLiquidityProviderClaim(ctx sdk.Context, addr sdk.AccAddress) (types.HardLiquidityProviderClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
-	bz := store.Get(addr)
+// GetSourceClaim returns an owner's claim in a source of a reward source type
+func (k Keeper) GetSourceClaim(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress) (types.SourceClaim, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
+	bz := store.Get(types.SourceClaimKey(sourceType, owner, sourceID))
 	if bz == nil {
-		return types.HardLiquidityProviderClaim{}, false
+		return types.SourceClaim{}, false
 	}
-	var c types.HardLiquidityProviderClaim
+	var c types.SourceClaim
 	k.cdc.MustUnmarshal(bz, &c)
 	return c, true
 }
 
-// SetHardLiquidityProviderClaim sets the claim in the store corresponding to the input address, collateral type, and id
-func (k Keeper) SetHardLiquidityProviderClaim(ctx sdk.Context, c types.HardLiquidityProviderClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
+// SetSourceClaim sets a claim of a reward source type in the store
+func (k Keeper) SetSourceClaim(ctx sdk.Context, c types.SourceClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
 	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	store.Set(types.SourceClaimKey(c.SourceType, c.Owner, c.SourceID), bz)
 }
 
-// DeleteHardLiquidityProviderClaim deletes the claim in the store corresponding to the input address, collateral type, and id
-func (k Keeper) DeleteHardLiquidityProviderClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
-	store.Delete(owner)
+// DeleteSourceClaim deletes an owner's claim in a source of a reward source type
+func (k Keeper) DeleteSourceClaim(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
+	store.Delete(types.SourceClaimKey(sourceType, owner, sourceID))
 }
 
-// IterateHardLiquidityProviderClaims iterates over all claim  objects in the store and preforms a callback function
-func (k Keeper) IterateHardLiquidityProviderClaims(ctx sdk.Context, cb func(c types.HardLiquidityProviderClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+// IterateSourceClaimsByOwner iterates over an owner's claims in all sources of a reward source type
+func (k Keeper) IterateSourceClaimsByOwner(ctx sdk.Context, sourceType string, owner sdk.AccAddress, cb func(c types.SourceClaim) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.SourceClaimOwnerKey(sourceType, owner))
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var c types.HardLiquidityProviderClaim
+		var c types.SourceClaim
 		k.cdc.MustUnmarshal(iterator.Value(), &c)
 		if cb(c) {
 			break
@@ -230,48 +139,13 @@ func (k Keeper) IterateHardLiquidityProviderClaims(ctx sdk.Context, cb func(c ty
 	}
 }
 
-// GetAllHardLiquidityProviderClaims returns all Claim objects in the store
-func (k Keeper) GetAllHardLiquidityProviderClaims(ctx sdk.Context) types.HardLiquidityProviderClaims {
-	cs := types.HardLiquidityProviderClaims{}
-	k.IterateHardLiquidityProviderClaims(ctx, func(c types.HardLiquidityProviderClaim) (stop bool) {
-		cs = append(cs, c)
-		return false
-	})
-	return cs
-}
-
-// GetDelegatorClaim returns the claim in the store corresponding the input address collateral type and id and a boolean for if the claim was found
-func (k Keeper) GetDelegatorClaim(ctx sdk.Context, addr sdk.AccAddress) (types.DelegatorClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
-		return types.DelegatorClaim{}, false
-	}
-	var c types.DelegatorClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
-}
-
-// SetDelegatorClaim sets the claim in the store corresponding to the input address, collateral type, and id
-func (k Keeper) SetDelegatorClaim(ctx sdk.Context, c types.DelegatorClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
-}
-
-// DeleteDelegatorClaim deletes the claim in the store corresponding to the input address, collateral type, and id
-func (k Keeper) DeleteDelegatorClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
-	store.Delete(owner)
-}
-
-// IterateDelegatorClaims iterates over all claim  objects in the store and preforms a callback function
-func (k Keeper) IterateDelegatorClaims(ctx sdk.Context, cb func(c types.DelegatorClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
+// IterateSourceClaims iterates over all claims of all reward source types in the store
+func (k Keeper) IterateSourceClaims(ctx sdk.Context, cb func(c types.SourceClaim) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var c types.DelegatorClaim
+		var c types.SourceClaim
 		k.cdc.MustUnmarshal(iterator.Value(), &c)
 		if cb(c) {
 			break
@@ -279,607 +153,336 @@ func (k Keeper) IterateDelegatorClaims(ctx sdk.Context, cb func(c types.Delegato
 	}
 }
 
-// GetAllDelegatorClaims returns all DelegatorClaim objects in the store
-func (k Keeper) GetAllDelegatorClaims(ctx sdk.Context) types.DelegatorClaims {
-	cs := types.DelegatorClaims{}
-	k.IterateDelegatorClaims(ctx, func(c types.DelegatorClaim) (stop bool) {
+// GetAllSourceClaims returns all claims of all reward source types in the store
+func (k Keeper) GetAllSourceClaims(ctx sdk.Context) types.SourceClaims {
+	cs := types.SourceClaims{}
+	k.IterateSourceClaims(ctx, func(c types.SourceClaim) (stop bool) {
 		cs = append(cs, c)
 		return false
 	})
 	return cs
 }
 
-// GetSwapClaim returns the claim in the store corresponding the input address.
-func (k Keeper) GetSwapClaim(ctx sdk.Context, addr sdk.AccAddress) (types.SwapClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
-		return types.SwapClaim{}, false
+// SetSourceRewardIndexes stores the global reward indexes that track total rewards to a source of a source type.
+func (k Keeper) SetSourceRewardIndexes(ctx sdk.Context, sourceType, sourceID string, indexes types.RewardIndexes) {
+	if sourceID == "" {
+		// sources are identified by their ID, so it must be set
+		panic("source id cannot be empty")
 	}
-	var c types.SwapClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
-}
-
-// SetSwapClaim sets the claim in the store corresponding to the input address.
-func (k Keeper) SetSwapClaim(ctx sdk.Context, c types.SwapClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceRewardIndexesKeyPrefix)
+	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
+		RewardIndexes: indexes,
+	})
+	store.Set(types.SourceRewardKey(sourceType, sourceID), bz)
 }
 
-// DeleteSwapClaim deletes the claim in the store corresponding to the input address.
-func (k Keeper) DeleteSwapClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapClaimKeyPrefix)
-	store.Delete(owner)
+// GetSourceRewardIndexes fetches the global reward indexes that track total rewards to a source of a source type.
+func (k Keeper) GetSourceRewardIndexes(ctx sdk.Context, sourceType, sourceID string) (types.RewardIndexes, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceRewardIndexesKeyPrefix)
+	bz := store.Get(types.SourceRewardKey(sourceType, sourceID))
+	if bz == nil {
+		return types.RewardIndexes{}, false
+	}
+	var proto types.RewardIndexesProto
+	k.cdc.MustUnmarshal(bz, &proto)
+	return proto.RewardIndexes, true
 }
 
-// IterateSwapClaims iterates over all claim  objects in the store and preforms a callback function
-func (k Keeper) IterateSwapClaims(ctx sdk.Context, cb func(c types.SwapClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapClaimKeyPrefix)
+// IterateSourceRewardIndexes iterates over the global reward indexes of all sources of a source type
+func (k Keeper) IterateSourceRewardIndexes(ctx sdk.Context, sourceType string, cb func(sourceID string, indexes types.RewardIndexes) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), append(types.SourceRewardIndexesKeyPrefix, types.SourceTypeKey(sourceType)...))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var c types.SwapClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
+		var proto types.RewardIndexesProto
+		k.cdc.MustUnmarshal(iterator.Value(), &proto)
+		if cb(string(iterator.Key()), proto.RewardIndexes) {
 			break
 		}
 	}
 }
 
-// GetAllSwapClaims returns all Claim objects in the store
-func (k Keeper) GetAllSwapClaims(ctx sdk.Context) types.SwapClaims {
-	cs := types.SwapClaims{}
-	k.IterateSwapClaims(ctx, func(c types.SwapClaim) (stop bool) {
-		cs = append(cs, c)
-		return false
-	})
-	return cs
-}
-
-// GetSavingsClaim returns the claim in the store corresponding the input address.
-func (k Keeper) GetSavingsClaim(ctx sdk.Context, addr sdk.AccAddress) (types.SavingsClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsClaimKeyPrefix)
-	bz := store.Get(addr)
+// GetSourceRewardAccrualTime fetches the last time rewards were accrued for a source of a source type.
+func (k Keeper) GetSourceRewardAccrualTime(ctx sdk.Context, sourceType, sourceID string) (blockTime time.Time, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSourceRewardAccrualTimeKeyPrefix)
+	bz := store.Get(types.SourceRewardKey(sourceType, sourceID))
 	if bz == nil {
-		return types.SavingsClaim{}, false
+		return time.Time{}, false
 	}
-	var c types.SavingsClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
-}
-
-// SetSavingsClaim sets the claim in the store corresponding to the input address.
-func (k Keeper) SetSavingsClaim(ctx sdk.Context, c types.SavingsClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	if err := blockTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	return blockTime, true
 }
 
-// DeleteSavingsClaim deletes the claim in the store corresponding to the input address.
-func (k Keeper) DeleteSavingsClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsClaimKeyPrefix)
-	store.Delete(owner)
+// SetSourceRewardAccrualTime stores the last time rewards were accrued for a source of a source type.
+func (k Keeper) SetSourceRewardAccrualTime(ctx sdk.Context, sourceType, sourceID string, blockTime time.Time) {
+	if sourceID == "" {
+		// sources are identified by their ID, so it must be set
+		panic("source id cannot be empty")
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSourceRewardAccrualTimeKeyPrefix)
+	bz, err := blockTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.SourceRewardKey(sourceType, sourceID), bz)
 }
 
-// IterateSavingsClaims iterates over all savings claim objects in the store and preforms a callback function
-func (k Keeper) IterateSavingsClaims(ctx sdk.Context, cb func(c types.SavingsClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsClaimKeyPrefix)
+// IterateSourceRewardAccrualTimes iterates over the last accrual times of all sources of a source type
+func (k Keeper) IterateSourceRewardAccrualTimes(ctx sdk.Context, sourceType string, cb func(sourceID string, accrualTime time.Time) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), append(types.PreviousSourceRewardAccrualTimeKeyPrefix, types.SourceTypeKey(sourceType)...))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var c types.SavingsClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
+		var accrualTime time.Time
+		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(string(iterator.Key()), accrualTime) {
 			break
 		}
 	}
 }
 
-// GetAllSavingsClaims returns all savings claim objects in the store
-func (k Keeper) GetAllSavingsClaims(ctx sdk.Context) types.SavingsClaims {
-	cs := types.SavingsClaims{}
-	k.IterateSavingsClaims(ctx, func(c types.SavingsClaim) (stop bool) {
-		cs = append(cs, c)
-		return false
-	})
-	return cs
+// GetSourceTypesWithRewardState returns all source types with global reward state in the store, in key order.
+func (k Keeper) GetSourceTypesWithRewardState(ctx sdk.Context) []string {
+	seen := make(map[string]bool)
+	var sourceTypes []string
+	for _, keyPrefix := range [][]byte{types.SourceRewardIndexesKeyPrefix, types.PreviousSourceRewardAccrualTimeKeyPrefix} {
+		store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()
+			sourceType := string(key[1 : 1+int(key[0])])
+			if !seen[sourceType] {
+				seen[sourceType] = true
+				sourceTypes = append(sourceTypes, sourceType)
+			}
+		}
+		iterator.Close()
+	}
+	sort.Strings(sourceTypes)
+	return sourceTypes
 }
 
-// GetEarnClaim returns the claim in the store corresponding the input address.
-func (k Keeper) GetEarnClaim(ctx sdk.Context, addr sdk.AccAddress) (types.EarnClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
-		return types.EarnClaim{}, false
+// GetUSDXMintingRewardFactor returns the current reward factor for an individual collateral type
+func (k Keeper) GetUSDXMintingRewardFactor(ctx sdk.Context, ctype string) (factor sdk.Dec, found bool) {
+	indexes, found := k.GetSourceRewardIndexes(ctx, types.USDXMintingRewardSourceType, ctype)
+	if !found {
+		return sdk.ZeroDec(), false
 	}
-	var c types.EarnClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
+	return indexes.Get(types.USDXMintingRewardDenom)
 }
 
-// SetEarnClaim sets the claim in the store corresponding to the input address.
-func (k Keeper) SetEarnClaim(ctx sdk.Context, c types.EarnClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+// SetUSDXMintingRewardFactor sets the current reward factor for an individual collateral type
+func (k Keeper) SetUSDXMintingRewardFactor(ctx sdk.Context, ctype string, factor sdk.Dec) {
+	k.SetSourceRewardIndexes(
+		ctx,
+		types.USDXMintingRewardSourceType,
+		ctype,
+		types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, factor)},
+	)
 }
 
-// DeleteEarnClaim deletes the claim in the store corresponding to the input address.
-func (k Keeper) DeleteEarnClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnClaimKeyPrefix)
-	store.Delete(owner)
+// IterateUSDXMintingRewardFactors iterates over all USDX Minting reward factor objects in the store and preforms a callback function
+func (k Keeper) IterateUSDXMintingRewardFactors(ctx sdk.Context, cb func(ctype string, factor sdk.Dec) (stop bool)) {
+	k.IterateSourceRewardIndexes(ctx, types.USDXMintingRewardSourceType, func(ctype string, indexes types.RewardIndexes) bool {
+		factor, found := indexes.Get(types.USDXMintingRewardDenom)
+		if !found {
+			factor = sdk.ZeroDec()
+		}
+		return cb(ctype, factor)
+	})
 }
 
-// IterateEarnClaims iterates over all claim  objects in the store and preforms a callback function
-func (k Keeper) IterateEarnClaims(ctx sdk.Context, cb func(c types.EarnClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var c types.EarnClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
-			break
-		}
-	}
+// GetPreviousUSDXMintingAccrualTime returns the last time a collateral type accrued USDX minting rewards
+func (k Keeper) GetPreviousUSDXMintingAccrualTime(ctx sdk.Context, ctype string) (blockTime time.Time, found bool) {
+	return k.GetSourceRewardAccrualTime(ctx, types.USDXMintingRewardSourceType, ctype)
 }
 
-// GetAllEarnClaims returns all Claim objects in the store
-func (k Keeper) GetAllEarnClaims(ctx sdk.Context) types.EarnClaims {
-	cs := types.EarnClaims{}
-	k.IterateEarnClaims(ctx, func(c types.EarnClaim) (stop bool) {
-		cs = append(cs, c)
-		return false
-	})
-	return cs
+// SetPreviousUSDXMintingAccrualTime sets the last time a collateral type accrued USDX minting rewards
+func (k Keeper) SetPreviousUSDXMintingAccrualTime(ctx sdk.Context, ctype string, blockTime time.Time) {
+	k.SetSourceRewardAccrualTime(ctx, types.USDXMintingRewardSourceType, ctype, blockTime)
+}
+
+// IterateUSDXMintingAccrualTimes iterates over all previous USDX minting accrual times and preforms a callback function
+func (k Keeper) IterateUSDXMintingAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
+	k.IterateSourceRewardAccrualTimes(ctx, types.USDXMintingRewardSourceType, cb)
 }
 
 // SetHardSupplyRewardIndexes sets the current reward indexes for an individual denom
 func (k Keeper) SetHardSupplyRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardSupplyRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardIndexes(ctx, types.HardSupplyRewardSourceType, denom, indexes)
 }
 
 // GetHardSupplyRewardIndexes gets the current reward indexes for an individual denom
 func (k Keeper) GetHardSupplyRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardSupplyRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.HardSupplyRewardSourceType, denom)
 }
 
 // IterateHardSupplyRewardIndexes iterates over all Hard supply reward index objects in the store and preforms a callback function
 func (k Keeper) IterateHardSupplyRewardIndexes(ctx sdk.Context, cb func(denom string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardSupplyRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.HardSupplyRewardSourceType, cb)
 }
 
 func (k Keeper) IterateHardSupplyRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardSupplyRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		denom := string(iterator.Key())
-		if cb(denom, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.HardSupplyRewardSourceType, cb)
+}
+
+// GetPreviousHardSupplyRewardAccrualTime returns the last time a denom accrued Hard protocol supply-side rewards
+func (k Keeper) GetPreviousHardSupplyRewardAccrualTime(ctx sdk.Context, denom string) (blockTime time.Time, found bool) {
+	return k.GetSourceRewardAccrualTime(ctx, types.HardSupplyRewardSourceType, denom)
+}
+
+// SetPreviousHardSupplyRewardAccrualTime sets the last time a denom accrued Hard protocol supply-side rewards
+func (k Keeper) SetPreviousHardSupplyRewardAccrualTime(ctx sdk.Context, denom string, blockTime time.Time) {
+	k.SetSourceRewardAccrualTime(ctx, types.HardSupplyRewardSourceType, denom, blockTime)
 }
 
 // SetHardBorrowRewardIndexes sets the current reward indexes for an individual denom
 func (k Keeper) SetHardBorrowRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardBorrowRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardIndexes(ctx, types.HardBorrowRewardSourceType, denom, indexes)
 }
 
 // GetHardBorrowRewardIndexes gets the current reward indexes for an individual denom
 func (k Keeper) GetHardBorrowRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardBorrowRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.HardBorrowRewardSourceType, denom)
 }
 
 // IterateHardBorrowRewardIndexes iterates over all Hard borrow reward index objects in the store and preforms a callback function
 func (k Keeper) IterateHardBorrowRewardIndexes(ctx sdk.Context, cb func(denom string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardBorrowRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.HardBorrowRewardSourceType, cb)
 }
 
 func (k Keeper) IterateHardBorrowRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardBorrowRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		denom := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(denom, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.HardBorrowRewardSourceType, cb)
+}
+
+// GetPreviousHardBorrowRewardAccrualTime returns the last time a denom accrued Hard protocol borrow-side rewards
+func (k Keeper) GetPreviousHardBorrowRewardAccrualTime(ctx sdk.Context, denom string) (blockTime time.Time, found bool) {
+	return k.GetSourceRewardAccrualTime(ctx, types.HardBorrowRewardSourceType, denom)
+}
+
+// SetPreviousHardBorrowRewardAccrualTime sets the last time a denom accrued Hard protocol borrow-side rewards
+func (k Keeper) SetPreviousHardBorrowRewardAccrualTime(ctx sdk.Context, denom string, blockTime time.Time) {
+	k.SetSourceRewardAccrualTime(ctx, types.HardBorrowRewardSourceType, denom, blockTime)
 }
 
 // GetDelegatorRewardIndexes gets the current reward indexes for an individual denom
 func (k Keeper) GetDelegatorRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.DelegatorRewardSourceType, denom)
 }
 
 // SetDelegatorRewardIndexes sets the current reward indexes for an individual denom
 func (k Keeper) SetDelegatorRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardIndexes(ctx, types.DelegatorRewardSourceType, denom, indexes)
 }
 
 // IterateDelegatorRewardIndexes iterates over all delegator reward index objects in the store and preforms a callback function
 func (k Keeper) IterateDelegatorRewardIndexes(ctx sdk.Context, cb func(denom string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.DelegatorRewardSourceType, cb)
 }
 
 func (k Keeper) IterateDelegatorRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousDelegatorRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		denom := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(denom, accrualTime) {
-			break
-		}
-	}
-}
-
-// GetPreviousHardSupplyRewardAccrualTime returns the last time a denom accrued Hard protocol supply-side rewards
-func (k Keeper) GetPreviousHardSupplyRewardAccrualTime(ctx sdk.Context, denom string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardSupplyRewardAccrualTimeKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(bz); err != nil {
-		panic(err)
-	}
-	return blockTime, true
-}
-
-// SetPreviousHardSupplyRewardAccrualTime sets the last time a denom accrued Hard protocol supply-side rewards
-func (k Keeper) SetPreviousHardSupplyRewardAccrualTime(ctx sdk.Context, denom string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardSupplyRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(denom), bz)
-}
-
-// GetPreviousHardBorrowRewardAccrualTime returns the last time a denom accrued Hard protocol borrow-side rewards
-func (k Keeper) GetPreviousHardBorrowRewardAccrualTime(ctx sdk.Context, denom string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardBorrowRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(denom))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
-}
-
-// SetPreviousHardBorrowRewardAccrualTime sets the last time a denom accrued Hard protocol borrow-side rewards
-func (k Keeper) SetPreviousHardBorrowRewardAccrualTime(ctx sdk.Context, denom string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardBorrowRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(denom), bz)
+	k.IterateSourceRewardAccrualTimes(ctx, types.DelegatorRewardSourceType, cb)
 }
 
 // GetPreviousDelegatorRewardAccrualTime returns the last time a denom accrued protocol delegator rewards
 func (k Keeper) GetPreviousDelegatorRewardAccrualTime(ctx sdk.Context, denom string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousDelegatorRewardAccrualTimeKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(bz); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.DelegatorRewardSourceType, denom)
 }
 
 // SetPreviousDelegatorRewardAccrualTime sets the last time a denom accrued protocol delegator rewards
 func (k Keeper) SetPreviousDelegatorRewardAccrualTime(ctx sdk.Context, denom string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousDelegatorRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.DelegatorRewardSourceType, denom, blockTime)
 }
 
 // SetSwapRewardIndexes stores the global reward indexes that track total rewards to a swap pool.
 func (k Keeper) SetSwapRewardIndexes(ctx sdk.Context, poolID string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(poolID), bz)
+	k.SetSourceRewardIndexes(ctx, types.SwapRewardSourceType, poolID, indexes)
 }
 
 // GetSwapRewardIndexes fetches the global reward indexes that track total rewards to a swap pool.
 func (k Keeper) GetSwapRewardIndexes(ctx sdk.Context, poolID string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(poolID))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.SwapRewardSourceType, poolID)
 }
 
 // IterateSwapRewardIndexes iterates over all swap reward index objects in the store and preforms a callback function
 func (k Keeper) IterateSwapRewardIndexes(ctx sdk.Context, cb func(poolID string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.SwapRewardSourceType, cb)
 }
 
 // GetSwapRewardAccrualTime fetches the last time rewards were accrued for a swap pool.
 func (k Keeper) GetSwapRewardAccrualTime(ctx sdk.Context, poolID string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSwapRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(poolID))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.SwapRewardSourceType, poolID)
 }
 
 // SetSwapRewardAccrualTime stores the last time rewards were accrued for a swap pool.
 func (k Keeper) SetSwapRewardAccrualTime(ctx sdk.Context, poolID string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSwapRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(poolID), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.SwapRewardSourceType, poolID, blockTime)
 }
 
 func (k Keeper) IterateSwapRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSwapRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		poolID := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(poolID, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.SwapRewardSourceType, cb)
 }
 
 // SetSavingsRewardIndexes stores the global reward indexes that rewards for an individual denom type
 func (k Keeper) SetSavingsRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardIndexes(ctx, types.SavingsRewardSourceType, denom, indexes)
 }
 
-// GetSavingsRewardIndexes fetches the global reward indexes that track rewards for an individual denom type
+// GetSavingsRewardIndexes fetches the global reward indexes that track total rewards to a given denom.
 func (k Keeper) GetSavingsRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.SavingsRewardSourceType, denom)
 }
 
 // IterateSavingsRewardIndexes iterates over all savings reward index objects in the store and preforms a callback function
 func (k Keeper) IterateSavingsRewardIndexes(ctx sdk.Context, cb func(poolID string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.SavingsRewardSourceType, cb)
 }
 
 // GetSavingsRewardAccrualTime fetches the last time rewards were accrued for an individual denom type
 func (k Keeper) GetSavingsRewardAccrualTime(ctx sdk.Context, poolID string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSavingsRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(poolID))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.SavingsRewardSourceType, poolID)
 }
 
 // SetSavingsRewardAccrualTime stores the last time rewards were accrued for a savings deposit denom type
 func (k Keeper) SetSavingsRewardAccrualTime(ctx sdk.Context, poolID string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSavingsRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(poolID), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.SavingsRewardSourceType, poolID, blockTime)
 }
 
-// IterateSavingsRewardAccrualTimesiterates over all the previous savings reward accrual times in the store
+// IterateSavingsRewardAccrualTimes iterates over all the previous savings reward accrual times in the store
 func (k Keeper) IterateSavingsRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSavingsRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		poolID := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(poolID, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.SavingsRewardSourceType, cb)
 }
 
 // SetEarnRewardIndexes stores the global reward indexes that track total rewards to a earn vault.
 func (k Keeper) SetEarnRewardIndexes(ctx sdk.Context, vaultDenom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(vaultDenom), bz)
+	k.SetSourceRewardIndexes(ctx, types.EarnRewardSourceType, vaultDenom, indexes)
 }
 
 // GetEarnRewardIndexes fetches the global reward indexes that track total rewards to a earn vault.
 func (k Keeper) GetEarnRewardIndexes(ctx sdk.Context, vaultDenom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(vaultDenom))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.EarnRewardSourceType, vaultDenom)
 }
 
 // IterateEarnRewardIndexes iterates over all earn reward index objects in the store and preforms a callback function
 func (k Keeper) IterateEarnRewardIndexes(ctx sdk.Context, cb func(vaultDenom string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.EarnRewardSourceType, cb)
 }
 
 // GetEarnRewardAccrualTime fetches the last time rewards were accrued for an earn vault.
 func (k Keeper) GetEarnRewardAccrualTime(ctx sdk.Context, vaultDenom string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousEarnRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(vaultDenom))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.EarnRewardSourceType, vaultDenom)
 }
 
 // SetEarnRewardAccrualTime stores the last time rewards were accrued for a earn vault.
 func (k Keeper) SetEarnRewardAccrualTime(ctx sdk.Context, vaultDenom string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousEarnRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(vaultDenom), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.EarnRewardSourceType, vaultDenom, blockTime)
 }
 
+// IterateEarnRewardAccrualTimes iterates over all the previous earn reward accrual times in the store
 func (k Keeper) IterateEarnRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousEarnRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		poolID := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(poolID, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.EarnRewardSourceType, cb)
 }
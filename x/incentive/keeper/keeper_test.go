@@ -45,81 +45,51 @@ func (suite *KeeperTestSuite) SetupApp() {
 	suite.ctx = suite.app.NewContext(true, tmprototypes.Header{Time: suite.genesisTime})
 }
 
-func (suite *KeeperTestSuite) TestGetSetDeleteUSDXMintingClaim() {
+func (suite *KeeperTestSuite) TestGetSetDeleteSourceClaim() {
 	suite.SetupApp()
-	c := types.NewUSDXMintingClaim(suite.addrs[0], c("ukava", 1000000), types.RewardIndexes{types.NewRewardIndex("bnb-a", sdk.ZeroDec())})
-	_, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
-	suite.Require().False(found)
-	suite.Require().NotPanics(func() {
-		suite.keeper.SetUSDXMintingClaim(suite.ctx, c)
-	})
-	testC, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
-	suite.Require().True(found)
-	suite.Require().Equal(c, testC)
-	suite.Require().NotPanics(func() {
-		suite.keeper.DeleteUSDXMintingClaim(suite.ctx, suite.addrs[0])
-	})
-	_, found = suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
-	suite.Require().False(found)
-}
+	c := types.NewSourceClaim(types.SwapRewardSourceType, "btcb/usdx", suite.addrs[0], arbitraryCoins(), nonEmptyRewardIndexes)
 
-func (suite *KeeperTestSuite) TestIterateUSDXMintingClaims() {
-	suite.SetupApp()
-	for i := 0; i < len(suite.addrs); i++ {
-		c := types.NewUSDXMintingClaim(suite.addrs[i], c("ukava", 100000), types.RewardIndexes{types.NewRewardIndex("bnb-a", sdk.ZeroDec())})
-		suite.Require().NotPanics(func() {
-			suite.keeper.SetUSDXMintingClaim(suite.ctx, c)
-		})
-	}
-	claims := types.USDXMintingClaims{}
-	suite.keeper.IterateUSDXMintingClaims(suite.ctx, func(c types.USDXMintingClaim) bool {
-		claims = append(claims, c)
-		return false
-	})
-	suite.Require().Equal(len(suite.addrs), len(claims))
-
-	claims = suite.keeper.GetAllUSDXMintingClaims(suite.ctx)
-	suite.Require().Equal(len(suite.addrs), len(claims))
-}
-
-func (suite *KeeperTestSuite) TestGetSetDeleteSwapClaims() {
-	suite.SetupApp()
-	c := types.NewSwapClaim(suite.addrs[0], arbitraryCoins(), nonEmptyMultiRewardIndexes)
-
-	_, found := suite.keeper.GetSwapClaim(suite.ctx, suite.addrs[0])
+	_, found := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, "btcb/usdx", suite.addrs[0])
 	suite.Require().False(found)
 
 	suite.Require().NotPanics(func() {
-		suite.keeper.SetSwapClaim(suite.ctx, c)
+		suite.keeper.SetSourceClaim(suite.ctx, c)
 	})
-	testC, found := suite.keeper.GetSwapClaim(suite.ctx, suite.addrs[0])
+	testC, found := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, "btcb/usdx", suite.addrs[0])
 	suite.Require().True(found)
 	suite.Require().Equal(c, testC)
 
+	// claims in other sources are separate
+	_, found = suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, "btcb/usdx", suite.addrs[0])
+	suite.Require().False(found)
+
 	suite.Require().NotPanics(func() {
-		suite.keeper.DeleteSwapClaim(suite.ctx, suite.addrs[0])
+		suite.keeper.DeleteSourceClaim(suite.ctx, types.SwapRewardSourceType, "btcb/usdx", suite.addrs[0])
 	})
-	_, found = suite.keeper.GetSwapClaim(suite.ctx, suite.addrs[0])
+	_, found = suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, "btcb/usdx", suite.addrs[0])
 	suite.Require().False(found)
 }
 
-func (suite *KeeperTestSuite) TestIterateSwapClaims() {
+func (suite *KeeperTestSuite) TestIterateSourceClaims() {
 	suite.SetupApp()
-	claims := types.SwapClaims{
-		types.NewSwapClaim(suite.addrs[0], arbitraryCoins(), nonEmptyMultiRewardIndexes),
-		types.NewSwapClaim(suite.addrs[1], nil, nil), // different claim to the first
+	claims := types.SourceClaims{
+		types.NewSourceClaim(types.SwapRewardSourceType, "btcb/usdx", suite.addrs[0], arbitraryCoins(), nonEmptyRewardIndexes),
+		types.NewSourceClaim(types.SwapRewardSourceType, "ukava/usdx", suite.addrs[0], nil, nil),
+		types.NewSourceClaim(types.SwapRewardSourceType, "btcb/usdx", suite.addrs[1], nil, nil),
+		types.NewSourceClaim(types.USDXMintingRewardSourceType, "bnb-a", suite.addrs[0], nil, nil),
 	}
 	for _, claim := range claims {
-		suite.keeper.SetSwapClaim(suite.ctx, claim)
+		suite.keeper.SetSourceClaim(suite.ctx, claim)
 	}
 
-	var actualClaims types.SwapClaims
-	suite.keeper.IterateSwapClaims(suite.ctx, func(c types.SwapClaim) bool {
+	var actualClaims types.SourceClaims
+	suite.keeper.IterateSourceClaimsByOwner(suite.ctx, types.SwapRewardSourceType, suite.addrs[0], func(c types.SourceClaim) bool {
 		actualClaims = append(actualClaims, c)
 		return false
 	})
+	suite.Require().Equal(claims[:2], actualClaims)
 
-	suite.Require().Equal(claims, actualClaims)
+	suite.Require().ElementsMatch(claims, suite.keeper.GetAllSourceClaims(suite.ctx))
 }
 
 func (suite *KeeperTestSuite) TestGetSetSwapRewardIndexes() {
@@ -291,46 +261,6 @@ func (suite *KeeperTestSuite) TestGetSetSwapRewardAccrualTimes() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestGetSetDeleteEarnClaims() {
-	suite.SetupApp()
-	c := types.NewEarnClaim(suite.addrs[0], arbitraryCoins(), nonEmptyMultiRewardIndexes)
-
-	_, found := suite.keeper.GetEarnClaim(suite.ctx, suite.addrs[0])
-	suite.Require().False(found)
-
-	suite.Require().NotPanics(func() {
-		suite.keeper.SetEarnClaim(suite.ctx, c)
-	})
-	testC, found := suite.keeper.GetEarnClaim(suite.ctx, suite.addrs[0])
-	suite.Require().True(found)
-	suite.Require().Equal(c, testC)
-
-	suite.Require().NotPanics(func() {
-		suite.keeper.DeleteEarnClaim(suite.ctx, suite.addrs[0])
-	})
-	_, found = suite.keeper.GetEarnClaim(suite.ctx, suite.addrs[0])
-	suite.Require().False(found)
-}
-
-func (suite *KeeperTestSuite) TestIterateEarnClaims() {
-	suite.SetupApp()
-	claims := types.EarnClaims{
-		types.NewEarnClaim(suite.addrs[0], arbitraryCoins(), nonEmptyMultiRewardIndexes),
-		types.NewEarnClaim(suite.addrs[1], nil, nil), // different claim to the first
-	}
-	for _, claim := range claims {
-		suite.keeper.SetEarnClaim(suite.ctx, claim)
-	}
-
-	var actualClaims types.EarnClaims
-	suite.keeper.IterateEarnClaims(suite.ctx, func(c types.EarnClaim) bool {
-		actualClaims = append(actualClaims, c)
-		return false
-	})
-
-	suite.Require().Equal(claims, actualClaims)
-}
-
 func (suite *KeeperTestSuite) TestGetSetEarnRewardIndexes() {
 	testCases := []struct {
 		name       string
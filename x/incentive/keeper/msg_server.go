@@ -115,3 +115,115 @@ func (k msgServer) ClaimEarnReward(goCtx context.Context, msg *types.MsgClaimEar
 
 	return &types.MsgClaimEarnRewardResponse{}, nil
 }
+
+func (k msgServer) ClaimAllRewards(goCtx context.Context, msg *types.MsgClaimAllRewards) (*types.MsgClaimAllRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := k.keeper.ClaimAllRewards(ctx, sender, receiver, msg.DenomsToClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAllRewardsResponse{Claimed: claimed}, nil
+}
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.SetAutoCompound(ctx, sender, msg.ClaimType, msg.DenomsToClaim, msg.Target, msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) RemoveAutoCompound(goCtx context.Context, msg *types.MsgRemoveAutoCompound) (*types.MsgRemoveAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.RemoveAutoCompound(ctx, sender, msg.ClaimType); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.MsgLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.Lock(ctx, sender, msg.Amount, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockResponse{}, nil
+}
+
+func (k msgServer) ExtendLock(goCtx context.Context, msg *types.MsgExtendLock) (*types.MsgExtendLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.ExtendLock(ctx, sender, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExtendLockResponse{}, nil
+}
+
+func (k msgServer) WithdrawLock(goCtx context.Context, msg *types.MsgWithdrawLock) (*types.MsgWithdrawLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.WithdrawLock(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawLockResponse{}, nil
+}
+
+func (k msgServer) CreateIncentiveCampaign(goCtx context.Context, msg *types.MsgCreateIncentiveCampaign) (*types.MsgCreateIncentiveCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.keeper.CreateIncentiveCampaign(ctx, sender, msg.SourceType, msg.SourceID, msg.Amount, msg.Start, msg.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateIncentiveCampaignResponse{CampaignID: id}, nil
+}
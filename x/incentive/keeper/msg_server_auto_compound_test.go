@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/testutil"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// autoCompoundIncentiveBuilder returns an incentive genesis builder with a ukava swap reward and a multiplier without a
+// lockup.
+func (suite *HandlerTestSuite) autoCompoundIncentiveBuilder() testutil.IncentiveGenesisBuilder {
+	return testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithMultipliers(types.MultipliersPerDenoms{
+			{
+				Denom: "ukava",
+				Multipliers: types.Multipliers{
+					types.NewMultiplier("none", 0, d("0.5")),
+					types.NewMultiplier("large", 12, d("1.0")),
+				},
+			},
+		}).
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("ukava", 1e6)))
+}
+
+func (suite *HandlerTestSuite) setupAutoCompound() sdk.AccAddress {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12)))
+
+	suite.SetupWithGenState(authBulder, suite.autoCompoundIncentiveBuilder())
+
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")),
+	)
+
+	return userAddr
+}
+
+func (suite *HandlerTestSuite) TestAutoCompoundIntoHard() {
+	userAddr := suite.setupAutoCompound()
+
+	msg := types.NewMsgSetAutoCompound(
+		userAddr.String(),
+		types.SwapClaimType,
+		types.Selections{types.NewSelection("ukava", "none")},
+		types.AUTO_COMPOUND_TARGET_HARD,
+		"",
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	// Rewards are accumulated then compounded in the next block
+	suite.NextBlockAfter(7 * time.Second)
+
+	expectedCompounded := c("ukava", int64(0.5*float64(7*1e6)))
+	deposit, found := suite.App.GetHardKeeper().GetDeposit(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.Equal(cs(expectedCompounded), deposit.Amount)
+	suite.SwapRewardEquals(userAddr, nil)
+
+	setting, found := suite.App.GetIncentiveKeeper().GetAutoCompoundSetting(suite.Ctx, userAddr, types.SwapClaimType)
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockTime(), setting.LastCompoundTime)
+
+	// Rewards are not compounded again until the interval has passed
+	suite.NextBlockAfter(time.Hour)
+	suite.syncedSwapRewardEquals(userAddr, cs(c("ukava", 3600*1e6)))
+
+	suite.NextBlockAfter(types.AutoCompoundInterval)
+	suite.SwapRewardEquals(userAddr, nil)
+
+	// Removing the setting stops compounding
+	removeMsg := types.NewMsgRemoveAutoCompound(userAddr.String(), types.SwapClaimType)
+	err = suite.DeliverIncentiveMsg(&removeMsg)
+	suite.Require().NoError(err)
+
+	suite.NextBlockAfter(2 * types.AutoCompoundInterval)
+	suite.syncedSwapRewardEquals(userAddr, cs(c("ukava", 2*int64(types.AutoCompoundInterval.Seconds())*1e6)))
+
+	err = suite.DeliverIncentiveMsg(&removeMsg)
+	suite.ErrorIs(err, types.ErrAutoCompoundSettingNotFound)
+}
+
+func (suite *HandlerTestSuite) TestAutoCompoundFailureKeepsRewards() {
+	userAddr := suite.setupAutoCompound()
+
+	// There is no earn vault for ukava, so compounding fails
+	msg := types.NewMsgSetAutoCompound(
+		userAddr.String(),
+		types.SwapClaimType,
+		types.Selections{types.NewSelection("ukava", "none")},
+		types.AUTO_COMPOUND_TARGET_EARN,
+		"",
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	preBalance := suite.GetBalance(userAddr)
+
+	suite.NextBlockAfter(7 * time.Second)
+
+	suite.syncedSwapRewardEquals(userAddr, cs(c("ukava", 7*1e6)))
+	suite.BalanceEquals(userAddr, preBalance)
+
+	setting, found := suite.App.GetIncentiveKeeper().GetAutoCompoundSetting(suite.Ctx, userAddr, types.SwapClaimType)
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockTime(), setting.LastCompoundTime, "failed compounds should not be retried every block")
+}
+
+func (suite *HandlerTestSuite) TestSetAutoCompoundRequiresMultiplierWithoutLockup() {
+	userAddr := suite.setupAutoCompound()
+
+	msg := types.NewMsgSetAutoCompound(
+		userAddr.String(),
+		types.SwapClaimType,
+		types.Selections{types.NewSelection("ukava", "large")},
+		types.AUTO_COMPOUND_TARGET_HARD,
+		"",
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidMultiplier)
+
+	msg = types.NewMsgSetAutoCompound(
+		userAddr.String(),
+		types.SwapClaimType,
+		types.Selections{types.NewSelection("ukava", "none")},
+		types.AUTO_COMPOUND_TARGET_EARN,
+		sdk.ValAddress(userAddr).String(),
+	)
+	err = suite.DeliverIncentiveMsg(&msg)
+	suite.Error(err, "validator must exist")
+
+	_, found := suite.App.GetIncentiveKeeper().GetAutoCompoundSetting(suite.Ctx, userAddr, types.SwapClaimType)
+	suite.False(found)
+}
+
+// syncedSwapRewardEquals checks the swap reward including rewards accumulated since the claim was last synced.
+func (suite *HandlerTestSuite) syncedSwapRewardEquals(owner sdk.AccAddress, expected sdk.Coins) {
+	claims := suite.App.GetIncentiveKeeper().GetSynchronizedSourceClaims(suite.Ctx, types.SwapRewardSourceType, owner)
+	suite.Require().NotEmpty(claims)
+
+	var reward sdk.Coins
+	for _, claim := range claims {
+		reward = reward.Add(claim.Reward...)
+	}
+	suite.Equal(expected, reward)
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// setupBoostedVaultSource sets up an app with hard rewards for a boosted vault source, where the user owns a quarter of
+// the shares and has ukava to lock.
+func (suite *HandlerTestSuite) setupBoostedVaultSource(userAddr sdk.AccAddress) *fakeRewardSource {
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e9), c("hard", 1e9)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSourceRewardPeriod(vaultSourceType, "pool-1", cs(c("hard", 1e6))).
+		WithBoostParams(types.NewBoostParams(4*365*24*time.Hour, d("2.5"), []string{vaultSourceType}))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	source := newFakeRewardSource()
+	source.setShares("pool-1", userAddr, d("1"))
+	source.setShares("pool-1", suite.addrs[2], d("3"))
+	suite.App.GetIncentiveKeeper().RegisterRewardSource(vaultSourceType, source)
+
+	return source
+}
+
+func (suite *HandlerTestSuite) TestLockBoostsSourceShares() {
+	userAddr, otherAddr := suite.addrs[0], suite.addrs[2]
+	source := suite.setupBoostedVaultSource(userAddr)
+	ik := suite.App.GetIncentiveKeeper()
+
+	msg := types.NewMsgLock(userAddr.String(), c("ukava", 1000), 4*365*24*time.Hour)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.BalanceEquals(userAddr, cs(c("ukava", 1e9-1000), c("hard", 1e9)))
+
+	lock, found := ik.GetBoostLock(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.Equal(d("1000"), lock.Weight)
+	suite.Equal(d("1000"), ik.GetTotalBoostWeight(suite.Ctx))
+
+	// The user holds all the boost weight, so their shares get the full 2.5x boost
+	suite.Equal(d("1.5"), ik.GetBoostedShares(suite.Ctx, vaultSourceType, "pool-1", userAddr))
+	suite.Equal(d("1.5"), ik.GetTotalBoostedShares(suite.Ctx, vaultSourceType, "pool-1"))
+
+	// Rewards are shared over the 5.5 boosted shares
+	suite.NextBlockAfter(11 * time.Second)
+
+	userClaims := ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, userAddr)
+	suite.Require().Len(userClaims, 1)
+	suite.Equal(cs(c("hard", 5e6)), userClaims[0].Reward)
+	otherClaims := ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, otherAddr)
+	suite.Require().Len(otherClaims, 1)
+	suite.Equal(cs(c("hard", 6e6)), otherClaims[0].Reward)
+
+	// The boost is recomputed from the user's shares when their claim is next synchronized
+	ik.SynchronizeSourceReward(suite.Ctx, vaultSourceType, "pool-1", userAddr)
+	source.setShares("pool-1", userAddr, d("3"))
+	ik.SynchronizeSourceReward(suite.Ctx, vaultSourceType, "pool-1", userAddr)
+
+	suite.Equal(d("4.5"), ik.GetBoostedShares(suite.Ctx, vaultSourceType, "pool-1", userAddr))
+	suite.Equal(d("4.5"), ik.GetTotalBoostedShares(suite.Ctx, vaultSourceType, "pool-1"))
+
+	queryServer := keeper.NewQueryServerImpl(ik)
+	queryRes, err := queryServer.BoostLock(sdk.WrapSDKContext(suite.Ctx), &types.QueryBoostLockRequest{Owner: userAddr.String()})
+	suite.Require().NoError(err)
+	suite.Equal(&lock, queryRes.Lock)
+	suite.Equal(types.BoostedSharesList{types.NewBoostedShares(vaultSourceType, "pool-1", userAddr, d("4.5"))}, queryRes.BoostedShares)
+	suite.Equal(d("1000"), queryRes.TotalWeight)
+}
+
+func (suite *HandlerTestSuite) TestBoostIsNotRewardedOnWithdrawnShares() {
+	userAddr, otherAddr := suite.addrs[0], suite.addrs[2]
+	source := suite.setupBoostedVaultSource(userAddr)
+	ik := suite.App.GetIncentiveKeeper()
+
+	msg := types.NewMsgLock(userAddr.String(), c("ukava", 1000), 4*365*24*time.Hour)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.Equal(d("1.5"), ik.GetBoostedShares(suite.Ctx, vaultSourceType, "pool-1", userAddr))
+
+	// The user withdraws all their shares, and the source only synchronizes their claim before the change
+	ik.SynchronizeSourceReward(suite.Ctx, vaultSourceType, "pool-1", userAddr)
+	source.setShares("pool-1", userAddr, d("0"))
+
+	// The stored boost is still counted in the source's total shares, but the user earns nothing on it
+	suite.NextBlockAfter(9 * time.Second)
+
+	userClaims := ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, userAddr)
+	suite.Require().Len(userClaims, 1)
+	suite.True(userClaims[0].Reward.IsZero())
+	otherClaims := ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, otherAddr)
+	suite.Require().Len(otherClaims, 1)
+	suite.Equal(cs(c("hard", 6e6)), otherClaims[0].Reward)
+
+	// Synchronizing the user's claim removes their boost from the source's total shares
+	ik.SynchronizeSourceReward(suite.Ctx, vaultSourceType, "pool-1", userAddr)
+	suite.True(ik.GetBoostedShares(suite.Ctx, vaultSourceType, "pool-1", userAddr).IsZero())
+	suite.True(ik.GetTotalBoostedShares(suite.Ctx, vaultSourceType, "pool-1").IsZero())
+}
+
+func (suite *HandlerTestSuite) TestBoostLockEndsAndIsWithdrawn() {
+	userAddr := suite.addrs[0]
+	suite.setupBoostedVaultSource(userAddr)
+	ik := suite.App.GetIncentiveKeeper()
+
+	msg := types.NewMsgLock(userAddr.String(), c("ukava", 1000), time.Hour)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.Equal(d("1.5"), ik.GetBoostedShares(suite.Ctx, vaultSourceType, "pool-1", userAddr))
+
+	// Locked coins cannot be withdrawn before the lock ends
+	withdrawMsg := types.NewMsgWithdrawLock(userAddr.String())
+	err := suite.DeliverIncentiveMsg(&withdrawMsg)
+	suite.ErrorIs(err, types.ErrBoostLockNotEnded)
+
+	// The lock ends, removing the boost
+	suite.NextBlockAfter(2 * time.Hour)
+
+	lock, found := ik.GetBoostLock(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.True(lock.Weight.IsZero())
+	suite.True(ik.GetTotalBoostWeight(suite.Ctx).IsZero())
+	suite.True(ik.GetBoostedShares(suite.Ctx, vaultSourceType, "pool-1", userAddr).IsZero())
+	suite.True(ik.GetTotalBoostedShares(suite.Ctx, vaultSourceType, "pool-1").IsZero())
+
+	// Ended locks cannot be extended or added to
+	extendMsg := types.NewMsgExtendLock(userAddr.String(), time.Hour)
+	err = suite.DeliverIncentiveMsg(&extendMsg)
+	suite.ErrorIs(err, types.ErrBoostLockEnded)
+	err = suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrBoostLockEnded)
+
+	// Without the boost the user earns a quarter of the rewards
+	claims := ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, userAddr)
+	suite.Require().Len(claims, 1)
+	rewardsAtEnd := claims[0].Reward
+
+	suite.NextBlockAfter(8 * time.Second)
+
+	claims = ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, userAddr)
+	suite.Require().Len(claims, 1)
+	suite.Equal(rewardsAtEnd.Add(c("hard", 2e6)), claims[0].Reward)
+
+	err = suite.DeliverIncentiveMsg(&withdrawMsg)
+	suite.Require().NoError(err)
+	suite.BalanceEquals(userAddr, cs(c("ukava", 1e9), c("hard", 1e9)))
+
+	_, found = ik.GetBoostLock(suite.Ctx, userAddr)
+	suite.False(found)
+}
+
+func (suite *HandlerTestSuite) TestExtendLockIncreasesWeight() {
+	userAddr := suite.addrs[0]
+	suite.setupBoostedVaultSource(userAddr)
+	ik := suite.App.GetIncentiveKeeper()
+
+	msg := types.NewMsgLock(userAddr.String(), c("ukava", 1000), 365*24*time.Hour)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+
+	lock, found := ik.GetBoostLock(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.Equal(d("250"), lock.Weight)
+
+	// The lock must end later than it does now
+	extendMsg := types.NewMsgExtendLock(userAddr.String(), 24*time.Hour)
+	err := suite.DeliverIncentiveMsg(&extendMsg)
+	suite.ErrorIs(err, types.ErrInvalidLockDuration)
+
+	extendMsg = types.NewMsgExtendLock(userAddr.String(), 4*365*24*time.Hour)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&extendMsg))
+
+	lock, found = ik.GetBoostLock(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.Equal(d("1000"), lock.Weight)
+	suite.Equal(suite.Ctx.BlockTime().Add(4*365*24*time.Hour), lock.End)
+	suite.Equal(d("1000"), ik.GetTotalBoostWeight(suite.Ctx))
+
+	// Adding coins keeps the end time
+	addMsg := types.NewMsgLock(userAddr.String(), c("ukava", 1000), 0)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&addMsg))
+
+	lock, found = ik.GetBoostLock(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.Equal(c("ukava", 2000), lock.Amount)
+	suite.Equal(d("2000"), lock.Weight)
+	suite.Equal(suite.Ctx.BlockTime().Add(4*365*24*time.Hour), lock.End)
+}
+
+func (suite *HandlerTestSuite) TestLockRejectsInvalidLocks() {
+	userAddr := suite.addrs[0]
+	suite.setupBoostedVaultSource(userAddr)
+
+	msg := types.NewMsgLock(userAddr.String(), c("hard", 1000), time.Hour)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidLockDenom)
+
+	msg = types.NewMsgLock(userAddr.String(), c("ukava", 1000), 5*365*24*time.Hour)
+	err = suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidLockDuration)
+
+	msg = types.NewMsgLock(userAddr.String(), c("ukava", 1000), 0)
+	err = suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidLockDuration)
+
+	extendMsg := types.NewMsgExtendLock(userAddr.String(), time.Hour)
+	err = suite.DeliverIncentiveMsg(&extendMsg)
+	suite.ErrorIs(err, types.ErrBoostLockNotFound)
+
+	withdrawMsg := types.NewMsgWithdrawLock(userAddr.String())
+	err = suite.DeliverIncentiveMsg(&withdrawMsg)
+	suite.ErrorIs(err, types.ErrBoostLockNotFound)
+}
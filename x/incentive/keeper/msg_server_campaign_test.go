@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestCampaignStreamsRewardsToSwapPool() {
+	userAddr, creatorAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(creatorAddr, cs(c("hard", 1e9), c("ukava", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+	ik := suite.App.GetIncentiveKeeper()
+	queryServer := keeper.NewQueryServerImpl(ik)
+
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")),
+	)
+
+	start := suite.Ctx.BlockTime()
+	msg := types.NewMsgCreateIncentiveCampaign(
+		creatorAddr.String(), types.SwapRewardSourceType, "busd:ukava", cs(c("hard", 1e8)), start, start.Add(100*time.Second),
+	)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.BalanceEquals(creatorAddr, cs(c("hard", 1e9-1e8), c("ukava", 1e9-1e8)))
+	suite.BalanceEquals(suite.GetModuleAccount(types.CampaignAccountName).GetAddress(), cs(c("hard", 1e8)))
+	// The creation fee is paid to the community pool
+	suite.BalanceEquals(suite.GetModuleAccount(communitytypes.ModuleAccountName).GetAddress(), types.DefaultCampaignCreationFee)
+
+	queryRes, err := queryServer.Campaigns(sdk.WrapSDKContext(suite.Ctx), &types.QueryCampaignsRequest{SourceType: types.SwapRewardSourceType})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.Campaigns, 1)
+	suite.Equal(uint64(1), queryRes.Campaigns[0].ID)
+
+	queryRes, err = queryServer.Campaigns(sdk.WrapSDKContext(suite.Ctx), &types.QueryCampaignsRequest{SourceID: "usdx:ukava"})
+	suite.Require().NoError(err)
+	suite.Empty(queryRes.Campaigns)
+
+	suite.NextBlockAfter(50 * time.Second)
+
+	campaignRes, err := queryServer.Campaign(sdk.WrapSDKContext(suite.Ctx), &types.QueryCampaignRequest{CampaignId: 1})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("hard", sdk.NewInt(5e7))), campaignRes.Campaign.Distributed)
+	suite.BalanceEquals(suite.GetModuleAccount(types.CampaignAccountName).GetAddress(), cs(c("hard", 5e7)))
+
+	// The campaign ends after distributing all its rewards
+	suite.NextBlockAfter(50 * time.Second)
+
+	suite.BalanceEquals(creatorAddr, cs(c("hard", 1e9-1e8), c("ukava", 1e9-1e8)))
+	suite.BalanceEquals(suite.GetModuleAccount(types.CampaignAccountName).GetAddress(), sdk.Coins{})
+
+	_, err = queryServer.Campaign(sdk.WrapSDKContext(suite.Ctx), &types.QueryCampaignRequest{CampaignId: 1})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// The user earned all the distributed rewards
+	preClaimBal := suite.GetBalance(userAddr)
+	claimMsg := types.NewMsgClaimSwapReward(userAddr.String(), types.Selections{types.NewSelection("hard", "large")})
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&claimMsg))
+	suite.BalanceEquals(userAddr, preClaimBal.Add(c("hard", 1e8)))
+}
+
+func (suite *HandlerTestSuite) TestCampaignRewardsRegisteredSource() {
+	userAddr, creatorAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(creatorAddr, cs(c("hard", 1e9), c("ukava", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder().WithSimpleSourceRewardPeriod(vaultSourceType, "pool-1", cs(c("hard", 1e6))))
+	ik := suite.App.GetIncentiveKeeper()
+
+	source := newFakeRewardSource()
+	source.setShares("pool-1", userAddr, d("1"))
+	source.setShares("pool-1", suite.addrs[2], d("1"))
+	ik.RegisterRewardSource(vaultSourceType, source)
+
+	start := suite.Ctx.BlockTime()
+	msg := types.NewMsgCreateIncentiveCampaign(
+		creatorAddr.String(), vaultSourceType, "pool-1", cs(c("hard", 1e8)), start, start.Add(100*time.Second),
+	)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// The campaign adds to the rewards of the governance reward period
+	suite.NextBlockAfter(10 * time.Second)
+
+	claims := ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, userAddr)
+	suite.Require().Len(claims, 1)
+	suite.Equal(cs(c("hard", 5e6+5e6)), claims[0].Reward)
+
+	// Rewards for times the source has no shares are not distributed, and are refunded when the campaign ends
+	for _, owner := range []sdk.AccAddress{userAddr, suite.addrs[2]} {
+		ik.SynchronizeSourceReward(suite.Ctx, vaultSourceType, "pool-1", owner)
+		source.setShares("pool-1", owner, d("0"))
+	}
+	suite.NextBlockAfter(90 * time.Second)
+
+	suite.BalanceEquals(creatorAddr, cs(c("hard", 1e9-1e7), c("ukava", 1e9-1e8)))
+	suite.BalanceEquals(suite.GetModuleAccount(types.CampaignAccountName).GetAddress(), sdk.Coins{})
+	suite.Empty(ik.GetAllIncentiveCampaigns(suite.Ctx))
+}
+
+func (suite *HandlerTestSuite) TestCampaignIsNotAccruedBeforeStart() {
+	userAddr, creatorAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(creatorAddr, cs(c("hard", 1e9), c("ukava", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+	ik := suite.App.GetIncentiveKeeper()
+
+	source := newFakeRewardSource()
+	source.setShares("pool-1", userAddr, d("1"))
+	ik.RegisterRewardSource(vaultSourceType, source)
+
+	created := suite.Ctx.BlockTime()
+	start := created.Add(time.Hour)
+	msg := types.NewMsgCreateIncentiveCampaign(
+		creatorAddr.String(), vaultSourceType, "pool-1", cs(c("hard", 1e8)), start, start.Add(100*time.Second),
+	)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// Campaigns that have not started are left untouched
+	suite.NextBlockAfter(10 * time.Second)
+
+	campaign, found := ik.GetIncentiveCampaign(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Equal(created, campaign.PreviousAccrualTime)
+
+	// Once started, the campaign distributes rewards from its start time
+	suite.NextBlockAfter(time.Hour)
+
+	campaign, found = ik.GetIncentiveCampaign(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("hard", sdk.NewInt(1e7))), campaign.Distributed)
+}
+
+func (suite *HandlerTestSuite) TestCreateCampaignRejectsInvalidCampaigns() {
+	creatorAddr, poorAddr := suite.addrs[1], suite.addrs[3]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(creatorAddr, cs(c("hard", 1e9), c("busd", 1e9), c("ukava", 1e9))).
+		WithSimpleAccount(poorAddr, cs(c("hard", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(creatorAddr, c("ukava", 1e8), c("busd", 1e8), d("1.0")),
+	)
+
+	start := suite.Ctx.BlockTime()
+	end := start.Add(100 * time.Second)
+
+	testCases := []struct {
+		name  string
+		msg   types.MsgCreateIncentiveCampaign
+		wraps error
+	}{
+		{
+			name:  "start before block time",
+			msg:   types.NewMsgCreateIncentiveCampaign(creatorAddr.String(), types.SwapRewardSourceType, "busd:ukava", cs(c("hard", 1e6)), start.Add(-time.Second), end),
+			wraps: types.ErrInvalidCampaign,
+		},
+		{
+			name:  "end after max duration",
+			msg:   types.NewMsgCreateIncentiveCampaign(creatorAddr.String(), types.SwapRewardSourceType, "busd:ukava", cs(c("hard", 1e6)), start, start.Add(types.DefaultCampaignMaxDuration+time.Second)),
+			wraps: types.ErrInvalidCampaign,
+		},
+		{
+			name:  "unregistered source type",
+			msg:   types.NewMsgCreateIncentiveCampaign(creatorAddr.String(), vaultSourceType, "pool-1", cs(c("hard", 1e6)), start, end),
+			wraps: types.ErrInvalidCampaign,
+		},
+		{
+			name:  "pool does not exist",
+			msg:   types.NewMsgCreateIncentiveCampaign(creatorAddr.String(), types.SwapRewardSourceType, "hard:ukava", cs(c("hard", 1e6)), start, end),
+			wraps: types.ErrInvalidCampaign,
+		},
+		{
+			name:  "vault does not exist",
+			msg:   types.NewMsgCreateIncentiveCampaign(creatorAddr.String(), types.EarnRewardSourceType, "usdx", cs(c("hard", 1e6)), start, end),
+			wraps: types.ErrInvalidCampaign,
+		},
+		{
+			name:  "all bkava vaults",
+			msg:   types.NewMsgCreateIncentiveCampaign(creatorAddr.String(), types.EarnRewardSourceType, "bkava", cs(c("hard", 1e6)), start, end),
+			wraps: types.ErrInvalidCampaign,
+		},
+		{
+			name:  "denom without claim multipliers",
+			msg:   types.NewMsgCreateIncentiveCampaign(creatorAddr.String(), types.SwapRewardSourceType, "busd:ukava", cs(c("busd", 1e6)), start, end),
+			wraps: types.ErrInvalidCampaign,
+		},
+		{
+			name:  "insufficient funds",
+			msg:   types.NewMsgCreateIncentiveCampaign(creatorAddr.String(), types.SwapRewardSourceType, "busd:ukava", cs(c("hard", 2e9)), start, end),
+			wraps: sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name:  "insufficient funds for creation fee",
+			msg:   types.NewMsgCreateIncentiveCampaign(poorAddr.String(), types.SwapRewardSourceType, "busd:ukava", cs(c("hard", 1e6)), start, end),
+			wraps: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.DeliverIncentiveMsg(&tc.msg)
+			suite.ErrorIs(err, tc.wraps)
+		})
+	}
+
+	suite.Empty(suite.App.GetIncentiveKeeper().GetAllIncentiveCampaigns(suite.Ctx))
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestPayoutClaimAllRewards() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12), c("ukava", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(receiverAddr, nil)
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6))).
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// create a hard deposit and a swap deposit
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+	suite.NoError(suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")))
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.GetIncentiveKeeper())
+
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		receiverAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "large"),
+			types.NewSelection("swap", "medium"),
+		},
+	)
+
+	res, err := msgServer.ClaimAllRewards(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+
+	expectedHardRewards := c("hard", 7*1e6)
+	expectedSwapRewards := cs(c("hard", 7*1e6), c("swap", int64(0.5*float64(7*1e6))))
+	suite.Equal(types.ClaimedRewards{
+		types.NewClaimedReward(types.HardLiquidityProviderClaimType, cs(expectedHardRewards)),
+		types.NewClaimedReward(types.SwapClaimType, expectedSwapRewards),
+	}, res.Claimed)
+
+	// Check rewards were paid out to the receiver
+	suite.BalanceEquals(receiverAddr, expectedSwapRewards.Add(expectedHardRewards))
+
+	// Check that claimed coins have been removed from each claim's reward
+	suite.HardRewardEquals(userAddr, nil)
+	suite.SwapRewardEquals(userAddr, nil)
+
+	// Claiming again in the same block fails as there are no rewards left
+	_, err = msgServer.ClaimAllRewards(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.ErrorIs(err, types.ErrZeroClaim)
+}
+
+func (suite *HandlerTestSuite) TestPayoutClaimAllRewardsSkipsUnselectedDenoms() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")))
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("swap", "large"),
+		},
+	)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+
+	suite.BalanceEquals(userAddr, preClaimBal.Add(c("swap", 7*1e6)))
+	suite.SwapRewardEquals(userAddr, cs(c("hard", 7*1e6)))
+
+	// An invalid multiplier fails the whole claim
+	msg = types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "does-not-exist"),
+		},
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidMultiplier)
+	suite.SwapRewardEquals(userAddr, cs(c("hard", 7*1e6)))
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// GetAccountRewardRates returns the rewards an owner earns per second from each source they hold shares in, for all
+// reward periods and incentive campaigns running at the current block time. A campaign has its own rate as it ends at
+// a different time to the source's reward period.
+// Shares are read the same way claims are synchronized, without writing any state, and include the shares added by the
+// owner's boost. Staking rewards paid to bkava earn vaults are not included as they are not known in advance.
+func (k Keeper) GetAccountRewardRates(ctx sdk.Context, owner sdk.AccAddress) (types.AccountRewardRates, error) {
+	params := k.GetParams(ctx)
+	rates := types.AccountRewardRates{}
+
+	addSourceRate := func(sourceType, sourceID string, shares, totalShares sdk.Dec, rewardsPerSecond sdk.DecCoins, end time.Time) {
+		shares = shares.Add(k.GetBoostedShares(ctx, sourceType, sourceID, owner))
+		if !shares.IsPositive() || !totalShares.IsPositive() {
+			return
+		}
+		rates = append(rates, types.NewAccountRewardRate(
+			sourceType, sourceID, shares, totalShares, rewardsPerSecond, ctx.BlockTime(), end,
+		))
+	}
+	addRate := func(sourceType, sourceID string, shares, totalShares sdk.Dec, period types.MultiRewardPeriod) {
+		addSourceRate(sourceType, sourceID, shares, totalShares, sdk.NewDecCoinsFromCoins(period.RewardsPerSecond...), period.End)
+	}
+
+	for _, rp := range params.USDXMintingRewardPeriods {
+		period := types.NewMultiRewardPeriodFromRewardPeriod(rp)
+		if !isPeriodRunning(ctx, period) {
+			continue
+		}
+		cdp, found := k.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, owner, rp.CollateralType)
+		if !found {
+			continue
+		}
+		shares, err := cdp.GetNormalizedPrincipal()
+		if err != nil {
+			return nil, err
+		}
+		addRate(types.USDXMintingRewardSourceType, rp.CollateralType, shares, k.getSourceTotalShares(ctx, types.USDXMintingRewardSourceType, rp.CollateralType), period)
+	}
+
+	if deposit, found := k.hardKeeper.GetDeposit(ctx, owner); found {
+		normalizedDeposit, err := deposit.NormalizedDeposit()
+		if err != nil {
+			return nil, err
+		}
+		for _, period := range runningPeriods(ctx, params.HardSupplyRewardPeriods) {
+			shares := normalizedDeposit.AmountOf(period.CollateralType)
+			addRate(types.HardSupplyRewardSourceType, period.CollateralType, shares, k.getSourceTotalShares(ctx, types.HardSupplyRewardSourceType, period.CollateralType), period)
+		}
+	}
+
+	if borrow, found := k.hardKeeper.GetBorrow(ctx, owner); found {
+		normalizedBorrow, err := borrow.NormalizedBorrow()
+		if err != nil {
+			return nil, err
+		}
+		for _, period := range runningPeriods(ctx, params.HardBorrowRewardPeriods) {
+			shares := normalizedBorrow.AmountOf(period.CollateralType)
+			addRate(types.HardBorrowRewardSourceType, period.CollateralType, shares, k.getSourceTotalShares(ctx, types.HardBorrowRewardSourceType, period.CollateralType), period)
+		}
+	}
+
+	delegated := k.GetTotalDelegated(ctx, owner, nil, false)
+	for _, period := range runningPeriods(ctx, params.DelegatorRewardPeriods) {
+		addRate(types.DelegatorRewardSourceType, period.CollateralType, delegated, k.getSourceTotalShares(ctx, types.DelegatorRewardSourceType, period.CollateralType), period)
+	}
+
+	for _, period := range runningPeriods(ctx, params.SwapRewardPeriods) {
+		shares, found := k.swapKeeper.GetDepositorSharesAmount(ctx, owner, period.CollateralType)
+		if !found {
+			continue
+		}
+		addRate(types.SwapRewardSourceType, period.CollateralType, sdk.NewDecFromInt(shares), k.getSourceTotalShares(ctx, types.SwapRewardSourceType, period.CollateralType), period)
+	}
+
+	if deposit, found := k.savingsKeeper.GetDeposit(ctx, owner); found {
+		for _, period := range runningPeriods(ctx, params.SavingsRewardPeriods) {
+			shares := sdk.NewDecFromInt(deposit.Amount.AmountOf(period.CollateralType))
+			addRate(types.SavingsRewardSourceType, period.CollateralType, shares, k.getSourceTotalShares(ctx, types.SavingsRewardSourceType, period.CollateralType), period)
+		}
+	}
+
+	earnRates, err := k.getEarnAccountRewardRates(ctx, owner, params.EarnRewardPeriods)
+	if err != nil {
+		return nil, err
+	}
+	rates = append(rates, earnRates...)
+
+	for _, sourcePeriods := range params.SourceRewardPeriods {
+		source, found := k.GetRewardSource(sourcePeriods.SourceType)
+		if !found {
+			continue
+		}
+		for _, period := range runningPeriods(ctx, sourcePeriods.RewardPeriods) {
+			shares := source.GetShares(ctx, period.CollateralType, owner)
+			addRate(sourcePeriods.SourceType, period.CollateralType, shares, k.getSourceTotalShares(ctx, sourcePeriods.SourceType, period.CollateralType), period)
+		}
+	}
+
+	k.IterateIncentiveCampaigns(ctx, func(campaign types.IncentiveCampaign) (stop bool) {
+		if ctx.BlockTime().Before(campaign.Start) || !ctx.BlockTime().Before(campaign.End) {
+			return false
+		}
+		source, found := k.GetRewardSource(campaign.SourceType)
+		if !found {
+			return false
+		}
+		shares := source.GetShares(ctx, campaign.SourceID, owner)
+		totalShares := k.getSourceTotalShares(ctx, campaign.SourceType, campaign.SourceID)
+		addSourceRate(campaign.SourceType, campaign.SourceID, shares, totalShares, campaign.RewardsPerSecond(), campaign.End)
+		return false
+	})
+
+	return rates, nil
+}
+
+// getEarnAccountRewardRates returns the earn reward rates of an owner. The bkava reward period is split across the
+// bkava vaults the owner holds shares in by the value of each vault's derivative, as it is when rewards accumulate.
+func (k Keeper) getEarnAccountRewardRates(
+	ctx sdk.Context, owner sdk.AccAddress, periods types.MultiRewardPeriods,
+) (types.AccountRewardRates, error) {
+	rates := types.AccountRewardRates{}
+
+	accountShares, found := k.earnKeeper.GetVaultAccountShares(ctx, owner)
+	if !found {
+		return rates, nil
+	}
+
+	for _, period := range runningPeriods(ctx, periods) {
+		if period.CollateralType != "bkava" {
+			shares := accountShares.AmountOf(period.CollateralType).
+				Add(k.GetBoostedShares(ctx, types.EarnRewardSourceType, period.CollateralType, owner))
+			totalShares := k.getSourceTotalShares(ctx, types.EarnRewardSourceType, period.CollateralType)
+			if shares.IsPositive() && totalShares.IsPositive() {
+				rates = append(rates, types.NewAccountRewardRate(
+					types.EarnRewardSourceType, period.CollateralType, shares, totalShares,
+					sdk.NewDecCoinsFromCoins(period.RewardsPerSecond...),
+					ctx.BlockTime(), period.End,
+				))
+			}
+			continue
+		}
+
+		bkavaShares := earntypes.VaultShares{}
+		for _, share := range accountShares {
+			if k.liquidKeeper.IsDerivativeDenom(ctx, share.Denom) && share.Amount.IsPositive() {
+				bkavaShares = append(bkavaShares, share)
+			}
+		}
+		if len(bkavaShares) == 0 {
+			continue
+		}
+		sort.Slice(bkavaShares, func(i, j int) bool { return bkavaShares[i].Denom < bkavaShares[j].Denom })
+
+		totalBkavaValue, err := k.liquidKeeper.GetTotalDerivativeValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, share := range bkavaShares {
+			derivativeValue, err := k.liquidKeeper.GetDerivativeValue(ctx, share.Denom)
+			if err != nil {
+				return nil, err
+			}
+			shares := share.Amount.Add(k.GetBoostedShares(ctx, types.EarnRewardSourceType, share.Denom, owner))
+			totalShares := k.getSourceTotalShares(ctx, types.EarnRewardSourceType, share.Denom)
+			if !totalShares.IsPositive() {
+				continue
+			}
+			rates = append(rates, types.NewAccountRewardRate(
+				types.EarnRewardSourceType, share.Denom, shares, totalShares,
+				GetProportionalRewardsPerSecond(period, totalBkavaValue.Amount, derivativeValue.Amount),
+				ctx.BlockTime(), period.End,
+			))
+		}
+	}
+
+	return rates, nil
+}
+
+// runningPeriods returns the reward periods that distribute rewards at the current block time.
+func runningPeriods(ctx sdk.Context, periods types.MultiRewardPeriods) types.MultiRewardPeriods {
+	var running types.MultiRewardPeriods
+	for _, period := range periods {
+		if isPeriodRunning(ctx, period) {
+			running = append(running, period)
+		}
+	}
+	return running
+}
+
+// isPeriodRunning returns true if a reward period distributes rewards at the current block time.
+func isPeriodRunning(ctx sdk.Context, period types.MultiRewardPeriod) bool {
+	return !ctx.BlockTime().Before(period.Start) && ctx.BlockTime().Before(period.End)
+}
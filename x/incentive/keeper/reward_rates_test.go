@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestQueryAccountRewardRates() {
+	userAddr, otherAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(otherAddr, nil)
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("swap", 1e6))).
+		WithSimpleSourceRewardPeriod(vaultSourceType, "pool-1", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	source := newFakeRewardSource()
+	source.setShares("pool-1", userAddr, d("1"))
+	source.setShares("pool-1", suite.addrs[2], d("3"))
+	suite.App.GetIncentiveKeeper().RegisterRewardSource(vaultSourceType, source)
+
+	suite.NoError(suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")))
+	suite.NextBlockAfter(7 * time.Second)
+
+	queryServer := keeper.NewQueryServerImpl(suite.App.GetIncentiveKeeper())
+	res, err := queryServer.AccountRewardRates(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryAccountRewardRatesRequest{Owner: userAddr.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RewardRates, 2)
+
+	projectedSeconds := int64(types.RewardProjectionDuration / time.Second)
+
+	swapRate := res.RewardRates[0]
+	suite.Equal(types.SwapRewardSourceType, swapRate.SourceType)
+	suite.Equal("busd:ukava", swapRate.SourceID)
+	suite.Equal(swapRate.TotalShares, swapRate.Shares)
+	suite.Equal(d("1"), swapRate.Share)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("swap", 1e6)), swapRate.RewardsPerSecond)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("swap", 1e6*projectedSeconds)), swapRate.ProjectedRewards)
+
+	vaultRate := res.RewardRates[1]
+	suite.Equal(vaultSourceType, vaultRate.SourceType)
+	suite.Equal("pool-1", vaultRate.SourceID)
+	suite.Equal(d("1"), vaultRate.Shares)
+	suite.Equal(d("4"), vaultRate.TotalShares)
+	suite.Equal(d("0.25"), vaultRate.Share)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 250000)), vaultRate.RewardsPerSecond)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 250000*projectedSeconds)), vaultRate.ProjectedRewards)
+
+	// Accounts without rewarded positions have no rates
+	res, err = queryServer.AccountRewardRates(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryAccountRewardRatesRequest{Owner: otherAddr.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Empty(res.RewardRates)
+}
+
+func (suite *HandlerTestSuite) TestQueryAccountRewardRates_BoostedBuiltInSource() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")))
+	ik := suite.App.GetIncentiveKeeper()
+	shares, found := suite.App.GetSwapKeeper().GetDepositorSharesAmount(suite.Ctx, userAddr, "busd:ukava")
+	suite.Require().True(found)
+	// the user's boost doubles their shares
+	ik.SetBoostedShares(suite.Ctx, types.NewBoostedShares(types.SwapRewardSourceType, "busd:ukava", userAddr, sdk.NewDecFromInt(shares)))
+	suite.NextBlockAfter(7 * time.Second)
+
+	queryServer := keeper.NewQueryServerImpl(ik)
+	res, err := queryServer.AccountRewardRates(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryAccountRewardRatesRequest{Owner: userAddr.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RewardRates, 1)
+
+	// The boosted shares count towards both the user's and the total shares
+	swapRate := res.RewardRates[0]
+	suite.Equal(sdk.NewDecFromInt(shares.MulRaw(2)), swapRate.Shares)
+	suite.Equal(swapRate.TotalShares, swapRate.Shares)
+	suite.Equal(d("1"), swapRate.Share)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("swap", 1e6)), swapRate.RewardsPerSecond)
+}
+
+func (suite *HandlerTestSuite) TestQueryAccountRewardRates_IncludesCampaigns() {
+	userAddr, creatorAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(creatorAddr, cs(c("hard", 1e9), c("ukava", 1e9)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")))
+
+	start := suite.Ctx.BlockTime()
+	msg := types.NewMsgCreateIncentiveCampaign(
+		creatorAddr.String(), types.SwapRewardSourceType, "busd:ukava", cs(c("hard", 1e8)), start, start.Add(100*time.Second),
+	)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.NextBlockAfter(10 * time.Second)
+
+	queryServer := keeper.NewQueryServerImpl(suite.App.GetIncentiveKeeper())
+	res, err := queryServer.AccountRewardRates(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryAccountRewardRatesRequest{Owner: userAddr.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RewardRates, 2)
+
+	projectedSeconds := int64(types.RewardProjectionDuration / time.Second)
+
+	swapRate := res.RewardRates[0]
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("swap", 1e6)), swapRate.RewardsPerSecond)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("swap", 1e6*projectedSeconds)), swapRate.ProjectedRewards)
+
+	// The campaign is projected up to its end
+	campaignRate := res.RewardRates[1]
+	suite.Equal(types.SwapRewardSourceType, campaignRate.SourceType)
+	suite.Equal("busd:ukava", campaignRate.SourceID)
+	suite.Equal(d("1"), campaignRate.Share)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 1e6)), campaignRate.RewardsPerSecond)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 90e6)), campaignRate.ProjectedRewards)
+
+	// Ended campaigns are not included
+	suite.NextBlockAfter(90 * time.Second)
+	res, err = queryServer.AccountRewardRates(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryAccountRewardRatesRequest{Owner: userAddr.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RewardRates, 1)
+	suite.Equal(types.SwapRewardSourceType, res.RewardRates[0].SourceType)
+}
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// usdxMintingRewardSource provides the shares of usdx minting rewards, which are sourced from cdps of each
+// collateral type.
+type usdxMintingRewardSource struct {
+	cdpKeeper types.CdpKeeper
+}
+
+var _ types.RewardSource = usdxMintingRewardSource{}
+
+// NewUSDXMintingRewardSource returns the reward source of the usdx_minting source type
+func NewUSDXMintingRewardSource(cdpKeeper types.CdpKeeper) types.RewardSource {
+	return usdxMintingRewardSource{cdpKeeper: cdpKeeper}
+}
+
+// GetTotalShares returns the total debt from all cdps of a collateral type divided by the cdp interest factor.
+// This gives the "pre interest" value of the total debt.
+func (s usdxMintingRewardSource) GetTotalShares(ctx sdk.Context, collateralType string) sdk.Dec {
+	totalPrincipal := s.cdpKeeper.GetTotalPrincipal(ctx, collateralType, cdptypes.DefaultStableDenom)
+
+	cdpFactor, found := s.cdpKeeper.GetInterestFactor(ctx, collateralType)
+	if !found {
+		// assume nothing has been borrowed so the factor starts at it's default value
+		cdpFactor = sdk.OneDec()
+	}
+	// return debt/factor to get the "pre interest" value of the current total debt
+	return sdk.NewDecFromInt(totalPrincipal).Quo(cdpFactor)
+}
+
+// GetShares returns the normalized principal of an owner's cdp of a collateral type
+func (s usdxMintingRewardSource) GetShares(ctx sdk.Context, collateralType string, owner sdk.AccAddress) sdk.Dec {
+	cdp, found := s.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	shares, err := cdp.GetNormalizedPrincipal()
+	if err != nil {
+		// cdps with an interest factor below one are invalid and cannot have accrued rewards
+		return sdk.ZeroDec()
+	}
+	return shares
+}
+
+// hardSupplyRewardSource provides the shares of hard supply rewards, which are sourced from deposits of each denom.
+type hardSupplyRewardSource struct {
+	hardKeeper types.HardKeeper
+}
+
+var _ types.RewardSource = hardSupplyRewardSource{}
+
+// NewHardSupplyRewardSource returns the reward source of the hard_supply source type
+func NewHardSupplyRewardSource(hardKeeper types.HardKeeper) types.RewardSource {
+	return hardSupplyRewardSource{hardKeeper: hardKeeper}
+}
+
+// GetTotalShares returns the total supplied of a denom divided by the supply interest factor.
+// This gives the "pre interest" value of the total supplied.
+func (s hardSupplyRewardSource) GetTotalShares(ctx sdk.Context, denom string) sdk.Dec {
+	totalSuppliedCoins, found := s.hardKeeper.GetSuppliedCoins(ctx)
+	if !found {
+		// assume no coins have been supplied
+		totalSuppliedCoins = sdk.NewCoins()
+	}
+	totalSupplied := totalSuppliedCoins.AmountOf(denom)
+
+	interestFactor, found := s.hardKeeper.GetSupplyInterestFactor(ctx, denom)
+	if !found {
+		// assume nothing has been borrowed so the factor starts at it's default value
+		interestFactor = sdk.OneDec()
+	}
+
+	// return supplied/factor to get the "pre interest" value of the current total supplied
+	return sdk.NewDecFromInt(totalSupplied).Quo(interestFactor)
+}
+
+// GetShares returns the normalized amount of a denom in an owner's deposit
+func (s hardSupplyRewardSource) GetShares(ctx sdk.Context, denom string, owner sdk.AccAddress) sdk.Dec {
+	deposit, found := s.hardKeeper.GetDeposit(ctx, owner)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	normalizedDeposit, err := deposit.NormalizedDeposit()
+	if err != nil {
+		// deposits with an interest factor below one are invalid and cannot have accrued rewards
+		return sdk.ZeroDec()
+	}
+	return normalizedDeposit.AmountOf(denom)
+}
+
+// hardBorrowRewardSource provides the shares of hard borrow rewards, which are sourced from borrows of each denom.
+type hardBorrowRewardSource struct {
+	hardKeeper types.HardKeeper
+}
+
+var _ types.RewardSource = hardBorrowRewardSource{}
+
+// NewHardBorrowRewardSource returns the reward source of the hard_borrow source type
+func NewHardBorrowRewardSource(hardKeeper types.HardKeeper) types.RewardSource {
+	return hardBorrowRewardSource{hardKeeper: hardKeeper}
+}
+
+// GetTotalShares returns the total borrowed of a denom divided by the borrow interest factor.
+//
+// This gives the "pre interest" or "normalized" value of the total borrowed. This is an amount, that if it was borrowed when
+// the interest factor was zero (ie at time 0), the current value of it with interest would be equal to the current total borrowed.
+//
+// The normalized borrow is also used for each individual borrow's source shares amount. Normalized amounts do not change except through
+// user input. This is essential as claims must be synced before any change to a source shares amount. The actual borrowed amounts cannot
+// be used as they increase every block due to interest.
+func (s hardBorrowRewardSource) GetTotalShares(ctx sdk.Context, denom string) sdk.Dec {
+	totalBorrowedCoins, found := s.hardKeeper.GetBorrowedCoins(ctx)
+	if !found {
+		// assume no coins have been borrowed
+		totalBorrowedCoins = sdk.NewCoins()
+	}
+	totalBorrowed := totalBorrowedCoins.AmountOf(denom)
+
+	interestFactor, found := s.hardKeeper.GetBorrowInterestFactor(ctx, denom)
+	if !found {
+		// assume nothing has been borrowed so the factor starts at it's default value
+		interestFactor = sdk.OneDec()
+	}
+
+	// return borrowed/factor to get the "pre interest" value of the current total borrowed
+	return sdk.NewDecFromInt(totalBorrowed).Quo(interestFactor)
+}
+
+// GetShares returns the normalized amount of a denom in an owner's borrow
+func (s hardBorrowRewardSource) GetShares(ctx sdk.Context, denom string, owner sdk.AccAddress) sdk.Dec {
+	borrow, found := s.hardKeeper.GetBorrow(ctx, owner)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	normalizedBorrow, err := borrow.NormalizedBorrow()
+	if err != nil {
+		// borrows with an interest factor below one are invalid and cannot have accrued rewards
+		return sdk.ZeroDec()
+	}
+	return normalizedBorrow.AmountOf(denom)
+}
+
+// delegatorRewardSource provides the shares of delegator rewards, which are sourced from tokens delegated to bonded
+// validators. The bond denom is the only source.
+type delegatorRewardSource struct {
+	stakingKeeper types.StakingKeeper
+}
+
+var _ types.RewardSource = delegatorRewardSource{}
+
+// NewDelegatorRewardSource returns the reward source of the delegator source type
+func NewDelegatorRewardSource(stakingKeeper types.StakingKeeper) types.RewardSource {
+	return delegatorRewardSource{stakingKeeper: stakingKeeper}
+}
+
+// GetTotalShares returns the total tokens staked to bonded validators
+func (s delegatorRewardSource) GetTotalShares(ctx sdk.Context, _ string) sdk.Dec {
+	return sdk.NewDecFromInt(s.stakingKeeper.TotalBondedTokens(ctx))
+}
+
+// GetShares returns the tokens an owner has delegated to bonded validators
+func (s delegatorRewardSource) GetShares(ctx sdk.Context, _ string, owner sdk.AccAddress) sdk.Dec {
+	return getTotalDelegated(ctx, s.stakingKeeper, owner, nil, false)
+}
+
+// getTotalDelegated returns the tokens a delegator has delegated to bonded validators.
+// valAddr and shouldIncludeValidator are used to ignore or include delegations to a particular validator regardless
+// of its bonded status.
+func getTotalDelegated(
+	ctx sdk.Context, stakingKeeper types.StakingKeeper, delegator sdk.AccAddress, valAddr sdk.ValAddress, shouldIncludeValidator bool,
+) sdk.Dec {
+	totalDelegated := sdk.ZeroDec()
+
+	delegations := stakingKeeper.GetDelegatorDelegations(ctx, delegator, 200)
+	for _, delegation := range delegations {
+		validator, found := stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		if validator.GetOperator().Equals(valAddr) {
+			if shouldIncludeValidator {
+				// do nothing, so the validator is included regardless of bonded status
+			} else {
+				// skip this validator
+				continue
+			}
+		} else {
+			// skip any not bonded validator
+			if validator.GetStatus() != stakingtypes.Bonded {
+				continue
+			}
+		}
+
+		if validator.GetTokens().IsZero() {
+			continue
+		}
+
+		delegatedTokens := validator.TokensFromShares(delegation.GetShares())
+		if delegatedTokens.IsNegative() {
+			continue
+		}
+		totalDelegated = totalDelegated.Add(delegatedTokens)
+	}
+	return totalDelegated
+}
+
+// swapRewardSource provides the shares of swap rewards, which are sourced from the shares of each swap pool.
+type swapRewardSource struct {
+	swapKeeper types.SwapKeeper
+}
+
+var _ types.RewardSource = swapRewardSource{}
+
+// NewSwapRewardSource returns the reward source of the swap source type
+func NewSwapRewardSource(swapKeeper types.SwapKeeper) types.RewardSource {
+	return swapRewardSource{swapKeeper: swapKeeper}
+}
+
+// GetTotalShares returns the total (swap module) shares in a pool
+func (s swapRewardSource) GetTotalShares(ctx sdk.Context, poolID string) sdk.Dec {
+	totalShares, found := s.swapKeeper.GetPoolShares(ctx, poolID)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(totalShares)
+}
+
+// GetShares returns an owner's shares in a pool
+func (s swapRewardSource) GetShares(ctx sdk.Context, poolID string, owner sdk.AccAddress) sdk.Dec {
+	shares, found := s.swapKeeper.GetDepositorSharesAmount(ctx, owner, poolID)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(shares)
+}
+
+// savingsRewardSource provides the shares of savings rewards, which are sourced from savings deposits of each denom.
+type savingsRewardSource struct {
+	savingsKeeper types.SavingsKeeper
+}
+
+var _ types.RewardSource = savingsRewardSource{}
+
+// NewSavingsRewardSource returns the reward source of the savings source type
+func NewSavingsRewardSource(savingsKeeper types.SavingsKeeper) types.RewardSource {
+	return savingsRewardSource{savingsKeeper: savingsKeeper}
+}
+
+// GetTotalShares returns the balance of a denom held by the savings module account
+func (s savingsRewardSource) GetTotalShares(ctx sdk.Context, denom string) sdk.Dec {
+	return sdk.NewDecFromInt(s.savingsKeeper.GetSavingsModuleAccountBalances(ctx).AmountOf(denom))
+}
+
+// GetShares returns the amount of a denom in an owner's savings deposit
+func (s savingsRewardSource) GetShares(ctx sdk.Context, denom string, owner sdk.AccAddress) sdk.Dec {
+	deposit, found := s.savingsKeeper.GetDeposit(ctx, owner)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(deposit.Amount.AmountOf(denom))
+}
+
+// earnRewardSource provides the shares of earn rewards, which are sourced from the shares of each earn vault.
+type earnRewardSource struct {
+	earnKeeper types.EarnKeeper
+}
+
+var _ types.RewardSource = earnRewardSource{}
+
+// NewEarnRewardSource returns the reward source of the earn source type
+func NewEarnRewardSource(earnKeeper types.EarnKeeper) types.RewardSource {
+	return earnRewardSource{earnKeeper: earnKeeper}
+}
+
+// GetTotalShares returns the total (earn module) shares in a vault
+func (s earnRewardSource) GetTotalShares(ctx sdk.Context, vaultDenom string) sdk.Dec {
+	totalShares, found := s.earnKeeper.GetVaultTotalShares(ctx, vaultDenom)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return totalShares.Amount
+}
+
+// GetShares returns an owner's shares in a vault
+func (s earnRewardSource) GetShares(ctx sdk.Context, vaultDenom string, owner sdk.AccAddress) sdk.Dec {
+	shares, found := s.earnKeeper.GetVaultAccountShares(ctx, owner)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return shares.AmountOf(vaultDenom)
+}
@@ -18,28 +18,21 @@ func TestInitializeHardBorrowReward(t *testing.T) {
 }
 
 func (suite *InitializeHardBorrowRewardTests) TestClaimIndexesAreSetWhenClaimExists() {
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		// Indexes should always be empty when initialize is called.
-		// If initialize is called then the user must have repaid their borrow positions,
-		// which means UpdateHardBorrowIndexDenoms was called and should have remove indexes.
-		BorrowRewardIndexes: types.MultiRewardIndexes{},
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	// Initialize overwrites any stored indexes with the current global indexes.
+	claimIndexes := types.MultiRewardIndexes{}
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, nil, claimIndexes)
 
 	globalIndexes := nonEmptyMultiRewardIndexes
 	suite.storeGlobalBorrowIndexes(globalIndexes)
 
-	borrow := NewBorrowBuilder(claim.Owner).
+	borrow := NewBorrowBuilder(owner).
 		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
 		Build()
 
 	suite.keeper.InitializeHardBorrowReward(suite.ctx, borrow)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.BorrowRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
 }
 
 func (suite *InitializeHardBorrowRewardTests) TestClaimIndexesAreSetWhenClaimDoesNotExist() {
@@ -53,9 +46,7 @@ func (suite *InitializeHardBorrowRewardTests) TestClaimIndexesAreSetWhenClaimDoe
 
 	suite.keeper.InitializeHardBorrowReward(suite.ctx, borrow)
 
-	syncedClaim, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, owner)
-	suite.True(found)
-	suite.Equal(globalIndexes, syncedClaim.BorrowRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
 }
 
 func (suite *InitializeHardBorrowRewardTests) TestClaimIndexesAreSetEmptyForMissingIndexes() {
@@ -73,6 +64,43 @@ func (suite *InitializeHardBorrowRewardTests) TestClaimIndexesAreSetEmptyForMiss
 
 	suite.keeper.InitializeHardBorrowReward(suite.ctx, borrow)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, owner)
-	suite.Equal(expectedIndexes, syncedClaim.BorrowRewardIndexes)
+	suite.Equal(expectedIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
+}
+
+func (suite *InitializeHardBorrowRewardTests) TestClaimsAreKeptForDenomsNoLongerBorrowed() {
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	reward := arbitraryCoins()
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, reward, claimIndexes)
+	suite.storeGlobalBorrowIndexes(claimIndexes)
+
+	// remove one denom from the indexes already in the borrow
+	borrow := NewBorrowBuilder(owner).
+		WithArbitrarySourceShares(extractCollateralTypes(claimIndexes[1:])...).
+		Build()
+
+	suite.keeper.InitializeHardBorrowReward(suite.ctx, borrow)
+
+	// the claim of the removed denom is kept so its rewards can still be claimed
+	suite.Equal(claimIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
+	suite.Equal(reward, suite.getSourceClaimsReward(types.HardBorrowRewardSourceType, owner))
+}
+
+func (suite *InitializeHardBorrowRewardTests) TestClaimIndexesAreAddedForNewlyBorrowedDenoms() {
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	reward := arbitraryCoins()
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, reward, claimIndexes)
+
+	globalIndexes := appendUniqueMultiRewardIndex(claimIndexes)
+	suite.storeGlobalBorrowIndexes(globalIndexes)
+
+	borrow := NewBorrowBuilder(owner).
+		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
+		Build()
+
+	suite.keeper.InitializeHardBorrowReward(suite.ctx, borrow)
+
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
+	suite.Equal(reward, suite.getSourceClaimsReward(types.HardBorrowRewardSourceType, owner))
 }
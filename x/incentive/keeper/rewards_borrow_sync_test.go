@@ -26,25 +26,20 @@ func TestSynchronizeHardBorrowReward(t *testing.T) {
 func (suite *SynchronizeHardBorrowRewardTests) TestClaimIndexesAreUpdatedWhenGlobalIndexesHaveIncreased() {
 	// This is the normal case
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		BorrowRewardIndexes: nonEmptyMultiRewardIndexes,
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, nil, claimIndexes)
 
 	globalIndexes := increaseAllRewardFactors(nonEmptyMultiRewardIndexes)
 	suite.storeGlobalBorrowIndexes(globalIndexes)
 
-	borrow := NewBorrowBuilder(claim.Owner).
-		WithArbitrarySourceShares(extractCollateralTypes(claim.BorrowRewardIndexes)...).
+	borrow := NewBorrowBuilder(owner).
+		WithArbitrarySourceShares(extractCollateralTypes(claimIndexes)...).
 		Build()
 
 	suite.keeper.SynchronizeHardBorrowReward(suite.ctx, borrow)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.BorrowRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
 }
 
 func (suite *SynchronizeHardBorrowRewardTests) TestClaimIndexesAreUnchangedWhenGlobalIndexesUnchanged() {
@@ -52,74 +47,59 @@ func (suite *SynchronizeHardBorrowRewardTests) TestClaimIndexesAreUnchangedWhenG
 
 	unchangingIndexes := nonEmptyMultiRewardIndexes
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		BorrowRewardIndexes: unchangingIndexes,
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := unchangingIndexes
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, nil, claimIndexes)
 
 	suite.storeGlobalBorrowIndexes(unchangingIndexes)
 
-	borrow := NewBorrowBuilder(claim.Owner).
+	borrow := NewBorrowBuilder(owner).
 		WithArbitrarySourceShares(extractCollateralTypes(unchangingIndexes)...).
 		Build()
 
 	suite.keeper.SynchronizeHardBorrowReward(suite.ctx, borrow)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(unchangingIndexes, syncedClaim.BorrowRewardIndexes)
+	suite.Equal(unchangingIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
 }
 
 func (suite *SynchronizeHardBorrowRewardTests) TestClaimIndexesAreUpdatedWhenNewRewardAdded() {
 	// When a new reward is added (via gov) for a hard borrow denom the user has already borrowed, and the claim is synced;
 	// Then the new reward's index should be added to the claim.
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		BorrowRewardIndexes: nonEmptyMultiRewardIndexes,
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, nil, claimIndexes)
 
 	globalIndexes := appendUniqueMultiRewardIndex(nonEmptyMultiRewardIndexes)
 	suite.storeGlobalBorrowIndexes(globalIndexes)
 
-	borrow := NewBorrowBuilder(claim.Owner).
+	borrow := NewBorrowBuilder(owner).
 		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
 		Build()
 
 	suite.keeper.SynchronizeHardBorrowReward(suite.ctx, borrow)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.BorrowRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
 }
 
 func (suite *SynchronizeHardBorrowRewardTests) TestClaimIndexesAreUpdatedWhenNewRewardDenomAdded() {
 	// When a new reward coin is added (via gov) to an already rewarded borrow denom (that the user has already borrowed), and the claim is synced;
 	// Then the new reward coin's index should be added to the claim.
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		BorrowRewardIndexes: nonEmptyMultiRewardIndexes,
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, nil, claimIndexes)
 
 	globalIndexes := appendUniqueRewardIndexToFirstItem(nonEmptyMultiRewardIndexes)
 	suite.storeGlobalBorrowIndexes(globalIndexes)
 
-	borrow := NewBorrowBuilder(claim.Owner).
+	borrow := NewBorrowBuilder(owner).
 		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
 		Build()
 
 	suite.keeper.SynchronizeHardBorrowReward(suite.ctx, borrow)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.BorrowRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardBorrowRewardSourceType, owner))
 }
 
 func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenGlobalIndexesHaveIncreased() {
@@ -130,24 +110,19 @@ func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenGlobal
 
 	originalReward := arbitraryCoins()
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		BorrowRewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: "borrowdenom",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom",
-						RewardFactor:   d("1000.001"),
-					},
+	owner := arbitraryAddress()
+	claimIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: "borrowdenom",
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "rewarddenom",
+					RewardFactor:   d("1000.001"),
 				},
 			},
 		},
 	}
-	suite.storeHardClaim(claim)
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, originalReward, claimIndexes)
 
 	suite.storeGlobalBorrowIndexes(types.MultiRewardIndexes{
 		{
@@ -161,17 +136,16 @@ func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenGlobal
 		},
 	})
 
-	borrow := NewBorrowBuilder(claim.Owner).
+	borrow := NewBorrowBuilder(owner).
 		WithSourceShares("borrowdenom", 1e9).
 		Build()
 
 	suite.keeper.SynchronizeHardBorrowReward(suite.ctx, borrow)
 
 	// new reward is (new index - old index) * borrow amount
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
 	suite.Equal(
 		cs(c("rewarddenom", 1_000_001_000_000)).Add(originalReward...),
-		syncedClaim.Reward,
+		suite.getSourceClaimsReward(types.HardBorrowRewardSourceType, owner),
 	)
 }
 
@@ -180,24 +154,19 @@ func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenNewRew
 	// Then the user earns rewards for the time since the reward was added
 
 	originalReward := arbitraryCoins()
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		BorrowRewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: "rewarded",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
+	owner := arbitraryAddress()
+	claimIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: "rewarded",
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "reward",
+					RewardFactor:   d("1000.001"),
 				},
 			},
 		},
 	}
-	suite.storeHardClaim(claim)
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, originalReward, claimIndexes)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -223,7 +192,7 @@ func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenNewRew
 	}
 	suite.storeGlobalBorrowIndexes(globalIndexes)
 
-	borrow := NewBorrowBuilder(claim.Owner).
+	borrow := NewBorrowBuilder(owner).
 		WithSourceShares("rewarded", 1e9).
 		WithSourceShares("newlyrewarded", 1e9).
 		Build()
@@ -232,10 +201,9 @@ func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenNewRew
 
 	// new reward is (new index - old index) * borrow amount for each borrowed denom
 	// The old index for `newlyrewarded` isn't in the claim, so it's added starting at 0 for calculating the reward.
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
 	suite.Equal(
 		cs(c("otherreward", 1_000_001_000_000), c("reward", 1_000_001_000_000)).Add(originalReward...),
-		syncedClaim.Reward,
+		suite.getSourceClaimsReward(types.HardBorrowRewardSourceType, owner),
 	)
 }
 
@@ -244,24 +212,19 @@ func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenNewRew
 	// Then the user earns rewards for the time since the reward was added
 
 	originalReward := arbitraryCoins()
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		BorrowRewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: "borrowed",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
+	owner := arbitraryAddress()
+	claimIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: "borrowed",
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "reward",
+					RewardFactor:   d("1000.001"),
 				},
 			},
 		},
 	}
-	suite.storeHardClaim(claim)
+	suite.storeSourceClaimsFromIndexes(types.HardBorrowRewardSourceType, owner, originalReward, claimIndexes)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -282,7 +245,7 @@ func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenNewRew
 	}
 	suite.storeGlobalBorrowIndexes(globalIndexes)
 
-	borrow := NewBorrowBuilder(claim.Owner).
+	borrow := NewBorrowBuilder(owner).
 		WithSourceShares("borrowed", 1e9).
 		Build()
 
@@ -290,10 +253,9 @@ func (suite *SynchronizeHardBorrowRewardTests) TestRewardIsIncrementedWhenNewRew
 
 	// new reward is (new index - old index) * borrow amount for each borrowed denom
 	// The old index for `otherreward` isn't in the claim, so it's added starting at 0 for calculating the reward.
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
 	suite.Equal(
 		cs(c("reward", 1_000_001_000_000), c("otherreward", 1_000_001_000_000)).Add(originalReward...),
-		syncedClaim.Reward,
+		suite.getSourceClaimsReward(types.HardBorrowRewardSourceType, owner),
 	)
 }
 
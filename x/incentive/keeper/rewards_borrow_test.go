@@ -405,9 +405,10 @@ func (suite *BorrowRewardsTestSuite) TestInitializeHardBorrowRewards() {
 			err = suite.hardKeeper.Borrow(suite.ctx, userAddr, tc.args.borrow)
 			suite.Require().NoError(err)
 
-			claim, foundClaim := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
-			suite.Require().True(foundClaim)
-			suite.Require().Equal(tc.args.expectedClaimBorrowRewardIndexes, claim.BorrowRewardIndexes)
+			suite.Require().Equal(
+				tc.args.expectedClaimBorrowRewardIndexes,
+				getSourceClaimsIndexes(suite.ctx, suite.keeper, types.HardBorrowRewardSourceType, userAddr),
+			)
 		})
 	}
 }
@@ -604,11 +605,10 @@ func (suite *BorrowRewardsTestSuite) TestSynchronizeHardBorrowReward() {
 			suite.Require().NoError(err)
 
 			// Check that Hard hooks initialized a HardLiquidityProviderClaim
-			claim, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
+			claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.HardBorrowRewardSourceType, tc.args.borrow.Denom, userAddr)
 			suite.Require().True(found)
-			multiRewardIndex, _ := claim.BorrowRewardIndexes.GetRewardIndex(tc.args.borrow.Denom)
 			for _, expectedRewardIndex := range tc.args.expectedRewardIndexes {
-				currRewardIndex, found := multiRewardIndex.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+				currRewardIndex, found := claim.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 				suite.Require().True(found)
 				suite.Require().Equal(sdk.ZeroDec(), currRewardIndex.RewardFactor)
 			}
@@ -642,7 +642,7 @@ func (suite *BorrowRewardsTestSuite) TestSynchronizeHardBorrowReward() {
 			})
 
 			// Check that the global reward index's reward factor and user's claim have been updated as expected
-			claim, found = suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
+			claim, found = suite.keeper.GetSourceClaim(suite.ctx, types.HardBorrowRewardSourceType, tc.args.borrow.Denom, userAddr)
 			suite.Require().True(found)
 			globalRewardIndexes, foundGlobalRewardIndexes := suite.keeper.GetHardBorrowRewardIndexes(suite.ctx, tc.args.borrow.Denom)
 			if len(tc.args.rewardsPerSecond) > 0 {
@@ -654,9 +654,7 @@ func (suite *BorrowRewardsTestSuite) TestSynchronizeHardBorrowReward() {
 					suite.Require().Equal(expectedRewardIndex, globalRewardIndex)
 
 					// Check that the user's claim's reward index matches the corresponding global reward index
-					multiRewardIndex, found := claim.BorrowRewardIndexes.GetRewardIndex(tc.args.borrow.Denom)
-					suite.Require().True(found)
-					rewardIndex, found := multiRewardIndex.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+					rewardIndex, found := claim.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 					suite.Require().True(found)
 					suite.Require().Equal(expectedRewardIndex, rewardIndex)
 
@@ -756,7 +754,7 @@ func (suite *BorrowRewardsTestSuite) TestSynchronizeHardBorrowReward() {
 			// Check that the global reward index's reward factor and user's claim have been updated as expected
 			globalRewardIndexes, found = suite.keeper.GetHardBorrowRewardIndexes(suite.ctx, tc.args.borrow.Denom)
 			suite.Require().True(found)
-			claim, found = suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
+			claim, found = suite.keeper.GetSourceClaim(suite.ctx, types.HardBorrowRewardSourceType, tc.args.borrow.Denom, userAddr)
 			suite.Require().True(found)
 
 			for _, expectedRewardIndex := range tc.args.updatedExpectedRewardIndexes {
@@ -765,9 +763,7 @@ func (suite *BorrowRewardsTestSuite) TestSynchronizeHardBorrowReward() {
 				suite.Require().True(found)
 				suite.Require().Equal(expectedRewardIndex, globalRewardIndex)
 				// Check that the user's claim's reward index matches the corresponding global reward index
-				multiRewardIndex, found := claim.BorrowRewardIndexes.GetRewardIndex(tc.args.borrow.Denom)
-				suite.Require().True(found)
-				rewardIndex, found := multiRewardIndex.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+				rewardIndex, found := claim.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 				suite.Require().True(found)
 				suite.Require().Equal(expectedRewardIndex, rewardIndex)
 
@@ -781,7 +777,7 @@ func (suite *BorrowRewardsTestSuite) TestSynchronizeHardBorrowReward() {
 	}
 }
 
-func (suite *BorrowRewardsTestSuite) TestUpdateHardBorrowIndexDenoms() {
+func (suite *BorrowRewardsTestSuite) TestHardBorrowClaimsAfterBorrowModified() {
 	type withdrawModification struct {
 		coins sdk.Coins
 		repay bool
@@ -861,33 +857,33 @@ func (suite *BorrowRewardsTestSuite) TestUpdateHardBorrowIndexDenoms() {
 			},
 		},
 		{
-			"single reward denom: fully repaying a denom deletes the denom's supply reward index",
+			"single reward denom: fully repaying a denom keeps the denom's borrow claim",
 			args{
 				initialDeposit:            cs(c("bnb", 1000000000)),
 				firstBorrow:               cs(c("bnb", 100000000)),
 				modification:              withdrawModification{coins: cs(c("bnb", 1100000000)), repay: true},
 				rewardsPerSecond:          cs(c("hard", 122354)),
-				expectedBorrowIndexDenoms: []string{},
+				expectedBorrowIndexDenoms: []string{"bnb"},
 			},
 		},
 		{
-			"single reward denom: fully repaying a denom deletes only the denom's supply reward index",
+			"single reward denom: fully repaying a denom keeps the denom's borrow claim",
 			args{
 				initialDeposit:            cs(c("bnb", 1000000000)),
 				firstBorrow:               cs(c("bnb", 100000000), c("ukava", 10000000)),
 				modification:              withdrawModification{coins: cs(c("bnb", 1100000000)), repay: true},
 				rewardsPerSecond:          cs(c("hard", 122354)),
-				expectedBorrowIndexDenoms: []string{"ukava"},
+				expectedBorrowIndexDenoms: []string{"bnb", "ukava"},
 			},
 		},
 		{
-			"multiple reward denoms: fully repaying a denom deletes the denom's supply reward index",
+			"multiple reward denoms: fully repaying a denom keeps the denom's borrow claim",
 			args{
 				initialDeposit:            cs(c("bnb", 1000000000)),
 				firstBorrow:               cs(c("bnb", 100000000), c("ukava", 10000000)),
 				modification:              withdrawModification{coins: cs(c("bnb", 1100000000)), repay: true},
 				rewardsPerSecond:          cs(c("hard", 122354), c("ukava", 122354)),
-				expectedBorrowIndexDenoms: []string{"ukava"},
+				expectedBorrowIndexDenoms: []string{"bnb", "ukava"},
 			},
 		},
 	}
@@ -922,22 +918,20 @@ func (suite *BorrowRewardsTestSuite) TestUpdateHardBorrowIndexDenoms() {
 			suite.Require().NoError(err)
 
 			// Confirm that claim exists but no borrow reward indexes have been added
-			claimAfterDeposit, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
-			suite.Require().True(found)
-			suite.Require().Equal(0, len(claimAfterDeposit.BorrowRewardIndexes))
+			suite.Require().NotEmpty(getSourceClaimsIndexes(suite.ctx, suite.keeper, types.HardSupplyRewardSourceType, userAddr))
+			suite.Require().Empty(getSourceClaimsIndexes(suite.ctx, suite.keeper, types.HardBorrowRewardSourceType, userAddr))
 
 			// User borrows (first time)
 			err = suite.hardKeeper.Borrow(suite.ctx, userAddr, tc.args.firstBorrow)
 			suite.Require().NoError(err)
 
 			// Confirm that claim's borrow reward indexes have been updated
-			claimAfterFirstBorrow, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
-			suite.Require().True(found)
+			claimAfterFirstBorrowIndexes := getSourceClaimsIndexes(suite.ctx, suite.keeper, types.HardBorrowRewardSourceType, userAddr)
 			for _, coin := range tc.args.firstBorrow {
-				_, hasIndex := claimAfterFirstBorrow.HasBorrowRewardIndex(coin.Denom)
+				_, hasIndex := claimAfterFirstBorrowIndexes.GetRewardIndex(coin.Denom)
 				suite.Require().True(hasIndex)
 			}
-			suite.Require().True(len(claimAfterFirstBorrow.BorrowRewardIndexes) == len(tc.args.firstBorrow))
+			suite.Require().Len(claimAfterFirstBorrowIndexes, len(tc.args.firstBorrow))
 
 			// User modifies their Borrow by either repaying or borrowing more
 			if tc.args.modification.repay {
@@ -948,25 +942,17 @@ func (suite *BorrowRewardsTestSuite) TestUpdateHardBorrowIndexDenoms() {
 			suite.Require().NoError(err)
 
 			// Confirm that claim's borrow reward indexes contain expected values
-			claimAfterModification, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
-			suite.Require().True(found)
-			for _, coin := range tc.args.modification.coins {
-				_, hasIndex := claimAfterModification.HasBorrowRewardIndex(coin.Denom)
-				if tc.args.modification.repay {
-					// Only false if denom is repaid in full
-					if tc.args.modification.coins.AmountOf(coin.Denom).GTE(tc.args.firstBorrow.AmountOf(coin.Denom)) {
-						suite.Require().False(hasIndex)
-					}
-				} else {
-					suite.Require().True(hasIndex)
-				}
+			claimAfterModificationIndexes := getSourceClaimsIndexes(suite.ctx, suite.keeper, types.HardBorrowRewardSourceType, userAddr)
+			for _, denom := range tc.args.expectedBorrowIndexDenoms {
+				_, hasIndex := claimAfterModificationIndexes.GetRewardIndex(denom)
+				suite.Require().True(hasIndex)
 			}
-			suite.Require().True(len(claimAfterModification.BorrowRewardIndexes) == len(tc.args.expectedBorrowIndexDenoms))
+			suite.Require().Len(claimAfterModificationIndexes, len(tc.args.expectedBorrowIndexDenoms))
 		})
 	}
 }
 
-func (suite *BorrowRewardsTestSuite) TestSimulateHardBorrowRewardSynchronization() {
+func (suite *BorrowRewardsTestSuite) TestGetSynchronizedHardBorrowClaims() {
 	type args struct {
 		borrow                sdk.Coin
 		rewardsPerSecond      sdk.Coins
@@ -1039,22 +1025,21 @@ func (suite *BorrowRewardsTestSuite) TestSimulateHardBorrowRewardSynchronization
 			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime)
 
 			// Confirm that the user's claim hasn't been synced
-			claimPre, foundPre := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
+			claimPre, foundPre := suite.keeper.GetSourceClaim(suite.ctx, types.HardBorrowRewardSourceType, tc.args.borrow.Denom, userAddr)
 			suite.Require().True(foundPre)
-			multiRewardIndexPre, _ := claimPre.BorrowRewardIndexes.GetRewardIndex(tc.args.borrow.Denom)
 			for _, expectedRewardIndex := range tc.args.expectedRewardIndexes {
-				currRewardIndex, found := multiRewardIndexPre.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+				currRewardIndex, found := claimPre.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 				suite.Require().True(found)
 				suite.Require().Equal(sdk.ZeroDec(), currRewardIndex.RewardFactor)
 			}
 
 			// Check that the synced claim held in memory has properly simulated syncing
-			syncedClaim := suite.keeper.SimulateHardSynchronization(suite.ctx, claimPre)
+			syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.HardBorrowRewardSourceType, userAddr)
+			suite.Require().Len(syncedClaims, 1)
+			syncedClaim := syncedClaims[0]
 			for _, expectedRewardIndex := range tc.args.expectedRewardIndexes {
 				// Check that the user's claim's reward index matches the expected reward index
-				multiRewardIndex, found := syncedClaim.BorrowRewardIndexes.GetRewardIndex(tc.args.borrow.Denom)
-				suite.Require().True(found)
-				rewardIndex, found := multiRewardIndex.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+				rewardIndex, found := syncedClaim.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 				suite.Require().True(found)
 				suite.Require().Equal(expectedRewardIndex, rewardIndex)
 
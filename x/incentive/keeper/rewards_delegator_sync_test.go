@@ -13,7 +13,7 @@ import (
 // SynchronizeDelegatorRewardTests runs unit tests for the keeper.SynchronizeDelegatorReward method
 //
 // inputs
-// - claim in store if it exists (only claim.DelegatorRewardIndexes and claim.Reward)
+// - claim in store if it exists (only claim.RewardIndexes and claim.Reward)
 // - global index in store
 // - function args: delegator address, validator address, shouldIncludeValidator flag
 // - delegator's delegations and the corresponding validators
@@ -33,26 +33,34 @@ func (suite *SynchronizeDelegatorRewardTests) storeGlobalDelegatorFactor(multiRe
 	suite.keeper.SetDelegatorRewardIndexes(suite.ctx, types.BondDenom, multiRewardIndex.RewardIndexes)
 }
 
+func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreSetWhenClaimDoesNotExist() {
+	delegator := arbitraryAddress()
+
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, &fakeStakingKeeper{}, nil, nil, nil, nil)
+
+	globalIndexes := arbitraryDelegatorRewardIndexes
+	suite.storeGlobalDelegatorIndexes(globalIndexes)
+
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, delegator, nil, false)
+
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.DelegatorRewardSourceType, delegator))
+	suite.True(suite.getSourceClaimsReward(types.DelegatorRewardSourceType, delegator).IsZero())
+}
+
 func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreUnchangedWhenGlobalFactorUnchanged() {
 	delegator := arbitraryAddress()
 
 	stakingKeeper := &fakeStakingKeeper{} // use an empty staking keeper that returns no delegations
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
-	claim := types.DelegatorClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: delegator,
-		},
-		RewardIndexes: arbitraryDelegatorRewardIndexes,
-	}
-	suite.storeDelegatorClaim(claim)
+	claimIndexes := arbitraryDelegatorRewardIndexes
+	suite.storeSourceClaimsFromIndexes(types.DelegatorRewardSourceType, delegator, nil, claimIndexes)
 
-	suite.storeGlobalDelegatorFactor(claim.RewardIndexes)
+	suite.storeGlobalDelegatorFactor(claimIndexes)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, delegator, nil, false)
 
-	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
-	suite.Equal(claim.RewardIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(claimIndexes, suite.getSourceClaimsIndexes(types.DelegatorRewardSourceType, delegator))
 }
 
 func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreUpdatedWhenGlobalFactorIncreased() {
@@ -60,26 +68,20 @@ func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreUpdatedWhenGlob
 
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, &fakeStakingKeeper{}, nil, nil, nil, nil)
 
-	claim := types.DelegatorClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: delegator,
-		},
-		RewardIndexes: arbitraryDelegatorRewardIndexes,
-	}
-	suite.storeDelegatorClaim(claim)
+	claimIndexes := arbitraryDelegatorRewardIndexes
+	suite.storeSourceClaimsFromIndexes(types.DelegatorRewardSourceType, delegator, nil, claimIndexes)
 
-	rewardIndexes, _ := claim.RewardIndexes.Get(types.BondDenom)
+	rewardIndexes, _ := claimIndexes.Get(types.BondDenom)
 	globalIndexes := increaseRewardFactors(rewardIndexes)
 
 	// Update the claim object with the new global factor
-	bondIndex, _ := claim.RewardIndexes.GetRewardIndexIndex(types.BondDenom)
-	claim.RewardIndexes[bondIndex].RewardIndexes = globalIndexes
-	suite.storeGlobalDelegatorFactor(claim.RewardIndexes)
+	bondIndex, _ := claimIndexes.GetRewardIndexIndex(types.BondDenom)
+	claimIndexes[bondIndex].RewardIndexes = globalIndexes
+	suite.storeGlobalDelegatorFactor(claimIndexes)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, delegator, nil, false)
 
-	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes[bondIndex].RewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.DelegatorRewardSourceType, delegator)[bondIndex].RewardIndexes)
 }
 
 func (suite *SynchronizeDelegatorRewardTests) TestRewardIsUnchangedWhenGlobalFactorUnchanged() {
@@ -99,32 +101,25 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsUnchangedWhenGlobalFac
 	}
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
-	claim := types.DelegatorClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  delegator,
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{{
-			CollateralType: types.BondDenom,
-			RewardIndexes: types.RewardIndexes{
-				{
-					CollateralType: "hard", RewardFactor: d("0.1"),
-				},
-				{
-					CollateralType: "swp", RewardFactor: d("0.2"),
-				},
+	reward := arbitraryCoins()
+	claimIndexes := types.MultiRewardIndexes{{
+		CollateralType: types.BondDenom,
+		RewardIndexes: types.RewardIndexes{
+			{
+				CollateralType: "hard", RewardFactor: d("0.1"),
 			},
-		}},
-	}
-	suite.storeDelegatorClaim(claim)
-
-	suite.storeGlobalDelegatorFactor(claim.RewardIndexes)
+			{
+				CollateralType: "swp", RewardFactor: d("0.2"),
+			},
+		},
+	}}
+	suite.storeSourceClaimsFromIndexes(types.DelegatorRewardSourceType, delegator, reward, claimIndexes)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
+	suite.storeGlobalDelegatorFactor(claimIndexes)
 
-	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, delegator, nil, false)
 
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	suite.Equal(reward, suite.getSourceClaimsReward(types.DelegatorRewardSourceType, delegator))
 }
 
 func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenNewRewardAdded() {
@@ -144,14 +139,8 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenNewReward
 	}
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
-	claim := types.DelegatorClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  delegator,
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{},
-	}
-	suite.storeDelegatorClaim(claim)
+	reward := arbitraryCoins()
+	suite.storeSourceClaims(types.NewSourceClaim(types.DelegatorRewardSourceType, types.BondDenom, delegator, reward, nil))
 
 	newGlobalIndexes := types.MultiRewardIndexes{{
 		CollateralType: types.BondDenom,
@@ -166,14 +155,12 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenNewReward
 	}}
 	suite.storeGlobalDelegatorIndexes(newGlobalIndexes)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
-
-	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, delegator, nil, false)
 
-	suite.Equal(newGlobalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(newGlobalIndexes, suite.getSourceClaimsIndexes(types.DelegatorRewardSourceType, delegator))
 	suite.Equal(
-		cs(c("hard", 100), c("swp", 200)).Add(claim.Reward...),
-		syncedClaim.Reward,
+		cs(c("hard", 100), c("swp", 200)).Add(reward...),
+		suite.getSourceClaimsReward(types.DelegatorRewardSourceType, delegator),
 	)
 }
 
@@ -194,24 +181,19 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenGlobalFac
 	}
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
-	claim := types.DelegatorClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  delegator,
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{{
-			CollateralType: types.BondDenom,
-			RewardIndexes: types.RewardIndexes{
-				{
-					CollateralType: "hard", RewardFactor: d("0.1"),
-				},
-				{
-					CollateralType: "swp", RewardFactor: d("0.2"),
-				},
+	reward := arbitraryCoins()
+	claimIndexes := types.MultiRewardIndexes{{
+		CollateralType: types.BondDenom,
+		RewardIndexes: types.RewardIndexes{
+			{
+				CollateralType: "hard", RewardFactor: d("0.1"),
 			},
-		}},
-	}
-	suite.storeDelegatorClaim(claim)
+			{
+				CollateralType: "swp", RewardFactor: d("0.2"),
+			},
+		},
+	}}
+	suite.storeSourceClaimsFromIndexes(types.DelegatorRewardSourceType, delegator, reward, claimIndexes)
 
 	suite.storeGlobalDelegatorIndexes(
 		types.MultiRewardIndexes{
@@ -229,13 +211,11 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenGlobalFac
 		},
 	)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
-
-	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, delegator, nil, false)
 
 	suite.Equal(
-		cs(c("hard", 100), c("swp", 200)).Add(claim.Reward...),
-		syncedClaim.Reward,
+		cs(c("hard", 100), c("swp", 200)).Add(reward...),
+		suite.getSourceClaimsReward(types.DelegatorRewardSourceType, delegator),
 	)
 }
 
@@ -394,3 +374,14 @@ func (suite *SynchronizeDelegatorRewardTests) TestGetDelegatedWhenIncludingAVali
 		suite.keeper.GetTotalDelegated(suite.ctx, delegator, validatorAddresses[2], true),
 	)
 }
+
+// arbitraryDelegatorRewardIndexes contains only one reward index as there is only ever one bond denom
+var arbitraryDelegatorRewardIndexes = types.MultiRewardIndexes{
+	types.NewMultiRewardIndex(
+		types.BondDenom,
+		types.RewardIndexes{
+			types.NewRewardIndex("hard", d("0.2")),
+			types.NewRewardIndex("swp", d("0.2")),
+		},
+	),
+}
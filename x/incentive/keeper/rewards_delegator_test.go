@@ -259,9 +259,9 @@ func (suite *DelegatorRewardsTestSuite) TestSynchronizeDelegatorReward() {
 			suite.Require().Equal(valAcc.Tokens, tc.args.delegation.Amount.Add(selfDelegationCoins.Amount))
 
 			// Check that Staking hooks initialized a DelegatorClaim
-			claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+			claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 			suite.Require().True(found)
-			for _, rewardIndex := range claim.RewardIndexes[0].RewardIndexes {
+			for _, rewardIndex := range claim.RewardIndexes {
 				suite.Require().Equal(sdk.ZeroDec(), rewardIndex.RewardFactor)
 			}
 
@@ -294,9 +294,9 @@ func (suite *DelegatorRewardsTestSuite) TestSynchronizeDelegatorReward() {
 				suite.Require().Equal(tc.args.expectedRewardIndexes[i].RewardFactor, rewardFactor)
 			}
 
-			claim, found = suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+			claim, found = suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 			suite.Require().True(found)
-			for i, delegatorRewardIndex := range claim.RewardIndexes[0].RewardIndexes {
+			for i, delegatorRewardIndex := range claim.RewardIndexes {
 				suite.Require().Equal(tc.args.expectedRewardIndexes[i].RewardFactor, delegatorRewardIndex.RewardFactor)
 			}
 			suite.Require().Equal(tc.args.expectedRewards, claim.Reward)
@@ -304,7 +304,7 @@ func (suite *DelegatorRewardsTestSuite) TestSynchronizeDelegatorReward() {
 	}
 }
 
-func (suite *DelegatorRewardsTestSuite) TestSimulateDelegatorRewardSynchronization() {
+func (suite *DelegatorRewardsTestSuite) TestGetSynchronizedDelegatorClaims() {
 	type args struct {
 		delegation            sdk.Coin
 		rewardsPerSecond      sdk.Coins
@@ -377,9 +377,9 @@ func (suite *DelegatorRewardsTestSuite) TestSimulateDelegatorRewardSynchronizati
 			staking.EndBlocker(suite.ctx, suite.stakingKeeper)
 
 			// Check that Staking hooks initialized a DelegatorClaim
-			claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+			claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 			suite.Require().True(found)
-			for _, rewardIndex := range claim.RewardIndexes[0].RewardIndexes {
+			for _, rewardIndex := range claim.RewardIndexes {
 				suite.Require().Equal(sdk.ZeroDec(), rewardIndex.RewardFactor)
 			}
 
@@ -401,13 +401,13 @@ func (suite *DelegatorRewardsTestSuite) TestSimulateDelegatorRewardSynchronizati
 			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime)
 
 			// Check that the synced claim held in memory has properly simulated syncing
-			syncedClaim := suite.keeper.SimulateDelegatorSynchronization(suite.ctx, claim)
+			syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.DelegatorRewardSourceType, suite.addrs[0])
+			suite.Require().Len(syncedClaims, 1)
+			syncedClaim := syncedClaims[0]
 
 			for i, expectedRewardIndex := range tc.args.expectedRewardIndexes {
 				// Check that the user's claim's reward index matches the expected reward index
-				multiRewardIndex, found := syncedClaim.RewardIndexes.Get(types.BondDenom)
-				suite.Require().True(found)
-				suite.Require().Equal(expectedRewardIndex, multiRewardIndex[i])
+				suite.Require().Equal(expectedRewardIndex, syncedClaim.RewardIndexes[i])
 
 				// Check that the user's claim holds the expected amount of reward coins
 				suite.Require().Equal(
@@ -519,16 +519,15 @@ func (suite *DelegatorRewardsTestSuite) TestUnbondingValidatorSyncsClaim() {
 	// but don't start the next block as it will accumulate delegator rewards and we won't be able to tell if the user's reward was synced.
 
 	// Check that the user's claim has been synced. ie rewards added, index updated
-	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+	claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 	suite.Require().True(found)
 
 	rewardIndexes, found := suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
 	suite.Require().True(found)
 	globalIndex, found := rewardIndexes.Get(rewardsPerSecond[0].Denom)
 	suite.Require().True(found)
-	claimIndex, found := claim.RewardIndexes.GetRewardIndex(bondDenom)
-	suite.Require().True(found)
-	suite.Require().Equal(globalIndex, claimIndex.RewardIndexes[0].RewardFactor)
+	claimIndex := claim.RewardIndexes
+	suite.Require().Equal(globalIndex, claimIndex[0].RewardFactor)
 
 	suite.Require().Equal(
 		cs(c(rewardsPerSecond[0].Denom, 76471)),
@@ -542,18 +541,17 @@ func (suite *DelegatorRewardsTestSuite) TestUnbondingValidatorSyncsClaim() {
 	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0], nil, false)
 
 	// rewards are the same as before
-	laterClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+	laterClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 	suite.Require().True(found)
 	suite.Require().Equal(claim.Reward, laterClaim.Reward)
 
 	// claim index has been updated to latest global value
-	laterClaimIndex, found := laterClaim.RewardIndexes.GetRewardIndex(bondDenom)
-	suite.Require().True(found)
+	laterClaimIndex := laterClaim.RewardIndexes
 	rewardIndexes, found = suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
 	suite.Require().True(found)
 	globalIndex, found = rewardIndexes.Get(rewardsPerSecond[0].Denom)
 	suite.Require().True(found)
-	suite.Require().Equal(globalIndex, laterClaimIndex.RewardIndexes[0].RewardFactor)
+	suite.Require().Equal(globalIndex, laterClaimIndex[0].RewardFactor)
 }
 
 // given a user has a delegation to an unbonded validator, when the validator becomes bonded, the user starts accumulating rewards
@@ -613,16 +611,15 @@ func (suite *DelegatorRewardsTestSuite) TestBondingValidatorSyncsClaim() {
 	// but don't start the next block as it will accumulate delegator rewards and we won't be able to tell if the user's reward was synced.
 
 	// Check that the user's claim has been synced. ie rewards added, index updated
-	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+	claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 	suite.Require().True(found)
 
 	rewardIndexes, found := suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
 	suite.Require().True(found)
 	globalIndex, found := rewardIndexes.Get(rewardsPerSecond[0].Denom)
 	suite.Require().True(found)
-	claimIndex, found := claim.RewardIndexes.GetRewardIndex(bondDenom)
-	suite.Require().True(found)
-	suite.Require().Equal(globalIndex, claimIndex.RewardIndexes[0].RewardFactor)
+	claimIndex := claim.RewardIndexes
+	suite.Require().Equal(globalIndex, claimIndex[0].RewardFactor)
 
 	suite.Require().Equal(
 		sdk.Coins(nil),
@@ -636,18 +633,17 @@ func (suite *DelegatorRewardsTestSuite) TestBondingValidatorSyncsClaim() {
 	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0], nil, false)
 
 	// rewards are greater than before
-	laterClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+	laterClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 	suite.Require().True(found)
 	suite.Require().True(laterClaim.Reward.IsAllGT(claim.Reward))
 
 	// claim index has been updated to latest global value
-	laterClaimIndex, found := laterClaim.RewardIndexes.GetRewardIndex(bondDenom)
-	suite.Require().True(found)
+	laterClaimIndex := laterClaim.RewardIndexes
 	rewardIndexes, found = suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
 	suite.Require().True(found)
 	globalIndex, found = rewardIndexes.Get(rewardsPerSecond[0].Denom)
 	suite.Require().True(found)
-	suite.Require().Equal(globalIndex, laterClaimIndex.RewardIndexes[0].RewardFactor)
+	suite.Require().Equal(globalIndex, laterClaimIndex[0].RewardFactor)
 }
 
 // If a validator is slashed delegators should have their claims synced
@@ -690,13 +686,12 @@ func (suite *DelegatorRewardsTestSuite) TestSlashingValidatorSyncsClaim() {
 	suite.Require().NoError(err)
 
 	// Check that claim has been created with synced reward index but no reward coins
-	initialClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+	initialClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 	suite.True(found)
 	initialGlobalIndex, found := suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
 	suite.True(found)
-	initialClaimIndex, found := initialClaim.RewardIndexes.GetRewardIndex(bondDenom)
-	suite.True(found)
-	suite.Require().Equal(initialGlobalIndex, initialClaimIndex.RewardIndexes)
+	initialClaimIndex := initialClaim.RewardIndexes
+	suite.Require().Equal(initialGlobalIndex, initialClaimIndex)
 	suite.True(initialClaim.Reward.Empty()) // Initial claim should not have any rewards
 
 	// Start a new block to accumulate some delegation rewards for the user.
@@ -717,13 +712,12 @@ func (suite *DelegatorRewardsTestSuite) TestSlashingValidatorSyncsClaim() {
 	stakingKeeper.Slash(suite.ctx, consAddr, suite.ctx.BlockHeight(), 10, fraction)
 
 	// Check that the user's claim has been synced. ie rewards added, index updated
-	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+	claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 	suite.Require().True(found)
 	globalIndex, found := suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
 	suite.Require().True(found)
-	claimIndex, found := claim.RewardIndexes.GetRewardIndex(bondDenom)
-	suite.Require().True(found)
-	suite.Require().Equal(globalIndex, claimIndex.RewardIndexes)
+	claimIndex := claim.RewardIndexes
+	suite.Require().Equal(globalIndex, claimIndex)
 
 	// Check that rewards were added
 	suite.Require().Equal(
@@ -732,7 +726,7 @@ func (suite *DelegatorRewardsTestSuite) TestSlashingValidatorSyncsClaim() {
 	)
 
 	// Check that reward factor increased from initial value
-	suite.True(claimIndex.RewardIndexes[0].RewardFactor.GT(initialClaimIndex.RewardIndexes[0].RewardFactor))
+	suite.True(claimIndex[0].RewardFactor.GT(initialClaimIndex[0].RewardFactor))
 }
 
 // Given a delegation to a bonded validator, when a user redelegates everything to another (bonded) validator, the user's claim is synced
@@ -777,14 +771,13 @@ func (suite *DelegatorRewardsTestSuite) TestRedelegationSyncsClaim() {
 	suite.Require().NoError(err)
 
 	// Check that the user's claim has been synced. ie rewards added, index updated
-	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
+	claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.DelegatorRewardSourceType, types.BondDenom, suite.addrs[0])
 	suite.Require().True(found)
 
 	globalIndex, found := suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
 	suite.Require().True(found)
-	claimIndex, found := claim.RewardIndexes.GetRewardIndex(bondDenom)
-	suite.Require().True(found)
-	suite.Require().Equal(globalIndex, claimIndex.RewardIndexes)
+	claimIndex := claim.RewardIndexes
+	suite.Require().Equal(globalIndex, claimIndex)
 	suite.Require().Equal(
 		cs(c(rewardsPerSecond[0].Denom, 76471)),
 		claim.Reward,
@@ -3,7 +3,6 @@ package keeper_test
 import (
 	"testing"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/kava-labs/kava/x/incentive/types"
@@ -26,31 +25,27 @@ func TestInitializeEarnReward(t *testing.T) {
 }
 
 func (suite *InitializeEarnRewardTests) TestClaimAddedWhenClaimDoesNotExistAndNoRewards() {
-	// When a claim doesn't exist, and a user deposits to a non-rewarded pool;
+	// When a claim doesn't exist, and a user deposits to a non-rewarded vault;
 	// then a claim is added with no rewards and no indexes
 
 	vaultDenom := "usdx"
 
-	// no global indexes stored as this pool is not rewarded
+	// no global indexes stored as this vault is not rewarded
 
 	owner := arbitraryAddress()
 
 	suite.keeper.InitializeEarnReward(suite.ctx, vaultDenom, owner)
 
-	syncedClaim, found := suite.keeper.GetEarnClaim(suite.ctx, owner)
+	syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, vaultDenom, owner)
 	suite.True(found)
-	// A new claim should have empty indexes. It doesn't strictly need the vaultDenom either.
-	expectedIndexes := types.MultiRewardIndexes{{
-		CollateralType: vaultDenom,
-		RewardIndexes:  nil,
-	}}
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
+	// A new claim should have empty indexes.
+	suite.Empty(syncedClaim.RewardIndexes)
 	// a new claim should start with 0 rewards
-	suite.Equal(sdk.Coins(nil), syncedClaim.Reward)
+	suite.Empty(syncedClaim.Reward)
 }
 
 func (suite *InitializeEarnRewardTests) TestClaimAddedWhenClaimDoesNotExistAndRewardsExist() {
-	// When a claim doesn't exist, and a user deposits to a rewarded pool;
+	// When a claim doesn't exist, and a user deposits to a rewarded vault;
 	// then a claim is added with no rewards and indexes matching the global indexes
 
 	vaultDenom := "usdx"
@@ -72,19 +67,19 @@ func (suite *InitializeEarnRewardTests) TestClaimAddedWhenClaimDoesNotExistAndRe
 
 	suite.keeper.InitializeEarnReward(suite.ctx, vaultDenom, owner)
 
-	syncedClaim, found := suite.keeper.GetEarnClaim(suite.ctx, owner)
+	syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, vaultDenom, owner)
 	suite.True(found)
 	// a new claim should start with the current global indexes
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// a new claim should start with 0 rewards
-	suite.Equal(sdk.Coins(nil), syncedClaim.Reward)
+	suite.Empty(syncedClaim.Reward)
 }
 
 func (suite *InitializeEarnRewardTests) TestClaimUpdatedWhenClaimExistsAndNoRewards() {
-	// When a claim exists, and a user deposits to a new non-rewarded pool;
-	// then the claim's rewards don't change
+	// When a claim exists, and a user deposits to a new non-rewarded vault;
+	// then the claim in the preexisting vault doesn't change
 
-	preexistingvaultDenom := "preexisting"
+	preexistingVaultDenom := "preexisting"
 	preexistingIndexes := types.RewardIndexes{
 		{
 			CollateralType: "rewarddenom",
@@ -92,104 +87,52 @@ func (suite *InitializeEarnRewardTests) TestClaimUpdatedWhenClaimExistsAndNoRewa
 		},
 	}
 
-	newVaultDenom := "btcb:usdx"
+	newVaultDenom := "usdx"
 
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: preexistingvaultDenom,
-				RewardIndexes:  preexistingIndexes,
-			},
-		},
-	}
-	suite.storeEarnClaim(claim)
+	claim := types.NewSourceClaim(types.EarnRewardSourceType, preexistingVaultDenom, arbitraryAddress(), arbitraryCoins(), preexistingIndexes)
+	suite.storeSourceClaims(claim)
 
-	// no global indexes stored as the new pool is not rewarded
+	// no global indexes stored as the new vault is not rewarded
 
 	suite.keeper.InitializeEarnReward(suite.ctx, newVaultDenom, claim.Owner)
 
-	syncedClaim, _ := suite.keeper.GetEarnClaim(suite.ctx, claim.Owner)
-	// The preexisting indexes shouldn't be changed. It doesn't strictly need the new vaultDenom either.
-	expectedIndexes := types.MultiRewardIndexes{
-		{
-			CollateralType: preexistingvaultDenom,
-			RewardIndexes:  preexistingIndexes,
-		},
-		{
-			CollateralType: newVaultDenom,
-			RewardIndexes:  nil,
-		},
-	}
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
-	// init should never alter the rewards
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	// The preexisting claim shouldn't be changed.
+	preexistingClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, preexistingVaultDenom, claim.Owner)
+	suite.Equal(claim, preexistingClaim)
+
+	syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, newVaultDenom, claim.Owner)
+	suite.True(found)
+	suite.Empty(syncedClaim.RewardIndexes)
+	suite.Empty(syncedClaim.Reward)
 }
 
 func (suite *InitializeEarnRewardTests) TestClaimUpdatedWhenClaimExistsAndRewardsExist() {
-	// When a claim exists, and a user deposits to a new rewarded pool;
+	// When a claim exists in a vault, and a user deposits to it again after withdrawing their shares;
 	// then the claim's rewards don't change and the indexes are updated to match the global indexes
 
-	preexistingvaultDenom := "preexisting"
-	preexistingIndexes := types.RewardIndexes{
+	vaultDenom := "usdx"
+	claimIndexes := types.RewardIndexes{
 		{
 			CollateralType: "rewarddenom",
 			RewardFactor:   d("1000.001"),
 		},
 	}
 
-	newVaultDenom := "btcb:usdx"
-	newIndexes := types.RewardIndexes{
-		{
-			CollateralType: "otherrewarddenom",
-			RewardFactor:   d("1000.001"),
-		},
-	}
-
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: preexistingvaultDenom,
-				RewardIndexes:  preexistingIndexes,
-			},
-		},
-	}
-	suite.storeEarnClaim(claim)
+	claim := types.NewSourceClaim(types.EarnRewardSourceType, vaultDenom, arbitraryAddress(), arbitraryCoins(), claimIndexes)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
-			CollateralType: preexistingvaultDenom,
-			RewardIndexes:  increaseRewardFactors(preexistingIndexes),
-		},
-		{
-			CollateralType: newVaultDenom,
-			RewardIndexes:  newIndexes,
+			CollateralType: vaultDenom,
+			RewardIndexes:  increaseRewardFactors(claimIndexes),
 		},
 	}
 	suite.storeGlobalEarnIndexes(globalIndexes)
 
-	suite.keeper.InitializeEarnReward(suite.ctx, newVaultDenom, claim.Owner)
+	suite.keeper.InitializeEarnReward(suite.ctx, vaultDenom, claim.Owner)
 
-	syncedClaim, _ := suite.keeper.GetEarnClaim(suite.ctx, claim.Owner)
-	// only the indexes for the new pool should be updated
-	expectedIndexes := types.MultiRewardIndexes{
-		{
-			CollateralType: preexistingvaultDenom,
-			RewardIndexes:  preexistingIndexes,
-		},
-		{
-			CollateralType: newVaultDenom,
-			RewardIndexes:  newIndexes,
-		},
-	}
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, vaultDenom, claim.Owner)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// init should never alter the rewards
 	suite.Equal(claim.Reward, syncedClaim.Reward)
 }
@@ -33,26 +33,21 @@ func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenGlobalIndexesHaveIn
 	// The user earns rewards for the time passed, and the claim indexes are updated
 
 	originalReward := arbitraryCoins()
-	vaultDenom := "cats"
-
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	vaultDenom := "usdx"
+
+	claim := types.NewSourceClaim(
+		types.EarnRewardSourceType,
+		vaultDenom,
+		arbitraryAddress(),
+		originalReward,
+		types.RewardIndexes{
 			{
-				CollateralType: vaultDenom,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeEarnClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -71,9 +66,9 @@ func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenGlobalIndexesHaveIn
 
 	suite.keeper.SynchronizeEarnReward(suite.ctx, vaultDenom, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetEarnClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, vaultDenom, claim.Owner)
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * user shares
 	suite.Equal(
 		cs(c("rewarddenom", 1_000_001_000_000)).Add(originalReward...),
@@ -84,64 +79,47 @@ func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenGlobalIndexesHaveIn
 func (suite *SynchronizeEarnRewardTests) TestClaimUnchangedWhenGlobalIndexesUnchanged() {
 	// It should be safe to call SynchronizeEarnReward multiple times
 
-	vaultDenom := "cats"
-	unchangingIndexes := types.MultiRewardIndexes{
+	vaultDenom := "usdx"
+	unchangingIndexes := types.RewardIndexes{
 		{
-			CollateralType: vaultDenom,
-			RewardIndexes: types.RewardIndexes{
-				{
-					CollateralType: "rewarddenom",
-					RewardFactor:   d("1000.001"),
-				},
-			},
+			CollateralType: "rewarddenom",
+			RewardFactor:   d("1000.001"),
 		},
 	}
 
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: unchangingIndexes,
-	}
-	suite.storeEarnClaim(claim)
+	claim := types.NewSourceClaim(types.EarnRewardSourceType, vaultDenom, arbitraryAddress(), arbitraryCoins(), unchangingIndexes)
+	suite.storeSourceClaims(claim)
 
-	suite.storeGlobalEarnIndexes(unchangingIndexes)
+	suite.storeGlobalEarnIndexes(types.MultiRewardIndexes{types.NewMultiRewardIndex(vaultDenom, unchangingIndexes)})
 
 	userShares := d("1000000000")
 
 	suite.keeper.SynchronizeEarnReward(suite.ctx, vaultDenom, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetEarnClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, vaultDenom, claim.Owner)
 	// claim should have the same rewards and indexes as before
 	suite.Equal(claim, syncedClaim)
 }
 
-func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenNewRewardAdded() {
+func (suite *SynchronizeEarnRewardTests) TestClaimCreatedWhenNewRewardAdded() {
 	// When a new reward is added (via gov) for a vault the user has already deposited to, and the claim is synced;
-	// Then the user earns rewards for the time since the reward was added, and the indexes are added to the claim.
+	// Then the user earns rewards for the time since the reward was added, and their claims in other vaults are unchanged.
 
-	originalReward := arbitraryCoins()
 	newlyRewardVaultDenom := "newlyRewardedVault"
 
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.EarnRewardSourceType,
+		"currentlyRewardedVault",
+		arbitraryAddress(),
+		arbitraryCoins(),
+		types.RewardIndexes{
 			{
-				CollateralType: "currentlyRewardedVault",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "reward",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeEarnClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -171,40 +149,33 @@ func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenNewRewardAdded() {
 
 	suite.keeper.SynchronizeEarnReward(suite.ctx, newlyRewardVaultDenom, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetEarnClaim(suite.ctx, claim.Owner)
-	// the new indexes should be added to the claim, but the old ones should be unchanged
-	newlyRewrdedIndexes, _ := globalIndexes.Get(newlyRewardVaultDenom)
-	expectedIndexes := claim.RewardIndexes.With(newlyRewardVaultDenom, newlyRewrdedIndexes)
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
+	// the claim in the other vault should be unchanged
+	otherClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, claim.SourceID, claim.Owner)
+	suite.Equal(claim, otherClaim)
+
+	syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, newlyRewardVaultDenom, claim.Owner)
+	suite.Require().True(found)
+	suite.Equal(globalIndexes[1].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * shares for the synced vault
-	// The old index for `newlyrewarded` isn't in the claim, so it's added starting at 0 for calculating the reward.
-	suite.Equal(
-		cs(c("otherreward", 1_000_001_000_000)).Add(originalReward...),
-		syncedClaim.Reward,
-	)
+	// The claim had no old index, so it starts at 0 for calculating the reward.
+	suite.Equal(cs(c("otherreward", 1_000_001_000_000)), syncedClaim.Reward)
 }
 
 func (suite *SynchronizeEarnRewardTests) TestClaimUnchangedWhenNoReward() {
 	// When a vault is not rewarded but the user has deposited to that vault, and the claim is synced;
 	// Then the claim should be the same.
 
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: nonEmptyMultiRewardIndexes,
-	}
-	suite.storeEarnClaim(claim)
-
 	vaultDenom := "nonRewardVault"
+	claim := types.NewSourceClaim(types.EarnRewardSourceType, vaultDenom, arbitraryAddress(), arbitraryCoins(), nonEmptyRewardIndexes)
+	suite.storeSourceClaims(claim)
+
 	// No global indexes stored as this vault is not rewarded
 
 	userShares := d("1000000000")
 
 	suite.keeper.SynchronizeEarnReward(suite.ctx, vaultDenom, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetEarnClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, vaultDenom, claim.Owner)
 	suite.Equal(claim, syncedClaim)
 }
 
@@ -213,26 +184,21 @@ func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenNewRewardDenomAdded
 	// Then the user earns rewards for the time since the reward was added, and the new indexes are added.
 
 	originalReward := arbitraryCoins()
-	vaultDenom := "cats"
-
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	vaultDenom := "usdx"
+
+	claim := types.NewSourceClaim(
+		types.EarnRewardSourceType,
+		vaultDenom,
+		arbitraryAddress(),
+		originalReward,
+		types.RewardIndexes{
 			{
-				CollateralType: vaultDenom,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "reward",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeEarnClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -257,9 +223,9 @@ func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenNewRewardDenomAdded
 
 	suite.keeper.SynchronizeEarnReward(suite.ctx, vaultDenom, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetEarnClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, vaultDenom, claim.Owner)
 	// indexes should have the new reward denom added
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * shares
 	// The old index for `otherreward` isn't in the claim, so it's added starting at 0 for calculating the reward.
 	suite.Equal(
@@ -273,26 +239,21 @@ func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenGlobalIndexesIncrea
 	// When the claim is synced, but the user has no shares
 	// The user earns no rewards for the time passed, but the claim indexes are updated
 
-	vaultDenom := "cats"
+	vaultDenom := "usdx"
 
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.EarnRewardSourceType,
+		vaultDenom,
+		arbitraryAddress(),
+		arbitraryCoins(),
+		types.RewardIndexes{
 			{
-				CollateralType: vaultDenom,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeEarnClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -311,47 +272,37 @@ func (suite *SynchronizeEarnRewardTests) TestClaimUpdatedWhenGlobalIndexesIncrea
 
 	suite.keeper.SynchronizeEarnReward(suite.ctx, vaultDenom, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetEarnClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.EarnRewardSourceType, vaultDenom, claim.Owner)
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// reward is unchanged
 	suite.Equal(claim.Reward, syncedClaim.Reward)
 }
 
-func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaim_ClaimUnchangedWhenNoGlobalIndexes() {
+func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaims_ClaimUnchangedWhenNoGlobalIndexes() {
 	vaultDenom_1 := "usdx"
 	owner := arbitraryAddress()
 
 	earnKeeper := newFakeEarnKeeper().
-		addDeposit(owner, earntypes.NewVaultShare("usdx", d("1000000000")))
+		addDeposit(owner, earntypes.NewVaultShare(vaultDenom_1, d("1000000000")))
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, earnKeeper)
 
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  owner,
-			Reward: nil,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: vaultDenom_1,
-				RewardIndexes:  nil, // this state only happens because Init stores empty indexes
-			},
-		},
-	}
-	suite.storeEarnClaim(claim)
+	// this state only happens because Init stores empty indexes
+	claim := types.NewSourceClaim(types.EarnRewardSourceType, vaultDenom_1, owner, nil, nil)
+	suite.storeSourceClaims(claim)
 
 	// no global indexes for any vault
 
-	syncedClaim, f := suite.keeper.GetSynchronizedEarnClaim(suite.ctx, claim.Owner)
-	suite.True(f)
+	syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.EarnRewardSourceType, claim.Owner)
+	suite.Require().Len(syncedClaims, 1)
 
 	// indexes are unchanged
-	suite.Equal(claim.RewardIndexes, syncedClaim.RewardIndexes)
+	suite.Empty(syncedClaims[0].RewardIndexes)
 	// reward is unchanged
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	suite.Empty(syncedClaims[0].Reward)
 }
 
-func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMissingIndexAndHasNoSourceShares() {
+func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaims_ClaimUpdatedWhenHasNoSourceShares() {
 	vaultDenom_1 := "usdx"
 	vaultDenom_2 := "ukava"
 	owner := arbitraryAddress()
@@ -359,24 +310,19 @@ func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 	// owner has no shares in any vault
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, newFakeEarnKeeper())
 
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  owner,
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.EarnRewardSourceType,
+		vaultDenom_1,
+		owner,
+		arbitraryCoins(),
+		types.RewardIndexes{
 			{
-				CollateralType: vaultDenom_1,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom1",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom1",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeEarnClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -400,49 +346,45 @@ func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 	}
 	suite.storeGlobalEarnIndexes(globalIndexes)
 
-	syncedClaim, f := suite.keeper.GetSynchronizedEarnClaim(suite.ctx, claim.Owner)
-	suite.True(f)
+	syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.EarnRewardSourceType, claim.Owner)
 
+	// only the existing claim is returned, as the owner has no shares in the other vault
+	suite.Require().Len(syncedClaims, 1)
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaims[0].RewardIndexes)
 	// reward is unchanged
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	suite.Equal(claim.Reward, syncedClaims[0].Reward)
 }
 
-func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMissingIndexButHasSourceShares() {
-	VaultDenom_1 := "usdx"
-	VaultDenom_2 := "ukava"
+func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaims_ClaimsUpdatedWhenHasSourceShares() {
+	vaultDenom_1 := "ukava"
+	vaultDenom_2 := "usdx"
 	owner := arbitraryAddress()
 
 	earnKeeper := newFakeEarnKeeper().
-		addVault(VaultDenom_1, earntypes.NewVaultShare(VaultDenom_1, d("1000000000"))).
-		addVault(VaultDenom_2, earntypes.NewVaultShare(VaultDenom_2, d("1000000000"))).
-		addDeposit(owner, earntypes.NewVaultShare(VaultDenom_1, d("1000000000"))).
-		addDeposit(owner, earntypes.NewVaultShare(VaultDenom_2, d("1000000000")))
+		addVault(vaultDenom_1, earntypes.NewVaultShare(vaultDenom_1, d("1000000000"))).
+		addVault(vaultDenom_2, earntypes.NewVaultShare(vaultDenom_2, d("1000000000"))).
+		addDeposit(owner, earntypes.NewVaultShare(vaultDenom_1, d("1000000000"))).
+		addDeposit(owner, earntypes.NewVaultShare(vaultDenom_2, d("1000000000")))
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, earnKeeper)
 
-	claim := types.EarnClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  owner,
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.EarnRewardSourceType,
+		vaultDenom_1,
+		owner,
+		arbitraryCoins(),
+		types.RewardIndexes{
 			{
-				CollateralType: VaultDenom_1,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom1",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom1",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeEarnClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
-			CollateralType: VaultDenom_1,
+			CollateralType: vaultDenom_1,
 			RewardIndexes: types.RewardIndexes{
 				{
 					CollateralType: "rewarddenom1",
@@ -451,7 +393,7 @@ func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 			},
 		},
 		{
-			CollateralType: VaultDenom_2,
+			CollateralType: vaultDenom_2,
 			RewardIndexes: types.RewardIndexes{
 				{
 					CollateralType: "rewarddenom2",
@@ -462,12 +404,13 @@ func (suite *SynchronizeEarnRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 	}
 	suite.storeGlobalEarnIndexes(globalIndexes)
 
-	syncedClaim, f := suite.keeper.GetSynchronizedEarnClaim(suite.ctx, claim.Owner)
-	suite.True(f)
+	syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.EarnRewardSourceType, claim.Owner)
+	suite.Require().Len(syncedClaims, 2)
 
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
-	// reward is incremented
-	expectedReward := cs(c("rewarddenom1", 1_000_001_000_000), c("rewarddenom2", 2_000_002_000_000))
-	suite.Equal(claim.Reward.Add(expectedReward...), syncedClaim.Reward)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaims[0].RewardIndexes)
+	suite.Equal(globalIndexes[1].RewardIndexes, syncedClaims[1].RewardIndexes)
+	// rewards are incremented
+	suite.Equal(claim.Reward.Add(c("rewarddenom1", 1_000_001_000_000)), syncedClaims[0].Reward)
+	suite.Equal(cs(c("rewarddenom2", 2_000_002_000_000)), syncedClaims[1].Reward)
 }
@@ -28,24 +28,19 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenGlobalIndexesHav
 	originalReward := arbitraryCoins()
 	denom := "test"
 
-	claim := types.SavingsClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.SavingsRewardSourceType,
+		denom,
+		arbitraryAddress(),
+		originalReward,
+		types.RewardIndexes{
 			{
-				CollateralType: denom,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSavingsClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -64,9 +59,9 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenGlobalIndexesHav
 	deposit := savingstypes.NewDeposit(claim.Owner, sdk.NewCoins(sdk.NewCoin(denom, userShares)))
 	suite.keeper.SynchronizeSavingsReward(suite.ctx, deposit, []string{})
 
-	syncedClaim, _ := suite.keeper.GetSavingsClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SavingsRewardSourceType, denom, claim.Owner)
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * user shares
 	suite.Equal(
 		cs(c("rewarddenom", 1_000_001_000_000)).Add(originalReward...),
@@ -76,64 +71,51 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenGlobalIndexesHav
 
 func (suite *SynchronizeSavingsRewardTests) TestClaimUnchangedWhenGlobalIndexesUnchanged() {
 	denom := "test"
-	unchangingIndexes := types.MultiRewardIndexes{
+	unchangingIndexes := types.RewardIndexes{
 		{
-			CollateralType: denom,
-			RewardIndexes: types.RewardIndexes{
-				{
-					CollateralType: "rewarddenom",
-					RewardFactor:   d("1000.001"),
-				},
-			},
+			CollateralType: "rewarddenom",
+			RewardFactor:   d("1000.001"),
 		},
 	}
 
-	claim := types.SavingsClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: unchangingIndexes,
-	}
-	suite.storeSavingsClaim(claim)
+	claim := types.NewSourceClaim(types.SavingsRewardSourceType, denom, arbitraryAddress(), arbitraryCoins(), unchangingIndexes)
+	suite.storeSourceClaims(claim)
 
-	suite.storeGlobalSavingsIndexes(unchangingIndexes)
+	suite.storeGlobalSavingsIndexes(types.MultiRewardIndexes{types.NewMultiRewardIndex(denom, unchangingIndexes)})
 
 	userShares := i(1e9)
 	deposit := savingstypes.NewDeposit(claim.Owner, sdk.NewCoins(sdk.NewCoin(denom, userShares)))
 	suite.keeper.SynchronizeSavingsReward(suite.ctx, deposit, []string{})
 
-	syncedClaim, _ := suite.keeper.GetSavingsClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SavingsRewardSourceType, denom, claim.Owner)
 	// claim should have the same rewards and indexes as before
 	suite.Equal(claim, syncedClaim)
 }
 
-func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardAdded() {
-	originalReward := arbitraryCoins()
-	newlyRewardedDenom := "newlyRewardedDenom"
+func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewDenomDeposited() {
+	// When a user deposits a new denom, and the claims are synced;
+	// Then the claim of the existing deposit earns rewards, and the claim of the new denom starts at the global indexes.
 
-	claim := types.SavingsClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	originalReward := arbitraryCoins()
+	newlyDepositedDenom := "newlyDepositedDenom"
+
+	claim := types.NewSourceClaim(
+		types.SavingsRewardSourceType,
+		"currentlyDepositedDenom",
+		arbitraryAddress(),
+		originalReward,
+		types.RewardIndexes{
 			{
-				CollateralType: "currentlyRewardedDenom",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "reward",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSavingsClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
-			CollateralType: "currentlyRewardedDenom",
+			CollateralType: "currentlyDepositedDenom",
 			RewardIndexes: types.RewardIndexes{
 				{
 					CollateralType: "reward",
@@ -142,13 +124,11 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardAdded()
 			},
 		},
 		{
-			CollateralType: newlyRewardedDenom,
+			CollateralType: newlyDepositedDenom,
 			RewardIndexes: types.RewardIndexes{
 				{
 					CollateralType: "otherreward",
-					// Indexes start at 0 when the reward is added by gov,
-					// so this represents the syncing happening some time later.
-					RewardFactor: d("1000.001"),
+					RewardFactor:   d("1000.001"),
 				},
 			},
 		},
@@ -158,22 +138,26 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardAdded()
 	userShares := i(1e9)
 	deposit := savingstypes.NewDeposit(claim.Owner,
 		sdk.NewCoins(
-			sdk.NewCoin("currentlyRewardedDenom", userShares),
-			sdk.NewCoin(newlyRewardedDenom, userShares),
+			sdk.NewCoin("currentlyDepositedDenom", userShares),
+			sdk.NewCoin(newlyDepositedDenom, userShares),
 		),
 	)
 
-	suite.keeper.SynchronizeSavingsReward(suite.ctx, deposit, []string{newlyRewardedDenom})
+	suite.keeper.SynchronizeSavingsReward(suite.ctx, deposit, []string{newlyDepositedDenom})
 
-	syncedClaim, _ := suite.keeper.GetSavingsClaim(suite.ctx, claim.Owner)
-	// the new indexes should be added to the claim and the old ones should be updated
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SavingsRewardSourceType, claim.SourceID, claim.Owner)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * shares for the synced deposit
-	// The old index for `newlyrewarded` isn't in the claim, so it's added starting at 0 for calculating the reward.
 	suite.Equal(
 		cs(c("reward", 1_000_001_000_000)).Add(originalReward...),
 		syncedClaim.Reward,
 	)
+
+	// the newly deposited denom had no shares to earn rewards on
+	newClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.SavingsRewardSourceType, newlyDepositedDenom, claim.Owner)
+	suite.Require().True(found)
+	suite.Equal(globalIndexes[1].RewardIndexes, newClaim.RewardIndexes)
+	suite.Empty(newClaim.Reward)
 }
 
 func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardDenomAdded() {
@@ -183,24 +167,19 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardDenomAd
 	originalReward := arbitraryCoins()
 	denom := "base"
 
-	claim := types.SavingsClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.SavingsRewardSourceType,
+		denom,
+		arbitraryAddress(),
+		originalReward,
+		types.RewardIndexes{
 			{
-				CollateralType: denom,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "reward",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSavingsClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -225,9 +204,9 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardDenomAd
 	deposit := savingstypes.NewDeposit(claim.Owner, sdk.NewCoins(sdk.NewCoin(denom, userShares)))
 	suite.keeper.SynchronizeSavingsReward(suite.ctx, deposit, []string{})
 
-	syncedClaim, _ := suite.keeper.GetSavingsClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SavingsRewardSourceType, denom, claim.Owner)
 	// indexes should have the new reward denom added
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * shares
 	// The old index for `otherreward` isn't in the claim, so it's added starting at 0 for calculating the reward.
 	suite.Equal(
@@ -235,11 +214,3 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardDenomAd
 		syncedClaim.Reward,
 	)
 }
-
-func getDenoms(coins sdk.Coins) []string {
-	denoms := []string{}
-	for _, coin := range coins {
-		denoms = append(denoms, coin.Denom)
-	}
-	return denoms
-}
@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// AccumulateSourceRewards calculates new rewards to distribute this block for a source of a registered reward source
+// type and updates the global indexes to reflect this. Reward periods of source types that are not registered are
+// ignored.
+// The provided rewardPeriod must be valid to avoid panics in calculating time durations.
+func (k Keeper) AccumulateSourceRewards(ctx sdk.Context, sourceType string, rewardPeriod types.MultiRewardPeriod) {
+	if _, found := k.GetRewardSource(sourceType); !found {
+		return
+	}
+
+	k.accumulateSourceRewards(
+		ctx,
+		sourceType,
+		rewardPeriod.CollateralType,
+		rewardPeriod.Start,
+		rewardPeriod.End,
+		sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...),
+	)
+}
+
+// getSourceTotalShares returns the shares the rewards of a source are distributed over, which are the source's total
+// shares plus the shares added by owners' boosts. Sources of unregistered source types have no shares.
+func (k Keeper) getSourceTotalShares(ctx sdk.Context, sourceType, sourceID string) sdk.Dec {
+	source, found := k.GetRewardSource(sourceType)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return source.GetTotalShares(ctx, sourceID).Add(k.GetTotalBoostedShares(ctx, sourceType, sourceID))
+}
+
+// accumulateSourceRewards accumulates rewards for a source of any source type, including the built in ones.
+func (k Keeper) accumulateSourceRewards(
+	ctx sdk.Context,
+	sourceType string,
+	sourceID string,
+	periodStart time.Time,
+	periodEnd time.Time,
+	periodRewardsPerSecond sdk.DecCoins,
+) {
+	previousAccrualTime, found := k.GetSourceRewardAccrualTime(ctx, sourceType, sourceID)
+	if !found {
+		previousAccrualTime = ctx.BlockTime()
+	}
+
+	indexes, found := k.GetSourceRewardIndexes(ctx, sourceType, sourceID)
+	if !found {
+		indexes = types.RewardIndexes{}
+	}
+
+	acc := types.NewAccumulator(previousAccrualTime, indexes)
+
+	acc.AccumulateDecCoins(
+		periodStart,
+		periodEnd,
+		periodRewardsPerSecond,
+		k.getSourceTotalShares(ctx, sourceType, sourceID),
+		ctx.BlockTime(),
+	)
+
+	k.SetSourceRewardAccrualTime(ctx, sourceType, sourceID, acc.PreviousAccumulationTime)
+	if len(acc.Indexes) > 0 {
+		// the store panics when setting empty or nil indexes
+		k.SetSourceRewardIndexes(ctx, sourceType, sourceID, acc.Indexes)
+	}
+}
+
+// SynchronizeSourceReward adds any rewards accumulated since an owner's claim in a source of a registered source type
+// was last synchronized, and updates its reward indexes and the shares their boost adds to the source. Modules
+// providing a reward source must call it before an owner's shares in a source change.
+func (k Keeper) SynchronizeSourceReward(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress) {
+	source, found := k.GetRewardSource(sourceType)
+	if !found {
+		// Without the registered source the shares are unknown. Rewards accumulated up to when the source stopped
+		// being registered are kept in the claim.
+		return
+	}
+	k.synchronizeSourceRewardWithShares(ctx, sourceType, sourceID, owner, source.GetShares(ctx, sourceID, owner))
+}
+
+// synchronizeSourceRewardWithShares adds the rewards accumulated on an owner's shares since their claim in a source was
+// last synchronized, and updates its reward indexes. It creates the claim if the owner does not have one.
+// The shares are passed in for hooks that run when the source's own store does not hold the owner's shares before
+// they change.
+func (k Keeper) synchronizeSourceRewardWithShares(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress, shares sdk.Dec) {
+	claim, found := k.GetSourceClaim(ctx, sourceType, sourceID, owner)
+	if !found {
+		// Owners without a claim have not had their shares changed since the source started accumulating rewards,
+		// so they are owed rewards from the start, which is an empty set of indexes.
+		claim = types.NewSourceClaim(sourceType, sourceID, owner, sdk.Coins{}, types.RewardIndexes{})
+	}
+	k.SetSourceClaim(ctx, k.synchronizeSourceClaim(ctx, claim, shares))
+	k.updateBoostedShares(ctx, sourceType, sourceID, owner)
+}
+
+// initializeSourceReward creates or updates an owner's claim in a source such that no new rewards are accrued, but any
+// existing rewards are not lost. It must be called after an owner's shares in a source are created, as they had no
+// shares to accrue rewards on since their claim was last synchronized.
+func (k Keeper) initializeSourceReward(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress) {
+	claim, found := k.GetSourceClaim(ctx, sourceType, sourceID, owner)
+	if !found {
+		claim = types.NewSourceClaim(sourceType, sourceID, owner, sdk.Coins{}, types.RewardIndexes{})
+	}
+
+	globalRewardIndexes, found := k.GetSourceRewardIndexes(ctx, sourceType, sourceID)
+	if !found {
+		globalRewardIndexes = types.RewardIndexes{}
+	}
+	claim.RewardIndexes = globalRewardIndexes
+
+	k.SetSourceClaim(ctx, claim)
+	k.updateBoostedShares(ctx, sourceType, sourceID, owner)
+}
+
+// synchronizeSourceReward returns an owner's claim in a source of a registered source type updated with any rewards
+// accumulated since it was last synchronized. It returns false if the owner has no claim and no shares in the source.
+func (k Keeper) synchronizeSourceReward(ctx sdk.Context, sourceType, sourceID string, owner sdk.AccAddress) (types.SourceClaim, bool) {
+	claim, claimFound := k.GetSourceClaim(ctx, sourceType, sourceID, owner)
+
+	source, found := k.GetRewardSource(sourceType)
+	if !found {
+		// Without the registered source the shares are unknown. Rewards accumulated up to when the source stopped
+		// being registered are kept in the claim.
+		return claim, claimFound
+	}
+
+	shares := source.GetShares(ctx, sourceID, owner)
+	if !claimFound {
+		if !shares.IsPositive() {
+			return types.SourceClaim{}, false
+		}
+		// Owners without a claim have not had their shares changed since the source started accumulating rewards,
+		// so they are owed rewards from the start, which is an empty set of indexes.
+		claim = types.NewSourceClaim(sourceType, sourceID, owner, sdk.Coins{}, types.RewardIndexes{})
+	}
+
+	return k.synchronizeSourceClaim(ctx, claim, shares), true
+}
+
+// synchronizeSourceClaim returns a claim updated with the rewards accumulated on the owner's shares, plus the shares
+// added by their boost, since it was last synchronized. It returns the claim unchanged if the source has not started
+// accumulating rewards.
+//
+// The boost is recomputed from the owner's shares, so owners are not rewarded for a boost on shares they no longer
+// hold. It is capped at the boosted shares stored for the owner, as those are what the source's rewards were shared
+// over since the claim was last synchronized.
+func (k Keeper) synchronizeSourceClaim(ctx sdk.Context, claim types.SourceClaim, shares sdk.Dec) types.SourceClaim {
+	globalRewardIndexes, found := k.GetSourceRewardIndexes(ctx, claim.SourceType, claim.SourceID)
+	if !found {
+		// The global indexes are only not found if
+		// - the source has not started accumulating rewards yet (either there is no reward specified in params, or the reward start time hasn't been hit)
+		// - OR they were wrongly deleted from state (indexes should never be removed while unsynced claims exist)
+		// Skipping will avoid storing unnecessary indexes in the claim for non rewarded sources.
+		// And in the event the global indexes are wrongly deleted, it will avoid this function panicking when calculating rewards.
+		return claim
+	}
+
+	boostedShares := k.GetBoostedShares(ctx, claim.SourceType, claim.SourceID, claim.Owner)
+	if boostedShares.IsPositive() {
+		boostedShares = sdk.MinDec(boostedShares, k.calculateBoostedShares(ctx, claim.SourceType, claim.SourceID, claim.Owner, shares))
+	}
+	shares = shares.Add(boostedShares)
+	newRewards, err := k.CalculateRewards(claim.RewardIndexes, globalRewardIndexes, shares)
+	if err != nil {
+		// Global reward factors should never decrease, as it would lead to a negative update to claim.Rewards.
+		// This panics if a global reward factor decreases or disappears between the old and new indexes.
+		panic(fmt.Sprintf("corrupted global reward indexes found: %v", err))
+	}
+
+	claim.Reward = claim.Reward.Add(newRewards...)
+	claim.RewardIndexes = globalRewardIndexes
+
+	return claim
+}
+
+// GetSynchronizedSourceClaims returns an owner's claims in all sources of a source type that they have a claim in or
+// that have accumulated rewards, synchronized with the global reward indexes.
+func (k Keeper) GetSynchronizedSourceClaims(ctx sdk.Context, sourceType string, owner sdk.AccAddress) types.SourceClaims {
+	seen := make(map[string]bool)
+	var sourceIDs []string
+	add := func(sourceID string) {
+		if !seen[sourceID] {
+			seen[sourceID] = true
+			sourceIDs = append(sourceIDs, sourceID)
+		}
+	}
+	k.IterateSourceClaimsByOwner(ctx, sourceType, owner, func(c types.SourceClaim) bool {
+		add(c.SourceID)
+		return false
+	})
+	k.IterateSourceRewardIndexes(ctx, sourceType, func(sourceID string, _ types.RewardIndexes) bool {
+		add(sourceID)
+		return false
+	})
+	sort.Strings(sourceIDs)
+
+	claims := types.SourceClaims{}
+	for _, sourceID := range sourceIDs {
+		claim, found := k.synchronizeSourceReward(ctx, sourceType, sourceID, owner)
+		if found {
+			claims = append(claims, claim)
+		}
+	}
+	return claims
+}
+
+// ClaimSourceReward pays out an owner's rewards of a denom from all sources of a registered source type to a receiver
+// account, using the selected multiplier.
+func (k Keeper) ClaimSourceReward(
+	ctx sdk.Context, sourceType string, owner, receiver sdk.AccAddress, denom string, multiplierName string,
+) error {
+	if _, found := k.GetRewardSource(sourceType); !found {
+		return errorsmod.Wrapf(types.ErrInvalidClaimType, "reward source type '%s' is not registered", sourceType)
+	}
+	return k.ClaimReward(ctx, sourceType, owner, receiver, denom, multiplierName)
+}
+
+// ClaimReward pays out an owner's rewards of a denom from all sources of the source types claimed under a claim type to
+// a receiver account.
+// Rewards are removed from the claims and paid out according to the multiplier, which reduces the reward amount in
+// exchange for shorter vesting times.
+func (k Keeper) ClaimReward(
+	ctx sdk.Context, claimType string, owner, receiver sdk.AccAddress, denom string, multiplierName string,
+) error {
+	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
+	}
+
+	claimEnd := k.GetClaimEnd(ctx)
+
+	if ctx.BlockTime().After(claimEnd) {
+		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
+	}
+
+	var syncedClaims types.SourceClaims
+	for _, sourceType := range types.GetClaimSourceTypes(claimType) {
+		syncedClaims = append(syncedClaims, k.GetSynchronizedSourceClaims(ctx, sourceType, owner)...)
+	}
+	if len(syncedClaims) == 0 {
+		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+	}
+
+	amt := sdk.ZeroInt()
+	for _, claim := range syncedClaims {
+		amt = amt.Add(claim.Reward.AmountOf(denom))
+	}
+
+	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
+	if rewardCoins.IsZero() {
+		return types.ErrZeroClaim
+	}
+	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
+
+	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
+	if err != nil {
+		return err
+	}
+
+	// remove claimed coins (NOT reward coins)
+	for _, claim := range syncedClaims {
+		if claimAmt := claim.Reward.AmountOf(denom); claimAmt.IsPositive() {
+			claim.Reward = claim.Reward.Sub(sdk.NewCoin(denom, claimAmt))
+		}
+		k.SetSourceClaim(ctx, claim)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimType, claimType),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+const vaultSourceType = "vault"
+
+// fakeRewardSource is a stub reward source that stores owner shares in memory.
+type fakeRewardSource struct {
+	shares map[string]map[string]sdk.Dec
+}
+
+var _ types.RewardSource = newFakeRewardSource()
+
+func newFakeRewardSource() *fakeRewardSource {
+	return &fakeRewardSource{shares: map[string]map[string]sdk.Dec{}}
+}
+
+func (source *fakeRewardSource) setShares(sourceID string, owner sdk.AccAddress, shares sdk.Dec) {
+	if source.shares[sourceID] == nil {
+		source.shares[sourceID] = map[string]sdk.Dec{}
+	}
+	source.shares[sourceID][owner.String()] = shares
+}
+
+func (source *fakeRewardSource) GetTotalShares(_ sdk.Context, sourceID string) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, shares := range source.shares[sourceID] {
+		total = total.Add(shares)
+	}
+	return total
+}
+
+func (source *fakeRewardSource) GetShares(_ sdk.Context, sourceID string, owner sdk.AccAddress) sdk.Dec {
+	shares, found := source.shares[sourceID][owner.String()]
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return shares
+}
+
+// setupVaultSource sets up an app with hard rewards for a vault source, where the user owns a quarter of the shares.
+func (suite *HandlerTestSuite) setupVaultSource(userAddr, receiverAddr sdk.AccAddress) *fakeRewardSource {
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, nil).
+		WithSimpleAccount(receiverAddr, nil)
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSourceRewardPeriod(vaultSourceType, "pool-1", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	source := newFakeRewardSource()
+	source.setShares("pool-1", userAddr, d("1"))
+	source.setShares("pool-1", suite.addrs[2], d("3"))
+	suite.App.GetIncentiveKeeper().RegisterRewardSource(vaultSourceType, source)
+
+	return source
+}
+
+func (suite *HandlerTestSuite) TestClaimSourceReward() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+	suite.setupVaultSource(userAddr, receiverAddr)
+
+	// accumulate some rewards
+	suite.NextBlockAfter(8 * time.Second)
+
+	ik := suite.App.GetIncentiveKeeper()
+	claims := ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, userAddr)
+	suite.Require().Len(claims, 1)
+	suite.Equal(cs(c("hard", 2e6)), claims[0].Reward)
+
+	err := ik.ClaimSourceReward(suite.Ctx, vaultSourceType, userAddr, receiverAddr, "hard", "large")
+	suite.Require().NoError(err)
+	suite.BalanceEquals(receiverAddr, cs(c("hard", 2e6)))
+
+	// Claiming again in the same block fails as there are no rewards left
+	err = ik.ClaimSourceReward(suite.Ctx, vaultSourceType, userAddr, receiverAddr, "hard", "large")
+	suite.ErrorIs(err, types.ErrZeroClaim)
+
+	// Unregistered source types cannot be claimed
+	err = ik.ClaimSourceReward(suite.Ctx, "pool", userAddr, receiverAddr, "hard", "large")
+	suite.ErrorIs(err, types.ErrInvalidClaimType)
+}
+
+func (suite *HandlerTestSuite) TestSynchronizeSourceRewardBeforeSharesChange() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+	source := suite.setupVaultSource(userAddr, receiverAddr)
+
+	suite.NextBlockAfter(8 * time.Second)
+
+	// The source synchronizes the claim before the user's shares change
+	ik := suite.App.GetIncentiveKeeper()
+	ik.SynchronizeSourceReward(suite.Ctx, vaultSourceType, "pool-1", userAddr)
+	source.setShares("pool-1", userAddr, d("3"))
+
+	claim, found := ik.GetSourceClaim(suite.Ctx, vaultSourceType, "pool-1", userAddr)
+	suite.Require().True(found)
+	suite.Equal(cs(c("hard", 2e6)), claim.Reward)
+
+	// The user now owns half of the shares
+	suite.NextBlockAfter(8 * time.Second)
+
+	claims := ik.GetSynchronizedSourceClaims(suite.Ctx, vaultSourceType, userAddr)
+	suite.Require().Len(claims, 1)
+	suite.Equal(cs(c("hard", 6e6)), claims[0].Reward)
+}
+
+func (suite *HandlerTestSuite) TestClaimAllRewardsIncludesRegisteredSources() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+	suite.setupVaultSource(userAddr, receiverAddr)
+
+	suite.NextBlockAfter(8 * time.Second)
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.GetIncentiveKeeper())
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		receiverAddr.String(),
+		types.Selections{types.NewSelection("hard", "large")},
+	)
+
+	res, err := msgServer.ClaimAllRewards(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+
+	suite.Equal(types.ClaimedRewards{
+		types.NewClaimedReward(vaultSourceType, cs(c("hard", 2e6))),
+	}, res.Claimed)
+	suite.BalanceEquals(receiverAddr, cs(c("hard", 2e6)))
+}
@@ -18,28 +18,21 @@ func TestInitializeHardSupplyReward(t *testing.T) {
 }
 
 func (suite *InitializeHardSupplyRewardTests) TestClaimIndexesAreSetWhenClaimExists() {
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		// Indexes should always be empty when initialize is called.
-		// If initialize is called then the user must have repaid their deposit positions,
-		// which means UpdateHardSupplyIndexDenoms was called and should have remove indexes.
-		SupplyRewardIndexes: types.MultiRewardIndexes{},
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	// Initialize overwrites any stored indexes with the current global indexes.
+	claimIndexes := types.MultiRewardIndexes{}
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, nil, claimIndexes)
 
 	globalIndexes := nonEmptyMultiRewardIndexes
 	suite.storeGlobalSupplyIndexes(globalIndexes)
 
-	deposit := NewHardDepositBuilder(claim.Owner).
+	deposit := NewHardDepositBuilder(owner).
 		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
 		Build()
 
 	suite.keeper.InitializeHardSupplyReward(suite.ctx, deposit)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.SupplyRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
 }
 
 func (suite *InitializeHardSupplyRewardTests) TestClaimIndexesAreSetWhenClaimDoesNotExist() {
@@ -53,9 +46,7 @@ func (suite *InitializeHardSupplyRewardTests) TestClaimIndexesAreSetWhenClaimDoe
 
 	suite.keeper.InitializeHardSupplyReward(suite.ctx, deposit)
 
-	syncedClaim, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, owner)
-	suite.True(found)
-	suite.Equal(globalIndexes, syncedClaim.SupplyRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
 }
 
 func (suite *InitializeHardSupplyRewardTests) TestClaimIndexesAreSetEmptyForMissingIndexes() {
@@ -73,6 +64,43 @@ func (suite *InitializeHardSupplyRewardTests) TestClaimIndexesAreSetEmptyForMiss
 
 	suite.keeper.InitializeHardSupplyReward(suite.ctx, deposit)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, owner)
-	suite.Equal(expectedIndexes, syncedClaim.SupplyRewardIndexes)
+	suite.Equal(expectedIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
+}
+
+func (suite *InitializeHardSupplyRewardTests) TestClaimsAreKeptForDenomsNoLongerSupplied() {
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	reward := arbitraryCoins()
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, reward, claimIndexes)
+	suite.storeGlobalSupplyIndexes(claimIndexes)
+
+	// remove one denom from the indexes already in the deposit
+	deposit := NewHardDepositBuilder(owner).
+		WithArbitrarySourceShares(extractCollateralTypes(claimIndexes[1:])...).
+		Build()
+
+	suite.keeper.InitializeHardSupplyReward(suite.ctx, deposit)
+
+	// the claim of the removed denom is kept so its rewards can still be claimed
+	suite.Equal(claimIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
+	suite.Equal(reward, suite.getSourceClaimsReward(types.HardSupplyRewardSourceType, owner))
+}
+
+func (suite *InitializeHardSupplyRewardTests) TestClaimIndexesAreAddedForNewlySuppliedDenoms() {
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	reward := arbitraryCoins()
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, reward, claimIndexes)
+
+	globalIndexes := appendUniqueMultiRewardIndex(claimIndexes)
+	suite.storeGlobalSupplyIndexes(globalIndexes)
+
+	deposit := NewHardDepositBuilder(owner).
+		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
+		Build()
+
+	suite.keeper.InitializeHardSupplyReward(suite.ctx, deposit)
+
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
+	suite.Equal(reward, suite.getSourceClaimsReward(types.HardSupplyRewardSourceType, owner))
 }
@@ -23,24 +23,19 @@ func TestSynchronizeHardSupplyReward(t *testing.T) {
 func (suite *SynchronizeHardSupplyRewardTests) TestClaimIndexesAreUpdatedWhenGlobalIndexesHaveIncreased() {
 	// This is the normal case
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		SupplyRewardIndexes: nonEmptyMultiRewardIndexes,
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, nil, claimIndexes)
 
 	globalIndexes := increaseAllRewardFactors(nonEmptyMultiRewardIndexes)
 	suite.storeGlobalSupplyIndexes(globalIndexes)
-	deposit := NewHardDepositBuilder(claim.Owner).
-		WithArbitrarySourceShares(extractCollateralTypes(claim.SupplyRewardIndexes)...).
+	deposit := NewHardDepositBuilder(owner).
+		WithArbitrarySourceShares(extractCollateralTypes(claimIndexes)...).
 		Build()
 
 	suite.keeper.SynchronizeHardSupplyReward(suite.ctx, deposit)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.SupplyRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
 }
 
 func (suite *SynchronizeHardSupplyRewardTests) TestClaimIndexesAreUnchangedWhenGlobalIndexesUnchanged() {
@@ -48,74 +43,59 @@ func (suite *SynchronizeHardSupplyRewardTests) TestClaimIndexesAreUnchangedWhenG
 
 	unchangingIndexes := nonEmptyMultiRewardIndexes
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		SupplyRewardIndexes: unchangingIndexes,
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := unchangingIndexes
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, nil, claimIndexes)
 
 	suite.storeGlobalSupplyIndexes(unchangingIndexes)
 
-	deposit := NewHardDepositBuilder(claim.Owner).
+	deposit := NewHardDepositBuilder(owner).
 		WithArbitrarySourceShares(extractCollateralTypes(unchangingIndexes)...).
 		Build()
 
 	suite.keeper.SynchronizeHardSupplyReward(suite.ctx, deposit)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(unchangingIndexes, syncedClaim.SupplyRewardIndexes)
+	suite.Equal(unchangingIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
 }
 
 func (suite *SynchronizeHardSupplyRewardTests) TestClaimIndexesAreUpdatedWhenNewRewardAdded() {
 	// When a new reward is added (via gov) for a hard deposit denom the user has already deposited, and the claim is synced;
 	// Then the new reward's index should be added to the claim.
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		SupplyRewardIndexes: nonEmptyMultiRewardIndexes,
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, nil, claimIndexes)
 
 	globalIndexes := appendUniqueMultiRewardIndex(nonEmptyMultiRewardIndexes)
 	suite.storeGlobalSupplyIndexes(globalIndexes)
 
-	deposit := NewHardDepositBuilder(claim.Owner).
+	deposit := NewHardDepositBuilder(owner).
 		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
 		Build()
 
 	suite.keeper.SynchronizeHardSupplyReward(suite.ctx, deposit)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.SupplyRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
 }
 
 func (suite *SynchronizeHardSupplyRewardTests) TestClaimIndexesAreUpdatedWhenNewRewardDenomAdded() {
 	// When a new reward coin is added (via gov) to an already rewarded deposit denom (that the user has already deposited), and the claim is synced;
 	// Then the new reward coin's index should be added to the claim.
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner: arbitraryAddress(),
-		},
-		SupplyRewardIndexes: nonEmptyMultiRewardIndexes,
-	}
-	suite.storeHardClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := nonEmptyMultiRewardIndexes
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, nil, claimIndexes)
 
 	globalIndexes := appendUniqueRewardIndexToFirstItem(nonEmptyMultiRewardIndexes)
 	suite.storeGlobalSupplyIndexes(globalIndexes)
 
-	deposit := NewHardDepositBuilder(claim.Owner).
+	deposit := NewHardDepositBuilder(owner).
 		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
 		Build()
 
 	suite.keeper.SynchronizeHardSupplyReward(suite.ctx, deposit)
 
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
-	suite.Equal(globalIndexes, syncedClaim.SupplyRewardIndexes)
+	suite.Equal(globalIndexes, suite.getSourceClaimsIndexes(types.HardSupplyRewardSourceType, owner))
 }
 
 func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenGlobalIndexesHaveIncreased() {
@@ -126,24 +106,19 @@ func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenGlobal
 
 	originalReward := arbitraryCoins()
 
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		SupplyRewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: "depositdenom",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom",
-						RewardFactor:   d("1000.001"),
-					},
+	owner := arbitraryAddress()
+	claimIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: "depositdenom",
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "rewarddenom",
+					RewardFactor:   d("1000.001"),
 				},
 			},
 		},
 	}
-	suite.storeHardClaim(claim)
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, originalReward, claimIndexes)
 
 	suite.storeGlobalSupplyIndexes(types.MultiRewardIndexes{
 		{
@@ -157,17 +132,16 @@ func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenGlobal
 		},
 	})
 
-	deposit := NewHardDepositBuilder(claim.Owner).
+	deposit := NewHardDepositBuilder(owner).
 		WithSourceShares("depositdenom", 1e9).
 		Build()
 
 	suite.keeper.SynchronizeHardSupplyReward(suite.ctx, deposit)
 
 	// new reward is (new index - old index) * deposit amount
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
 	suite.Equal(
 		cs(c("rewarddenom", 1_000_001_000_000)).Add(originalReward...),
-		syncedClaim.Reward,
+		suite.getSourceClaimsReward(types.HardSupplyRewardSourceType, owner),
 	)
 }
 
@@ -176,24 +150,19 @@ func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenNewRew
 	// Then the user earns rewards for the time since the reward was added
 
 	originalReward := arbitraryCoins()
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		SupplyRewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: "rewarded",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
+	owner := arbitraryAddress()
+	claimIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: "rewarded",
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "reward",
+					RewardFactor:   d("1000.001"),
 				},
 			},
 		},
 	}
-	suite.storeHardClaim(claim)
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, originalReward, claimIndexes)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -219,7 +188,7 @@ func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenNewRew
 	}
 	suite.storeGlobalSupplyIndexes(globalIndexes)
 
-	deposit := NewHardDepositBuilder(claim.Owner).
+	deposit := NewHardDepositBuilder(owner).
 		WithSourceShares("rewarded", 1e9).
 		WithSourceShares("newlyrewarded", 1e9).
 		Build()
@@ -228,10 +197,9 @@ func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenNewRew
 
 	// new reward is (new index - old index) * deposit amount for each deposited denom
 	// The old index for `newlyrewarded` isn't in the claim, so it's added starting at 0 for calculating the reward.
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
 	suite.Equal(
 		cs(c("otherreward", 1_000_001_000_000), c("reward", 1_000_001_000_000)).Add(originalReward...),
-		syncedClaim.Reward,
+		suite.getSourceClaimsReward(types.HardSupplyRewardSourceType, owner),
 	)
 }
 
@@ -240,24 +208,19 @@ func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenNewRew
 	// Then the user earns rewards for the time since the reward was added
 
 	originalReward := arbitraryCoins()
-	claim := types.HardLiquidityProviderClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		SupplyRewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: "deposited",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
+	owner := arbitraryAddress()
+	claimIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: "deposited",
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "reward",
+					RewardFactor:   d("1000.001"),
 				},
 			},
 		},
 	}
-	suite.storeHardClaim(claim)
+	suite.storeSourceClaimsFromIndexes(types.HardSupplyRewardSourceType, owner, originalReward, claimIndexes)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -278,7 +241,7 @@ func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenNewRew
 	}
 	suite.storeGlobalSupplyIndexes(globalIndexes)
 
-	deposit := NewHardDepositBuilder(claim.Owner).
+	deposit := NewHardDepositBuilder(owner).
 		WithSourceShares("deposited", 1e9).
 		Build()
 
@@ -286,10 +249,9 @@ func (suite *SynchronizeHardSupplyRewardTests) TestRewardIsIncrementedWhenNewRew
 
 	// new reward is (new index - old index) * deposit amount for each deposited denom
 	// The old index for `otherreward` isn't in the claim, so it's added starting at 0 for calculating the reward.
-	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
 	suite.Equal(
 		cs(c("reward", 1_000_001_000_000), c("otherreward", 1_000_001_000_000)).Add(originalReward...),
-		syncedClaim.Reward,
+		suite.getSourceClaimsReward(types.HardSupplyRewardSourceType, owner),
 	)
 }
 
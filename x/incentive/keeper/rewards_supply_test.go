@@ -402,9 +402,10 @@ func (suite *SupplyRewardsTestSuite) TestInitializeHardSupplyRewards() {
 			err := suite.hardKeeper.Deposit(suite.ctx, userAddr, tc.args.deposit)
 			suite.Require().NoError(err)
 
-			claim, foundClaim := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
-			suite.Require().True(foundClaim)
-			suite.Require().Equal(tc.args.expectedClaimSupplyRewardIndexes, claim.SupplyRewardIndexes)
+			suite.Require().Equal(
+				tc.args.expectedClaimSupplyRewardIndexes,
+				getSourceClaimsIndexes(suite.ctx, suite.keeper, types.HardSupplyRewardSourceType, userAddr),
+			)
 		})
 	}
 }
@@ -598,11 +599,10 @@ func (suite *SupplyRewardsTestSuite) TestSynchronizeHardSupplyReward() {
 			suite.Require().NoError(err)
 
 			// Check that Hard hooks initialized a HardLiquidityProviderClaim with 0 reward indexes
-			claim, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
+			claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.HardSupplyRewardSourceType, tc.args.deposit.Denom, userAddr)
 			suite.Require().True(found)
-			multiRewardIndex, _ := claim.SupplyRewardIndexes.GetRewardIndex(tc.args.deposit.Denom)
 			for _, expectedRewardIndex := range tc.args.expectedRewardIndexes {
-				currRewardIndex, found := multiRewardIndex.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+				currRewardIndex, found := claim.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 				suite.Require().True(found)
 				suite.Require().Equal(sdk.ZeroDec(), currRewardIndex.RewardFactor)
 			}
@@ -636,7 +636,7 @@ func (suite *SupplyRewardsTestSuite) TestSynchronizeHardSupplyReward() {
 			})
 
 			// Check that the global reward index's reward factor and user's claim have been updated as expected
-			claim, found = suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
+			claim, found = suite.keeper.GetSourceClaim(suite.ctx, types.HardSupplyRewardSourceType, tc.args.deposit.Denom, userAddr)
 			suite.Require().True(found)
 			globalRewardIndexes, foundGlobalRewardIndexes := suite.keeper.GetHardSupplyRewardIndexes(suite.ctx, tc.args.deposit.Denom)
 			if len(tc.args.rewardsPerSecond) > 0 {
@@ -648,9 +648,7 @@ func (suite *SupplyRewardsTestSuite) TestSynchronizeHardSupplyReward() {
 					suite.Require().Equal(expectedRewardIndex, globalRewardIndex)
 
 					// Check that the user's claim's reward index matches the corresponding global reward index
-					multiRewardIndex, found := claim.SupplyRewardIndexes.GetRewardIndex(tc.args.deposit.Denom)
-					suite.Require().True(found)
-					rewardIndex, found := multiRewardIndex.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+					rewardIndex, found := claim.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 					suite.Require().True(found)
 					suite.Require().Equal(expectedRewardIndex, rewardIndex)
 
@@ -749,7 +747,7 @@ func (suite *SupplyRewardsTestSuite) TestSynchronizeHardSupplyReward() {
 			// Check that the global reward index's reward factor and user's claim have been updated as expected
 			globalRewardIndexes, found = suite.keeper.GetHardSupplyRewardIndexes(suite.ctx, tc.args.deposit.Denom)
 			suite.Require().True(found)
-			claim, found = suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
+			claim, found = suite.keeper.GetSourceClaim(suite.ctx, types.HardSupplyRewardSourceType, tc.args.deposit.Denom, userAddr)
 			suite.Require().True(found)
 			for _, expectedRewardIndex := range tc.args.updatedExpectedRewardIndexes {
 				// Check that global reward index has been updated as expected
@@ -758,9 +756,7 @@ func (suite *SupplyRewardsTestSuite) TestSynchronizeHardSupplyReward() {
 				suite.Require().Equal(expectedRewardIndex, globalRewardIndex)
 
 				// Check that the user's claim's reward index matches the corresponding global reward index
-				multiRewardIndex, found := claim.SupplyRewardIndexes.GetRewardIndex(tc.args.deposit.Denom)
-				suite.Require().True(found)
-				rewardIndex, found := multiRewardIndex.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+				rewardIndex, found := claim.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 				suite.Require().True(found)
 				suite.Require().Equal(expectedRewardIndex, rewardIndex)
 
@@ -774,7 +770,7 @@ func (suite *SupplyRewardsTestSuite) TestSynchronizeHardSupplyReward() {
 	}
 }
 
-func (suite *SupplyRewardsTestSuite) TestUpdateHardSupplyIndexDenoms() {
+func (suite *SupplyRewardsTestSuite) TestHardSupplyClaimsAfterDepositModified() {
 	type depositModification struct {
 		coins    sdk.Coins
 		withdraw bool
@@ -847,30 +843,30 @@ func (suite *SupplyRewardsTestSuite) TestUpdateHardSupplyIndexDenoms() {
 			},
 		},
 		{
-			"single reward denom: fully withdrawing a denom deletes the denom's supply reward index",
+			"single reward denom: fully withdrawing a denom keeps the denom's supply claim",
 			args{
 				firstDeposit:              cs(c("bnb", 1000000000)),
 				modification:              depositModification{coins: cs(c("bnb", 1100000000)), withdraw: true},
 				rewardsPerSecond:          cs(c("hard", 122354)),
-				expectedSupplyIndexDenoms: []string{},
+				expectedSupplyIndexDenoms: []string{"bnb"},
 			},
 		},
 		{
-			"single reward denom: fully withdrawing a denom deletes only the denom's supply reward index",
+			"single reward denom: fully withdrawing a denom keeps the denom's supply claim",
 			args{
 				firstDeposit:              cs(c("bnb", 1000000000), c("ukava", 100000000)),
 				modification:              depositModification{coins: cs(c("bnb", 1100000000)), withdraw: true},
 				rewardsPerSecond:          cs(c("hard", 122354)),
-				expectedSupplyIndexDenoms: []string{"ukava"},
+				expectedSupplyIndexDenoms: []string{"bnb", "ukava"},
 			},
 		},
 		{
-			"multiple reward denoms: fully repaying a denom deletes the denom's supply reward index",
+			"multiple reward denoms: fully repaying a denom keeps the denom's supply claim",
 			args{
 				firstDeposit:              cs(c("bnb", 1000000000)),
 				modification:              depositModification{coins: cs(c("bnb", 1100000000)), withdraw: true},
 				rewardsPerSecond:          cs(c("hard", 122354), c("ukava", 122354)),
-				expectedSupplyIndexDenoms: []string{},
+				expectedSupplyIndexDenoms: []string{"bnb"},
 			},
 		},
 	}
@@ -895,13 +891,12 @@ func (suite *SupplyRewardsTestSuite) TestUpdateHardSupplyIndexDenoms() {
 			suite.Require().NoError(err)
 
 			// Confirm that a claim was created and populated with the correct supply indexes
-			claimAfterFirstDeposit, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
-			suite.Require().True(found)
+			claimAfterFirstDepositIndexes := getSourceClaimsIndexes(suite.ctx, suite.keeper, types.HardSupplyRewardSourceType, userAddr)
 			for _, coin := range tc.args.firstDeposit {
-				_, hasIndex := claimAfterFirstDeposit.HasSupplyRewardIndex(coin.Denom)
+				_, hasIndex := claimAfterFirstDepositIndexes.GetRewardIndex(coin.Denom)
 				suite.Require().True(hasIndex)
 			}
-			suite.Require().True(len(claimAfterFirstDeposit.SupplyRewardIndexes) == len(tc.args.firstDeposit))
+			suite.Require().Len(claimAfterFirstDepositIndexes, len(tc.args.firstDeposit))
 
 			// User modifies their Deposit by withdrawing or depositing more
 			if tc.args.modification.withdraw {
@@ -912,18 +907,17 @@ func (suite *SupplyRewardsTestSuite) TestUpdateHardSupplyIndexDenoms() {
 			suite.Require().NoError(err)
 
 			// Confirm that the claim contains all expected supply indexes
-			claimAfterModification, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
-			suite.Require().True(found)
+			claimAfterModificationIndexes := getSourceClaimsIndexes(suite.ctx, suite.keeper, types.HardSupplyRewardSourceType, userAddr)
 			for _, denom := range tc.args.expectedSupplyIndexDenoms {
-				_, hasIndex := claimAfterModification.HasSupplyRewardIndex(denom)
+				_, hasIndex := claimAfterModificationIndexes.GetRewardIndex(denom)
 				suite.Require().True(hasIndex)
 			}
-			suite.Require().True(len(claimAfterModification.SupplyRewardIndexes) == len(tc.args.expectedSupplyIndexDenoms))
+			suite.Require().Len(claimAfterModificationIndexes, len(tc.args.expectedSupplyIndexDenoms))
 		})
 	}
 }
 
-func (suite *SupplyRewardsTestSuite) TestSimulateHardSupplyRewardSynchronization() {
+func (suite *SupplyRewardsTestSuite) TestGetSynchronizedHardSupplyClaims() {
 	type args struct {
 		deposit               sdk.Coin
 		rewardsPerSecond      sdk.Coins
@@ -996,22 +990,21 @@ func (suite *SupplyRewardsTestSuite) TestSimulateHardSupplyRewardSynchronization
 			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime)
 
 			// Confirm that the user's claim hasn't been synced
-			claimPre, foundPre := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, userAddr)
+			claimPre, foundPre := suite.keeper.GetSourceClaim(suite.ctx, types.HardSupplyRewardSourceType, tc.args.deposit.Denom, userAddr)
 			suite.Require().True(foundPre)
-			multiRewardIndexPre, _ := claimPre.SupplyRewardIndexes.GetRewardIndex(tc.args.deposit.Denom)
 			for _, expectedRewardIndex := range tc.args.expectedRewardIndexes {
-				currRewardIndex, found := multiRewardIndexPre.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+				currRewardIndex, found := claimPre.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 				suite.Require().True(found)
 				suite.Require().Equal(sdk.ZeroDec(), currRewardIndex.RewardFactor)
 			}
 
 			// Check that the synced claim held in memory has properly simulated syncing
-			syncedClaim := suite.keeper.SimulateHardSynchronization(suite.ctx, claimPre)
+			syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.HardSupplyRewardSourceType, userAddr)
+			suite.Require().Len(syncedClaims, 1)
+			syncedClaim := syncedClaims[0]
 			for _, expectedRewardIndex := range tc.args.expectedRewardIndexes {
 				// Check that the user's claim's reward index matches the expected reward index
-				multiRewardIndex, found := syncedClaim.SupplyRewardIndexes.GetRewardIndex(tc.args.deposit.Denom)
-				suite.Require().True(found)
-				rewardIndex, found := multiRewardIndex.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
+				rewardIndex, found := syncedClaim.RewardIndexes.GetRewardIndex(expectedRewardIndex.CollateralType)
 				suite.Require().True(found)
 				suite.Require().Equal(expectedRewardIndex, rewardIndex)
 
@@ -3,7 +3,6 @@ package keeper_test
 import (
 	"testing"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/kava-labs/kava/x/incentive/types"
@@ -37,16 +36,12 @@ func (suite *InitializeSwapRewardTests) TestClaimAddedWhenClaimDoesNotExistAndNo
 
 	suite.keeper.InitializeSwapReward(suite.ctx, poolID, owner)
 
-	syncedClaim, found := suite.keeper.GetSwapClaim(suite.ctx, owner)
+	syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, poolID, owner)
 	suite.True(found)
-	// A new claim should have empty indexes. It doesn't strictly need the poolID either.
-	expectedIndexes := types.MultiRewardIndexes{{
-		CollateralType: poolID,
-		RewardIndexes:  nil,
-	}}
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
+	// A new claim should have empty indexes.
+	suite.Empty(syncedClaim.RewardIndexes)
 	// a new claim should start with 0 rewards
-	suite.Equal(sdk.Coins(nil), syncedClaim.Reward)
+	suite.Empty(syncedClaim.Reward)
 }
 
 func (suite *InitializeSwapRewardTests) TestClaimAddedWhenClaimDoesNotExistAndRewardsExist() {
@@ -72,17 +67,17 @@ func (suite *InitializeSwapRewardTests) TestClaimAddedWhenClaimDoesNotExistAndRe
 
 	suite.keeper.InitializeSwapReward(suite.ctx, poolID, owner)
 
-	syncedClaim, found := suite.keeper.GetSwapClaim(suite.ctx, owner)
+	syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, poolID, owner)
 	suite.True(found)
 	// a new claim should start with the current global indexes
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// a new claim should start with 0 rewards
-	suite.Equal(sdk.Coins(nil), syncedClaim.Reward)
+	suite.Empty(syncedClaim.Reward)
 }
 
 func (suite *InitializeSwapRewardTests) TestClaimUpdatedWhenClaimExistsAndNoRewards() {
 	// When a claim exists, and a user deposits to a new non-rewarded pool;
-	// then the claim's rewards don't change
+	// then the claim in the preexisting pool doesn't change
 
 	preexistingPoolID := "preexisting"
 	preexistingIndexes := types.RewardIndexes{
@@ -94,102 +89,50 @@ func (suite *InitializeSwapRewardTests) TestClaimUpdatedWhenClaimExistsAndNoRewa
 
 	newPoolID := "btcb:usdx"
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: preexistingPoolID,
-				RewardIndexes:  preexistingIndexes,
-			},
-		},
-	}
-	suite.storeSwapClaim(claim)
+	claim := types.NewSourceClaim(types.SwapRewardSourceType, preexistingPoolID, arbitraryAddress(), arbitraryCoins(), preexistingIndexes)
+	suite.storeSourceClaims(claim)
 
 	// no global indexes stored as the new pool is not rewarded
 
 	suite.keeper.InitializeSwapReward(suite.ctx, newPoolID, claim.Owner)
 
-	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
-	// The preexisting indexes shouldn't be changed. It doesn't strictly need the new poolID either.
-	expectedIndexes := types.MultiRewardIndexes{
-		{
-			CollateralType: preexistingPoolID,
-			RewardIndexes:  preexistingIndexes,
-		},
-		{
-			CollateralType: newPoolID,
-			RewardIndexes:  nil,
-		},
-	}
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
-	// init should never alter the rewards
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	// The preexisting claim shouldn't be changed.
+	preexistingClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, preexistingPoolID, claim.Owner)
+	suite.Equal(claim, preexistingClaim)
+
+	syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, newPoolID, claim.Owner)
+	suite.True(found)
+	suite.Empty(syncedClaim.RewardIndexes)
+	suite.Empty(syncedClaim.Reward)
 }
 
 func (suite *InitializeSwapRewardTests) TestClaimUpdatedWhenClaimExistsAndRewardsExist() {
-	// When a claim exists, and a user deposits to a new rewarded pool;
+	// When a claim exists in a pool, and a user deposits to it again after withdrawing their shares;
 	// then the claim's rewards don't change and the indexes are updated to match the global indexes
 
-	preexistingPoolID := "preexisting"
-	preexistingIndexes := types.RewardIndexes{
+	poolID := "btcb:usdx"
+	claimIndexes := types.RewardIndexes{
 		{
 			CollateralType: "rewarddenom",
 			RewardFactor:   d("1000.001"),
 		},
 	}
 
-	newPoolID := "btcb:usdx"
-	newIndexes := types.RewardIndexes{
-		{
-			CollateralType: "otherrewarddenom",
-			RewardFactor:   d("1000.001"),
-		},
-	}
-
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: preexistingPoolID,
-				RewardIndexes:  preexistingIndexes,
-			},
-		},
-	}
-	suite.storeSwapClaim(claim)
+	claim := types.NewSourceClaim(types.SwapRewardSourceType, poolID, arbitraryAddress(), arbitraryCoins(), claimIndexes)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
-			CollateralType: preexistingPoolID,
-			RewardIndexes:  increaseRewardFactors(preexistingIndexes),
-		},
-		{
-			CollateralType: newPoolID,
-			RewardIndexes:  newIndexes,
+			CollateralType: poolID,
+			RewardIndexes:  increaseRewardFactors(claimIndexes),
 		},
 	}
 	suite.storeGlobalSwapIndexes(globalIndexes)
 
-	suite.keeper.InitializeSwapReward(suite.ctx, newPoolID, claim.Owner)
+	suite.keeper.InitializeSwapReward(suite.ctx, poolID, claim.Owner)
 
-	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
-	// only the indexes for the new pool should be updated
-	expectedIndexes := types.MultiRewardIndexes{
-		{
-			CollateralType: preexistingPoolID,
-			RewardIndexes:  preexistingIndexes,
-		},
-		{
-			CollateralType: newPoolID,
-			RewardIndexes:  newIndexes,
-		},
-	}
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, poolID, claim.Owner)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// init should never alter the rewards
 	suite.Equal(claim.Reward, syncedClaim.Reward)
 }
@@ -34,24 +34,19 @@ func (suite *SynchronizeSwapRewardTests) TestClaimUpdatedWhenGlobalIndexesHaveIn
 	originalReward := arbitraryCoins()
 	poolID := "base:quote"
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.SwapRewardSourceType,
+		poolID,
+		arbitraryAddress(),
+		originalReward,
+		types.RewardIndexes{
 			{
-				CollateralType: poolID,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSwapClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -70,9 +65,9 @@ func (suite *SynchronizeSwapRewardTests) TestClaimUpdatedWhenGlobalIndexesHaveIn
 
 	suite.keeper.SynchronizeSwapReward(suite.ctx, poolID, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, poolID, claim.Owner)
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * user shares
 	suite.Equal(
 		cs(c("rewarddenom", 1_000_001_000_000)).Add(originalReward...),
@@ -84,63 +79,46 @@ func (suite *SynchronizeSwapRewardTests) TestClaimUnchangedWhenGlobalIndexesUnch
 	// It should be safe to call SynchronizeSwapReward multiple times
 
 	poolID := "base:quote"
-	unchangingIndexes := types.MultiRewardIndexes{
+	unchangingIndexes := types.RewardIndexes{
 		{
-			CollateralType: poolID,
-			RewardIndexes: types.RewardIndexes{
-				{
-					CollateralType: "rewarddenom",
-					RewardFactor:   d("1000.001"),
-				},
-			},
+			CollateralType: "rewarddenom",
+			RewardFactor:   d("1000.001"),
 		},
 	}
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: unchangingIndexes,
-	}
-	suite.storeSwapClaim(claim)
+	claim := types.NewSourceClaim(types.SwapRewardSourceType, poolID, arbitraryAddress(), arbitraryCoins(), unchangingIndexes)
+	suite.storeSourceClaims(claim)
 
-	suite.storeGlobalSwapIndexes(unchangingIndexes)
+	suite.storeGlobalSwapIndexes(types.MultiRewardIndexes{types.NewMultiRewardIndex(poolID, unchangingIndexes)})
 
 	userShares := i(1e9)
 
 	suite.keeper.SynchronizeSwapReward(suite.ctx, poolID, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, poolID, claim.Owner)
 	// claim should have the same rewards and indexes as before
 	suite.Equal(claim, syncedClaim)
 }
 
-func (suite *SynchronizeSwapRewardTests) TestClaimUpdatedWhenNewRewardAdded() {
+func (suite *SynchronizeSwapRewardTests) TestClaimCreatedWhenNewRewardAdded() {
 	// When a new reward is added (via gov) for a pool the user has already deposited to, and the claim is synced;
-	// Then the user earns rewards for the time since the reward was added, and the indexes are added to the claim.
+	// Then the user earns rewards for the time since the reward was added, and their claims in other pools are unchanged.
 
-	originalReward := arbitraryCoins()
 	newlyRewardPoolID := "newlyRewardedPool"
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.SwapRewardSourceType,
+		"currentlyRewardedPool",
+		arbitraryAddress(),
+		arbitraryCoins(),
+		types.RewardIndexes{
 			{
-				CollateralType: "currentlyRewardedPool",
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "reward",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSwapClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -170,40 +148,33 @@ func (suite *SynchronizeSwapRewardTests) TestClaimUpdatedWhenNewRewardAdded() {
 
 	suite.keeper.SynchronizeSwapReward(suite.ctx, newlyRewardPoolID, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
-	// the new indexes should be added to the claim, but the old ones should be unchanged
-	newlyRewrdedIndexes, _ := globalIndexes.Get(newlyRewardPoolID)
-	expectedIndexes := claim.RewardIndexes.With(newlyRewardPoolID, newlyRewrdedIndexes)
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
+	// the claim in the other pool should be unchanged
+	otherClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, claim.SourceID, claim.Owner)
+	suite.Equal(claim, otherClaim)
+
+	syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, newlyRewardPoolID, claim.Owner)
+	suite.Require().True(found)
+	suite.Equal(globalIndexes[1].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * shares for the synced pool
-	// The old index for `newlyrewarded` isn't in the claim, so it's added starting at 0 for calculating the reward.
-	suite.Equal(
-		cs(c("otherreward", 1_000_001_000_000)).Add(originalReward...),
-		syncedClaim.Reward,
-	)
+	// The claim had no old index, so it starts at 0 for calculating the reward.
+	suite.Equal(cs(c("otherreward", 1_000_001_000_000)), syncedClaim.Reward)
 }
 
 func (suite *SynchronizeSwapRewardTests) TestClaimUnchangedWhenNoReward() {
 	// When a pool is not rewarded but the user has deposited to that pool, and the claim is synced;
 	// Then the claim should be the same.
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: nonEmptyMultiRewardIndexes,
-	}
-	suite.storeSwapClaim(claim)
-
 	poolID := "nonRewardPool"
+	claim := types.NewSourceClaim(types.SwapRewardSourceType, poolID, arbitraryAddress(), arbitraryCoins(), nonEmptyRewardIndexes)
+	suite.storeSourceClaims(claim)
+
 	// No global indexes stored as this pool is not rewarded
 
 	userShares := i(1e9)
 
 	suite.keeper.SynchronizeSwapReward(suite.ctx, poolID, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, poolID, claim.Owner)
 	suite.Equal(claim, syncedClaim)
 }
 
@@ -214,24 +185,19 @@ func (suite *SynchronizeSwapRewardTests) TestClaimUpdatedWhenNewRewardDenomAdded
 	originalReward := arbitraryCoins()
 	poolID := "base:quote"
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: originalReward,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.SwapRewardSourceType,
+		poolID,
+		arbitraryAddress(),
+		originalReward,
+		types.RewardIndexes{
 			{
-				CollateralType: poolID,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "reward",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "reward",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSwapClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -256,9 +222,9 @@ func (suite *SynchronizeSwapRewardTests) TestClaimUpdatedWhenNewRewardDenomAdded
 
 	suite.keeper.SynchronizeSwapReward(suite.ctx, poolID, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, poolID, claim.Owner)
 	// indexes should have the new reward denom added
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// new reward is (new index - old index) * shares
 	// The old index for `otherreward` isn't in the claim, so it's added starting at 0 for calculating the reward.
 	suite.Equal(
@@ -274,24 +240,19 @@ func (suite *SynchronizeSwapRewardTests) TestClaimUpdatedWhenGlobalIndexesIncrea
 
 	poolID := "base:quote"
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  arbitraryAddress(),
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.SwapRewardSourceType,
+		poolID,
+		arbitraryAddress(),
+		arbitraryCoins(),
+		types.RewardIndexes{
 			{
-				CollateralType: poolID,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSwapClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -310,14 +271,14 @@ func (suite *SynchronizeSwapRewardTests) TestClaimUpdatedWhenGlobalIndexesIncrea
 
 	suite.keeper.SynchronizeSwapReward(suite.ctx, poolID, claim.Owner, userShares)
 
-	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.SwapRewardSourceType, poolID, claim.Owner)
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaim.RewardIndexes)
 	// reward is unchanged
 	suite.Equal(claim.Reward, syncedClaim.Reward)
 }
 
-func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUnchangedWhenNoGlobalIndexes() {
+func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaims_ClaimUnchangedWhenNoGlobalIndexes() {
 	poolID_1 := "btcb:usdx"
 	owner := arbitraryAddress()
 
@@ -325,32 +286,22 @@ func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUnchangedWhenNo
 		addDeposit(poolID_1, owner, i(1e9))
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, swapKeeper, nil, nil, nil)
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  owner,
-			Reward: nil,
-		},
-		RewardIndexes: types.MultiRewardIndexes{
-			{
-				CollateralType: poolID_1,
-				RewardIndexes:  nil, // this state only happens because Init stores empty indexes
-			},
-		},
-	}
-	suite.storeSwapClaim(claim)
+	// this state only happens because Init stores empty indexes
+	claim := types.NewSourceClaim(types.SwapRewardSourceType, poolID_1, owner, nil, nil)
+	suite.storeSourceClaims(claim)
 
 	// no global indexes for any pool
 
-	syncedClaim, f := suite.keeper.GetSynchronizedSwapClaim(suite.ctx, claim.Owner)
-	suite.True(f)
+	syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.SwapRewardSourceType, claim.Owner)
+	suite.Require().Len(syncedClaims, 1)
 
 	// indexes are unchanged
-	suite.Equal(claim.RewardIndexes, syncedClaim.RewardIndexes)
+	suite.Empty(syncedClaims[0].RewardIndexes)
 	// reward is unchanged
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	suite.Empty(syncedClaims[0].Reward)
 }
 
-func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMissingIndexAndHasNoSourceShares() {
+func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaims_ClaimUpdatedWhenHasNoSourceShares() {
 	poolID_1 := "btcb:usdx"
 	poolID_2 := "ukava:usdx"
 	owner := arbitraryAddress()
@@ -358,24 +309,19 @@ func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 	// owner has no shares in any pool
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, newFakeSwapKeeper(), nil, nil, nil)
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  owner,
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.SwapRewardSourceType,
+		poolID_1,
+		owner,
+		arbitraryCoins(),
+		types.RewardIndexes{
 			{
-				CollateralType: poolID_1,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom1",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom1",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSwapClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -399,16 +345,17 @@ func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 	}
 	suite.storeGlobalSwapIndexes(globalIndexes)
 
-	syncedClaim, f := suite.keeper.GetSynchronizedSwapClaim(suite.ctx, claim.Owner)
-	suite.True(f)
+	syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.SwapRewardSourceType, claim.Owner)
 
+	// only the existing claim is returned, as the owner has no shares in the other pool
+	suite.Require().Len(syncedClaims, 1)
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaims[0].RewardIndexes)
 	// reward is unchanged
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	suite.Equal(claim.Reward, syncedClaims[0].Reward)
 }
 
-func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMissingIndexButHasSourceShares() {
+func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaims_ClaimsUpdatedWhenHasSourceShares() {
 	poolID_1 := "btcb:usdx"
 	poolID_2 := "ukava:usdx"
 	owner := arbitraryAddress()
@@ -418,24 +365,19 @@ func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 		addDeposit(poolID_2, owner, i(1e9))
 	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, swapKeeper, nil, nil, nil)
 
-	claim := types.SwapClaim{
-		BaseMultiClaim: types.BaseMultiClaim{
-			Owner:  owner,
-			Reward: arbitraryCoins(),
-		},
-		RewardIndexes: types.MultiRewardIndexes{
+	claim := types.NewSourceClaim(
+		types.SwapRewardSourceType,
+		poolID_1,
+		owner,
+		arbitraryCoins(),
+		types.RewardIndexes{
 			{
-				CollateralType: poolID_1,
-				RewardIndexes: types.RewardIndexes{
-					{
-						CollateralType: "rewarddenom1",
-						RewardFactor:   d("1000.001"),
-					},
-				},
+				CollateralType: "rewarddenom1",
+				RewardFactor:   d("1000.001"),
 			},
 		},
-	}
-	suite.storeSwapClaim(claim)
+	)
+	suite.storeSourceClaims(claim)
 
 	globalIndexes := types.MultiRewardIndexes{
 		{
@@ -459,12 +401,13 @@ func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 	}
 	suite.storeGlobalSwapIndexes(globalIndexes)
 
-	syncedClaim, f := suite.keeper.GetSynchronizedSwapClaim(suite.ctx, claim.Owner)
-	suite.True(f)
+	syncedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.SwapRewardSourceType, claim.Owner)
+	suite.Require().Len(syncedClaims, 2)
 
 	// indexes updated from global
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
-	// reward is incremented
-	expectedReward := cs(c("rewarddenom1", 1_000_001_000_000), c("rewarddenom2", 2_000_002_000_000))
-	suite.Equal(claim.Reward.Add(expectedReward...), syncedClaim.Reward)
+	suite.Equal(globalIndexes[0].RewardIndexes, syncedClaims[0].RewardIndexes)
+	suite.Equal(globalIndexes[1].RewardIndexes, syncedClaims[1].RewardIndexes)
+	// rewards are incremented
+	suite.Equal(claim.Reward.Add(c("rewarddenom1", 1_000_001_000_000)), syncedClaims[0].Reward)
+	suite.Equal(cs(c("rewarddenom2", 2_000_002_000_000)), syncedClaims[1].Reward)
 }
@@ -156,9 +156,11 @@ func (suite *AccumulateUSDXRewardsTests) TestStateAddedWhenStateDoesNotExist() {
 
 	suite.keeper.AccumulateUSDXMintingRewards(suite.ctx, period)
 
-	// After the first accumulation the current block time should be stored and the factor will be zero.
+	// After the first accumulation only the current block time should be stored.
+	// The factor will not be stored as no time has passed since the previous block because it didn't exist.
 	suite.storedTimeEquals(cType, firstAccrualTime)
-	suite.storedIndexesEqual(cType, sdk.ZeroDec())
+	_, found := suite.keeper.GetUSDXMintingRewardFactor(suite.ctx, cType)
+	suite.False(found)
 
 	secondAccrualTime := firstAccrualTime.Add(10 * time.Second)
 	suite.ctx = suite.ctx.WithBlockTime(secondAccrualTime)
@@ -389,7 +389,7 @@ func (suite *USDXRewardsTestSuite) TestSynchronizeUSDXMintingReward() {
 			err := suite.cdpKeeper.AddCdp(suite.ctx, suite.addrs[0], tc.args.initialCollateral, tc.args.initialPrincipal, tc.args.ctype)
 			suite.Require().NoError(err)
 
-			claim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+			claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.USDXMintingRewardSourceType, tc.args.ctype, suite.addrs[0])
 			suite.Require().True(found)
 			suite.Require().Equal(sdk.ZeroDec(), claim.RewardIndexes[0].RewardFactor)
 
@@ -415,15 +415,15 @@ func (suite *USDXRewardsTestSuite) TestSynchronizeUSDXMintingReward() {
 			rewardFactor, _ := suite.keeper.GetUSDXMintingRewardFactor(suite.ctx, tc.args.ctype)
 			suite.Require().Equal(tc.args.expectedRewardFactor, rewardFactor)
 
-			claim, found = suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+			claim, found = suite.keeper.GetSourceClaim(suite.ctx, types.USDXMintingRewardSourceType, tc.args.ctype, suite.addrs[0])
 			suite.Require().True(found)
 			suite.Require().Equal(tc.args.expectedRewardFactor, claim.RewardIndexes[0].RewardFactor)
-			suite.Require().Equal(tc.args.expectedRewards, claim.Reward)
+			suite.Require().Equal(cs(tc.args.expectedRewards), claim.Reward)
 		})
 	}
 }
 
-func (suite *USDXRewardsTestSuite) TestSimulateUSDXMintingRewardSynchronization() {
+func (suite *USDXRewardsTestSuite) TestGetSynchronizedUSDXMintingClaims() {
 	type args struct {
 		ctype                string
 		rewardsPerSecond     sdk.Coin
@@ -475,7 +475,7 @@ func (suite *USDXRewardsTestSuite) TestSimulateUSDXMintingRewardSynchronization(
 			err := suite.cdpKeeper.AddCdp(suite.ctx, suite.addrs[0], tc.args.initialCollateral, tc.args.initialPrincipal, tc.args.ctype)
 			suite.Require().NoError(err)
 
-			claim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+			claim, found := suite.keeper.GetSourceClaim(suite.ctx, types.USDXMintingRewardSourceType, tc.args.ctype, suite.addrs[0])
 			suite.Require().True(found)
 			suite.Require().Equal(sdk.ZeroDec(), claim.RewardIndexes[0].RewardFactor)
 
@@ -493,14 +493,15 @@ func (suite *USDXRewardsTestSuite) TestSimulateUSDXMintingRewardSynchronization(
 			updatedBlockTime := suite.ctx.BlockTime().Add(time.Duration(int(time.Second) * timeElapsed))
 			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime)
 
-			claim, found = suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+			claim, found = suite.keeper.GetSourceClaim(suite.ctx, types.USDXMintingRewardSourceType, tc.args.ctype, suite.addrs[0])
 			suite.Require().True(found)
 			suite.Require().Equal(claim.RewardIndexes[0].RewardFactor, sdk.ZeroDec())
-			suite.Require().Equal(claim.Reward, sdk.NewCoin("ukava", sdk.ZeroInt()))
+			suite.Require().True(claim.Reward.IsZero())
 
-			updatedClaim := suite.keeper.SimulateUSDXMintingSynchronization(suite.ctx, claim)
-			suite.Require().Equal(tc.args.expectedRewardFactor, updatedClaim.RewardIndexes[0].RewardFactor)
-			suite.Require().Equal(tc.args.expectedRewards, updatedClaim.Reward)
+			updatedClaims := suite.keeper.GetSynchronizedSourceClaims(suite.ctx, types.USDXMintingRewardSourceType, suite.addrs[0])
+			suite.Require().Len(updatedClaims, 1)
+			suite.Require().Equal(tc.args.expectedRewardFactor, updatedClaims[0].RewardIndexes[0].RewardFactor)
+			suite.Require().Equal(cs(tc.args.expectedRewards), updatedClaims[0].Reward)
 		})
 	}
 }
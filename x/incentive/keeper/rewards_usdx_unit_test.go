@@ -22,8 +22,33 @@ func (suite *usdxRewardsUnitTester) storeGlobalUSDXIndexes(indexes types.RewardI
 	}
 }
 
-func (suite *usdxRewardsUnitTester) storeClaim(claim types.USDXMintingClaim) {
-	suite.keeper.SetUSDXMintingClaim(suite.ctx, claim)
+// storeClaims stores an owner's claims in the usdx minting sources of each collateral type in indexes, with the
+// reward held in the first claim.
+func (suite *usdxRewardsUnitTester) storeClaims(owner sdk.AccAddress, reward sdk.Coins, indexes types.RewardIndexes) {
+	multiIndexes := types.MultiRewardIndexes{}
+	for _, ri := range indexes {
+		multiIndexes = append(multiIndexes, types.NewMultiRewardIndex(
+			ri.CollateralType,
+			types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, ri.RewardFactor)},
+		))
+	}
+	suite.storeSourceClaimsFromIndexes(types.USDXMintingRewardSourceType, owner, reward, multiIndexes)
+}
+
+// getClaimsIndexes returns the usdx minting reward factor of an owner's claims for each collateral type
+func (suite *usdxRewardsUnitTester) getClaimsIndexes(owner sdk.AccAddress) types.RewardIndexes {
+	var indexes types.RewardIndexes
+	for _, mi := range suite.getSourceClaimsIndexes(types.USDXMintingRewardSourceType, owner) {
+		factor, _ := mi.RewardIndexes.Get(types.USDXMintingRewardDenom)
+		indexes = append(indexes, types.NewRewardIndex(mi.CollateralType, factor))
+	}
+	return indexes
+}
+
+// getClaimsReward returns the usdx minting reward of an owner's claims
+func (suite *usdxRewardsUnitTester) getClaimsReward(owner sdk.AccAddress) sdk.Coin {
+	reward := suite.getSourceClaimsReward(types.USDXMintingRewardSourceType, owner)
+	return sdk.NewCoin(types.USDXMintingRewardDenom, reward.AmountOf(types.USDXMintingRewardDenom))
 }
 
 type InitializeUSDXMintingClaimTests struct {
@@ -45,26 +70,20 @@ func (suite *InitializeUSDXMintingClaimTests) TestClaimIndexIsSetWhenClaimDoesNo
 	}}
 	suite.storeGlobalUSDXIndexes(globalIndexes)
 
-	suite.keeper.InitializeUSDXMintingClaim(suite.ctx, cdp)
+	suite.keeper.InitializeUSDXMintingReward(suite.ctx, cdp)
 
-	syncedClaim, f := suite.keeper.GetUSDXMintingClaim(suite.ctx, cdp.Owner)
-	suite.True(f)
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes, suite.getClaimsIndexes(cdp.Owner))
 }
 
 func (suite *InitializeUSDXMintingClaimTests) TestClaimIndexIsSetWhenClaimExists() {
 	collateralType := "bnb-a"
 
-	claim := types.USDXMintingClaim{
-		BaseClaim: types.BaseClaim{
-			Owner: arbitraryAddress(),
-		},
-		RewardIndexes: types.RewardIndexes{{
-			CollateralType: collateralType,
-			RewardFactor:   d("0.1"),
-		}},
-	}
-	suite.storeClaim(claim)
+	owner := arbitraryAddress()
+	claimIndexes := types.RewardIndexes{{
+		CollateralType: collateralType,
+		RewardFactor:   d("0.1"),
+	}}
+	suite.storeClaims(owner, nil, claimIndexes)
 
 	globalIndexes := types.RewardIndexes{{
 		CollateralType: collateralType,
@@ -72,12 +91,11 @@ func (suite *InitializeUSDXMintingClaimTests) TestClaimIndexIsSetWhenClaimExists
 	}}
 	suite.storeGlobalUSDXIndexes(globalIndexes)
 
-	cdp := NewCDPBuilder(claim.Owner, collateralType).Build()
+	cdp := NewCDPBuilder(owner, collateralType).Build()
 
-	suite.keeper.InitializeUSDXMintingClaim(suite.ctx, cdp)
+	suite.keeper.InitializeUSDXMintingReward(suite.ctx, cdp)
 
-	syncedClaim, _ := suite.keeper.GetUSDXMintingClaim(suite.ctx, cdp.Owner)
-	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(globalIndexes, suite.getClaimsIndexes(cdp.Owner))
 }
 
 type SynchronizeUSDXMintingRewardTests struct {
@@ -92,41 +110,32 @@ func (suite *SynchronizeUSDXMintingRewardTests) TestRewardUnchangedWhenGlobalInd
 	unchangingRewardIndexes := nonEmptyRewardIndexes
 	collateralType := extractFirstCollateralType(unchangingRewardIndexes)
 
-	claim := types.USDXMintingClaim{
-		BaseClaim: types.BaseClaim{
-			Owner:  arbitraryAddress(),
-			Reward: c(types.USDXMintingRewardDenom, 0),
-		},
-		RewardIndexes: unchangingRewardIndexes,
-	}
-	suite.storeClaim(claim)
+	owner := arbitraryAddress()
+	reward := c(types.USDXMintingRewardDenom, 0)
+	claimIndexes := unchangingRewardIndexes
+	suite.storeClaims(owner, sdk.NewCoins(reward), claimIndexes)
 
 	suite.storeGlobalUSDXIndexes(unchangingRewardIndexes)
 
-	cdp := NewCDPBuilder(claim.Owner, collateralType).WithSourceShares(1e12).Build()
+	cdp := NewCDPBuilder(owner, collateralType).WithSourceShares(1e12).Build()
 
 	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
 
-	syncedClaim, _ := suite.keeper.GetUSDXMintingClaim(suite.ctx, claim.Owner)
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	suite.Equal(reward, suite.getClaimsReward(cdp.Owner))
 }
 
 func (suite *SynchronizeUSDXMintingRewardTests) TestRewardIsIncrementedWhenGlobalIndexIncreased() {
 	collateralType := "bnb-a"
 
-	claim := types.USDXMintingClaim{
-		BaseClaim: types.BaseClaim{
-			Owner:  arbitraryAddress(),
-			Reward: c(types.USDXMintingRewardDenom, 0),
-		},
-		RewardIndexes: types.RewardIndexes{
-			{
-				CollateralType: collateralType,
-				RewardFactor:   d("0.1"),
-			},
+	owner := arbitraryAddress()
+	reward := c(types.USDXMintingRewardDenom, 0)
+	claimIndexes := types.RewardIndexes{
+		{
+			CollateralType: collateralType,
+			RewardFactor:   d("0.1"),
 		},
 	}
-	suite.storeClaim(claim)
+	suite.storeClaims(owner, sdk.NewCoins(reward), claimIndexes)
 
 	globalIndexes := types.RewardIndexes{
 		{
@@ -136,41 +145,34 @@ func (suite *SynchronizeUSDXMintingRewardTests) TestRewardIsIncrementedWhenGloba
 	}
 	suite.storeGlobalUSDXIndexes(globalIndexes)
 
-	cdp := NewCDPBuilder(claim.Owner, collateralType).WithSourceShares(1e12).Build()
+	cdp := NewCDPBuilder(owner, collateralType).WithSourceShares(1e12).Build()
 
 	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
 
-	syncedClaim, _ := suite.keeper.GetUSDXMintingClaim(suite.ctx, claim.Owner)
 	// reward is ( new index - old index ) * cdp.TotalPrincipal
-	suite.Equal(c(types.USDXMintingRewardDenom, 1e11), syncedClaim.Reward)
+	suite.Equal(c(types.USDXMintingRewardDenom, 1e11), suite.getClaimsReward(cdp.Owner))
 }
 
 func (suite *SynchronizeUSDXMintingRewardTests) TestClaimIndexIsUpdatedWhenGlobalIndexIncreased() {
 	claimsRewardIndexes := nonEmptyRewardIndexes
 	collateralType := extractFirstCollateralType(claimsRewardIndexes)
 
-	claim := types.USDXMintingClaim{
-		BaseClaim: types.BaseClaim{
-			Owner:  arbitraryAddress(),
-			Reward: c(types.USDXMintingRewardDenom, 0),
-		},
-		RewardIndexes: claimsRewardIndexes,
-	}
-	suite.storeClaim(claim)
+	owner := arbitraryAddress()
+	reward := c(types.USDXMintingRewardDenom, 0)
+	claimIndexes := claimsRewardIndexes
+	suite.storeClaims(owner, sdk.NewCoins(reward), claimIndexes)
 
 	globalIndexes := increaseRewardFactors(claimsRewardIndexes)
 	suite.storeGlobalUSDXIndexes(globalIndexes)
 
-	cdp := NewCDPBuilder(claim.Owner, collateralType).Build()
+	cdp := NewCDPBuilder(owner, collateralType).Build()
 
 	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
 
-	syncedClaim, _ := suite.keeper.GetUSDXMintingClaim(suite.ctx, claim.Owner)
-
 	// Only the claim's index for `collateralType` should have been changed
 	i, _ := globalIndexes.Get(collateralType)
 	expectedIndexes := claimsRewardIndexes.With(collateralType, i)
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(expectedIndexes, suite.getClaimsIndexes(cdp.Owner))
 }
 
 func (suite *SynchronizeUSDXMintingRewardTests) TestClaimIndexIsUpdatedWhenNewRewardAddedAndClaimAlreadyExists() {
@@ -186,50 +188,46 @@ func (suite *SynchronizeUSDXMintingRewardTests) TestClaimIndexIsUpdatedWhenNewRe
 	}
 	newRewardIndex := types.NewRewardIndex("xrp-a", d("0.0001"))
 
-	claim := types.USDXMintingClaim{
-		BaseClaim: types.BaseClaim{
-			Owner:  arbitraryAddress(),
-			Reward: c(types.USDXMintingRewardDenom, 0),
-		},
-		RewardIndexes: claimsRewardIndexes,
-	}
-	suite.storeClaim(claim)
+	owner := arbitraryAddress()
+	reward := c(types.USDXMintingRewardDenom, 0)
+	claimIndexes := claimsRewardIndexes
+	suite.storeClaims(owner, sdk.NewCoins(reward), claimIndexes)
 
 	globalIndexes := increaseRewardFactors(claimsRewardIndexes)
 	globalIndexes = append(globalIndexes, newRewardIndex)
 	suite.storeGlobalUSDXIndexes(globalIndexes)
 
-	cdp := NewCDPBuilder(claim.Owner, newRewardIndex.CollateralType).Build()
+	cdp := NewCDPBuilder(owner, newRewardIndex.CollateralType).Build()
 
 	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
 
-	syncedClaim, _ := suite.keeper.GetUSDXMintingClaim(suite.ctx, claim.Owner)
-
 	// Only the claim's index for `collateralType` should have been changed
 	expectedIndexes := claimsRewardIndexes.With(newRewardIndex.CollateralType, newRewardIndex.RewardFactor)
-	suite.Equal(expectedIndexes, syncedClaim.RewardIndexes)
+	suite.Equal(expectedIndexes, suite.getClaimsIndexes(cdp.Owner))
 }
 
 func (suite *SynchronizeUSDXMintingRewardTests) TestClaimIsUnchangedWhenGlobalFactorMissing() {
 	claimsRewardIndexes := nonEmptyRewardIndexes
-	claim := types.USDXMintingClaim{
-		BaseClaim: types.BaseClaim{
-			Owner:  arbitraryAddress(),
-			Reward: c(types.USDXMintingRewardDenom, 0),
-		},
-		RewardIndexes: claimsRewardIndexes,
-	}
-	suite.storeClaim(claim)
+	owner := arbitraryAddress()
+	reward := c(types.USDXMintingRewardDenom, 0)
+	claimIndexes := claimsRewardIndexes
+	suite.storeClaims(owner, sdk.NewCoins(reward), claimIndexes)
 	// don't store any reward indexes
 
 	// create a cdp with collateral type that doesn't exist in the claim's indexes, and does not have a corresponding global factor
-	cdp := NewCDPBuilder(claim.Owner, "unrewardedcollateral").WithSourceShares(1e12).Build()
+	cdp := NewCDPBuilder(owner, "unrewardedcollateral").WithSourceShares(1e12).Build()
 
 	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
 
-	syncedClaim, _ := suite.keeper.GetUSDXMintingClaim(suite.ctx, claim.Owner)
-	suite.Equal(claim.RewardIndexes, syncedClaim.RewardIndexes)
-	suite.Equal(claim.Reward, syncedClaim.Reward)
+	// the claims of other collateral types are unchanged, and the new claim has no indexes to accrue rewards from
+	for _, ri := range claimIndexes {
+		syncedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, types.USDXMintingRewardSourceType, ri.CollateralType, owner)
+		suite.True(found)
+		suite.Equal(types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, ri.RewardFactor)}, syncedClaim.RewardIndexes)
+	}
+	syncedClaim, _ := suite.keeper.GetSourceClaim(suite.ctx, types.USDXMintingRewardSourceType, cdp.Type, owner)
+	suite.Empty(syncedClaim.RewardIndexes)
+	suite.Equal(reward, suite.getClaimsReward(cdp.Owner))
 }
 
 // CDPBuilder is a tool for creating a CDP in tests.
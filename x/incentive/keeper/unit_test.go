@@ -78,11 +78,41 @@ func (suite *unitTester) NewKeeper(
 	ak types.AccountKeeper, stk types.StakingKeeper, swk types.SwapKeeper,
 	svk types.SavingsKeeper, lqk types.LiquidKeeper, ek types.EarnKeeper,
 ) keeper.Keeper {
-	return keeper.NewKeeper(
+	k := keeper.NewKeeper(
 		suite.cdc, suite.incentiveStoreKey, paramSubspace,
 		bk, cdpk, hk, ak, stk, swk, svk, lqk, ek,
 		nil, nil, nil,
 	)
+	registerBuiltInRewardSources(k, cdpk, hk, stk, swk, svk, ek)
+	return k
+}
+
+// registerBuiltInRewardSources registers the reward sources of the built in reward types that have a keeper to read
+// shares from, as the app does.
+func registerBuiltInRewardSources(
+	k keeper.Keeper,
+	cdpk types.CdpKeeper, hk types.HardKeeper, stk types.StakingKeeper,
+	swk types.SwapKeeper, svk types.SavingsKeeper, ek types.EarnKeeper,
+) {
+	if cdpk != nil {
+		k.RegisterRewardSource(types.USDXMintingRewardSourceType, keeper.NewUSDXMintingRewardSource(cdpk))
+	}
+	if hk != nil {
+		k.RegisterRewardSource(types.HardSupplyRewardSourceType, keeper.NewHardSupplyRewardSource(hk))
+		k.RegisterRewardSource(types.HardBorrowRewardSourceType, keeper.NewHardBorrowRewardSource(hk))
+	}
+	if stk != nil {
+		k.RegisterRewardSource(types.DelegatorRewardSourceType, keeper.NewDelegatorRewardSource(stk))
+	}
+	if swk != nil {
+		k.RegisterRewardSource(types.SwapRewardSourceType, keeper.NewSwapRewardSource(swk))
+	}
+	if svk != nil {
+		k.RegisterRewardSource(types.SavingsRewardSourceType, keeper.NewSavingsRewardSource(svk))
+	}
+	if ek != nil {
+		k.RegisterRewardSource(types.EarnRewardSourceType, keeper.NewEarnRewardSource(ek))
+	}
 }
 
 func (suite *unitTester) storeGlobalBorrowIndexes(indexes types.MultiRewardIndexes) {
@@ -121,24 +151,55 @@ func (suite *unitTester) storeGlobalEarnIndexes(indexes types.MultiRewardIndexes
 	}
 }
 
-func (suite *unitTester) storeHardClaim(claim types.HardLiquidityProviderClaim) {
-	suite.keeper.SetHardLiquidityProviderClaim(suite.ctx, claim)
+func (suite *unitTester) storeSourceClaims(claims ...types.SourceClaim) {
+	for _, claim := range claims {
+		suite.keeper.SetSourceClaim(suite.ctx, claim)
+	}
 }
 
-func (suite *unitTester) storeDelegatorClaim(claim types.DelegatorClaim) {
-	suite.keeper.SetDelegatorClaim(suite.ctx, claim)
+// storeSourceClaimsFromIndexes stores an owner's claims in the sources of a source type, one for each of the indexes,
+// with the reward held in the first claim.
+func (suite *unitTester) storeSourceClaimsFromIndexes(
+	sourceType string, owner sdk.AccAddress, reward sdk.Coins, indexes types.MultiRewardIndexes,
+) {
+	for i, index := range indexes {
+		claimReward := sdk.Coins(nil)
+		if i == 0 {
+			claimReward = reward
+		}
+		suite.storeSourceClaims(types.NewSourceClaim(sourceType, index.CollateralType, owner, claimReward, index.RewardIndexes))
+	}
 }
 
-func (suite *unitTester) storeSwapClaim(claim types.SwapClaim) {
-	suite.keeper.SetSwapClaim(suite.ctx, claim)
+// getSourceClaimsIndexes returns the reward indexes of an owner's claims in the sources of a source type
+func (suite *unitTester) getSourceClaimsIndexes(sourceType string, owner sdk.AccAddress) types.MultiRewardIndexes {
+	return getSourceClaimsIndexes(suite.ctx, suite.keeper, sourceType, owner)
 }
 
-func (suite *unitTester) storeSavingsClaim(claim types.SavingsClaim) {
-	suite.keeper.SetSavingsClaim(suite.ctx, claim)
+// getSourceClaimsReward returns the sum of the rewards of an owner's claims in the sources of a source type
+func (suite *unitTester) getSourceClaimsReward(sourceType string, owner sdk.AccAddress) sdk.Coins {
+	return getSourceClaimsReward(suite.ctx, suite.keeper, sourceType, owner)
 }
 
-func (suite *unitTester) storeEarnClaim(claim types.EarnClaim) {
-	suite.keeper.SetEarnClaim(suite.ctx, claim)
+// getSourceClaimsIndexes returns the reward indexes of an owner's claims in the sources of a source type, keyed by
+// source ID
+func getSourceClaimsIndexes(ctx sdk.Context, k keeper.Keeper, sourceType string, owner sdk.AccAddress) types.MultiRewardIndexes {
+	var indexes types.MultiRewardIndexes
+	k.IterateSourceClaimsByOwner(ctx, sourceType, owner, func(c types.SourceClaim) bool {
+		indexes = append(indexes, types.NewMultiRewardIndex(c.SourceID, c.RewardIndexes))
+		return false
+	})
+	return indexes
+}
+
+// getSourceClaimsReward returns the sum of the rewards of an owner's claims in the sources of a source type
+func getSourceClaimsReward(ctx sdk.Context, k keeper.Keeper, sourceType string, owner sdk.AccAddress) sdk.Coins {
+	var reward sdk.Coins
+	k.IterateSourceClaimsByOwner(ctx, sourceType, owner, func(c types.SourceClaim) bool {
+		reward = reward.Add(c.Reward...)
+		return false
+	})
+	return reward
 }
 
 type TestKeeperBuilder struct {
@@ -223,12 +284,14 @@ func (tk *TestKeeperBuilder) WithLiquidKeeper(k types.LiquidKeeper) *TestKeeperB
 }
 
 func (tk *TestKeeperBuilder) Build() keeper.Keeper {
-	return keeper.NewKeeper(
+	k := keeper.NewKeeper(
 		tk.cdc, tk.key, tk.paramSubspace,
 		tk.bankKeeper, tk.cdpKeeper, tk.hardKeeper, tk.accountKeeper,
 		tk.stakingKeeper, tk.swapKeeper, tk.savingsKeeper, tk.liquidKeeper,
 		tk.earnKeeper, tk.mintKeeper, tk.distrKeeper, tk.pricefeedKeeper,
 	)
+	registerBuiltInRewardSources(k, tk.cdpKeeper, tk.hardKeeper, tk.stakingKeeper, tk.swapKeeper, tk.savingsKeeper, tk.earnKeeper)
+	return k
 }
 
 // fakeParamSubspace is a stub paramSpace to simplify keeper unit test setup.
@@ -244,6 +307,10 @@ func (subspace *fakeParamSubspace) SetParamSet(_ sdk.Context, ps paramtypes.Para
 	subspace.params = *(ps.(*types.Params))
 }
 
+func (subspace *fakeParamSubspace) Set(_ sdk.Context, _ []byte, _ interface{}) {
+	// individual params are only set by store migrations, which are not run on the stub
+}
+
 func (subspace *fakeParamSubspace) HasKeyTable() bool {
 	// return true so the keeper does not try to call WithKeyTable, which does nothing
 	return true
@@ -365,6 +432,10 @@ func (k *fakeHardKeeper) GetDeposit(_ sdk.Context, _ sdk.AccAddress) (hardtypes.
 	panic("unimplemented")
 }
 
+func (k *fakeHardKeeper) Deposit(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) error {
+	panic("unimplemented")
+}
+
 // fakeStakingKeeper is a stub staking keeper.
 // It can be used to return values to the incentive keeper without having to initialize a full staking keeper.
 type fakeStakingKeeper struct {
@@ -411,6 +482,16 @@ func (k *fakeStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (st
 	return stakingtypes.Validator{}, false
 }
 
+func (k *fakeStakingKeeper) BondDenom(_ sdk.Context) string {
+	return "ukava"
+}
+
+func (k *fakeStakingKeeper) Delegate(
+	_ sdk.Context, _ sdk.AccAddress, _ sdkmath.Int, _ stakingtypes.BondStatus, _ stakingtypes.Validator, _ bool,
+) (sdk.Dec, error) {
+	panic("unimplemented")
+}
+
 func (k *fakeStakingKeeper) GetValidatorDelegations(_ sdk.Context, valAddr sdk.ValAddress) []stakingtypes.Delegation {
 	var delegations stakingtypes.Delegations
 	for _, d := range k.delegations {
@@ -536,6 +617,14 @@ func (k *fakeEarnKeeper) IterateVaultRecords(
 	}
 }
 
+func (k *fakeEarnKeeper) GetAllowedVault(_ sdk.Context, _ string) (earntypes.AllowedVault, bool) {
+	panic("unimplemented")
+}
+
+func (k *fakeEarnKeeper) Deposit(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coin, _ earntypes.StrategyType) error {
+	panic("unimplemented")
+}
+
 // fakeLiquidKeeper is a stub liquid keeper.
 // It can be used to return values to the incentive keeper without having to initialize a full liquid keeper.
 type fakeLiquidKeeper struct {
@@ -592,6 +681,10 @@ func (k *fakeLiquidKeeper) GetDerivativeValue(ctx sdk.Context, denom string) (sd
 	return sdk.NewCoin("ukava", supply), nil
 }
 
+func (k *fakeLiquidKeeper) GetStakedTokensForDerivatives(ctx sdk.Context, coins sdk.Coins) (sdk.Coin, error) {
+	panic("unimplemented")
+}
+
 func (k *fakeLiquidKeeper) CollectStakingRewardsByDenom(
 	ctx sdk.Context,
 	derivativeDenom string,
@@ -602,6 +695,10 @@ func (k *fakeLiquidKeeper) CollectStakingRewardsByDenom(
 	return sdk.NewCoins(sdk.NewCoin("ukava", amt)), nil
 }
 
+func (k *fakeLiquidKeeper) MintDerivative(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Coin) (sdk.Coin, error) {
+	panic("unimplemented")
+}
+
 func (k *fakeLiquidKeeper) getRewardAmount(
 	ctx sdk.Context,
 	derivativeDenom string,
@@ -719,6 +816,28 @@ func (k *fakeBankKeeper) SendCoinsFromModuleToAccount(
 	panic("not implemented")
 }
 
+func (k *fakeBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	panic("not implemented")
+}
+
+func (k *fakeBankKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context,
+	senderModule string,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	panic("not implemented")
+}
+
+func (k *fakeBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	panic("not implemented")
+}
+
 func (k *fakeBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	panic("not implemented")
 }
@@ -0,0 +1,153 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1types "github.com/kava-labs/kava/x/incentive/migrations/v2/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// claimMigration maps the version 1 claims of a built in reward to their source types.
+type claimMigration struct {
+	prefix      []byte
+	sourceTypes []string
+	// decode returns the owner, reward and the reward indexes for each source type of a version 1 claim
+	decode func(cdc codec.BinaryCodec, bz []byte) (sdk.AccAddress, sdk.Coins, []types.MultiRewardIndexes, error)
+}
+
+var claimMigrations = []claimMigration{
+	{USDXMintingClaimKeyPrefix, []string{types.USDXMintingRewardSourceType}, decodeUSDXMintingClaim},
+	{HardLiquidityClaimKeyPrefix, []string{types.HardSupplyRewardSourceType, types.HardBorrowRewardSourceType}, decodeHardLiquidityProviderClaim},
+	{DelegatorClaimKeyPrefix, []string{types.DelegatorRewardSourceType}, decodeDelegatorClaim},
+	{SwapClaimKeyPrefix, []string{types.SwapRewardSourceType}, decodeSwapClaim},
+	{SavingsClaimKeyPrefix, []string{types.SavingsRewardSourceType}, decodeSavingsClaim},
+	{EarnClaimKeyPrefix, []string{types.EarnRewardSourceType}, decodeEarnClaim},
+}
+
+// migrateClaims splits each version 1 claim of a built in reward into a source claim for each source it has reward
+// indexes for. The reward is held by the first of them. Claims with a reward but no indexes are kept in a single
+// source claim so the reward can still be claimed.
+func migrateClaims(store storetypes.KVStore, cdc codec.BinaryCodec, m claimMigration) error {
+	oldStore := prefix.NewStore(store, m.prefix)
+	newStore := prefix.NewStore(store, types.SourceClaimKeyPrefix)
+
+	keys, values := readAll(oldStore)
+	for i, key := range keys {
+		owner, reward, indexes, err := m.decode(cdc, values[i])
+		if err != nil {
+			return fmt.Errorf("could not decode claim %X: %w", key, err)
+		}
+
+		var claims types.SourceClaims
+		for j, sourceType := range m.sourceTypes {
+			for _, index := range indexes[j] {
+				claims = append(claims, types.NewSourceClaim(sourceType, index.CollateralType, owner, nil, index.RewardIndexes))
+			}
+		}
+		if len(claims) == 0 && !reward.IsZero() {
+			claims = append(claims, types.NewSourceClaim(m.sourceTypes[0], unindexedSourceID(m.sourceTypes[0], reward), owner, nil, nil))
+		}
+		if len(claims) > 0 {
+			claims[0].Reward = reward
+		}
+
+		for _, claim := range claims {
+			newStore.Set(types.SourceClaimKey(claim.SourceType, claim.Owner, claim.SourceID), cdc.MustMarshal(&claim))
+		}
+		oldStore.Delete(key)
+	}
+	return nil
+}
+
+// unindexedSourceID returns the source ID of the claim holding the reward of a version 1 claim without reward indexes.
+func unindexedSourceID(sourceType string, reward sdk.Coins) string {
+	if sourceType == types.DelegatorRewardSourceType {
+		return types.BondDenom
+	}
+	return reward[0].Denom
+}
+
+// decodeUSDXMintingClaim decodes a version 1 usdx minting claim, converting the reward factor of each collateral type
+// to the reward indexes of its source.
+func decodeUSDXMintingClaim(cdc codec.BinaryCodec, bz []byte) (sdk.AccAddress, sdk.Coins, []types.MultiRewardIndexes, error) {
+	var claim v1types.USDXMintingClaim
+	if err := cdc.Unmarshal(bz, &claim); err != nil {
+		return nil, nil, nil, err
+	}
+	indexes := make(types.MultiRewardIndexes, len(claim.RewardIndexes))
+	for i, factor := range claim.RewardIndexes {
+		indexes[i] = types.NewMultiRewardIndex(
+			factor.CollateralType,
+			types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, factor.RewardFactor)},
+		)
+	}
+	return claim.Owner, sdk.NewCoins(claim.Reward), []types.MultiRewardIndexes{indexes}, nil
+}
+
+// decodeHardLiquidityProviderClaim decodes a version 1 hard liquidity provider claim, returning its supply and then
+// its borrow reward indexes.
+func decodeHardLiquidityProviderClaim(cdc codec.BinaryCodec, bz []byte) (sdk.AccAddress, sdk.Coins, []types.MultiRewardIndexes, error) {
+	var claim v1types.HardLiquidityProviderClaim
+	if err := cdc.Unmarshal(bz, &claim); err != nil {
+		return nil, nil, nil, err
+	}
+	indexes := []types.MultiRewardIndexes{
+		convertMultiRewardIndexes(claim.SupplyRewardIndexes),
+		convertMultiRewardIndexes(claim.BorrowRewardIndexes),
+	}
+	return claim.Owner, claim.Reward, indexes, nil
+}
+
+// decodeDelegatorClaim decodes a version 1 delegator claim.
+func decodeDelegatorClaim(cdc codec.BinaryCodec, bz []byte) (sdk.AccAddress, sdk.Coins, []types.MultiRewardIndexes, error) {
+	var claim v1types.DelegatorClaim
+	if err := cdc.Unmarshal(bz, &claim); err != nil {
+		return nil, nil, nil, err
+	}
+	return claim.Owner, claim.Reward, []types.MultiRewardIndexes{convertMultiRewardIndexes(claim.RewardIndexes)}, nil
+}
+
+// decodeSwapClaim decodes a version 1 swap claim.
+func decodeSwapClaim(cdc codec.BinaryCodec, bz []byte) (sdk.AccAddress, sdk.Coins, []types.MultiRewardIndexes, error) {
+	var claim v1types.SwapClaim
+	if err := cdc.Unmarshal(bz, &claim); err != nil {
+		return nil, nil, nil, err
+	}
+	return claim.Owner, claim.Reward, []types.MultiRewardIndexes{convertMultiRewardIndexes(claim.RewardIndexes)}, nil
+}
+
+// decodeSavingsClaim decodes a version 1 savings claim.
+func decodeSavingsClaim(cdc codec.BinaryCodec, bz []byte) (sdk.AccAddress, sdk.Coins, []types.MultiRewardIndexes, error) {
+	var claim v1types.SavingsClaim
+	if err := cdc.Unmarshal(bz, &claim); err != nil {
+		return nil, nil, nil, err
+	}
+	return claim.Owner, claim.Reward, []types.MultiRewardIndexes{convertMultiRewardIndexes(claim.RewardIndexes)}, nil
+}
+
+// decodeEarnClaim decodes a version 1 earn claim.
+func decodeEarnClaim(cdc codec.BinaryCodec, bz []byte) (sdk.AccAddress, sdk.Coins, []types.MultiRewardIndexes, error) {
+	var claim v1types.EarnClaim
+	if err := cdc.Unmarshal(bz, &claim); err != nil {
+		return nil, nil, nil, err
+	}
+	return claim.Owner, claim.Reward, []types.MultiRewardIndexes{convertMultiRewardIndexes(claim.RewardIndexes)}, nil
+}
+
+// convertMultiRewardIndexes converts version 1 reward indexes to the current type, which is encoded the same way.
+func convertMultiRewardIndexes(v1Indexes v1types.MultiRewardIndexes) types.MultiRewardIndexes {
+	indexes := make(types.MultiRewardIndexes, len(v1Indexes))
+	for i, mri := range v1Indexes {
+		rewardIndexes := make(types.RewardIndexes, len(mri.RewardIndexes))
+		for j, ri := range mri.RewardIndexes {
+			rewardIndexes[j] = types.NewRewardIndex(ri.CollateralType, ri.RewardFactor)
+		}
+		indexes[i] = types.NewMultiRewardIndex(mri.CollateralType, rewardIndexes)
+	}
+	return indexes
+}
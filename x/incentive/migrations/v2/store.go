@@ -0,0 +1,129 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// Key prefixes of the claims and global reward state of the built in rewards in version 1.
+var (
+	USDXMintingClaimKeyPrefix                     = []byte{0x01}
+	USDXMintingRewardFactorKeyPrefix              = []byte{0x02}
+	PreviousUSDXMintingRewardAccrualTimeKeyPrefix = []byte{0x03}
+	HardLiquidityClaimKeyPrefix                   = []byte{0x04}
+	HardSupplyRewardIndexesKeyPrefix              = []byte{0x05}
+	PreviousHardSupplyRewardAccrualTimeKeyPrefix  = []byte{0x06}
+	HardBorrowRewardIndexesKeyPrefix              = []byte{0x07}
+	PreviousHardBorrowRewardAccrualTimeKeyPrefix  = []byte{0x08}
+	DelegatorClaimKeyPrefix                       = []byte{0x09}
+	DelegatorRewardIndexesKeyPrefix               = []byte{0x10}
+	PreviousDelegatorRewardAccrualTimeKeyPrefix   = []byte{0x11}
+	SwapClaimKeyPrefix                            = []byte{0x12}
+	SwapRewardIndexesKeyPrefix                    = []byte{0x13}
+	PreviousSwapRewardAccrualTimeKeyPrefix        = []byte{0x14}
+	SavingsClaimKeyPrefix                         = []byte{0x15}
+	SavingsRewardIndexesKeyPrefix                 = []byte{0x16}
+	PreviousSavingsRewardAccrualTimeKeyPrefix     = []byte{0x17}
+	EarnClaimKeyPrefix                            = []byte{0x18}
+	EarnRewardIndexesKeyPrefix                    = []byte{0x19}
+	PreviousEarnRewardAccrualTimeKeyPrefix        = []byte{0x20}
+)
+
+// sourceStateMigration maps the version 1 prefixes of a built in reward to its source type.
+type sourceStateMigration struct {
+	sourceType            string
+	indexesPrefix         []byte
+	previousAccrualPrefix []byte
+}
+
+var sourceStateMigrations = []sourceStateMigration{
+	{types.HardSupplyRewardSourceType, HardSupplyRewardIndexesKeyPrefix, PreviousHardSupplyRewardAccrualTimeKeyPrefix},
+	{types.HardBorrowRewardSourceType, HardBorrowRewardIndexesKeyPrefix, PreviousHardBorrowRewardAccrualTimeKeyPrefix},
+	{types.DelegatorRewardSourceType, DelegatorRewardIndexesKeyPrefix, PreviousDelegatorRewardAccrualTimeKeyPrefix},
+	{types.SwapRewardSourceType, SwapRewardIndexesKeyPrefix, PreviousSwapRewardAccrualTimeKeyPrefix},
+	{types.SavingsRewardSourceType, SavingsRewardIndexesKeyPrefix, PreviousSavingsRewardAccrualTimeKeyPrefix},
+	{types.EarnRewardSourceType, EarnRewardIndexesKeyPrefix, PreviousEarnRewardAccrualTimeKeyPrefix},
+}
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 moves the claims, global reward indexes and accrual times of the built in rewards to the source reward stores, and
+// adds the source_reward_periods param to parameters.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore types.ParamSubspace) error {
+	store := ctx.KVStore(storeKey)
+	for _, m := range sourceStateMigrations {
+		migratePrefix(store, m.indexesPrefix, types.SourceRewardIndexesKeyPrefix, m.sourceType)
+		migratePrefix(store, m.previousAccrualPrefix, types.PreviousSourceRewardAccrualTimeKeyPrefix, m.sourceType)
+	}
+	migratePrefix(store, PreviousUSDXMintingRewardAccrualTimeKeyPrefix, types.PreviousSourceRewardAccrualTimeKeyPrefix, types.USDXMintingRewardSourceType)
+	if err := migrateUSDXMintingRewardFactors(store, cdc); err != nil {
+		return err
+	}
+	for _, m := range claimMigrations {
+		if err := migrateClaims(store, cdc, m); err != nil {
+			return err
+		}
+	}
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migratePrefix moves all values under an old prefix, keyed by source ID, to a source store under a source type.
+// Values are copied unchanged as the encoding is the same.
+func migratePrefix(store storetypes.KVStore, oldPrefix, newPrefix []byte, sourceType string) {
+	oldStore := prefix.NewStore(store, oldPrefix)
+	newStore := prefix.NewStore(store, newPrefix)
+
+	keys, values := readAll(oldStore)
+	for i, key := range keys {
+		newStore.Set(types.SourceRewardKey(sourceType, string(key)), values[i])
+		oldStore.Delete(key)
+	}
+}
+
+// migrateUSDXMintingRewardFactors converts the usdx minting reward factors, which version 1 stored as a single value
+// per collateral type, to the reward indexes of the usdx minting sources.
+func migrateUSDXMintingRewardFactors(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	oldStore := prefix.NewStore(store, USDXMintingRewardFactorKeyPrefix)
+	newStore := prefix.NewStore(store, types.SourceRewardIndexesKeyPrefix)
+
+	keys, values := readAll(oldStore)
+	for i, key := range keys {
+		var factor sdk.Dec
+		if err := factor.Unmarshal(values[i]); err != nil {
+			return err
+		}
+		bz := cdc.MustMarshal(&types.RewardIndexesProto{
+			RewardIndexes: types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, factor)},
+		})
+		newStore.Set(types.SourceRewardKey(types.USDXMintingRewardSourceType, string(key)), bz)
+		oldStore.Delete(key)
+	}
+	return nil
+}
+
+// readAll returns all keys and values in a store. The store is written to after reading as it cannot be written to
+// while an iterator over it is open.
+func readAll(store storetypes.KVStore) (keys, values [][]byte) {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	return keys, values
+}
+
+// migrateParamsStore ensures the param key table exists and has the source_reward_periods, boost_params and
+// campaign_params properties
+func migrateParamsStore(ctx sdk.Context, paramstore types.ParamSubspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeySourceRewardPeriods, types.DefaultSourceRewardPeriods)
+	paramstore.Set(ctx, types.KeyBoostParams, types.DefaultBoostParams)
+	paramstore.Set(ctx, types.KeyCampaignParams, types.DefaultCampaignParams)
+}
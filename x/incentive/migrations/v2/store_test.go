@@ -0,0 +1,190 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2incentive "github.com/kava-labs/kava/x/incentive/migrations/v2"
+	v1types "github.com/kava-labs/kava/x/incentive/migrations/v2/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func TestStoreMigrationMovesBuiltInRewardState(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tincentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tincentiveKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, incentiveKey, tincentiveKey, types.ModuleName)
+	store := ctx.KVStore(incentiveKey)
+
+	accrualTime, err := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).MarshalBinary()
+	require.NoError(t, err)
+	indexes := []byte("indexes")
+
+	store.Set(append(v2incentive.SwapRewardIndexesKeyPrefix, []byte("ukava:usdx")...), indexes)
+	store.Set(append(v2incentive.PreviousSwapRewardAccrualTimeKeyPrefix, []byte("ukava:usdx")...), accrualTime)
+	store.Set(append(v2incentive.EarnRewardIndexesKeyPrefix, []byte("bkava")...), indexes)
+	store.Set(append(v2incentive.PreviousHardBorrowRewardAccrualTimeKeyPrefix, []byte("bnb")...), accrualTime)
+
+	// Run migrations.
+	err = v2incentive.MigrateStore(ctx, incentiveKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Check the old keys are removed
+	require.Nil(t, store.Get(append(v2incentive.SwapRewardIndexesKeyPrefix, []byte("ukava:usdx")...)))
+	require.Nil(t, store.Get(append(v2incentive.PreviousSwapRewardAccrualTimeKeyPrefix, []byte("ukava:usdx")...)))
+	require.Nil(t, store.Get(append(v2incentive.EarnRewardIndexesKeyPrefix, []byte("bkava")...)))
+	require.Nil(t, store.Get(append(v2incentive.PreviousHardBorrowRewardAccrualTimeKeyPrefix, []byte("bnb")...)))
+
+	// Check the values are unchanged under the source keys
+	sourceKey := func(prefix []byte, sourceType, sourceID string) []byte {
+		return append(append([]byte{}, prefix...), types.SourceRewardKey(sourceType, sourceID)...)
+	}
+	require.Equal(t, indexes, store.Get(sourceKey(types.SourceRewardIndexesKeyPrefix, types.SwapRewardSourceType, "ukava:usdx")))
+	require.Equal(t, accrualTime, store.Get(sourceKey(types.PreviousSourceRewardAccrualTimeKeyPrefix, types.SwapRewardSourceType, "ukava:usdx")))
+	require.Equal(t, indexes, store.Get(sourceKey(types.SourceRewardIndexesKeyPrefix, types.EarnRewardSourceType, "bkava")))
+	require.Equal(t, accrualTime, store.Get(sourceKey(types.PreviousSourceRewardAccrualTimeKeyPrefix, types.HardBorrowRewardSourceType, "bnb")))
+}
+
+func TestStoreMigrationMovesUSDXMintingRewardState(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tincentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tincentiveKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, incentiveKey, tincentiveKey, types.ModuleName)
+	store := ctx.KVStore(incentiveKey)
+
+	accrualTime, err := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).MarshalBinary()
+	require.NoError(t, err)
+	factor, err := sdk.MustNewDecFromStr("0.5").Marshal()
+	require.NoError(t, err)
+
+	store.Set(append(v2incentive.USDXMintingRewardFactorKeyPrefix, []byte("bnb-a")...), factor)
+	store.Set(append(v2incentive.PreviousUSDXMintingRewardAccrualTimeKeyPrefix, []byte("bnb-a")...), accrualTime)
+
+	err = v2incentive.MigrateStore(ctx, incentiveKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	require.Nil(t, store.Get(append(v2incentive.USDXMintingRewardFactorKeyPrefix, []byte("bnb-a")...)))
+	require.Nil(t, store.Get(append(v2incentive.PreviousUSDXMintingRewardAccrualTimeKeyPrefix, []byte("bnb-a")...)))
+
+	sourceKey := func(prefix []byte, sourceType, sourceID string) []byte {
+		return append(append([]byte{}, prefix...), types.SourceRewardKey(sourceType, sourceID)...)
+	}
+	require.Equal(t, accrualTime, store.Get(sourceKey(types.PreviousSourceRewardAccrualTimeKeyPrefix, types.USDXMintingRewardSourceType, "bnb-a")))
+
+	// the reward factor is converted to reward indexes
+	var indexes types.RewardIndexesProto
+	encCfg.Codec.MustUnmarshal(store.Get(sourceKey(types.SourceRewardIndexesKeyPrefix, types.USDXMintingRewardSourceType, "bnb-a")), &indexes)
+	require.Equal(t, types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, sdk.MustNewDecFromStr("0.5"))}, indexes.RewardIndexes)
+}
+
+func TestStoreMigrationMovesBuiltInClaims(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tincentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tincentiveKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, incentiveKey, tincentiveKey, types.ModuleName)
+	store := ctx.KVStore(incentiveKey)
+
+	owner := sdk.AccAddress("owner_______________")
+	other := sdk.AccAddress("other_______________")
+	hardIndexes := types.RewardIndexes{types.NewRewardIndex("hard", sdk.MustNewDecFromStr("0.1"))}
+	v1HardIndexes := v1types.RewardIndexes{{CollateralType: "hard", RewardFactor: sdk.MustNewDecFromStr("0.1")}}
+
+	store.Set(append(v2incentive.HardLiquidityClaimKeyPrefix, owner...), encCfg.Codec.MustMarshal(&v1types.HardLiquidityProviderClaim{
+		BaseMultiClaim: v1types.BaseMultiClaim{Owner: owner, Reward: sdk.NewCoins(sdk.NewInt64Coin("hard", 10))},
+		SupplyRewardIndexes: v1types.MultiRewardIndexes{
+			{CollateralType: "bnb", RewardIndexes: v1HardIndexes},
+			{CollateralType: "ukava", RewardIndexes: v1HardIndexes},
+		},
+		BorrowRewardIndexes: v1types.MultiRewardIndexes{{CollateralType: "bnb", RewardIndexes: v1HardIndexes}},
+	}))
+	store.Set(append(v2incentive.USDXMintingClaimKeyPrefix, owner...), encCfg.Codec.MustMarshal(&v1types.USDXMintingClaim{
+		BaseClaim:     v1types.BaseClaim{Owner: owner, Reward: sdk.NewInt64Coin("ukava", 5)},
+		RewardIndexes: v1types.RewardIndexes{{CollateralType: "bnb-a", RewardFactor: sdk.MustNewDecFromStr("0.5")}},
+	}))
+	// a claim with a reward but no indexes
+	store.Set(append(v2incentive.DelegatorClaimKeyPrefix, other...), encCfg.Codec.MustMarshal(&v1types.DelegatorClaim{
+		BaseMultiClaim: v1types.BaseMultiClaim{Owner: other, Reward: sdk.NewCoins(sdk.NewInt64Coin("hard", 3))},
+	}))
+	// a claim with no reward or indexes
+	store.Set(append(v2incentive.SwapClaimKeyPrefix, other...), encCfg.Codec.MustMarshal(&v1types.SwapClaim{
+		BaseMultiClaim: v1types.BaseMultiClaim{Owner: other},
+	}))
+
+	err := v2incentive.MigrateStore(ctx, incentiveKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	require.Nil(t, store.Get(append(v2incentive.HardLiquidityClaimKeyPrefix, owner...)))
+	require.Nil(t, store.Get(append(v2incentive.USDXMintingClaimKeyPrefix, owner...)))
+	require.Nil(t, store.Get(append(v2incentive.DelegatorClaimKeyPrefix, other...)))
+	require.Nil(t, store.Get(append(v2incentive.SwapClaimKeyPrefix, other...)))
+
+	var claims types.SourceClaims
+	iterator := sdk.KVStorePrefixIterator(store, types.SourceClaimKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.SourceClaim
+		encCfg.Codec.MustUnmarshal(iterator.Value(), &claim)
+		claims = append(claims, claim)
+	}
+
+	require.ElementsMatch(t, types.SourceClaims{
+		// the reward is held by the first claim
+		types.NewSourceClaim(types.HardSupplyRewardSourceType, "bnb", owner, sdk.NewCoins(sdk.NewInt64Coin("hard", 10)), hardIndexes),
+		types.NewSourceClaim(types.HardSupplyRewardSourceType, "ukava", owner, nil, hardIndexes),
+		types.NewSourceClaim(types.HardBorrowRewardSourceType, "bnb", owner, nil, hardIndexes),
+		types.NewSourceClaim(
+			types.USDXMintingRewardSourceType, "bnb-a", owner,
+			sdk.NewCoins(sdk.NewInt64Coin("ukava", 5)),
+			types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, sdk.MustNewDecFromStr("0.5"))},
+		),
+		types.NewSourceClaim(types.DelegatorRewardSourceType, types.BondDenom, other, sdk.NewCoins(sdk.NewInt64Coin("hard", 3)), nil),
+	}, claims)
+}
+
+func TestStoreMigrationAddsKeyTableIncludingNewParam(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tincentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tincentiveKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, incentiveKey, tincentiveKey, types.ModuleName)
+
+	// Check param doesn't exist before
+	require.False(t, paramstore.Has(ctx, types.KeySourceRewardPeriods))
+	require.False(t, paramstore.Has(ctx, types.KeyBoostParams))
+	require.False(t, paramstore.Has(ctx, types.KeyCampaignParams))
+
+	// Run migrations.
+	err := v2incentive.MigrateStore(ctx, incentiveKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeySourceRewardPeriods))
+	// Assert the value is what we expect, no source reward periods
+	var result types.TypedMultiRewardPeriods
+	paramstore.Get(ctx, types.KeySourceRewardPeriods, &result)
+	require.Empty(t, result)
+
+	// Boosts are set up without any boosted source types
+	require.True(t, paramstore.Has(ctx, types.KeyBoostParams))
+	var boostParams types.BoostParams
+	paramstore.Get(ctx, types.KeyBoostParams, &boostParams)
+	require.Equal(t, types.DefaultBoostMaxLockDuration, boostParams.MaxLockDuration)
+	require.Equal(t, types.DefaultBoostMaxFactor, boostParams.MaxBoostFactor)
+	require.Empty(t, boostParams.SourceTypes)
+
+	// Campaigns are limited by the default fee and duration
+	require.True(t, paramstore.Has(ctx, types.KeyCampaignParams))
+	var campaignParams types.CampaignParams
+	paramstore.Get(ctx, types.KeyCampaignParams, &campaignParams)
+	require.Equal(t, types.DefaultCampaignParams, campaignParams)
+}
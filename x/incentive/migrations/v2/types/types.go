@@ -0,0 +1,10 @@
+// Package types holds the version 1 incentive claim types, which are decoded by the version 2 store migration.
+// claims.pb.go is a copy of the version 1 generated code, without registering its types as they have the same proto
+// names as the current ones.
+package types
+
+// RewardIndexes slice of RewardIndex
+type RewardIndexes []RewardIndex
+
+// MultiRewardIndexes slice of MultiRewardIndex
+type MultiRewardIndexes []MultiRewardIndex
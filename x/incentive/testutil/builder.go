@@ -192,6 +192,57 @@ func (builder IncentiveGenesisBuilder) WithSimpleEarnRewardPeriod(ctype string,
 	return builder.WithInitializedEarnRewardPeriod(builder.simpleRewardPeriod(ctype, rewardsPerSecond))
 }
 
+// WithInitializedSourceRewardPeriod adds a reward period for a source of a registered reward source type, setting the
+// genesis time as the previous accumulation time for the source.
+func (builder IncentiveGenesisBuilder) WithInitializedSourceRewardPeriod(sourceType string, period types.MultiRewardPeriod) IncentiveGenesisBuilder {
+	periods := make(types.TypedMultiRewardPeriods, 0, len(builder.Params.SourceRewardPeriods)+1)
+	added := false
+	for _, p := range builder.Params.SourceRewardPeriods {
+		if p.SourceType == sourceType {
+			p.RewardPeriods = append(append(types.MultiRewardPeriods{}, p.RewardPeriods...), period)
+			added = true
+		}
+		periods = append(periods, p)
+	}
+	if !added {
+		periods = append(periods, types.NewTypedMultiRewardPeriod(sourceType, types.MultiRewardPeriods{period}))
+	}
+	builder.Params.SourceRewardPeriods = periods
+
+	accumulationTimeForPeriod := types.NewAccumulationTime(period.CollateralType, builder.genesisTime)
+	states := make(types.TypedGenesisRewardStates, 0, len(builder.SourceRewardStates)+1)
+	added = false
+	for _, state := range builder.SourceRewardStates {
+		if state.SourceType == sourceType {
+			state.RewardState.AccumulationTimes = append(
+				append(types.AccumulationTimes{}, state.RewardState.AccumulationTimes...),
+				accumulationTimeForPeriod,
+			)
+			added = true
+		}
+		states = append(states, state)
+	}
+	if !added {
+		states = append(states, types.NewTypedGenesisRewardState(
+			sourceType,
+			types.NewGenesisRewardState(types.AccumulationTimes{accumulationTimeForPeriod}, types.MultiRewardIndexes{}),
+		))
+	}
+	builder.SourceRewardStates = states
+
+	return builder
+}
+
+func (builder IncentiveGenesisBuilder) WithSimpleSourceRewardPeriod(sourceType, sourceID string, rewardsPerSecond sdk.Coins) IncentiveGenesisBuilder {
+	return builder.WithInitializedSourceRewardPeriod(sourceType, builder.simpleRewardPeriod(sourceID, rewardsPerSecond))
+}
+
+func (builder IncentiveGenesisBuilder) WithBoostParams(params types.BoostParams) IncentiveGenesisBuilder {
+	builder.Params.BoostParams = params
+
+	return builder
+}
+
 func (builder IncentiveGenesisBuilder) WithMultipliers(multipliers types.MultipliersPerDenoms) IncentiveGenesisBuilder {
 	builder.Params.ClaimMultipliers = multipliers
 
@@ -152,6 +152,20 @@ func (suite *IntegrationTester) DeliverIncentiveMsg(msg sdk.Msg) error {
 		_, err = msgServer.ClaimDelegatorReward(sdk.WrapSDKContext(suite.Ctx), msg)
 	case *types.MsgClaimEarnReward:
 		_, err = msgServer.ClaimEarnReward(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgClaimAllRewards:
+		_, err = msgServer.ClaimAllRewards(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgSetAutoCompound:
+		_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgRemoveAutoCompound:
+		_, err = msgServer.RemoveAutoCompound(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgLock:
+		_, err = msgServer.Lock(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgExtendLock:
+		_, err = msgServer.ExtendLock(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgWithdrawLock:
+		_, err = msgServer.WithdrawLock(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgCreateIncentiveCampaign:
+		_, err = msgServer.CreateIncentiveCampaign(sdk.WrapSDKContext(suite.Ctx), msg)
 	default:
 		panic("unhandled incentive msg")
 	}
@@ -406,34 +420,53 @@ func (suite *IntegrationTester) VestingPeriodsEqual(address sdk.AccAddress, expe
 // -----------------------------------------------------------------------------
 // x/incentive
 
+// storedSourceReward returns the sum of the rewards stored in an owner's claims in all sources of the source types
+func (suite *IntegrationTester) storedSourceReward(owner sdk.AccAddress, sourceTypes ...string) (sdk.Coins, bool) {
+	reward := sdk.NewCoins()
+	found := false
+	for _, sourceType := range sourceTypes {
+		suite.App.GetIncentiveKeeper().IterateSourceClaimsByOwner(suite.Ctx, sourceType, owner, func(c types.SourceClaim) bool {
+			reward = reward.Add(c.Reward...)
+			found = true
+			return false
+		})
+	}
+	if reward.Empty() {
+		// match the nil reward of a claim with no rewards
+		reward = nil
+	}
+	return reward, found
+}
+
 func (suite *IntegrationTester) SwapRewardEquals(owner sdk.AccAddress, expected sdk.Coins) {
-	claim, found := suite.App.GetIncentiveKeeper().GetSwapClaim(suite.Ctx, owner)
+	reward, found := suite.storedSourceReward(owner, types.SwapRewardSourceType)
 	suite.Require().Truef(found, "expected swap claim to be found for %s", owner)
-	suite.Equalf(expected, claim.Reward, "expected swap claim reward to be %s, but got %s", expected, claim.Reward)
+	suite.Equalf(expected, reward, "expected swap claim reward to be %s, but got %s", expected, reward)
 }
 
 func (suite *IntegrationTester) DelegatorRewardEquals(owner sdk.AccAddress, expected sdk.Coins) {
-	claim, found := suite.App.GetIncentiveKeeper().GetDelegatorClaim(suite.Ctx, owner)
+	reward, found := suite.storedSourceReward(owner, types.DelegatorRewardSourceType)
 	suite.Require().Truef(found, "expected delegator claim to be found for %s", owner)
-	suite.Equalf(expected, claim.Reward, "expected delegator claim reward to be %s, but got %s", expected, claim.Reward)
+	suite.Equalf(expected, reward, "expected delegator claim reward to be %s, but got %s", expected, reward)
 }
 
 func (suite *IntegrationTester) HardRewardEquals(owner sdk.AccAddress, expected sdk.Coins) {
-	claim, found := suite.App.GetIncentiveKeeper().GetHardLiquidityProviderClaim(suite.Ctx, owner)
-	suite.Require().Truef(found, "expected delegator claim to be found for %s", owner)
-	suite.Equalf(expected, claim.Reward, "expected delegator claim reward to be %s, but got %s", expected, claim.Reward)
+	reward, found := suite.storedSourceReward(owner, types.HardSupplyRewardSourceType, types.HardBorrowRewardSourceType)
+	suite.Require().Truef(found, "expected hard claim to be found for %s", owner)
+	suite.Equalf(expected, reward, "expected hard claim reward to be %s, but got %s", expected, reward)
 }
 
 func (suite *IntegrationTester) USDXRewardEquals(owner sdk.AccAddress, expected sdk.Coin) {
-	claim, found := suite.App.GetIncentiveKeeper().GetUSDXMintingClaim(suite.Ctx, owner)
-	suite.Require().Truef(found, "expected delegator claim to be found for %s", owner)
-	suite.Equalf(expected, claim.Reward, "expected delegator claim reward to be %s, but got %s", expected, claim.Reward)
+	reward, found := suite.storedSourceReward(owner, types.USDXMintingRewardSourceType)
+	suite.Require().Truef(found, "expected usdx minting claim to be found for %s", owner)
+	actual := sdk.NewCoin(expected.Denom, reward.AmountOf(expected.Denom))
+	suite.Equalf(expected, actual, "expected usdx minting claim reward to be %s, but got %s", expected, reward)
 }
 
 func (suite *IntegrationTester) EarnRewardEquals(owner sdk.AccAddress, expected sdk.Coins) {
-	claim, found := suite.App.GetIncentiveKeeper().GetEarnClaim(suite.Ctx, owner)
+	reward, found := suite.storedSourceReward(owner, types.EarnRewardSourceType)
 	suite.Require().Truef(found, "expected earn claim to be found for %s", owner)
-	suite.Truef(expected.IsEqual(claim.Reward), "expected earn claim reward to be %s, but got %s", expected, claim.Reward)
+	suite.Truef(expected.IsEqual(reward), "expected earn claim reward to be %s, but got %s", expected, reward)
 }
 
 // AddTestAddrsFromPubKeys adds the addresses into the SimApp providing only the public keys.
@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // NewAPY returns a new instance of APY
 func NewAPY(collateralType string, apy sdk.Dec) Apy {
@@ -12,3 +17,43 @@ func NewAPY(collateralType string, apy sdk.Dec) Apy {
 
 // APYs is a slice of APY
 type APYs []Apy
+
+// RewardProjectionDuration is the duration over which AccountRewardRate projects rewards.
+const RewardProjectionDuration = 30 * 24 * time.Hour
+
+// NewAccountRewardRate returns a new AccountRewardRate for an account's shares in a source rewarded at the given
+// rate. Rewards are projected over RewardProjectionDuration from the block time, up to the end of the reward period.
+func NewAccountRewardRate(
+	sourceType, sourceID string,
+	shares, totalShares sdk.Dec,
+	sourceRewardsPerSecond sdk.DecCoins,
+	blockTime, periodEnd time.Time,
+) AccountRewardRate {
+	share := sdk.ZeroDec()
+	if totalShares.IsPositive() {
+		share = shares.Quo(totalShares)
+	}
+	rewardsPerSecond := sourceRewardsPerSecond.MulDecTruncate(share)
+
+	projectionEnd := blockTime.Add(RewardProjectionDuration)
+	if periodEnd.Before(projectionEnd) {
+		projectionEnd = periodEnd
+	}
+	projectedSeconds := sdk.ZeroDec()
+	if projectionEnd.After(blockTime) {
+		projectedSeconds = sdk.NewDec(int64(math.RoundToEven(projectionEnd.Sub(blockTime).Seconds())))
+	}
+
+	return AccountRewardRate{
+		SourceType:       sourceType,
+		SourceID:         sourceID,
+		Shares:           shares,
+		TotalShares:      totalShares,
+		Share:            share,
+		RewardsPerSecond: rewardsPerSecond,
+		ProjectedRewards: rewardsPerSecond.MulDecTruncate(projectedSeconds),
+	}
+}
+
+// AccountRewardRates is a slice of AccountRewardRate
+type AccountRewardRates []AccountRewardRate
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -72,30 +73,125 @@ func (m *Apy) GetCollateralType() string {
 	return ""
 }
 
+// AccountRewardRate contains the rewards an account earns from one of its rewarded positions at a specific instant in
+// time.
+type AccountRewardRate struct {
+	// source_type is the type of the rewarded position, e.g. hard_supply, swap, or a registered reward source type.
+	SourceType string `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// source_id identifies the rewarded position within the source type, e.g. a denom or pool id.
+	SourceID string `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// shares are the account's shares in the source.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// total_shares are the shares of all accounts in the source.
+	TotalShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_shares"`
+	// share is the fraction of the total shares owned by the account.
+	Share github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+	// rewards_per_second are the rewards the account currently earns each second.
+	RewardsPerSecond github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=rewards_per_second,json=rewardsPerSecond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_second"`
+	// projected_rewards are the rewards the account earns over the next 30 days if its share does not change, up to the
+	// end of the reward period.
+	ProjectedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=projected_rewards,json=projectedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"projected_rewards"`
+}
+
+func (m *AccountRewardRate) Reset()         { *m = AccountRewardRate{} }
+func (m *AccountRewardRate) String() string { return proto.CompactTextString(m) }
+func (*AccountRewardRate) ProtoMessage()    {}
+func (*AccountRewardRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2c1ad571f25cae9, []int{1}
+}
+func (m *AccountRewardRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountRewardRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountRewardRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountRewardRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountRewardRate.Merge(m, src)
+}
+func (m *AccountRewardRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountRewardRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountRewardRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountRewardRate proto.InternalMessageInfo
+
+func (m *AccountRewardRate) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *AccountRewardRate) GetSourceID() string {
+	if m != nil {
+		return m.SourceID
+	}
+	return ""
+}
+
+func (m *AccountRewardRate) GetRewardsPerSecond() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerSecond
+	}
+	return nil
+}
+
+func (m *AccountRewardRate) GetProjectedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ProjectedRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Apy)(nil), "kava.incentive.v1beta1.Apy")
+	proto.RegisterType((*AccountRewardRate)(nil), "kava.incentive.v1beta1.AccountRewardRate")
 }
 
 func init() { proto.RegisterFile("kava/incentive/v1beta1/apy.proto", fileDescriptor_b2c1ad571f25cae9) }
 
 var fileDescriptor_b2c1ad571f25cae9 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4e, 0x2c, 0x4b,
-	0xd4, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x2c, 0xa8, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x03, 0xa9,
-	0xd0, 0x83, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x07,
-	0xab, 0xd2, 0x87, 0x70, 0x20, 0x5a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0x21, 0xe2, 0x20, 0x16,
-	0x44, 0x54, 0xa9, 0x8e, 0x8b, 0xd9, 0xb1, 0xa0, 0x52, 0x48, 0x9d, 0x8b, 0x3f, 0x39, 0x3f, 0x27,
-	0x27, 0xb1, 0x24, 0xb5, 0x28, 0x31, 0x27, 0xbe, 0xa4, 0xb2, 0x20, 0x55, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0x33, 0x88, 0x0f, 0x21, 0x1c, 0x52, 0x59, 0x90, 0x2a, 0xe4, 0xc7, 0xc5, 0x9c, 0x58, 0x50,
-	0x29, 0xc1, 0x04, 0x92, 0x74, 0xb2, 0x39, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb5, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xa8, 0x9d, 0x50, 0x4a, 0xb7, 0x38, 0x25,
-	0x5b, 0x1f, 0x64, 0x5a, 0xb1, 0x9e, 0x4b, 0x6a, 0xf2, 0xa5, 0x2d, 0xba, 0x5c, 0x50, 0x27, 0xb9,
-	0xa4, 0x26, 0x07, 0x81, 0x0c, 0x72, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6,
-	0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39,
-	0x86, 0x28, 0x6d, 0x24, 0x43, 0x41, 0xbe, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x06, 0xb3, 0xf4, 0x2b,
-	0x90, 0xc2, 0x06, 0x6c, 0x7a, 0x12, 0x1b, 0xd8, 0x37, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x95, 0x59, 0xa8, 0x77, 0x3a, 0x01, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x63, 0xdc, 0x86, 0xf6, 0x52, 0x01, 0x3d, 0x21, 0x64, 0x2a, 0x64, 0x47, 0x1d, 0xa0,
+	0x08, 0xc5, 0x56, 0xe9, 0xca, 0xd2, 0x10, 0x86, 0x2e, 0x08, 0x39, 0x9d, 0x58, 0xac, 0xf3, 0xf9,
+	0x55, 0x6a, 0xea, 0xfa, 0x4e, 0x77, 0x97, 0x80, 0x97, 0xf2, 0x15, 0xf8, 0x1c, 0xcc, 0x7c, 0x88,
+	0x8e, 0x15, 0x13, 0x62, 0x08, 0xc8, 0x19, 0xf9, 0x12, 0xe8, 0xfe, 0xd0, 0x64, 0x64, 0x48, 0x27,
+	0xdf, 0xbd, 0xef, 0xf3, 0xfe, 0x9e, 0x47, 0xd6, 0xbd, 0xa8, 0x7f, 0x4e, 0x66, 0x24, 0x29, 0x6b,
+	0x0a, 0xb5, 0x2a, 0x67, 0x90, 0xcc, 0x0e, 0x73, 0x50, 0xe4, 0x30, 0x21, 0xbc, 0x89, 0xb9, 0x60,
+	0x8a, 0xe1, 0x47, 0x5a, 0x11, 0xdf, 0x28, 0x62, 0xa7, 0xd8, 0x0b, 0x29, 0x93, 0x17, 0x4c, 0x26,
+	0x39, 0x91, 0xcb, 0x31, 0xca, 0xca, 0xda, 0xce, 0xed, 0x3d, 0xb6, 0xfd, 0xcc, 0xdc, 0x12, 0x7b,
+	0x71, 0xad, 0x87, 0x13, 0x36, 0x61, 0xb6, 0xae, 0x4f, 0xb6, 0xba, 0x7f, 0x89, 0xfc, 0x63, 0xde,
+	0xe0, 0x67, 0xe8, 0x3e, 0x65, 0x55, 0x45, 0x14, 0x08, 0x52, 0x65, 0xaa, 0xe1, 0x10, 0x78, 0x7d,
+	0xef, 0x60, 0x3b, 0xbd, 0xb7, 0x2c, 0x9f, 0x36, 0x1c, 0xf0, 0x5b, 0xe4, 0x13, 0xde, 0x04, 0x77,
+	0x74, 0x73, 0xf8, 0xea, 0x6a, 0x1e, 0x75, 0x7e, 0xce, 0xa3, 0xa7, 0x93, 0x52, 0x9d, 0x4d, 0xf3,
+	0x98, 0xb2, 0x0b, 0xe7, 0xe9, 0x3e, 0x03, 0x59, 0x9c, 0x27, 0x9a, 0x26, 0xe3, 0x11, 0xd0, 0xef,
+	0xdf, 0x06, 0xc8, 0x45, 0x1a, 0x01, 0x4d, 0x35, 0x68, 0xff, 0xcf, 0x06, 0xda, 0x3d, 0xa6, 0x94,
+	0x4d, 0x6b, 0x95, 0xc2, 0x47, 0x22, 0x8a, 0x94, 0x28, 0xc0, 0x11, 0xea, 0x49, 0x36, 0x15, 0x14,
+	0x56, 0xa3, 0x20, 0x5b, 0x32, 0x31, 0x9e, 0xa3, 0x6d, 0x27, 0x28, 0x0b, 0x17, 0x66, 0xa7, 0x9d,
+	0x47, 0x5b, 0x63, 0x53, 0x3c, 0x19, 0xa5, 0x5b, 0xb6, 0x7d, 0x52, 0xe0, 0x53, 0xd4, 0x95, 0x67,
+	0x44, 0x80, 0x0c, 0xfc, 0x35, 0x84, 0x76, 0x2c, 0x9c, 0xa1, 0x1d, 0xc5, 0x14, 0xa9, 0x32, 0xc7,
+	0xde, 0x58, 0x03, 0xbb, 0x67, 0x88, 0x63, 0x6b, 0x90, 0xa2, 0x4d, 0x83, 0x0e, 0x36, 0xd7, 0x40,
+	0xb6, 0x28, 0xfc, 0x19, 0x61, 0x61, 0x7e, 0xb2, 0xcc, 0x38, 0x88, 0x4c, 0x02, 0x65, 0x75, 0x11,
+	0x74, 0xfb, 0xfe, 0x41, 0xef, 0xe5, 0x93, 0xd8, 0xe9, 0xf5, 0xd3, 0xfa, 0xf7, 0xde, 0xf4, 0xf0,
+	0x6b, 0x56, 0xd6, 0xc3, 0x23, 0x6d, 0xff, 0xf5, 0x57, 0xf4, 0xe2, 0xff, 0xec, 0xf5, 0x8c, 0x4c,
+	0x1f, 0x38, 0xb3, 0x77, 0x20, 0xc6, 0xc6, 0x0a, 0x5f, 0xa2, 0x5d, 0x2e, 0xd8, 0x07, 0xa0, 0x0a,
+	0x8a, 0xcc, 0x75, 0x83, 0xbb, 0xb7, 0xe6, 0x7f, 0xe3, 0x65, 0x9f, 0x96, 0x1c, 0xbe, 0xb9, 0x6a,
+	0x43, 0xef, 0xba, 0x0d, 0xbd, 0xdf, 0x6d, 0xe8, 0x7d, 0x59, 0x84, 0x9d, 0xeb, 0x45, 0xd8, 0xf9,
+	0xb1, 0x08, 0x3b, 0xef, 0x57, 0xc1, 0x7a, 0xf7, 0x06, 0x15, 0xc9, 0xa5, 0x39, 0x25, 0x9f, 0x56,
+	0x36, 0xd5, 0x38, 0xe4, 0x5d, 0xb3, 0x3b, 0x47, 0x7f, 0x07, 0x00, 0x97, 0x60, 0x00, 0xd6, 0xc8,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Apy) Marshal() (dAtA []byte, err error) {
@@ -138,6 +234,101 @@ func (m *Apy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountRewardRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRewardRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRewardRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectedRewards) > 0 {
+		for iNdEx := len(m.ProjectedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardsPerSecond) > 0 {
+		for iNdEx := len(m.RewardsPerSecond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerSecond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SourceID) > 0 {
+		i -= len(m.SourceID)
+		copy(dAtA[i:], m.SourceID)
+		i = encodeVarintApy(dAtA, i, uint64(len(m.SourceID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintApy(dAtA, i, uint64(len(m.SourceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApy(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApy(v)
 	base := offset
@@ -164,6 +355,41 @@ func (m *Apy) Size() (n int) {
 	return n
 }
 
+func (m *AccountRewardRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovApy(uint64(l))
+	}
+	l = len(m.SourceID)
+	if l > 0 {
+		n += 1 + l + sovApy(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovApy(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovApy(uint64(l))
+	l = m.Share.Size()
+	n += 1 + l + sovApy(uint64(l))
+	if len(m.RewardsPerSecond) > 0 {
+		for _, e := range m.RewardsPerSecond {
+			l = e.Size()
+			n += 1 + l + sovApy(uint64(l))
+		}
+	}
+	if len(m.ProjectedRewards) > 0 {
+		for _, e := range m.ProjectedRewards {
+			l = e.Size()
+			n += 1 + l + sovApy(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovApy(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -286,6 +512,290 @@ func (m *Apy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccountRewardRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRewardRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRewardRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerSecond = append(m.RewardsPerSecond, types.DecCoin{})
+			if err := m.RewardsPerSecond[len(m.RewardsPerSecond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectedRewards = append(m.ProjectedRewards, types.DecCoin{})
+			if err := m.ProjectedRewards[len(m.ProjectedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApy(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func TestNewAccountRewardRate(t *testing.T) {
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	rewardsPerSecond := sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 1000))
+
+	testCases := []struct {
+		name                string
+		shares, totalShares sdk.Dec
+		periodEnd           time.Time
+		expShare            sdk.Dec
+		expRewardsPerSecond sdk.DecCoins
+		expProjected        sdk.DecCoins
+	}{
+		{
+			name:                "projects over the projection duration",
+			shares:              sdk.NewDec(1),
+			totalShares:         sdk.NewDec(4),
+			periodEnd:           blockTime.Add(365 * 24 * time.Hour),
+			expShare:            sdk.MustNewDecFromStr("0.25"),
+			expRewardsPerSecond: sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 250)),
+			expProjected:        sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 250*30*24*3600)),
+		},
+		{
+			name:                "projection stops at the end of the period",
+			shares:              sdk.NewDec(1),
+			totalShares:         sdk.NewDec(4),
+			periodEnd:           blockTime.Add(time.Hour),
+			expShare:            sdk.MustNewDecFromStr("0.25"),
+			expRewardsPerSecond: sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 250)),
+			expProjected:        sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 250*3600)),
+		},
+		{
+			name:                "no shares earns nothing",
+			shares:              sdk.ZeroDec(),
+			totalShares:         sdk.ZeroDec(),
+			periodEnd:           blockTime.Add(time.Hour),
+			expShare:            sdk.ZeroDec(),
+			expRewardsPerSecond: sdk.DecCoins{},
+			expProjected:        sdk.DecCoins{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rate := types.NewAccountRewardRate("swap", "ukava:usdx", tc.shares, tc.totalShares, rewardsPerSecond, blockTime, tc.periodEnd)
+			require.Equal(t, tc.expShare, rate.Share)
+			require.True(t, tc.expRewardsPerSecond.IsEqual(rate.RewardsPerSecond), "%s != %s", tc.expRewardsPerSecond, rate.RewardsPerSecond)
+			require.True(t, tc.expProjected.IsEqual(rate.ProjectedRewards), "%s != %s", tc.expProjected, rate.ProjectedRewards)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AutoCompoundInterval is the minimum time between compounds of an auto-compound setting
+	AutoCompoundInterval = 24 * time.Hour
+	// AutoCompoundMaxGasPerSetting is the most gas that compounding a single setting can use
+	AutoCompoundMaxGasPerSetting = 1_000_000
+	// AutoCompoundMaxGasPerBlock is the most gas spent compounding rewards in a block
+	AutoCompoundMaxGasPerBlock = 10_000_000
+	// AutoCompoundMaxScannedPerBlock is the most auto-compound settings read in a block when looking for settings to
+	// compound
+	AutoCompoundMaxScannedPerBlock = 100
+)
+
+// AutoCompoundClaimTypes are the claim types that can be auto-compounded
+var AutoCompoundClaimTypes = []string{
+	USDXMintingClaimType,
+	HardLiquidityProviderClaimType,
+	DelegatorClaimType,
+	SwapClaimType,
+	EarnClaimType,
+}
+
+// IsAutoCompoundClaimType returns true if rewards of the claim type can be auto-compounded
+func IsAutoCompoundClaimType(claimType string) bool {
+	for _, ct := range AutoCompoundClaimTypes {
+		if ct == claimType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValid returns true if the target is a valid auto-compound target
+func (t AutoCompoundTarget) IsValid() bool {
+	return t == AUTO_COMPOUND_TARGET_HARD || t == AUTO_COMPOUND_TARGET_EARN
+}
+
+// NewAutoCompoundSetting returns a new AutoCompoundSetting
+func NewAutoCompoundSetting(
+	owner sdk.AccAddress, claimType string, denomsToClaim Selections, target AutoCompoundTarget, validator string,
+) AutoCompoundSetting {
+	return AutoCompoundSetting{
+		Owner:         owner,
+		ClaimType:     claimType,
+		DenomsToClaim: denomsToClaim,
+		Target:        target,
+		Validator:     validator,
+	}
+}
+
+// Validate performs a basic check of an AutoCompoundSetting's fields
+func (s AutoCompoundSetting) Validate() error {
+	if s.Owner.Empty() {
+		return fmt.Errorf("auto-compound setting owner cannot be empty")
+	}
+	if err := validateAutoCompound(s.ClaimType, s.DenomsToClaim, s.Target, s.Validator); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateAutoCompound checks the fields shared by auto-compound settings and messages
+func validateAutoCompound(claimType string, denomsToClaim Selections, target AutoCompoundTarget, validator string) error {
+	if !IsAutoCompoundClaimType(claimType) {
+		return errorsmod.Wrapf(ErrInvalidClaimType, "cannot auto-compound claim type '%s'", claimType)
+	}
+	if err := denomsToClaim.Validate(); err != nil {
+		return err
+	}
+	if !target.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidAutoCompoundTarget, "%s", target)
+	}
+	if validator != "" {
+		if target != AUTO_COMPOUND_TARGET_EARN {
+			return errorsmod.Wrap(ErrInvalidAutoCompoundTarget, "validator can only be set when compounding into earn")
+		}
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAutoCompoundTarget, "invalid validator address: %s", err)
+		}
+	}
+	return nil
+}
+
+// AutoCompoundSettings is a slice of AutoCompoundSetting
+type AutoCompoundSettings []AutoCompoundSetting
+
+// Validate checks if all the settings are valid and there are no duplicate settings for an owner and claim type.
+func (ss AutoCompoundSettings) Validate() error {
+	seen := make(map[string]bool)
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		key := s.Owner.String() + "/" + s.ClaimType
+		if seen[key] {
+			return fmt.Errorf("duplicate auto-compound setting for owner %s and claim type %s", s.Owner, s.ClaimType)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,596 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/incentive/v1beta1/auto_compound.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AutoCompoundTarget is the position that auto-compounded rewards are deposited into.
+type AutoCompoundTarget int32
+
+const (
+	// AUTO_COMPOUND_TARGET_UNSPECIFIED represents an unspecified or invalid target.
+	AUTO_COMPOUND_TARGET_UNSPECIFIED AutoCompoundTarget = 0
+	// AUTO_COMPOUND_TARGET_HARD deposits rewards into the owner's Hard supply position.
+	AUTO_COMPOUND_TARGET_HARD AutoCompoundTarget = 1
+	// AUTO_COMPOUND_TARGET_EARN deposits rewards into earn vaults. Rewards in the staking denom are delegated to a
+	// validator and minted into bkava before being deposited.
+	AUTO_COMPOUND_TARGET_EARN AutoCompoundTarget = 2
+)
+
+var AutoCompoundTarget_name = map[int32]string{
+	0: "AUTO_COMPOUND_TARGET_UNSPECIFIED",
+	1: "AUTO_COMPOUND_TARGET_HARD",
+	2: "AUTO_COMPOUND_TARGET_EARN",
+}
+
+var AutoCompoundTarget_value = map[string]int32{
+	"AUTO_COMPOUND_TARGET_UNSPECIFIED": 0,
+	"AUTO_COMPOUND_TARGET_HARD":        1,
+	"AUTO_COMPOUND_TARGET_EARN":        2,
+}
+
+func (x AutoCompoundTarget) String() string {
+	return proto.EnumName(AutoCompoundTarget_name, int32(x))
+}
+
+func (AutoCompoundTarget) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0020dc1ea146333a, []int{0}
+}
+
+// AutoCompoundSetting is an owner's choice to compound the rewards of a claim type back into a position.
+type AutoCompoundSetting struct {
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// claim_type is the reward source that is compounded, for example "hard_liquidity_provider".
+	ClaimType string `protobuf:"bytes,2,opt,name=claim_type,json=claimType,proto3" json:"claim_type,omitempty"`
+	// denoms_to_claim are the reward denoms to compound and the multipliers to claim them with.
+	DenomsToClaim Selections         `protobuf:"bytes,3,rep,name=denoms_to_claim,json=denomsToClaim,proto3,castrepeated=Selections" json:"denoms_to_claim"`
+	Target        AutoCompoundTarget `protobuf:"varint,4,opt,name=target,proto3,enum=kava.incentive.v1beta1.AutoCompoundTarget" json:"target,omitempty"`
+	// validator is the validator that staking denom rewards are delegated to when compounding into earn.
+	Validator string `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty"`
+	// last_compound_time is when the rewards were last compounded.
+	LastCompoundTime time.Time `protobuf:"bytes,6,opt,name=last_compound_time,json=lastCompoundTime,proto3,stdtime" json:"last_compound_time"`
+}
+
+func (m *AutoCompoundSetting) Reset()         { *m = AutoCompoundSetting{} }
+func (m *AutoCompoundSetting) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundSetting) ProtoMessage()    {}
+func (*AutoCompoundSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0020dc1ea146333a, []int{0}
+}
+func (m *AutoCompoundSetting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundSetting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundSetting.Merge(m, src)
+}
+func (m *AutoCompoundSetting) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundSetting proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("kava.incentive.v1beta1.AutoCompoundTarget", AutoCompoundTarget_name, AutoCompoundTarget_value)
+	proto.RegisterType((*AutoCompoundSetting)(nil), "kava.incentive.v1beta1.AutoCompoundSetting")
+}
+
+func init() {
+	proto.RegisterFile("kava/incentive/v1beta1/auto_compound.proto", fileDescriptor_0020dc1ea146333a)
+}
+
+var fileDescriptor_0020dc1ea146333a = []byte{
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xb5, 0xfb, 0x12, 0x99, 0xf2, 0x88, 0xa6, 0x08, 0xb9, 0x91, 0xe2, 0xb8, 0xc0, 0x22, 0x0a,
+	0x8a, 0xad, 0x86, 0x0f, 0x40, 0x76, 0x12, 0x68, 0x16, 0x24, 0x95, 0xe3, 0xb0, 0x40, 0x02, 0x6b,
+	0x62, 0x0f, 0xc6, 0xaa, 0xed, 0x89, 0x3c, 0x93, 0x40, 0x36, 0xac, 0x59, 0xf6, 0x1f, 0xd8, 0xb1,
+	0xee, 0x47, 0x64, 0xc1, 0xa2, 0xea, 0x8a, 0x55, 0x0b, 0xc9, 0x5f, 0xb0, 0x42, 0x63, 0x4f, 0x42,
+	0x24, 0x9a, 0xd5, 0xcc, 0x9c, 0x39, 0xe7, 0xea, 0x9c, 0x7b, 0x2f, 0xa8, 0x9d, 0xa1, 0x09, 0x32,
+	0xc2, 0xc4, 0xc3, 0x09, 0x0b, 0x27, 0xd8, 0x98, 0x1c, 0x0f, 0x31, 0x43, 0xc7, 0x06, 0x1a, 0x33,
+	0xe2, 0x7a, 0x24, 0x1e, 0x91, 0x71, 0xe2, 0xeb, 0xa3, 0x94, 0x30, 0x02, 0x1f, 0x71, 0xae, 0xbe,
+	0xe2, 0xea, 0x82, 0x5b, 0x3a, 0xf4, 0x08, 0x8d, 0x09, 0x75, 0x33, 0x96, 0x91, 0x3f, 0x72, 0x49,
+	0xe9, 0x61, 0x40, 0x02, 0x92, 0xe3, 0xfc, 0x26, 0xd0, 0x4a, 0x40, 0x48, 0x10, 0x61, 0x23, 0x7b,
+	0x0d, 0xc7, 0x1f, 0x0c, 0x16, 0xc6, 0x98, 0x32, 0x14, 0x8f, 0x04, 0xe1, 0xc9, 0x06, 0x57, 0x5e,
+	0x84, 0xc2, 0x58, 0xd4, 0x7e, 0xfc, 0x63, 0x1b, 0x1c, 0x98, 0x63, 0x46, 0x9a, 0xc2, 0x65, 0x1f,
+	0x33, 0x16, 0x26, 0x01, 0x7c, 0x0f, 0x76, 0xc9, 0xa7, 0x04, 0xa7, 0x8a, 0xac, 0xc9, 0xd5, 0xbb,
+	0xd6, 0xc9, 0x9f, 0xeb, 0x4a, 0x3d, 0x08, 0xd9, 0xc7, 0xf1, 0x50, 0xf7, 0x48, 0x2c, 0xfc, 0x89,
+	0xa3, 0x4e, 0xfd, 0x33, 0x83, 0x4d, 0x47, 0x98, 0xea, 0xa6, 0xe7, 0x99, 0xbe, 0x9f, 0x62, 0x4a,
+	0xaf, 0x2e, 0xea, 0x07, 0x22, 0x85, 0x40, 0xac, 0x29, 0xc3, 0xd4, 0xce, 0xcb, 0xc2, 0x32, 0x00,
+	0x99, 0x0f, 0x97, 0xeb, 0x94, 0x2d, 0x4d, 0xae, 0x16, 0xec, 0x42, 0x86, 0x38, 0xd3, 0x11, 0x86,
+	0xef, 0xc0, 0x03, 0x1f, 0x27, 0x24, 0xa6, 0x2e, 0xef, 0x20, 0x87, 0x95, 0x6d, 0x6d, 0xbb, 0xba,
+	0xdf, 0x38, 0xd2, 0x6f, 0xef, 0x9f, 0xde, 0xc7, 0x11, 0xf6, 0x58, 0x48, 0x12, 0x0b, 0xce, 0xae,
+	0x2b, 0xd2, 0xf7, 0x9b, 0x0a, 0x58, 0x41, 0xd4, 0xbe, 0x97, 0x57, 0x73, 0x48, 0x93, 0xd7, 0x82,
+	0x16, 0xd8, 0x63, 0x28, 0x0d, 0x30, 0x53, 0x76, 0x34, 0xb9, 0x7a, 0xbf, 0x51, 0xdb, 0x54, 0x75,
+	0xbd, 0x35, 0x4e, 0xa6, 0xb0, 0x85, 0x12, 0xbe, 0x00, 0x85, 0x09, 0x8a, 0x42, 0x1f, 0x31, 0x92,
+	0x2a, 0xbb, 0x3c, 0x80, 0x75, 0x74, 0x75, 0x51, 0x2f, 0x8b, 0xd0, 0x6f, 0x96, 0x7f, 0x22, 0x7d,
+	0x9f, 0xa5, 0x61, 0x12, 0xd8, 0xff, 0x34, 0xd0, 0x06, 0x30, 0x42, 0x94, 0xad, 0x16, 0xc4, 0xe5,
+	0x03, 0x54, 0xf6, 0x34, 0xb9, 0xba, 0xdf, 0x28, 0xe9, 0xf9, 0x74, 0xf5, 0xe5, 0x74, 0x75, 0x67,
+	0x39, 0x5d, 0xeb, 0x0e, 0xcf, 0x77, 0x7e, 0x53, 0x91, 0xed, 0x22, 0xd7, 0xaf, 0xec, 0x85, 0x31,
+	0xae, 0x7d, 0x01, 0xf0, 0x7f, 0xcb, 0xf0, 0x29, 0xd0, 0xcc, 0x81, 0xd3, 0x73, 0x9b, 0xbd, 0xd7,
+	0xa7, 0xbd, 0x41, 0xb7, 0xe5, 0x3a, 0xa6, 0xfd, 0xaa, 0xed, 0xb8, 0x83, 0x6e, 0xff, 0xb4, 0xdd,
+	0xec, 0xbc, 0xec, 0xb4, 0x5b, 0x45, 0x09, 0x96, 0xc1, 0xe1, 0xad, 0xac, 0x13, 0xd3, 0x6e, 0x15,
+	0xe5, 0x8d, 0xdf, 0x6d, 0xd3, 0xee, 0x16, 0xb7, 0x4a, 0x3b, 0x5f, 0xbf, 0xa9, 0x92, 0xd5, 0x99,
+	0xfd, 0x56, 0xa5, 0xd9, 0x5c, 0x95, 0x2f, 0xe7, 0xaa, 0xfc, 0x6b, 0xae, 0xca, 0xe7, 0x0b, 0x55,
+	0xba, 0x5c, 0xa8, 0xd2, 0xcf, 0x85, 0x2a, 0xbd, 0x7d, 0xb6, 0xb6, 0x41, 0xbc, 0xe1, 0xf5, 0x08,
+	0x0d, 0x69, 0x76, 0x33, 0x3e, 0xaf, 0x2d, 0x6a, 0xb6, 0x4a, 0xc3, 0xbd, 0x2c, 0xfa, 0xf3, 0xbf,
+	0x03, 0x00, 0x34, 0x56, 0xf1, 0xe3, 0x5d, 0x03, 0x00, 0x00,
+}
+
+func (m *AutoCompoundSetting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundSetting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundSetting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastCompoundTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastCompoundTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAutoCompound(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Target != 0 {
+		i = encodeVarintAutoCompound(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for iNdEx := len(m.DenomsToClaim) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomsToClaim[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAutoCompound(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ClaimType) > 0 {
+		i -= len(m.ClaimType)
+		copy(dAtA[i:], m.ClaimType)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.ClaimType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAutoCompound(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAutoCompound(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AutoCompoundSetting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	l = len(m.ClaimType)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovAutoCompound(uint64(l))
+		}
+	}
+	if m.Target != 0 {
+		n += 1 + sovAutoCompound(uint64(m.Target))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastCompoundTime)
+	n += 1 + l + sovAutoCompound(uint64(l))
+	return n
+}
+
+func sovAutoCompound(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAutoCompound(x uint64) (n int) {
+	return sovAutoCompound(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AutoCompoundSetting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoCompound
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundSetting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundSetting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
+			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= AutoCompoundTarget(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompoundTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastCompoundTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoCompound(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAutoCompound(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAutoCompound
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAutoCompound
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAutoCompound
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAutoCompound
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAutoCompound        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAutoCompound          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAutoCompound = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BoostLockAccountName is the name of the module account holding the coins of boost locks
+const BoostLockAccountName = "incentive_boost_lock"
+
+var (
+	DefaultBoostMaxLockDuration = 4 * 365 * 24 * time.Hour
+	DefaultBoostMaxFactor       = sdk.MustNewDecFromStr("2.5")
+	DefaultBoostParams          = NewBoostParams(DefaultBoostMaxLockDuration, DefaultBoostMaxFactor, []string{})
+
+	DefaultBoostLocks        = BoostLocks{}
+	DefaultBoostedSharesList = BoostedSharesList{}
+)
+
+// NewBoostParams returns a new BoostParams
+func NewBoostParams(maxLockDuration time.Duration, maxBoostFactor sdk.Dec, sourceTypes []string) BoostParams {
+	return BoostParams{
+		MaxLockDuration: maxLockDuration,
+		MaxBoostFactor:  maxBoostFactor,
+		SourceTypes:     sourceTypes,
+	}
+}
+
+// Validate performs a basic check of the boost params
+func (p BoostParams) Validate() error {
+	if p.MaxLockDuration <= 0 {
+		return fmt.Errorf("boost max lock duration must be positive: %s", p.MaxLockDuration)
+	}
+	if p.MaxBoostFactor.IsNil() || p.MaxBoostFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("boost max factor must be at least 1: %s", p.MaxBoostFactor)
+	}
+
+	seen := make(map[string]bool)
+	for _, sourceType := range p.SourceTypes {
+		// built in source types can be boosted, unlike being rewarded through the source reward periods param
+		if err := ValidateRewardSourceType(sourceType); err != nil {
+			return err
+		}
+		if seen[sourceType] {
+			return fmt.Errorf("duplicate boosted source type %s", sourceType)
+		}
+		seen[sourceType] = true
+	}
+	return nil
+}
+
+// IsBoostedSourceType returns true if the shares of a source type are boosted
+func (p BoostParams) IsBoostedSourceType(sourceType string) bool {
+	for _, st := range p.SourceTypes {
+		if st == sourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// CalculateBoostWeight returns the weight of locked coins worth value ukava, that end after the remaining duration.
+// Locking for the max lock duration gives a weight equal to the value, which decreases linearly with shorter locks.
+func CalculateBoostWeight(value sdk.Dec, remaining, maxLockDuration time.Duration) sdk.Dec {
+	if remaining <= 0 || !value.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	if remaining > maxLockDuration {
+		remaining = maxLockDuration
+	}
+	return value.MulInt64(int64(remaining.Seconds())).QuoInt64(int64(maxLockDuration.Seconds()))
+}
+
+// CalculateBoostedShares returns the shares a boost adds to an owner's shares in a source.
+//
+// The owner's shares are raised by a factor of 1 + (maxBoostFactor - 1) * min(1, (weight/totalWeight)/(shares/totalShares)),
+// so an owner with the same fraction of boost weight as they have of a source's shares earns the full boost.
+func CalculateBoostedShares(shares, totalShares, weight, totalWeight, maxBoostFactor sdk.Dec) sdk.Dec {
+	if !shares.IsPositive() || !totalShares.IsPositive() || !weight.IsPositive() || !totalWeight.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	// shares * min(1, (weight/totalWeight)/(shares/totalShares)) = min(shares, totalShares * weight/totalWeight)
+	boostable := sdk.MinDec(shares, totalShares.Mul(weight).Quo(totalWeight))
+	return boostable.Mul(maxBoostFactor.Sub(sdk.OneDec()))
+}
+
+// NewBoostLock returns a new BoostLock
+func NewBoostLock(owner sdk.AccAddress, amount sdk.Coin, start, end time.Time, weight sdk.Dec) BoostLock {
+	return BoostLock{
+		Owner:  owner,
+		Amount: amount,
+		Start:  start,
+		End:    end,
+		Weight: weight,
+	}
+}
+
+// Validate performs a basic check of a BoostLock's fields
+func (l BoostLock) Validate() error {
+	if l.Owner.Empty() {
+		return fmt.Errorf("boost lock owner cannot be empty")
+	}
+	if !l.Amount.IsValid() || !l.Amount.IsPositive() {
+		return fmt.Errorf("invalid boost lock amount: %s", l.Amount)
+	}
+	if l.End.Before(l.Start) {
+		return fmt.Errorf("boost lock end %s is before start %s", l.End, l.Start)
+	}
+	if l.Weight.IsNil() || l.Weight.IsNegative() {
+		return fmt.Errorf("boost lock weight cannot be negative: %s", l.Weight)
+	}
+	return nil
+}
+
+// BoostLocks is a slice of BoostLock
+type BoostLocks []BoostLock
+
+// Validate checks if all the locks are valid and there is at most one lock per owner.
+func (ls BoostLocks) Validate() error {
+	seen := make(map[string]bool)
+	for _, l := range ls {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		if seen[l.Owner.String()] {
+			return fmt.Errorf("duplicate boost lock for owner %s", l.Owner)
+		}
+		seen[l.Owner.String()] = true
+	}
+	return nil
+}
+
+// NewBoostedShares returns a new BoostedShares
+func NewBoostedShares(sourceType, sourceID string, owner sdk.AccAddress, shares sdk.Dec) BoostedShares {
+	return BoostedShares{
+		SourceType: sourceType,
+		SourceID:   sourceID,
+		Owner:      owner,
+		Shares:     shares,
+	}
+}
+
+// Validate performs a basic check of a BoostedShares' fields
+func (bs BoostedShares) Validate() error {
+	if err := ValidateRewardSourceType(bs.SourceType); err != nil {
+		return err
+	}
+	if bs.SourceID == "" {
+		return fmt.Errorf("boosted shares source id cannot be empty")
+	}
+	if bs.Owner.Empty() {
+		return fmt.Errorf("boosted shares owner cannot be empty")
+	}
+	if bs.Shares.IsNil() || !bs.Shares.IsPositive() {
+		return fmt.Errorf("boosted shares must be positive: %s", bs.Shares)
+	}
+	return nil
+}
+
+// BoostedSharesList is a slice of BoostedShares
+type BoostedSharesList []BoostedShares
+
+// Validate checks if all the boosted shares are valid and there are no duplicates for an owner and source.
+func (l BoostedSharesList) Validate() error {
+	seen := make(map[string]bool)
+	for _, bs := range l {
+		if err := bs.Validate(); err != nil {
+			return err
+		}
+		key := bs.SourceType + "/" + bs.SourceID + "/" + bs.Owner.String()
+		if seen[key] {
+			return fmt.Errorf("duplicate boosted shares for owner %s in source %s %s", bs.Owner, bs.SourceType, bs.SourceID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
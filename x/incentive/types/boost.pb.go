@@ -0,0 +1,1095 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/incentive/v1beta1/boost.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BoostParams configure the boost that locking KAVA or bkava gives to rewards of registered reward sources.
+type BoostParams struct {
+	// max_lock_duration is the longest time coins can be locked for, which earns the full boost weight.
+	MaxLockDuration time.Duration `protobuf:"bytes,1,opt,name=max_lock_duration,json=maxLockDuration,proto3,stdduration" json:"max_lock_duration"`
+	// max_boost_factor is the largest factor an owner's shares in a source can be raised by.
+	MaxBoostFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_boost_factor,json=maxBoostFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_boost_factor"`
+	// source_types are the registered reward source types that are boosted.
+	SourceTypes []string `protobuf:"bytes,3,rep,name=source_types,json=sourceTypes,proto3" json:"source_types,omitempty"`
+}
+
+func (m *BoostParams) Reset()         { *m = BoostParams{} }
+func (m *BoostParams) String() string { return proto.CompactTextString(m) }
+func (*BoostParams) ProtoMessage()    {}
+func (*BoostParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3460768de0d7e2f, []int{0}
+}
+func (m *BoostParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BoostParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BoostParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BoostParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoostParams.Merge(m, src)
+}
+func (m *BoostParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BoostParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoostParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoostParams proto.InternalMessageInfo
+
+// BoostLock is an owner's locked coins, which give a boost weight until the lock ends.
+type BoostLock struct {
+	Owner  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Amount types.Coin                                    `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// start is when the lock was created.
+	Start time.Time `protobuf:"bytes,3,opt,name=start,proto3,stdtime" json:"start"`
+	// end is when the coins can be withdrawn. The lock stops giving a boost at this time.
+	End time.Time `protobuf:"bytes,4,opt,name=end,proto3,stdtime" json:"end"`
+	// weight is the value of the locked coins in ukava, scaled by the lock duration over the max lock duration. It is
+	// zero once the lock has ended.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *BoostLock) Reset()         { *m = BoostLock{} }
+func (m *BoostLock) String() string { return proto.CompactTextString(m) }
+func (*BoostLock) ProtoMessage()    {}
+func (*BoostLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3460768de0d7e2f, []int{1}
+}
+func (m *BoostLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BoostLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BoostLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BoostLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoostLock.Merge(m, src)
+}
+func (m *BoostLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *BoostLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoostLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoostLock proto.InternalMessageInfo
+
+// BoostedShares are the shares an owner's boost added to a source when it was last updated.
+type BoostedShares struct {
+	SourceType string                                        `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	SourceID   string                                        `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Owner      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Shares     github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,4,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *BoostedShares) Reset()         { *m = BoostedShares{} }
+func (m *BoostedShares) String() string { return proto.CompactTextString(m) }
+func (*BoostedShares) ProtoMessage()    {}
+func (*BoostedShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3460768de0d7e2f, []int{2}
+}
+func (m *BoostedShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BoostedShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BoostedShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BoostedShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoostedShares.Merge(m, src)
+}
+func (m *BoostedShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *BoostedShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoostedShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoostedShares proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BoostParams)(nil), "kava.incentive.v1beta1.BoostParams")
+	proto.RegisterType((*BoostLock)(nil), "kava.incentive.v1beta1.BoostLock")
+	proto.RegisterType((*BoostedShares)(nil), "kava.incentive.v1beta1.BoostedShares")
+}
+
+func init() {
+	proto.RegisterFile("kava/incentive/v1beta1/boost.proto", fileDescriptor_b3460768de0d7e2f)
+}
+
+var fileDescriptor_b3460768de0d7e2f = []byte{
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x8f, 0xd2, 0x4e,
+	0x18, 0xa6, 0xb0, 0x4b, 0x60, 0xe0, 0xf7, 0x53, 0x47, 0x63, 0xba, 0x1c, 0x5a, 0xe4, 0x60, 0x30,
+	0x86, 0x36, 0xab, 0x89, 0x26, 0xc6, 0xcb, 0x56, 0x62, 0x24, 0x31, 0xd1, 0x74, 0x39, 0x79, 0x90,
+	0x4c, 0xa7, 0x43, 0x69, 0xa0, 0x1d, 0xd2, 0x19, 0x58, 0xf6, 0x5b, 0xac, 0x37, 0x3f, 0xc8, 0x7e,
+	0x08, 0x8e, 0x9b, 0x3d, 0x19, 0x0f, 0xa8, 0x70, 0xf2, 0xe2, 0x07, 0xf0, 0x64, 0xe6, 0x4f, 0x85,
+	0xe8, 0x45, 0x93, 0xf5, 0xd4, 0x99, 0xf7, 0x7d, 0x9e, 0xa7, 0xef, 0x3c, 0xcf, 0x64, 0x40, 0x6b,
+	0x8c, 0xe6, 0xc8, 0x8d, 0x53, 0x4c, 0x52, 0x1e, 0xcf, 0x89, 0x3b, 0x3f, 0x0c, 0x08, 0x47, 0x87,
+	0x6e, 0x40, 0x29, 0xe3, 0xce, 0x34, 0xa3, 0x9c, 0xc2, 0xdb, 0x02, 0xe3, 0xfc, 0xc4, 0x38, 0x1a,
+	0xd3, 0xb0, 0x30, 0x65, 0x09, 0x65, 0x6e, 0x80, 0xd8, 0x96, 0x88, 0x69, 0x9c, 0x2a, 0x5e, 0xe3,
+	0x40, 0xf5, 0x07, 0x72, 0xe7, 0xaa, 0x8d, 0x6e, 0xdd, 0x8a, 0x68, 0x44, 0x55, 0x5d, 0xac, 0x74,
+	0xd5, 0x8a, 0x28, 0x8d, 0x26, 0xc4, 0x95, 0xbb, 0x60, 0x36, 0x74, 0xc3, 0x59, 0x86, 0x78, 0x4c,
+	0x73, 0x41, 0xfb, 0xd7, 0x3e, 0x8f, 0x13, 0xc2, 0x38, 0x4a, 0xa6, 0x0a, 0xd0, 0xfa, 0x6a, 0x80,
+	0x9a, 0x27, 0x26, 0x7f, 0x8d, 0x32, 0x94, 0x30, 0xf8, 0x0a, 0xdc, 0x48, 0xd0, 0x62, 0x30, 0xa1,
+	0x78, 0x3c, 0xc8, 0xb5, 0x4c, 0xa3, 0x69, 0xb4, 0x6b, 0x0f, 0x0e, 0x1c, 0x25, 0xe6, 0xe4, 0x62,
+	0x4e, 0x57, 0x03, 0xbc, 0xca, 0x72, 0x65, 0x17, 0xde, 0x7f, 0xb2, 0x0d, 0xff, 0x5a, 0x82, 0x16,
+	0x2f, 0x29, 0x1e, 0xe7, 0x2d, 0x38, 0x04, 0xd7, 0x85, 0xa0, 0x74, 0x67, 0x30, 0x44, 0x98, 0xd3,
+	0xcc, 0x2c, 0x36, 0x8d, 0x76, 0xd5, 0x7b, 0x2a, 0x48, 0x1f, 0x57, 0xf6, 0xdd, 0x28, 0xe6, 0xa3,
+	0x59, 0xe0, 0x60, 0x9a, 0xe8, 0x23, 0xeb, 0x4f, 0x87, 0x85, 0x63, 0x97, 0x9f, 0x4e, 0x09, 0x73,
+	0xba, 0x04, 0x5f, 0x9e, 0x77, 0x80, 0x76, 0xa4, 0x4b, 0xb0, 0xff, 0x7f, 0x82, 0x16, 0x72, 0xf0,
+	0xe7, 0x52, 0x13, 0xde, 0x01, 0x75, 0x46, 0x67, 0x19, 0x26, 0x03, 0xc9, 0x30, 0x4b, 0xcd, 0x52,
+	0xbb, 0xea, 0xd7, 0x54, 0xad, 0x2f, 0x4a, 0xad, 0x6f, 0x45, 0x50, 0x95, 0x14, 0x31, 0x20, 0x7c,
+	0x0b, 0xf6, 0xe9, 0x49, 0x4a, 0x32, 0x79, 0xba, 0xba, 0xf7, 0xe2, 0xfb, 0xca, 0xee, 0xfc, 0xc1,
+	0x24, 0x47, 0x18, 0x1f, 0x85, 0x61, 0x46, 0x18, 0xbb, 0x3c, 0xef, 0xdc, 0xd4, 0x03, 0xe9, 0x8a,
+	0x77, 0xca, 0x09, 0xf3, 0x95, 0x2c, 0x7c, 0x0c, 0xca, 0x28, 0xa1, 0xb3, 0x94, 0x9b, 0x45, 0x6d,
+	0x9f, 0x06, 0x8b, 0xf0, 0xf3, 0x1b, 0xe1, 0x3c, 0xa3, 0x71, 0xea, 0xed, 0x09, 0x27, 0x7c, 0x0d,
+	0x87, 0x4f, 0xc0, 0x3e, 0xe3, 0x28, 0xe3, 0x66, 0x49, 0xf2, 0x1a, 0xbf, 0xd9, 0xde, 0xcf, 0x33,
+	0x54, 0xbe, 0x9f, 0x09, 0xdf, 0x15, 0x05, 0x3e, 0x02, 0x25, 0x92, 0x86, 0xe6, 0xde, 0x5f, 0x30,
+	0x05, 0x01, 0xf6, 0x41, 0xf9, 0x84, 0xc4, 0xd1, 0x88, 0x9b, 0xfb, 0x57, 0x90, 0x8d, 0xd6, 0x6a,
+	0xbd, 0x2b, 0x82, 0xff, 0xa4, 0xe1, 0x24, 0x3c, 0x1e, 0xa1, 0x8c, 0x30, 0x68, 0x83, 0xda, 0x4e,
+	0x4a, 0xd2, 0xfa, 0xaa, 0x0f, 0xb6, 0x21, 0xc1, 0x7b, 0xa0, 0xaa, 0x01, 0x71, 0xa8, 0xef, 0x49,
+	0x7d, 0xbd, 0xb2, 0x2b, 0xc7, 0xb2, 0xd8, 0xeb, 0xfa, 0x15, 0xd5, 0xee, 0x85, 0xdb, 0x00, 0x4b,
+	0xff, 0x26, 0xc0, 0x3e, 0x28, 0x33, 0x39, 0xb5, 0xb9, 0x77, 0x15, 0x9e, 0x28, 0x2d, 0xaf, 0xb7,
+	0xfc, 0x62, 0x15, 0x96, 0x6b, 0xcb, 0xb8, 0x58, 0x5b, 0xc6, 0xe7, 0xb5, 0x65, 0x9c, 0x6d, 0xac,
+	0xc2, 0xc5, 0xc6, 0x2a, 0x7c, 0xd8, 0x58, 0x85, 0x37, 0xf7, 0x77, 0xb4, 0xc5, 0x1b, 0xd2, 0x99,
+	0xa0, 0x80, 0xc9, 0x95, 0xbb, 0xd8, 0x79, 0x73, 0xe4, 0x4f, 0x82, 0xb2, 0xcc, 0xf5, 0xe1, 0x8f,
+	0x01, 0x00, 0x4b, 0x73, 0x80, 0x9a, 0x92, 0x04, 0x00, 0x00,
+}
+
+func (m *BoostParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoostParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoostParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceTypes) > 0 {
+		for iNdEx := len(m.SourceTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceTypes[iNdEx])
+			copy(dAtA[i:], m.SourceTypes[iNdEx])
+			i = encodeVarintBoost(dAtA, i, uint64(len(m.SourceTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.MaxBoostFactor.Size()
+		i -= size
+		if _, err := m.MaxBoostFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBoost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxLockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLockDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBoost(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BoostLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoostLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoostLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBoost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintBoost(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBoost(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBoost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintBoost(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BoostedShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoostedShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoostedShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBoost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintBoost(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceID) > 0 {
+		i -= len(m.SourceID)
+		copy(dAtA[i:], m.SourceID)
+		i = encodeVarintBoost(dAtA, i, uint64(len(m.SourceID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintBoost(dAtA, i, uint64(len(m.SourceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBoost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBoost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BoostParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLockDuration)
+	n += 1 + l + sovBoost(uint64(l))
+	l = m.MaxBoostFactor.Size()
+	n += 1 + l + sovBoost(uint64(l))
+	if len(m.SourceTypes) > 0 {
+		for _, s := range m.SourceTypes {
+			l = len(s)
+			n += 1 + l + sovBoost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BoostLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovBoost(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBoost(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovBoost(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovBoost(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovBoost(uint64(l))
+	return n
+}
+
+func (m *BoostedShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovBoost(uint64(l))
+	}
+	l = len(m.SourceID)
+	if l > 0 {
+		n += 1 + l + sovBoost(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovBoost(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovBoost(uint64(l))
+	return n
+}
+
+func sovBoost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBoost(x uint64) (n int) {
+	return sovBoost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BoostParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBoost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoostParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoostParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxLockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBoostFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBoostFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceTypes = append(m.SourceTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBoost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoostLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBoost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoostLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoostLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBoost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoostedShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBoost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoostedShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoostedShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBoost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBoost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBoost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBoost(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBoost
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBoost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBoost
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBoost
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBoost
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBoost        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBoost          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBoost = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,173 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func TestCalculateBoostWeight(t *testing.T) {
+	maxDuration := 4 * 365 * 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		value     sdk.Dec
+		remaining time.Duration
+		expected  sdk.Dec
+	}{
+		{
+			name:      "max duration gives full weight",
+			value:     sdk.NewDec(1000),
+			remaining: maxDuration,
+			expected:  sdk.NewDec(1000),
+		},
+		{
+			name:      "weight decreases linearly",
+			value:     sdk.NewDec(1000),
+			remaining: maxDuration / 4,
+			expected:  sdk.NewDec(250),
+		},
+		{
+			name:      "durations above max are capped",
+			value:     sdk.NewDec(1000),
+			remaining: 2 * maxDuration,
+			expected:  sdk.NewDec(1000),
+		},
+		{
+			name:      "ended locks have no weight",
+			value:     sdk.NewDec(1000),
+			remaining: 0,
+			expected:  sdk.ZeroDec(),
+		},
+		{
+			name:      "zero value has no weight",
+			value:     sdk.ZeroDec(),
+			remaining: maxDuration,
+			expected:  sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.CalculateBoostWeight(tc.value, tc.remaining, maxDuration))
+		})
+	}
+}
+
+func TestCalculateBoostedShares(t *testing.T) {
+	maxFactor := sdk.MustNewDecFromStr("2.5")
+
+	tests := []struct {
+		name        string
+		shares      sdk.Dec
+		totalShares sdk.Dec
+		weight      sdk.Dec
+		totalWeight sdk.Dec
+		expected    sdk.Dec
+	}{
+		{
+			name:        "all weight gives full boost",
+			shares:      sdk.NewDec(1),
+			totalShares: sdk.NewDec(4),
+			weight:      sdk.NewDec(100),
+			totalWeight: sdk.NewDec(100),
+			expected:    sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name:        "equal fraction of weight and shares gives full boost",
+			shares:      sdk.NewDec(1),
+			totalShares: sdk.NewDec(4),
+			weight:      sdk.NewDec(25),
+			totalWeight: sdk.NewDec(100),
+			expected:    sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name:        "smaller fraction of weight gives partial boost",
+			shares:      sdk.NewDec(2),
+			totalShares: sdk.NewDec(4),
+			weight:      sdk.NewDec(25),
+			totalWeight: sdk.NewDec(100),
+			expected:    sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name:        "no weight gives no boost",
+			shares:      sdk.NewDec(1),
+			totalShares: sdk.NewDec(4),
+			weight:      sdk.ZeroDec(),
+			totalWeight: sdk.NewDec(100),
+			expected:    sdk.ZeroDec(),
+		},
+		{
+			name:        "no shares gives no boost",
+			shares:      sdk.ZeroDec(),
+			totalShares: sdk.NewDec(4),
+			weight:      sdk.NewDec(100),
+			totalWeight: sdk.NewDec(100),
+			expected:    sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			boosted := types.CalculateBoostedShares(tc.shares, tc.totalShares, tc.weight, tc.totalWeight, maxFactor)
+			require.Equal(t, tc.expected, boosted)
+		})
+	}
+}
+
+func TestBoostLocks_Validate(t *testing.T) {
+	owner := sdk.AccAddress("test1")
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	validLock := types.NewBoostLock(owner, sdk.NewInt64Coin("ukava", 1000), start, start.Add(time.Hour), sdk.NewDec(1))
+
+	tests := []struct {
+		name    string
+		locks   types.BoostLocks
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			locks: types.BoostLocks{validLock},
+		},
+		{
+			name:    "duplicate owner",
+			locks:   types.BoostLocks{validLock, validLock},
+			wantErr: true,
+		},
+		{
+			name:    "empty owner",
+			locks:   types.BoostLocks{types.NewBoostLock(nil, validLock.Amount, validLock.Start, validLock.End, validLock.Weight)},
+			wantErr: true,
+		},
+		{
+			name:    "zero amount",
+			locks:   types.BoostLocks{types.NewBoostLock(owner, sdk.NewInt64Coin("ukava", 0), validLock.Start, validLock.End, validLock.Weight)},
+			wantErr: true,
+		},
+		{
+			name:    "end before start",
+			locks:   types.BoostLocks{types.NewBoostLock(owner, validLock.Amount, validLock.End, validLock.Start, validLock.Weight)},
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			locks:   types.BoostLocks{types.NewBoostLock(owner, validLock.Amount, validLock.Start, validLock.End, sdk.NewDec(-1))},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.locks.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CampaignAccountName is the name of the module account holding the undistributed rewards of incentive campaigns
+const CampaignAccountName = "incentive_campaign"
+
+var (
+	DefaultCampaignCreationFee = sdk.NewCoins(sdk.NewCoin(BondDenom, sdk.NewInt(100_000_000)))
+	DefaultCampaignMaxDuration = 365 * 24 * time.Hour
+	DefaultCampaignParams      = NewCampaignParams(DefaultCampaignCreationFee, DefaultCampaignMaxDuration)
+
+	DefaultCampaigns             = IncentiveCampaigns{}
+	DefaultNextCampaignID uint64 = 1
+)
+
+// NewCampaignParams returns a new CampaignParams
+func NewCampaignParams(creationFee sdk.Coins, maxDuration time.Duration) CampaignParams {
+	return CampaignParams{
+		CreationFee: creationFee,
+		MaxDuration: maxDuration,
+	}
+}
+
+// Validate performs a basic check of the campaign params
+func (p CampaignParams) Validate() error {
+	if !p.CreationFee.IsValid() {
+		return fmt.Errorf("invalid campaign creation fee: %s", p.CreationFee)
+	}
+	if p.MaxDuration <= 0 {
+		return fmt.Errorf("campaign max duration must be positive: %s", p.MaxDuration)
+	}
+	return nil
+}
+
+// NewIncentiveCampaign returns a new IncentiveCampaign with nothing distributed
+func NewIncentiveCampaign(
+	id uint64, creator sdk.AccAddress, sourceType, sourceID string, amount sdk.Coins, start, end, accrualTime time.Time,
+) IncentiveCampaign {
+	return IncentiveCampaign{
+		ID:                  id,
+		Creator:             creator,
+		SourceType:          sourceType,
+		SourceID:            sourceID,
+		Amount:              amount,
+		Start:               start,
+		End:                 end,
+		Distributed:         sdk.DecCoins{},
+		PreviousAccrualTime: accrualTime,
+	}
+}
+
+// ValidateCampaignSourceType checks a campaign can pay rewards to a source type. Campaigns can pay rewards to swap
+// pools, earn vaults, and registered reward sources.
+func ValidateCampaignSourceType(sourceType string) error {
+	if sourceType == SwapRewardSourceType || sourceType == EarnRewardSourceType {
+		return nil
+	}
+	return ValidateSourceType(sourceType)
+}
+
+// Validate performs a basic check of an IncentiveCampaign's fields
+func (c IncentiveCampaign) Validate() error {
+	if c.ID == 0 {
+		return fmt.Errorf("campaign id cannot be 0")
+	}
+	if c.Creator.Empty() {
+		return fmt.Errorf("campaign creator cannot be empty")
+	}
+	if err := ValidateCampaignSourceType(c.SourceType); err != nil {
+		return err
+	}
+	if c.SourceID == "" {
+		return fmt.Errorf("campaign source id cannot be empty")
+	}
+	if !c.Amount.IsValid() || c.Amount.IsZero() {
+		return fmt.Errorf("invalid campaign amount: %s", c.Amount)
+	}
+	if !c.End.After(c.Start) {
+		return fmt.Errorf("campaign end %s must be after start %s", c.End, c.Start)
+	}
+	if !c.Distributed.IsValid() {
+		return fmt.Errorf("invalid campaign distributed amount: %s", c.Distributed)
+	}
+	if _, hasNeg := sdk.NewDecCoinsFromCoins(c.Amount...).SafeSub(c.Distributed); hasNeg {
+		return fmt.Errorf("campaign distributed amount %s is greater than its amount %s", c.Distributed, c.Amount)
+	}
+	return nil
+}
+
+// RewardsPerSecond returns the rate the campaign's rewards are streamed at between its start and end
+func (c IncentiveCampaign) RewardsPerSecond() sdk.DecCoins {
+	seconds := int64(c.End.Sub(c.Start).Seconds())
+	if seconds <= 0 {
+		return sdk.DecCoins{}
+	}
+	return sdk.NewDecCoinsFromCoins(c.Amount...).QuoDec(sdk.NewDec(seconds))
+}
+
+// Undistributed returns the rewards that have not yet been distributed
+func (c IncentiveCampaign) Undistributed() sdk.DecCoins {
+	return sdk.NewDecCoinsFromCoins(c.Amount...).Sub(c.Distributed)
+}
+
+// DistributedCoins returns the distributed rewards rounded up to whole coins. These are the coins that have been moved
+// out of escrow to pay claims.
+func (c IncentiveCampaign) DistributedCoins() sdk.Coins {
+	coins := sdk.NewCoins()
+	for _, dc := range c.Distributed {
+		coins = coins.Add(sdk.NewCoin(dc.Denom, dc.Amount.Ceil().TruncateInt()))
+	}
+	return coins
+}
+
+// Escrowed returns the coins of the campaign still held in escrow
+func (c IncentiveCampaign) Escrowed() sdk.Coins {
+	return c.Amount.Sub(c.DistributedCoins()...)
+}
+
+// IsEnded returns true if rewards have been distributed up to the campaign's end
+func (c IncentiveCampaign) IsEnded() bool {
+	return !c.PreviousAccrualTime.Before(c.End)
+}
+
+// IncentiveCampaigns is a slice of IncentiveCampaign
+type IncentiveCampaigns []IncentiveCampaign
+
+// Validate checks if all the campaigns are valid and there are no duplicate ids
+func (cs IncentiveCampaigns) Validate() error {
+	seen := make(map[uint64]bool)
+	for _, c := range cs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if seen[c.ID] {
+			return fmt.Errorf("duplicate campaign id %d", c.ID)
+		}
+		seen[c.ID] = true
+	}
+	return nil
+}
@@ -0,0 +1,935 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/incentive/v1beta1/campaign.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IncentiveCampaign is a third party reward for a swap pool, earn vault, or registered reward source, paid from coins
+// escrowed by its creator and streamed evenly between its start and end times.
+type IncentiveCampaign struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the account that escrowed the rewards, and is refunded any that are not distributed.
+	Creator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+	// source_type is the reward source type the campaign pays rewards to, such as swap or earn.
+	SourceType string `protobuf:"bytes,3,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// source_id is the source within the source type, such as a swap pool ID or an earn vault denom.
+	SourceID string `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// amount is the total rewards escrowed for the campaign.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Start  time.Time                                `protobuf:"bytes,6,opt,name=start,proto3,stdtime" json:"start"`
+	End    time.Time                                `protobuf:"bytes,7,opt,name=end,proto3,stdtime" json:"end"`
+	// distributed is the rewards added to the source's reward indexes so far. Rewards for times the source has no
+	// shares are not distributed.
+	Distributed github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"distributed"`
+	// previous_accrual_time is the time rewards were last distributed up to.
+	PreviousAccrualTime time.Time `protobuf:"bytes,9,opt,name=previous_accrual_time,json=previousAccrualTime,proto3,stdtime" json:"previous_accrual_time"`
+}
+
+func (m *IncentiveCampaign) Reset()         { *m = IncentiveCampaign{} }
+func (m *IncentiveCampaign) String() string { return proto.CompactTextString(m) }
+func (*IncentiveCampaign) ProtoMessage()    {}
+func (*IncentiveCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05eee46dc85b8934, []int{0}
+}
+func (m *IncentiveCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveCampaign.Merge(m, src)
+}
+func (m *IncentiveCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveCampaign proto.InternalMessageInfo
+
+// CampaignParams limit the incentive campaigns that any account can create.
+type CampaignParams struct {
+	// creation_fee is paid to the community pool by the creator of each campaign.
+	CreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=creation_fee,json=creationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_fee"`
+	// max_duration is the latest a campaign can end after it is created.
+	MaxDuration time.Duration `protobuf:"bytes,2,opt,name=max_duration,json=maxDuration,proto3,stdduration" json:"max_duration"`
+}
+
+func (m *CampaignParams) Reset()         { *m = CampaignParams{} }
+func (m *CampaignParams) String() string { return proto.CompactTextString(m) }
+func (*CampaignParams) ProtoMessage()    {}
+func (*CampaignParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05eee46dc85b8934, []int{1}
+}
+func (m *CampaignParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CampaignParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CampaignParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CampaignParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignParams.Merge(m, src)
+}
+func (m *CampaignParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *CampaignParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignParams proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*IncentiveCampaign)(nil), "kava.incentive.v1beta1.IncentiveCampaign")
+	proto.RegisterType((*CampaignParams)(nil), "kava.incentive.v1beta1.CampaignParams")
+}
+
+func init() {
+	proto.RegisterFile("kava/incentive/v1beta1/campaign.proto", fileDescriptor_05eee46dc85b8934)
+}
+
+var fileDescriptor_05eee46dc85b8934 = []byte{
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6a, 0xdb, 0x4c,
+	0x10, 0xb6, 0x9c, 0xd8, 0xb1, 0x57, 0xe6, 0x87, 0x7f, 0xd3, 0x06, 0xc5, 0x14, 0xc9, 0x04, 0x0a,
+	0x2a, 0xc5, 0x52, 0x93, 0x40, 0x0f, 0xbd, 0x59, 0x31, 0xa1, 0xbe, 0x15, 0x35, 0x87, 0xd2, 0x8b,
+	0x58, 0xad, 0x36, 0xea, 0x12, 0x4b, 0x2b, 0xb4, 0x2b, 0x63, 0x9f, 0xfb, 0x02, 0x39, 0xf6, 0x19,
+	0x7a, 0xee, 0x43, 0xf8, 0x18, 0x7a, 0xea, 0xc9, 0x69, 0xed, 0xb7, 0xe8, 0xa9, 0xac, 0xb4, 0x0a,
+	0x26, 0xf4, 0x90, 0x40, 0x4f, 0xda, 0x9d, 0xf9, 0xbe, 0x99, 0xf9, 0x66, 0x46, 0x0b, 0x9e, 0x5f,
+	0xa1, 0x19, 0x72, 0x69, 0x8a, 0x49, 0x2a, 0xe8, 0x8c, 0xb8, 0xb3, 0xe3, 0x90, 0x08, 0x74, 0xec,
+	0x62, 0x94, 0x64, 0x88, 0xc6, 0xa9, 0x93, 0xe5, 0x4c, 0x30, 0x78, 0x20, 0x61, 0xce, 0x1d, 0xcc,
+	0x51, 0xb0, 0xbe, 0x89, 0x19, 0x4f, 0x18, 0x77, 0x43, 0xc4, 0xb7, 0xb8, 0x8c, 0x2a, 0x5e, 0xff,
+	0xb0, 0xf2, 0x07, 0xe5, 0xcd, 0xad, 0x2e, 0xca, 0xf5, 0x24, 0x66, 0x31, 0xab, 0xec, 0xf2, 0xa4,
+	0xac, 0x66, 0xcc, 0x58, 0x3c, 0x25, 0x6e, 0x79, 0x0b, 0x8b, 0x4b, 0x37, 0x2a, 0x72, 0x24, 0x28,
+	0xab, 0x03, 0x5a, 0xf7, 0xfd, 0x82, 0x26, 0x84, 0x0b, 0x94, 0x64, 0x15, 0xe0, 0xe8, 0x73, 0x0b,
+	0xfc, 0x3f, 0xa9, 0xeb, 0x3c, 0x53, 0x2a, 0xe0, 0x01, 0x68, 0xd2, 0xc8, 0xd0, 0x06, 0x9a, 0xbd,
+	0xeb, 0xb5, 0xd7, 0x2b, 0xab, 0x39, 0x19, 0xfb, 0x4d, 0x1a, 0xc1, 0x10, 0xec, 0xe1, 0x9c, 0x20,
+	0xc1, 0x72, 0xa3, 0x39, 0xd0, 0xec, 0x9e, 0xf7, 0xf6, 0xf7, 0xca, 0x1a, 0xc6, 0x54, 0x7c, 0x2a,
+	0x42, 0x07, 0xb3, 0x44, 0x95, 0xac, 0x3e, 0x43, 0x1e, 0x5d, 0xb9, 0x62, 0x91, 0x11, 0xee, 0x8c,
+	0x30, 0x1e, 0x45, 0x51, 0x4e, 0x38, 0xff, 0xfe, 0x6d, 0xb8, 0xaf, 0x84, 0x29, 0x8b, 0xb7, 0x10,
+	0x84, 0xfb, 0x75, 0x60, 0x68, 0x01, 0x9d, 0xb3, 0x22, 0xc7, 0x24, 0x90, 0x54, 0x63, 0x67, 0xa0,
+	0xd9, 0x5d, 0x1f, 0x54, 0xa6, 0x8b, 0x45, 0x46, 0xe0, 0x0b, 0xd0, 0x55, 0x00, 0x1a, 0x19, 0xbb,
+	0xd2, 0xed, 0xf5, 0xd6, 0x2b, 0xab, 0xf3, 0xbe, 0x34, 0x4e, 0xc6, 0x7e, 0xa7, 0x72, 0x4f, 0x22,
+	0x88, 0x41, 0x1b, 0x25, 0xac, 0x48, 0x85, 0xd1, 0x1a, 0xec, 0xd8, 0xfa, 0xc9, 0xa1, 0xa3, 0x52,
+	0xcb, 0x01, 0xd4, 0x53, 0x71, 0xce, 0x18, 0x4d, 0xbd, 0x57, 0xcb, 0x95, 0xd5, 0xf8, 0x7a, 0x6b,
+	0xd9, 0x0f, 0x50, 0x23, 0x09, 0xdc, 0x57, 0xa1, 0xe1, 0x1b, 0xd0, 0xe2, 0x02, 0xe5, 0xc2, 0x68,
+	0x0f, 0x34, 0x5b, 0x3f, 0xe9, 0x3b, 0x55, 0xcf, 0x9d, 0xba, 0xe7, 0xce, 0x45, 0xdd, 0x73, 0xaf,
+	0x23, 0x93, 0x5c, 0xdf, 0x5a, 0x9a, 0x5f, 0x51, 0xe0, 0x6b, 0xb0, 0x43, 0xd2, 0xc8, 0xd8, 0x7b,
+	0x04, 0x53, 0x12, 0x20, 0x07, 0x7a, 0x44, 0xb9, 0xc8, 0x69, 0x58, 0x08, 0x12, 0x19, 0x9d, 0x52,
+	0xdd, 0xb3, 0xbf, 0xaa, 0x1b, 0x13, 0x5c, 0x0a, 0x3c, 0x55, 0x02, 0x5f, 0x3e, 0x40, 0xa0, 0xe2,
+	0x70, 0x7f, 0x3b, 0x0b, 0xfc, 0x00, 0x9e, 0x66, 0x39, 0x99, 0x51, 0x56, 0xf0, 0x00, 0x61, 0x9c,
+	0x17, 0x68, 0x1a, 0xc8, 0x7d, 0x32, 0xba, 0x8f, 0x28, 0x7f, 0xbf, 0x0e, 0x31, 0xaa, 0x22, 0x48,
+	0xcc, 0xd1, 0x52, 0x03, 0xff, 0xd5, 0xcb, 0xf7, 0x0e, 0xe5, 0x28, 0xe1, 0x30, 0x05, 0xbd, 0x72,
+	0x23, 0x28, 0x4b, 0x83, 0x4b, 0x42, 0x0c, 0xed, 0xdf, 0x0f, 0x50, 0xaf, 0x13, 0x9c, 0x13, 0x02,
+	0xcf, 0x41, 0x2f, 0x41, 0xf3, 0xa0, 0xfe, 0x7f, 0xca, 0xfd, 0x96, 0xf9, 0xee, 0x6b, 0x1a, 0x2b,
+	0x40, 0x25, 0xe9, 0x8b, 0x94, 0xa4, 0x27, 0x68, 0x7e, 0x67, 0x9e, 0x2c, 0x7f, 0x99, 0x8d, 0xe5,
+	0xda, 0xd4, 0x6e, 0xd6, 0xa6, 0xf6, 0x73, 0x6d, 0x6a, 0xd7, 0x1b, 0xb3, 0x71, 0xb3, 0x31, 0x1b,
+	0x3f, 0x36, 0x66, 0xe3, 0xe3, 0x76, 0xf3, 0xe5, 0x1b, 0x31, 0x9c, 0xa2, 0x90, 0x97, 0x27, 0x77,
+	0xbe, 0xf5, 0xac, 0x94, 0x55, 0x86, 0xed, 0x32, 0xe9, 0xe9, 0x9f, 0x01, 0x00, 0x6f, 0x08, 0xd4,
+	0x8d, 0x75, 0x04, 0x00, 0x00,
+}
+
+func (m *IncentiveCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccrualTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccrualTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCampaign(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaign(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCampaign(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintCampaign(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaign(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SourceID) > 0 {
+		i -= len(m.SourceID)
+		copy(dAtA[i:], m.SourceID)
+		i = encodeVarintCampaign(dAtA, i, uint64(len(m.SourceID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintCampaign(dAtA, i, uint64(len(m.SourceType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintCampaign(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintCampaign(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CampaignParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CampaignParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CampaignParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCampaign(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaign(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCampaign(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCampaign(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IncentiveCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovCampaign(uint64(m.ID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovCampaign(uint64(l))
+	}
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovCampaign(uint64(l))
+	}
+	l = len(m.SourceID)
+	if l > 0 {
+		n += 1 + l + sovCampaign(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCampaign(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovCampaign(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovCampaign(uint64(l))
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovCampaign(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccrualTime)
+	n += 1 + l + sovCampaign(uint64(l))
+	return n
+}
+
+func (m *CampaignParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovCampaign(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration)
+	n += 1 + l + sovCampaign(uint64(l))
+	return n
+}
+
+func sovCampaign(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCampaign(x uint64) (n int) {
+	return sovCampaign(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IncentiveCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.DecCoin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreviousAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaign(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CampaignParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CampaignParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CampaignParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaign(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCampaign(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCampaign
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCampaign
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCampaign
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCampaign        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCampaign          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCampaign = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func TestIncentiveCampaign_Amounts(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	campaign := types.NewIncentiveCampaign(
+		1, sdk.AccAddress("test1"), types.SwapRewardSourceType, "busd:ukava",
+		sdk.NewCoins(sdk.NewInt64Coin("hard", 1000)), start, start.Add(400*time.Second), start,
+	)
+
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("hard", sdk.MustNewDecFromStr("2.5"))), campaign.RewardsPerSecond())
+	require.True(t, campaign.DistributedCoins().IsZero())
+	require.Equal(t, campaign.Amount, campaign.Escrowed())
+
+	campaign.Distributed = sdk.NewDecCoins(sdk.NewDecCoinFromDec("hard", sdk.MustNewDecFromStr("100.5")))
+
+	// Distributed rewards are rounded up so the escrow never pays out less than claims are owed
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("hard", 101)), campaign.DistributedCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("hard", 899)), campaign.Escrowed())
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("hard", sdk.MustNewDecFromStr("899.5"))), campaign.Undistributed())
+
+	require.False(t, campaign.IsEnded())
+	campaign.PreviousAccrualTime = campaign.End
+	require.True(t, campaign.IsEnded())
+}
+
+func TestIncentiveCampaigns_Validate(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	validCampaign := types.NewIncentiveCampaign(
+		1, sdk.AccAddress("test1"), types.SwapRewardSourceType, "busd:ukava",
+		sdk.NewCoins(sdk.NewInt64Coin("hard", 1000)), start, start.Add(time.Hour), start,
+	)
+
+	tests := []struct {
+		name      string
+		campaigns func() types.IncentiveCampaigns
+		wantErr   bool
+	}{
+		{
+			name: "valid",
+			campaigns: func() types.IncentiveCampaigns {
+				return types.IncentiveCampaigns{validCampaign}
+			},
+		},
+		{
+			name: "valid registered source type",
+			campaigns: func() types.IncentiveCampaigns {
+				c := validCampaign
+				c.SourceType = "vault"
+				return types.IncentiveCampaigns{c}
+			},
+		},
+		{
+			name: "duplicate id",
+			campaigns: func() types.IncentiveCampaigns {
+				return types.IncentiveCampaigns{validCampaign, validCampaign}
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero id",
+			campaigns: func() types.IncentiveCampaigns {
+				c := validCampaign
+				c.ID = 0
+				return types.IncentiveCampaigns{c}
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved source type",
+			campaigns: func() types.IncentiveCampaigns {
+				c := validCampaign
+				c.SourceType = types.DelegatorRewardSourceType
+				return types.IncentiveCampaigns{c}
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty source id",
+			campaigns: func() types.IncentiveCampaigns {
+				c := validCampaign
+				c.SourceID = ""
+				return types.IncentiveCampaigns{c}
+			},
+			wantErr: true,
+		},
+		{
+			name: "end before start",
+			campaigns: func() types.IncentiveCampaigns {
+				c := validCampaign
+				c.End = c.Start.Add(-time.Second)
+				return types.IncentiveCampaigns{c}
+			},
+			wantErr: true,
+		},
+		{
+			name: "distributed more than amount",
+			campaigns: func() types.IncentiveCampaigns {
+				c := validCampaign
+				c.Distributed = sdk.NewDecCoins(sdk.NewDecCoin("hard", sdk.NewInt(1001)))
+				return types.IncentiveCampaigns{c}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.campaigns().Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,11 +3,14 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Claim types name the rewards paid out together by a claim msg, and are used by auto-compound settings and claimed
+// rewards.
 const (
 	USDXMintingClaimType           = "usdx_minting"
 	HardLiquidityProviderClaimType = "hard_liquidity_provider"
@@ -17,6 +20,21 @@ const (
 	EarnClaimType                  = "earn"
 )
 
+// GetClaimSourceTypes returns the reward source types whose rewards are claimed together under a claim type. The
+// claim type of any other reward source is its source type.
+func GetClaimSourceTypes(claimType string) []string {
+	switch claimType {
+	case HardLiquidityProviderClaimType:
+		return []string{HardSupplyRewardSourceType, HardBorrowRewardSourceType}
+	case DelegatorClaimType:
+		return []string{DelegatorRewardSourceType}
+	default:
+		return []string{claimType}
+	}
+}
+
+// ---------------------- Deprecated claim types ----------------------
+
 // GetOwner is a getter for Claim Owner
 func (c BaseClaim) GetOwner() sdk.AccAddress { return c.Owner }
 
@@ -392,6 +410,183 @@ func (cs EarnClaims) Validate() error {
 	return nil
 }
 
+// ---------------------- Deprecated claim types are converted to and from source claims ----------------------
+
+// SourceClaims splits the claim into a source claim for each collateral type it has a reward factor for.
+func (c USDXMintingClaim) SourceClaims() SourceClaims {
+	indexes := make(MultiRewardIndexes, len(c.RewardIndexes))
+	for i, ri := range c.RewardIndexes {
+		indexes[i] = NewMultiRewardIndex(ri.CollateralType, RewardIndexes{NewRewardIndex(USDXMintingRewardDenom, ri.RewardFactor)})
+	}
+	return splitClaim(c.Owner, sdk.NewCoins(c.Reward), []string{USDXMintingRewardSourceType}, indexes)
+}
+
+// SourceClaims splits the claim into a source claim for each supply and borrow denom it has reward indexes for.
+func (c HardLiquidityProviderClaim) SourceClaims() SourceClaims {
+	return splitClaim(
+		c.Owner, c.Reward,
+		[]string{HardSupplyRewardSourceType, HardBorrowRewardSourceType},
+		c.SupplyRewardIndexes, c.BorrowRewardIndexes,
+	)
+}
+
+// SourceClaims splits the claim into a source claim for each denom it has reward indexes for.
+func (c DelegatorClaim) SourceClaims() SourceClaims {
+	return splitClaim(c.Owner, c.Reward, []string{DelegatorRewardSourceType}, c.RewardIndexes)
+}
+
+// SourceClaims splits the claim into a source claim for each pool it has reward indexes for.
+func (c SwapClaim) SourceClaims() SourceClaims {
+	return splitClaim(c.Owner, c.Reward, []string{SwapRewardSourceType}, c.RewardIndexes)
+}
+
+// SourceClaims splits the claim into a source claim for each denom it has reward indexes for.
+func (c SavingsClaim) SourceClaims() SourceClaims {
+	return splitClaim(c.Owner, c.Reward, []string{SavingsRewardSourceType}, c.RewardIndexes)
+}
+
+// SourceClaims splits the claim into a source claim for each vault it has reward indexes for.
+func (c EarnClaim) SourceClaims() SourceClaims {
+	return splitClaim(c.Owner, c.Reward, []string{EarnRewardSourceType}, c.RewardIndexes)
+}
+
+// splitClaim returns a source claim for each reward index of a deprecated claim, where indexes holds the reward
+// indexes of each of the source types. The reward is held by the first source claim. A claim with a reward but no
+// indexes is kept in a single source claim so the reward can still be claimed.
+func splitClaim(owner sdk.AccAddress, reward sdk.Coins, sourceTypes []string, indexes ...MultiRewardIndexes) SourceClaims {
+	var claims SourceClaims
+	for i, sourceType := range sourceTypes {
+		for _, mri := range indexes[i] {
+			claims = append(claims, NewSourceClaim(sourceType, mri.CollateralType, owner, nil, mri.RewardIndexes))
+		}
+	}
+	if len(claims) == 0 {
+		if reward.IsZero() {
+			return nil
+		}
+		sourceID := reward[0].Denom
+		if sourceTypes[0] == DelegatorRewardSourceType {
+			sourceID = BondDenom
+		}
+		claims = append(claims, NewSourceClaim(sourceTypes[0], sourceID, owner, nil, nil))
+	}
+	claims[0].Reward = reward
+	return claims
+}
+
+// NewUSDXMintingClaims groups usdx minting source claims by owner into deprecated usdx minting claims.
+func NewUSDXMintingClaims(claims SourceClaims) USDXMintingClaims {
+	owners, grouped := groupClaims(claims, USDXMintingRewardSourceType)
+	typed := make(USDXMintingClaims, len(owners))
+	for i, owner := range owners {
+		reward := sdk.NewCoin(USDXMintingRewardDenom, sdk.ZeroInt())
+		var indexes RewardIndexes
+		for _, c := range grouped[owner.String()] {
+			reward = reward.AddAmount(c.Reward.AmountOf(USDXMintingRewardDenom))
+			if factor, found := c.RewardIndexes.Get(USDXMintingRewardDenom); found {
+				indexes = append(indexes, NewRewardIndex(c.SourceID, factor))
+			}
+		}
+		typed[i] = NewUSDXMintingClaim(owner, reward, indexes)
+	}
+	return typed
+}
+
+// NewHardLiquidityProviderClaims groups hard supply and borrow source claims by owner into deprecated hard liquidity
+// provider claims.
+func NewHardLiquidityProviderClaims(claims SourceClaims) HardLiquidityProviderClaims {
+	owners, grouped := groupClaims(claims, HardSupplyRewardSourceType, HardBorrowRewardSourceType)
+	typed := make(HardLiquidityProviderClaims, len(owners))
+	for i, owner := range owners {
+		reward := sdk.NewCoins()
+		var supplyIndexes, borrowIndexes MultiRewardIndexes
+		for _, c := range grouped[owner.String()] {
+			reward = reward.Add(c.Reward...)
+			index := NewMultiRewardIndex(c.SourceID, c.RewardIndexes)
+			if c.SourceType == HardSupplyRewardSourceType {
+				supplyIndexes = append(supplyIndexes, index)
+			} else {
+				borrowIndexes = append(borrowIndexes, index)
+			}
+		}
+		typed[i] = NewHardLiquidityProviderClaim(owner, reward, supplyIndexes, borrowIndexes)
+	}
+	return typed
+}
+
+// NewDelegatorClaims groups delegator source claims by owner into deprecated delegator claims.
+func NewDelegatorClaims(claims SourceClaims) DelegatorClaims {
+	owners, grouped := groupClaims(claims, DelegatorRewardSourceType)
+	typed := make(DelegatorClaims, len(owners))
+	for i, owner := range owners {
+		reward, indexes := mergeClaims(grouped[owner.String()])
+		typed[i] = NewDelegatorClaim(owner, reward, indexes)
+	}
+	return typed
+}
+
+// NewSwapClaims groups swap source claims by owner into deprecated swap claims.
+func NewSwapClaims(claims SourceClaims) SwapClaims {
+	owners, grouped := groupClaims(claims, SwapRewardSourceType)
+	typed := make(SwapClaims, len(owners))
+	for i, owner := range owners {
+		reward, indexes := mergeClaims(grouped[owner.String()])
+		typed[i] = NewSwapClaim(owner, reward, indexes)
+	}
+	return typed
+}
+
+// NewSavingsClaims groups savings source claims by owner into deprecated savings claims.
+func NewSavingsClaims(claims SourceClaims) SavingsClaims {
+	owners, grouped := groupClaims(claims, SavingsRewardSourceType)
+	typed := make(SavingsClaims, len(owners))
+	for i, owner := range owners {
+		reward, indexes := mergeClaims(grouped[owner.String()])
+		typed[i] = NewSavingsClaim(owner, reward, indexes)
+	}
+	return typed
+}
+
+// NewEarnClaims groups earn source claims by owner into deprecated earn claims.
+func NewEarnClaims(claims SourceClaims) EarnClaims {
+	owners, grouped := groupClaims(claims, EarnRewardSourceType)
+	typed := make(EarnClaims, len(owners))
+	for i, owner := range owners {
+		reward, indexes := mergeClaims(grouped[owner.String()])
+		typed[i] = NewEarnClaim(owner, reward, indexes)
+	}
+	return typed
+}
+
+// groupClaims groups the source claims of the given source types by owner. Owners are returned in the order they
+// first appear in claims.
+func groupClaims(claims SourceClaims, sourceTypes ...string) ([]sdk.AccAddress, map[string]SourceClaims) {
+	var owners []sdk.AccAddress
+	grouped := make(map[string]SourceClaims)
+	for _, c := range claims {
+		if !slices.Contains(sourceTypes, c.SourceType) {
+			continue
+		}
+		key := c.Owner.String()
+		if _, found := grouped[key]; !found {
+			owners = append(owners, c.Owner)
+		}
+		grouped[key] = append(grouped[key], c)
+	}
+	return owners, grouped
+}
+
+// mergeClaims returns the total reward of source claims and the reward indexes of each of their sources.
+func mergeClaims(claims SourceClaims) (sdk.Coins, MultiRewardIndexes) {
+	reward := sdk.NewCoins()
+	var indexes MultiRewardIndexes
+	for _, c := range claims {
+		reward = reward.Add(c.Reward...)
+		indexes = append(indexes, NewMultiRewardIndex(c.SourceID, c.RewardIndexes))
+	}
+	return reward, indexes
+}
+
 // ---------------------- Reward indexes are used internally in the store ----------------------
 
 // NewRewardIndex returns a new RewardIndex
@@ -634,3 +829,14 @@ func (mris MultiRewardIndexes) copy() MultiRewardIndexes {
 	copy(newIndexes, mris)
 	return newIndexes
 }
+
+// NewClaimedReward returns a new ClaimedReward
+func NewClaimedReward(claimType string, amount sdk.Coins) ClaimedReward {
+	return ClaimedReward{
+		ClaimType: claimType,
+		Amount:    amount,
+	}
+}
+
+// ClaimedRewards is a slice of ClaimedReward
+type ClaimedRewards []ClaimedReward
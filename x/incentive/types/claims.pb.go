@@ -26,84 +26,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// BaseClaim is a claim with a single reward coin types
-type BaseClaim struct {
-	Owner  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
-	Reward types.Coin                                    `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward"`
-}
-
-func (m *BaseClaim) Reset()         { *m = BaseClaim{} }
-func (m *BaseClaim) String() string { return proto.CompactTextString(m) }
-func (*BaseClaim) ProtoMessage()    {}
-func (*BaseClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{0}
-}
-func (m *BaseClaim) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *BaseClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_BaseClaim.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *BaseClaim) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BaseClaim.Merge(m, src)
-}
-func (m *BaseClaim) XXX_Size() int {
-	return m.Size()
-}
-func (m *BaseClaim) XXX_DiscardUnknown() {
-	xxx_messageInfo_BaseClaim.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_BaseClaim proto.InternalMessageInfo
-
-// BaseMultiClaim is a claim with multiple reward coin types
-type BaseMultiClaim struct {
-	Owner  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
-	Reward github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
-}
-
-func (m *BaseMultiClaim) Reset()         { *m = BaseMultiClaim{} }
-func (m *BaseMultiClaim) String() string { return proto.CompactTextString(m) }
-func (*BaseMultiClaim) ProtoMessage()    {}
-func (*BaseMultiClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{1}
-}
-func (m *BaseMultiClaim) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *BaseMultiClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_BaseMultiClaim.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *BaseMultiClaim) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BaseMultiClaim.Merge(m, src)
-}
-func (m *BaseMultiClaim) XXX_Size() int {
-	return m.Size()
-}
-func (m *BaseMultiClaim) XXX_DiscardUnknown() {
-	xxx_messageInfo_BaseMultiClaim.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_BaseMultiClaim proto.InternalMessageInfo
-
 // RewardIndex stores reward accumulation information
 type RewardIndex struct {
 	CollateralType string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -114,7 +36,7 @@ func (m *RewardIndex) Reset()         { *m = RewardIndex{} }
 func (m *RewardIndex) String() string { return proto.CompactTextString(m) }
 func (*RewardIndex) ProtoMessage()    {}
 func (*RewardIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{2}
+	return fileDescriptor_5f7515029623a895, []int{0}
 }
 func (m *RewardIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +74,7 @@ func (m *RewardIndexesProto) Reset()         { *m = RewardIndexesProto{} }
 func (m *RewardIndexesProto) String() string { return proto.CompactTextString(m) }
 func (*RewardIndexesProto) ProtoMessage()    {}
 func (*RewardIndexesProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{3}
+	return fileDescriptor_5f7515029623a895, []int{1}
 }
 func (m *RewardIndexesProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +113,7 @@ func (m *MultiRewardIndex) Reset()         { *m = MultiRewardIndex{} }
 func (m *MultiRewardIndex) String() string { return proto.CompactTextString(m) }
 func (*MultiRewardIndex) ProtoMessage()    {}
 func (*MultiRewardIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{4}
+	return fileDescriptor_5f7515029623a895, []int{2}
 }
 func (m *MultiRewardIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +151,7 @@ func (m *MultiRewardIndexesProto) Reset()         { *m = MultiRewardIndexesProto
 func (m *MultiRewardIndexesProto) String() string { return proto.CompactTextString(m) }
 func (*MultiRewardIndexesProto) ProtoMessage()    {}
 func (*MultiRewardIndexesProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{5}
+	return fileDescriptor_5f7515029623a895, []int{3}
 }
 func (m *MultiRewardIndexesProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,6 +180,127 @@ func (m *MultiRewardIndexesProto) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MultiRewardIndexesProto proto.InternalMessageInfo
 
+// SourceClaim stores the rewards of an owner in a single source of a reward source type, such as a hard supply denom
+// or a swap pool.
+type SourceClaim struct {
+	SourceType    string                                        `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	SourceID      string                                        `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Owner         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Reward        github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,4,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
+	RewardIndexes RewardIndexes                                 `protobuf:"bytes,5,rep,name=reward_indexes,json=rewardIndexes,proto3,castrepeated=RewardIndexes" json:"reward_indexes"`
+}
+
+func (m *SourceClaim) Reset()         { *m = SourceClaim{} }
+func (m *SourceClaim) String() string { return proto.CompactTextString(m) }
+func (*SourceClaim) ProtoMessage()    {}
+func (*SourceClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f7515029623a895, []int{4}
+}
+func (m *SourceClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceClaim.Merge(m, src)
+}
+func (m *SourceClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceClaim proto.InternalMessageInfo
+
+// BaseClaim is a claim with a single reward coin types
+type BaseClaim struct {
+	Owner  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Reward types.Coin                                    `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward"`
+}
+
+func (m *BaseClaim) Reset()         { *m = BaseClaim{} }
+func (m *BaseClaim) String() string { return proto.CompactTextString(m) }
+func (*BaseClaim) ProtoMessage()    {}
+func (*BaseClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f7515029623a895, []int{5}
+}
+func (m *BaseClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseClaim.Merge(m, src)
+}
+func (m *BaseClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseClaim proto.InternalMessageInfo
+
+// BaseMultiClaim is a claim with multiple reward coin types
+type BaseMultiClaim struct {
+	Owner  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Reward github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
+}
+
+func (m *BaseMultiClaim) Reset()         { *m = BaseMultiClaim{} }
+func (m *BaseMultiClaim) String() string { return proto.CompactTextString(m) }
+func (*BaseMultiClaim) ProtoMessage()    {}
+func (*BaseMultiClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f7515029623a895, []int{6}
+}
+func (m *BaseMultiClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseMultiClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseMultiClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseMultiClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseMultiClaim.Merge(m, src)
+}
+func (m *BaseMultiClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseMultiClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseMultiClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseMultiClaim proto.InternalMessageInfo
+
 // USDXMintingClaim is for USDX minting rewards
 type USDXMintingClaim struct {
 	BaseClaim     `protobuf:"bytes,1,opt,name=base_claim,json=baseClaim,proto3,embedded=base_claim" json:"base_claim"`
@@ -268,7 +311,7 @@ func (m *USDXMintingClaim) Reset()         { *m = USDXMintingClaim{} }
 func (m *USDXMintingClaim) String() string { return proto.CompactTextString(m) }
 func (*USDXMintingClaim) ProtoMessage()    {}
 func (*USDXMintingClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{6}
+	return fileDescriptor_5f7515029623a895, []int{7}
 }
 func (m *USDXMintingClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +351,7 @@ func (m *HardLiquidityProviderClaim) Reset()         { *m = HardLiquidityProvide
 func (m *HardLiquidityProviderClaim) String() string { return proto.CompactTextString(m) }
 func (*HardLiquidityProviderClaim) ProtoMessage()    {}
 func (*HardLiquidityProviderClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{7}
+	return fileDescriptor_5f7515029623a895, []int{8}
 }
 func (m *HardLiquidityProviderClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +390,7 @@ func (m *DelegatorClaim) Reset()         { *m = DelegatorClaim{} }
 func (m *DelegatorClaim) String() string { return proto.CompactTextString(m) }
 func (*DelegatorClaim) ProtoMessage()    {}
 func (*DelegatorClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{8}
+	return fileDescriptor_5f7515029623a895, []int{9}
 }
 func (m *DelegatorClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +429,7 @@ func (m *SwapClaim) Reset()         { *m = SwapClaim{} }
 func (m *SwapClaim) String() string { return proto.CompactTextString(m) }
 func (*SwapClaim) ProtoMessage()    {}
 func (*SwapClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{9}
+	return fileDescriptor_5f7515029623a895, []int{10}
 }
 func (m *SwapClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +468,7 @@ func (m *SavingsClaim) Reset()         { *m = SavingsClaim{} }
 func (m *SavingsClaim) String() string { return proto.CompactTextString(m) }
 func (*SavingsClaim) ProtoMessage()    {}
 func (*SavingsClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{10}
+	return fileDescriptor_5f7515029623a895, []int{11}
 }
 func (m *SavingsClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +507,7 @@ func (m *EarnClaim) Reset()         { *m = EarnClaim{} }
 func (m *EarnClaim) String() string { return proto.CompactTextString(m) }
 func (*EarnClaim) ProtoMessage()    {}
 func (*EarnClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{11}
+	return fileDescriptor_5f7515029623a895, []int{12}
 }
 func (m *EarnClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,19 +536,61 @@ func (m *EarnClaim) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EarnClaim proto.InternalMessageInfo
 
+// Selection is a pair of denom and multiplier name. It holds the choice of multiplier a user makes when they claim a
+// denom.
+type Selection struct {
+	Denom          string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MultiplierName string `protobuf:"bytes,2,opt,name=multiplier_name,json=multiplierName,proto3" json:"multiplier_name,omitempty"`
+}
+
+func (m *Selection) Reset()         { *m = Selection{} }
+func (m *Selection) String() string { return proto.CompactTextString(m) }
+func (*Selection) ProtoMessage()    {}
+func (*Selection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f7515029623a895, []int{13}
+}
+func (m *Selection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Selection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Selection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Selection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Selection.Merge(m, src)
+}
+func (m *Selection) XXX_Size() int {
+	return m.Size()
+}
+func (m *Selection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Selection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Selection proto.InternalMessageInfo
+
 func init() {
-	proto.RegisterType((*BaseClaim)(nil), "kava.incentive.v1beta1.BaseClaim")
-	proto.RegisterType((*BaseMultiClaim)(nil), "kava.incentive.v1beta1.BaseMultiClaim")
 	proto.RegisterType((*RewardIndex)(nil), "kava.incentive.v1beta1.RewardIndex")
 	proto.RegisterType((*RewardIndexesProto)(nil), "kava.incentive.v1beta1.RewardIndexesProto")
 	proto.RegisterType((*MultiRewardIndex)(nil), "kava.incentive.v1beta1.MultiRewardIndex")
 	proto.RegisterType((*MultiRewardIndexesProto)(nil), "kava.incentive.v1beta1.MultiRewardIndexesProto")
+	proto.RegisterType((*SourceClaim)(nil), "kava.incentive.v1beta1.SourceClaim")
+	proto.RegisterType((*BaseClaim)(nil), "kava.incentive.v1beta1.BaseClaim")
+	proto.RegisterType((*BaseMultiClaim)(nil), "kava.incentive.v1beta1.BaseMultiClaim")
 	proto.RegisterType((*USDXMintingClaim)(nil), "kava.incentive.v1beta1.USDXMintingClaim")
 	proto.RegisterType((*HardLiquidityProviderClaim)(nil), "kava.incentive.v1beta1.HardLiquidityProviderClaim")
 	proto.RegisterType((*DelegatorClaim)(nil), "kava.incentive.v1beta1.DelegatorClaim")
 	proto.RegisterType((*SwapClaim)(nil), "kava.incentive.v1beta1.SwapClaim")
 	proto.RegisterType((*SavingsClaim)(nil), "kava.incentive.v1beta1.SavingsClaim")
 	proto.RegisterType((*EarnClaim)(nil), "kava.incentive.v1beta1.EarnClaim")
+	proto.RegisterType((*Selection)(nil), "kava.incentive.v1beta1.Selection")
 }
 
 func init() {
@@ -513,54 +598,61 @@ func init() {
 }
 
 var fileDescriptor_5f7515029623a895 = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0x13, 0x4d,
-	0x18, 0xef, 0xc0, 0x0b, 0x79, 0x3b, 0x94, 0xbe, 0x64, 0x81, 0x57, 0xe8, 0x61, 0x8b, 0x25, 0xc1,
-	0x26, 0xa6, 0xbb, 0x82, 0x07, 0x13, 0x6f, 0x2c, 0x68, 0xc0, 0x48, 0x24, 0x5b, 0x4d, 0x8c, 0x07,
-	0x9b, 0xd9, 0xdd, 0xb1, 0x4e, 0xd8, 0xee, 0xd4, 0x99, 0x6d, 0x4b, 0x3f, 0x83, 0x17, 0xfd, 0x02,
-	0x7e, 0x00, 0x2f, 0x5e, 0xf8, 0x10, 0xc4, 0x78, 0x20, 0xc6, 0xc4, 0x3f, 0x87, 0x8a, 0x70, 0xf5,
-	0x13, 0x78, 0x32, 0xf3, 0x07, 0x58, 0xa0, 0x25, 0xc4, 0x14, 0x0f, 0x9c, 0x76, 0xe7, 0x99, 0x67,
-	0x9e, 0xdf, 0x9f, 0x79, 0x76, 0x76, 0xe0, 0xec, 0x06, 0x6a, 0x22, 0x9b, 0x44, 0x3e, 0x8e, 0x62,
-	0xd2, 0xc4, 0x76, 0x73, 0xde, 0xc3, 0x31, 0x9a, 0xb7, 0xfd, 0x10, 0x91, 0x1a, 0xb7, 0xea, 0x8c,
-	0xc6, 0xd4, 0xf8, 0x5f, 0x24, 0x59, 0x87, 0x49, 0x96, 0x4e, 0xca, 0x99, 0x3e, 0xe5, 0x35, 0xca,
-	0x6d, 0x0f, 0xf1, 0xc4, 0x4a, 0x4a, 0x22, 0xb5, 0x2e, 0x37, 0xad, 0xe6, 0x2b, 0x72, 0x64, 0xab,
-	0x81, 0x9e, 0x9a, 0xa8, 0xd2, 0x2a, 0x55, 0x71, 0xf1, 0xa6, 0xa2, 0x85, 0x77, 0x00, 0xa6, 0x1d,
-	0xc4, 0xf1, 0x92, 0x40, 0x37, 0x9e, 0xc2, 0x21, 0xda, 0x8a, 0x30, 0x9b, 0x02, 0x33, 0xa0, 0x98,
-	0x71, 0x56, 0x7e, 0x75, 0xf2, 0xa5, 0x2a, 0x89, 0x9f, 0x37, 0x3c, 0xcb, 0xa7, 0x35, 0x5d, 0x4f,
-	0x3f, 0x4a, 0x3c, 0xd8, 0xb0, 0xe3, 0x76, 0x1d, 0x73, 0x6b, 0xd1, 0xf7, 0x17, 0x83, 0x80, 0x61,
-	0xce, 0x3f, 0x6e, 0x95, 0xc6, 0x35, 0xaa, 0x8e, 0x38, 0xed, 0x18, 0x73, 0x57, 0x95, 0x35, 0x6e,
-	0xc1, 0x61, 0x86, 0x5b, 0x88, 0x05, 0x53, 0x03, 0x33, 0xa0, 0x38, 0xb2, 0x30, 0x6d, 0xe9, 0x64,
-	0xa1, 0xe7, 0x40, 0xa4, 0xb5, 0x44, 0x49, 0xe4, 0xfc, 0xb3, 0xdd, 0xc9, 0xa7, 0x5c, 0x9d, 0x7e,
-	0x3b, 0xfd, 0x7e, 0xab, 0x34, 0x24, 0x39, 0x16, 0x76, 0x01, 0xcc, 0x0a, 0xc6, 0x6b, 0x8d, 0x30,
-	0x26, 0x7f, 0x87, 0xb6, 0x9f, 0xa0, 0x3d, 0x78, 0x36, 0xed, 0x1b, 0x82, 0xf6, 0xdb, 0xef, 0xf9,
-	0xe2, 0x39, 0xf0, 0xc5, 0x02, 0xde, 0x4d, 0xe2, 0x4b, 0x00, 0x47, 0x5c, 0x19, 0x5d, 0x8d, 0x02,
-	0xbc, 0x69, 0x5c, 0x83, 0xff, 0xf9, 0x34, 0x0c, 0x51, 0x8c, 0x19, 0x0a, 0x2b, 0x62, 0xb1, 0x54,
-	0x9a, 0x76, 0xb3, 0x47, 0xe1, 0x87, 0xed, 0x3a, 0x36, 0xca, 0x70, 0x54, 0x55, 0xab, 0x3c, 0x43,
-	0x7e, 0x4c, 0x99, 0xb4, 0x39, 0xe3, 0x58, 0x82, 0xd4, 0xb7, 0x4e, 0x7e, 0xee, 0x1c, 0xa4, 0x96,
-	0xb1, 0xef, 0x66, 0x54, 0x91, 0xbb, 0xb2, 0x46, 0xa1, 0x05, 0x8d, 0x04, 0x19, 0xcc, 0xd7, 0x65,
-	0x87, 0x22, 0x98, 0xd5, 0x50, 0x44, 0x85, 0xa7, 0x80, 0xf4, 0x66, 0xd6, 0xea, 0xde, 0xba, 0x56,
-	0xa2, 0x86, 0x33, 0xa9, 0x5d, 0x1a, 0x3d, 0x56, 0xd8, 0xd5, 0xe4, 0xf5, 0xb0, 0xf0, 0x06, 0xc0,
-	0x31, 0xb9, 0xcb, 0x7f, 0xe4, 0xc5, 0x69, 0x82, 0x03, 0xfd, 0x26, 0xf8, 0x1a, 0xc0, 0x2b, 0x27,
-	0x09, 0x1e, 0xf8, 0xd3, 0x84, 0x13, 0x35, 0x31, 0x55, 0xe9, 0xea, 0x52, 0xb1, 0x17, 0x89, 0x93,
-	0xe5, 0x9c, 0x9c, 0x66, 0x62, 0x9c, 0x06, 0x72, 0x8d, 0xda, 0xa9, 0x58, 0xe1, 0x03, 0x80, 0x63,
-	0x8f, 0xca, 0xcb, 0x8f, 0xd7, 0x48, 0x14, 0x93, 0xa8, 0xaa, 0x3e, 0x90, 0x7b, 0x10, 0x8a, 0x56,
-	0xad, 0xc8, 0x33, 0x46, 0xfa, 0x35, 0xb2, 0x70, 0xb5, 0x17, 0x85, 0xc3, 0xe3, 0xc0, 0xf9, 0x57,
-	0x60, 0xef, 0x74, 0xf2, 0xc0, 0x4d, 0x7b, 0x87, 0x67, 0xc4, 0xc5, 0xfb, 0x9a, 0xfc, 0x14, 0x7e,
-	0x0e, 0xc0, 0xdc, 0x0a, 0x62, 0xc1, 0x7d, 0xf2, 0xa2, 0x41, 0x02, 0x12, 0xb7, 0xd7, 0x19, 0x6d,
-	0x92, 0x00, 0x33, 0x45, 0xe6, 0x41, 0x17, 0x61, 0x73, 0x67, 0x09, 0x3b, 0x3a, 0x35, 0xba, 0xab,
-	0xdb, 0x84, 0x93, 0xbc, 0x51, 0xaf, 0x87, 0xed, 0x4a, 0x57, 0x91, 0xfd, 0xd9, 0xb7, 0x71, 0x05,
-	0x71, 0x2c, 0x28, 0x90, 0x3d, 0xca, 0x18, 0x6d, 0x9d, 0x44, 0x1e, 0xec, 0x27, 0xb2, 0x82, 0x70,
-	0x7b, 0xd9, 0xfd, 0x15, 0xc0, 0xec, 0x32, 0x0e, 0x71, 0x15, 0xc5, 0xf4, 0xa2, 0x2c, 0xde, 0xe8,
-	0xd1, 0x40, 0xfd, 0x51, 0xd8, 0xbb, 0x95, 0x3e, 0x01, 0x98, 0x2e, 0xb7, 0x50, 0xfd, 0x92, 0xc9,
-	0xfa, 0x0c, 0x60, 0xa6, 0x8c, 0x9a, 0x24, 0xaa, 0xf2, 0x4b, 0xb8, 0x61, 0x77, 0x10, 0x8b, 0x2e,
-	0x97, 0x2c, 0x67, 0x75, 0xfb, 0x87, 0x99, 0xda, 0xde, 0x33, 0xc1, 0xce, 0x9e, 0x09, 0x76, 0xf7,
-	0x4c, 0xf0, 0x6a, 0xdf, 0x4c, 0xed, 0xec, 0x9b, 0xa9, 0x2f, 0xfb, 0x66, 0xea, 0xc9, 0xf5, 0xc4,
-	0x3f, 0x5a, 0xf0, 0x28, 0x85, 0xc8, 0xe3, 0xf2, 0xcd, 0xde, 0x4c, 0xdc, 0x1a, 0xe5, 0xcf, 0xda,
-	0x1b, 0x96, 0x97, 0xb8, 0x9b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x88, 0xd7, 0x8a, 0x4f, 0x54,
-	0x0a, 0x00, 0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0xcd, 0x34, 0x2f, 0x4f, 0xf1, 0x24, 0x0d, 0x4f, 0x7e, 0x7d, 0x90, 0x97, 0x85, 0x5d, 0x52,
+	0xa9, 0x04, 0xa1, 0x38, 0xb4, 0x2c, 0x90, 0xba, 0xab, 0x1b, 0x50, 0x83, 0x28, 0x54, 0x4e, 0x91,
+	0x10, 0x0b, 0xa2, 0x89, 0x3d, 0x84, 0x51, 0x6d, 0x4f, 0x98, 0x71, 0x92, 0x66, 0xc9, 0x0e, 0x89,
+	0x0d, 0xfc, 0x01, 0xd4, 0x35, 0x1b, 0x36, 0xfd, 0x11, 0x15, 0x62, 0x51, 0x21, 0x24, 0x3e, 0x16,
+	0xa1, 0xa4, 0x1b, 0x16, 0xfc, 0x02, 0x56, 0x68, 0x66, 0xdc, 0xd4, 0x6d, 0x93, 0xaa, 0x42, 0xc9,
+	0x5b, 0x74, 0x15, 0xcf, 0x9d, 0x99, 0x7b, 0xcf, 0x39, 0xf7, 0x64, 0x3c, 0x86, 0x6b, 0x87, 0xa8,
+	0x8f, 0x6a, 0x24, 0x74, 0x71, 0x18, 0x91, 0x3e, 0xae, 0xf5, 0x37, 0xda, 0x38, 0x42, 0x1b, 0x35,
+	0xd7, 0x47, 0x24, 0xe0, 0x56, 0x97, 0xd1, 0x88, 0xea, 0x2f, 0x8b, 0x45, 0xd6, 0x64, 0x91, 0x15,
+	0x2f, 0x2a, 0x19, 0x2e, 0xe5, 0x01, 0xe5, 0xb5, 0x36, 0xe2, 0x89, 0x9d, 0x94, 0x84, 0x6a, 0x5f,
+	0xe9, 0xb9, 0x9a, 0x6f, 0xc9, 0x51, 0x4d, 0x0d, 0xe2, 0xa9, 0x95, 0x0e, 0xed, 0x50, 0x15, 0x17,
+	0x4f, 0x2a, 0x5a, 0xfe, 0x1a, 0xc0, 0x9c, 0x83, 0x07, 0x88, 0x79, 0x8d, 0xd0, 0xc3, 0x47, 0xfa,
+	0x6b, 0xf0, 0x25, 0x97, 0xfa, 0x3e, 0x8a, 0x30, 0x43, 0x7e, 0x2b, 0x1a, 0x76, 0x71, 0x11, 0xac,
+	0x82, 0x8a, 0xe6, 0x14, 0xae, 0xc2, 0x07, 0xc3, 0x2e, 0xd6, 0x9b, 0x70, 0x99, 0xc9, 0x7d, 0xad,
+	0xcf, 0x90, 0x1b, 0x51, 0x56, 0x5c, 0x5a, 0x05, 0x95, 0xbc, 0x6d, 0x9d, 0x8e, 0xcc, 0xd4, 0x1f,
+	0x23, 0x73, 0xbd, 0x43, 0xa2, 0xcf, 0x7b, 0x6d, 0xcb, 0xa5, 0x41, 0x0c, 0x23, 0xfe, 0xa9, 0x72,
+	0xef, 0xb0, 0x26, 0xf2, 0x72, 0xab, 0x8e, 0x5d, 0x27, 0xaf, 0x92, 0xbc, 0x2b, 0x73, 0x94, 0x07,
+	0x50, 0x4f, 0x80, 0xc1, 0x7c, 0x5f, 0x8a, 0x81, 0x60, 0x21, 0x2e, 0x45, 0x54, 0xb8, 0x08, 0x56,
+	0xd3, 0x95, 0xdc, 0xe6, 0x9a, 0x35, 0x5d, 0x25, 0x2b, 0x91, 0xc3, 0x7e, 0x26, 0x00, 0x7d, 0xff,
+	0xa7, 0xb9, 0x7c, 0x2d, 0xb1, 0xb3, 0xcc, 0x92, 0xc3, 0xf2, 0x77, 0x00, 0x3e, 0xd9, 0xeb, 0xf9,
+	0x11, 0xf9, 0x5f, 0x5a, 0xdc, 0x06, 0xb8, 0x34, 0x6f, 0x80, 0xdf, 0x02, 0xf8, 0xca, 0x4d, 0x80,
+	0x97, 0xfa, 0xf4, 0xe1, 0x4a, 0x20, 0xa6, 0x5a, 0x53, 0x55, 0xaa, 0xcc, 0x02, 0x71, 0x33, 0x9d,
+	0x5d, 0x8a, 0x91, 0xe8, 0xb7, 0x0b, 0x39, 0x7a, 0x70, 0x2b, 0x56, 0xfe, 0x32, 0x0d, 0x73, 0x4d,
+	0xda, 0x63, 0x2e, 0xde, 0x11, 0xde, 0xd5, 0x4d, 0x98, 0xe3, 0x72, 0x98, 0xd4, 0x0a, 0xaa, 0x90,
+	0xd4, 0xe9, 0x75, 0xa8, 0xc5, 0x0b, 0x88, 0x27, 0xfd, 0xa2, 0xd9, 0xf9, 0xf1, 0xc8, 0xcc, 0xaa,
+	0x24, 0x8d, 0xba, 0x93, 0x55, 0xd3, 0x0d, 0x4f, 0xff, 0x14, 0x66, 0xe8, 0x20, 0xc4, 0xac, 0x98,
+	0x96, 0xb6, 0xda, 0xfd, 0x77, 0x64, 0x56, 0xef, 0x61, 0xa9, 0x6d, 0xd7, 0xdd, 0xf6, 0x3c, 0x86,
+	0x39, 0xff, 0xf9, 0xa4, 0xfa, 0x54, 0x4d, 0x5b, 0x71, 0xc4, 0x1e, 0x46, 0x98, 0x3b, 0x2a, 0xad,
+	0xee, 0xc2, 0xc7, 0x4a, 0xad, 0xe2, 0x23, 0xa9, 0xd2, 0x73, 0x2b, 0x5e, 0x2c, 0xfe, 0x59, 0x13,
+	0x89, 0x76, 0x28, 0x09, 0xed, 0x37, 0x63, 0x59, 0x2a, 0xf7, 0xa8, 0x2f, 0x36, 0x70, 0x27, 0x4e,
+	0x3d, 0xc5, 0x17, 0x99, 0x79, 0xfb, 0xe2, 0x07, 0x00, 0x35, 0x1b, 0xf1, 0xb8, 0x03, 0x13, 0xd5,
+	0xc0, 0x62, 0x54, 0x7b, 0x7b, 0xa2, 0x9a, 0xe8, 0xde, 0x9d, 0xaa, 0x3d, 0x12, 0xf0, 0x2f, 0x95,
+	0xd8, 0xd2, 0x7e, 0x3c, 0xa9, 0x66, 0x24, 0xc6, 0xf2, 0x39, 0x80, 0x05, 0x81, 0x58, 0x9a, 0xec,
+	0xc5, 0xc0, 0x76, 0x13, 0xb0, 0x17, 0xd5, 0xec, 0x24, 0xc5, 0x9f, 0x00, 0x7c, 0xf2, 0x51, 0xb3,
+	0xfe, 0xf1, 0x1e, 0x09, 0x23, 0x12, 0x76, 0x14, 0xc9, 0xf7, 0x20, 0x14, 0xe5, 0x5a, 0xf2, 0x9c,
+	0x97, 0x4c, 0x73, 0x9b, 0xaf, 0xce, 0x32, 0xc2, 0xa4, 0xa5, 0x76, 0x56, 0x00, 0x3a, 0x1b, 0x99,
+	0xc0, 0xd1, 0xda, 0x93, 0x3e, 0x2f, 0xfe, 0xc0, 0x49, 0xd2, 0xf9, 0x67, 0x09, 0x96, 0x76, 0x11,
+	0xf3, 0xde, 0x27, 0x5f, 0xf4, 0x88, 0x47, 0xa2, 0xe1, 0x3e, 0xa3, 0x7d, 0xe2, 0x61, 0xa6, 0xc0,
+	0x7c, 0x38, 0x85, 0xd8, 0xfa, 0x5d, 0xc4, 0xae, 0x3a, 0x3f, 0x9d, 0xdd, 0x11, 0x7c, 0xc6, 0x7b,
+	0xdd, 0xae, 0x3f, 0x6c, 0x4d, 0x25, 0x39, 0x9f, 0x03, 0xed, 0xa9, 0x2a, 0x71, 0x2d, 0x28, 0x2a,
+	0xb7, 0x29, 0x63, 0x74, 0x70, 0xb3, 0x72, 0x7a, 0x9e, 0x95, 0x55, 0x09, 0x67, 0x96, 0xdc, 0xbf,
+	0x03, 0x58, 0xa8, 0x63, 0x1f, 0x77, 0x50, 0x44, 0x17, 0x25, 0xf1, 0xe1, 0x0c, 0x03, 0xcd, 0x87,
+	0xe1, 0x6c, 0x2b, 0xfd, 0x02, 0xa0, 0xd6, 0x1c, 0xa0, 0xee, 0x03, 0xa3, 0xf5, 0x2b, 0x80, 0xf9,
+	0x26, 0xea, 0x93, 0xb0, 0xc3, 0x1f, 0x60, 0xc3, 0xde, 0x41, 0x2c, 0x7c, 0x60, 0xb4, 0x0e, 0xa0,
+	0xd6, 0xc4, 0x3e, 0x76, 0x23, 0x42, 0x43, 0x7d, 0x05, 0x66, 0x3c, 0x1c, 0xd2, 0x20, 0xbe, 0xb1,
+	0xa8, 0x81, 0xb8, 0xfd, 0xc9, 0x3b, 0x4f, 0xd7, 0x27, 0x98, 0xb5, 0x42, 0x14, 0x60, 0x75, 0x65,
+	0x71, 0x0a, 0x57, 0xe1, 0x0f, 0x50, 0x80, 0xb7, 0xb2, 0x5f, 0x1d, 0x9b, 0xa9, 0xbf, 0x8f, 0xcd,
+	0x94, 0xdd, 0x38, 0xfd, 0xcb, 0x48, 0x9d, 0x8e, 0x0d, 0x70, 0x36, 0x36, 0xc0, 0xf9, 0xd8, 0x00,
+	0xdf, 0x5c, 0x18, 0xa9, 0xb3, 0x0b, 0x23, 0xf5, 0xdb, 0x85, 0x91, 0xfa, 0xe4, 0x8d, 0xc4, 0x2b,
+	0x45, 0xb0, 0xab, 0xfa, 0xa8, 0xcd, 0xe5, 0x53, 0xed, 0x28, 0xf1, 0x3d, 0x20, 0xdf, 0x2d, 0xed,
+	0xc7, 0xf2, 0x7a, 0xfe, 0xd6, 0x7f, 0x03, 0x00, 0xf4, 0xc8, 0x51, 0x01, 0x2e, 0x0c, 0x00, 0x00,
 }
 
-func (m *BaseClaim) Marshal() (dAtA []byte, err error) {
+func (m *RewardIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -570,37 +662,37 @@ func (m *BaseClaim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BaseClaim) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BaseClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.RewardFactor.Size()
+		i -= size
+		if _, err := m.RewardFactor.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintClaims(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintClaims(dAtA, i, uint64(len(m.Owner)))
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.CollateralType)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BaseMultiClaim) Marshal() (dAtA []byte, err error) {
+func (m *RewardIndexesProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -610,20 +702,20 @@ func (m *BaseMultiClaim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BaseMultiClaim) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardIndexesProto) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BaseMultiClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardIndexesProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RewardIndexes) > 0 {
+		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -631,20 +723,13 @@ func (m *BaseMultiClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintClaims(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintClaims(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RewardIndex) Marshal() (dAtA []byte, err error) {
+func (m *MultiRewardIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -654,26 +739,30 @@ func (m *RewardIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RewardIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiRewardIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiRewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.RewardFactor.Size()
-		i -= size
-		if _, err := m.RewardFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.RewardIndexes) > 0 {
+		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintClaims(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.CollateralType) > 0 {
 		i -= len(m.CollateralType)
 		copy(dAtA[i:], m.CollateralType)
@@ -684,7 +773,7 @@ func (m *RewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RewardIndexesProto) Marshal() (dAtA []byte, err error) {
+func (m *MultiRewardIndexesProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -694,20 +783,20 @@ func (m *RewardIndexesProto) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RewardIndexesProto) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiRewardIndexesProto) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardIndexesProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiRewardIndexesProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RewardIndexes) > 0 {
-		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MultiRewardIndexes) > 0 {
+		for iNdEx := len(m.MultiRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MultiRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -721,7 +810,7 @@ func (m *RewardIndexesProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiRewardIndex) Marshal() (dAtA []byte, err error) {
+func (m *SourceClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -731,12 +820,12 @@ func (m *MultiRewardIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiRewardIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *SourceClaim) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiRewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SourceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -752,20 +841,48 @@ func (m *MultiRewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintClaims(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintClaims(dAtA, i, uint64(len(m.CollateralType)))
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceID) > 0 {
+		i -= len(m.SourceID)
+		copy(dAtA[i:], m.SourceID)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.SourceID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.SourceType)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiRewardIndexesProto) Marshal() (dAtA []byte, err error) {
+func (m *BaseClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -775,20 +892,60 @@ func (m *MultiRewardIndexesProto) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiRewardIndexesProto) MarshalTo(dAtA []byte) (int, error) {
+func (m *BaseClaim) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiRewardIndexesProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BaseClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MultiRewardIndexes) > 0 {
-		for iNdEx := len(m.MultiRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseMultiClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseMultiClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseMultiClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MultiRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -796,9 +953,16 @@ func (m *MultiRewardIndexesProto) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i = encodeVarintClaims(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1098,6 +1262,43 @@ func (m *EarnClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Selection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Selection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Selection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MultiplierName) > 0 {
+		i -= len(m.MultiplierName)
+		copy(dAtA[i:], m.MultiplierName)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.MultiplierName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClaims(v)
 	base := offset
@@ -1109,33 +1310,29 @@ func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *BaseClaim) Size() (n int) {
+func (m *RewardIndex) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
+	l = len(m.CollateralType)
 	if l > 0 {
 		n += 1 + l + sovClaims(uint64(l))
 	}
-	l = m.Reward.Size()
+	l = m.RewardFactor.Size()
 	n += 1 + l + sovClaims(uint64(l))
 	return n
 }
 
-func (m *BaseMultiClaim) Size() (n int) {
+func (m *RewardIndexesProto) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovClaims(uint64(l))
-	}
-	if len(m.Reward) > 0 {
-		for _, e := range m.Reward {
+	if len(m.RewardIndexes) > 0 {
+		for _, e := range m.RewardIndexes {
 			l = e.Size()
 			n += 1 + l + sovClaims(uint64(l))
 		}
@@ -1143,7 +1340,7 @@ func (m *BaseMultiClaim) Size() (n int) {
 	return n
 }
 
-func (m *RewardIndex) Size() (n int) {
+func (m *MultiRewardIndex) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1153,19 +1350,23 @@ func (m *RewardIndex) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovClaims(uint64(l))
 	}
-	l = m.RewardFactor.Size()
-	n += 1 + l + sovClaims(uint64(l))
+	if len(m.RewardIndexes) > 0 {
+		for _, e := range m.RewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *RewardIndexesProto) Size() (n int) {
+func (m *MultiRewardIndexesProto) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.RewardIndexes) > 0 {
-		for _, e := range m.RewardIndexes {
+	if len(m.MultiRewardIndexes) > 0 {
+		for _, e := range m.MultiRewardIndexes {
 			l = e.Size()
 			n += 1 + l + sovClaims(uint64(l))
 		}
@@ -1173,16 +1374,30 @@ func (m *RewardIndexesProto) Size() (n int) {
 	return n
 }
 
-func (m *MultiRewardIndex) Size() (n int) {
+func (m *SourceClaim) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.CollateralType)
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.SourceID)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovClaims(uint64(l))
 	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
 	if len(m.RewardIndexes) > 0 {
 		for _, e := range m.RewardIndexes {
 			l = e.Size()
@@ -1192,14 +1407,33 @@ func (m *MultiRewardIndex) Size() (n int) {
 	return n
 }
 
-func (m *MultiRewardIndexesProto) Size() (n int) {
+func (m *BaseClaim) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.MultiRewardIndexes) > 0 {
-		for _, e := range m.MultiRewardIndexes {
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *BaseMultiClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
 			l = e.Size()
 			n += 1 + l + sovClaims(uint64(l))
 		}
@@ -1315,13 +1549,30 @@ func (m *EarnClaim) Size() (n int) {
 	return n
 }
 
+func (m *Selection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.MultiplierName)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	return n
+}
+
 func sovClaims(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozClaims(x uint64) (n int) {
 	return sovClaims(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *BaseClaim) Unmarshal(dAtA []byte) error {
+func (m *RewardIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1344,17 +1595,49 @@ func (m *BaseClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFactor", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -1379,14 +1662,63 @@ func (m *BaseClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			if err := m.RewardFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardIndexesProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardIndexesProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardIndexesProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1413,7 +1745,8 @@ func (m *BaseClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardIndexes = append(m.RewardIndexes, RewardIndex{})
+			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1438,7 +1771,7 @@ func (m *BaseClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BaseMultiClaim) Unmarshal(dAtA []byte) error {
+func (m *MultiRewardIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1461,17 +1794,17 @@ func (m *BaseMultiClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseMultiClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiRewardIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseMultiClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiRewardIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -1481,29 +1814,27 @@ func (m *BaseMultiClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1530,8 +1861,8 @@ func (m *BaseMultiClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.Coin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardIndexes = append(m.RewardIndexes, RewardIndex{})
+			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1556,7 +1887,7 @@ func (m *BaseMultiClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardIndex) Unmarshal(dAtA []byte) error {
+func (m *MultiRewardIndexesProto) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1579,15 +1910,99 @@ func (m *RewardIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiRewardIndexesProto: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiRewardIndexesProto: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiRewardIndexes = append(m.MultiRewardIndexes, MultiRewardIndex{})
+			if err := m.MultiRewardIndexes[len(m.MultiRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1608,20 +2023,86 @@ func (m *RewardIndex) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -1631,76 +2112,27 @@ func (m *RewardIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClaims(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthClaims
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RewardIndexesProto) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClaims
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RewardIndexesProto: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardIndexesProto: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
 			}
@@ -1755,7 +2187,7 @@ func (m *RewardIndexesProto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiRewardIndex) Unmarshal(dAtA []byte) error {
+func (m *BaseClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1778,17 +2210,17 @@ func (m *MultiRewardIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiRewardIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: BaseClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiRewardIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BaseClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -1798,27 +2230,29 @@ func (m *MultiRewardIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1845,8 +2279,7 @@ func (m *MultiRewardIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardIndexes = append(m.RewardIndexes, RewardIndex{})
-			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1871,7 +2304,7 @@ func (m *MultiRewardIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiRewardIndexesProto) Unmarshal(dAtA []byte) error {
+func (m *BaseMultiClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1894,15 +2327,49 @@ func (m *MultiRewardIndexesProto) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiRewardIndexesProto: wiretype end group for non-group")
+			return fmt.Errorf("proto: BaseMultiClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiRewardIndexesProto: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BaseMultiClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiRewardIndexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1929,8 +2396,8 @@ func (m *MultiRewardIndexesProto) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultiRewardIndexes = append(m.MultiRewardIndexes, MultiRewardIndex{})
-			if err := m.MultiRewardIndexes[len(m.MultiRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2691,6 +3158,120 @@ func (m *EarnClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Selection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Selection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Selection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiplierName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClaims(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/cometbft/cometbft/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,176 +18,109 @@ func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amo
 // c is a helper function for created sdk.Coins types in tests
 func cs(coins ...sdk.Coin) sdk.Coins { return sdk.NewCoins(coins...) }
 
-func TestClaims_Validate(t *testing.T) {
-	owner := sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser1")))
+func TestGetClaimSourceTypes(t *testing.T) {
+	testCases := []struct {
+		claimType   string
+		sourceTypes []string
+	}{
+		{HardLiquidityProviderClaimType, []string{HardSupplyRewardSourceType, HardBorrowRewardSourceType}},
+		{DelegatorClaimType, []string{DelegatorRewardSourceType}},
+		{USDXMintingClaimType, []string{USDXMintingRewardSourceType}},
+		{SwapClaimType, []string{SwapRewardSourceType}},
+		{SavingsClaimType, []string{SavingsRewardSourceType}},
+		{EarnClaimType, []string{EarnRewardSourceType}},
+		{"vault", []string{"vault"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.claimType, func(t *testing.T) {
+			require.Equal(t, tc.sourceTypes, GetClaimSourceTypes(tc.claimType))
+		})
+	}
+}
 
-	t.Run("USDXMintingClaims", func(t *testing.T) {
-		testCases := []struct {
-			name    string
-			claims  USDXMintingClaims
-			expPass bool
-		}{
-			{
-				"valid",
-				USDXMintingClaims{
-					NewUSDXMintingClaim(owner, sdk.NewCoin("bnb", sdk.OneInt()), RewardIndexes{NewRewardIndex("bnb-a", sdk.ZeroDec())}),
-				},
-				true,
-			},
-			{
-				"invalid owner",
-				USDXMintingClaims{
-					USDXMintingClaim{
-						BaseClaim: BaseClaim{
-							Owner: nil,
-						},
-					},
-				},
-				false,
-			},
-			{
-				"invalid reward",
-				USDXMintingClaims{
-					{
-						BaseClaim: BaseClaim{
-							Owner:  owner,
-							Reward: sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
-						},
-					},
-				},
-				false,
-			},
-			{
-				"invalid collateral type",
-				USDXMintingClaims{
-					{
-						BaseClaim: BaseClaim{
-							Owner:  owner,
-							Reward: sdk.NewCoin("bnb", sdk.OneInt()),
-						},
-						RewardIndexes: []RewardIndex{{"", sdk.ZeroDec()}},
-					},
-				},
-				false,
-			},
-		}
+func TestDeprecatedClaims_SourceClaims(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________")
+	hardIndexes := RewardIndexes{NewRewardIndex("hard", d("0.1"))}
 
-		for _, tc := range testCases {
-			t.Run(tc.name, func(t *testing.T) {
-				err := tc.claims.Validate()
-				if tc.expPass {
-					require.NoError(t, err)
-				} else {
-					require.Error(t, err)
-				}
-			})
-		}
-	})
-	t.Run("SwapClaims", func(t *testing.T) {
-		validRewardIndexes := RewardIndexes{}.With("swap", d("0.002"))
-		validMultiRewardIndexes := MultiRewardIndexes{}.With("btcb/usdx", validRewardIndexes)
-		invalidRewardIndexes := RewardIndexes{}.With("swap", d("-0.002"))
-		invalidMultiRewardIndexes := MultiRewardIndexes{}.With("btcb/usdx", invalidRewardIndexes)
-
-		testCases := []struct {
-			name    string
-			claims  SwapClaims
-			expPass bool
-		}{
-			{
-				name: "valid",
-				claims: SwapClaims{
-					NewSwapClaim(owner, cs(c("bnb", 1)), validMultiRewardIndexes),
-				},
-				expPass: true,
-			},
-			{
-				name: "invalid owner",
-				claims: SwapClaims{
-					NewSwapClaim(nil, cs(c("bnb", 1)), validMultiRewardIndexes),
-				},
-				expPass: false,
-			},
-			{
-				name: "invalid reward",
-				claims: SwapClaims{
-					NewSwapClaim(owner, sdk.Coins{sdk.Coin{Denom: "invalid😫"}}, validMultiRewardIndexes),
-				},
-				expPass: false,
+	testCases := []struct {
+		name     string
+		claims   SourceClaims
+		expected SourceClaims
+	}{
+		{
+			name:   "usdx minting reward factors are converted to reward indexes",
+			claims: NewUSDXMintingClaim(owner, c("ukava", 5), RewardIndexes{NewRewardIndex("bnb-a", d("0.5"))}).SourceClaims(),
+			expected: SourceClaims{
+				NewSourceClaim(USDXMintingRewardSourceType, "bnb-a", owner, cs(c("ukava", 5)), RewardIndexes{NewRewardIndex(USDXMintingRewardDenom, d("0.5"))}),
 			},
-			{
-				name: "invalid indexes",
-				claims: SwapClaims{
-					NewSwapClaim(nil, cs(c("bnb", 1)), invalidMultiRewardIndexes),
-				},
-				expPass: false,
+		},
+		{
+			name: "the reward is held by the first source claim",
+			claims: NewHardLiquidityProviderClaim(
+				owner, cs(c("hard", 10)),
+				MultiRewardIndexes{NewMultiRewardIndex("bnb", hardIndexes)},
+				MultiRewardIndexes{NewMultiRewardIndex("bnb", hardIndexes), NewMultiRewardIndex("ukava", hardIndexes)},
+			).SourceClaims(),
+			expected: SourceClaims{
+				NewSourceClaim(HardSupplyRewardSourceType, "bnb", owner, cs(c("hard", 10)), hardIndexes),
+				NewSourceClaim(HardBorrowRewardSourceType, "bnb", owner, nil, hardIndexes),
+				NewSourceClaim(HardBorrowRewardSourceType, "ukava", owner, nil, hardIndexes),
 			},
-		}
-
-		for _, tc := range testCases {
-			t.Run(tc.name, func(t *testing.T) {
-				err := tc.claims.Validate()
-				if tc.expPass {
-					require.NoError(t, err)
-				} else {
-					require.Error(t, err)
-				}
-			})
-		}
-	})
-
-	t.Run("SavingsClaims", func(t *testing.T) {
-		validRewardIndexes := RewardIndexes{}.With("ukava", d("0.002"))
-		validMultiRewardIndexes := MultiRewardIndexes{}.With("btcb/usdx", validRewardIndexes)
-		invalidRewardIndexes := RewardIndexes{}.With("ukava", d("-0.002"))
-		invalidMultiRewardIndexes := MultiRewardIndexes{}.With("btcb/usdx", invalidRewardIndexes)
+		},
+		{
+			name:     "a reward without indexes is held by a delegator claim for the bond denom",
+			claims:   NewDelegatorClaim(owner, cs(c("hard", 3)), nil).SourceClaims(),
+			expected: SourceClaims{NewSourceClaim(DelegatorRewardSourceType, BondDenom, owner, cs(c("hard", 3)), nil)},
+		},
+		{
+			name:     "a reward without indexes is held by a claim for the reward denom",
+			claims:   NewSwapClaim(owner, cs(c("swp", 3)), nil).SourceClaims(),
+			expected: SourceClaims{NewSourceClaim(SwapRewardSourceType, "swp", owner, cs(c("swp", 3)), nil)},
+		},
+		{
+			name:     "a claim without reward or indexes has no source claims",
+			claims:   NewEarnClaim(owner, nil, nil).SourceClaims(),
+			expected: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.claims)
+		})
+	}
+}
 
-		testCases := []struct {
-			name    string
-			claims  SavingsClaims
-			expPass bool
-		}{
-			{
-				name: "valid",
-				claims: SavingsClaims{
-					NewSavingsClaim(owner, cs(c("bnb", 1)), validMultiRewardIndexes),
-				},
-				expPass: true,
-			},
-			{
-				name: "invalid owner",
-				claims: SavingsClaims{
-					NewSavingsClaim(nil, cs(c("bnb", 1)), validMultiRewardIndexes),
-				},
-				expPass: false,
-			},
-			{
-				name: "invalid reward",
-				claims: SavingsClaims{
-					NewSavingsClaim(owner, sdk.Coins{sdk.Coin{Denom: "invalid😫"}}, validMultiRewardIndexes),
-				},
-				expPass: false,
-			},
-			{
-				name: "invalid indexes",
-				claims: SavingsClaims{
-					NewSavingsClaim(nil, cs(c("bnb", 1)), invalidMultiRewardIndexes),
-				},
-				expPass: false,
-			},
-		}
+func TestNewDeprecatedClaims(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________")
+	other := sdk.AccAddress("other_______________")
+	hardIndexes := RewardIndexes{NewRewardIndex("hard", d("0.1"))}
+
+	claims := SourceClaims{
+		NewSourceClaim(USDXMintingRewardSourceType, "bnb-a", owner, cs(c("ukava", 5)), RewardIndexes{NewRewardIndex(USDXMintingRewardDenom, d("0.5"))}),
+		NewSourceClaim(USDXMintingRewardSourceType, "xrpb-a", owner, cs(c("ukava", 2)), RewardIndexes{NewRewardIndex(USDXMintingRewardDenom, d("0.2"))}),
+		NewSourceClaim(HardSupplyRewardSourceType, "bnb", owner, cs(c("hard", 10)), hardIndexes),
+		NewSourceClaim(HardBorrowRewardSourceType, "bnb", owner, cs(c("hard", 1)), hardIndexes),
+		NewSourceClaim(HardSupplyRewardSourceType, "bnb", other, cs(c("hard", 4)), hardIndexes),
+		NewSourceClaim(SwapRewardSourceType, "ukava:usdx", other, cs(c("swp", 3)), hardIndexes),
+	}
 
-		for _, tc := range testCases {
-			t.Run(tc.name, func(t *testing.T) {
-				err := tc.claims.Validate()
-				if tc.expPass {
-					require.NoError(t, err)
-				} else {
-					require.Error(t, err)
-				}
-			})
-		}
-	})
+	require.Equal(t, USDXMintingClaims{
+		NewUSDXMintingClaim(owner, c("ukava", 7), RewardIndexes{NewRewardIndex("bnb-a", d("0.5")), NewRewardIndex("xrpb-a", d("0.2"))}),
+	}, NewUSDXMintingClaims(claims))
+	require.Equal(t, HardLiquidityProviderClaims{
+		NewHardLiquidityProviderClaim(
+			owner, cs(c("hard", 11)),
+			MultiRewardIndexes{NewMultiRewardIndex("bnb", hardIndexes)},
+			MultiRewardIndexes{NewMultiRewardIndex("bnb", hardIndexes)},
+		),
+		NewHardLiquidityProviderClaim(other, cs(c("hard", 4)), MultiRewardIndexes{NewMultiRewardIndex("bnb", hardIndexes)}, nil),
+	}, NewHardLiquidityProviderClaims(claims))
+	require.Equal(t, SwapClaims{
+		NewSwapClaim(other, cs(c("swp", 3)), MultiRewardIndexes{NewMultiRewardIndex("ukava:usdx", hardIndexes)}),
+	}, NewSwapClaims(claims))
+	require.Empty(t, NewDelegatorClaims(claims))
+	require.Empty(t, NewSavingsClaims(claims))
+	require.Empty(t, NewEarnClaims(claims))
 }
 
 func TestRewardIndexes(t *testing.T) {
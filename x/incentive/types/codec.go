@@ -18,6 +18,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClaimSwapReward{}, "incentive/MsgClaimSwapReward", nil)
 	cdc.RegisterConcrete(&MsgClaimSavingsReward{}, "incentive/MsgClaimSavingsReward", nil)
 	cdc.RegisterConcrete(&MsgClaimEarnReward{}, "incentive/MsgClaimEarnReward", nil)
+	cdc.RegisterConcrete(&MsgClaimAllRewards{}, "incentive/MsgClaimAllRewards", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "incentive/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgRemoveAutoCompound{}, "incentive/MsgRemoveAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgLock{}, "incentive/MsgLock", nil)
+	cdc.RegisterConcrete(&MsgExtendLock{}, "incentive/MsgExtendLock", nil)
+	cdc.RegisterConcrete(&MsgWithdrawLock{}, "incentive/MsgWithdrawLock", nil)
+	cdc.RegisterConcrete(&MsgCreateIncentiveCampaign{}, "incentive/MsgCreateIncentiveCampaign", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +35,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgClaimSwapReward{},
 		&MsgClaimSavingsReward{},
 		&MsgClaimEarnReward{},
+		&MsgClaimAllRewards{},
+		&MsgSetAutoCompound{},
+		&MsgRemoveAutoCompound{},
+		&MsgLock{},
+		&MsgExtendLock{},
+		&MsgWithdrawLock{},
+		&MsgCreateIncentiveCampaign{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -18,4 +18,13 @@ var (
 	ErrInvalidClaimType              = errorsmod.Register(ModuleName, 11, "invalid claim type")
 	ErrDecreasingRewardFactor        = errorsmod.Register(ModuleName, 13, "found new reward factor less than an old reward factor")
 	ErrInvalidClaimDenoms            = errorsmod.Register(ModuleName, 14, "invalid claim denoms")
+	ErrInvalidAutoCompoundTarget     = errorsmod.Register(ModuleName, 15, "invalid auto-compound target")
+	ErrAutoCompoundSettingNotFound   = errorsmod.Register(ModuleName, 16, "auto-compound setting not found")
+	ErrBoostLockNotFound             = errorsmod.Register(ModuleName, 17, "boost lock not found")
+	ErrInvalidLockDenom              = errorsmod.Register(ModuleName, 18, "invalid boost lock denom")
+	ErrInvalidLockDuration           = errorsmod.Register(ModuleName, 19, "invalid boost lock duration")
+	ErrBoostLockEnded                = errorsmod.Register(ModuleName, 20, "boost lock has ended")
+	ErrBoostLockNotEnded             = errorsmod.Register(ModuleName, 21, "boost lock has not ended")
+	ErrInvalidCampaign               = errorsmod.Register(ModuleName, 22, "invalid incentive campaign")
+	ErrCampaignNotFound              = errorsmod.Register(ModuleName, 23, "incentive campaign not found")
 )
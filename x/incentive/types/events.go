@@ -2,10 +2,17 @@ package types
 
 // Events emitted by the incentive module
 const (
-	EventTypeClaim             = "claim_reward"
-	EventTypeRewardPeriod      = "new_reward_period"
-	EventTypeClaimPeriod       = "new_claim_period"
-	EventTypeClaimPeriodExpiry = "claim_period_expiry"
+	EventTypeClaim                   = "claim_reward"
+	EventTypeRewardPeriod            = "new_reward_period"
+	EventTypeClaimPeriod             = "new_claim_period"
+	EventTypeClaimPeriodExpiry       = "claim_period_expiry"
+	EventTypeAutoCompound            = "auto_compound"
+	EventTypeBoostLock               = "boost_lock"
+	EventTypeExtendBoostLock         = "extend_boost_lock"
+	EventTypeWithdrawBoostLock       = "withdraw_boost_lock"
+	EventTypeBoostLockEnd            = "boost_lock_end"
+	EventTypeCreateIncentiveCampaign = "create_incentive_campaign"
+	EventTypeRefundIncentiveCampaign = "refund_incentive_campaign"
 
 	AttributeValueCategory   = ModuleName
 	AttributeKeyClaimedBy    = "claimed_by"
@@ -13,4 +20,15 @@ const (
 	AttributeKeyClaimType    = "claim_type"
 	AttributeKeyRewardPeriod = "reward_period"
 	AttributeKeyClaimPeriod  = "claim_period"
+	AttributeKeyOwner        = "owner"
+	AttributeKeyTarget       = "target"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyError        = "error"
+	AttributeKeyEnd          = "end"
+	AttributeKeyWeight       = "weight"
+	AttributeKeyCampaignID   = "campaign_id"
+	AttributeKeyCreator      = "creator"
+	AttributeKeySourceType   = "source_type"
+	AttributeKeySourceID     = "source_id"
+	AttributeKeyStart        = "start"
 )
@@ -18,6 +18,7 @@ import (
 type ParamSubspace interface {
 	GetParamSet(sdk.Context, paramtypes.ParamSet)
 	SetParamSet(sdk.Context, paramtypes.ParamSet)
+	Set(sdk.Context, []byte, interface{})
 	WithKeyTable(paramtypes.KeyTable) paramtypes.Subspace
 	HasKeyTable() bool
 }
@@ -25,6 +26,9 @@ type ParamSubspace interface {
 // BankKeeper defines the expected interface needed to send coins
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
@@ -35,6 +39,11 @@ type StakingKeeper interface {
 	GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []stakingtypes.Delegation)
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
 	TotalBondedTokens(ctx sdk.Context) sdkmath.Int
+	BondDenom(ctx sdk.Context) (res string)
+	Delegate(
+		ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdkmath.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool,
+	) (newShares sdk.Dec, err error)
 }
 
 // CdpKeeper defines the expected cdp keeper for interacting with cdps
@@ -54,6 +63,8 @@ type HardKeeper interface {
 	GetBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool)
 	GetBorrowedCoins(ctx sdk.Context) (coins sdk.Coins, found bool)
 	GetSuppliedCoins(ctx sdk.Context) (coins sdk.Coins, found bool)
+
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 }
 
 // SwapKeeper defines the required methods needed by this modules keeper
@@ -74,6 +85,9 @@ type EarnKeeper interface {
 	GetVaultTotalValue(ctx sdk.Context, denom string) (sdk.Coin, error)
 	GetVaultAccountShares(ctx sdk.Context, acc sdk.AccAddress) (shares earntypes.VaultShares, found bool)
 	IterateVaultRecords(ctx sdk.Context, cb func(record earntypes.VaultRecord) (stop bool))
+
+	GetAllowedVault(ctx sdk.Context, vaultDenom string) (earntypes.AllowedVault, bool)
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin, depositStrategy earntypes.StrategyType) error
 }
 
 // LiquidKeeper defines the required methods needed by this modules keeper
@@ -81,11 +95,13 @@ type LiquidKeeper interface {
 	IsDerivativeDenom(ctx sdk.Context, denom string) bool
 	GetTotalDerivativeValue(ctx sdk.Context) (sdk.Coin, error)
 	GetDerivativeValue(ctx sdk.Context, denom string) (sdk.Coin, error)
+	GetStakedTokensForDerivatives(ctx sdk.Context, coins sdk.Coins) (sdk.Coin, error)
 	CollectStakingRewardsByDenom(
 		ctx sdk.Context,
 		derivativeDenom string,
 		destinationModAccount string,
 	) (sdk.Coins, error)
+	MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error)
 }
 
 // AccountKeeper expected interface for the account keeper (noalias)
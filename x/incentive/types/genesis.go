@@ -6,24 +6,25 @@ import (
 )
 
 var (
-	DefaultUSDXClaims         = USDXMintingClaims{}
-	DefaultHardClaims         = HardLiquidityProviderClaims{}
-	DefaultDelegatorClaims    = DelegatorClaims{}
-	DefaultSwapClaims         = SwapClaims{}
-	DefaultSavingsClaims      = SavingsClaims{}
 	DefaultGenesisRewardState = NewGenesisRewardState(
 		AccumulationTimes{},
 		MultiRewardIndexes{},
 	)
-	DefaultEarnClaims = EarnClaims{}
+
+	DefaultAutoCompoundSettings = AutoCompoundSettings{}
+
+	DefaultSourceRewardStates = TypedGenesisRewardStates{}
+	DefaultSourceClaims       = SourceClaims{}
 )
 
 // NewGenesisState returns a new genesis state
 func NewGenesisState(
 	params Params,
 	usdxState, hardSupplyState, hardBorrowState, delegatorState, swapState, savingsState, earnState GenesisRewardState,
-	c USDXMintingClaims, hc HardLiquidityProviderClaims, dc DelegatorClaims, sc SwapClaims, savingsc SavingsClaims,
-	earnc EarnClaims,
+	autoCompoundSettings AutoCompoundSettings,
+	sourceStates TypedGenesisRewardStates, sourceClaims SourceClaims,
+	boostLocks BoostLocks, boostedShares BoostedSharesList,
+	campaigns IncentiveCampaigns, nextCampaignID uint64,
 ) GenesisState {
 	return GenesisState{
 		Params: params,
@@ -36,32 +37,37 @@ func NewGenesisState(
 		SavingsRewardState:    savingsState,
 		EarnRewardState:       earnState,
 
-		USDXMintingClaims:           c,
-		HardLiquidityProviderClaims: hc,
-		DelegatorClaims:             dc,
-		SwapClaims:                  sc,
-		SavingsClaims:               savingsc,
-		EarnClaims:                  earnc,
+		AutoCompoundSettings: autoCompoundSettings,
+
+		SourceRewardStates: sourceStates,
+		SourceClaims:       sourceClaims,
+
+		BoostLocks:    boostLocks,
+		BoostedShares: boostedShares,
+
+		Campaigns:      campaigns,
+		NextCampaignID: nextCampaignID,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:                      DefaultParams(),
-		USDXRewardState:             DefaultGenesisRewardState,
-		HardSupplyRewardState:       DefaultGenesisRewardState,
-		HardBorrowRewardState:       DefaultGenesisRewardState,
-		DelegatorRewardState:        DefaultGenesisRewardState,
-		SwapRewardState:             DefaultGenesisRewardState,
-		SavingsRewardState:          DefaultGenesisRewardState,
-		EarnRewardState:             DefaultGenesisRewardState,
-		USDXMintingClaims:           DefaultUSDXClaims,
-		HardLiquidityProviderClaims: DefaultHardClaims,
-		DelegatorClaims:             DefaultDelegatorClaims,
-		SwapClaims:                  DefaultSwapClaims,
-		SavingsClaims:               DefaultSavingsClaims,
-		EarnClaims:                  DefaultEarnClaims,
+		Params:                DefaultParams(),
+		USDXRewardState:       DefaultGenesisRewardState,
+		HardSupplyRewardState: DefaultGenesisRewardState,
+		HardBorrowRewardState: DefaultGenesisRewardState,
+		DelegatorRewardState:  DefaultGenesisRewardState,
+		SwapRewardState:       DefaultGenesisRewardState,
+		SavingsRewardState:    DefaultGenesisRewardState,
+		EarnRewardState:       DefaultGenesisRewardState,
+		AutoCompoundSettings:  DefaultAutoCompoundSettings,
+		SourceRewardStates:    DefaultSourceRewardStates,
+		SourceClaims:          DefaultSourceClaims,
+		BoostLocks:            DefaultBoostLocks,
+		BoostedShares:         DefaultBoostedSharesList,
+		Campaigns:             DefaultCampaigns,
+		NextCampaignID:        DefaultNextCampaignID,
 	}
 }
 
@@ -94,6 +100,65 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.AutoCompoundSettings.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.SourceRewardStates.Validate(); err != nil {
+		return err
+	}
+	if err := gs.validateDeprecatedClaims(); err != nil {
+		return err
+	}
+	if err := gs.GetSourceClaims().Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.BoostLocks.Validate(); err != nil {
+		return err
+	}
+	if err := gs.BoostedShares.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.Campaigns.Validate(); err != nil {
+		return err
+	}
+	for _, campaign := range gs.Campaigns {
+		if campaign.ID >= gs.NextCampaignID {
+			return fmt.Errorf("campaign id %d must be less than the next campaign id %d", campaign.ID, gs.NextCampaignID)
+		}
+	}
+	return nil
+}
+
+// GetSourceClaims returns the source claims in the genesis state, including the deprecated claims of the built in
+// rewards split into source claims.
+func (gs GenesisState) GetSourceClaims() SourceClaims {
+	claims := append(SourceClaims{}, gs.SourceClaims...)
+	for _, c := range gs.USDXMintingClaims {
+		claims = append(claims, c.SourceClaims()...)
+	}
+	for _, c := range gs.HardLiquidityProviderClaims {
+		claims = append(claims, c.SourceClaims()...)
+	}
+	for _, c := range gs.DelegatorClaims {
+		claims = append(claims, c.SourceClaims()...)
+	}
+	for _, c := range gs.SwapClaims {
+		claims = append(claims, c.SourceClaims()...)
+	}
+	for _, c := range gs.SavingsClaims {
+		claims = append(claims, c.SourceClaims()...)
+	}
+	for _, c := range gs.EarnClaims {
+		claims = append(claims, c.SourceClaims()...)
+	}
+	return claims
+}
+
+// validateDeprecatedClaims performs a basic check of the deprecated claims of the built in rewards
+func (gs GenesisState) validateDeprecatedClaims() error {
 	if err := gs.USDXMintingClaims.Validate(); err != nil {
 		return err
 	}
@@ -106,11 +171,9 @@ func (gs GenesisState) Validate() error {
 	if err := gs.SwapClaims.Validate(); err != nil {
 		return err
 	}
-
 	if err := gs.SavingsClaims.Validate(); err != nil {
 		return err
 	}
-
 	return gs.EarnClaims.Validate()
 }
 
@@ -105,6 +105,45 @@ func (m *GenesisRewardState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisRewardState proto.InternalMessageInfo
 
+// TypedGenesisRewardState is the global state of a reward source type registered with the incentive module.
+type TypedGenesisRewardState struct {
+	SourceType  string             `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	RewardState GenesisRewardState `protobuf:"bytes,2,opt,name=reward_state,json=rewardState,proto3" json:"reward_state"`
+}
+
+func (m *TypedGenesisRewardState) Reset()         { *m = TypedGenesisRewardState{} }
+func (m *TypedGenesisRewardState) String() string { return proto.CompactTextString(m) }
+func (*TypedGenesisRewardState) ProtoMessage()    {}
+func (*TypedGenesisRewardState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b76737885d05afd, []int{2}
+}
+func (m *TypedGenesisRewardState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TypedGenesisRewardState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TypedGenesisRewardState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TypedGenesisRewardState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypedGenesisRewardState.Merge(m, src)
+}
+func (m *TypedGenesisRewardState) XXX_Size() int {
+	return m.Size()
+}
+func (m *TypedGenesisRewardState) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypedGenesisRewardState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypedGenesisRewardState proto.InternalMessageInfo
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	Params                      Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -113,21 +152,30 @@ type GenesisState struct {
 	HardBorrowRewardState       GenesisRewardState          `protobuf:"bytes,4,opt,name=hard_borrow_reward_state,json=hardBorrowRewardState,proto3" json:"hard_borrow_reward_state"`
 	DelegatorRewardState        GenesisRewardState          `protobuf:"bytes,5,opt,name=delegator_reward_state,json=delegatorRewardState,proto3" json:"delegator_reward_state"`
 	SwapRewardState             GenesisRewardState          `protobuf:"bytes,6,opt,name=swap_reward_state,json=swapRewardState,proto3" json:"swap_reward_state"`
-	USDXMintingClaims           USDXMintingClaims           `protobuf:"bytes,7,rep,name=usdx_minting_claims,json=usdxMintingClaims,proto3,castrepeated=USDXMintingClaims" json:"usdx_minting_claims"`
-	HardLiquidityProviderClaims HardLiquidityProviderClaims `protobuf:"bytes,8,rep,name=hard_liquidity_provider_claims,json=hardLiquidityProviderClaims,proto3,castrepeated=HardLiquidityProviderClaims" json:"hard_liquidity_provider_claims"`
-	DelegatorClaims             DelegatorClaims             `protobuf:"bytes,9,rep,name=delegator_claims,json=delegatorClaims,proto3,castrepeated=DelegatorClaims" json:"delegator_claims"`
-	SwapClaims                  SwapClaims                  `protobuf:"bytes,10,rep,name=swap_claims,json=swapClaims,proto3,castrepeated=SwapClaims" json:"swap_claims"`
+	USDXMintingClaims           USDXMintingClaims           `protobuf:"bytes,7,rep,name=usdx_minting_claims,json=usdxMintingClaims,proto3,castrepeated=USDXMintingClaims" json:"usdx_minting_claims"`                                           // Deprecated: Do not use.
+	HardLiquidityProviderClaims HardLiquidityProviderClaims `protobuf:"bytes,8,rep,name=hard_liquidity_provider_claims,json=hardLiquidityProviderClaims,proto3,castrepeated=HardLiquidityProviderClaims" json:"hard_liquidity_provider_claims"` // Deprecated: Do not use.
+	DelegatorClaims             DelegatorClaims             `protobuf:"bytes,9,rep,name=delegator_claims,json=delegatorClaims,proto3,castrepeated=DelegatorClaims" json:"delegator_claims"`                                                     // Deprecated: Do not use.
+	SwapClaims                  SwapClaims                  `protobuf:"bytes,10,rep,name=swap_claims,json=swapClaims,proto3,castrepeated=SwapClaims" json:"swap_claims"`                                                                        // Deprecated: Do not use.
 	SavingsRewardState          GenesisRewardState          `protobuf:"bytes,11,opt,name=savings_reward_state,json=savingsRewardState,proto3" json:"savings_reward_state"`
-	SavingsClaims               SavingsClaims               `protobuf:"bytes,12,rep,name=savings_claims,json=savingsClaims,proto3,castrepeated=SavingsClaims" json:"savings_claims"`
+	SavingsClaims               SavingsClaims               `protobuf:"bytes,12,rep,name=savings_claims,json=savingsClaims,proto3,castrepeated=SavingsClaims" json:"savings_claims"` // Deprecated: Do not use.
 	EarnRewardState             GenesisRewardState          `protobuf:"bytes,13,opt,name=earn_reward_state,json=earnRewardState,proto3" json:"earn_reward_state"`
-	EarnClaims                  EarnClaims                  `protobuf:"bytes,14,rep,name=earn_claims,json=earnClaims,proto3,castrepeated=EarnClaims" json:"earn_claims"`
+	EarnClaims                  EarnClaims                  `protobuf:"bytes,14,rep,name=earn_claims,json=earnClaims,proto3,castrepeated=EarnClaims" json:"earn_claims"` // Deprecated: Do not use.
+	AutoCompoundSettings        AutoCompoundSettings        `protobuf:"bytes,15,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3,castrepeated=AutoCompoundSettings" json:"auto_compound_settings"`
+	SourceRewardStates          TypedGenesisRewardStates    `protobuf:"bytes,16,rep,name=source_reward_states,json=sourceRewardStates,proto3,castrepeated=TypedGenesisRewardStates" json:"source_reward_states"`
+	// source_claims are the claims of all reward sources, including the ones built into the incentive module.
+	SourceClaims  SourceClaims       `protobuf:"bytes,17,rep,name=source_claims,json=sourceClaims,proto3,castrepeated=SourceClaims" json:"source_claims"`
+	BoostLocks    BoostLocks         `protobuf:"bytes,18,rep,name=boost_locks,json=boostLocks,proto3,castrepeated=BoostLocks" json:"boost_locks"`
+	BoostedShares BoostedSharesList  `protobuf:"bytes,19,rep,name=boosted_shares,json=boostedShares,proto3,castrepeated=BoostedSharesList" json:"boosted_shares"`
+	Campaigns     IncentiveCampaigns `protobuf:"bytes,20,rep,name=campaigns,proto3,castrepeated=IncentiveCampaigns" json:"campaigns"`
+	// next_campaign_id is the id given to the next incentive campaign created.
+	NextCampaignID uint64 `protobuf:"varint,21,opt,name=next_campaign_id,json=nextCampaignId,proto3" json:"next_campaign_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b76737885d05afd, []int{2}
+	return fileDescriptor_8b76737885d05afd, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,6 +207,7 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*AccumulationTime)(nil), "kava.incentive.v1beta1.AccumulationTime")
 	proto.RegisterType((*GenesisRewardState)(nil), "kava.incentive.v1beta1.GenesisRewardState")
+	proto.RegisterType((*TypedGenesisRewardState)(nil), "kava.incentive.v1beta1.TypedGenesisRewardState")
 	proto.RegisterType((*GenesisState)(nil), "kava.incentive.v1beta1.GenesisState")
 }
 
@@ -167,57 +216,74 @@ func init() {
 }
 
 var fileDescriptor_8b76737885d05afd = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xc7, 0x63, 0x60, 0x59, 0x98, 0x00, 0x21, 0xb3, 0x01, 0xb2, 0x41, 0x72, 0x58, 0x40, 0xbb,
-	0xd1, 0x56, 0xb5, 0x45, 0x7a, 0xed, 0xa5, 0x2e, 0x55, 0x8b, 0x54, 0x24, 0xe4, 0x50, 0x54, 0x55,
-	0x95, 0xa2, 0x71, 0x3c, 0x98, 0x69, 0xfd, 0xab, 0x1e, 0x3b, 0x21, 0xb7, 0x1e, 0x7b, 0xe4, 0x0f,
-	0xa8, 0xd4, 0x7b, 0xff, 0x8f, 0x4a, 0x1c, 0x39, 0xf6, 0x04, 0x6d, 0xf8, 0x47, 0xaa, 0x19, 0x8f,
-	0x83, 0x9d, 0x60, 0xaa, 0xa6, 0xb7, 0xc9, 0x9b, 0x37, 0xdf, 0xcf, 0xf7, 0xcd, 0x7b, 0x13, 0x83,
-	0xed, 0xb7, 0xa8, 0x8b, 0x54, 0xe2, 0x76, 0xb0, 0x1b, 0x92, 0x2e, 0x56, 0xbb, 0x3b, 0x06, 0x0e,
-	0xd1, 0x8e, 0x6a, 0x61, 0x17, 0x53, 0x42, 0x15, 0x3f, 0xf0, 0x42, 0x0f, 0xae, 0xb2, 0x2c, 0x65,
-	0x98, 0xa5, 0x88, 0xac, 0x5a, 0xc5, 0xf2, 0x2c, 0x8f, 0xa7, 0xa8, 0x6c, 0x15, 0x67, 0xd7, 0xea,
-	0x96, 0xe7, 0x59, 0x36, 0x56, 0xf9, 0x2f, 0x23, 0x3a, 0x56, 0x43, 0xe2, 0x60, 0x1a, 0x22, 0xc7,
-	0x17, 0x09, 0x5b, 0x39, 0xd0, 0x8e, 0x8d, 0x88, 0x43, 0x7f, 0x92, 0xe4, 0xa3, 0x00, 0x25, 0x49,
-	0x9b, 0x9f, 0x24, 0xb0, 0xfc, 0xa8, 0xd3, 0x89, 0x9c, 0xc8, 0x46, 0x21, 0xf1, 0xdc, 0x43, 0xe2,
-	0x60, 0xf8, 0x1f, 0x28, 0x75, 0x3c, 0xdb, 0x46, 0x21, 0x0e, 0x90, 0xdd, 0x0e, 0xfb, 0x3e, 0xae,
-	0x4a, 0x1b, 0x52, 0x63, 0x5e, 0x5f, 0xba, 0x09, 0x1f, 0xf6, 0x7d, 0x0c, 0x0d, 0x50, 0xf3, 0x03,
-	0xdc, 0x25, 0x5e, 0x44, 0xdb, 0x28, 0xa5, 0xd2, 0x66, 0x86, 0xab, 0x53, 0x1b, 0x52, 0xa3, 0xd8,
-	0xac, 0x29, 0x71, 0x35, 0x4a, 0x52, 0x8d, 0x72, 0x98, 0x54, 0xa3, 0xcd, 0x9d, 0x5f, 0xd6, 0x0b,
-	0x67, 0x57, 0x75, 0x49, 0xaf, 0x26, 0x3a, 0xa3, 0x66, 0x36, 0xdf, 0x4f, 0x01, 0xf8, 0x34, 0xbe,
-	0x4c, 0x1d, 0xf7, 0x50, 0x60, 0xb6, 0x42, 0x14, 0x62, 0x18, 0x00, 0x38, 0x46, 0xa4, 0x55, 0x69,
-	0x63, 0xba, 0x51, 0x6c, 0x36, 0x94, 0xdb, 0xaf, 0x5b, 0x19, 0x15, 0xd7, 0xfe, 0x66, 0x06, 0x3e,
-	0x5f, 0xd5, 0xcb, 0xa3, 0x3b, 0x54, 0x2f, 0xa3, 0xd1, 0x10, 0xec, 0x82, 0x8a, 0x13, 0xd9, 0x21,
-	0x69, 0x07, 0xdc, 0x48, 0x9b, 0xb8, 0x26, 0x3e, 0xc5, 0xb4, 0x3a, 0x75, 0x37, 0x75, 0x9f, 0x9d,
-	0x89, 0xbd, 0xef, 0xb1, 0x13, 0x5a, 0x4d, 0x50, 0xe1, 0xe8, 0x0e, 0xa6, 0x3a, 0x74, 0xc6, 0x62,
-	0x9b, 0x5f, 0x8a, 0x60, 0x41, 0x5c, 0x41, 0x5c, 0xfc, 0x43, 0x30, 0x1b, 0x77, 0x91, 0xf7, 0xa5,
-	0xd8, 0x94, 0xf3, 0xd0, 0x07, 0x3c, 0x4b, 0x9b, 0x61, 0x40, 0x5d, 0x9c, 0x81, 0x1e, 0x28, 0x47,
-	0xd4, 0x3c, 0x4d, 0xaa, 0xa0, 0x4c, 0x52, 0x34, 0xeb, 0xff, 0x3c, 0xa1, 0xf1, 0x0e, 0x68, 0x6b,
-	0x4c, 0x74, 0x70, 0x59, 0x2f, 0xbd, 0x68, 0xed, 0xbe, 0x4c, 0x6d, 0xe8, 0x25, 0xa6, 0x9e, 0xee,
-	0x15, 0x01, 0xd5, 0x13, 0x4e, 0x8a, 0x7c, 0xdf, 0xee, 0x67, 0xb9, 0xd3, 0xbf, 0xcc, 0x8d, 0x8b,
-	0x59, 0x61, 0x8a, 0x2d, 0x2e, 0x78, 0x1b, 0xca, 0xf0, 0x82, 0xc0, 0xeb, 0x65, 0x51, 0x33, 0xbf,
-	0x83, 0xd2, 0xb8, 0x60, 0x1a, 0x75, 0x0c, 0x56, 0x4d, 0x6c, 0x63, 0x0b, 0x85, 0x5e, 0x90, 0x05,
-	0xfd, 0x31, 0x21, 0xa8, 0x32, 0xd4, 0x4b, 0x73, 0x5e, 0x83, 0x32, 0xed, 0x21, 0x3f, 0x8b, 0x98,
-	0x9d, 0x10, 0x51, 0x62, 0x52, 0x69, 0xf5, 0x0f, 0x12, 0xf8, 0x8b, 0x4f, 0x83, 0x43, 0xdc, 0x90,
-	0xb8, 0x56, 0x3b, 0xfe, 0x0f, 0xa9, 0xfe, 0x79, 0xf7, 0x4c, 0xb3, 0x9e, 0xef, 0xc7, 0x27, 0x1e,
-	0xb3, 0x03, 0x9a, 0x22, 0xa6, 0xa1, 0x3c, 0xba, 0x43, 0xd9, 0xf3, 0x1a, 0x0b, 0xea, 0x7c, 0x04,
-	0x33, 0x21, 0xf8, 0x51, 0x02, 0x32, 0x6f, 0x9e, 0x4d, 0xde, 0x45, 0xc4, 0x24, 0x61, 0xbf, 0xed,
-	0x07, 0x5e, 0x97, 0x98, 0x38, 0x48, 0x5c, 0xcd, 0x71, 0x57, 0xcd, 0x3c, 0x57, 0xcf, 0x50, 0x60,
-	0x3e, 0x4f, 0x0e, 0x1f, 0x88, 0xb3, 0xb1, 0xbf, 0x2d, 0xf1, 0xe6, 0xd6, 0xf3, 0x73, 0xa8, 0xbe,
-	0x7e, 0x92, 0xbf, 0x09, 0xdf, 0x80, 0xe5, 0x9b, 0x7e, 0x0b, 0x3f, 0xf3, 0xdc, 0xcf, 0xbf, 0x79,
-	0x7e, 0x76, 0x93, 0xfc, 0xd8, 0xc3, 0x9a, 0xf0, 0x50, 0xca, 0xc6, 0xa9, 0x5e, 0x32, 0xb3, 0x01,
-	0x78, 0x04, 0x8a, 0xbc, 0xe7, 0x02, 0x03, 0x38, 0xe6, 0x9f, 0x3c, 0x4c, 0xab, 0x87, 0xfc, 0x98,
-	0x00, 0x05, 0x01, 0x0c, 0x43, 0x54, 0x07, 0x74, 0xb8, 0x86, 0x06, 0xa8, 0x50, 0xd4, 0x25, 0xae,
-	0x45, 0xb3, 0xe3, 0x54, 0x9c, 0x70, 0x9c, 0xa0, 0x50, 0x4b, 0x4f, 0x94, 0x01, 0x96, 0x12, 0x86,
-	0xb0, 0xbf, 0xc0, 0xed, 0x6f, 0xe7, 0xda, 0x8f, 0xb3, 0xe3, 0x0a, 0x56, 0x44, 0x05, 0x8b, 0xe9,
-	0x28, 0xd5, 0x17, 0x69, 0xfa, 0x27, 0x7b, 0x13, 0x18, 0x05, 0x6e, 0xb6, 0x88, 0xc5, 0x49, 0xdf,
-	0x04, 0x93, 0x4a, 0x57, 0x70, 0x04, 0x8a, 0x5c, 0x5d, 0xd8, 0x5f, 0xba, 0xfb, 0xf6, 0x9f, 0xa0,
-	0xc0, 0x1d, 0xb9, 0xfd, 0x61, 0x88, 0xea, 0x00, 0x0f, 0xd7, 0xda, 0xde, 0xf9, 0x77, 0xb9, 0x70,
-	0x3e, 0x90, 0xa5, 0x8b, 0x81, 0x2c, 0x7d, 0x1b, 0xc8, 0xd2, 0xd9, 0xb5, 0x5c, 0xb8, 0xb8, 0x96,
-	0x0b, 0x5f, 0xaf, 0xe5, 0xc2, 0xab, 0x7b, 0x16, 0x09, 0x4f, 0x22, 0x43, 0xe9, 0x78, 0x8e, 0xca,
-	0x50, 0xf7, 0x6d, 0x64, 0x50, 0xbe, 0x52, 0x4f, 0x53, 0x9f, 0x71, 0xf6, 0x39, 0xa6, 0xc6, 0x2c,
-	0xff, 0x9a, 0x3e, 0xf8, 0x11, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xa8, 0x1c, 0xb0, 0x7f, 0x08, 0x00,
-	0x00,
+	// 1067 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xdb, 0x36,
+	0x18, 0x8d, 0xd2, 0x2c, 0x6b, 0xe8, 0xc4, 0x8e, 0x59, 0x37, 0xd1, 0xd2, 0xc1, 0xce, 0xd2, 0x66,
+	0xf3, 0x5a, 0xcc, 0x5e, 0xb3, 0x6b, 0x2f, 0x53, 0x32, 0x6c, 0x01, 0xd2, 0xa1, 0x90, 0xb3, 0x21,
+	0x18, 0x86, 0x09, 0x94, 0xc4, 0x2a, 0x44, 0x24, 0x51, 0x13, 0x29, 0xc7, 0x39, 0x6d, 0xc0, 0x6e,
+	0xbb, 0xac, 0x3f, 0x60, 0xd8, 0xee, 0xfd, 0x25, 0x39, 0xf6, 0xb8, 0x53, 0xb2, 0x39, 0x7f, 0x64,
+	0x20, 0x45, 0xd9, 0x92, 0x63, 0xb9, 0x80, 0x7b, 0x13, 0x1f, 0xdf, 0xf7, 0xde, 0x23, 0x3f, 0x4a,
+	0x22, 0x78, 0x74, 0x86, 0xfa, 0xa8, 0x4b, 0x42, 0x07, 0x87, 0x9c, 0xf4, 0x71, 0xb7, 0xff, 0xd4,
+	0xc6, 0x1c, 0x3d, 0xed, 0x7a, 0x38, 0xc4, 0x8c, 0xb0, 0x4e, 0x14, 0x53, 0x4e, 0xe1, 0x86, 0x60,
+	0x75, 0x46, 0xac, 0x8e, 0x62, 0x6d, 0x35, 0x3c, 0xea, 0x51, 0x49, 0xe9, 0x8a, 0xa7, 0x94, 0xbd,
+	0xd5, 0xf2, 0x28, 0xf5, 0x7c, 0xdc, 0x95, 0x23, 0x3b, 0x79, 0xd9, 0xe5, 0x24, 0xc0, 0x8c, 0xa3,
+	0x20, 0x52, 0x84, 0xc7, 0x25, 0xa6, 0x28, 0xe1, 0xd4, 0x72, 0x68, 0x10, 0xd1, 0x24, 0x74, 0x15,
+	0x77, 0xa7, 0x84, 0x6b, 0x53, 0xca, 0xb8, 0xe2, 0xec, 0x96, 0x70, 0x1c, 0x14, 0x44, 0x88, 0x78,
+	0xa1, 0xa2, 0x3d, 0x2c, 0xa3, 0xf9, 0x88, 0x04, 0xec, 0x2d, 0xa4, 0x08, 0xc5, 0x28, 0x23, 0xed,
+	0xfc, 0xad, 0x81, 0xf5, 0x2f, 0x1d, 0x27, 0x09, 0x12, 0x1f, 0x71, 0x42, 0xc3, 0x63, 0x12, 0x60,
+	0xf8, 0x09, 0xa8, 0x39, 0xd4, 0xf7, 0x11, 0xc7, 0x31, 0xf2, 0x2d, 0x7e, 0x11, 0x61, 0x5d, 0xdb,
+	0xd6, 0xda, 0x2b, 0x66, 0x75, 0x0c, 0x1f, 0x5f, 0x44, 0x18, 0xda, 0x60, 0x2b, 0x8a, 0x71, 0x9f,
+	0xd0, 0x84, 0x59, 0x28, 0xa7, 0x62, 0x89, 0x7d, 0xd2, 0x17, 0xb7, 0xb5, 0x76, 0x65, 0x6f, 0xab,
+	0x93, 0x6e, 0x62, 0x27, 0xdb, 0xc4, 0xce, 0x71, 0xb6, 0x89, 0xc6, 0xdd, 0xcb, 0xab, 0xd6, 0xc2,
+	0xab, 0xeb, 0x96, 0x66, 0xea, 0x99, 0xce, 0x64, 0x98, 0x9d, 0x5f, 0x17, 0x01, 0xfc, 0x3a, 0xed,
+	0xa1, 0x89, 0xcf, 0x51, 0xec, 0xf6, 0x38, 0xe2, 0x18, 0xc6, 0x00, 0xde, 0x72, 0x64, 0xba, 0xb6,
+	0x7d, 0xa7, 0x5d, 0xd9, 0x6b, 0x77, 0xa6, 0x77, 0xb9, 0x33, 0x29, 0x6e, 0x7c, 0x20, 0x02, 0xbc,
+	0xbe, 0x6e, 0xd5, 0x27, 0x67, 0x98, 0x59, 0x47, 0x93, 0x10, 0xec, 0x83, 0x46, 0x90, 0xf8, 0x9c,
+	0x58, 0xb1, 0x0c, 0x62, 0x91, 0xd0, 0xc5, 0x03, 0xcc, 0xf4, 0xc5, 0xd9, 0xae, 0xcf, 0x45, 0x4d,
+	0x9a, 0xfd, 0x50, 0x54, 0x18, 0x5b, 0xca, 0x15, 0x4e, 0xce, 0x60, 0x66, 0xc2, 0xe0, 0x16, 0xb6,
+	0xf3, 0x87, 0x06, 0x36, 0xc5, 0x7e, 0xbb, 0x53, 0xf6, 0xa1, 0x05, 0x2a, 0x8c, 0x26, 0xb1, 0x83,
+	0xf3, 0x7d, 0x02, 0x29, 0x24, 0x7b, 0xd4, 0x03, 0xab, 0x2a, 0x2e, 0x13, 0x05, 0xaa, 0x2b, 0x8f,
+	0xcb, 0xc2, 0xde, 0xb6, 0x30, 0x96, 0x44, 0x5c, 0xb3, 0x12, 0x8f, 0xa1, 0x9d, 0x3f, 0xeb, 0x60,
+	0x55, 0x31, 0xd3, 0x18, 0xcf, 0xc0, 0x72, 0x7a, 0xae, 0x64, 0x82, 0xca, 0x5e, 0xb3, 0x4c, 0xff,
+	0x85, 0x64, 0x29, 0x4d, 0x55, 0x03, 0x29, 0xa8, 0x27, 0xcc, 0x1d, 0x58, 0xef, 0x18, 0x74, 0x53,
+	0x88, 0x0e, 0xaf, 0x5a, 0xb5, 0xef, 0x7a, 0x07, 0x27, 0xb9, 0x09, 0xb3, 0x26, 0xd4, 0xf3, 0xbb,
+	0x46, 0x80, 0x7e, 0x2a, 0x9d, 0x92, 0x28, 0xf2, 0x2f, 0x8a, 0xbe, 0x77, 0xe6, 0xdc, 0xa0, 0xfb,
+	0x42, 0xb1, 0x27, 0x05, 0xa7, 0x59, 0xd9, 0x34, 0x8e, 0xe9, 0x79, 0xd1, 0x6a, 0xe9, 0x5d, 0xac,
+	0x0c, 0x29, 0x98, 0xb7, 0x7a, 0x09, 0x36, 0x5c, 0xec, 0x63, 0x0f, 0x71, 0x1a, 0x17, 0x8d, 0xde,
+	0x9b, 0xd3, 0xa8, 0x31, 0xd2, 0xcb, 0xfb, 0xfc, 0x08, 0xea, 0xec, 0x1c, 0x45, 0x45, 0x8b, 0xe5,
+	0x39, 0x2d, 0x6a, 0x42, 0x2a, 0xaf, 0xfe, 0xbb, 0x06, 0xee, 0xc9, 0xd3, 0x10, 0x90, 0x90, 0x93,
+	0xd0, 0xb3, 0xd2, 0xaf, 0x9a, 0xfe, 0xfe, 0xec, 0xb7, 0x4c, 0xf4, 0xfc, 0x79, 0x5a, 0xb1, 0x2f,
+	0x0a, 0x8c, 0xcf, 0xd5, 0x69, 0xa8, 0x4f, 0xce, 0xb0, 0xd7, 0xd7, 0x53, 0x40, 0x5d, 0x33, 0xe5,
+	0x21, 0x2c, 0x80, 0xf0, 0x2f, 0x0d, 0x34, 0x65, 0xfb, 0x7c, 0xf2, 0x73, 0x42, 0x5c, 0xc2, 0x2f,
+	0xac, 0x28, 0xa6, 0x7d, 0xe2, 0xe2, 0x38, 0xcb, 0x75, 0x57, 0xe6, 0xda, 0x2b, 0xcb, 0xf5, 0x0d,
+	0x8a, 0xdd, 0xa3, 0xac, 0xf8, 0x85, 0xaa, 0x4d, 0x13, 0xee, 0xaa, 0xef, 0xc0, 0x83, 0x72, 0x8e,
+	0x88, 0xf5, 0xe0, 0xb4, 0x7c, 0x1a, 0xfa, 0x60, 0x7d, 0xdc, 0x73, 0x95, 0x68, 0x45, 0x26, 0xfa,
+	0xb8, 0x2c, 0xd1, 0x41, 0xc6, 0x4f, 0x53, 0x64, 0xdf, 0xc0, 0x5a, 0x11, 0x17, 0xce, 0x35, 0xb7,
+	0x08, 0xc1, 0x13, 0x50, 0x91, 0x9d, 0x57, 0x46, 0x40, 0x1a, 0x7d, 0x54, 0x66, 0xd4, 0x3b, 0x47,
+	0x51, 0xea, 0xd1, 0x50, 0x1e, 0x60, 0x04, 0x09, 0x79, 0xc0, 0x46, 0x23, 0x68, 0x83, 0x06, 0x43,
+	0x7d, 0x12, 0x7a, 0xac, 0x78, 0xac, 0x2a, 0x73, 0x1e, 0x2b, 0xa8, 0xd4, 0xf2, 0x27, 0xcb, 0x05,
+	0xd5, 0xcc, 0x43, 0x2d, 0x60, 0x55, 0x2e, 0xe0, 0x51, 0xe9, 0x02, 0x52, 0x76, 0xba, 0x86, 0x4d,
+	0xb5, 0x86, 0xb5, 0x3c, 0x2a, 0x96, 0xb1, 0xc6, 0xf2, 0x80, 0x78, 0x3b, 0x30, 0x8a, 0xc3, 0xe2,
+	0x32, 0xd6, 0xe6, 0x7d, 0x3b, 0x84, 0x54, 0x7e, 0x0d, 0x27, 0xa0, 0x22, 0xd5, 0xd5, 0x02, 0xaa,
+	0xb3, 0x3b, 0xf0, 0x15, 0x8a, 0xc3, 0x89, 0x0e, 0x8c, 0x20, 0xd9, 0x01, 0x3c, 0x1a, 0xc1, 0x5f,
+	0xc0, 0x46, 0xe1, 0xda, 0x62, 0x31, 0xcc, 0xc5, 0x9b, 0xc0, 0xf4, 0x9a, 0x34, 0x79, 0x52, 0xfa,
+	0x57, 0x4d, 0x38, 0xdd, 0x57, 0x45, 0xbd, 0xb4, 0xc6, 0xf8, 0x50, 0xd9, 0x35, 0xa6, 0x4c, 0x32,
+	0xb3, 0x81, 0xa6, 0xa0, 0xf0, 0x37, 0x0d, 0x34, 0xd4, 0xbf, 0x2c, 0xbf, 0x77, 0x4c, 0x5f, 0x97,
+	0xfe, 0xdd, 0x32, 0xff, 0x92, 0x5f, 0xa3, 0xb1, 0xad, 0x32, 0xe8, 0x25, 0x04, 0x66, 0xc2, 0xd4,
+	0x2e, 0x8f, 0xc1, 0x9f, 0xc0, 0x9a, 0x0a, 0xa1, 0xb6, 0xb8, 0x2e, 0xdd, 0x1f, 0x96, 0x9e, 0x11,
+	0x49, 0x2e, 0x6e, 0xf2, 0x6a, 0x0e, 0x64, 0xe6, 0x2a, 0xcb, 0x8d, 0xe0, 0xf7, 0xa0, 0x22, 0x6f,
+	0x7c, 0x96, 0x4f, 0x9d, 0x33, 0xa6, 0xc3, 0xd9, 0x0d, 0x34, 0x04, 0xf5, 0x88, 0x3a, 0x67, 0x06,
+	0xcc, 0x1a, 0x38, 0x82, 0x98, 0x09, 0xec, 0xd1, 0x33, 0xf4, 0x40, 0x55, 0x8e, 0xb0, 0x6b, 0xb1,
+	0x53, 0x14, 0x63, 0xa6, 0xdf, 0x93, 0xd2, 0xbb, 0x33, 0xa5, 0xb1, 0xdb, 0x93, 0xe4, 0xf1, 0x4d,
+	0xa8, 0x00, 0x1f, 0x11, 0xc6, 0xcd, 0x35, 0x3b, 0x0f, 0x41, 0x07, 0xac, 0x64, 0xd7, 0x51, 0xa6,
+	0x37, 0xa4, 0xc7, 0xa7, 0x65, 0x1e, 0x87, 0x19, 0xb2, 0xaf, 0x2a, 0xc6, 0x77, 0x9f, 0x5b, 0x53,
+	0xcc, 0x1c, 0xeb, 0xc2, 0x67, 0x60, 0x3d, 0xc4, 0x03, 0x6e, 0x65, 0x88, 0x45, 0x5c, 0xfd, 0xfe,
+	0xb6, 0xd6, 0x5e, 0x32, 0xe0, 0xf0, 0xaa, 0x55, 0xfd, 0x16, 0x0f, 0x78, 0x56, 0x77, 0x78, 0x60,
+	0x56, 0xc3, 0xfc, 0xd8, 0x35, 0x0e, 0x2f, 0xff, 0x6b, 0x2e, 0x5c, 0x0e, 0x9b, 0xda, 0x9b, 0x61,
+	0x53, 0xfb, 0x77, 0xd8, 0xd4, 0x5e, 0xdd, 0x34, 0x17, 0xde, 0xdc, 0x34, 0x17, 0xfe, 0xb9, 0x69,
+	0x2e, 0xfc, 0xf0, 0xc4, 0x23, 0xfc, 0x34, 0xb1, 0x3b, 0x0e, 0x0d, 0xba, 0x22, 0xf7, 0x67, 0x3e,
+	0xb2, 0x99, 0x7c, 0xea, 0x0e, 0x72, 0xf7, 0x65, 0x71, 0x9f, 0x62, 0xf6, 0xb2, 0xbc, 0xb6, 0x7e,
+	0xf1, 0xff, 0x00, 0xe2, 0xfb, 0xbe, 0xd9, 0x5f, 0x0c, 0x00, 0x00,
 }
 
 func (m *AccumulationTime) Marshal() (dAtA []byte, err error) {
@@ -309,6 +375,46 @@ func (m *GenesisRewardState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TypedGenesisRewardState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TypedGenesisRewardState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TypedGenesisRewardState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RewardState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SourceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -329,6 +435,107 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextCampaignID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextCampaignID))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.BoostedShares) > 0 {
+		for iNdEx := len(m.BoostedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BoostedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.BoostLocks) > 0 {
+		for iNdEx := len(m.BoostLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BoostLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.SourceClaims) > 0 {
+		for iNdEx := len(m.SourceClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.SourceRewardStates) > 0 {
+		for iNdEx := len(m.SourceRewardStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceRewardStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for iNdEx := len(m.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.EarnClaims) > 0 {
 		for iNdEx := len(m.EarnClaims) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -543,6 +750,21 @@ func (m *GenesisRewardState) Size() (n int) {
 	return n
 }
 
+func (m *TypedGenesisRewardState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RewardState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -601,6 +823,45 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for _, e := range m.AutoCompoundSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceRewardStates) > 0 {
+		for _, e := range m.SourceRewardStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceClaims) > 0 {
+		for _, e := range m.SourceClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BoostLocks) > 0 {
+		for _, e := range m.BoostLocks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BoostedShares) > 0 {
+		for _, e := range m.BoostedShares {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextCampaignID != 0 {
+		n += 2 + sovGenesis(uint64(m.NextCampaignID))
+	}
 	return n
 }
 
@@ -843,6 +1104,121 @@ func (m *GenesisRewardState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TypedGenesisRewardState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypedGenesisRewardState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypedGenesisRewardState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1340,6 +1716,229 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundSettings = append(m.AutoCompoundSettings, AutoCompoundSetting{})
+			if err := m.AutoCompoundSettings[len(m.AutoCompoundSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRewardStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRewardStates = append(m.SourceRewardStates, TypedGenesisRewardState{})
+			if err := m.SourceRewardStates[len(m.SourceRewardStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceClaims = append(m.SourceClaims, SourceClaim{})
+			if err := m.SourceClaims[len(m.SourceClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoostLocks = append(m.BoostLocks, BoostLock{})
+			if err := m.BoostLocks[len(m.BoostLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoostedShares = append(m.BoostedShares, BoostedShares{})
+			if err := m.BoostedShares[len(m.BoostedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, IncentiveCampaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCampaignID", wireType)
+			}
+			m.NextCampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
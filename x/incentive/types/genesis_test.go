@@ -48,6 +48,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					DefaultMultiRewardPeriods,
 					DefaultMultiRewardPeriods,
 					DefaultMultiRewardPeriods,
+					DefaultSourceRewardPeriods,
 					MultipliersPerDenoms{
 						{
 							Denom: "ukava",
@@ -58,6 +59,8 @@ func TestGenesisState_Validate(t *testing.T) {
 						},
 					},
 					time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+					DefaultBoostParams,
+					DefaultCampaignParams,
 				),
 				USDXRewardState: GenesisRewardState{
 					AccumulationTimes: AccumulationTimes{{
@@ -69,19 +72,14 @@ func TestGenesisState_Validate(t *testing.T) {
 						RewardIndexes:  normalRewardIndexes,
 					}},
 				},
-				USDXMintingClaims: USDXMintingClaims{
-					{
-						BaseClaim: BaseClaim{
-							Owner:  sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser1"))),
-							Reward: sdk.NewCoin("ukava", sdkmath.NewInt(100000000)),
-						},
-						RewardIndexes: []RewardIndex{
-							{
-								CollateralType: "bnb-a",
-								RewardFactor:   sdk.ZeroDec(),
-							},
-						},
-					},
+				SourceClaims: SourceClaims{
+					NewSourceClaim(
+						USDXMintingRewardSourceType,
+						"bnb-a",
+						sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser1"))),
+						sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100000000))),
+						RewardIndexes{NewRewardIndex("ukava", sdk.ZeroDec())},
+					),
 				},
 			},
 			errArgs: errArgs{
@@ -102,7 +100,6 @@ func TestGenesisState_Validate(t *testing.T) {
 						RewardIndexes:  normalRewardIndexes,
 					}},
 				},
-				USDXMintingClaims: DefaultUSDXClaims,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -114,19 +111,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			genesis: GenesisState{
 				Params:          DefaultParams(),
 				USDXRewardState: DefaultGenesisRewardState,
-				USDXMintingClaims: USDXMintingClaims{
-					{
-						BaseClaim: BaseClaim{
-							Owner:  nil, // invalid address
-							Reward: sdk.NewCoin("ukava", sdkmath.NewInt(100000000)),
-						},
-						RewardIndexes: []RewardIndex{
-							{
-								CollateralType: "bnb-a",
-								RewardFactor:   sdk.ZeroDec(),
-							},
-						},
-					},
+				SourceClaims: SourceClaims{
+					NewSourceClaim(
+						USDXMintingRewardSourceType,
+						"bnb-a",
+						nil, // invalid address
+						sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100000000))),
+						RewardIndexes{NewRewardIndex("ukava", sdk.ZeroDec())},
+					),
 				},
 			},
 			errArgs: errArgs{
@@ -134,6 +126,29 @@ func TestGenesisState_Validate(t *testing.T) {
 				contains:   "claim owner cannot be empty",
 			},
 		},
+		{
+			name: "campaign id not less than next campaign id",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				Campaigns: IncentiveCampaigns{
+					NewIncentiveCampaign(
+						2,
+						sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser1"))),
+						SwapRewardSourceType,
+						"busd:ukava",
+						sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1000))),
+						time.Date(2020, 10, 15, 14, 0, 0, 0, time.UTC),
+						time.Date(2020, 11, 15, 14, 0, 0, 0, time.UTC),
+						time.Date(2020, 10, 15, 14, 0, 0, 0, time.UTC),
+					),
+				},
+				NextCampaignID: 2,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "must be less than the next campaign id",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "incentive"
@@ -16,24 +23,55 @@ const (
 
 // Key Prefixes
 var (
-	USDXMintingClaimKeyPrefix                     = []byte{0x01} // prefix for keys that store USDX minting claims
-	USDXMintingRewardFactorKeyPrefix              = []byte{0x02} // prefix for key that stores USDX minting reward factors
-	PreviousUSDXMintingRewardAccrualTimeKeyPrefix = []byte{0x03} // prefix for key that stores the blocktime
-	HardLiquidityClaimKeyPrefix                   = []byte{0x04} // prefix for keys that store Hard liquidity claims
-	HardSupplyRewardIndexesKeyPrefix              = []byte{0x05} // prefix for key that stores Hard supply reward indexes
-	PreviousHardSupplyRewardAccrualTimeKeyPrefix  = []byte{0x06} // prefix for key that stores the previous time Hard supply rewards accrued
-	HardBorrowRewardIndexesKeyPrefix              = []byte{0x07} // prefix for key that stores Hard borrow reward indexes
-	PreviousHardBorrowRewardAccrualTimeKeyPrefix  = []byte{0x08} // prefix for key that stores the previous time Hard borrow rewards accrued
-	DelegatorClaimKeyPrefix                       = []byte{0x09} // prefix for keys that store delegator claims
-	DelegatorRewardIndexesKeyPrefix               = []byte{0x10} // prefix for key that stores delegator reward indexes
-	PreviousDelegatorRewardAccrualTimeKeyPrefix   = []byte{0x11} // prefix for key that stores the previous time delegator rewards accrued
-	SwapClaimKeyPrefix                            = []byte{0x12} // prefix for keys that store swap claims
-	SwapRewardIndexesKeyPrefix                    = []byte{0x13} // prefix for key that stores swap reward indexes
-	PreviousSwapRewardAccrualTimeKeyPrefix        = []byte{0x14} // prefix for key that stores the previous time swap rewards accrued
-	SavingsClaimKeyPrefix                         = []byte{0x15} // prefix for keys that store savings claims
-	SavingsRewardIndexesKeyPrefix                 = []byte{0x16} // prefix for key that stores savings reward indexes
-	PreviousSavingsRewardAccrualTimeKeyPrefix     = []byte{0x17} // prefix for key that stores the previous time savings rewards accrued
-	EarnClaimKeyPrefix                            = []byte{0x18} // prefix for keys that store earn claims
-	EarnRewardIndexesKeyPrefix                    = []byte{0x19} // prefix for key that stores earn reward indexes
-	PreviousEarnRewardAccrualTimeKeyPrefix        = []byte{0x20} // prefix for key that stores the previous time earn rewards accrued
+	AutoCompoundSettingKeyPrefix             = []byte{0x21} // prefix for keys that store auto-compound settings
+	AutoCompoundCursorKey                    = []byte{0x22} // key for the auto-compound setting to continue compounding from
+	SourceClaimKeyPrefix                     = []byte{0x23} // prefix for keys that store claims of all reward sources
+	SourceRewardIndexesKeyPrefix             = []byte{0x24} // prefix for keys that store reward indexes of all reward sources
+	PreviousSourceRewardAccrualTimeKeyPrefix = []byte{0x25} // prefix for keys that store the previous time rewards of a source accrued
+	BoostLockKeyPrefix                       = []byte{0x26} // prefix for keys that store boost locks
+	BoostLockByEndKeyPrefix                  = []byte{0x27} // prefix for keys of the boost lock end time index
+	BoostedSharesKeyPrefix                   = []byte{0x28} // prefix for keys that store the shares boosts add to owners in sources
+	TotalBoostedSharesKeyPrefix              = []byte{0x29} // prefix for keys that store the total shares boosts add to sources
+	TotalBoostWeightKey                      = []byte{0x30} // key for the total weight of all boost locks
+	CampaignKeyPrefix                        = []byte{0x31} // prefix for keys that store incentive campaigns
+	NextCampaignIDKey                        = []byte{0x32} // key for the id of the next incentive campaign
+	CampaignByStartKeyPrefix                 = []byte{0x33} // prefix for keys of the incentive campaign start time index
+
+	// Prefixes 0x01-0x20 stored the claims, reward indexes and accrual times of built in rewards before they were
+	// moved to the source stores.
 )
+
+// AutoCompoundSettingKey returns the key of an owner's auto-compound setting for a claim type
+func AutoCompoundSettingKey(owner sdk.AccAddress, claimType string) []byte {
+	return append(address.MustLengthPrefix(owner), []byte(claimType)...)
+}
+
+// SourceClaimKey returns the key of an owner's claim in a source of a source type
+func SourceClaimKey(sourceType string, owner sdk.AccAddress, sourceID string) []byte {
+	return append(SourceClaimOwnerKey(sourceType, owner), []byte(sourceID)...)
+}
+
+// SourceClaimOwnerKey returns the key prefix of all an owner's claims in sources of a source type
+func SourceClaimOwnerKey(sourceType string, owner sdk.AccAddress) []byte {
+	return append(SourceTypeKey(sourceType), address.MustLengthPrefix(owner)...)
+}
+
+// SourceRewardKey returns the key of the global reward state of a source of a source type
+func SourceRewardKey(sourceType string, sourceID string) []byte {
+	return append(SourceTypeKey(sourceType), []byte(sourceID)...)
+}
+
+// SourceTypeKey returns the key prefix of all state of a source type
+func SourceTypeKey(sourceType string) []byte {
+	return address.MustLengthPrefix([]byte(sourceType))
+}
+
+// BoostLockByEndKey returns the key of a boost lock in the end time index
+func BoostLockByEndKey(end time.Time, owner sdk.AccAddress) []byte {
+	return append(sdk.FormatTimeBytes(end), owner...)
+}
+
+// CampaignByStartKey returns the key of an incentive campaign in the start time index
+func CampaignByStartKey(start time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(start), sdk.Uint64ToBigEndian(id)...)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -17,6 +19,13 @@ var (
 	_ sdk.Msg = &MsgClaimSwapReward{}
 	_ sdk.Msg = &MsgClaimSavingsReward{}
 	_ sdk.Msg = &MsgClaimEarnReward{}
+	_ sdk.Msg = &MsgClaimAllRewards{}
+	_ sdk.Msg = &MsgSetAutoCompound{}
+	_ sdk.Msg = &MsgRemoveAutoCompound{}
+	_ sdk.Msg = &MsgLock{}
+	_ sdk.Msg = &MsgExtendLock{}
+	_ sdk.Msg = &MsgWithdrawLock{}
+	_ sdk.Msg = &MsgCreateIncentiveCampaign{}
 
 	_ legacytx.LegacyMsg = &MsgClaimUSDXMintingReward{}
 	_ legacytx.LegacyMsg = &MsgClaimHardReward{}
@@ -24,15 +33,29 @@ var (
 	_ legacytx.LegacyMsg = &MsgClaimSwapReward{}
 	_ legacytx.LegacyMsg = &MsgClaimSavingsReward{}
 	_ legacytx.LegacyMsg = &MsgClaimEarnReward{}
+	_ legacytx.LegacyMsg = &MsgClaimAllRewards{}
+	_ legacytx.LegacyMsg = &MsgSetAutoCompound{}
+	_ legacytx.LegacyMsg = &MsgRemoveAutoCompound{}
+	_ legacytx.LegacyMsg = &MsgLock{}
+	_ legacytx.LegacyMsg = &MsgExtendLock{}
+	_ legacytx.LegacyMsg = &MsgWithdrawLock{}
+	_ legacytx.LegacyMsg = &MsgCreateIncentiveCampaign{}
 )
 
 const (
-	TypeMsgClaimUSDXMintingReward = "claim_usdx_minting_reward"
-	TypeMsgClaimHardReward        = "claim_hard_reward"
-	TypeMsgClaimDelegatorReward   = "claim_delegator_reward"
-	TypeMsgClaimSwapReward        = "claim_swap_reward"
-	TypeMsgClaimSavingsReward     = "claim_savings_reward"
-	TypeMsgClaimEarnReward        = "claim_earn_reward"
+	TypeMsgClaimUSDXMintingReward  = "claim_usdx_minting_reward"
+	TypeMsgClaimHardReward         = "claim_hard_reward"
+	TypeMsgClaimDelegatorReward    = "claim_delegator_reward"
+	TypeMsgClaimSwapReward         = "claim_swap_reward"
+	TypeMsgClaimSavingsReward      = "claim_savings_reward"
+	TypeMsgClaimEarnReward         = "claim_earn_reward"
+	TypeMsgClaimAllRewards         = "claim_all_rewards"
+	TypeMsgSetAutoCompound         = "set_auto_compound"
+	TypeMsgRemoveAutoCompound      = "remove_auto_compound"
+	TypeMsgLock                    = "lock"
+	TypeMsgExtendLock              = "extend_lock"
+	TypeMsgWithdrawLock            = "withdraw_lock"
+	TypeMsgCreateIncentiveCampaign = "create_incentive_campaign"
 )
 
 // NewMsgClaimUSDXMintingReward returns a new MsgClaimUSDXMintingReward.
@@ -290,3 +313,316 @@ func (msg MsgClaimEarnReward) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgClaimAllRewards returns a new MsgClaimAllRewards.
+func NewMsgClaimAllRewards(sender, receiver string, denomsToClaim Selections) MsgClaimAllRewards {
+	return MsgClaimAllRewards{
+		Sender:        sender,
+		Receiver:      receiver,
+		DenomsToClaim: denomsToClaim,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgClaimAllRewards) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgClaimAllRewards) Type() string {
+	return TypeMsgClaimAllRewards
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgClaimAllRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be empty or invalid")
+	}
+	if err := msg.DenomsToClaim.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimAllRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimAllRewards) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound.
+func NewMsgSetAutoCompound(
+	sender string, claimType string, denomsToClaim Selections, target AutoCompoundTarget, validator string,
+) MsgSetAutoCompound {
+	return MsgSetAutoCompound{
+		Sender:        sender,
+		ClaimType:     claimType,
+		DenomsToClaim: denomsToClaim,
+		Target:        target,
+		Validator:     validator,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetAutoCompound) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetAutoCompound) Type() string {
+	return TypeMsgSetAutoCompound
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	return validateAutoCompound(msg.ClaimType, msg.DenomsToClaim, msg.Target, msg.Validator)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRemoveAutoCompound returns a new MsgRemoveAutoCompound.
+func NewMsgRemoveAutoCompound(sender string, claimType string) MsgRemoveAutoCompound {
+	return MsgRemoveAutoCompound{
+		Sender:    sender,
+		ClaimType: claimType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRemoveAutoCompound) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRemoveAutoCompound) Type() string {
+	return TypeMsgRemoveAutoCompound
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgRemoveAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if !IsAutoCompoundClaimType(msg.ClaimType) {
+		return errorsmod.Wrapf(ErrInvalidClaimType, "cannot auto-compound claim type '%s'", msg.ClaimType)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRemoveAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRemoveAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgLock returns a new MsgLock.
+func NewMsgLock(sender string, amount sdk.Coin, duration time.Duration) MsgLock {
+	return MsgLock{
+		Sender:   sender,
+		Amount:   amount,
+		Duration: duration,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLock) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLock) Type() string { return TypeMsgLock }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "lock amount must be positive: %s", msg.Amount)
+	}
+	if msg.Duration < 0 {
+		return errorsmod.Wrapf(ErrInvalidLockDuration, "duration cannot be negative: %s", msg.Duration)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLock) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgExtendLock returns a new MsgExtendLock.
+func NewMsgExtendLock(sender string, duration time.Duration) MsgExtendLock {
+	return MsgExtendLock{
+		Sender:   sender,
+		Duration: duration,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgExtendLock) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgExtendLock) Type() string { return TypeMsgExtendLock }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgExtendLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if msg.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidLockDuration, "duration must be positive: %s", msg.Duration)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgExtendLock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgExtendLock) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgWithdrawLock returns a new MsgWithdrawLock.
+func NewMsgWithdrawLock(sender string) MsgWithdrawLock {
+	return MsgWithdrawLock{
+		Sender: sender,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawLock) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawLock) Type() string { return TypeMsgWithdrawLock }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgWithdrawLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawLock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawLock) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgCreateIncentiveCampaign returns a new MsgCreateIncentiveCampaign.
+func NewMsgCreateIncentiveCampaign(sender, sourceType, sourceID string, amount sdk.Coins, start, end time.Time) MsgCreateIncentiveCampaign {
+	return MsgCreateIncentiveCampaign{
+		Sender:     sender,
+		SourceType: sourceType,
+		SourceID:   sourceID,
+		Amount:     amount,
+		Start:      start,
+		End:        end,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCreateIncentiveCampaign) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCreateIncentiveCampaign) Type() string { return TypeMsgCreateIncentiveCampaign }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgCreateIncentiveCampaign) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if err := ValidateCampaignSourceType(msg.SourceType); err != nil {
+		return errorsmod.Wrap(ErrInvalidCampaign, err.Error())
+	}
+	if msg.SourceID == "" {
+		return errorsmod.Wrap(ErrInvalidCampaign, "source id cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "campaign amount must be positive: %s", msg.Amount)
+	}
+	if msg.End.Sub(msg.Start) < time.Second {
+		return errorsmod.Wrapf(ErrInvalidCampaign, "end %s must be at least one second after start %s", msg.End, msg.Start)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCreateIncentiveCampaign) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCreateIncentiveCampaign) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -221,6 +222,338 @@ func TestMsgClaimUSDXMintingReward_Validate(t *testing.T) {
 	}
 }
 
+func TestMsgClaimAllRewards_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+	validSelections := types.Selections{types.NewSelection("hard", "large")}
+
+	tests := []struct {
+		name       string
+		sender     string
+		receiver   string
+		selections types.Selections
+		wraps      error
+	}{
+		{
+			name:       "valid",
+			sender:     validAddress,
+			receiver:   validAddress,
+			selections: validSelections,
+		},
+		{
+			name:       "invalid sender",
+			sender:     "",
+			receiver:   validAddress,
+			selections: validSelections,
+			wraps:      sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:       "invalid receiver",
+			sender:     validAddress,
+			receiver:   "",
+			selections: validSelections,
+			wraps:      sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:       "empty selections",
+			sender:     validAddress,
+			receiver:   validAddress,
+			selections: nil,
+			wraps:      types.ErrInvalidClaimDenoms,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgClaimAllRewards(tc.sender, tc.receiver, tc.selections)
+
+			err := msg.ValidateBasic()
+			if tc.wraps == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wraps)
+			}
+		})
+	}
+}
+
+func TestMsgSetAutoCompound_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+	validValidator := sdk.ValAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+	validSelections := types.Selections{types.NewSelection("ukava", "none")}
+
+	tests := []struct {
+		name       string
+		sender     string
+		claimType  string
+		selections types.Selections
+		target     types.AutoCompoundTarget
+		validator  string
+		wraps      error
+	}{
+		{
+			name:       "valid hard",
+			sender:     validAddress,
+			claimType:  types.SwapClaimType,
+			selections: validSelections,
+			target:     types.AUTO_COMPOUND_TARGET_HARD,
+		},
+		{
+			name:       "valid earn with validator",
+			sender:     validAddress,
+			claimType:  types.DelegatorClaimType,
+			selections: validSelections,
+			target:     types.AUTO_COMPOUND_TARGET_EARN,
+			validator:  validValidator,
+		},
+		{
+			name:       "invalid sender",
+			sender:     "",
+			claimType:  types.SwapClaimType,
+			selections: validSelections,
+			target:     types.AUTO_COMPOUND_TARGET_HARD,
+			wraps:      sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:       "invalid claim type",
+			sender:     validAddress,
+			claimType:  "savings",
+			selections: validSelections,
+			target:     types.AUTO_COMPOUND_TARGET_HARD,
+			wraps:      types.ErrInvalidClaimType,
+		},
+		{
+			name:       "empty selections",
+			sender:     validAddress,
+			claimType:  types.SwapClaimType,
+			selections: nil,
+			target:     types.AUTO_COMPOUND_TARGET_HARD,
+			wraps:      types.ErrInvalidClaimDenoms,
+		},
+		{
+			name:       "unspecified target",
+			sender:     validAddress,
+			claimType:  types.SwapClaimType,
+			selections: validSelections,
+			target:     types.AUTO_COMPOUND_TARGET_UNSPECIFIED,
+			wraps:      types.ErrInvalidAutoCompoundTarget,
+		},
+		{
+			name:       "validator with hard target",
+			sender:     validAddress,
+			claimType:  types.SwapClaimType,
+			selections: validSelections,
+			target:     types.AUTO_COMPOUND_TARGET_HARD,
+			validator:  validValidator,
+			wraps:      types.ErrInvalidAutoCompoundTarget,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSetAutoCompound(tc.sender, tc.claimType, tc.selections, tc.target, tc.validator)
+
+			err := msg.ValidateBasic()
+			if tc.wraps == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wraps)
+			}
+		})
+	}
+}
+
+func TestMsgLock_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+
+	tests := []struct {
+		name     string
+		sender   string
+		amount   sdk.Coin
+		duration time.Duration
+		wraps    error
+	}{
+		{
+			name:     "valid",
+			sender:   validAddress,
+			amount:   sdk.NewInt64Coin("ukava", 1000),
+			duration: time.Hour,
+		},
+		{
+			name:     "valid add to lock",
+			sender:   validAddress,
+			amount:   sdk.NewInt64Coin("ukava", 1000),
+			duration: 0,
+		},
+		{
+			name:     "invalid sender",
+			sender:   "",
+			amount:   sdk.NewInt64Coin("ukava", 1000),
+			duration: time.Hour,
+			wraps:    sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:     "zero amount",
+			sender:   validAddress,
+			amount:   sdk.NewInt64Coin("ukava", 0),
+			duration: time.Hour,
+			wraps:    sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:     "negative duration",
+			sender:   validAddress,
+			amount:   sdk.NewInt64Coin("ukava", 1000),
+			duration: -time.Hour,
+			wraps:    types.ErrInvalidLockDuration,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgLock(tc.sender, tc.amount, tc.duration)
+
+			err := msg.ValidateBasic()
+			if tc.wraps == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wraps)
+			}
+		})
+	}
+}
+
+func TestMsgExtendLock_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+
+	tests := []struct {
+		name     string
+		sender   string
+		duration time.Duration
+		wraps    error
+	}{
+		{
+			name:     "valid",
+			sender:   validAddress,
+			duration: time.Hour,
+		},
+		{
+			name:     "invalid sender",
+			sender:   "",
+			duration: time.Hour,
+			wraps:    sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:     "zero duration",
+			sender:   validAddress,
+			duration: 0,
+			wraps:    types.ErrInvalidLockDuration,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgExtendLock(tc.sender, tc.duration)
+
+			err := msg.ValidateBasic()
+			if tc.wraps == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wraps)
+			}
+		})
+	}
+}
+
+func TestMsgCreateIncentiveCampaign_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	validAmount := sdk.NewCoins(sdk.NewInt64Coin("hard", 1000))
+
+	tests := []struct {
+		name       string
+		sender     string
+		sourceType string
+		sourceID   string
+		amount     sdk.Coins
+		end        time.Time
+		wraps      error
+	}{
+		{
+			name:       "valid swap pool",
+			sender:     validAddress,
+			sourceType: types.SwapRewardSourceType,
+			sourceID:   "busd:ukava",
+			amount:     validAmount,
+			end:        start.Add(time.Hour),
+		},
+		{
+			name:       "valid registered source",
+			sender:     validAddress,
+			sourceType: "vault",
+			sourceID:   "pool-1",
+			amount:     validAmount,
+			end:        start.Add(time.Hour),
+		},
+		{
+			name:       "invalid sender",
+			sender:     "",
+			sourceType: types.SwapRewardSourceType,
+			sourceID:   "busd:ukava",
+			amount:     validAmount,
+			end:        start.Add(time.Hour),
+			wraps:      sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:       "reserved source type",
+			sender:     validAddress,
+			sourceType: types.HardSupplyRewardSourceType,
+			sourceID:   "bnb",
+			amount:     validAmount,
+			end:        start.Add(time.Hour),
+			wraps:      types.ErrInvalidCampaign,
+		},
+		{
+			name:       "empty source id",
+			sender:     validAddress,
+			sourceType: types.SwapRewardSourceType,
+			sourceID:   "",
+			amount:     validAmount,
+			end:        start.Add(time.Hour),
+			wraps:      types.ErrInvalidCampaign,
+		},
+		{
+			name:       "empty amount",
+			sender:     validAddress,
+			sourceType: types.SwapRewardSourceType,
+			sourceID:   "busd:ukava",
+			amount:     sdk.Coins{},
+			end:        start.Add(time.Hour),
+			wraps:      sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:       "end too soon after start",
+			sender:     validAddress,
+			sourceType: types.SwapRewardSourceType,
+			sourceID:   "busd:ukava",
+			amount:     validAmount,
+			end:        start.Add(time.Millisecond),
+			wraps:      types.ErrInvalidCampaign,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCreateIncentiveCampaign(tc.sender, tc.sourceType, tc.sourceID, tc.amount, start, tc.end)
+
+			err := msg.ValidateBasic()
+			if tc.wraps == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wraps)
+			}
+		})
+	}
+}
+
 func tooManySelections() types.Selections {
 	selections := make(types.Selections, types.MaxDenomsToClaim+1)
 	for i := range selections {
@@ -22,14 +22,18 @@ var (
 	KeySwapRewardPeriods        = []byte("SwapRewardPeriods")
 	KeySavingsRewardPeriods     = []byte("SavingsRewardPeriods")
 	KeyEarnRewardPeriods        = []byte("EarnRewardPeriods")
+	KeySourceRewardPeriods      = []byte("SourceRewardPeriods")
 	KeyClaimEnd                 = []byte("ClaimEnd")
 	KeyMultipliers              = []byte("ClaimMultipliers")
+	KeyBoostParams              = []byte("BoostParams")
+	KeyCampaignParams           = []byte("CampaignParams")
 
-	DefaultActive             = false
-	DefaultRewardPeriods      = RewardPeriods{}
-	DefaultMultiRewardPeriods = MultiRewardPeriods{}
-	DefaultMultipliers        = MultipliersPerDenoms{}
-	DefaultClaimEnd           = tmtime.Canonical(time.Unix(1, 0))
+	DefaultActive              = false
+	DefaultRewardPeriods       = RewardPeriods{}
+	DefaultMultiRewardPeriods  = MultiRewardPeriods{}
+	DefaultSourceRewardPeriods = TypedMultiRewardPeriods{}
+	DefaultMultipliers         = MultipliersPerDenoms{}
+	DefaultClaimEnd            = tmtime.Canonical(time.Unix(1, 0))
 
 	BondDenom              = "ukava"
 	USDXMintingRewardDenom = "ukava"
@@ -42,8 +46,11 @@ func NewParams(
 	usdxMinting RewardPeriods,
 	// MultiRewardPeriods
 	hardSupply, hardBorrow, delegator, swap, savings, earn MultiRewardPeriods,
+	sources TypedMultiRewardPeriods,
 	multipliers MultipliersPerDenoms,
 	claimEnd time.Time,
+	boost BoostParams,
+	campaign CampaignParams,
 ) Params {
 	return Params{
 		USDXMintingRewardPeriods: usdxMinting,
@@ -52,8 +59,11 @@ func NewParams(
 		DelegatorRewardPeriods:   delegator,
 		SwapRewardPeriods:        swap,
 		SavingsRewardPeriods:     savings,
+		SourceRewardPeriods:      sources,
 		ClaimMultipliers:         multipliers,
 		ClaimEnd:                 claimEnd,
+		BoostParams:              boost,
+		CampaignParams:           campaign,
 	}
 }
 
@@ -67,8 +77,11 @@ func DefaultParams() Params {
 		DefaultMultiRewardPeriods,
 		DefaultMultiRewardPeriods,
 		DefaultMultiRewardPeriods,
+		DefaultSourceRewardPeriods,
 		DefaultMultipliers,
 		DefaultClaimEnd,
+		DefaultBoostParams,
+		DefaultCampaignParams,
 	)
 }
 
@@ -87,8 +100,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySwapRewardPeriods, &p.SwapRewardPeriods, validateMultiRewardPeriodsParam),
 		paramtypes.NewParamSetPair(KeySavingsRewardPeriods, &p.SavingsRewardPeriods, validateMultiRewardPeriodsParam),
 		paramtypes.NewParamSetPair(KeyEarnRewardPeriods, &p.EarnRewardPeriods, validateMultiRewardPeriodsParam),
+		paramtypes.NewParamSetPair(KeySourceRewardPeriods, &p.SourceRewardPeriods, validateTypedMultiRewardPeriodsParam),
 		paramtypes.NewParamSetPair(KeyMultipliers, &p.ClaimMultipliers, validateMultipliersPerDenomParam),
 		paramtypes.NewParamSetPair(KeyClaimEnd, &p.ClaimEnd, validateClaimEndParam),
+		paramtypes.NewParamSetPair(KeyBoostParams, &p.BoostParams, validateBoostParamsParam),
+		paramtypes.NewParamSetPair(KeyCampaignParams, &p.CampaignParams, validateCampaignParamsParam),
 	}
 }
 
@@ -126,6 +142,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateTypedMultiRewardPeriodsParam(p.SourceRewardPeriods); err != nil {
+		return err
+	}
+
+	if err := validateBoostParamsParam(p.BoostParams); err != nil {
+		return err
+	}
+
+	if err := validateCampaignParamsParam(p.CampaignParams); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -147,6 +175,31 @@ func validateMultiRewardPeriodsParam(i interface{}) error {
 	return rewards.Validate()
 }
 
+func validateTypedMultiRewardPeriodsParam(i interface{}) error {
+	rewards, ok := i.(TypedMultiRewardPeriods)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return rewards.Validate()
+}
+
+func validateBoostParamsParam(i interface{}) error {
+	boost, ok := i.(BoostParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return boost.Validate()
+}
+
+func validateCampaignParamsParam(i interface{}) error {
+	campaign, ok := i.(CampaignParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return campaign.Validate()
+}
+
 func validateMultipliersPerDenomParam(i interface{}) error {
 	multipliers, ok := i.(MultipliersPerDenoms)
 	if !ok {
@@ -192,24 +192,66 @@ func (m *MultipliersPerDenom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MultipliersPerDenom proto.InternalMessageInfo
 
+// TypedMultiRewardPeriod stores the reward periods of a reward source type registered with the incentive module
+type TypedMultiRewardPeriod struct {
+	SourceType    string             `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	RewardPeriods MultiRewardPeriods `protobuf:"bytes,2,rep,name=reward_periods,json=rewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"reward_periods"`
+}
+
+func (m *TypedMultiRewardPeriod) Reset()         { *m = TypedMultiRewardPeriod{} }
+func (m *TypedMultiRewardPeriod) String() string { return proto.CompactTextString(m) }
+func (*TypedMultiRewardPeriod) ProtoMessage()    {}
+func (*TypedMultiRewardPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb8833f5d745eac9, []int{4}
+}
+func (m *TypedMultiRewardPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TypedMultiRewardPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TypedMultiRewardPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TypedMultiRewardPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypedMultiRewardPeriod.Merge(m, src)
+}
+func (m *TypedMultiRewardPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *TypedMultiRewardPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypedMultiRewardPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypedMultiRewardPeriod proto.InternalMessageInfo
+
 // Params
 type Params struct {
-	USDXMintingRewardPeriods RewardPeriods        `protobuf:"bytes,1,rep,name=usdx_minting_reward_periods,json=usdxMintingRewardPeriods,proto3,castrepeated=RewardPeriods" json:"usdx_minting_reward_periods"`
-	HardSupplyRewardPeriods  MultiRewardPeriods   `protobuf:"bytes,2,rep,name=hard_supply_reward_periods,json=hardSupplyRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_supply_reward_periods"`
-	HardBorrowRewardPeriods  MultiRewardPeriods   `protobuf:"bytes,3,rep,name=hard_borrow_reward_periods,json=hardBorrowRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_borrow_reward_periods"`
-	DelegatorRewardPeriods   MultiRewardPeriods   `protobuf:"bytes,4,rep,name=delegator_reward_periods,json=delegatorRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"delegator_reward_periods"`
-	SwapRewardPeriods        MultiRewardPeriods   `protobuf:"bytes,5,rep,name=swap_reward_periods,json=swapRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"swap_reward_periods"`
-	ClaimMultipliers         MultipliersPerDenoms `protobuf:"bytes,6,rep,name=claim_multipliers,json=claimMultipliers,proto3,castrepeated=MultipliersPerDenoms" json:"claim_multipliers"`
-	ClaimEnd                 time.Time            `protobuf:"bytes,7,opt,name=claim_end,json=claimEnd,proto3,stdtime" json:"claim_end"`
-	SavingsRewardPeriods     MultiRewardPeriods   `protobuf:"bytes,8,rep,name=savings_reward_periods,json=savingsRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"savings_reward_periods"`
-	EarnRewardPeriods        MultiRewardPeriods   `protobuf:"bytes,9,rep,name=earn_reward_periods,json=earnRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"earn_reward_periods"`
+	USDXMintingRewardPeriods RewardPeriods           `protobuf:"bytes,1,rep,name=usdx_minting_reward_periods,json=usdxMintingRewardPeriods,proto3,castrepeated=RewardPeriods" json:"usdx_minting_reward_periods"`
+	HardSupplyRewardPeriods  MultiRewardPeriods      `protobuf:"bytes,2,rep,name=hard_supply_reward_periods,json=hardSupplyRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_supply_reward_periods"`
+	HardBorrowRewardPeriods  MultiRewardPeriods      `protobuf:"bytes,3,rep,name=hard_borrow_reward_periods,json=hardBorrowRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_borrow_reward_periods"`
+	DelegatorRewardPeriods   MultiRewardPeriods      `protobuf:"bytes,4,rep,name=delegator_reward_periods,json=delegatorRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"delegator_reward_periods"`
+	SwapRewardPeriods        MultiRewardPeriods      `protobuf:"bytes,5,rep,name=swap_reward_periods,json=swapRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"swap_reward_periods"`
+	ClaimMultipliers         MultipliersPerDenoms    `protobuf:"bytes,6,rep,name=claim_multipliers,json=claimMultipliers,proto3,castrepeated=MultipliersPerDenoms" json:"claim_multipliers"`
+	ClaimEnd                 time.Time               `protobuf:"bytes,7,opt,name=claim_end,json=claimEnd,proto3,stdtime" json:"claim_end"`
+	SavingsRewardPeriods     MultiRewardPeriods      `protobuf:"bytes,8,rep,name=savings_reward_periods,json=savingsRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"savings_reward_periods"`
+	EarnRewardPeriods        MultiRewardPeriods      `protobuf:"bytes,9,rep,name=earn_reward_periods,json=earnRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"earn_reward_periods"`
+	SourceRewardPeriods      TypedMultiRewardPeriods `protobuf:"bytes,10,rep,name=source_reward_periods,json=sourceRewardPeriods,proto3,castrepeated=TypedMultiRewardPeriods" json:"source_reward_periods"`
+	BoostParams              BoostParams             `protobuf:"bytes,11,opt,name=boost_params,json=boostParams,proto3" json:"boost_params"`
+	CampaignParams           CampaignParams          `protobuf:"bytes,12,opt,name=campaign_params,json=campaignParams,proto3" json:"campaign_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{4}
+	return fileDescriptor_bb8833f5d745eac9, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,6 +285,7 @@ func init() {
 	proto.RegisterType((*MultiRewardPeriod)(nil), "kava.incentive.v1beta1.MultiRewardPeriod")
 	proto.RegisterType((*Multiplier)(nil), "kava.incentive.v1beta1.Multiplier")
 	proto.RegisterType((*MultipliersPerDenom)(nil), "kava.incentive.v1beta1.MultipliersPerDenom")
+	proto.RegisterType((*TypedMultiRewardPeriod)(nil), "kava.incentive.v1beta1.TypedMultiRewardPeriod")
 	proto.RegisterType((*Params)(nil), "kava.incentive.v1beta1.Params")
 }
 
@@ -251,56 +294,64 @@ func init() {
 }
 
 var fileDescriptor_bb8833f5d745eac9 = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xfc, 0xbb, 0xc9, 0xb4, 0xbd, 0xb7, 0x9d, 0x46, 0xb9, 0xbe, 0xb9, 0xc8, 0xa9,
-	0x52, 0x04, 0x41, 0x55, 0x6d, 0x0a, 0x12, 0x0b, 0x76, 0x98, 0x82, 0x84, 0x44, 0xa5, 0xca, 0x2d,
-	0x12, 0xb0, 0x89, 0x26, 0xf6, 0xd4, 0xb5, 0x6a, 0x7b, 0xac, 0x99, 0x49, 0xda, 0x88, 0x05, 0x12,
-	0x0b, 0x76, 0x48, 0x15, 0x0b, 0x1e, 0xa2, 0xaf, 0xc1, 0xa6, 0xcb, 0x8a, 0x15, 0x62, 0xd1, 0x42,
-	0xfa, 0x22, 0x68, 0xc6, 0x6e, 0xe3, 0xa4, 0x69, 0xa1, 0x52, 0x36, 0xac, 0x32, 0x3e, 0x73, 0xce,
-	0xf9, 0x7d, 0xfe, 0xc6, 0x67, 0x14, 0xb0, 0xb8, 0x83, 0xba, 0xc8, 0xf0, 0x42, 0x1b, 0x87, 0xdc,
-	0xeb, 0x62, 0xa3, 0xbb, 0xd2, 0xc6, 0x1c, 0xad, 0x18, 0x11, 0xa2, 0x28, 0x60, 0x7a, 0x44, 0x09,
-	0x27, 0xb0, 0x2a, 0x92, 0xf4, 0xf3, 0x24, 0x3d, 0x49, 0xaa, 0x69, 0x36, 0x61, 0x01, 0x61, 0x46,
-	0x1b, 0xb1, 0x41, 0xa5, 0x4d, 0xbc, 0x30, 0xae, 0xab, 0x55, 0x5c, 0xe2, 0x12, 0xb9, 0x34, 0xc4,
-	0x2a, 0x89, 0xd6, 0x5d, 0x42, 0x5c, 0x1f, 0x1b, 0xf2, 0xa9, 0xdd, 0xd9, 0x32, 0xb8, 0x17, 0x60,
-	0xc6, 0x51, 0x10, 0xc5, 0x09, 0x8d, 0x8f, 0x59, 0x30, 0x6d, 0xe1, 0x5d, 0x44, 0x9d, 0x75, 0x4c,
-	0x3d, 0xe2, 0xc0, 0x2a, 0x28, 0x22, 0x5b, 0x90, 0x55, 0x65, 0x41, 0x69, 0x96, 0xac, 0xe4, 0x09,
-	0xde, 0x06, 0xff, 0xd8, 0xc4, 0xf7, 0x11, 0xc7, 0x14, 0xf9, 0x2d, 0xde, 0x8b, 0xb0, 0x9a, 0x5d,
-	0x50, 0x9a, 0x65, 0xeb, 0xef, 0x41, 0x78, 0xb3, 0x17, 0x61, 0xf8, 0x10, 0x14, 0x18, 0x47, 0x94,
-	0xab, 0xb9, 0x05, 0xa5, 0x39, 0x75, 0xaf, 0xa6, 0xc7, 0x12, 0xf4, 0x33, 0x09, 0xfa, 0xe6, 0x99,
-	0x04, 0xb3, 0x74, 0x78, 0x5c, 0xcf, 0xec, 0x9f, 0xd4, 0x15, 0x2b, 0x2e, 0x81, 0x0f, 0x40, 0x0e,
-	0x87, 0x8e, 0x9a, 0xbf, 0x46, 0xa5, 0x28, 0x80, 0x6b, 0x00, 0x52, 0xf9, 0x12, 0xac, 0x15, 0x61,
-	0xda, 0x62, 0xd8, 0x26, 0xa1, 0xa3, 0x16, 0x64, 0x9b, 0xff, 0xf4, 0xd8, 0x39, 0x5d, 0x38, 0x77,
-	0x66, 0xa7, 0xfe, 0x98, 0x78, 0xa1, 0x99, 0x17, 0x5d, 0xac, 0xd9, 0xa4, 0x74, 0x1d, 0xd3, 0x0d,
-	0x59, 0xd8, 0xf8, 0x9c, 0x05, 0x73, 0x6b, 0x1d, 0x9f, 0x7b, 0x7f, 0xbe, 0x33, 0xbd, 0x4b, 0x9c,
-	0xc9, 0x5d, 0xed, 0xcc, 0x5d, 0xd1, 0xe5, 0xe0, 0xa4, 0xde, 0x74, 0x3d, 0xbe, 0xdd, 0x69, 0xeb,
-	0x36, 0x09, 0x8c, 0xe4, 0x03, 0x8c, 0x7f, 0x96, 0x99, 0xb3, 0x63, 0x88, 0x77, 0x65, 0xb2, 0x80,
-	0x8d, 0x71, 0xf1, 0x83, 0x02, 0x80, 0x74, 0x31, 0xf2, 0x3d, 0x4c, 0x21, 0x04, 0xf9, 0x10, 0x05,
-	0xb1, 0x79, 0x65, 0x4b, 0xae, 0xe1, 0x22, 0x98, 0x09, 0x48, 0xc8, 0xb7, 0x59, 0xcb, 0x27, 0xf6,
-	0x4e, 0x27, 0x92, 0xc6, 0xe5, 0xac, 0xe9, 0x38, 0xf8, 0x5c, 0xc6, 0xe0, 0x53, 0x50, 0xdc, 0x42,
-	0x36, 0x27, 0x54, 0xfa, 0x36, 0x6d, 0xea, 0x42, 0xdb, 0xb7, 0xe3, 0xfa, 0xad, 0xdf, 0xd0, 0xb6,
-	0x8a, 0x6d, 0x2b, 0xa9, 0x6e, 0xbc, 0x57, 0xc0, 0xfc, 0x40, 0x8f, 0x10, 0xba, 0x8a, 0x43, 0x12,
-	0xc0, 0x0a, 0x28, 0x38, 0x62, 0x91, 0x28, 0x8b, 0x1f, 0xe0, 0x2b, 0x30, 0x15, 0x0c, 0x92, 0xd5,
-	0xac, 0x74, 0xac, 0xa1, 0x8f, 0x9f, 0x4e, 0x7d, 0xd0, 0xd7, 0x9c, 0x4f, 0xac, 0x9b, 0x4a, 0xb1,
-	0xac, 0x74, 0xaf, 0xc6, 0x97, 0x12, 0x28, 0xae, 0xcb, 0x99, 0x87, 0x9f, 0x14, 0xf0, 0x7f, 0x87,
-	0x39, 0x7b, 0xad, 0xc0, 0x0b, 0xb9, 0x17, 0xba, 0xad, 0xd8, 0x45, 0x71, 0x56, 0x1e, 0x71, 0x98,
-	0xaa, 0x48, 0xec, 0xcd, 0xcb, 0xb0, 0xe9, 0xef, 0xd3, 0x5c, 0x11, 0xe0, 0xfe, 0x71, 0x5d, 0x7d,
-	0xb1, 0xb1, 0xfa, 0x72, 0x2d, 0xee, 0x97, 0x4e, 0x60, 0x07, 0x27, 0xf5, 0x99, 0xa1, 0x80, 0xa5,
-	0x0a, 0xf6, 0xb8, 0x54, 0xf8, 0x4e, 0x01, 0xb5, 0x6d, 0xa1, 0x84, 0x75, 0xa2, 0xc8, 0xef, 0x8d,
-	0xea, 0x8a, 0xed, 0xb8, 0x73, 0xa5, 0x1d, 0x43, 0xe2, 0x6a, 0x89, 0x2b, 0xf0, 0xc2, 0x16, 0xb3,
-	0xfe, 0x15, 0xa0, 0x0d, 0xc9, 0xb9, 0x44, 0x44, 0x9b, 0x50, 0x4a, 0x76, 0x47, 0x45, 0xe4, 0x26,
-	0x2e, 0xc2, 0x94, 0x9c, 0x61, 0x11, 0x6f, 0x81, 0xea, 0x60, 0x1f, 0xbb, 0x88, 0x13, 0x3a, 0xaa,
-	0x20, 0x3f, 0x49, 0x05, 0xd5, 0x73, 0xcc, 0xb0, 0x80, 0x0e, 0x98, 0x67, 0xbb, 0x28, 0x1a, 0x65,
-	0x17, 0x26, 0xc9, 0x9e, 0x13, 0x84, 0x61, 0x6c, 0x17, 0xcc, 0xd9, 0x3e, 0xf2, 0x82, 0x56, 0x7a,
-	0x0c, 0x8a, 0x12, 0xba, 0xf4, 0xeb, 0x31, 0x38, 0x1f, 0x2f, 0xf3, 0x46, 0x82, 0xad, 0x8c, 0xd9,
-	0x64, 0xd6, 0xac, 0x64, 0xa4, 0xb6, 0xe0, 0x23, 0x50, 0x8e, 0xb9, 0xe2, 0xbe, 0xfb, 0xeb, 0x1a,
-	0xf7, 0x5d, 0x49, 0x96, 0x3d, 0x09, 0x1d, 0xf8, 0x06, 0x54, 0x19, 0xea, 0x7a, 0xa1, 0xcb, 0x46,
-	0x4d, 0x2b, 0x4d, 0xd2, 0xb4, 0x4a, 0x02, 0xb9, 0x70, 0x5c, 0x18, 0xd1, 0x70, 0x94, 0x5c, 0x9e,
-	0xe8, 0x71, 0x09, 0xc2, 0x50, 0xc8, 0x7c, 0x76, 0xf8, 0x43, 0xcb, 0x1c, 0xf6, 0x35, 0xe5, 0xa8,
-	0xaf, 0x29, 0xdf, 0xfb, 0x9a, 0xb2, 0x7f, 0xaa, 0x65, 0x8e, 0x4e, 0xb5, 0xcc, 0xd7, 0x53, 0x2d,
-	0xf3, 0x7a, 0x29, 0x75, 0x57, 0x0a, 0x05, 0xcb, 0x3e, 0x6a, 0x33, 0xb9, 0x32, 0xf6, 0x52, 0xff,
-	0x48, 0xe4, 0xa5, 0xd9, 0x2e, 0x4a, 0x9b, 0xef, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xca, 0x77,
-	0xf8, 0x09, 0xb0, 0x08, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xc7, 0xe3, 0xa6, 0x0d, 0xed, 0x93, 0xb4, 0xbb, 0x9d, 0x96, 0xac, 0x09, 0x28, 0xae, 0x52,
+	0x58, 0x8a, 0x56, 0x6b, 0x53, 0x90, 0x38, 0x70, 0xc3, 0x5b, 0x90, 0x90, 0xb6, 0x52, 0xe5, 0xee,
+	0x4a, 0xc0, 0xc5, 0x1a, 0xdb, 0xb3, 0xae, 0x55, 0xdb, 0x63, 0xcd, 0x38, 0xe9, 0x56, 0x1c, 0x90,
+	0x40, 0xe2, 0x86, 0xb4, 0xe2, 0xc0, 0x57, 0x40, 0xda, 0xaf, 0xc1, 0xa5, 0xc7, 0x3d, 0x22, 0x0e,
+	0x2d, 0xb4, 0x9f, 0x03, 0x09, 0xcd, 0x4b, 0x9a, 0x38, 0x4d, 0x16, 0x56, 0x0a, 0x87, 0x3d, 0x65,
+	0x5e, 0x9e, 0xe7, 0xf9, 0xff, 0xe6, 0x3f, 0xe3, 0xc9, 0xc0, 0xf6, 0x31, 0x1e, 0x60, 0x27, 0xc9,
+	0x43, 0x92, 0x97, 0xc9, 0x80, 0x38, 0x83, 0xdd, 0x80, 0x94, 0x78, 0xd7, 0x29, 0x30, 0xc3, 0x19,
+	0xb7, 0x0b, 0x46, 0x4b, 0x8a, 0xda, 0x22, 0xc8, 0xbe, 0x0e, 0xb2, 0x75, 0x50, 0xa7, 0x1b, 0x52,
+	0x9e, 0x51, 0xee, 0x04, 0x98, 0x8f, 0x32, 0x43, 0x9a, 0xe4, 0x2a, 0xaf, 0xb3, 0x19, 0xd3, 0x98,
+	0xca, 0xa6, 0x23, 0x5a, 0x7a, 0xd4, 0x8a, 0x29, 0x8d, 0x53, 0xe2, 0xc8, 0x5e, 0xd0, 0x7f, 0xe2,
+	0x94, 0x49, 0x46, 0x78, 0x89, 0xb3, 0x42, 0x07, 0xf4, 0x66, 0x30, 0x05, 0x94, 0xf2, 0x52, 0xc7,
+	0xbc, 0x37, 0x23, 0x26, 0xc4, 0x59, 0x81, 0x93, 0x58, 0x13, 0xf4, 0x7e, 0x5e, 0x80, 0x96, 0x47,
+	0x4e, 0x30, 0x8b, 0x0e, 0x08, 0x4b, 0x68, 0x84, 0xda, 0xd0, 0xc0, 0xa1, 0xc8, 0x30, 0x8d, 0x2d,
+	0x63, 0x67, 0xd9, 0xd3, 0x3d, 0xf4, 0x3e, 0xdc, 0x0a, 0x69, 0x9a, 0xe2, 0x92, 0x30, 0x9c, 0xfa,
+	0xe5, 0x69, 0x41, 0xcc, 0x85, 0x2d, 0x63, 0x67, 0xc5, 0x5b, 0x1b, 0x0d, 0x3f, 0x3a, 0x2d, 0x08,
+	0xfa, 0x14, 0x96, 0x78, 0x89, 0x59, 0x69, 0xd6, 0xb7, 0x8c, 0x9d, 0xe6, 0x47, 0x1d, 0x5b, 0xad,
+	0xc6, 0x1e, 0xae, 0xc6, 0x7e, 0x34, 0x5c, 0x8d, 0xbb, 0x7c, 0x76, 0x6e, 0xd5, 0x9e, 0x5d, 0x58,
+	0x86, 0xa7, 0x52, 0xd0, 0x27, 0x50, 0x27, 0x79, 0x64, 0x2e, 0xbe, 0x42, 0xa6, 0x48, 0x40, 0xfb,
+	0x80, 0x98, 0x5c, 0x04, 0xf7, 0x0b, 0xc2, 0x7c, 0x4e, 0x42, 0x9a, 0x47, 0xe6, 0x92, 0x2c, 0xf3,
+	0x96, 0xad, 0x36, 0xc1, 0x16, 0x9b, 0x30, 0xdc, 0x19, 0xfb, 0x01, 0x4d, 0x72, 0x77, 0x51, 0x54,
+	0xf1, 0x6e, 0xeb, 0xd4, 0x03, 0xc2, 0x0e, 0x65, 0x62, 0xef, 0xb7, 0x05, 0x58, 0xdf, 0xef, 0xa7,
+	0x65, 0xf2, 0xfa, 0x3b, 0x73, 0x3a, 0xc3, 0x99, 0xfa, 0xcb, 0x9d, 0xf9, 0x50, 0x54, 0x79, 0x7e,
+	0x61, 0xed, 0xc4, 0x49, 0x79, 0xd4, 0x0f, 0xec, 0x90, 0x66, 0x8e, 0x3e, 0xcb, 0xea, 0xe7, 0x3e,
+	0x8f, 0x8e, 0x1d, 0xb1, 0x56, 0x2e, 0x13, 0xf8, 0x14, 0x17, 0x7f, 0x32, 0x00, 0xa4, 0x8b, 0x45,
+	0x9a, 0x10, 0x86, 0x10, 0x2c, 0xe6, 0x38, 0x53, 0xe6, 0xad, 0x78, 0xb2, 0x8d, 0xb6, 0x61, 0x35,
+	0xa3, 0x79, 0x79, 0xc4, 0xfd, 0x94, 0x86, 0xc7, 0xfd, 0x42, 0x1a, 0x57, 0xf7, 0x5a, 0x6a, 0xf0,
+	0xa1, 0x1c, 0x43, 0x5f, 0x40, 0xe3, 0x09, 0x0e, 0x4b, 0xca, 0xa4, 0x6f, 0x2d, 0xd7, 0x16, 0x6c,
+	0x7f, 0x9c, 0x5b, 0x77, 0xff, 0x03, 0xdb, 0x1e, 0x09, 0x3d, 0x9d, 0xdd, 0xfb, 0xd1, 0x80, 0x8d,
+	0x11, 0x8f, 0x00, 0xdd, 0x23, 0x39, 0xcd, 0xd0, 0x26, 0x2c, 0x45, 0xa2, 0xa1, 0xc9, 0x54, 0x07,
+	0x7d, 0x0d, 0xcd, 0x6c, 0x14, 0x6c, 0x2e, 0x48, 0xc7, 0x7a, 0xf6, 0xf4, 0x0f, 0xdd, 0x1e, 0xd5,
+	0x75, 0x37, 0xb4, 0x75, 0xcd, 0x31, 0x2d, 0x6f, 0xbc, 0x56, 0xef, 0x57, 0x03, 0xda, 0xe2, 0x40,
+	0x44, 0x37, 0xcf, 0x98, 0x05, 0x4d, 0x4e, 0xfb, 0x2c, 0x24, 0xea, 0x1c, 0x29, 0x22, 0x50, 0x43,
+	0xf2, 0x0c, 0xa5, 0xb0, 0xa6, 0x8c, 0x16, 0xdb, 0x99, 0xd0, 0x68, 0x48, 0xf6, 0xc1, 0x4b, 0xc9,
+	0xc6, 0x35, 0xdc, 0x8e, 0x06, 0x44, 0x37, 0xa6, 0xb8, 0xb7, 0xca, 0xc6, 0xbb, 0xbd, 0xbf, 0x01,
+	0x1a, 0x07, 0xf2, 0xa2, 0x43, 0xbf, 0x18, 0xf0, 0x76, 0x9f, 0x47, 0x4f, 0xfd, 0x2c, 0xc9, 0xcb,
+	0x24, 0x8f, 0xfd, 0x09, 0x0c, 0x43, 0x62, 0xbc, 0x3b, 0x0b, 0xa3, 0x42, 0xb0, 0x2b, 0x08, 0x2e,
+	0xcf, 0x2d, 0xf3, 0xf1, 0xe1, 0xde, 0x57, 0xfb, 0xaa, 0x5e, 0x85, 0xe3, 0xf9, 0x85, 0xb5, 0x5a,
+	0x05, 0x33, 0x85, 0xf6, 0xb4, 0x50, 0xf4, 0xbd, 0x01, 0x9d, 0x23, 0x41, 0xc2, 0xfb, 0x45, 0x91,
+	0x9e, 0xfa, 0xff, 0xa7, 0x3d, 0x77, 0x84, 0xd0, 0xa1, 0xd4, 0x99, 0x01, 0x11, 0x50, 0xc6, 0xe8,
+	0xc9, 0x24, 0x44, 0x7d, 0xee, 0x10, 0xae, 0xd4, 0xa9, 0x42, 0x7c, 0x07, 0x66, 0x44, 0x52, 0x12,
+	0xe3, 0x92, 0xb2, 0x49, 0x82, 0xc5, 0x79, 0x12, 0xb4, 0xaf, 0x65, 0xaa, 0x00, 0x7d, 0xd8, 0xe0,
+	0x27, 0xb8, 0x98, 0xd4, 0x5e, 0x9a, 0xa7, 0xf6, 0xba, 0x50, 0xa8, 0xca, 0x0e, 0x60, 0x3d, 0x4c,
+	0x71, 0x92, 0xf9, 0xe3, 0x1f, 0x6c, 0x43, 0x8a, 0xde, 0xfb, 0xf7, 0x0f, 0xf6, 0xfa, 0x22, 0x70,
+	0xdf, 0xd1, 0xb2, 0x9b, 0x53, 0x26, 0xb9, 0x77, 0x5b, 0x6a, 0x8c, 0x4d, 0xa1, 0xcf, 0x60, 0x45,
+	0xe9, 0x8a, 0x9b, 0xf9, 0x8d, 0x57, 0xb8, 0x99, 0x97, 0x65, 0xda, 0xe7, 0x79, 0x84, 0xbe, 0x85,
+	0x36, 0xc7, 0x83, 0x24, 0x8f, 0xf9, 0xa4, 0x69, 0xcb, 0xf3, 0x34, 0x6d, 0x53, 0x8b, 0xdc, 0xd8,
+	0x2e, 0x82, 0x59, 0x3e, 0xa9, 0xbc, 0x32, 0xd7, 0xed, 0x12, 0x0a, 0x55, 0xd9, 0x1f, 0x0c, 0x78,
+	0x53, 0x5f, 0x72, 0x13, 0xca, 0x20, 0x95, 0xed, 0x59, 0xca, 0xd3, 0xef, 0x4c, 0xd7, 0xd2, 0xf2,
+	0x77, 0xa6, 0xcf, 0x73, 0x6f, 0x43, 0xa9, 0x55, 0x29, 0x1e, 0x42, 0x4b, 0x3e, 0x97, 0x7c, 0xf5,
+	0x90, 0x33, 0x9b, 0x72, 0xff, 0xb6, 0x67, 0x69, 0xbb, 0x22, 0x56, 0x5d, 0x85, 0xfa, 0xd9, 0xd0,
+	0x0c, 0x46, 0x43, 0xe8, 0x31, 0xdc, 0x1a, 0x3e, 0xac, 0x86, 0x05, 0x5b, 0xb2, 0xe0, 0xdd, 0x59,
+	0x05, 0x1f, 0xe8, 0xf0, 0x4a, 0xcd, 0xb5, 0xb0, 0x3a, 0xfa, 0xe5, 0xd9, 0x5f, 0xdd, 0xda, 0xd9,
+	0x65, 0xd7, 0x78, 0x71, 0xd9, 0x35, 0xfe, 0xbc, 0xec, 0x1a, 0xcf, 0xae, 0xba, 0xb5, 0x17, 0x57,
+	0xdd, 0xda, 0xef, 0x57, 0xdd, 0xda, 0x37, 0xf7, 0xc6, 0xfe, 0x00, 0x85, 0xca, 0xfd, 0x14, 0x07,
+	0x5c, 0xb6, 0x9c, 0xa7, 0x63, 0x2f, 0x3f, 0xf9, 0x4f, 0x18, 0x34, 0xe4, 0x89, 0xfc, 0xf8, 0x9f,
+	0x01, 0x00, 0x19, 0x8c, 0x62, 0x73, 0xd0, 0x0a, 0x00, 0x00,
 }
 
 func (m *RewardPeriod) Marshal() (dAtA []byte, err error) {
@@ -528,6 +579,50 @@ func (m *MultipliersPerDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TypedMultiRewardPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TypedMultiRewardPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TypedMultiRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPeriods) > 0 {
+		for iNdEx := len(m.RewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SourceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -548,6 +643,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CampaignParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.BoostParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.SourceRewardPeriods) > 0 {
+		for iNdEx := len(m.SourceRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceRewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.EarnRewardPeriods) > 0 {
 		for iNdEx := len(m.EarnRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -576,12 +705,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x42
 		}
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClaimEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClaimEnd):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClaimEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClaimEnd):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.ClaimMultipliers) > 0 {
@@ -767,6 +896,25 @@ func (m *MultipliersPerDenom) Size() (n int) {
 	return n
 }
 
+func (m *TypedMultiRewardPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.RewardPeriods) > 0 {
+		for _, e := range m.RewardPeriods {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -823,6 +971,16 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.SourceRewardPeriods) > 0 {
+		for _, e := range m.SourceRewardPeriods {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.BoostParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CampaignParams.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1485,6 +1643,122 @@ func (m *MultipliersPerDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TypedMultiRewardPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypedMultiRewardPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypedMultiRewardPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriods = append(m.RewardPeriods, MultiRewardPeriod{})
+			if err := m.RewardPeriods[len(m.RewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1819,6 +2093,106 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRewardPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRewardPeriods = append(m.SourceRewardPeriods, TypedMultiRewardPeriod{})
+			if err := m.SourceRewardPeriods[len(m.SourceRewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CampaignParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -119,7 +119,9 @@ func (suite *ParamTestSuite) TestParamValidation() {
 						},
 					},
 				},
-				ClaimEnd: time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				ClaimEnd:       time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				BoostParams:    types.DefaultBoostParams,
+				CampaignParams: types.DefaultCampaignParams,
 			},
 			errArgs{
 				expectPass: true,
@@ -272,6 +274,89 @@ func (suite *ParamTestSuite) TestParamValidation() {
 				contains:   "reward amount cannot be zero: 0ukava",
 			},
 		},
+		{
+			"invalid boost factor below one",
+			types.NewParams(
+				types.DefaultRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultSourceRewardPeriods,
+				types.DefaultMultipliers,
+				time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				types.NewBoostParams(types.DefaultBoostMaxLockDuration, sdk.MustNewDecFromStr("0.5"), []string{}),
+				types.DefaultCampaignParams,
+			),
+			errArgs{
+				expectPass: false,
+				contains:   "boost max factor must be at least 1",
+			},
+		},
+		{
+			"valid boosted built in source types",
+			types.NewParams(
+				types.DefaultRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultSourceRewardPeriods,
+				types.DefaultMultipliers,
+				time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				types.NewBoostParams(types.DefaultBoostMaxLockDuration, types.DefaultBoostMaxFactor, []string{types.SwapRewardSourceType, types.HardSupplyRewardSourceType, types.DelegatorRewardSourceType}),
+				types.DefaultCampaignParams,
+			),
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid boosted source type",
+			types.NewParams(
+				types.DefaultRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultSourceRewardPeriods,
+				types.DefaultMultipliers,
+				time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				types.NewBoostParams(types.DefaultBoostMaxLockDuration, types.DefaultBoostMaxFactor, []string{types.HardLiquidityProviderClaimType}),
+				types.DefaultCampaignParams,
+			),
+			errArgs{
+				expectPass: false,
+				contains:   "is reserved",
+			},
+		},
+		{
+			"invalid campaign params",
+			types.NewParams(
+				types.DefaultRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultMultiRewardPeriods,
+				types.DefaultSourceRewardPeriods,
+				types.DefaultMultipliers,
+				time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				types.DefaultBoostParams,
+				types.NewCampaignParams(types.DefaultCampaignCreationFee, 0),
+			),
+			errArgs{
+				expectPass: false,
+				contains:   "campaign max duration must be positive",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -179,12 +181,14 @@ func (m *QueryRewardsRequest) GetUnsynchronized() bool {
 
 // QueryRewardsResponse is the response type for the Query/Rewards RPC method.
 type QueryRewardsResponse struct {
-	USDXMintingClaims           USDXMintingClaims           `protobuf:"bytes,1,rep,name=usdx_minting_claims,json=usdxMintingClaims,proto3,castrepeated=USDXMintingClaims" json:"usdx_minting_claims"`
-	HardLiquidityProviderClaims HardLiquidityProviderClaims `protobuf:"bytes,2,rep,name=hard_liquidity_provider_claims,json=hardLiquidityProviderClaims,proto3,castrepeated=HardLiquidityProviderClaims" json:"hard_liquidity_provider_claims"`
-	DelegatorClaims             DelegatorClaims             `protobuf:"bytes,3,rep,name=delegator_claims,json=delegatorClaims,proto3,castrepeated=DelegatorClaims" json:"delegator_claims"`
-	SwapClaims                  SwapClaims                  `protobuf:"bytes,4,rep,name=swap_claims,json=swapClaims,proto3,castrepeated=SwapClaims" json:"swap_claims"`
-	SavingsClaims               SavingsClaims               `protobuf:"bytes,5,rep,name=savings_claims,json=savingsClaims,proto3,castrepeated=SavingsClaims" json:"savings_claims"`
-	EarnClaims                  EarnClaims                  `protobuf:"bytes,6,rep,name=earn_claims,json=earnClaims,proto3,castrepeated=EarnClaims" json:"earn_claims"`
+	USDXMintingClaims           USDXMintingClaims           `protobuf:"bytes,1,rep,name=usdx_minting_claims,json=usdxMintingClaims,proto3,castrepeated=USDXMintingClaims" json:"usdx_minting_claims"`                                           // Deprecated: Do not use.
+	HardLiquidityProviderClaims HardLiquidityProviderClaims `protobuf:"bytes,2,rep,name=hard_liquidity_provider_claims,json=hardLiquidityProviderClaims,proto3,castrepeated=HardLiquidityProviderClaims" json:"hard_liquidity_provider_claims"` // Deprecated: Do not use.
+	DelegatorClaims             DelegatorClaims             `protobuf:"bytes,3,rep,name=delegator_claims,json=delegatorClaims,proto3,castrepeated=DelegatorClaims" json:"delegator_claims"`                                                     // Deprecated: Do not use.
+	SwapClaims                  SwapClaims                  `protobuf:"bytes,4,rep,name=swap_claims,json=swapClaims,proto3,castrepeated=SwapClaims" json:"swap_claims"`                                                                         // Deprecated: Do not use.
+	SavingsClaims               SavingsClaims               `protobuf:"bytes,5,rep,name=savings_claims,json=savingsClaims,proto3,castrepeated=SavingsClaims" json:"savings_claims"`                                                             // Deprecated: Do not use.
+	EarnClaims                  EarnClaims                  `protobuf:"bytes,6,rep,name=earn_claims,json=earnClaims,proto3,castrepeated=EarnClaims" json:"earn_claims"`                                                                         // Deprecated: Do not use.
+	// claims are the owner's claims in each source of the reward types queried.
+	Claims SourceClaims `protobuf:"bytes,7,rep,name=claims,proto3,castrepeated=SourceClaims" json:"claims"`
 }
 
 func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
@@ -220,6 +224,7 @@ func (m *QueryRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryRewardsResponse proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *QueryRewardsResponse) GetUSDXMintingClaims() USDXMintingClaims {
 	if m != nil {
 		return m.USDXMintingClaims
@@ -227,6 +232,7 @@ func (m *QueryRewardsResponse) GetUSDXMintingClaims() USDXMintingClaims {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryRewardsResponse) GetHardLiquidityProviderClaims() HardLiquidityProviderClaims {
 	if m != nil {
 		return m.HardLiquidityProviderClaims
@@ -234,6 +240,7 @@ func (m *QueryRewardsResponse) GetHardLiquidityProviderClaims() HardLiquidityPro
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryRewardsResponse) GetDelegatorClaims() DelegatorClaims {
 	if m != nil {
 		return m.DelegatorClaims
@@ -241,6 +248,7 @@ func (m *QueryRewardsResponse) GetDelegatorClaims() DelegatorClaims {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryRewardsResponse) GetSwapClaims() SwapClaims {
 	if m != nil {
 		return m.SwapClaims
@@ -248,6 +256,7 @@ func (m *QueryRewardsResponse) GetSwapClaims() SwapClaims {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryRewardsResponse) GetSavingsClaims() SavingsClaims {
 	if m != nil {
 		return m.SavingsClaims
@@ -255,6 +264,7 @@ func (m *QueryRewardsResponse) GetSavingsClaims() SavingsClaims {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryRewardsResponse) GetEarnClaims() EarnClaims {
 	if m != nil {
 		return m.EarnClaims
@@ -262,6 +272,13 @@ func (m *QueryRewardsResponse) GetEarnClaims() EarnClaims {
 	return nil
 }
 
+func (m *QueryRewardsResponse) GetClaims() SourceClaims {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
 // QueryRewardFactorsRequest is the request type for the Query/RewardFactors RPC method.
 type QueryRewardFactorsRequest struct {
 }
@@ -474,6 +491,389 @@ func (m *QueryApyResponse) GetEarn() []Apy {
 	return nil
 }
 
+// QueryAccountRewardRatesRequest is the request type for the Query/AccountRewardRates RPC method.
+type QueryAccountRewardRatesRequest struct {
+	// owner is the address of the user to query reward rates for.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryAccountRewardRatesRequest) Reset()         { *m = QueryAccountRewardRatesRequest{} }
+func (m *QueryAccountRewardRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRewardRatesRequest) ProtoMessage()    {}
+func (*QueryAccountRewardRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d71d0cbe5e95a, []int{8}
+}
+func (m *QueryAccountRewardRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRewardRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRewardRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountRewardRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRewardRatesRequest.Merge(m, src)
+}
+func (m *QueryAccountRewardRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRewardRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRewardRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountRewardRatesRequest proto.InternalMessageInfo
+
+func (m *QueryAccountRewardRatesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryAccountRewardRatesResponse is the response type for the Query/AccountRewardRates RPC method.
+type QueryAccountRewardRatesResponse struct {
+	RewardRates AccountRewardRates `protobuf:"bytes,1,rep,name=reward_rates,json=rewardRates,proto3,castrepeated=AccountRewardRates" json:"reward_rates"`
+}
+
+func (m *QueryAccountRewardRatesResponse) Reset()         { *m = QueryAccountRewardRatesResponse{} }
+func (m *QueryAccountRewardRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRewardRatesResponse) ProtoMessage()    {}
+func (*QueryAccountRewardRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d71d0cbe5e95a, []int{9}
+}
+func (m *QueryAccountRewardRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRewardRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRewardRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountRewardRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRewardRatesResponse.Merge(m, src)
+}
+func (m *QueryAccountRewardRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRewardRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRewardRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountRewardRatesResponse proto.InternalMessageInfo
+
+func (m *QueryAccountRewardRatesResponse) GetRewardRates() AccountRewardRates {
+	if m != nil {
+		return m.RewardRates
+	}
+	return nil
+}
+
+// QueryBoostLockRequest is the request type for the Query/BoostLock RPC method.
+type QueryBoostLockRequest struct {
+	// owner is the address of the user to query the boost lock of.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryBoostLockRequest) Reset()         { *m = QueryBoostLockRequest{} }
+func (m *QueryBoostLockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBoostLockRequest) ProtoMessage()    {}
+func (*QueryBoostLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d71d0cbe5e95a, []int{10}
+}
+func (m *QueryBoostLockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoostLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoostLockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoostLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoostLockRequest.Merge(m, src)
+}
+func (m *QueryBoostLockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoostLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoostLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoostLockRequest proto.InternalMessageInfo
+
+func (m *QueryBoostLockRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryBoostLockResponse is the response type for the Query/BoostLock RPC method.
+type QueryBoostLockResponse struct {
+	// lock is the owner's boost lock. It is nil if the owner has no lock.
+	Lock          *BoostLock        `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
+	BoostedShares BoostedSharesList `protobuf:"bytes,2,rep,name=boosted_shares,json=boostedShares,proto3,castrepeated=BoostedSharesList" json:"boosted_shares"`
+	// total_weight is the sum of the weights of all boost locks.
+	TotalWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_weight"`
+}
+
+func (m *QueryBoostLockResponse) Reset()         { *m = QueryBoostLockResponse{} }
+func (m *QueryBoostLockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBoostLockResponse) ProtoMessage()    {}
+func (*QueryBoostLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d71d0cbe5e95a, []int{11}
+}
+func (m *QueryBoostLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoostLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoostLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoostLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoostLockResponse.Merge(m, src)
+}
+func (m *QueryBoostLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoostLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoostLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoostLockResponse proto.InternalMessageInfo
+
+func (m *QueryBoostLockResponse) GetLock() *BoostLock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+func (m *QueryBoostLockResponse) GetBoostedShares() BoostedSharesList {
+	if m != nil {
+		return m.BoostedShares
+	}
+	return nil
+}
+
+// QueryCampaignsRequest is the request type for the Query/Campaigns RPC method.
+type QueryCampaignsRequest struct {
+	// source_type optionally filters campaigns by the source type they pay rewards to.
+	SourceType string `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// source_id optionally filters campaigns by the source they pay rewards to.
+	SourceID string `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+}
+
+func (m *QueryCampaignsRequest) Reset()         { *m = QueryCampaignsRequest{} }
+func (m *QueryCampaignsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsRequest) ProtoMessage()    {}
+func (*QueryCampaignsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d71d0cbe5e95a, []int{12}
+}
+func (m *QueryCampaignsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsRequest.Merge(m, src)
+}
+func (m *QueryCampaignsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignsRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignsRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *QueryCampaignsRequest) GetSourceID() string {
+	if m != nil {
+		return m.SourceID
+	}
+	return ""
+}
+
+// QueryCampaignsResponse is the response type for the Query/Campaigns RPC method.
+type QueryCampaignsResponse struct {
+	Campaigns IncentiveCampaigns `protobuf:"bytes,1,rep,name=campaigns,proto3,castrepeated=IncentiveCampaigns" json:"campaigns"`
+}
+
+func (m *QueryCampaignsResponse) Reset()         { *m = QueryCampaignsResponse{} }
+func (m *QueryCampaignsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsResponse) ProtoMessage()    {}
+func (*QueryCampaignsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d71d0cbe5e95a, []int{13}
+}
+func (m *QueryCampaignsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsResponse.Merge(m, src)
+}
+func (m *QueryCampaignsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignsResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignsResponse) GetCampaigns() IncentiveCampaigns {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+// QueryCampaignRequest is the request type for the Query/Campaign RPC method.
+type QueryCampaignRequest struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *QueryCampaignRequest) Reset()         { *m = QueryCampaignRequest{} }
+func (m *QueryCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignRequest) ProtoMessage()    {}
+func (*QueryCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d71d0cbe5e95a, []int{14}
+}
+func (m *QueryCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignRequest.Merge(m, src)
+}
+func (m *QueryCampaignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// QueryCampaignResponse is the response type for the Query/Campaign RPC method.
+type QueryCampaignResponse struct {
+	Campaign IncentiveCampaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign"`
+}
+
+func (m *QueryCampaignResponse) Reset()         { *m = QueryCampaignResponse{} }
+func (m *QueryCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignResponse) ProtoMessage()    {}
+func (*QueryCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d71d0cbe5e95a, []int{15}
+}
+func (m *QueryCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignResponse.Merge(m, src)
+}
+func (m *QueryCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignResponse) GetCampaign() IncentiveCampaign {
+	if m != nil {
+		return m.Campaign
+	}
+	return IncentiveCampaign{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.incentive.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.incentive.v1beta1.QueryParamsResponse")
@@ -483,6 +883,14 @@ func init() {
 	proto.RegisterType((*QueryRewardFactorsResponse)(nil), "kava.incentive.v1beta1.QueryRewardFactorsResponse")
 	proto.RegisterType((*QueryApyRequest)(nil), "kava.incentive.v1beta1.QueryApyRequest")
 	proto.RegisterType((*QueryApyResponse)(nil), "kava.incentive.v1beta1.QueryApyResponse")
+	proto.RegisterType((*QueryAccountRewardRatesRequest)(nil), "kava.incentive.v1beta1.QueryAccountRewardRatesRequest")
+	proto.RegisterType((*QueryAccountRewardRatesResponse)(nil), "kava.incentive.v1beta1.QueryAccountRewardRatesResponse")
+	proto.RegisterType((*QueryBoostLockRequest)(nil), "kava.incentive.v1beta1.QueryBoostLockRequest")
+	proto.RegisterType((*QueryBoostLockResponse)(nil), "kava.incentive.v1beta1.QueryBoostLockResponse")
+	proto.RegisterType((*QueryCampaignsRequest)(nil), "kava.incentive.v1beta1.QueryCampaignsRequest")
+	proto.RegisterType((*QueryCampaignsResponse)(nil), "kava.incentive.v1beta1.QueryCampaignsResponse")
+	proto.RegisterType((*QueryCampaignRequest)(nil), "kava.incentive.v1beta1.QueryCampaignRequest")
+	proto.RegisterType((*QueryCampaignResponse)(nil), "kava.incentive.v1beta1.QueryCampaignResponse")
 }
 
 func init() {
@@ -490,64 +898,94 @@ func init() {
 }
 
 var fileDescriptor_a78d71d0cbe5e95a = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xeb, 0xe6, 0x47, 0xe1, 0x55, 0xdd, 0x6e, 0xa7, 0xa1, 0x1b, 0x1c, 0x70, 0xb2, 0x29,
-	0xea, 0x46, 0x2c, 0xc4, 0x6a, 0x10, 0x37, 0x2e, 0x2d, 0xbb, 0x88, 0x95, 0x58, 0x69, 0x71, 0x01,
-	0x21, 0x2e, 0xd5, 0x24, 0x1e, 0x5c, 0x43, 0x3a, 0xe3, 0xce, 0xd8, 0x49, 0xbd, 0x12, 0x48, 0x70,
-	0x01, 0x0e, 0x48, 0x48, 0x5c, 0x39, 0x73, 0xd8, 0xbf, 0x82, 0xe3, 0x4a, 0x5c, 0x56, 0xe2, 0xc2,
-	0x69, 0x17, 0xb5, 0xfc, 0x21, 0xc8, 0x33, 0x63, 0x37, 0xf6, 0xd6, 0xd9, 0x22, 0xe5, 0xe6, 0xbc,
-	0xf9, 0xbe, 0xf7, 0xfd, 0x3c, 0xeb, 0xbd, 0x8c, 0xa1, 0xfb, 0x35, 0x9e, 0x60, 0xdb, 0xa7, 0x23,
-	0x42, 0x43, 0x7f, 0x42, 0xec, 0xc9, 0xee, 0x90, 0x84, 0x78, 0xd7, 0x3e, 0x89, 0x08, 0x8f, 0xfb,
-	0x01, 0x67, 0x21, 0x43, 0x5b, 0x89, 0xa6, 0x9f, 0x69, 0xfa, 0x5a, 0x63, 0x36, 0x3c, 0xe6, 0x31,
-	0x29, 0xb1, 0x93, 0x27, 0xa5, 0x36, 0x5f, 0xf3, 0x18, 0xf3, 0xc6, 0xc4, 0xc6, 0x81, 0x6f, 0x63,
-	0x4a, 0x59, 0x88, 0x43, 0x9f, 0x51, 0xa1, 0x4f, 0x3b, 0x25, 0x7e, 0x38, 0xd0, 0x6e, 0xe6, 0x76,
-	0x89, 0x62, 0x34, 0xc6, 0xfe, 0xb1, 0x78, 0x81, 0x28, 0xc0, 0x1c, 0xa7, 0xa2, 0x6e, 0x03, 0xd0,
-	0xc7, 0x49, 0x1b, 0x0f, 0x64, 0xd0, 0x21, 0x27, 0x11, 0x11, 0x61, 0xf7, 0x00, 0x36, 0x73, 0x51,
-	0x11, 0x30, 0x2a, 0x08, 0x7a, 0x0f, 0xea, 0x2a, 0xb9, 0x69, 0x74, 0x8c, 0xde, 0xea, 0xc0, 0xea,
-	0x5f, 0xde, 0x75, 0x5f, 0xe5, 0xed, 0x57, 0x1f, 0x3f, 0x6d, 0x2f, 0x39, 0x3a, 0xa7, 0x1b, 0xea,
-	0xa2, 0x0e, 0x99, 0x62, 0xee, 0xa6, 0x5e, 0xa8, 0x01, 0x35, 0x36, 0xa5, 0x84, 0xcb, 0x9a, 0x2f,
-	0x3b, 0xea, 0x07, 0x6a, 0xc3, 0x2a, 0x97, 0xba, 0xc3, 0x30, 0x0e, 0x48, 0x73, 0x59, 0x9e, 0x81,
-	0x0a, 0x7d, 0x12, 0x07, 0x04, 0xed, 0xc0, 0xb5, 0x88, 0x8a, 0x98, 0x8e, 0x8e, 0x38, 0xa3, 0xfe,
-	0x43, 0xe2, 0x36, 0x2b, 0x1d, 0xa3, 0xf7, 0x92, 0x53, 0x88, 0x76, 0xff, 0xa8, 0x41, 0x23, 0x6f,
-	0xab, 0x9b, 0xf9, 0xd1, 0x80, 0xcd, 0x48, 0xb8, 0xa7, 0x87, 0xc7, 0x3e, 0x0d, 0x7d, 0xea, 0x1d,
-	0xaa, 0x97, 0xd7, 0x34, 0x3a, 0x95, 0xde, 0xea, 0xa0, 0x57, 0xd6, 0xda, 0xa7, 0x07, 0x77, 0x3e,
-	0xbf, 0xaf, 0x32, 0xde, 0x4f, 0x12, 0xf6, 0xfb, 0x49, 0x93, 0x67, 0x4f, 0xdb, 0x1b, 0xc5, 0x13,
-	0xf1, 0xe8, 0xd9, 0x25, 0x41, 0x67, 0x23, 0x31, 0xcd, 0x85, 0xd0, 0x6f, 0x06, 0x58, 0x47, 0x49,
-	0xaf, 0x63, 0xff, 0x24, 0xf2, 0x5d, 0x3f, 0x8c, 0x0f, 0x03, 0xce, 0x26, 0xbe, 0x4b, 0x78, 0x4a,
-	0xb5, 0x2c, 0xa9, 0x06, 0x65, 0x54, 0x1f, 0x62, 0xee, 0x7e, 0x94, 0x26, 0x3f, 0xd0, 0xb9, 0x8a,
-	0x6f, 0x3b, 0xe1, 0x7b, 0xf4, 0xac, 0xdd, 0x2a, 0xd7, 0x08, 0xa7, 0x75, 0x54, 0x7e, 0x88, 0xbe,
-	0x82, 0xeb, 0x2e, 0x19, 0x13, 0x0f, 0x87, 0x2c, 0xe3, 0xa9, 0x48, 0x9e, 0x9d, 0x32, 0x9e, 0x3b,
-	0xa9, 0x5e, 0x31, 0xdc, 0xd0, 0x0c, 0xeb, 0xf9, 0xb8, 0x70, 0xd6, 0xdd, 0x7c, 0x00, 0x7d, 0x06,
-	0xab, 0x62, 0x8a, 0x83, 0xd4, 0xa6, 0x2a, 0x6d, 0x6e, 0x96, 0xd9, 0x1c, 0x4c, 0x71, 0xa0, 0x1c,
-	0x90, 0x76, 0x80, 0x2c, 0x24, 0x1c, 0x10, 0xd9, 0x33, 0x1a, 0xc2, 0x35, 0x81, 0x27, 0x3e, 0xf5,
-	0x44, 0x5a, 0xba, 0x26, 0x4b, 0xbf, 0x51, 0x5a, 0x5a, 0xa9, 0x55, 0xf5, 0x57, 0x74, 0xf5, 0xb5,
-	0xd9, 0xa8, 0x70, 0xd6, 0xc4, 0xec, 0xcf, 0x84, 0x9d, 0x60, 0x4e, 0x53, 0x83, 0xfa, 0x7c, 0xf6,
-	0xbb, 0x98, 0xd3, 0x02, 0x7b, 0x16, 0x12, 0x0e, 0x90, 0xec, 0xb9, 0xdb, 0x82, 0x57, 0x67, 0x26,
-	0xf8, 0x03, 0x3c, 0x0a, 0x19, 0xcf, 0x56, 0xf5, 0x87, 0x15, 0x30, 0x2f, 0x3b, 0xd5, 0x53, 0x1e,
-	0x43, 0x2b, 0x37, 0xe4, 0x7a, 0xa9, 0xbe, 0x54, 0x32, 0x3d, 0xec, 0xdb, 0x65, 0x8c, 0xaa, 0xe6,
-	0x3d, 0xea, 0x92, 0xd3, 0x8b, 0x77, 0x30, 0x13, 0x24, 0xc2, 0x69, 0xce, 0x8c, 0x73, 0x0e, 0x01,
-	0x7d, 0x67, 0x80, 0x29, 0xa7, 0x5a, 0x44, 0x41, 0x30, 0x8e, 0x8b, 0xd6, 0xcb, 0xf3, 0xf7, 0xec,
-	0x7e, 0x34, 0x0e, 0xfd, 0x59, 0x7f, 0x53, 0xfb, 0xa3, 0xe2, 0x09, 0x11, 0xce, 0x8d, 0xc4, 0xe7,
-	0x40, 0xda, 0x94, 0x30, 0x0c, 0x19, 0xe7, 0x6c, 0x5a, 0x64, 0xa8, 0x2c, 0x9a, 0x61, 0x5f, 0xda,
-	0xe4, 0x19, 0xbe, 0x85, 0xe6, 0xc5, 0xfa, 0x14, 0x00, 0xaa, 0x0b, 0x04, 0xd8, 0xca, 0x5c, 0xf2,
-	0xfe, 0x21, 0x6c, 0xca, 0x95, 0x2a, 0x58, 0xd7, 0x16, 0x68, 0xbd, 0x91, 0x18, 0xe4, 0x5d, 0x1f,
-	0xc2, 0x56, 0xba, 0x70, 0x05, 0xe3, 0xfa, 0x02, 0x8d, 0x1b, 0xda, 0xe3, 0xb9, 0x8e, 0xe5, 0x22,
-	0x16, 0x8c, 0x57, 0x16, 0xd9, 0x71, 0x62, 0x90, 0x73, 0xed, 0x6e, 0xc0, 0xba, 0x5c, 0xc4, 0xbd,
-	0x20, 0x4e, 0x97, 0xf3, 0x1e, 0x5c, 0xbf, 0x08, 0xe9, 0x8d, 0x7c, 0x17, 0xaa, 0x49, 0xae, 0x5e,
-	0xbd, 0x56, 0x19, 0xcd, 0x5e, 0x10, 0xeb, 0xfb, 0x53, 0xca, 0x07, 0x7f, 0x56, 0xa1, 0x26, 0x6b,
-	0xa1, 0x9f, 0x0c, 0xa8, 0xab, 0x0b, 0x16, 0xbd, 0x59, 0x96, 0xfd, 0xfc, 0x9d, 0x6e, 0xde, 0xbe,
-	0x92, 0x56, 0x41, 0x76, 0x77, 0xbe, 0xff, 0xeb, 0xdf, 0x5f, 0x97, 0x3b, 0xc8, 0xb2, 0xe7, 0x7e,
-	0x44, 0xa0, 0x9f, 0x0d, 0x58, 0xd1, 0x17, 0x2b, 0x9a, 0x6f, 0x90, 0xbf, 0xf5, 0xcd, 0xb7, 0xae,
-	0x26, 0xd6, 0x38, 0xb7, 0x24, 0xce, 0x4d, 0xd4, 0x2e, 0xc3, 0xe1, 0x9a, 0xe1, 0x77, 0x03, 0xd6,
-	0xf2, 0xb3, 0xb0, 0x7b, 0x05, 0xa3, 0xfc, 0x5f, 0xaa, 0x39, 0xf8, 0x3f, 0x29, 0x9a, 0xb0, 0x2f,
-	0x09, 0x7b, 0x68, 0x67, 0x3e, 0x61, 0x3a, 0x8b, 0xe8, 0x1b, 0xa8, 0xec, 0x05, 0x31, 0xba, 0x35,
-	0xd7, 0xea, 0x62, 0x92, 0xcc, 0xde, 0x8b, 0x85, 0x9a, 0x64, 0x5b, 0x92, 0xbc, 0x8e, 0x5a, 0x76,
-	0xf9, 0x67, 0xe4, 0xfe, 0xdd, 0xc7, 0x67, 0x96, 0xf1, 0xe4, 0xcc, 0x32, 0xfe, 0x39, 0xb3, 0x8c,
-	0x5f, 0xce, 0xad, 0xa5, 0x27, 0xe7, 0xd6, 0xd2, 0xdf, 0xe7, 0xd6, 0xd2, 0x17, 0xb7, 0x3d, 0x3f,
-	0x3c, 0x8a, 0x86, 0xfd, 0x11, 0x3b, 0x96, 0x05, 0xde, 0x1e, 0xe3, 0xa1, 0x50, 0xa5, 0x4e, 0x67,
-	0x8a, 0x25, 0x5f, 0x65, 0x62, 0x58, 0x97, 0x1f, 0x91, 0xef, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x8e, 0xce, 0x8c, 0x23, 0x22, 0x0b, 0x00, 0x00,
+	// 1378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4d, 0x6f, 0xdc, 0x54,
+	0x17, 0xc7, 0xe3, 0xbc, 0x4c, 0x32, 0x67, 0x92, 0xb6, 0xb9, 0x4d, 0xd3, 0xa9, 0xf3, 0x3c, 0x33,
+	0xa9, 0x43, 0xd3, 0xf4, 0x25, 0x63, 0x12, 0xa0, 0xdd, 0x74, 0xd3, 0x69, 0x8a, 0x88, 0x68, 0xa5,
+	0xd6, 0x01, 0x51, 0xb1, 0x19, 0x79, 0xec, 0xcb, 0x8c, 0x95, 0x89, 0xaf, 0xeb, 0xeb, 0x49, 0x3a,
+	0xad, 0x82, 0x04, 0x1b, 0x04, 0x08, 0x09, 0x09, 0xb1, 0x60, 0x03, 0xac, 0x58, 0x74, 0xcd, 0x37,
+	0x60, 0xd3, 0x65, 0x05, 0x1b, 0xc4, 0x22, 0x45, 0x29, 0x5b, 0xbe, 0x03, 0xba, 0x2f, 0xf6, 0xd8,
+	0x6e, 0xec, 0x99, 0x4a, 0x59, 0xb5, 0x3e, 0xfe, 0x9f, 0xf3, 0xff, 0xdd, 0x2b, 0x9f, 0x7b, 0xcf,
+	0x04, 0xb4, 0x6d, 0x73, 0xd7, 0xd4, 0x1d, 0xd7, 0xc2, 0x6e, 0xe0, 0xec, 0x62, 0x7d, 0x77, 0xad,
+	0x89, 0x03, 0x73, 0x4d, 0x7f, 0xd8, 0xc5, 0x7e, 0xaf, 0xe6, 0xf9, 0x24, 0x20, 0x68, 0x9e, 0x69,
+	0x6a, 0x91, 0xa6, 0x26, 0x35, 0xea, 0x39, 0x8b, 0xd0, 0x1d, 0x42, 0x1b, 0x5c, 0xa5, 0x8b, 0x07,
+	0x91, 0xa2, 0xce, 0xb5, 0x48, 0x8b, 0x88, 0x38, 0xfb, 0x9f, 0x8c, 0xfe, 0xaf, 0x45, 0x48, 0xab,
+	0x83, 0x75, 0xd3, 0x73, 0x74, 0xd3, 0x75, 0x49, 0x60, 0x06, 0x0e, 0x71, 0xc3, 0x9c, 0xc5, 0x0c,
+	0x14, 0xd3, 0x93, 0x20, 0x6a, 0x16, 0x6c, 0x93, 0x10, 0x1a, 0x48, 0xcd, 0x85, 0x0c, 0x8d, 0x65,
+	0xee, 0x78, 0xa6, 0xd3, 0x72, 0xa5, 0x6c, 0x29, 0x4b, 0xd6, 0x31, 0x9d, 0x1d, 0x3a, 0x40, 0xe4,
+	0x99, 0xbe, 0x19, 0x8a, 0xb4, 0x39, 0x40, 0xf7, 0xd9, 0x66, 0xdd, 0xe3, 0x41, 0x03, 0x3f, 0xec,
+	0x62, 0x1a, 0x68, 0x5b, 0x70, 0x3a, 0x11, 0xa5, 0x1e, 0x71, 0x29, 0x46, 0x37, 0xa0, 0x20, 0x92,
+	0xcb, 0xca, 0xa2, 0xb2, 0x52, 0x5a, 0xaf, 0xd4, 0x8e, 0xde, 0xdb, 0x9a, 0xc8, 0xab, 0x8f, 0x3f,
+	0x3b, 0xa8, 0x8e, 0x18, 0x32, 0x47, 0x0b, 0x64, 0x51, 0x03, 0xef, 0x99, 0xbe, 0x1d, 0x7a, 0xa1,
+	0x39, 0x98, 0x20, 0x7b, 0x2e, 0xf6, 0x79, 0xcd, 0xa2, 0x21, 0x1e, 0x50, 0x15, 0x4a, 0x3e, 0xd7,
+	0x35, 0x82, 0x9e, 0x87, 0xcb, 0xa3, 0xfc, 0x1d, 0x88, 0xd0, 0x07, 0x3d, 0x0f, 0xa3, 0x65, 0x38,
+	0xd1, 0x75, 0x69, 0xcf, 0xb5, 0xda, 0x3e, 0x71, 0x9d, 0xc7, 0xd8, 0x2e, 0x8f, 0x2d, 0x2a, 0x2b,
+	0x53, 0x46, 0x2a, 0xaa, 0xfd, 0x54, 0x80, 0xb9, 0xa4, 0xad, 0x5c, 0xcc, 0x57, 0x0a, 0x9c, 0xee,
+	0x52, 0xfb, 0x51, 0x63, 0xc7, 0x71, 0x03, 0xc7, 0x6d, 0x35, 0xc4, 0xe6, 0x95, 0x95, 0xc5, 0xb1,
+	0x95, 0xd2, 0xfa, 0x4a, 0xd6, 0xd2, 0x3e, 0xdc, 0xda, 0x78, 0x70, 0x57, 0x64, 0xdc, 0x62, 0x09,
+	0xf5, 0x37, 0xd9, 0x22, 0x0f, 0x0f, 0xaa, 0xb3, 0xe9, 0x37, 0xf4, 0xe9, 0x8b, 0x23, 0x82, 0x65,
+	0xc5, 0x98, 0x65, 0xb6, 0x89, 0x20, 0xfa, 0x51, 0x81, 0x4a, 0x9b, 0xad, 0xb6, 0xe3, 0x3c, 0xec,
+	0x3a, 0xb6, 0x13, 0xf4, 0xd8, 0x77, 0xb9, 0xeb, 0xd8, 0xd8, 0x0f, 0xb9, 0x46, 0x39, 0xd7, 0x7a,
+	0x16, 0xd7, 0x7b, 0xa6, 0x6f, 0xdf, 0x09, 0x93, 0xef, 0xc9, 0x5c, 0x41, 0x78, 0x81, 0x11, 0x3e,
+	0x7d, 0x51, 0x5d, 0xc8, 0xd6, 0x30, 0xac, 0x85, 0x76, 0xf6, 0x6b, 0xd4, 0x81, 0x53, 0x36, 0xee,
+	0xe0, 0x96, 0x19, 0x90, 0x88, 0x68, 0x8c, 0x13, 0x2d, 0x67, 0x11, 0x6d, 0x84, 0x7a, 0x41, 0x71,
+	0x4e, 0x52, 0x9c, 0x4c, 0xc6, 0x99, 0xf3, 0x49, 0x3b, 0x19, 0x42, 0x0f, 0xa0, 0x44, 0xf7, 0x4c,
+	0x2f, 0x34, 0x1a, 0xe7, 0x46, 0xe7, 0xb3, 0x8c, 0xb6, 0xf6, 0x4c, 0x4f, 0x78, 0xcc, 0x49, 0x0f,
+	0x88, 0x42, 0xac, 0x3c, 0xd0, 0xe8, 0x09, 0xd9, 0x70, 0x82, 0x9a, 0xbb, 0x8e, 0xdb, 0xa2, 0x61,
+	0xf1, 0x09, 0x5e, 0xfc, 0x8d, 0xcc, 0xe2, 0x42, 0x2d, 0xea, 0x9f, 0x95, 0xf5, 0x67, 0xe2, 0x51,
+	0x66, 0x31, 0x43, 0xe3, 0x01, 0xc6, 0x8f, 0x4d, 0xdf, 0x0d, 0x2d, 0x0a, 0xf9, 0xfc, 0xb7, 0x4d,
+	0xdf, 0x4d, 0xf1, 0x47, 0x21, 0xce, 0x8f, 0xa3, 0x27, 0x74, 0x1f, 0x0a, 0xb2, 0xe8, 0x24, 0x2f,
+	0xba, 0x94, 0xc9, 0x4d, 0xba, 0xbe, 0x85, 0x93, 0x65, 0xa7, 0x63, 0x41, 0x6a, 0xc8, 0x42, 0xda,
+	0x02, 0x9c, 0x8b, 0x35, 0xc8, 0xbb, 0xa6, 0x15, 0x10, 0x3f, 0x3a, 0x09, 0xbe, 0x98, 0x04, 0xf5,
+	0xa8, 0xb7, 0xb2, 0x89, 0x7a, 0xb0, 0x90, 0xe8, 0x21, 0xd9, 0xb3, 0x9f, 0x08, 0x59, 0x59, 0xc9,
+	0x67, 0x14, 0x35, 0x37, 0x5d, 0x1b, 0x3f, 0xaa, 0x9f, 0x09, 0xb7, 0x36, 0x16, 0xc4, 0xd4, 0x28,
+	0xc7, 0x7a, 0x25, 0x81, 0x80, 0x3e, 0x53, 0x40, 0xe5, 0x2d, 0x43, 0xbb, 0x9e, 0xd7, 0xe9, 0xa5,
+	0xad, 0x47, 0xf3, 0xdb, 0xf8, 0x6e, 0xb7, 0x13, 0x38, 0x71, 0x7f, 0x55, 0xfa, 0xa3, 0xf4, 0x1b,
+	0x4c, 0x8d, 0xb3, 0xcc, 0x67, 0x8b, 0xdb, 0x64, 0x30, 0x34, 0x89, 0xef, 0x93, 0xbd, 0x34, 0xc3,
+	0xd8, 0x71, 0x33, 0xd4, 0xb9, 0x4d, 0x92, 0xe1, 0x53, 0x28, 0xf7, 0x3b, 0x33, 0x05, 0x30, 0x7e,
+	0x8c, 0x00, 0xf3, 0x91, 0x4b, 0xd2, 0x3f, 0x80, 0xd3, 0xbc, 0x57, 0x53, 0xd6, 0x13, 0xc7, 0x68,
+	0x3d, 0xcb, 0x0c, 0x92, 0xae, 0x8f, 0x61, 0x3e, 0xec, 0xe3, 0x94, 0x71, 0xe1, 0x18, 0x8d, 0xe7,
+	0xa4, 0xc7, 0x2b, 0x2b, 0xe6, 0xdd, 0x9d, 0x32, 0x9e, 0x3c, 0xce, 0x15, 0x33, 0x83, 0x84, 0xab,
+	0x36, 0x0b, 0x27, 0x79, 0x23, 0xde, 0xf4, 0x7a, 0x61, 0x73, 0x6e, 0xc2, 0xa9, 0x7e, 0x48, 0x76,
+	0xe4, 0x3b, 0x30, 0xce, 0x72, 0x65, 0xeb, 0x2d, 0x64, 0xd1, 0xdc, 0xf4, 0x7a, 0xf2, 0x7a, 0xe6,
+	0x72, 0xed, 0x1a, 0x54, 0x44, 0x29, 0xcb, 0x22, 0x5d, 0x37, 0x10, 0xd6, 0x86, 0x19, 0xe0, 0xfc,
+	0x7b, 0x5a, 0xfb, 0x5a, 0x81, 0x6a, 0x66, 0xa2, 0x44, 0x6a, 0xc3, 0xb4, 0xdc, 0x2a, 0x9f, 0xc5,
+	0x25, 0xda, 0xa5, 0x4c, 0xb4, 0x74, 0xa5, 0xfe, 0x4e, 0x1d, 0x61, 0x52, 0xf2, 0xfb, 0x0f, 0xda,
+	0x2a, 0x9c, 0xe1, 0x30, 0x75, 0x36, 0x52, 0xdd, 0x21, 0xd6, 0x76, 0x3e, 0xfc, 0x0f, 0xa3, 0x30,
+	0x9f, 0xd6, 0xf7, 0xb7, 0xb1, 0x43, 0xac, 0x6d, 0x39, 0xe8, 0x64, 0x1e, 0xdd, 0xfd, 0x44, 0x2e,
+	0x47, 0x2d, 0x38, 0xc1, 0xc7, 0x39, 0x6c, 0x37, 0x68, 0xdb, 0xf4, 0x71, 0x78, 0x0e, 0x5d, 0xc8,
+	0x2d, 0x80, 0xed, 0x2d, 0x2e, 0x8e, 0xee, 0xc8, 0xd9, 0x44, 0xf8, 0x8e, 0x43, 0x03, 0x63, 0xa6,
+	0x19, 0x0f, 0xa1, 0x06, 0x4c, 0x07, 0x24, 0x30, 0x3b, 0x8d, 0x3d, 0xec, 0xb4, 0xda, 0x01, 0x1f,
+	0x7e, 0x8a, 0xf5, 0x1b, 0x2c, 0xff, 0xaf, 0x83, 0xea, 0x72, 0xcb, 0x09, 0xda, 0xdd, 0x66, 0xcd,
+	0x22, 0x3b, 0x72, 0xb2, 0x95, 0xff, 0xac, 0x52, 0x7b, 0x5b, 0x67, 0x13, 0x15, 0xad, 0x6d, 0x60,
+	0xeb, 0xf7, 0x5f, 0x57, 0x41, 0xc4, 0xd9, 0x93, 0x51, 0xe2, 0x15, 0x3f, 0xe2, 0x05, 0x35, 0x4b,
+	0x6e, 0xe5, 0x2d, 0x39, 0x79, 0x46, 0xdf, 0x41, 0x15, 0x4a, 0x94, 0x5f, 0x23, 0x62, 0x32, 0x13,
+	0x1b, 0x0a, 0x22, 0xc4, 0x27, 0xb3, 0x4b, 0x50, 0x94, 0x02, 0xc7, 0x16, 0x83, 0x5b, 0x7d, 0xfa,
+	0xf0, 0xa0, 0x3a, 0x25, 0x2e, 0x9f, 0xcd, 0x0d, 0x63, 0x4a, 0xbc, 0xde, 0xb4, 0xb5, 0x7d, 0x98,
+	0x4f, 0x9b, 0xc8, 0xfd, 0xb7, 0xa0, 0x18, 0xce, 0xbc, 0x03, 0x3f, 0x98, 0xcd, 0x30, 0x12, 0x96,
+	0xe9, 0x7f, 0x30, 0xaf, 0xbc, 0xa2, 0x46, 0xbf, 0xae, 0x76, 0x5d, 0x8e, 0x86, 0xe1, 0xcb, 0xd8,
+	0x12, 0x43, 0x11, 0x5b, 0x03, 0x5b, 0xe2, 0xb8, 0x01, 0x61, 0x68, 0xd3, 0xd6, 0xec, 0xd4, 0xe6,
+	0x44, 0xd8, 0xef, 0xc3, 0x54, 0x28, 0x93, 0x9f, 0xce, 0x6b, 0x50, 0x8b, 0x7e, 0x8c, 0x0a, 0xac,
+	0xff, 0x5b, 0x84, 0x09, 0x6e, 0x83, 0xbe, 0x54, 0xa0, 0x20, 0x66, 0x6a, 0x74, 0x39, 0xab, 0xde,
+	0xab, 0x63, 0xbc, 0x7a, 0x65, 0x28, 0xad, 0x40, 0xd7, 0x96, 0x3f, 0xff, 0xe3, 0x9f, 0xef, 0x46,
+	0x17, 0x51, 0x45, 0xcf, 0xfd, 0xdd, 0x80, 0xbe, 0x51, 0x60, 0x52, 0xce, 0xd2, 0x28, 0xdf, 0x20,
+	0x39, 0xe8, 0xab, 0x57, 0x87, 0x13, 0x4b, 0x9c, 0x8b, 0x1c, 0xe7, 0x3c, 0xaa, 0x66, 0xe1, 0xf8,
+	0x92, 0xe1, 0x17, 0x05, 0x66, 0x92, 0xe7, 0xf3, 0xda, 0x10, 0x46, 0xc9, 0x31, 0x47, 0x5d, 0x7f,
+	0x9d, 0x14, 0x49, 0x58, 0xe3, 0x84, 0x2b, 0x68, 0x39, 0x9f, 0x30, 0xbc, 0x1f, 0xd0, 0x3e, 0x8c,
+	0xdd, 0xf4, 0x7a, 0xe8, 0x62, 0xae, 0x55, 0xff, 0x74, 0x57, 0x57, 0x06, 0x0b, 0x25, 0xc9, 0x12,
+	0x27, 0xf9, 0x3f, 0x5a, 0xd0, 0xb3, 0x7f, 0x84, 0xa2, 0xdf, 0x14, 0x38, 0xe2, 0xfc, 0x44, 0xd7,
+	0xf2, 0x5d, 0xb2, 0xae, 0x03, 0xf5, 0xfa, 0x6b, 0xe7, 0x49, 0xd8, 0x1b, 0x1c, 0xf6, 0x1a, 0x7a,
+	0x3b, 0x13, 0x56, 0xe4, 0x36, 0xe2, 0x77, 0x86, 0xfe, 0x84, 0x9f, 0xd8, 0xfb, 0xec, 0x87, 0x52,
+	0x31, 0x3a, 0x74, 0xd1, 0x6a, 0x2e, 0x44, 0xfa, 0x16, 0x50, 0x6b, 0xc3, 0xca, 0x25, 0xea, 0x3a,
+	0x47, 0xbd, 0x8a, 0x2e, 0xeb, 0x79, 0x3f, 0xdd, 0x1b, 0xec, 0xe4, 0x8f, 0x00, 0xbf, 0x57, 0xa0,
+	0x18, 0x1d, 0x36, 0x03, 0x00, 0xd3, 0x67, 0xab, 0x5a, 0x1b, 0x56, 0x2e, 0x01, 0x2f, 0x71, 0xc0,
+	0x25, 0x74, 0x5e, 0x1f, 0xf0, 0x77, 0x03, 0x8a, 0x7e, 0x56, 0x60, 0x2a, 0x2c, 0x80, 0xae, 0x0e,
+	0xe5, 0x13, 0x52, 0xad, 0x0e, 0xa9, 0x96, 0x50, 0xd7, 0x39, 0xd4, 0x1a, 0xd2, 0x07, 0x42, 0xe9,
+	0x4f, 0x62, 0xc7, 0xec, 0x7e, 0xfd, 0xf6, 0xb3, 0xc3, 0x8a, 0xf2, 0xfc, 0xb0, 0xa2, 0xfc, 0x7d,
+	0x58, 0x51, 0xbe, 0x7d, 0x59, 0x19, 0x79, 0xfe, 0xb2, 0x32, 0xf2, 0xe7, 0xcb, 0xca, 0xc8, 0xc7,
+	0x57, 0x62, 0xf7, 0x19, 0x2b, 0xba, 0xda, 0x31, 0x9b, 0x54, 0x94, 0x7f, 0x14, 0x33, 0xe0, 0x17,
+	0x5b, 0xb3, 0xc0, 0xff, 0xb2, 0xf1, 0xd6, 0x7f, 0x03, 0x00, 0x2d, 0xa0, 0x63, 0x03, 0x1d, 0x12,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -570,6 +1008,15 @@ type QueryClient interface {
 	RewardFactors(ctx context.Context, in *QueryRewardFactorsRequest, opts ...grpc.CallOption) (*QueryRewardFactorsResponse, error)
 	// Apy queries incentive reward apy for a reward.
 	Apy(ctx context.Context, in *QueryApyRequest, opts ...grpc.CallOption) (*QueryApyResponse, error)
+	// AccountRewardRates queries the rewards an account earns per second from each of its rewarded positions, with a
+	// separate rate for each running incentive campaign.
+	AccountRewardRates(ctx context.Context, in *QueryAccountRewardRatesRequest, opts ...grpc.CallOption) (*QueryAccountRewardRatesResponse, error)
+	// BoostLock queries an account's boost lock and the shares it adds to registered reward sources.
+	BoostLock(ctx context.Context, in *QueryBoostLockRequest, opts ...grpc.CallOption) (*QueryBoostLockResponse, error)
+	// Campaigns queries incentive campaigns that have not yet ended, optionally filtered by source.
+	Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error)
+	// Campaign queries an incentive campaign by id.
+	Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error)
 }
 
 type queryClient struct {
@@ -616,6 +1063,42 @@ func (c *queryClient) Apy(ctx context.Context, in *QueryApyRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *queryClient) AccountRewardRates(ctx context.Context, in *QueryAccountRewardRatesRequest, opts ...grpc.CallOption) (*QueryAccountRewardRatesResponse, error) {
+	out := new(QueryAccountRewardRatesResponse)
+	err := c.cc.Invoke(ctx, "/kava.incentive.v1beta1.Query/AccountRewardRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BoostLock(ctx context.Context, in *QueryBoostLockRequest, opts ...grpc.CallOption) (*QueryBoostLockResponse, error) {
+	out := new(QueryBoostLockResponse)
+	err := c.cc.Invoke(ctx, "/kava.incentive.v1beta1.Query/BoostLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error) {
+	out := new(QueryCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/kava.incentive.v1beta1.Query/Campaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error) {
+	out := new(QueryCampaignResponse)
+	err := c.cc.Invoke(ctx, "/kava.incentive.v1beta1.Query/Campaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries module params.
@@ -626,6 +1109,15 @@ type QueryServer interface {
 	RewardFactors(context.Context, *QueryRewardFactorsRequest) (*QueryRewardFactorsResponse, error)
 	// Apy queries incentive reward apy for a reward.
 	Apy(context.Context, *QueryApyRequest) (*QueryApyResponse, error)
+	// AccountRewardRates queries the rewards an account earns per second from each of its rewarded positions, with a
+	// separate rate for each running incentive campaign.
+	AccountRewardRates(context.Context, *QueryAccountRewardRatesRequest) (*QueryAccountRewardRatesResponse, error)
+	// BoostLock queries an account's boost lock and the shares it adds to registered reward sources.
+	BoostLock(context.Context, *QueryBoostLockRequest) (*QueryBoostLockResponse, error)
+	// Campaigns queries incentive campaigns that have not yet ended, optionally filtered by source.
+	Campaigns(context.Context, *QueryCampaignsRequest) (*QueryCampaignsResponse, error)
+	// Campaign queries an incentive campaign by id.
+	Campaign(context.Context, *QueryCampaignRequest) (*QueryCampaignResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -644,6 +1136,18 @@ func (*UnimplementedQueryServer) RewardFactors(ctx context.Context, req *QueryRe
 func (*UnimplementedQueryServer) Apy(ctx context.Context, req *QueryApyRequest) (*QueryApyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Apy not implemented")
 }
+func (*UnimplementedQueryServer) AccountRewardRates(ctx context.Context, req *QueryAccountRewardRatesRequest) (*QueryAccountRewardRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRewardRates not implemented")
+}
+func (*UnimplementedQueryServer) BoostLock(ctx context.Context, req *QueryBoostLockRequest) (*QueryBoostLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoostLock not implemented")
+}
+func (*UnimplementedQueryServer) Campaigns(ctx context.Context, req *QueryCampaignsRequest) (*QueryCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaigns not implemented")
+}
+func (*UnimplementedQueryServer) Campaign(ctx context.Context, req *QueryCampaignRequest) (*QueryCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -721,9 +1225,81 @@ func _Query_Apy_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kava.incentive.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
+func _Query_AccountRewardRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRewardRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRewardRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.incentive.v1beta1.Query/AccountRewardRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRewardRates(ctx, req.(*QueryAccountRewardRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BoostLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBoostLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BoostLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.incentive.v1beta1.Query/BoostLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BoostLock(ctx, req.(*QueryBoostLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.incentive.v1beta1.Query/Campaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaigns(ctx, req.(*QueryCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.incentive.v1beta1.Query/Campaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaign(ctx, req.(*QueryCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kava.incentive.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Params",
@@ -741,6 +1317,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Apy",
 			Handler:    _Query_Apy_Handler,
 		},
+		{
+			MethodName: "AccountRewardRates",
+			Handler:    _Query_AccountRewardRates_Handler,
+		},
+		{
+			MethodName: "BoostLock",
+			Handler:    _Query_BoostLock_Handler,
+		},
+		{
+			MethodName: "Campaigns",
+			Handler:    _Query_Campaigns_Handler,
+		},
+		{
+			MethodName: "Campaign",
+			Handler:    _Query_Campaign_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/incentive/v1beta1/query.proto",
@@ -869,6 +1461,20 @@ func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.EarnClaims) > 0 {
 		for iNdEx := len(m.EarnClaims) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1160,109 +1766,406 @@ func (m *QueryApyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAccountRewardRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAccountRewardRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRewardRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountRewardRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRewardRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRewardRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.RewardRates) > 0 {
+		for iNdEx := len(m.RewardRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBoostLockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoostLockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoostLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RewardType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Unsynchronized {
-		n += 2
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBoostLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoostLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoostLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.USDXMintingClaims) > 0 {
-		for _, e := range m.USDXMintingClaims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.HardLiquidityProviderClaims) > 0 {
-		for _, e := range m.HardLiquidityProviderClaims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.DelegatorClaims) > 0 {
-		for _, e := range m.DelegatorClaims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.SwapClaims) > 0 {
-		for _, e := range m.SwapClaims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.SavingsClaims) > 0 {
-		for _, e := range m.SavingsClaims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BoostedShares) > 0 {
+		for iNdEx := len(m.BoostedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BoostedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.EarnClaims) > 0 {
-		for _, e := range m.EarnClaims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Lock != nil {
+		{
+			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardFactorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCampaignsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceID) > 0 {
+		i -= len(m.SourceID)
+		copy(dAtA[i:], m.SourceID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RewardType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Unsynchronized {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.USDXMintingClaims) > 0 {
+		for _, e := range m.USDXMintingClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.HardLiquidityProviderClaims) > 0 {
+		for _, e := range m.HardLiquidityProviderClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatorClaims) > 0 {
+		for _, e := range m.DelegatorClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SwapClaims) > 0 {
+		for _, e := range m.SwapClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SavingsClaims) > 0 {
+		for _, e := range m.SavingsClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EarnClaims) > 0 {
+		for _, e := range m.EarnClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardFactorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryRewardFactorsResponse) Size() (n int) {
@@ -1340,13 +2243,1023 @@ func (m *QueryApyResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryAccountRewardRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryAccountRewardRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardRates) > 0 {
+		for _, e := range m.RewardRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBoostLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBoostLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lock != nil {
+		l = m.Lock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.BoostedShares) > 0 {
+		for _, e := range m.BoostedShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCampaignsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *QueryCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Campaign.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsynchronized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unsynchronized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field USDXMintingClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.USDXMintingClaims = append(m.USDXMintingClaims, USDXMintingClaim{})
+			if err := m.USDXMintingClaims[len(m.USDXMintingClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardLiquidityProviderClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardLiquidityProviderClaims = append(m.HardLiquidityProviderClaims, HardLiquidityProviderClaim{})
+			if err := m.HardLiquidityProviderClaims[len(m.HardLiquidityProviderClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorClaims = append(m.DelegatorClaims, DelegatorClaim{})
+			if err := m.DelegatorClaims[len(m.DelegatorClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapClaims = append(m.SwapClaims, SwapClaim{})
+			if err := m.SwapClaims[len(m.SwapClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SavingsClaims = append(m.SavingsClaims, SavingsClaim{})
+			if err := m.SavingsClaims[len(m.SavingsClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarnClaims = append(m.EarnClaims, EarnClaim{})
+			if err := m.EarnClaims[len(m.EarnClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, SourceClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardFactorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardFactorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardFactorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardFactorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdxMintingRewardFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdxMintingRewardFactors = append(m.UsdxMintingRewardFactors, RewardIndex{})
+			if err := m.UsdxMintingRewardFactors[len(m.UsdxMintingRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardSupplyRewardFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardSupplyRewardFactors = append(m.HardSupplyRewardFactors, MultiRewardIndex{})
+			if err := m.HardSupplyRewardFactors[len(m.HardSupplyRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardBorrowRewardFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardBorrowRewardFactors = append(m.HardBorrowRewardFactors, MultiRewardIndex{})
+			if err := m.HardBorrowRewardFactors[len(m.HardBorrowRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewardFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorRewardFactors = append(m.DelegatorRewardFactors, MultiRewardIndex{})
+			if err := m.DelegatorRewardFactors[len(m.DelegatorRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRewardFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRewardFactors = append(m.SwapRewardFactors, MultiRewardIndex{})
+			if err := m.SwapRewardFactors[len(m.SwapRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsRewardFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SavingsRewardFactors = append(m.SavingsRewardFactors, MultiRewardIndex{})
+			if err := m.SavingsRewardFactors[len(m.SavingsRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnRewardFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarnRewardFactors = append(m.EarnRewardFactors, MultiRewardIndex{})
+			if err := m.EarnRewardFactors[len(m.EarnRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryApyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,10 +3282,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryApyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryApyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1396,7 +3309,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryApyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1419,15 +3332,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryApyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryApyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Earn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1454,7 +3367,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Earn = append(m.Earn, Apy{})
+			if err := m.Earn[len(m.Earn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1479,7 +3393,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountRewardRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1502,10 +3416,10 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountRewardRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountRewardRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1540,58 +3454,6 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unsynchronized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Unsynchronized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1613,7 +3475,7 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountRewardRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1621,200 +3483,30 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field USDXMintingClaims", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.USDXMintingClaims = append(m.USDXMintingClaims, USDXMintingClaim{})
-			if err := m.USDXMintingClaims[len(m.USDXMintingClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardLiquidityProviderClaims", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HardLiquidityProviderClaims = append(m.HardLiquidityProviderClaims, HardLiquidityProviderClaim{})
-			if err := m.HardLiquidityProviderClaims[len(m.HardLiquidityProviderClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorClaims", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorClaims = append(m.DelegatorClaims, DelegatorClaim{})
-			if err := m.DelegatorClaims[len(m.DelegatorClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapClaims", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SwapClaims = append(m.SwapClaims, SwapClaim{})
-			if err := m.SwapClaims[len(m.SwapClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SavingsClaims", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SavingsClaims = append(m.SavingsClaims, SavingsClaim{})
-			if err := m.SavingsClaims[len(m.SavingsClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRewardRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRewardRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarnClaims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1841,8 +3533,8 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EarnClaims = append(m.EarnClaims, EarnClaim{})
-			if err := m.EarnClaims[len(m.EarnClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardRates = append(m.RewardRates, AccountRewardRate{})
+			if err := m.RewardRates[len(m.RewardRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1867,7 +3559,7 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardFactorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBoostLockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1890,12 +3582,44 @@ func (m *QueryRewardFactorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardFactorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBoostLockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardFactorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBoostLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1917,7 +3641,7 @@ func (m *QueryRewardFactorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBoostLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1940,15 +3664,15 @@ func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardFactorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBoostLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBoostLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdxMintingRewardFactors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1975,14 +3699,16 @@ func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UsdxMintingRewardFactors = append(m.UsdxMintingRewardFactors, RewardIndex{})
-			if err := m.UsdxMintingRewardFactors[len(m.UsdxMintingRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Lock == nil {
+				m.Lock = &BoostLock{}
+			}
+			if err := m.Lock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardSupplyRewardFactors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostedShares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2009,16 +3735,16 @@ func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HardSupplyRewardFactors = append(m.HardSupplyRewardFactors, MultiRewardIndex{})
-			if err := m.HardSupplyRewardFactors[len(m.HardSupplyRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BoostedShares = append(m.BoostedShares, BoostedShares{})
+			if err := m.BoostedShares[len(m.BoostedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardBorrowRewardFactors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2028,65 +3754,81 @@ func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HardBorrowRewardFactors = append(m.HardBorrowRewardFactors, MultiRewardIndex{})
-			if err := m.HardBorrowRewardFactors[len(m.HardBorrowRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewardFactors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorRewardFactors = append(m.DelegatorRewardFactors, MultiRewardIndex{})
-			if err := m.DelegatorRewardFactors[len(m.DelegatorRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapRewardFactors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2096,31 +3838,29 @@ func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwapRewardFactors = append(m.SwapRewardFactors, MultiRewardIndex{})
-			if err := m.SwapRewardFactors[len(m.SwapRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SavingsRewardFactors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2130,29 +3870,77 @@ func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SavingsRewardFactors = append(m.SavingsRewardFactors, MultiRewardIndex{})
-			if err := m.SavingsRewardFactors[len(m.SavingsRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SourceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarnRewardFactors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2179,8 +3967,8 @@ func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EarnRewardFactors = append(m.EarnRewardFactors, MultiRewardIndex{})
-			if err := m.EarnRewardFactors[len(m.EarnRewardFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Campaigns = append(m.Campaigns, IncentiveCampaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2205,7 +3993,7 @@ func (m *QueryRewardFactorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryApyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCampaignRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2228,12 +4016,31 @@ func (m *QueryApyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryApyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCampaignRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryApyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2255,7 +4062,7 @@ func (m *QueryApyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryApyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCampaignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2278,15 +4085,15 @@ func (m *QueryApyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryApyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCampaignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryApyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Earn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2313,8 +4120,7 @@ func (m *QueryApyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Earn = append(m.Earn, Apy{})
-			if err := m.Earn[len(m.Earn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
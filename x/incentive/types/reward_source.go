@@ -0,0 +1,206 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Source types of the rewards built into the incentive module. They are rewarded by their own params, and their
+// reward sources are registered by the app like any other.
+const (
+	USDXMintingRewardSourceType = "usdx_minting"
+	HardSupplyRewardSourceType  = "hard_supply"
+	HardBorrowRewardSourceType  = "hard_borrow"
+	DelegatorRewardSourceType   = "delegator"
+	SwapRewardSourceType        = "swap"
+	SavingsRewardSourceType     = "savings"
+	EarnRewardSourceType        = "earn"
+)
+
+// IsBuiltInSourceType returns true if a source type is used by a reward built into the incentive module.
+func IsBuiltInSourceType(sourceType string) bool {
+	switch sourceType {
+	case USDXMintingRewardSourceType, HardSupplyRewardSourceType, HardBorrowRewardSourceType,
+		DelegatorRewardSourceType, SwapRewardSourceType, SavingsRewardSourceType, EarnRewardSourceType:
+		return true
+	}
+	return false
+}
+
+// reservedSourceTypes cannot be rewarded through the source reward periods param, as they are used by the built in
+// rewards or their claim types.
+var reservedSourceTypes = map[string]bool{
+	USDXMintingRewardSourceType:    true,
+	HardSupplyRewardSourceType:     true,
+	HardBorrowRewardSourceType:     true,
+	DelegatorRewardSourceType:      true,
+	SwapRewardSourceType:           true,
+	SavingsRewardSourceType:        true,
+	EarnRewardSourceType:           true,
+	HardLiquidityProviderClaimType: true,
+	DelegatorClaimType:             true,
+}
+
+var sourceTypeRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// RewardSource provides the shares that the rewards of a reward source type are distributed over.
+// A source type can have many sources, such as one per pool or denom, identified by a source ID.
+//
+// Modules providing a source must call Keeper.SynchronizeSourceReward before an owner's shares in a source change.
+// Boosts are recomputed from the owner's shares each time their claim is synchronized.
+type RewardSource interface {
+	// GetTotalShares returns the sum of all owners' shares in a source.
+	GetTotalShares(ctx sdk.Context, sourceID string) sdk.Dec
+	// GetShares returns an owner's shares in a source.
+	GetShares(ctx sdk.Context, sourceID string, owner sdk.AccAddress) sdk.Dec
+}
+
+// ValidateSourceType checks a source type can be rewarded through the source reward periods param.
+func ValidateSourceType(sourceType string) error {
+	if err := ValidateRewardSourceType(sourceType); err != nil {
+		return err
+	}
+	if reservedSourceTypes[sourceType] {
+		return fmt.Errorf("source type '%s' is reserved", sourceType)
+	}
+	return nil
+}
+
+// ValidateRewardSourceType checks a source type can be used by a reward source, including the built in ones.
+func ValidateRewardSourceType(sourceType string) error {
+	if !sourceTypeRegex.MatchString(sourceType) {
+		return fmt.Errorf("invalid source type '%s', must match %s", sourceType, sourceTypeRegex)
+	}
+	if reservedSourceTypes[sourceType] && !IsBuiltInSourceType(sourceType) {
+		return fmt.Errorf("source type '%s' is reserved", sourceType)
+	}
+	return nil
+}
+
+// NewSourceClaim returns a new SourceClaim
+func NewSourceClaim(sourceType, sourceID string, owner sdk.AccAddress, reward sdk.Coins, rewardIndexes RewardIndexes) SourceClaim {
+	return SourceClaim{
+		SourceType:    sourceType,
+		SourceID:      sourceID,
+		Owner:         owner,
+		Reward:        reward,
+		RewardIndexes: rewardIndexes,
+	}
+}
+
+// Validate performs a basic check of a SourceClaim fields
+func (c SourceClaim) Validate() error {
+	if err := ValidateRewardSourceType(c.SourceType); err != nil {
+		return err
+	}
+	if c.SourceID == "" {
+		return errors.New("source id cannot be empty")
+	}
+	if c.Owner.Empty() {
+		return errors.New("claim owner cannot be empty")
+	}
+	if !c.Reward.IsValid() {
+		return fmt.Errorf("invalid reward amount: %s", c.Reward)
+	}
+	return c.RewardIndexes.Validate()
+}
+
+// SourceClaims slice of SourceClaim
+type SourceClaims []SourceClaim
+
+// Validate checks if all the claims are valid and there are no duplicated entries.
+func (cs SourceClaims) Validate() error {
+	seen := make(map[string]bool)
+	for _, c := range cs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		key := string(SourceClaimKey(c.SourceType, c.Owner, c.SourceID))
+		if seen[key] {
+			return fmt.Errorf("duplicated %s claim for source %s and owner %s", c.SourceType, c.SourceID, c.Owner)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// NewTypedMultiRewardPeriod returns a new TypedMultiRewardPeriod
+func NewTypedMultiRewardPeriod(sourceType string, rewardPeriods MultiRewardPeriods) TypedMultiRewardPeriod {
+	return TypedMultiRewardPeriod{
+		SourceType:    sourceType,
+		RewardPeriods: rewardPeriods,
+	}
+}
+
+// Validate performs a basic check of a TypedMultiRewardPeriod fields
+func (p TypedMultiRewardPeriod) Validate() error {
+	if err := ValidateSourceType(p.SourceType); err != nil {
+		return err
+	}
+	return p.RewardPeriods.Validate()
+}
+
+// TypedMultiRewardPeriods slice of TypedMultiRewardPeriod
+type TypedMultiRewardPeriods []TypedMultiRewardPeriod
+
+// Validate checks if all the reward periods are valid and there are no duplicated source types.
+func (ps TypedMultiRewardPeriods) Validate() error {
+	seen := make(map[string]bool)
+	for _, p := range ps {
+		if seen[p.SourceType] {
+			return fmt.Errorf("duplicated reward periods with source type %s", p.SourceType)
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		seen[p.SourceType] = true
+	}
+	return nil
+}
+
+// Get returns the reward periods of a source type
+func (ps TypedMultiRewardPeriods) Get(sourceType string) (MultiRewardPeriods, bool) {
+	for _, p := range ps {
+		if p.SourceType == sourceType {
+			return p.RewardPeriods, true
+		}
+	}
+	return nil, false
+}
+
+// NewTypedGenesisRewardState returns a new TypedGenesisRewardState
+func NewTypedGenesisRewardState(sourceType string, state GenesisRewardState) TypedGenesisRewardState {
+	return TypedGenesisRewardState{
+		SourceType:  sourceType,
+		RewardState: state,
+	}
+}
+
+// Validate performs a basic check of a TypedGenesisRewardState fields
+func (s TypedGenesisRewardState) Validate() error {
+	if err := ValidateSourceType(s.SourceType); err != nil {
+		return err
+	}
+	return s.RewardState.Validate()
+}
+
+// TypedGenesisRewardStates slice of TypedGenesisRewardState
+type TypedGenesisRewardStates []TypedGenesisRewardState
+
+// Validate checks if all the reward states are valid and there are no duplicated source types.
+func (ss TypedGenesisRewardStates) Validate() error {
+	seen := make(map[string]bool)
+	for _, s := range ss {
+		if seen[s.SourceType] {
+			return fmt.Errorf("duplicated reward state with source type %s", s.SourceType)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		seen[s.SourceType] = true
+	}
+	return nil
+}
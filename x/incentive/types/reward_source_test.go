@@ -0,0 +1,182 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func TestValidateSourceType(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sourceType string
+		expPass    bool
+	}{
+		{"valid", "vault", true},
+		{"valid with digits and underscores", "vault_v2", true},
+		{"empty", "", false},
+		{"uppercase", "Vault", false},
+		{"leading digit", "2vault", false},
+		{"too long", "a23456789012345678901234567890123", false},
+		{"reserved built in source type", types.SwapRewardSourceType, false},
+		{"reserved claim type", types.HardLiquidityProviderClaimType, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateSourceType(tc.sourceType)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRewardSourceType(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sourceType string
+		expPass    bool
+	}{
+		{"valid", "vault", true},
+		{"built in source type", types.SwapRewardSourceType, true},
+		{"usdx minting source type", types.USDXMintingRewardSourceType, true},
+		{"empty", "", false},
+		{"uppercase", "Vault", false},
+		{"reserved claim type", types.HardLiquidityProviderClaimType, false},
+		{"reserved delegator claim type", types.DelegatorClaimType, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateRewardSourceType(tc.sourceType)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTypedMultiRewardPeriods_Validate(t *testing.T) {
+	period := types.NewMultiRewardPeriod(
+		true,
+		"pool-1",
+		time.Date(2020, 10, 15, 14, 0, 0, 0, time.UTC),
+		time.Date(2024, 10, 15, 14, 0, 0, 0, time.UTC),
+		sdk.NewCoins(sdk.NewInt64Coin("hard", 1e6)),
+	)
+
+	testCases := []struct {
+		name    string
+		periods types.TypedMultiRewardPeriods
+		expPass bool
+	}{
+		{
+			"valid",
+			types.TypedMultiRewardPeriods{
+				types.NewTypedMultiRewardPeriod("vault", types.MultiRewardPeriods{period}),
+				types.NewTypedMultiRewardPeriod("pool", types.MultiRewardPeriods{period}),
+			},
+			true,
+		},
+		{
+			"invalid source type",
+			types.TypedMultiRewardPeriods{types.NewTypedMultiRewardPeriod(types.EarnRewardSourceType, types.MultiRewardPeriods{period})},
+			false,
+		},
+		{
+			"duplicated source type",
+			types.TypedMultiRewardPeriods{
+				types.NewTypedMultiRewardPeriod("vault", types.MultiRewardPeriods{period}),
+				types.NewTypedMultiRewardPeriod("vault", types.MultiRewardPeriods{}),
+			},
+			false,
+		},
+		{
+			"invalid reward period",
+			types.TypedMultiRewardPeriods{types.NewTypedMultiRewardPeriod("vault", types.MultiRewardPeriods{period, period})},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.periods.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSourceClaims_Validate(t *testing.T) {
+	owner := sdk.AccAddress("test_address________")
+
+	testCases := []struct {
+		name    string
+		claims  types.SourceClaims
+		expPass bool
+	}{
+		{
+			"valid",
+			types.SourceClaims{
+				types.NewSourceClaim("vault", "pool-1", owner, sdk.NewCoins(sdk.NewInt64Coin("hard", 1)), types.RewardIndexes{}),
+				types.NewSourceClaim("vault", "pool-2", owner, nil, types.RewardIndexes{}),
+			},
+			true,
+		},
+		{
+			"valid built in source types",
+			types.SourceClaims{
+				types.NewSourceClaim(types.USDXMintingRewardSourceType, "bnb-a", owner, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1)), types.RewardIndexes{}),
+				types.NewSourceClaim(types.HardSupplyRewardSourceType, "bnb", owner, nil, types.RewardIndexes{}),
+			},
+			true,
+		},
+		{
+			"reserved source type",
+			types.SourceClaims{types.NewSourceClaim(types.HardLiquidityProviderClaimType, "bnb", owner, nil, types.RewardIndexes{})},
+			false,
+		},
+		{
+			"empty source id",
+			types.SourceClaims{types.NewSourceClaim("vault", "", owner, nil, types.RewardIndexes{})},
+			false,
+		},
+		{
+			"empty owner",
+			types.SourceClaims{types.NewSourceClaim("vault", "pool-1", nil, nil, types.RewardIndexes{})},
+			false,
+		},
+		{
+			"invalid reward",
+			types.SourceClaims{types.NewSourceClaim("vault", "pool-1", owner, sdk.Coins{sdk.Coin{Denom: "hard", Amount: sdk.NewInt(-1)}}, types.RewardIndexes{})},
+			false,
+		},
+		{
+			"duplicated claim",
+			types.SourceClaims{
+				types.NewSourceClaim("vault", "pool-1", owner, nil, types.RewardIndexes{}),
+				types.NewSourceClaim("vault", "pool-1", owner, sdk.NewCoins(sdk.NewInt64Coin("hard", 1)), types.RewardIndexes{}),
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.claims.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
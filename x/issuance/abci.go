@@ -18,5 +18,6 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 	k.SynchronizeBlockList(ctx)
+	k.SynchronizeMinterAllowances(ctx)
 	k.UpdateTimeBasedSupplyLimits(ctx)
 }
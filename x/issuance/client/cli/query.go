@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/issuance/types"
 )
@@ -21,6 +22,12 @@ func GetQueryCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdQueryParams(),
+		GetCmdQueryRoles(),
+		GetCmdQueryMinterAllowances(),
+		GetCmdQueryAsset(),
+		GetCmdQueryAssetSupply(),
+		GetCmdQueryAssetSupplies(),
+		GetCmdQueryIsBlocked(),
 	}
 
 	for _, cmd := range cmds {
@@ -56,3 +63,178 @@ func GetCmdQueryParams() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryRoles queries the role assignments of an asset
+func GetCmdQueryRoles() *cobra.Command {
+	return &cobra.Command{
+		Use:     "roles [denom]",
+		Short:   "get the role assignments of an asset",
+		Long:    "Get the owner, master minter, minters, pauser and blocklister of an asset.",
+		Example: fmt.Sprintf("%s query %s roles usdtoken", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Roles(context.Background(), &types.QueryRolesRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdQueryMinterAllowances queries the remaining minter allowances of an asset
+func GetCmdQueryMinterAllowances() *cobra.Command {
+	return &cobra.Command{
+		Use:   "minter-allowances [denom] [minter]",
+		Short: "get the remaining minter allowances of an asset",
+		Long:  "Get the remaining amount each minter of an asset can issue, optionally for a single minter.",
+		Example: fmt.Sprintf(`%[1]s query %[2]s minter-allowances usdtoken
+%[1]s query %[2]s minter-allowances usdtoken kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw`, version.AppName, types.ModuleName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMinterAllowancesRequest{Denom: args[0]}
+			if len(args) > 1 {
+				req.Minter = args[1]
+			}
+			res, err := queryClient.MinterAllowances(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdQueryAsset queries a single asset
+func GetCmdQueryAsset() *cobra.Command {
+	return &cobra.Command{
+		Use:     "asset [denom]",
+		Short:   "get an asset",
+		Long:    "Get the parameters of an asset, including its roles, blocked addresses, pause status and rate limit.",
+		Example: fmt.Sprintf("%s query %s asset usdtoken", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Asset(context.Background(), &types.QueryAssetRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Asset)
+		},
+	}
+}
+
+// GetCmdQueryAssetSupply queries the rate-limited supply of an asset
+func GetCmdQueryAssetSupply() *cobra.Command {
+	return &cobra.Command{
+		Use:     "supply [denom]",
+		Short:   "get the rate-limited supply of an asset",
+		Long:    "Get the supply issued in the current rate-limit period of an asset, and the time remaining until the period resets.",
+		Example: fmt.Sprintf("%s query %s supply usdtoken", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AssetSupply(context.Background(), &types.QueryAssetSupplyRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdQueryAssetSupplies queries the rate-limited supplies of all assets
+func GetCmdQueryAssetSupplies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supplies",
+		Short:   "get the rate-limited supplies of all assets",
+		Long:    "Get the supply issued in the current rate-limit period of all assets.",
+		Example: fmt.Sprintf("%s query %s supplies --page=2 --limit=10", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AssetSupplies(context.Background(), &types.QueryAssetSuppliesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "supplies")
+
+	return cmd
+}
+
+// GetCmdQueryIsBlocked queries whether an address is blocked from holding an asset
+func GetCmdQueryIsBlocked() *cobra.Command {
+	return &cobra.Command{
+		Use:     "is-blocked [denom] [address]",
+		Short:   "check whether an address is blocked for an asset",
+		Long:    "Check whether an address is on the blocked address list of an asset.",
+		Example: fmt.Sprintf("%s query %s is-blocked usdtoken kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsBlocked(context.Background(), &types.QueryIsBlockedRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
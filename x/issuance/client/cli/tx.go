@@ -5,6 +5,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -27,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdBlockAddress(),
 		GetCmdUnblockAddress(),
 		GetCmdPauseAsset(),
+		GetCmdGrantRole(),
+		GetCmdRevokeRole(),
+		GetCmdConfigureMinterAllowance(),
 	}
 
 	for _, cmd := range cmds {
@@ -203,3 +208,123 @@ func GetCmdPauseAsset() *cobra.Command {
 		},
 	}
 }
+
+func GetCmdGrantRole() *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant-role [denom] [role] [address]",
+		Short: "grant a role for an asset to an address",
+		Long: `Assigns a role for the asset to the address. Granting the master minter, pauser or blocklister role replaces the current holder.
+Roles are one of master-minter, minter, pauser or blocklister. Minters are managed by the master minter, all other roles by the asset owner.`,
+		Example: fmt.Sprintf(`$ %s tx %s grant-role usdtoken minter kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+		`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := parseRole(args[1])
+			if err != nil {
+				return err
+			}
+			// We use the string later but here validate the acc address
+			addr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantRole(cliCtx.GetFromAddress().String(), args[0], role, addr.String())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func GetCmdRevokeRole() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-role [denom] [role] [address]",
+		Short: "revoke a role for an asset from an address",
+		Long: `Removes a role for the asset from the address. Revoking a minter also removes its allowance.
+Roles are one of master-minter, minter, pauser or blocklister. Minters are managed by the master minter, all other roles by the asset owner.`,
+		Example: fmt.Sprintf(`$ %s tx %s revoke-role usdtoken minter kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+		`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := parseRole(args[1])
+			if err != nil {
+				return err
+			}
+			// We use the string later but here validate the acc address
+			addr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(cliCtx.GetFromAddress().String(), args[0], role, addr.String())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func GetCmdConfigureMinterAllowance() *cobra.Command {
+	return &cobra.Command{
+		Use:   "configure-minter-allowance [denom] [minter] [allowance]",
+		Short: "set the amount of an asset a minter can issue",
+		Long:  "The master minter sets the remaining amount of the asset the minter is allowed to issue",
+		Example: fmt.Sprintf(`$ %s tx %s configure-minter-allowance usdtoken kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 20000000
+		`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// We use the string later but here validate the acc address
+			minter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			allowance, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid allowance: %s", args[2])
+			}
+
+			msg := types.NewMsgConfigureMinterAllowance(cliCtx.GetFromAddress().String(), args[0], minter.String(), allowance)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// parseRole converts a role name to a Role
+func parseRole(role string) (types.Role, error) {
+	switch role {
+	case "master-minter":
+		return types.ROLE_MASTER_MINTER, nil
+	case "minter":
+		return types.ROLE_MINTER, nil
+	case "pauser":
+		return types.ROLE_PAUSER, nil
+	case "blocklister":
+		return types.ROLE_BLOCKLISTER, nil
+	default:
+		return types.ROLE_UNSPECIFIED, fmt.Errorf("role must be one of master-minter, minter, pauser or blocklister, got %s", role)
+	}
+}
@@ -27,6 +27,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 		k.SetAssetSupply(ctx, supply, supply.GetDenom())
 	}
 
+	for _, allowance := range gs.MinterAllowances {
+		k.SetMinterAllowance(ctx, allowance)
+	}
+
 	for _, asset := range gs.Params.Assets {
 		if asset.RateLimit.Active {
 			_, found := k.GetAssetSupply(ctx, asset.Denom)
@@ -41,5 +45,6 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	supplies := k.GetAllAssetSupplies(ctx)
-	return types.NewGenesisState(params, supplies)
+	allowances := k.GetAllMinterAllowances(ctx)
+	return types.NewGenesisState(params, supplies, allowances)
 }
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ bankkeeper.Keeper = TransferRestrictedBankKeeper{}
+
+// TransferRestrictedBankKeeper is a bank keeper wrapper that enforces the restrictions on issued assets on every
+// transfer, including transfers made by other modules, incoming IBC transfers, and messages executed by proposals.
+// Transfers of paused assets, and transfers of blockable assets to or from blocked addresses, are rejected.
+//
+// The issuance keeper itself is given the underlying keeper, so the issuer can still seize coins from blocked addresses.
+type TransferRestrictedBankKeeper struct {
+	bankkeeper.Keeper
+	// ik is a pointer as the issuance keeper is created after the bank keeper it depends on.
+	ik *Keeper
+}
+
+// NewTransferRestrictedBankKeeper returns a new TransferRestrictedBankKeeper wrapping bk.
+func NewTransferRestrictedBankKeeper(bk bankkeeper.Keeper, ik *Keeper) TransferRestrictedBankKeeper {
+	return TransferRestrictedBankKeeper{
+		Keeper: bk,
+		ik:     ik,
+	}
+}
+
+// SendCoins validates the transfer before sending coins between accounts.
+func (k TransferRestrictedBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.ik.ValidateTransfer(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins validates each input and output before performing a multi-send.
+func (k TransferRestrictedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.ik.ValidateTransfer(ctx, addr, nil, in.Coins); err != nil {
+			return err
+		}
+	}
+	for _, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.ik.ValidateTransfer(ctx, nil, addr, out.Coins); err != nil {
+			return err
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromModuleToAccount validates the transfer before sending coins from a module account.
+func (k TransferRestrictedBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.ik.ValidateTransfer(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromModuleToModule validates the transfer before sending coins between module accounts.
+func (k TransferRestrictedBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	if err := k.ik.ValidateTransfer(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
+
+// SendCoinsFromAccountToModule validates the transfer before sending coins to a module account.
+func (k TransferRestrictedBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.ik.ValidateTransfer(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// DelegateCoinsFromAccountToModule validates the transfer before delegating coins to a module account.
+func (k TransferRestrictedBankKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.ik.ValidateTransfer(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+	return k.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// UndelegateCoinsFromModuleToAccount validates the transfer before undelegating coins from a module account.
+func (k TransferRestrictedBankKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.ik.ValidateTransfer(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// DelegateCoins validates the transfer before delegating coins.
+func (k TransferRestrictedBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.ik.ValidateTransfer(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
+
+// UndelegateCoins validates the transfer before undelegating coins.
+func (k TransferRestrictedBankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.ik.ValidateTransfer(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+}
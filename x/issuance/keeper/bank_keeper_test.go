@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferRestrictedBankKeeper() {
+	token := sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 100))
+	otherToken := sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))
+
+	testCases := []struct {
+		name     string
+		paused   bool
+		transfer func(addrs []sdk.AccAddress) error
+		contains string
+	}{
+		{
+			"send between unblocked addresses",
+			false,
+			func(addrs []sdk.AccAddress) error {
+				return suite.tApp.GetBankKeeper().SendCoins(suite.ctx, addrs[1], addrs[2], token)
+			},
+			"",
+		},
+		{
+			"send of other denom to blocked address",
+			false,
+			func(addrs []sdk.AccAddress) error {
+				return suite.tApp.GetBankKeeper().SendCoins(suite.ctx, addrs[1], addrs[3], otherToken)
+			},
+			"",
+		},
+		{
+			"send to blocked address",
+			false,
+			func(addrs []sdk.AccAddress) error {
+				return suite.tApp.GetBankKeeper().SendCoins(suite.ctx, addrs[1], addrs[3], token)
+			},
+			"account is blocked",
+		},
+		{
+			"multi send to blocked address",
+			false,
+			func(addrs []sdk.AccAddress) error {
+				return suite.tApp.GetBankKeeper().InputOutputCoins(
+					suite.ctx,
+					[]banktypes.Input{banktypes.NewInput(addrs[1], token)},
+					[]banktypes.Output{banktypes.NewOutput(addrs[3], token)},
+				)
+			},
+			"account is blocked",
+		},
+		{
+			"bank msg send to blocked address",
+			false,
+			func(addrs []sdk.AccAddress) error {
+				msg := banktypes.NewMsgSend(addrs[1], addrs[3], token)
+				_, err := suite.tApp.MsgServiceRouter().Handler(msg)(suite.ctx, msg)
+				return err
+			},
+			"account is blocked",
+		},
+		{
+			"module send to blocked address",
+			false,
+			func(addrs []sdk.AccAddress) error {
+				if err := suite.tApp.GetBankKeeper().SendCoinsFromAccountToModule(suite.ctx, addrs[1], ibctransfertypes.ModuleName, token); err != nil {
+					return err
+				}
+				return suite.tApp.GetBankKeeper().SendCoinsFromModuleToAccount(suite.ctx, ibctransfertypes.ModuleName, addrs[3], token)
+			},
+			"account is blocked",
+		},
+		{
+			"send of paused asset to module",
+			true,
+			func(addrs []sdk.AccAddress) error {
+				return suite.tApp.GetBankKeeper().SendCoinsFromAccountToModule(suite.ctx, addrs[1], ibctransfertypes.ModuleName, token)
+			},
+			"asset is paused",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var addrs []sdk.AccAddress
+			for _, addr := range suite.addrs {
+				acc, err := sdk.AccAddressFromBech32(addr)
+				suite.Require().NoError(err)
+				addrs = append(addrs, acc)
+			}
+			suite.Require().NoError(suite.tApp.FundAccount(suite.ctx, addrs[1], token.Add(otherToken...)))
+
+			asset := types.NewAsset(suite.addrs[0], "usdtoken", []string{suite.addrs[3]}, tc.paused, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+			suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Asset{asset}))
+
+			err := tc.transfer(addrs)
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.contains), err.Error())
+			}
+		})
+	}
+}
@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kava-labs/kava/x/issuance/types"
 )
@@ -33,3 +36,143 @@ func (s queryServer) Params(ctx context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Roles implements the gRPC service handler for querying the role assignments of an asset.
+func (s queryServer) Roles(ctx context.Context, req *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	asset, found := s.keeper.GetAsset(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "asset %s not found", req.Denom)
+	}
+
+	return &types.QueryRolesResponse{
+		Owner:        asset.Owner,
+		MasterMinter: asset.MasterMinter,
+		Minters:      asset.Minters,
+		Pauser:       asset.Pauser,
+		Blocklister:  asset.Blocklister,
+	}, nil
+}
+
+// MinterAllowances implements the gRPC service handler for querying the remaining minter allowances of an asset.
+func (s queryServer) MinterAllowances(ctx context.Context, req *types.QueryMinterAllowancesRequest) (*types.QueryMinterAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, found := s.keeper.GetAsset(sdkCtx, req.Denom); !found {
+		return nil, status.Errorf(codes.NotFound, "asset %s not found", req.Denom)
+	}
+
+	if req.Minter != "" {
+		minter, err := sdk.AccAddressFromBech32(req.Minter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid minter address: %s", err)
+		}
+		allowance, found := s.keeper.GetMinterAllowance(sdkCtx, req.Denom, minter)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "minter %s not found for asset %s", req.Minter, req.Denom)
+		}
+		return &types.QueryMinterAllowancesResponse{Allowances: []types.MinterAllowance{allowance}}, nil
+	}
+
+	return &types.QueryMinterAllowancesResponse{
+		Allowances: s.keeper.GetMinterAllowancesByDenom(sdkCtx, req.Denom),
+	}, nil
+}
+
+// Asset implements the gRPC service handler for querying a single asset.
+func (s queryServer) Asset(ctx context.Context, req *types.QueryAssetRequest) (*types.QueryAssetResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	asset, found := s.keeper.GetAsset(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "asset %s not found", req.Denom)
+	}
+
+	return &types.QueryAssetResponse{Asset: asset}, nil
+}
+
+// AssetSupply implements the gRPC service handler for querying the rate-limited supply of an asset.
+func (s queryServer) AssetSupply(ctx context.Context, req *types.QueryAssetSupplyRequest) (*types.QueryAssetSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	asset, found := s.keeper.GetAsset(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "asset %s not found", req.Denom)
+	}
+	supply, found := s.keeper.GetAssetSupply(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "supply for asset %s not found", req.Denom)
+	}
+
+	timeRemaining := time.Duration(0)
+	if asset.RateLimit.Active && asset.RateLimit.TimePeriod > supply.TimeElapsed {
+		timeRemaining = asset.RateLimit.TimePeriod - supply.TimeElapsed
+	}
+
+	return &types.QueryAssetSupplyResponse{
+		AssetSupply:   supply,
+		TimeRemaining: timeRemaining,
+	}, nil
+}
+
+// AssetSupplies implements the gRPC service handler for querying the rate-limited supplies of all assets.
+func (s queryServer) AssetSupplies(ctx context.Context, req *types.QueryAssetSuppliesRequest) (*types.QueryAssetSuppliesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(sdkCtx.KVStore(s.keeper.key), types.AssetSupplyPrefix)
+
+	var supplies []types.AssetSupply
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var supply types.AssetSupply
+		if err := s.keeper.cdc.Unmarshal(value, &supply); err != nil {
+			return err
+		}
+		supplies = append(supplies, supply)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAssetSuppliesResponse{
+		AssetSupplies: supplies,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// IsBlocked implements the gRPC service handler for querying whether an address is blocked from holding an asset.
+func (s queryServer) IsBlocked(ctx context.Context, req *types.QueryIsBlockedRequest) (*types.QueryIsBlockedResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	asset, found := s.keeper.GetAsset(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "asset %s not found", req.Denom)
+	}
+	blocked, _ := s.keeper.checkBlockedAddress(asset, addr.String())
+
+	return &types.QueryIsBlockedResponse{Blocked: blocked}, nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/kava-labs/kava/x/issuance/keeper"
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+func (suite *KeeperTestSuite) setupQueryAssets() []types.Asset {
+	assets := []types.Asset{
+		types.NewAsset(suite.addrs[0], "usdtoken", []string{suite.addrs[1]}, false, true, types.NewRateLimit(true, sdkmath.NewInt(1000), time.Hour)),
+		types.NewAsset(suite.addrs[0], "eurtoken", []string{}, false, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+		types.NewAsset(suite.addrs[0], "gbptoken", []string{}, false, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+	}
+	suite.keeper.SetParams(suite.ctx, types.NewParams(assets))
+	suite.keeper.SetAssetSupply(suite.ctx, types.NewAssetSupply(sdk.NewInt64Coin("usdtoken", 100), 20*time.Minute), "usdtoken")
+	suite.keeper.SetAssetSupply(suite.ctx, types.NewAssetSupply(sdk.NewInt64Coin("eurtoken", 0), time.Duration(0)), "eurtoken")
+	suite.keeper.SetAssetSupply(suite.ctx, types.NewAssetSupply(sdk.NewInt64Coin("gbptoken", 0), time.Duration(0)), "gbptoken")
+	return assets
+}
+
+func (suite *KeeperTestSuite) TestGrpcQueryAsset() {
+	assets := suite.setupQueryAssets()
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	res, err := queryServer.Asset(sdk.WrapSDKContext(suite.ctx), &types.QueryAssetRequest{Denom: "usdtoken"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(assets[0], res.Asset)
+
+	_, err = queryServer.Asset(sdk.WrapSDKContext(suite.ctx), &types.QueryAssetRequest{Denom: "jpytoken"})
+	suite.Require().ErrorContains(err, "asset jpytoken not found")
+}
+
+func (suite *KeeperTestSuite) TestGrpcQueryAssetSupply() {
+	suite.setupQueryAssets()
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	res, err := queryServer.AssetSupply(sdk.WrapSDKContext(suite.ctx), &types.QueryAssetSupplyRequest{Denom: "usdtoken"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("usdtoken", 100), res.AssetSupply.CurrentSupply)
+	suite.Require().Equal(40*time.Minute, res.TimeRemaining)
+
+	// assets without an active rate limit have no time remaining
+	res, err = queryServer.AssetSupply(sdk.WrapSDKContext(suite.ctx), &types.QueryAssetSupplyRequest{Denom: "eurtoken"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Duration(0), res.TimeRemaining)
+
+	_, err = queryServer.AssetSupply(sdk.WrapSDKContext(suite.ctx), &types.QueryAssetSupplyRequest{Denom: "jpytoken"})
+	suite.Require().ErrorContains(err, "asset jpytoken not found")
+}
+
+func (suite *KeeperTestSuite) TestGrpcQueryAssetSupplies() {
+	suite.setupQueryAssets()
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	res, err := queryServer.AssetSupplies(sdk.WrapSDKContext(suite.ctx), &types.QueryAssetSuppliesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.AssetSupplies, 3)
+
+	res, err = queryServer.AssetSupplies(sdk.WrapSDKContext(suite.ctx), &types.QueryAssetSuppliesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.AssetSupplies, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryServer.AssetSupplies(sdk.WrapSDKContext(suite.ctx), &types.QueryAssetSuppliesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.AssetSupplies, 1)
+}
+
+func (suite *KeeperTestSuite) TestGrpcQueryIsBlocked() {
+	suite.setupQueryAssets()
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	testCases := []struct {
+		name     string
+		denom    string
+		address  string
+		blocked  bool
+		contains string
+	}{
+		{"blocked address", "usdtoken", suite.addrs[1], true, ""},
+		{"unblocked address", "usdtoken", suite.addrs[2], false, ""},
+		{"other asset", "eurtoken", suite.addrs[1], false, ""},
+		{"asset not found", "jpytoken", suite.addrs[1], false, "asset jpytoken not found"},
+		{"invalid address", "usdtoken", "invalid", false, "invalid address"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := queryServer.IsBlocked(sdk.WrapSDKContext(suite.ctx), &types.QueryIsBlockedRequest{
+				Denom:   tc.denom,
+				Address: tc.address,
+			})
+			if tc.contains != "" {
+				suite.Require().ErrorContains(err, tc.contains)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.blocked, res.Blocked)
+		})
+	}
+}
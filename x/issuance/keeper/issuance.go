@@ -10,14 +10,15 @@ import (
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
-// IssueTokens mints new tokens and sends them to the receiver address
-func (k Keeper) IssueTokens(ctx sdk.Context, tokens sdk.Coin, owner, receiver sdk.AccAddress) error {
+// IssueTokens mints new tokens and sends them to the receiver address.
+// The owner can issue any amount, minters can issue up to their allowance.
+func (k Keeper) IssueTokens(ctx sdk.Context, tokens sdk.Coin, sender, receiver sdk.AccAddress) error {
 	asset, found := k.GetAsset(ctx, tokens.Denom)
 	if !found {
 		return errorsmod.Wrapf(types.ErrAssetNotFound, "denom: %s", tokens.Denom)
 	}
-	if strings.Compare(owner.String(), asset.Owner) != 0 {
-		return errorsmod.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	if !asset.HasRole(types.ROLE_MINTER, sender.String()) {
+		return errorsmod.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, sender)
 	}
 	if asset.Paused {
 		return errorsmod.Wrapf(types.ErrAssetPaused, "denom: %s", tokens.Denom)
@@ -34,6 +35,13 @@ func (k Keeper) IssueTokens(ctx sdk.Context, tokens sdk.Coin, owner, receiver sd
 		return errorsmod.Wrapf(types.ErrIssueToModuleAccount, "address: %s", receiver)
 	}
 
+	// minters other than the owner are limited by their allowance
+	if strings.Compare(sender.String(), asset.Owner) != 0 {
+		if err := k.consumeMinterAllowance(ctx, sender, tokens); err != nil {
+			return err
+		}
+	}
+
 	// for rate-limited assets, check that the issuance isn't over the limit
 	if asset.RateLimit.Active {
 		err := k.IncrementCurrentAssetSupply(ctx, tokens)
@@ -61,13 +69,13 @@ func (k Keeper) IssueTokens(ctx sdk.Context, tokens sdk.Coin, owner, receiver sd
 	return nil
 }
 
-// RedeemTokens sends tokens from the owner address to the module account and burns them
+// RedeemTokens sends tokens from the owner or a minter address to the module account and burns them
 func (k Keeper) RedeemTokens(ctx sdk.Context, tokens sdk.Coin, owner sdk.AccAddress) error {
 	asset, found := k.GetAsset(ctx, tokens.Denom)
 	if !found {
 		return errorsmod.Wrapf(types.ErrAssetNotFound, "denom: %s", tokens.Denom)
 	}
-	if strings.Compare(owner.String(), asset.Owner) != 0 {
+	if !asset.HasRole(types.ROLE_MINTER, owner.String()) {
 		return errorsmod.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
 	}
 	if asset.Paused {
@@ -91,7 +99,7 @@ func (k Keeper) RedeemTokens(ctx sdk.Context, tokens sdk.Coin, owner sdk.AccAddr
 	return nil
 }
 
-// BlockAddress adds an address to the blocked list
+// BlockAddress adds an address to the blocked list. Only the owner or blocklister can block addresses.
 func (k Keeper) BlockAddress(ctx sdk.Context, denom string, owner, blockedAddress sdk.AccAddress) error {
 	asset, found := k.GetAsset(ctx, denom)
 	if !found {
@@ -100,7 +108,7 @@ func (k Keeper) BlockAddress(ctx sdk.Context, denom string, owner, blockedAddres
 	if !asset.Blockable {
 		return errorsmod.Wrap(types.ErrAssetUnblockable, denom)
 	}
-	if strings.Compare(owner.String(), asset.Owner) != 0 {
+	if !asset.HasRole(types.ROLE_BLOCKLISTER, owner.String()) {
 		return errorsmod.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
 	}
 	blocked, _ := k.checkBlockedAddress(asset, blockedAddress.String())
@@ -132,7 +140,7 @@ func (k Keeper) UnblockAddress(ctx sdk.Context, denom string, owner, addr sdk.Ac
 	if !asset.Blockable {
 		return errorsmod.Wrap(types.ErrAssetUnblockable, denom)
 	}
-	if strings.Compare(owner.String(), asset.Owner) != 0 {
+	if !asset.HasRole(types.ROLE_BLOCKLISTER, owner.String()) {
 		return errorsmod.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
 	}
 	blocked, i := k.checkBlockedAddress(asset, addr.String())
@@ -153,13 +161,13 @@ func (k Keeper) UnblockAddress(ctx sdk.Context, denom string, owner, addr sdk.Ac
 	return nil
 }
 
-// SetPauseStatus pauses/un-pauses an asset
+// SetPauseStatus pauses/un-pauses an asset. Only the owner or pauser can change the pause status.
 func (k Keeper) SetPauseStatus(ctx sdk.Context, owner sdk.AccAddress, denom string, status bool) error {
 	asset, found := k.GetAsset(ctx, denom)
 	if !found {
 		return errorsmod.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
 	}
-	if strings.Compare(owner.String(), asset.Owner) != 0 {
+	if !asset.HasRole(types.ROLE_PAUSER, owner.String()) {
 		return errorsmod.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
 	}
 	if asset.Paused == status {
@@ -238,6 +246,43 @@ func (k Keeper) SeizeCoinsFromBlockedAddresses(ctx sdk.Context, denom string) er
 	return nil
 }
 
+// ValidateTransfer returns an error if coins of a paused asset are transferred, or if coins of a blockable asset are
+// transferred to or from a blocked address. Empty addresses are not checked, for transfers with only one side on chain.
+func (k Keeper) ValidateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	if coins.Empty() {
+		return nil
+	}
+	// Transfers are validated for every module, including those whose genesis is initialized before this module's.
+	var params types.Params
+	k.paramSubspace.GetParamSetIfExists(ctx, &params)
+	assets := make(map[string]types.Asset, len(params.Assets))
+	for _, asset := range params.Assets {
+		assets[asset.Denom] = asset
+	}
+
+	for _, coin := range coins {
+		asset, found := assets[coin.Denom]
+		if !found {
+			continue
+		}
+		if asset.Paused {
+			return errorsmod.Wrapf(types.ErrAssetPaused, "denom: %s", coin.Denom)
+		}
+		if !asset.Blockable {
+			continue
+		}
+		for _, addr := range []sdk.AccAddress{from, to} {
+			if addr.Empty() {
+				continue
+			}
+			if blocked, _ := k.checkBlockedAddress(asset, addr.String()); blocked {
+				return errorsmod.Wrapf(types.ErrAccountBlocked, "address: %s", addr)
+			}
+		}
+	}
+	return nil
+}
+
 func (k Keeper) checkBlockedAddress(asset types.Asset, checkAddress string) (bool, int) {
 	for i, address := range asset.BlockedAddresses {
 		if strings.Compare(address, checkAddress) == 0 {
@@ -383,14 +383,15 @@ func (suite *KeeperTestSuite) TestRedeemTokens() {
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
-			params := types.NewParams(tc.args.assets)
-			suite.keeper.SetParams(suite.ctx, params)
 			sk := suite.tApp.GetBankKeeper()
 			err := sk.MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(tc.args.initialTokens))
 			suite.Require().NoError(err)
 			sender, _ := sdk.AccAddressFromBech32(tc.args.sender)
 			err = sk.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleAccountName, sender, sdk.NewCoins(tc.args.initialTokens))
 			suite.Require().NoError(err)
+			// params are set after funding, as transfers of paused assets are rejected
+			params := types.NewParams(tc.args.assets)
+			suite.keeper.SetParams(suite.ctx, params)
 
 			err = suite.keeper.RedeemTokens(suite.ctx, tc.args.redeemTokens, sender)
 
@@ -773,15 +774,17 @@ func (suite *KeeperTestSuite) TestSeizeCoinsFromBlockedAddress() {
 				asset.BlockedAddresses = tc.args.blockedAddrs
 				assetsWithBlockedAddrs = append(assetsWithBlockedAddrs, asset)
 			}
-			params := types.NewParams(assetsWithBlockedAddrs)
-			suite.keeper.SetParams(suite.ctx, params)
 			sk := suite.tApp.GetBankKeeper()
 			for _, addrStr := range tc.args.blockedAddrs {
 				addr, _ := sdk.AccAddressFromBech32(addrStr)
 				err := sk.MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(tc.args.initialCoins))
 				suite.Require().NoError(err)
 				err = sk.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleAccountName, addr, sdk.NewCoins(tc.args.initialCoins))
+				suite.Require().NoError(err)
 			}
+			// params are set after funding, as transfers to blocked addresses are rejected
+			params := types.NewParams(assetsWithBlockedAddrs)
+			suite.keeper.SetParams(suite.ctx, params)
 
 			err := suite.keeper.SeizeCoinsFromBlockedAddresses(suite.ctx, tc.args.denom)
 			if tc.errArgs.expectPass {
@@ -799,6 +802,132 @@ func (suite *KeeperTestSuite) TestSeizeCoinsFromBlockedAddress() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestValidateTransfer() {
+	type args struct {
+		assets []types.Asset
+		from   string
+		to     string
+		coins  sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	testCases := []struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}{
+		{
+			"valid transfer",
+			args{
+				assets: []types.Asset{
+					types.NewAsset(suite.addrs[0], "usdtoken", []string{suite.addrs[3]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				from:  suite.addrs[1],
+				to:    suite.addrs[2],
+				coins: sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 100)),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid transfer of non-issuance denom",
+			args{
+				assets: []types.Asset{
+					types.NewAsset(suite.addrs[0], "usdtoken", []string{suite.addrs[1]}, true, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				from:  suite.addrs[1],
+				to:    suite.addrs[2],
+				coins: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid transfer with empty receiver",
+			args{
+				assets: []types.Asset{
+					types.NewAsset(suite.addrs[0], "usdtoken", []string{suite.addrs[3]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				from:  suite.addrs[1],
+				to:    "",
+				coins: sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 100)),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"invalid blocked sender",
+			args{
+				assets: []types.Asset{
+					types.NewAsset(suite.addrs[0], "usdtoken", []string{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				from:  suite.addrs[1],
+				to:    suite.addrs[2],
+				coins: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100), sdk.NewInt64Coin("usdtoken", 100)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "account is blocked",
+			},
+		},
+		{
+			"invalid blocked receiver",
+			args{
+				assets: []types.Asset{
+					types.NewAsset(suite.addrs[0], "usdtoken", []string{suite.addrs[2]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				from:  suite.addrs[1],
+				to:    suite.addrs[2],
+				coins: sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 100)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "account is blocked",
+			},
+		},
+		{
+			"invalid paused asset",
+			args{
+				assets: []types.Asset{
+					types.NewAsset(suite.addrs[0], "usdtoken", []string{}, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				from:  suite.addrs[1],
+				to:    suite.addrs[2],
+				coins: sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 100)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "asset is paused",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := types.NewParams(tc.args.assets)
+			suite.keeper.SetParams(suite.ctx, params)
+
+			from, _ := sdk.AccAddressFromBech32(tc.args.from)
+			to, _ := sdk.AccAddressFromBech32(tc.args.to)
+			err := suite.keeper.ValidateTransfer(suite.ctx, from, to, tc.args.coins)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+			}
+		})
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
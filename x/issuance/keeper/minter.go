@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+// GetMinterAllowance gets a minter's remaining allowance for an asset from the store.
+func (k Keeper) GetMinterAllowance(ctx sdk.Context, denom string, minter sdk.AccAddress) (types.MinterAllowance, bool) {
+	var allowance types.MinterAllowance
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MinterAllowancePrefix)
+	bz := store.Get(types.GetMinterAllowanceKey(denom, minter))
+	if bz == nil {
+		return types.MinterAllowance{}, false
+	}
+	k.cdc.MustUnmarshal(bz, &allowance)
+	return allowance, true
+}
+
+// SetMinterAllowance updates a minter's remaining allowance for an asset
+func (k Keeper) SetMinterAllowance(ctx sdk.Context, allowance types.MinterAllowance) {
+	minter, err := sdk.AccAddressFromBech32(allowance.Minter)
+	if err != nil {
+		panic(err)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MinterAllowancePrefix)
+	store.Set(types.GetMinterAllowanceKey(allowance.Denom, minter), k.cdc.MustMarshal(&allowance))
+}
+
+// DeleteMinterAllowance removes a minter's allowance for an asset from the store
+func (k Keeper) DeleteMinterAllowance(ctx sdk.Context, denom string, minter sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MinterAllowancePrefix)
+	store.Delete(types.GetMinterAllowanceKey(denom, minter))
+}
+
+// IterateMinterAllowances provides an iterator over all stored minter allowances.
+func (k Keeper) IterateMinterAllowances(ctx sdk.Context, cb func(allowance types.MinterAllowance) (stop bool)) {
+	k.iterateMinterAllowances(ctx, types.MinterAllowancePrefix, cb)
+}
+
+// GetAllMinterAllowances returns all minter allowances from the store
+func (k Keeper) GetAllMinterAllowances(ctx sdk.Context) (allowances []types.MinterAllowance) {
+	k.IterateMinterAllowances(ctx, func(allowance types.MinterAllowance) bool {
+		allowances = append(allowances, allowance)
+		return false
+	})
+	return
+}
+
+// GetMinterAllowancesByDenom returns all minter allowances of an asset from the store
+func (k Keeper) GetMinterAllowancesByDenom(ctx sdk.Context, denom string) (allowances []types.MinterAllowance) {
+	storePrefix := append(types.MinterAllowancePrefix, types.GetMinterAllowanceDenomPrefix(denom)...)
+	k.iterateMinterAllowances(ctx, storePrefix, func(allowance types.MinterAllowance) bool {
+		allowances = append(allowances, allowance)
+		return false
+	})
+	return
+}
+
+func (k Keeper) iterateMinterAllowances(ctx sdk.Context, storePrefix []byte, cb func(allowance types.MinterAllowance) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), storePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.MinterAllowance
+		k.cdc.MustUnmarshal(iterator.Value(), &allowance)
+		if cb(allowance) {
+			break
+		}
+	}
+}
+
+// GrantRole assigns a role for an asset to an address.
+// The master minter, pauser, and blocklister roles are granted by the owner and replace the current holder.
+// Minters are added by the master minter, starting with a zero allowance.
+func (k Keeper) GrantRole(ctx sdk.Context, sender sdk.AccAddress, denom string, role types.Role, addr sdk.AccAddress) error {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	if err := k.checkRoleAdmin(asset, sender, role); err != nil {
+		return err
+	}
+
+	address := addr.String()
+	switch role {
+	case types.ROLE_MASTER_MINTER:
+		if asset.MasterMinter == address {
+			return errorsmod.Wrapf(types.ErrRoleAlreadyGranted, "role: %s, address: %s", role, address)
+		}
+		asset.MasterMinter = address
+	case types.ROLE_MINTER:
+		if asset.IsMinter(address) {
+			return errorsmod.Wrapf(types.ErrRoleAlreadyGranted, "role: %s, address: %s", role, address)
+		}
+		asset.Minters = append(asset.Minters, address)
+		k.SetMinterAllowance(ctx, types.NewMinterAllowance(denom, address, sdkmath.ZeroInt()))
+	case types.ROLE_PAUSER:
+		if asset.Pauser == address {
+			return errorsmod.Wrapf(types.ErrRoleAlreadyGranted, "role: %s, address: %s", role, address)
+		}
+		asset.Pauser = address
+	case types.ROLE_BLOCKLISTER:
+		if asset.Blocklister == address {
+			return errorsmod.Wrapf(types.ErrRoleAlreadyGranted, "role: %s, address: %s", role, address)
+		}
+		asset.Blocklister = address
+	}
+	k.SetAsset(ctx, asset)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantRole,
+			sdk.NewAttribute(types.AttributeKeyRole, role.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+		),
+	)
+	return nil
+}
+
+// RevokeRole removes a role for an asset from an address. Revoking a minter also removes its allowance.
+func (k Keeper) RevokeRole(ctx sdk.Context, sender sdk.AccAddress, denom string, role types.Role, addr sdk.AccAddress) error {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	if err := k.checkRoleAdmin(asset, sender, role); err != nil {
+		return err
+	}
+
+	address := addr.String()
+	switch role {
+	case types.ROLE_MASTER_MINTER:
+		if asset.MasterMinter != address {
+			return errorsmod.Wrapf(types.ErrRoleNotGranted, "role: %s, address: %s", role, address)
+		}
+		asset.MasterMinter = ""
+	case types.ROLE_MINTER:
+		if !asset.IsMinter(address) {
+			return errorsmod.Wrapf(types.ErrRoleNotGranted, "role: %s, address: %s", role, address)
+		}
+		minters := make([]string, 0, len(asset.Minters)-1)
+		for _, minter := range asset.Minters {
+			if minter != address {
+				minters = append(minters, minter)
+			}
+		}
+		asset.Minters = minters
+		k.DeleteMinterAllowance(ctx, denom, addr)
+	case types.ROLE_PAUSER:
+		if asset.Pauser != address {
+			return errorsmod.Wrapf(types.ErrRoleNotGranted, "role: %s, address: %s", role, address)
+		}
+		asset.Pauser = ""
+	case types.ROLE_BLOCKLISTER:
+		if asset.Blocklister != address {
+			return errorsmod.Wrapf(types.ErrRoleNotGranted, "role: %s, address: %s", role, address)
+		}
+		asset.Blocklister = ""
+	}
+	k.SetAsset(ctx, asset)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeRole,
+			sdk.NewAttribute(types.AttributeKeyRole, role.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+		),
+	)
+	return nil
+}
+
+// ConfigureMinterAllowance sets the amount of an asset a minter is allowed to issue
+func (k Keeper) ConfigureMinterAllowance(ctx sdk.Context, sender sdk.AccAddress, denom string, minter sdk.AccAddress, allowance sdkmath.Int) error {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	if !asset.HasRole(types.ROLE_MASTER_MINTER, sender.String()) {
+		return errorsmod.Wrapf(types.ErrNotAuthorized, "address: %s is not the master minter of %s", sender, denom)
+	}
+	if !asset.IsMinter(minter.String()) {
+		return errorsmod.Wrapf(types.ErrRoleNotGranted, "role: %s, address: %s", types.ROLE_MINTER, minter)
+	}
+
+	k.SetMinterAllowance(ctx, types.NewMinterAllowance(denom, minter.String(), allowance))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMinterAllowance,
+			sdk.NewAttribute(types.AttributeKeyMinter, minter.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowance, allowance.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+		),
+	)
+	return nil
+}
+
+// consumeMinterAllowance reduces a minter's allowance by the issued amount, returning an error if the allowance is insufficient
+func (k Keeper) consumeMinterAllowance(ctx sdk.Context, minter sdk.AccAddress, tokens sdk.Coin) error {
+	allowance, found := k.GetMinterAllowance(ctx, tokens.Denom, minter)
+	if !found || allowance.Allowance.LT(tokens.Amount) {
+		remaining := sdkmath.ZeroInt()
+		if found {
+			remaining = allowance.Allowance
+		}
+		return errorsmod.Wrapf(types.ErrExceedsMinterAllowance, "allowance: %s, amount: %s", remaining, tokens.Amount)
+	}
+	allowance.Allowance = allowance.Allowance.Sub(tokens.Amount)
+	k.SetMinterAllowance(ctx, allowance)
+	return nil
+}
+
+// checkRoleAdmin returns an error if the sender cannot grant or revoke the role.
+// Minters are managed by the master minter, all other roles by the owner.
+func (k Keeper) checkRoleAdmin(asset types.Asset, sender sdk.AccAddress, role types.Role) error {
+	if err := role.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrNotAuthorized, err.Error())
+	}
+	if role == types.ROLE_MINTER {
+		if !asset.HasRole(types.ROLE_MASTER_MINTER, sender.String()) {
+			return errorsmod.Wrapf(types.ErrNotAuthorized, "address: %s is not the master minter of %s", sender, asset.Denom)
+		}
+		return nil
+	}
+	if sender.String() != asset.Owner {
+		return errorsmod.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, sender)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+func (suite *KeeperTestSuite) setupRoleAsset() types.Asset {
+	asset := types.NewAsset(suite.addrs[0], "usdtoken", []string{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+	asset.MasterMinter = suite.addrs[1]
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Asset{asset}))
+	return asset
+}
+
+func (suite *KeeperTestSuite) mustAddr(addr string) sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(addr)
+	suite.Require().NoError(err)
+	return acc
+}
+
+func (suite *KeeperTestSuite) TestGrantRole() {
+	testCases := []struct {
+		name     string
+		sender   string
+		role     types.Role
+		address  string
+		contains string
+	}{
+		{"owner grants pauser", suite.addrs[0], types.ROLE_PAUSER, suite.addrs[3], ""},
+		{"owner grants blocklister", suite.addrs[0], types.ROLE_BLOCKLISTER, suite.addrs[3], ""},
+		{"owner replaces master minter", suite.addrs[0], types.ROLE_MASTER_MINTER, suite.addrs[3], ""},
+		{"owner grants minter", suite.addrs[0], types.ROLE_MINTER, suite.addrs[3], ""},
+		{"master minter grants minter", suite.addrs[1], types.ROLE_MINTER, suite.addrs[3], ""},
+		{"master minter cannot grant pauser", suite.addrs[1], types.ROLE_PAUSER, suite.addrs[3], "account not authorized"},
+		{"other address cannot grant minter", suite.addrs[2], types.ROLE_MINTER, suite.addrs[3], "account not authorized"},
+		{"master minter already granted", suite.addrs[0], types.ROLE_MASTER_MINTER, suite.addrs[1], "role is already granted"},
+		{"unspecified role", suite.addrs[0], types.ROLE_UNSPECIFIED, suite.addrs[3], "invalid role"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupRoleAsset()
+
+			err := suite.keeper.GrantRole(suite.ctx, suite.mustAddr(tc.sender), "usdtoken", tc.role, suite.mustAddr(tc.address))
+			if tc.contains != "" {
+				suite.Require().ErrorContains(err, tc.contains)
+				return
+			}
+			suite.Require().NoError(err)
+			asset, found := suite.keeper.GetAsset(suite.ctx, "usdtoken")
+			suite.Require().True(found)
+			suite.Require().True(asset.HasRole(tc.role, tc.address))
+
+			if tc.role == types.ROLE_MINTER {
+				allowance, found := suite.keeper.GetMinterAllowance(suite.ctx, "usdtoken", suite.mustAddr(tc.address))
+				suite.Require().True(found)
+				suite.Require().True(allowance.Allowance.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevokeRole() {
+	suite.setupRoleAsset()
+	owner, masterMinter, minter, pauser := suite.mustAddr(suite.addrs[0]), suite.mustAddr(suite.addrs[1]), suite.mustAddr(suite.addrs[2]), suite.mustAddr(suite.addrs[3])
+
+	suite.Require().NoError(suite.keeper.GrantRole(suite.ctx, masterMinter, "usdtoken", types.ROLE_MINTER, minter))
+	suite.Require().NoError(suite.keeper.GrantRole(suite.ctx, owner, "usdtoken", types.ROLE_PAUSER, pauser))
+
+	err := suite.keeper.RevokeRole(suite.ctx, pauser, "usdtoken", types.ROLE_PAUSER, pauser)
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+	err = suite.keeper.RevokeRole(suite.ctx, masterMinter, "usdtoken", types.ROLE_MINTER, pauser)
+	suite.Require().ErrorIs(err, types.ErrRoleNotGranted)
+
+	suite.Require().NoError(suite.keeper.RevokeRole(suite.ctx, owner, "usdtoken", types.ROLE_PAUSER, pauser))
+	suite.Require().NoError(suite.keeper.RevokeRole(suite.ctx, masterMinter, "usdtoken", types.ROLE_MINTER, minter))
+
+	asset, found := suite.keeper.GetAsset(suite.ctx, "usdtoken")
+	suite.Require().True(found)
+	suite.Require().Empty(asset.Pauser)
+	suite.Require().Empty(asset.Minters)
+	_, found = suite.keeper.GetMinterAllowance(suite.ctx, "usdtoken", minter)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSynchronizeMinterAllowances() {
+	asset := suite.setupRoleAsset()
+	masterMinter, minter := suite.mustAddr(suite.addrs[1]), suite.mustAddr(suite.addrs[2])
+	suite.Require().NoError(suite.keeper.GrantRole(suite.ctx, masterMinter, "usdtoken", types.ROLE_MINTER, minter))
+	suite.Require().NoError(suite.keeper.ConfigureMinterAllowance(suite.ctx, masterMinter, "usdtoken", minter, sdkmath.NewInt(100)))
+
+	// a param change that does not include the minter removes its allowance
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Asset{asset}))
+	_, found := suite.keeper.GetMinterAllowance(suite.ctx, "usdtoken", minter)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestIssueTokensWithMinterAllowance() {
+	suite.setupRoleAsset()
+	owner, masterMinter, minter, receiver := suite.mustAddr(suite.addrs[0]), suite.mustAddr(suite.addrs[1]), suite.mustAddr(suite.addrs[2]), suite.mustAddr(suite.addrs[3])
+
+	// only minters can have an allowance configured
+	err := suite.keeper.ConfigureMinterAllowance(suite.ctx, masterMinter, "usdtoken", minter, sdkmath.NewInt(100))
+	suite.Require().ErrorIs(err, types.ErrRoleNotGranted)
+
+	// minters cannot issue before the role is granted
+	err = suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 10), minter, receiver)
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+
+	suite.Require().NoError(suite.keeper.GrantRole(suite.ctx, masterMinter, "usdtoken", types.ROLE_MINTER, minter))
+	err = suite.keeper.ConfigureMinterAllowance(suite.ctx, minter, "usdtoken", minter, sdkmath.NewInt(100))
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+	suite.Require().NoError(suite.keeper.ConfigureMinterAllowance(suite.ctx, masterMinter, "usdtoken", minter, sdkmath.NewInt(100)))
+
+	suite.Require().NoError(suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 60), minter, receiver))
+	allowance, found := suite.keeper.GetMinterAllowance(suite.ctx, "usdtoken", minter)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(40), allowance.Allowance)
+	suite.Require().Equal(sdk.NewInt64Coin("usdtoken", 60), suite.getBalance(receiver, "usdtoken"))
+
+	err = suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 41), minter, receiver)
+	suite.Require().ErrorIs(err, types.ErrExceedsMinterAllowance)
+
+	// the owner is not limited by an allowance
+	suite.Require().NoError(suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 1000), owner, receiver))
+	suite.Require().Equal(sdk.NewInt64Coin("usdtoken", 1060), suite.getBalance(receiver, "usdtoken"))
+}
+
+func (suite *KeeperTestSuite) TestRoleAuthorization() {
+	suite.setupRoleAsset()
+	owner, pauser, blocklister, other := suite.mustAddr(suite.addrs[0]), suite.mustAddr(suite.addrs[2]), suite.mustAddr(suite.addrs[3]), suite.mustAddr(suite.addrs[4])
+
+	suite.Require().NoError(suite.keeper.GrantRole(suite.ctx, owner, "usdtoken", types.ROLE_PAUSER, pauser))
+	suite.Require().NoError(suite.keeper.GrantRole(suite.ctx, owner, "usdtoken", types.ROLE_BLOCKLISTER, blocklister))
+
+	suite.Require().ErrorIs(suite.keeper.SetPauseStatus(suite.ctx, blocklister, "usdtoken", true), types.ErrNotAuthorized)
+	suite.Require().NoError(suite.keeper.SetPauseStatus(suite.ctx, pauser, "usdtoken", true))
+
+	suite.Require().ErrorIs(suite.keeper.BlockAddress(suite.ctx, "usdtoken", pauser, other), types.ErrNotAuthorized)
+	suite.Require().NoError(suite.keeper.BlockAddress(suite.ctx, "usdtoken", blocklister, other))
+	suite.Require().NoError(suite.keeper.UnblockAddress(suite.ctx, "usdtoken", blocklister, other))
+}
@@ -14,9 +14,10 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return p
 }
 
-// SetParams sets params on the store
+// SetParams sets params on the store, removing the allowances of minters no longer in the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
+	k.SynchronizeMinterAllowances(ctx)
 }
 
 // GetAsset returns an asset from the params and a boolean for if it was found
@@ -60,3 +61,29 @@ func (k Keeper) SynchronizeBlockList(ctx sdk.Context) {
 		}
 	}
 }
+
+// SynchronizeMinterAllowances removes the allowances of minters that are no longer minters of their asset, or whose
+// asset was removed - could happen if the assets are changed via governance
+func (k Keeper) SynchronizeMinterAllowances(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	assets := make(map[string]types.Asset, len(params.Assets))
+	for _, asset := range params.Assets {
+		assets[asset.Denom] = asset
+	}
+
+	var orphaned []types.MinterAllowance
+	k.IterateMinterAllowances(ctx, func(allowance types.MinterAllowance) bool {
+		asset, found := assets[allowance.Denom]
+		if !found || !asset.IsMinter(allowance.Minter) {
+			orphaned = append(orphaned, allowance)
+		}
+		return false
+	})
+	for _, allowance := range orphaned {
+		minter, err := sdk.AccAddressFromBech32(allowance.Minter)
+		if err != nil {
+			panic(err)
+		}
+		k.DeleteMinterAllowance(ctx, allowance.Denom, minter)
+	}
+}
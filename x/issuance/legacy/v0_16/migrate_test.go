@@ -89,7 +89,11 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 						"active": false,
 						"limit": "0",
 						"time_period": "0s"
-					}
+					},
+					"master_minter": "",
+					"minters": [],
+					"pauser": "",
+					"blocklister": ""
 				}
 			]
 		},
@@ -102,7 +106,8 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 				"current_supply": { "denom": "bnb", "amount": "300" },
 				"time_elapsed": "300s"
 			}
-		]
+		],
+		"minter_allowances": []
 	}`
 	actual := s.cdc.MustMarshalJSON(genstate)
 	s.Require().NoError(err)
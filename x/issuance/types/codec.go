@@ -22,6 +22,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBlockAddress{}, "issuance/MsgBlockAddress", nil)
 	cdc.RegisterConcrete(&MsgUnblockAddress{}, "issuance/MsgUnblockAddress", nil)
 	cdc.RegisterConcrete(&MsgSetPauseStatus{}, "issuance/MsgChangePauseStatus", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "issuance/MsgGrantRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "issuance/MsgRevokeRole", nil)
+	cdc.RegisterConcrete(&MsgConfigureMinterAllowance{}, "issuance/MsgConfigureMinterAllowance", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -31,6 +34,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBlockAddress{},
 		&MsgUnblockAddress{},
 		&MsgSetPauseStatus{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgConfigureMinterAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
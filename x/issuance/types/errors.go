@@ -16,4 +16,7 @@ var (
 	ErrExceedsSupplyLimit      = errorsmod.Register(ModuleName, 9, "asset supply over limit")
 	ErrAssetUnblockable        = errorsmod.Register(ModuleName, 10, "asset does not support block/unblock functionality")
 	ErrAccountNotFound         = errorsmod.Register(ModuleName, 11, "cannot block account that does not exist in state")
+	ErrRoleAlreadyGranted      = errorsmod.Register(ModuleName, 12, "role is already granted")
+	ErrRoleNotGranted          = errorsmod.Register(ModuleName, 13, "role is not granted")
+	ErrExceedsMinterAllowance  = errorsmod.Register(ModuleName, 14, "amount exceeds minter allowance")
 )
@@ -8,6 +8,9 @@ const (
 	EventTypeUnblock         = "unblock_address"
 	EventTypePause           = "change_pause_status"
 	EventTypeSeize           = "seize_coins_from_blocked_address"
+	EventTypeGrantRole       = "grant_role"
+	EventTypeRevokeRole      = "revoke_role"
+	EventTypeMinterAllowance = "configure_minter_allowance"
 	AttributeValueCategory   = ModuleName
 	AttributeKeyDenom        = "denom"
 	AttributeKeyIssueAmount  = "amount_issued"
@@ -16,4 +19,7 @@ const (
 	AttributeKeyUnblock      = "address_unblocked"
 	AttributeKeyAddress      = "address"
 	AttributeKeyPauseStatus  = "pause_status"
+	AttributeKeyRole         = "role"
+	AttributeKeyMinter       = "minter"
+	AttributeKeyAllowance    = "allowance"
 )
@@ -1,21 +1,25 @@
 package types
 
+import "fmt"
+
 // DefaultSupplies is used to set default asset supplies in default genesis state
 var DefaultSupplies = []AssetSupply{}
 
 // NewGenesisState returns a new GenesisState
-func NewGenesisState(params Params, supplies []AssetSupply) GenesisState {
+func NewGenesisState(params Params, supplies []AssetSupply, minterAllowances []MinterAllowance) GenesisState {
 	return GenesisState{
-		Params:   params,
-		Supplies: supplies,
+		Params:           params,
+		Supplies:         supplies,
+		MinterAllowances: minterAllowances,
 	}
 }
 
 // DefaultGenesisState returns the default GenesisState for the issuance module
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:   DefaultParams(),
-		Supplies: DefaultSupplies,
+		Params:           DefaultParams(),
+		Supplies:         DefaultSupplies,
+		MinterAllowances: DefaultMinterAllowances,
 	}
 }
 
@@ -28,5 +32,31 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	assets := make(map[string]Asset, len(gs.Params.Assets))
+	for _, asset := range gs.Params.Assets {
+		assets[asset.Denom] = asset
+	}
+	seen := make(map[string]bool, len(gs.MinterAllowances))
+	for _, allowance := range gs.MinterAllowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+		asset, found := assets[allowance.Denom]
+		if !found {
+			return fmt.Errorf("minter allowance for unknown asset %s", allowance.Denom)
+		}
+		if !asset.IsMinter(allowance.Minter) {
+			return fmt.Errorf("minter allowance for %s is not a minter of asset %s", allowance.Minter, allowance.Denom)
+		}
+		key := allowance.Denom + "/" + allowance.Minter
+		if seen[key] {
+			return fmt.Errorf("duplicate minter allowance for %s of asset %s", allowance.Minter, allowance.Denom)
+		}
+		seen[key] = true
+	}
+	return nil
 }
@@ -29,11 +29,52 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Role enumerates the roles that can be assigned for an asset.
+type Role int32
+
+const (
+	// ROLE_UNSPECIFIED defines a null role.
+	ROLE_UNSPECIFIED Role = 0
+	// ROLE_MASTER_MINTER manages the minters of an asset and their allowances.
+	ROLE_MASTER_MINTER Role = 1
+	// ROLE_MINTER issues tokens up to its allowance.
+	ROLE_MINTER Role = 2
+	// ROLE_PAUSER pauses and unpauses an asset.
+	ROLE_PAUSER Role = 3
+	// ROLE_BLOCKLISTER blocks and unblocks addresses.
+	ROLE_BLOCKLISTER Role = 4
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_MASTER_MINTER",
+	2: "ROLE_MINTER",
+	3: "ROLE_PAUSER",
+	4: "ROLE_BLOCKLISTER",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":   0,
+	"ROLE_MASTER_MINTER": 1,
+	"ROLE_MINTER":        2,
+	"ROLE_PAUSER":        3,
+	"ROLE_BLOCKLISTER":   4,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e567e34e5c078b96, []int{0}
+}
+
 // GenesisState defines the issuance module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params   Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Supplies []AssetSupply `protobuf:"bytes,2,rep,name=supplies,proto3" json:"supplies"`
+	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Supplies         []AssetSupply     `protobuf:"bytes,2,rep,name=supplies,proto3" json:"supplies"`
+	MinterAllowances []MinterAllowance `protobuf:"bytes,3,rep,name=minter_allowances,json=minterAllowances,proto3" json:"minter_allowances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,6 +124,13 @@ func (m *GenesisState) GetSupplies() []AssetSupply {
 	return nil
 }
 
+func (m *GenesisState) GetMinterAllowances() []MinterAllowance {
+	if m != nil {
+		return m.MinterAllowances
+	}
+	return nil
+}
+
 // Params defines the parameters for the issuance module.
 type Params struct {
 	Assets []Asset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets"`
@@ -135,6 +183,14 @@ type Asset struct {
 	Paused           bool      `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
 	Blockable        bool      `protobuf:"varint,5,opt,name=blockable,proto3" json:"blockable,omitempty"`
 	RateLimit        RateLimit `protobuf:"bytes,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// master_minter can add and remove minters and configure their allowances. The owner always holds every role.
+	MasterMinter string `protobuf:"bytes,7,opt,name=master_minter,json=masterMinter,proto3" json:"master_minter,omitempty"`
+	// minters can issue tokens up to their allowance, and redeem tokens.
+	Minters []string `protobuf:"bytes,8,rep,name=minters,proto3" json:"minters,omitempty"`
+	// pauser can pause and unpause the asset.
+	Pauser string `protobuf:"bytes,9,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	// blocklister can block and unblock addresses.
+	Blocklister string `protobuf:"bytes,10,opt,name=blocklister,proto3" json:"blocklister,omitempty"`
 }
 
 func (m *Asset) Reset()      { *m = Asset{} }
@@ -211,6 +267,34 @@ func (m *Asset) GetRateLimit() RateLimit {
 	return RateLimit{}
 }
 
+func (m *Asset) GetMasterMinter() string {
+	if m != nil {
+		return m.MasterMinter
+	}
+	return ""
+}
+
+func (m *Asset) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *Asset) GetPauser() string {
+	if m != nil {
+		return m.Pauser
+	}
+	return ""
+}
+
+func (m *Asset) GetBlocklister() string {
+	if m != nil {
+		return m.Blocklister
+	}
+	return ""
+}
+
 // RateLimit parameters for rate-limiting the supply of an issued asset
 type RateLimit struct {
 	Active     bool                                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
@@ -318,12 +402,67 @@ func (m *AssetSupply) GetTimeElapsed() time.Duration {
 	return 0
 }
 
+// MinterAllowance contains the remaining amount of an asset a minter is allowed to issue
+type MinterAllowance struct {
+	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Minter    string                                 `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Allowance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allowance"`
+}
+
+func (m *MinterAllowance) Reset()      { *m = MinterAllowance{} }
+func (*MinterAllowance) ProtoMessage() {}
+func (*MinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e567e34e5c078b96, []int{5}
+}
+func (m *MinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterAllowance.Merge(m, src)
+}
+func (m *MinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterAllowance proto.InternalMessageInfo
+
+func (m *MinterAllowance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MinterAllowance) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("kava.issuance.v1beta1.Role", Role_name, Role_value)
 	proto.RegisterType((*GenesisState)(nil), "kava.issuance.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "kava.issuance.v1beta1.Params")
 	proto.RegisterType((*Asset)(nil), "kava.issuance.v1beta1.Asset")
 	proto.RegisterType((*RateLimit)(nil), "kava.issuance.v1beta1.RateLimit")
 	proto.RegisterType((*AssetSupply)(nil), "kava.issuance.v1beta1.AssetSupply")
+	proto.RegisterType((*MinterAllowance)(nil), "kava.issuance.v1beta1.MinterAllowance")
 }
 
 func init() {
@@ -331,44 +470,57 @@ func init() {
 }
 
 var fileDescriptor_e567e34e5c078b96 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0x8d, 0x9b, 0x26, 0x4a, 0x36, 0xfd, 0xfd, 0x80, 0x55, 0x41, 0x6e, 0x55, 0x9c, 0x28, 0x48,
-	0x28, 0x02, 0xba, 0x56, 0xcb, 0xad, 0x9c, 0x1a, 0x52, 0x10, 0x88, 0x43, 0xe5, 0x1e, 0x90, 0xb8,
-	0x44, 0x6b, 0x7b, 0x30, 0xab, 0xda, 0x5e, 0xcb, 0xbb, 0x2e, 0xe4, 0x5b, 0xc0, 0xad, 0x47, 0x24,
-	0xbe, 0x09, 0xa7, 0x1e, 0x7b, 0x44, 0x1c, 0x02, 0x4a, 0x6e, 0x7c, 0x0a, 0xb4, 0x7f, 0x92, 0xf4,
-	0x40, 0x11, 0x27, 0xef, 0xcc, 0xbe, 0x37, 0x33, 0x6f, 0xfc, 0x16, 0xdd, 0x3b, 0xa5, 0x67, 0xd4,
-	0x67, 0x42, 0x54, 0x34, 0x8f, 0xc0, 0x3f, 0xdb, 0x0b, 0x41, 0xd2, 0x3d, 0x3f, 0x81, 0x1c, 0x04,
-	0x13, 0xa4, 0x28, 0xb9, 0xe4, 0xf8, 0xb6, 0x02, 0x91, 0x05, 0x88, 0x58, 0xd0, 0xb6, 0x17, 0x71,
-	0x91, 0x71, 0xe1, 0x87, 0x54, 0xac, 0x98, 0x11, 0x67, 0xb9, 0xa1, 0x6d, 0x6f, 0x26, 0x3c, 0xe1,
-	0xfa, 0xe8, 0xab, 0x93, 0xcd, 0x7a, 0x09, 0xe7, 0x49, 0x0a, 0xbe, 0x8e, 0xc2, 0xea, 0xad, 0x1f,
-	0x57, 0x25, 0x95, 0x8c, 0x5b, 0x56, 0xff, 0x93, 0x83, 0x36, 0x9e, 0x9b, 0xf6, 0x27, 0x92, 0x4a,
-	0xc0, 0x4f, 0x50, 0xb3, 0xa0, 0x25, 0xcd, 0x84, 0xeb, 0xf4, 0x9c, 0x41, 0x67, 0xff, 0x2e, 0xf9,
-	0xe3, 0x38, 0xe4, 0x58, 0x83, 0x86, 0xeb, 0x17, 0xd3, 0x6e, 0x2d, 0xb0, 0x14, 0x3c, 0x42, 0x2d,
-	0x51, 0x15, 0x45, 0xca, 0x40, 0xb8, 0x6b, 0xbd, 0xfa, 0xa0, 0xb3, 0xdf, 0xbf, 0x86, 0x7e, 0x28,
-	0x04, 0xc8, 0x13, 0x85, 0x9d, 0xd8, 0x1a, 0x4b, 0x66, 0xff, 0x25, 0x6a, 0x9a, 0xea, 0xf8, 0x00,
-	0x35, 0xa9, 0x02, 0xaa, 0x61, 0x54, 0xb5, 0x9d, 0xbf, 0x55, 0x5b, 0xcc, 0x62, 0x18, 0x07, 0xeb,
-	0xe7, 0x9f, 0xbb, 0xb5, 0xfe, 0xdc, 0x41, 0x0d, 0x7d, 0x8b, 0x37, 0x51, 0x83, 0xbf, 0xcf, 0xa1,
-	0xd4, 0xba, 0xda, 0x81, 0x09, 0x54, 0x36, 0x86, 0x9c, 0x67, 0xee, 0x9a, 0xc9, 0xea, 0x00, 0x3f,
-	0x44, 0xb7, 0xc2, 0x94, 0x47, 0xa7, 0x10, 0x8f, 0x69, 0x1c, 0x97, 0x20, 0x04, 0x08, 0xb7, 0xde,
-	0xab, 0x0f, 0xda, 0xc1, 0x4d, 0x7b, 0x71, 0xb8, 0xc8, 0xe3, 0x3b, 0x6a, 0x63, 0x95, 0x80, 0xd8,
-	0x5d, 0xef, 0x39, 0x83, 0x56, 0x60, 0x23, 0xbc, 0x83, 0xda, 0x1a, 0x4b, 0xc3, 0x14, 0xdc, 0x86,
-	0xbe, 0x5a, 0x25, 0xf0, 0x11, 0x42, 0x25, 0x95, 0x30, 0x4e, 0x59, 0xc6, 0xa4, 0xdb, 0xd4, 0xbb,
-	0xee, 0x5d, 0x23, 0x2f, 0xa0, 0x12, 0x5e, 0x29, 0x9c, 0x95, 0xd8, 0x2e, 0x17, 0x09, 0xab, 0xf2,
-	0xab, 0x83, 0xda, 0x4b, 0x90, 0x1a, 0x88, 0x46, 0x92, 0x9d, 0x81, 0x96, 0xda, 0x0a, 0x6c, 0x84,
-	0x5f, 0xa3, 0x86, 0xe9, 0xa6, 0xb4, 0x6e, 0x0c, 0x0f, 0x55, 0xad, 0xef, 0xd3, 0xee, 0xfd, 0x84,
-	0xc9, 0x77, 0x55, 0x48, 0x22, 0x9e, 0xf9, 0xd6, 0x63, 0xe6, 0xb3, 0x2b, 0xe2, 0x53, 0x5f, 0x4e,
-	0x0a, 0x10, 0xe4, 0x45, 0x2e, 0x7f, 0x4d, 0xbb, 0x37, 0x34, 0xfd, 0x11, 0xcf, 0x98, 0x84, 0xac,
-	0x90, 0x93, 0xc0, 0xd4, 0xc3, 0x23, 0xd4, 0x91, 0x2c, 0x83, 0x71, 0x01, 0x25, 0xe3, 0xb1, 0x5b,
-	0xd7, 0x62, 0xb6, 0x88, 0xb1, 0x1e, 0x59, 0x58, 0x8f, 0x8c, 0xac, 0xf5, 0x86, 0x2d, 0xd5, 0xf9,
-	0xfc, 0x47, 0xd7, 0x09, 0x90, 0xe2, 0x1d, 0x6b, 0x5a, 0xff, 0x8b, 0x83, 0x3a, 0x57, 0x6c, 0x81,
-	0x9f, 0xa1, 0xff, 0xa3, 0xaa, 0x2c, 0x21, 0x97, 0x63, 0x6d, 0x8d, 0x89, 0x75, 0xe4, 0x16, 0x31,
-	0xe3, 0x11, 0xf5, 0x12, 0x96, 0x3b, 0x7a, 0xca, 0x59, 0x6e, 0xd7, 0xf3, 0x9f, 0xa5, 0x2d, 0xeb,
-	0x6c, 0xe8, 0xe9, 0x20, 0xa5, 0x85, 0xfa, 0x4b, 0x6b, 0xff, 0x3e, 0x9e, 0x96, 0x75, 0x64, 0x78,
-	0x66, 0xd5, 0xc3, 0xd1, 0xc5, 0xcc, 0x73, 0x2e, 0x67, 0x9e, 0xf3, 0x73, 0xe6, 0x39, 0x1f, 0xe7,
-	0x5e, 0xed, 0x72, 0xee, 0xd5, 0xbe, 0xcd, 0xbd, 0xda, 0x9b, 0x07, 0x57, 0xf6, 0xa8, 0xfe, 0xe3,
-	0x6e, 0x4a, 0x43, 0xa1, 0x4f, 0xfe, 0x87, 0xd5, 0x9b, 0xd7, 0xfb, 0x0c, 0x9b, 0xba, 0xeb, 0xe3,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xa6, 0xf1, 0xda, 0x11, 0x04, 0x00, 0x00,
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0xf3, 0x8b, 0x64, 0x12, 0x4a, 0x18, 0x51, 0x64, 0x10, 0x75, 0xa2, 0x20, 0x21, 0x44,
+	0x8b, 0x2d, 0xe8, 0x8d, 0x9e, 0x12, 0x62, 0xaa, 0xb4, 0x01, 0x22, 0x07, 0x54, 0xb5, 0x97, 0x68,
+	0x1c, 0x4f, 0x53, 0x0b, 0xdb, 0x63, 0x79, 0x26, 0xd0, 0xfc, 0x07, 0x3d, 0x56, 0xea, 0x85, 0x63,
+	0x25, 0xfe, 0x93, 0x9e, 0x38, 0x72, 0xac, 0xaa, 0x8a, 0x56, 0x70, 0x5b, 0xed, 0x1f, 0xb1, 0x9a,
+	0x1f, 0x71, 0xb2, 0xab, 0x65, 0xb5, 0x7b, 0xca, 0xbc, 0x6f, 0xde, 0xf7, 0xfc, 0x7d, 0x6f, 0xde,
+	0x0b, 0xd8, 0xbe, 0x42, 0xd7, 0xc8, 0xf2, 0x29, 0x9d, 0xa0, 0x68, 0x84, 0xad, 0xeb, 0x03, 0x17,
+	0x33, 0x74, 0x60, 0x8d, 0x71, 0x84, 0xa9, 0x4f, 0xcd, 0x38, 0x21, 0x8c, 0xc0, 0xcf, 0x79, 0x92,
+	0x39, 0x4b, 0x32, 0x55, 0xd2, 0xa6, 0x31, 0x22, 0x34, 0x24, 0xd4, 0x72, 0x11, 0x9d, 0x33, 0x47,
+	0xc4, 0x8f, 0x24, 0x6d, 0x73, 0x6d, 0x4c, 0xc6, 0x44, 0x1c, 0x2d, 0x7e, 0x52, 0xa8, 0x31, 0x26,
+	0x64, 0x1c, 0x60, 0x4b, 0x44, 0xee, 0xe4, 0x67, 0xcb, 0x9b, 0x24, 0x88, 0xf9, 0x44, 0xb1, 0x9a,
+	0xaf, 0x35, 0x50, 0xfd, 0x56, 0x7e, 0x7e, 0xc0, 0x10, 0xc3, 0xf0, 0x1b, 0x50, 0x8c, 0x51, 0x82,
+	0x42, 0xaa, 0x6b, 0x0d, 0x6d, 0xb7, 0x72, 0xf8, 0x85, 0xf9, 0x5e, 0x39, 0x66, 0x5f, 0x24, 0xb5,
+	0xf3, 0xf7, 0x8f, 0xf5, 0x8c, 0xa3, 0x28, 0xb0, 0x03, 0x4a, 0x74, 0x12, 0xc7, 0x81, 0x8f, 0xa9,
+	0x9e, 0x6d, 0xe4, 0x76, 0x2b, 0x87, 0xcd, 0x17, 0xe8, 0x2d, 0x4a, 0x31, 0x1b, 0xf0, 0xdc, 0xa9,
+	0xaa, 0x91, 0x32, 0xe1, 0x8f, 0x60, 0x35, 0xf4, 0x23, 0x86, 0x93, 0x21, 0x0a, 0x02, 0x72, 0xc3,
+	0x79, 0x54, 0xcf, 0x89, 0x72, 0x3b, 0x2f, 0x94, 0x3b, 0x15, 0xf9, 0xad, 0x59, 0xba, 0x2a, 0x59,
+	0x0b, 0xdf, 0x86, 0x69, 0xf3, 0x3b, 0x50, 0x94, 0xc2, 0xe1, 0x11, 0x28, 0x22, 0xae, 0x81, 0xfb,
+	0xe4, 0x95, 0xb7, 0x3e, 0x24, 0x74, 0x66, 0x53, 0x32, 0x8e, 0xf2, 0xb7, 0x7f, 0xd6, 0x33, 0xcd,
+	0x7f, 0xb3, 0xa0, 0x20, 0x6e, 0xe1, 0x1a, 0x28, 0x90, 0x9b, 0x08, 0x27, 0xa2, 0x65, 0x65, 0x47,
+	0x06, 0x1c, 0xf5, 0x70, 0x44, 0x42, 0x3d, 0x2b, 0x51, 0x11, 0xc0, 0x2f, 0xc1, 0xaa, 0x1b, 0x90,
+	0xd1, 0x15, 0xf6, 0x86, 0xc8, 0xf3, 0x12, 0x4c, 0xa9, 0x32, 0x57, 0x76, 0x6a, 0xea, 0xa2, 0x35,
+	0xc3, 0xe1, 0x3a, 0x7f, 0x8c, 0x09, 0xc5, 0x9e, 0x9e, 0x6f, 0x68, 0xbb, 0x25, 0x47, 0x45, 0x70,
+	0x0b, 0x94, 0x45, 0x2e, 0x72, 0x03, 0xac, 0x17, 0xc4, 0xd5, 0x1c, 0x80, 0x36, 0x00, 0x09, 0x62,
+	0x78, 0x18, 0xf8, 0xa1, 0xcf, 0xf4, 0xa2, 0x78, 0xc6, 0xc6, 0x0b, 0xf6, 0x1c, 0xc4, 0x70, 0x8f,
+	0xe7, 0x29, 0x8b, 0xe5, 0x64, 0x06, 0xc0, 0x6d, 0xb0, 0x1c, 0x22, 0xca, 0x9f, 0x41, 0xb6, 0x51,
+	0x5f, 0x12, 0x3e, 0xaa, 0x12, 0x94, 0x1d, 0x87, 0x3a, 0x58, 0x92, 0xb7, 0x54, 0x2f, 0x09, 0x13,
+	0xb3, 0x30, 0xd5, 0x9e, 0xe8, 0x65, 0xc1, 0x53, 0x11, 0x6c, 0x80, 0x8a, 0x90, 0x1a, 0xf8, 0xbc,
+	0x8c, 0x0e, 0xc4, 0xe5, 0x22, 0xa4, 0xda, 0xfb, 0x97, 0x06, 0xca, 0xa9, 0x3a, 0x5e, 0x0d, 0x8d,
+	0x98, 0x7f, 0x8d, 0x45, 0x8f, 0x4b, 0x8e, 0x8a, 0xe0, 0x0f, 0xa0, 0x20, 0x6d, 0xf2, 0x26, 0x57,
+	0xdb, 0x2d, 0x6e, 0xe2, 0x9f, 0xc7, 0xfa, 0xce, 0xd8, 0x67, 0xbf, 0x4c, 0x5c, 0x73, 0x44, 0x42,
+	0x4b, 0xed, 0x8d, 0xfc, 0xd9, 0xa7, 0xde, 0x95, 0xc5, 0xa6, 0x31, 0xa6, 0x66, 0x37, 0x62, 0xaf,
+	0x1e, 0xeb, 0x2b, 0x82, 0xfe, 0x15, 0x09, 0x7d, 0x86, 0xc3, 0x98, 0x4d, 0x1d, 0x59, 0x0f, 0x76,
+	0x40, 0x85, 0xf9, 0x21, 0x1e, 0xc6, 0x38, 0xf1, 0x89, 0xa7, 0xe7, 0x44, 0x17, 0x37, 0x4c, 0xb9,
+	0x4e, 0xe6, 0x6c, 0x9d, 0xcc, 0x8e, 0x5a, 0xa7, 0x76, 0x89, 0x7f, 0xf9, 0xf6, 0xbf, 0xba, 0xe6,
+	0x00, 0xce, 0xeb, 0x0b, 0x5a, 0xf3, 0x4e, 0x03, 0x95, 0x85, 0x51, 0x87, 0x27, 0xe0, 0xb3, 0xd1,
+	0x24, 0x49, 0x70, 0xc4, 0x86, 0x62, 0xdc, 0xa7, 0x6a, 0xcb, 0x36, 0x4c, 0x29, 0xcf, 0xe4, 0xdb,
+	0x9d, 0x3e, 0xce, 0x31, 0xf1, 0x23, 0xf5, 0x2e, 0xcb, 0x8a, 0x96, 0xd6, 0xa9, 0x0a, 0x75, 0x38,
+	0x40, 0x31, 0x1f, 0x8f, 0xec, 0xc7, 0xcb, 0x13, 0xb6, 0x6c, 0xc9, 0x53, 0xad, 0xfe, 0x43, 0x03,
+	0x2b, 0xef, 0x6c, 0xd0, 0x7c, 0x7a, 0xb5, 0xc5, 0xe9, 0x5d, 0x07, 0x45, 0x35, 0x0c, 0x72, 0xa8,
+	0x55, 0x04, 0x7b, 0xa0, 0x9c, 0xee, 0xaa, 0xe8, 0x55, 0xb5, 0x6d, 0x7e, 0xda, 0x53, 0x38, 0xf3,
+	0x02, 0x52, 0xd5, 0x5e, 0x02, 0xf2, 0x0e, 0x09, 0xb8, 0x92, 0x9a, 0x73, 0xde, 0xb3, 0x87, 0x97,
+	0x67, 0x83, 0xbe, 0x7d, 0xdc, 0x3d, 0xe9, 0xda, 0x9d, 0x5a, 0x06, 0xae, 0x03, 0x28, 0xd0, 0xd3,
+	0xd6, 0xe0, 0xc2, 0x76, 0x86, 0xa7, 0xdd, 0xb3, 0x0b, 0xdb, 0xa9, 0x69, 0x70, 0x05, 0x54, 0x24,
+	0x2e, 0x81, 0x6c, 0x0a, 0xf4, 0x5b, 0x97, 0x03, 0xdb, 0xa9, 0xe5, 0xd2, 0x7a, 0xed, 0xde, 0xf9,
+	0xf1, 0xf7, 0xbd, 0x2e, 0xa7, 0xd7, 0xf2, 0x9b, 0xf9, 0xdf, 0xee, 0x8c, 0x4c, 0xbb, 0x73, 0xff,
+	0x64, 0x68, 0x0f, 0x4f, 0x86, 0xf6, 0xff, 0x93, 0xa1, 0xfd, 0xfe, 0x6c, 0x64, 0x1e, 0x9e, 0x8d,
+	0xcc, 0xdf, 0xcf, 0x46, 0xe6, 0xa7, 0xbd, 0x05, 0x1b, 0x7c, 0x95, 0xf6, 0x03, 0xe4, 0x52, 0x71,
+	0xb2, 0x7e, 0x9d, 0xff, 0xa3, 0x0b, 0x3b, 0x6e, 0x51, 0xf4, 0xff, 0xeb, 0x37, 0x03, 0x00, 0xc9,
+	0x8d, 0xe5, 0xff, 0xef, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -391,6 +543,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinterAllowances) > 0 {
+		for iNdEx := len(m.MinterAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Supplies) > 0 {
 		for iNdEx := len(m.Supplies) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -475,6 +641,36 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Blocklister) > 0 {
+		i -= len(m.Blocklister)
+		copy(dAtA[i:], m.Blocklister)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Blocklister)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Pauser) > 0 {
+		i -= len(m.Pauser)
+		copy(dAtA[i:], m.Pauser)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Pauser)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MasterMinter) > 0 {
+		i -= len(m.MasterMinter)
+		copy(dAtA[i:], m.MasterMinter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MasterMinter)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
 		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -623,6 +819,53 @@ func (m *AssetSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -648,6 +891,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MinterAllowances) > 0 {
+		for _, e := range m.MinterAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -694,6 +943,24 @@ func (m *Asset) Size() (n int) {
 	}
 	l = m.RateLimit.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.MasterMinter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Pauser)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Blocklister)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -726,6 +993,25 @@ func (m *AssetSupply) Size() (n int) {
 	return n
 }
 
+func (m *MinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -828,6 +1114,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAllowances = append(m.MinterAllowances, MinterAllowance{})
+			if err := m.MinterAllowances[len(m.MinterAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1131,6 +1451,134 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterMinter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocklister", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocklister = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1404,6 +1852,153 @@ func (m *AssetSupply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -187,10 +187,40 @@ func (suite *GenesisTestSuite) TestValidate() {
 				contains:   "blocked-list should be empty",
 			},
 		},
+		{
+			"invalid minter address",
+			args{
+				assets: []types.Asset{func() types.Asset {
+					asset := types.NewAsset(suite.addrs[0], "usdtoken", []string{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+					asset.Minters = []string{"not-an-address"}
+					return asset
+				}()},
+				supplies: []types.AssetSupply{},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "invalid minter address",
+			},
+		},
+		{
+			"invalid pauser address",
+			args{
+				assets: []types.Asset{func() types.Asset {
+					asset := types.NewAsset(suite.addrs[0], "usdtoken", []string{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+					asset.Pauser = "not-an-address"
+					return asset
+				}()},
+				supplies: []types.AssetSupply{},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "invalid pauser address",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			gs := types.NewGenesisState(types.NewParams(tc.args.assets), tc.args.supplies)
+			gs := types.NewGenesisState(types.NewParams(tc.args.assets), tc.args.supplies, types.DefaultMinterAllowances)
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err, tc.name)
@@ -202,6 +232,57 @@ func (suite *GenesisTestSuite) TestValidate() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestValidateMinterAllowances() {
+	asset := types.NewAsset(suite.addrs[0], "usdtoken", []string{}, false, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+	asset.Minters = []string{suite.addrs[1]}
+
+	testCases := []struct {
+		name       string
+		allowances []types.MinterAllowance
+		contains   string
+	}{
+		{
+			"valid",
+			[]types.MinterAllowance{types.NewMinterAllowance("usdtoken", suite.addrs[1], sdkmath.NewInt(100))},
+			"",
+		},
+		{
+			"negative allowance",
+			[]types.MinterAllowance{types.NewMinterAllowance("usdtoken", suite.addrs[1], sdkmath.NewInt(-1))},
+			"must be non-negative",
+		},
+		{
+			"unknown asset",
+			[]types.MinterAllowance{types.NewMinterAllowance("othertoken", suite.addrs[1], sdkmath.NewInt(100))},
+			"unknown asset",
+		},
+		{
+			"not a minter",
+			[]types.MinterAllowance{types.NewMinterAllowance("usdtoken", suite.addrs[0], sdkmath.NewInt(100))},
+			"is not a minter",
+		},
+		{
+			"duplicate allowance",
+			[]types.MinterAllowance{
+				types.NewMinterAllowance("usdtoken", suite.addrs[1], sdkmath.NewInt(100)),
+				types.NewMinterAllowance("usdtoken", suite.addrs[1], sdkmath.NewInt(200)),
+			},
+			"duplicate minter allowance",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			gs := types.NewGenesisState(types.NewParams([]types.Asset{asset}), types.DefaultSupplies, tc.allowances)
+			err := gs.Validate()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.contains)
+			}
+		})
+	}
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
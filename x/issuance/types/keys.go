@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "issuance"
@@ -16,6 +21,17 @@ const (
 
 // KVStore key prefixes
 var (
-	AssetSupplyPrefix    = []byte{0x01}
-	PreviousBlockTimeKey = []byte{0x02}
+	AssetSupplyPrefix     = []byte{0x01}
+	PreviousBlockTimeKey  = []byte{0x02}
+	MinterAllowancePrefix = []byte{0x03}
 )
+
+// GetMinterAllowanceKey returns the key of a minter's allowance for an asset, prefixed by the asset's denom
+func GetMinterAllowanceKey(denom string, minter sdk.AccAddress) []byte {
+	return append(GetMinterAllowanceDenomPrefix(denom), minter...)
+}
+
+// GetMinterAllowanceDenomPrefix returns the prefix of all minter allowances for an asset
+func GetMinterAllowanceDenomPrefix(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
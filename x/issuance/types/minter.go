@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMinterAllowances is used to set default minter allowances in default genesis state
+var DefaultMinterAllowances = []MinterAllowance{}
+
+// NewMinterAllowance returns a new MinterAllowance
+func NewMinterAllowance(denom string, minter string, allowance sdkmath.Int) MinterAllowance {
+	return MinterAllowance{
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: allowance,
+	}
+}
+
+// Validate performs a basic validation of minter allowance fields.
+func (ma MinterAllowance) Validate() error {
+	if err := sdk.ValidateDenom(ma.Denom); err != nil {
+		return err
+	}
+	if len(ma.Minter) == 0 {
+		return fmt.Errorf("minter must not be empty")
+	}
+	if ma.Allowance.IsNil() || ma.Allowance.IsNegative() {
+		return fmt.Errorf("minter allowance must be non-negative: %s", ma.Allowance)
+	}
+	return nil
+}
+
+// String implements stringer
+func (ma MinterAllowance) String() string {
+	return fmt.Sprintf(`
+	minter allowance:
+		Denom:     %s
+		Minter:    %s
+		Allowance: %s
+		`,
+		ma.Denom, ma.Minter, ma.Allowance)
+}
+
+// Validate checks that the role is a known role.
+func (r Role) Validate() error {
+	switch r {
+	case ROLE_MASTER_MINTER, ROLE_MINTER, ROLE_PAUSER, ROLE_BLOCKLISTER:
+		return nil
+	default:
+		return fmt.Errorf("invalid role: %s", r)
+	}
+}
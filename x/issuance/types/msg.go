@@ -2,16 +2,20 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
-	TypeMsgIssueTokens    = "issue_tokens"
-	TypeMsgRedeemTokens   = "redeem_tokens"
-	TypeMsgBlockAddress   = "block_address"
-	TypeMsgUnBlockAddress = "unblock_address"
-	TypeMsgSetPauseStatus = "change_pause_status"
+	TypeMsgIssueTokens              = "issue_tokens"
+	TypeMsgRedeemTokens             = "redeem_tokens"
+	TypeMsgBlockAddress             = "block_address"
+	TypeMsgUnBlockAddress           = "unblock_address"
+	TypeMsgSetPauseStatus           = "change_pause_status"
+	TypeMsgGrantRole                = "grant_role"
+	TypeMsgRevokeRole               = "revoke_role"
+	TypeMsgConfigureMinterAllowance = "configure_minter_allowance"
 )
 
 // ensure Msg interface compliance at compile time
@@ -21,6 +25,9 @@ var (
 	_ sdk.Msg = &MsgBlockAddress{}
 	_ sdk.Msg = &MsgUnblockAddress{}
 	_ sdk.Msg = &MsgSetPauseStatus{}
+	_ sdk.Msg = &MsgGrantRole{}
+	_ sdk.Msg = &MsgRevokeRole{}
+	_ sdk.Msg = &MsgConfigureMinterAllowance{}
 )
 
 // NewMsgIssueTokens returns a new MsgIssueTokens
@@ -250,3 +257,143 @@ func (msg MsgSetPauseStatus) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgGrantRole returns a new MsgGrantRole
+func NewMsgGrantRole(sender string, denom string, role Role, addr string) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: addr,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgGrantRole) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgGrantRole) Type() string { return TypeMsgGrantRole }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgGrantRole) ValidateBasic() error {
+	return validateRoleMsg(msg.Sender, msg.Denom, msg.Role, msg.Address)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgGrantRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgGrantRole) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRevokeRole returns a new MsgRevokeRole
+func NewMsgRevokeRole(sender string, denom string, role Role, addr string) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: addr,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevokeRole) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevokeRole) Type() string { return TypeMsgRevokeRole }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgRevokeRole) ValidateBasic() error {
+	return validateRoleMsg(msg.Sender, msg.Denom, msg.Role, msg.Address)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgRevokeRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func validateRoleMsg(sender string, denom string, role Role, addr string) error {
+	if len(sender) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender bech32 address")
+	}
+	if err := role.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	_, err = sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid role bech32 address")
+	}
+	return sdk.ValidateDenom(denom)
+}
+
+// NewMsgConfigureMinterAllowance returns a new MsgConfigureMinterAllowance
+func NewMsgConfigureMinterAllowance(sender string, denom string, minter string, allowance sdkmath.Int) *MsgConfigureMinterAllowance {
+	return &MsgConfigureMinterAllowance{
+		Sender:    sender,
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: allowance,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgConfigureMinterAllowance) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgConfigureMinterAllowance) Type() string { return TypeMsgConfigureMinterAllowance }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgConfigureMinterAllowance) ValidateBasic() error {
+	if len(msg.Sender) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender bech32 address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter bech32 address")
+	}
+	if msg.Allowance.IsNil() || msg.Allowance.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "allowance must be non-negative: %s", msg.Allowance)
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgConfigureMinterAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgConfigureMinterAllowance) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
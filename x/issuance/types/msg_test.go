@@ -354,6 +354,54 @@ func (suite *MsgTestSuite) TestMsgSetPauseStatus() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgGrantRole() {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgGrantRole
+		contains string
+	}{
+		{"valid", types.NewMsgGrantRole(suite.addrs[0], "valid", types.ROLE_MINTER, suite.addrs[1]), ""},
+		{"invalid sender", types.NewMsgGrantRole("", "valid", types.ROLE_MINTER, suite.addrs[1]), "sender address cannot be empty"},
+		{"invalid role", types.NewMsgGrantRole(suite.addrs[0], "valid", types.ROLE_UNSPECIFIED, suite.addrs[1]), "invalid role"},
+		{"invalid address", types.NewMsgGrantRole(suite.addrs[0], "valid", types.ROLE_PAUSER, ""), "invalid role bech32 address"},
+		{"invalid denom", types.NewMsgGrantRole(suite.addrs[0], "", types.ROLE_PAUSER, suite.addrs[1]), "invalid denom"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.contains)
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgConfigureMinterAllowance() {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgConfigureMinterAllowance
+		contains string
+	}{
+		{"valid", types.NewMsgConfigureMinterAllowance(suite.addrs[0], "valid", suite.addrs[1], sdkmath.NewInt(100)), ""},
+		{"zero allowance", types.NewMsgConfigureMinterAllowance(suite.addrs[0], "valid", suite.addrs[1], sdkmath.ZeroInt()), ""},
+		{"invalid sender", types.NewMsgConfigureMinterAllowance("", "valid", suite.addrs[1], sdkmath.NewInt(100)), "sender address cannot be empty"},
+		{"invalid minter", types.NewMsgConfigureMinterAllowance(suite.addrs[0], "valid", "", sdkmath.NewInt(100)), "invalid minter bech32 address"},
+		{"negative allowance", types.NewMsgConfigureMinterAllowance(suite.addrs[0], "valid", suite.addrs[1], sdkmath.NewInt(-1)), "allowance must be non-negative"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.contains)
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
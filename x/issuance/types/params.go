@@ -80,6 +80,34 @@ func (a Asset) Validate() error {
 	if !a.Blockable && len(a.BlockedAddresses) > 0 {
 		return fmt.Errorf("asset %s does not support blocking, blocked-list should be empty: %s", a.Denom, a.BlockedAddresses)
 	}
+	for _, role := range []struct {
+		name    string
+		address string
+	}{
+		{"master minter", a.MasterMinter},
+		{"pauser", a.Pauser},
+		{"blocklister", a.Blocklister},
+	} {
+		if len(role.address) == 0 {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(role.address); err != nil {
+			return fmt.Errorf("asset %s has invalid %s address %s: %w", a.Denom, role.name, role.address, err)
+		}
+	}
+	minters := make(map[string]bool, len(a.Minters))
+	for _, minter := range a.Minters {
+		if len(minter) == 0 {
+			return fmt.Errorf("minter must not be empty")
+		}
+		if _, err := sdk.AccAddressFromBech32(minter); err != nil {
+			return fmt.Errorf("asset %s has invalid minter address %s: %w", a.Denom, minter, err)
+		}
+		if minters[minter] {
+			return fmt.Errorf("asset %s has duplicate minter %s", a.Denom, minter)
+		}
+		minters[minter] = true
+	}
 	for _, address := range a.BlockedAddresses {
 		if len(address) == 0 {
 			return fmt.Errorf("blocked address must not be empty")
@@ -98,8 +126,41 @@ func (a Asset) String() string {
 	Paused: %t
 	Denom: %s
 	Blocked Addresses: %s
-	Rate limits: %s`,
-		a.Owner, a.Paused, a.Denom, a.BlockedAddresses, a.RateLimit.String())
+	Rate limits: %s
+	Master Minter: %s
+	Minters: %s
+	Pauser: %s
+	Blocklister: %s`,
+		a.Owner, a.Paused, a.Denom, a.BlockedAddresses, a.RateLimit.String(), a.MasterMinter, a.Minters, a.Pauser, a.Blocklister)
+}
+
+// HasRole returns true if the address holds the role for the asset. The owner holds every role.
+func (a Asset) HasRole(role Role, address string) bool {
+	if address == a.Owner {
+		return true
+	}
+	switch role {
+	case ROLE_MASTER_MINTER:
+		return address == a.MasterMinter
+	case ROLE_MINTER:
+		return a.IsMinter(address)
+	case ROLE_PAUSER:
+		return address == a.Pauser
+	case ROLE_BLOCKLISTER:
+		return address == a.Blocklister
+	default:
+		return false
+	}
+}
+
+// IsMinter returns true if the address has been granted the minter role for the asset.
+func (a Asset) IsMinter(address string) bool {
+	for _, minter := range a.Minters {
+		if minter == address {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate checks if all assets are valid and there are no duplicate entries
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -413,6 +415,240 @@ func (m *MsgSetPauseStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetPauseStatusResponse proto.InternalMessageInfo
 
+// MsgGrantRole represents a message used to assign a role for an asset to an address
+type MsgGrantRole struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=kava.issuance.v1beta1.Role" json:"role,omitempty"`
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgGrantRole) Reset()         { *m = MsgGrantRole{} }
+func (m *MsgGrantRole) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRole) ProtoMessage()    {}
+func (*MsgGrantRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cb7117b12e184a2, []int{10}
+}
+func (m *MsgGrantRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRole.Merge(m, src)
+}
+func (m *MsgGrantRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantRole proto.InternalMessageInfo
+
+// MsgGrantRoleResponse defines the Msg/GrantRole response type.
+type MsgGrantRoleResponse struct {
+}
+
+func (m *MsgGrantRoleResponse) Reset()         { *m = MsgGrantRoleResponse{} }
+func (m *MsgGrantRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRoleResponse) ProtoMessage()    {}
+func (*MsgGrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cb7117b12e184a2, []int{11}
+}
+func (m *MsgGrantRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRoleResponse.Merge(m, src)
+}
+func (m *MsgGrantRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantRoleResponse proto.InternalMessageInfo
+
+// MsgRevokeRole represents a message used to remove a role for an asset from an address
+type MsgRevokeRole struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=kava.issuance.v1beta1.Role" json:"role,omitempty"`
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRevokeRole) Reset()         { *m = MsgRevokeRole{} }
+func (m *MsgRevokeRole) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRole) ProtoMessage()    {}
+func (*MsgRevokeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cb7117b12e184a2, []int{12}
+}
+func (m *MsgRevokeRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRole.Merge(m, src)
+}
+func (m *MsgRevokeRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRole proto.InternalMessageInfo
+
+// MsgRevokeRoleResponse defines the Msg/RevokeRole response type.
+type MsgRevokeRoleResponse struct {
+}
+
+func (m *MsgRevokeRoleResponse) Reset()         { *m = MsgRevokeRoleResponse{} }
+func (m *MsgRevokeRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRoleResponse) ProtoMessage()    {}
+func (*MsgRevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cb7117b12e184a2, []int{13}
+}
+func (m *MsgRevokeRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRoleResponse.Merge(m, src)
+}
+func (m *MsgRevokeRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRoleResponse proto.InternalMessageInfo
+
+// MsgConfigureMinterAllowance represents a message used by the master minter to set the amount a minter may issue
+type MsgConfigureMinterAllowance struct {
+	Sender    string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom     string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Minter    string                                 `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty"`
+	Allowance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=allowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allowance"`
+}
+
+func (m *MsgConfigureMinterAllowance) Reset()         { *m = MsgConfigureMinterAllowance{} }
+func (m *MsgConfigureMinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgConfigureMinterAllowance) ProtoMessage()    {}
+func (*MsgConfigureMinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cb7117b12e184a2, []int{14}
+}
+func (m *MsgConfigureMinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfigureMinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfigureMinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfigureMinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfigureMinterAllowance.Merge(m, src)
+}
+func (m *MsgConfigureMinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfigureMinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfigureMinterAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfigureMinterAllowance proto.InternalMessageInfo
+
+// MsgConfigureMinterAllowanceResponse defines the Msg/ConfigureMinterAllowance response type.
+type MsgConfigureMinterAllowanceResponse struct {
+}
+
+func (m *MsgConfigureMinterAllowanceResponse) Reset()         { *m = MsgConfigureMinterAllowanceResponse{} }
+func (m *MsgConfigureMinterAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfigureMinterAllowanceResponse) ProtoMessage()    {}
+func (*MsgConfigureMinterAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cb7117b12e184a2, []int{15}
+}
+func (m *MsgConfigureMinterAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfigureMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfigureMinterAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfigureMinterAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfigureMinterAllowanceResponse.Merge(m, src)
+}
+func (m *MsgConfigureMinterAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfigureMinterAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfigureMinterAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfigureMinterAllowanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgIssueTokens)(nil), "kava.issuance.v1beta1.MsgIssueTokens")
 	proto.RegisterType((*MsgIssueTokensResponse)(nil), "kava.issuance.v1beta1.MsgIssueTokensResponse")
@@ -424,43 +660,64 @@ func init() {
 	proto.RegisterType((*MsgUnblockAddressResponse)(nil), "kava.issuance.v1beta1.MsgUnblockAddressResponse")
 	proto.RegisterType((*MsgSetPauseStatus)(nil), "kava.issuance.v1beta1.MsgSetPauseStatus")
 	proto.RegisterType((*MsgSetPauseStatusResponse)(nil), "kava.issuance.v1beta1.MsgSetPauseStatusResponse")
+	proto.RegisterType((*MsgGrantRole)(nil), "kava.issuance.v1beta1.MsgGrantRole")
+	proto.RegisterType((*MsgGrantRoleResponse)(nil), "kava.issuance.v1beta1.MsgGrantRoleResponse")
+	proto.RegisterType((*MsgRevokeRole)(nil), "kava.issuance.v1beta1.MsgRevokeRole")
+	proto.RegisterType((*MsgRevokeRoleResponse)(nil), "kava.issuance.v1beta1.MsgRevokeRoleResponse")
+	proto.RegisterType((*MsgConfigureMinterAllowance)(nil), "kava.issuance.v1beta1.MsgConfigureMinterAllowance")
+	proto.RegisterType((*MsgConfigureMinterAllowanceResponse)(nil), "kava.issuance.v1beta1.MsgConfigureMinterAllowanceResponse")
 }
 
 func init() { proto.RegisterFile("kava/issuance/v1beta1/tx.proto", fileDescriptor_0cb7117b12e184a2) }
 
 var fileDescriptor_0cb7117b12e184a2 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xbf, 0x6e, 0xd3, 0x50,
-	0x14, 0xc6, 0x6d, 0x5a, 0xa2, 0xf4, 0x14, 0xa5, 0xc2, 0x2a, 0x25, 0x31, 0x92, 0x53, 0x45, 0x02,
-	0x22, 0xa4, 0x5e, 0xd3, 0x32, 0x20, 0xb1, 0x11, 0x58, 0x18, 0x22, 0x21, 0x17, 0x16, 0x16, 0x74,
-	0x6d, 0x1f, 0x8c, 0x95, 0xc4, 0x37, 0xf2, 0xb9, 0x8e, 0xca, 0x13, 0xc0, 0xc8, 0xc4, 0xdc, 0xc7,
-	0xe9, 0xd8, 0x91, 0x09, 0xa1, 0x64, 0xe1, 0x31, 0x90, 0xaf, 0x9d, 0xc4, 0xce, 0x3f, 0x85, 0x81,
-	0x6e, 0xf7, 0xdc, 0xf3, 0x9d, 0xf3, 0xfd, 0x64, 0x7d, 0xbe, 0x60, 0xf5, 0xf8, 0x88, 0xdb, 0x21,
-	0x51, 0xc2, 0x23, 0x0f, 0xed, 0xd1, 0xa9, 0x8b, 0x92, 0x9f, 0xda, 0xf2, 0x82, 0x0d, 0x63, 0x21,
-	0x85, 0x71, 0x2f, 0xed, 0xb3, 0x69, 0x9f, 0xe5, 0x7d, 0xd3, 0xf2, 0x04, 0x0d, 0x04, 0xd9, 0x2e,
-	0xa7, 0xf9, 0x90, 0x27, 0xc2, 0x28, 0x1b, 0x33, 0x0f, 0x03, 0x11, 0x08, 0x75, 0xb4, 0xd3, 0x53,
-	0x76, 0xdb, 0xfa, 0xaa, 0x43, 0xad, 0x4b, 0xc1, 0x1b, 0xa2, 0x04, 0xdf, 0x89, 0x1e, 0x46, 0x64,
-	0x1c, 0x41, 0x85, 0x30, 0xf2, 0x31, 0xae, 0xeb, 0xc7, 0x7a, 0x7b, 0xcf, 0xc9, 0x2b, 0xe3, 0x39,
-	0x54, 0xa4, 0x52, 0xd4, 0x6f, 0x1d, 0xeb, 0xed, 0xfd, 0xb3, 0x06, 0xcb, 0x1c, 0x59, 0xea, 0x38,
-	0xc5, 0x60, 0xaf, 0x44, 0x18, 0x75, 0x76, 0xaf, 0x7e, 0x35, 0x35, 0x27, 0x97, 0x1b, 0x26, 0x54,
-	0x63, 0xf4, 0x30, 0x1c, 0x61, 0x5c, 0xdf, 0x51, 0x2b, 0x67, 0xf5, 0x8b, 0xea, 0xb7, 0xcb, 0xa6,
-	0xf6, 0xe7, 0xb2, 0xa9, 0xb5, 0xea, 0x70, 0x54, 0x06, 0x71, 0x90, 0x86, 0x22, 0x22, 0x6c, 0xf5,
-	0xe1, 0xa0, 0x4b, 0x81, 0x83, 0x3e, 0xe2, 0xe0, 0x3f, 0x31, 0x16, 0x38, 0x1a, 0x70, 0x7f, 0xc1,
-	0x6d, 0x06, 0x12, 0x2b, 0x90, 0x4e, 0x5f, 0x78, 0xbd, 0x97, 0xbe, 0x1f, 0x23, 0xad, 0x07, 0x39,
-	0x84, 0xdb, 0x3e, 0x46, 0x62, 0xa0, 0x38, 0xf6, 0x9c, 0xac, 0x30, 0x1e, 0xc3, 0x81, 0x9b, 0x4e,
-	0xa3, 0xff, 0x91, 0x67, 0x0b, 0xf2, 0x0f, 0x52, 0xcb, 0xaf, 0xf3, 0xb5, 0x4b, 0x38, 0x45, 0xcf,
-	0x19, 0x8e, 0x84, 0xbb, 0x5d, 0x0a, 0xde, 0x47, 0xee, 0x8d, 0x02, 0x3d, 0x80, 0xc6, 0x92, 0xeb,
-	0x0c, 0xc9, 0x53, 0x48, 0xe7, 0x28, 0xdf, 0xf2, 0x84, 0xf0, 0x5c, 0x72, 0x99, 0xfc, 0x2b, 0x52,
-	0xaa, 0x56, 0x73, 0x8a, 0xa4, 0xea, 0xe4, 0xd5, 0x12, 0x41, 0xd9, 0x64, 0x4a, 0x70, 0xf6, 0x63,
-	0x17, 0x76, 0xba, 0x14, 0x18, 0x1e, 0xec, 0x17, 0x43, 0xfd, 0x90, 0xad, 0xfc, 0x6b, 0x58, 0x39,
-	0x72, 0xe6, 0xc9, 0x56, 0xb2, 0xa9, 0x99, 0xf1, 0x09, 0xee, 0x94, 0x62, 0xf9, 0x68, 0xfd, 0x78,
-	0x51, 0x67, 0xb2, 0xed, 0x74, 0x45, 0x9f, 0x52, 0xea, 0x36, 0xf8, 0x14, 0x75, 0x9b, 0x7c, 0x56,
-	0x25, 0xca, 0xe8, 0x43, 0x6d, 0x21, 0x4e, 0xed, 0xf5, 0x1b, 0xca, 0x4a, 0xf3, 0xe9, 0xb6, 0xca,
-	0xa2, 0xdb, 0x42, 0x52, 0x36, 0xb8, 0x95, 0x95, 0x9b, 0xdc, 0x56, 0x07, 0xa3, 0xf3, 0xfa, 0x6a,
-	0x6c, 0xe9, 0xd7, 0x63, 0x4b, 0xff, 0x3d, 0xb6, 0xf4, 0xef, 0x13, 0x4b, 0xbb, 0x9e, 0x58, 0xda,
-	0xcf, 0x89, 0xa5, 0x7d, 0x78, 0x12, 0x84, 0xf2, 0x73, 0xe2, 0x32, 0x4f, 0x0c, 0xec, 0x74, 0xeb,
-	0x49, 0x9f, 0xbb, 0xa4, 0x4e, 0xf6, 0xc5, 0xfc, 0x1d, 0x96, 0x5f, 0x86, 0x48, 0x6e, 0x45, 0x3d,
-	0x9b, 0xcf, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x6f, 0x10, 0xd6, 0xa5, 0x05, 0x00, 0x00,
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xaf, 0xfd, 0x42, 0x73, 0x5b, 0x52, 0x61, 0xf5, 0x4f, 0xe2, 0x4a, 0x4e, 0xd5,
+	0xd2, 0x52, 0x01, 0xb1, 0xdb, 0xb0, 0x40, 0xaa, 0xd8, 0x34, 0x45, 0x42, 0x5d, 0x44, 0x42, 0x2e,
+	0x6c, 0x2a, 0xa1, 0xe2, 0xd8, 0xb7, 0xc6, 0x8a, 0xe3, 0xa9, 0x3c, 0x4e, 0x28, 0x4f, 0x00, 0x62,
+	0x05, 0x3c, 0x41, 0x1f, 0x82, 0x87, 0x28, 0xbb, 0x8a, 0x15, 0x62, 0x51, 0xa1, 0x96, 0x05, 0x8f,
+	0x81, 0x3c, 0x1e, 0x3b, 0x76, 0x5b, 0x47, 0xce, 0x02, 0xc4, 0x2a, 0xb9, 0x33, 0xe7, 0x9e, 0xf3,
+	0x1b, 0x27, 0x73, 0x65, 0x90, 0x3b, 0x7a, 0x5f, 0x57, 0x6d, 0x4a, 0x7b, 0xba, 0x6b, 0xa0, 0xda,
+	0xdf, 0x68, 0xa3, 0xaf, 0x6f, 0xa8, 0xfe, 0x91, 0x72, 0xe8, 0x11, 0x9f, 0x88, 0xb3, 0xc1, 0xbe,
+	0x12, 0xed, 0x2b, 0x7c, 0x5f, 0x92, 0x0d, 0x42, 0xbb, 0x84, 0xaa, 0x6d, 0x9d, 0x0e, 0x9a, 0x0c,
+	0x62, 0xbb, 0x61, 0x9b, 0x54, 0x0d, 0xf7, 0xf7, 0x59, 0xa5, 0x86, 0x05, 0xdf, 0x9a, 0xb1, 0x88,
+	0x45, 0xc2, 0xf5, 0xe0, 0x1b, 0x5f, 0x5d, 0xbe, 0x9e, 0xc3, 0x42, 0x17, 0xa9, 0xcd, 0x5b, 0x97,
+	0xde, 0x0a, 0x50, 0x6e, 0x51, 0x6b, 0x87, 0xd2, 0x1e, 0x3e, 0x23, 0x1d, 0x74, 0xa9, 0x38, 0x07,
+	0x45, 0x8a, 0xae, 0x89, 0x5e, 0x45, 0x58, 0x14, 0xd6, 0x4a, 0x1a, 0xaf, 0xc4, 0x87, 0x50, 0xf4,
+	0x99, 0xa2, 0xf2, 0xdf, 0xa2, 0xb0, 0x36, 0xd9, 0xa8, 0x2a, 0x1c, 0x22, 0x20, 0x8e, 0x8e, 0xa1,
+	0x6c, 0x13, 0xdb, 0x6d, 0x8e, 0x9f, 0x9c, 0xd5, 0x0a, 0x1a, 0x97, 0x8b, 0x12, 0x4c, 0x78, 0x68,
+	0xa0, 0xdd, 0x47, 0xaf, 0x32, 0xc6, 0x2c, 0xe3, 0x7a, 0x73, 0xe2, 0xdd, 0x71, 0xad, 0xf0, 0xeb,
+	0xb8, 0x56, 0x58, 0xaa, 0xc0, 0x5c, 0x1a, 0x44, 0x43, 0x7a, 0x48, 0x5c, 0x8a, 0x4b, 0x0e, 0x4c,
+	0xb7, 0xa8, 0xa5, 0xa1, 0x89, 0xd8, 0xfd, 0x43, 0x8c, 0x09, 0x8e, 0x2a, 0xcc, 0x5f, 0x4a, 0x8b,
+	0x41, 0x3c, 0x06, 0xd2, 0x74, 0x88, 0xd1, 0xd9, 0x32, 0x4d, 0x0f, 0x69, 0x36, 0xc8, 0x0c, 0xfc,
+	0x6f, 0xa2, 0x4b, 0xba, 0x8c, 0xa3, 0xa4, 0x85, 0x85, 0x78, 0x07, 0xa6, 0xdb, 0x41, 0x37, 0x9a,
+	0xfb, 0x7a, 0x68, 0xc0, 0x1f, 0x48, 0x99, 0x2f, 0x73, 0xdb, 0x2b, 0x38, 0xc9, 0xcc, 0x18, 0xc7,
+	0x87, 0x5b, 0x2d, 0x6a, 0x3d, 0x77, 0xdb, 0x7f, 0x15, 0x68, 0x01, 0xaa, 0x57, 0x52, 0x63, 0x24,
+	0x83, 0x21, 0xed, 0xa2, 0xff, 0x54, 0xef, 0x51, 0xdc, 0xf5, 0x75, 0xbf, 0x37, 0x2a, 0x52, 0xa0,
+	0x66, 0x7d, 0x8c, 0x64, 0x42, 0xe3, 0xd5, 0x15, 0x82, 0x74, 0x48, 0x4c, 0xf0, 0x51, 0x80, 0xa9,
+	0x16, 0xb5, 0x9e, 0x78, 0xba, 0xeb, 0x6b, 0xc4, 0xc1, 0x11, 0xd3, 0x55, 0x18, 0xf7, 0x88, 0x83,
+	0x2c, 0xbb, 0xdc, 0x58, 0x50, 0xae, 0xbd, 0xab, 0x4a, 0x60, 0xac, 0x31, 0xa1, 0x58, 0x81, 0x1b,
+	0xd1, 0x93, 0x1b, 0x67, 0x46, 0x51, 0x99, 0x00, 0x9e, 0x83, 0x99, 0x24, 0x52, 0xcc, 0xfa, 0x49,
+	0x80, 0x9b, 0xec, 0xbf, 0xd6, 0x27, 0x1d, 0xfc, 0x57, 0x60, 0xe7, 0x61, 0x36, 0xc5, 0x14, 0xd3,
+	0x7e, 0x11, 0x60, 0xa1, 0x45, 0xad, 0x6d, 0xe2, 0x1e, 0xd8, 0x56, 0xcf, 0xc3, 0x96, 0xed, 0xfa,
+	0xe8, 0x6d, 0x39, 0x0e, 0x79, 0x1d, 0xe4, 0x8d, 0xfe, 0x33, 0x77, 0x99, 0x01, 0xff, 0xc3, 0xf1,
+	0x4a, 0xdc, 0x83, 0x92, 0x1e, 0x59, 0x86, 0x90, 0xcd, 0x47, 0xc1, 0x4d, 0xfd, 0x7e, 0x56, 0x5b,
+	0xb5, 0x6c, 0xff, 0x55, 0xaf, 0xad, 0x18, 0xa4, 0xcb, 0xe7, 0x1f, 0xff, 0xa8, 0x53, 0xb3, 0xa3,
+	0xfa, 0x6f, 0x0e, 0x91, 0x2a, 0x3b, 0xae, 0xff, 0xf5, 0x73, 0x1d, 0xc2, 0xf5, 0xa0, 0xd2, 0x06,
+	0x76, 0x89, 0x43, 0xae, 0xc0, 0xf2, 0x90, 0xa3, 0x44, 0x47, 0x6e, 0xfc, 0x2c, 0xc2, 0x58, 0x8b,
+	0x5a, 0xa2, 0x01, 0x93, 0xc9, 0x09, 0xb9, 0x92, 0xf1, 0xa4, 0xd3, 0xf3, 0x4b, 0xaa, 0xe7, 0x92,
+	0x45, 0x61, 0xe2, 0x01, 0x4c, 0xa5, 0x66, 0xdc, 0x6a, 0x76, 0x7b, 0x52, 0x27, 0x29, 0xf9, 0x74,
+	0xc9, 0x9c, 0xd4, 0x08, 0x1b, 0x92, 0x93, 0xd4, 0x49, 0x4a, 0x3e, 0x5d, 0x9c, 0xe3, 0x40, 0xf9,
+	0xd2, 0x6c, 0x5a, 0xcb, 0x76, 0x48, 0x2b, 0xa5, 0xf5, 0xbc, 0xca, 0x64, 0xda, 0xa5, 0xb1, 0x33,
+	0x24, 0x2d, 0xad, 0x94, 0xd6, 0xf3, 0x2a, 0xe3, 0xb4, 0x17, 0x50, 0x1a, 0x4c, 0x98, 0xe5, 0xec,
+	0xf6, 0x58, 0x24, 0xdd, 0xcb, 0x21, 0x8a, 0xed, 0x5f, 0x02, 0x24, 0x86, 0xc2, 0xed, 0x61, 0x3f,
+	0x70, 0xa4, 0x92, 0xee, 0xe7, 0x51, 0xc5, 0x09, 0xef, 0x05, 0xa8, 0x64, 0xde, 0xe4, 0x46, 0xb6,
+	0x55, 0x56, 0x8f, 0xb4, 0x39, 0x7a, 0x4f, 0x04, 0xd3, 0x7c, 0x7c, 0x72, 0x2e, 0x0b, 0xa7, 0xe7,
+	0xb2, 0xf0, 0xe3, 0x5c, 0x16, 0x3e, 0x5c, 0xc8, 0x85, 0xd3, 0x0b, 0xb9, 0xf0, 0xed, 0x42, 0x2e,
+	0xec, 0xdd, 0x4d, 0x5c, 0xf9, 0xc0, 0xbf, 0xee, 0xe8, 0x6d, 0xca, 0xbe, 0xa9, 0x47, 0x83, 0x57,
+	0x1b, 0x76, 0xf5, 0xdb, 0x45, 0xf6, 0x46, 0xf3, 0xe0, 0xf7, 0x00, 0x27, 0x74, 0x91, 0xc9, 0x80,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -485,6 +742,12 @@ type MsgClient interface {
 	UnblockAddress(ctx context.Context, in *MsgUnblockAddress, opts ...grpc.CallOption) (*MsgUnblockAddressResponse, error)
 	// SetPauseStatus message type used to pause or unpause status
 	SetPauseStatus(ctx context.Context, in *MsgSetPauseStatus, opts ...grpc.CallOption) (*MsgSetPauseStatusResponse, error)
+	// GrantRole message type used to assign a role for an asset to an address
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	// RevokeRole message type used to remove a role for an asset from an address
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	// ConfigureMinterAllowance message type used by the master minter to set the amount a minter may issue
+	ConfigureMinterAllowance(ctx context.Context, in *MsgConfigureMinterAllowance, opts ...grpc.CallOption) (*MsgConfigureMinterAllowanceResponse, error)
 }
 
 type msgClient struct {
@@ -540,6 +803,33 @@ func (c *msgClient) SetPauseStatus(ctx context.Context, in *MsgSetPauseStatus, o
 	return out, nil
 }
 
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/kava.issuance.v1beta1.Msg/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/kava.issuance.v1beta1.Msg/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfigureMinterAllowance(ctx context.Context, in *MsgConfigureMinterAllowance, opts ...grpc.CallOption) (*MsgConfigureMinterAllowanceResponse, error) {
+	out := new(MsgConfigureMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/kava.issuance.v1beta1.Msg/ConfigureMinterAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// IssueTokens message type used by the issuer to issue new tokens
@@ -552,6 +842,12 @@ type MsgServer interface {
 	UnblockAddress(context.Context, *MsgUnblockAddress) (*MsgUnblockAddressResponse, error)
 	// SetPauseStatus message type used to pause or unpause status
 	SetPauseStatus(context.Context, *MsgSetPauseStatus) (*MsgSetPauseStatusResponse, error)
+	// GrantRole message type used to assign a role for an asset to an address
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	// RevokeRole message type used to remove a role for an asset from an address
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	// ConfigureMinterAllowance message type used by the master minter to set the amount a minter may issue
+	ConfigureMinterAllowance(context.Context, *MsgConfigureMinterAllowance) (*MsgConfigureMinterAllowanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -573,6 +869,15 @@ func (*UnimplementedMsgServer) UnblockAddress(ctx context.Context, req *MsgUnblo
 func (*UnimplementedMsgServer) SetPauseStatus(ctx context.Context, req *MsgSetPauseStatus) (*MsgSetPauseStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPauseStatus not implemented")
 }
+func (*UnimplementedMsgServer) GrantRole(ctx context.Context, req *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRole(ctx context.Context, req *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedMsgServer) ConfigureMinterAllowance(ctx context.Context, req *MsgConfigureMinterAllowance) (*MsgConfigureMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureMinterAllowance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -668,6 +973,60 @@ func _Msg_SetPauseStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.issuance.v1beta1.Msg/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.issuance.v1beta1.Msg/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfigureMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfigureMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfigureMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.issuance.v1beta1.Msg/ConfigureMinterAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfigureMinterAllowance(ctx, req.(*MsgConfigureMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.issuance.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -692,6 +1051,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetPauseStatus",
 			Handler:    _Msg_SetPauseStatus_Handler,
 		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ConfigureMinterAllowance",
+			Handler:    _Msg_ConfigureMinterAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/issuance/v1beta1/tx.proto",
@@ -1034,66 +1405,287 @@ func (m *MsgSetPauseStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgIssueTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgGrantRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgIssueTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgGrantRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedeemTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgGrantRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgRedeemTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfigureMinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfigureMinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfigureMinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfigureMinterAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfigureMinterAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfigureMinterAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgIssueTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIssueTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -1174,25 +1766,681 @@ func (m *MsgSetPauseStatus) Size() (n int) {
 	if m.Status {
 		n += 2
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgSetPauseStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfigureMinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConfigureMinterAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgIssueTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssueTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBlockAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBlockAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBlockAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetPauseStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgBlockAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBlockAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBlockAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgIssueTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgUnblockAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1215,10 +2463,10 @@ func (m *MsgIssueTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnblockAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnblockAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1255,9 +2503,9 @@ func (m *MsgIssueTokens) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1267,28 +2515,27 @@ func (m *MsgIssueTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1316,7 +2563,7 @@ func (m *MsgIssueTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.BlockedAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1339,7 +2586,7 @@ func (m *MsgIssueTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnblockAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1362,10 +2609,10 @@ func (m *MsgIssueTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnblockAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnblockAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1389,7 +2636,7 @@ func (m *MsgIssueTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPauseStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,10 +2659,10 @@ func (m *MsgRedeemTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPauseStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPauseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1452,9 +2699,9 @@ func (m *MsgRedeemTokens) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1464,25 +2711,44 @@ func (m *MsgRedeemTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1504,7 +2770,7 @@ func (m *MsgRedeemTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPauseStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1527,10 +2793,10 @@ func (m *MsgRedeemTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPauseStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1554,7 +2820,7 @@ func (m *MsgRedeemTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBlockAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,10 +2843,10 @@ func (m *MsgBlockAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBlockAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBlockAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1648,8 +2914,27 @@ func (m *MsgBlockAddress) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1677,7 +2962,7 @@ func (m *MsgBlockAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockedAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1700,7 +2985,7 @@ func (m *MsgBlockAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBlockAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1723,10 +3008,10 @@ func (m *MsgBlockAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBlockAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBlockAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1750,7 +3035,7 @@ func (m *MsgBlockAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnblockAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1773,10 +3058,10 @@ func (m *MsgUnblockAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnblockAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnblockAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1844,8 +3129,27 @@ func (m *MsgUnblockAddress) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1873,7 +3177,7 @@ func (m *MsgUnblockAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockedAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1896,7 +3200,7 @@ func (m *MsgUnblockAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnblockAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1919,10 +3223,10 @@ func (m *MsgUnblockAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnblockAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnblockAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1946,7 +3250,7 @@ func (m *MsgUnblockAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPauseStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgConfigureMinterAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1969,10 +3273,10 @@ func (m *MsgSetPauseStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPauseStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfigureMinterAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPauseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfigureMinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2040,10 +3344,10 @@ func (m *MsgSetPauseStatus) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2053,12 +3357,58 @@ func (m *MsgSetPauseStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2080,7 +3430,7 @@ func (m *MsgSetPauseStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPauseStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConfigureMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2103,10 +3453,10 @@ func (m *MsgSetPauseStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPauseStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfigureMinterAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfigureMinterAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,19 @@
+package liquid
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// BeginBlocker runs the liquid module begin blocker logic.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ProcessUnstakePoolUndelegations(ctx)
+	k.ProcessPendingRedelegations(ctx)
+}
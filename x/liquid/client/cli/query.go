@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/liquid/types"
 )
@@ -19,7 +24,16 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmds := []*cobra.Command{}
+	cmds := []*cobra.Command{
+		queryParamsCmd(),
+		queryUnstakePoolCmd(),
+		queryUnstakePoolDepositCmd(),
+		queryDerivativesCmd(),
+		queryDerivativeCmd(),
+		queryDerivativeSlashesCmd(),
+		querySimulateMintCmd(),
+		querySimulateBurnCmd(),
+	}
 
 	for _, cmd := range cmds {
 		flags.AddQueryFlagsToCmd(cmd)
@@ -29,3 +43,270 @@ func GetQueryCmd() *cobra.Command {
 
 	return liquidQueryCmd
 }
+
+func queryParamsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "params",
+		Short:   "get the liquid module parameters",
+		Long:    "Get the current global liquid module parameters.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s q %s params", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+}
+
+func queryUnstakePoolCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "unstake-pool",
+		Short:   "get the instant unstake pool",
+		Long:    "Get the shares, balances, and total value of the instant unstake pool.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s q %s unstake-pool", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnstakePool(context.Background(), &types.QueryUnstakePoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryUnstakePoolDepositCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unstake-pool-deposit [depositor]",
+		Short: "get an instant unstake pool deposit",
+		Long:  "Get the shares and current value of an account's instant unstake pool deposit.",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			"%s q %s unstake-pool-deposit kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny", version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnstakePoolDeposit(context.Background(), &types.QueryUnstakePoolDepositRequest{
+				Depositor: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryDerivativesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "derivatives",
+		Short:   "get the exchange rates of all derivatives",
+		Long:    "Get the exchange rate, supply, and underlying delegation of each staking derivative.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s q %s derivatives", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Derivatives(context.Background(), &types.QueryDerivativesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "derivatives")
+
+	return cmd
+}
+
+func queryDerivativeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "derivative [denom]",
+		Short: "get the exchange rate of a derivative",
+		Long:  "Get the exchange rate, supply, and underlying delegation of a staking derivative.",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			"%s q %s derivative bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd", version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Derivative(context.Background(), &types.QueryDerivativeRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Derivative)
+		},
+	}
+}
+
+func queryDerivativeSlashesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derivative-slashes [denom]",
+		Short: "get the slash history of a derivative",
+		Long:  "Get the slashes of the validator backing a staking derivative and the resulting exchange rate changes.",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			"%s q %s derivative-slashes bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd --reverse", version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivativeSlashes(context.Background(), &types.QueryDerivativeSlashesRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "derivative slashes")
+
+	return cmd
+}
+
+func querySimulateMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-mint [amount]",
+		Short:   "get the derivatives minted for an amount of staking tokens",
+		Long:    "Get the derivatives minted for an amount of staking tokens delegated to each validator.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s q %s simulate-mint 1000000", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[0])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateMint(context.Background(), &types.QuerySimulateMintRequest{
+				Amount:     amount,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "validators")
+
+	return cmd
+}
+
+func querySimulateBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-burn [amount]",
+		Short:   "get the staking tokens delegated for an amount of derivatives",
+		Long:    "Get the staking tokens delegated when burning an amount of each validator's derivative.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s q %s simulate-burn 1000000", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[0])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateBurn(context.Background(), &types.QuerySimulateBurnRequest{
+				Amount:     amount,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "validators")
+
+	return cmd
+}
@@ -29,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdMintDerivative(),
 		getCmdBurnDerivative(),
+		getCmdDepositUnstakePool(),
+		getCmdWithdrawUnstakePool(),
+		getCmdInstantUnstake(),
 	}
 
 	for _, cmd := range cmds {
@@ -107,3 +110,90 @@ func getCmdBurnDerivative() *cobra.Command {
 		},
 	}
 }
+
+func getCmdDepositUnstakePool() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-unstake-pool [amount]",
+		Short: "deposits staking tokens into the instant unstake pool",
+		Long:  "Deposit adds staking tokens to the instant unstake pool in return for a share of the pool and the fees it collects.",
+		Example: fmt.Sprintf(
+			`%s tx %s deposit-unstake-pool 10000000ukava --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositUnstakePool(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdWithdrawUnstakePool() *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-unstake-pool [amount]",
+		Short: "withdraws staking tokens from the instant unstake pool",
+		Long:  "Withdraw removes staking tokens from the instant unstake pool, limited to the tokens the pool holds that are not unbonding.",
+		Example: fmt.Sprintf(
+			`%s tx %s withdraw-unstake-pool 10000000ukava --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawUnstakePool(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdInstantUnstake() *cobra.Command {
+	return &cobra.Command{
+		Use:   "instant-unstake [amount]",
+		Short: "sells staking derivative to the instant unstake pool",
+		Long:  "Instant unstake sells staking derivative to the instant unstake pool for staking tokens, less the instant unstake fee, skipping the unbonding period.",
+		Example: fmt.Sprintf(
+			`%s tx %s instant-unstake 10000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInstantUnstake(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
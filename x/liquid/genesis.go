@@ -0,0 +1,56 @@
+package liquid
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// InitGenesis initializes the store state from a genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	// Fetching the module account creates it if it doesn't exist, so funds sent
+	// to the pool are held by a module account rather than a normal account.
+	if moduleAcc := ak.GetModuleAccount(ctx, types.UnstakePoolAccountName); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.UnstakePoolAccountName))
+	}
+
+	k.SetParams(ctx, gs.Params)
+	k.SetUnstakePool(ctx, gs.UnstakePool)
+
+	for _, deposit := range gs.UnstakePoolDeposits {
+		k.SetUnstakePoolDeposit(ctx, deposit)
+	}
+
+	for _, slash := range gs.DerivativeSlashes {
+		k.SetDerivativeSlash(ctx, slash)
+	}
+
+	for _, redelegation := range gs.PendingRedelegations {
+		k.SetPendingRedelegation(ctx, redelegation)
+	}
+}
+
+// ExportGenesis export genesis state for liquid module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	params, found := k.GetParams(ctx)
+	if !found {
+		params = types.DefaultParams()
+	}
+
+	pool, found := k.GetUnstakePool(ctx)
+	if !found {
+		pool = types.DefaultUnstakePool()
+	}
+
+	return types.NewGenesisState(
+		params, pool, k.GetAllUnstakePoolDeposits(ctx), k.GetAllDerivativeSlashes(ctx),
+		k.GetAllPendingRedelegations(ctx),
+	)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// GetDerivativeSlash returns the slash of a validator at a height.
+func (k Keeper) GetDerivativeSlash(ctx sdk.Context, valAddr sdk.ValAddress, height int64) (types.DerivativeSlash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DerivativeSlashKeyPrefix)
+
+	bz := store.Get(types.DerivativeSlashKey(valAddr, height))
+	if bz == nil {
+		return types.DerivativeSlash{}, false
+	}
+
+	var slash types.DerivativeSlash
+	k.cdc.MustUnmarshal(bz, &slash)
+
+	return slash, true
+}
+
+// SetDerivativeSlash stores a derivative slash.
+func (k Keeper) SetDerivativeSlash(ctx sdk.Context, slash types.DerivativeSlash) {
+	valAddr, err := sdk.ValAddressFromBech32(slash.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DerivativeSlashKeyPrefix)
+	bz := k.cdc.MustMarshal(&slash)
+	store.Set(types.DerivativeSlashKey(valAddr, slash.Height), bz)
+}
+
+// GetLatestDerivativeSlash returns the most recent slash of a validator
+// backing derivatives. Lending markets can use this to apply a haircut or to
+// pause a derivative denom after a slash.
+func (k Keeper) GetLatestDerivativeSlash(ctx sdk.Context, valAddr sdk.ValAddress) (types.DerivativeSlash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DerivativeSlashKeyPrefix)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.DerivativeSlashValidatorKey(valAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.DerivativeSlash{}, false
+	}
+
+	var slash types.DerivativeSlash
+	k.cdc.MustUnmarshal(iterator.Value(), &slash)
+
+	return slash, true
+}
+
+// IterateDerivativeSlashes iterates over all derivative slashes and performs
+// a callback function.
+func (k Keeper) IterateDerivativeSlashes(ctx sdk.Context, cb func(slash types.DerivativeSlash) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DerivativeSlashKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var slash types.DerivativeSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &slash)
+		if cb(slash) {
+			break
+		}
+	}
+}
+
+// GetAllDerivativeSlashes returns all derivative slashes.
+func (k Keeper) GetAllDerivativeSlashes(ctx sdk.Context) types.DerivativeSlashes {
+	slashes := types.DerivativeSlashes{}
+
+	k.IterateDerivativeSlashes(ctx, func(slash types.DerivativeSlash) bool {
+		slashes = append(slashes, slash)
+		return false
+	})
+
+	return slashes
+}
+
+// RecordDerivativeSlash records a slash of a validator that the liquid module
+// holds a delegation with, and emits an event with the change in the
+// derivative exchange rate. It must be called before the slash is applied.
+func (k Keeper) RecordDerivativeSlash(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	modAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, modAddr, valAddr)
+	if !found {
+		return
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found || !validator.DelegatorShares.IsPositive() {
+		return
+	}
+
+	// bkava is 1:1 to delegation shares
+	rateBefore := validator.TokensFromShares(sdk.OneDec())
+	rateAfter := rateBefore.Mul(sdk.OneDec().Sub(fraction))
+	tokensSlashed := validator.TokensFromShares(delegation.Shares).Mul(fraction).TruncateInt()
+
+	// Multiple slashes of the same validator in one block are combined
+	slash := types.NewDerivativeSlash(
+		valAddr, ctx.BlockHeight(), ctx.BlockTime(), fraction, rateBefore, rateAfter, tokensSlashed,
+	)
+	if existing, found := k.GetDerivativeSlash(ctx, valAddr, ctx.BlockHeight()); found {
+		remaining := sdk.OneDec().Sub(existing.Fraction).Mul(sdk.OneDec().Sub(fraction))
+		slash.Fraction = sdk.OneDec().Sub(remaining)
+		slash.ExchangeRateBefore = existing.ExchangeRateBefore
+		slash.TokensSlashed = existing.TokensSlashed.Add(tokensSlashed)
+	}
+	k.SetDerivativeSlash(ctx, slash)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDerivativeSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, k.GetLiquidStakingTokenDenom(valAddr)),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyExchangeRateBefore, rateBefore.String()),
+			sdk.NewAttribute(types.AttributeKeyExchangeRateAfter, rateAfter.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensSlashed, tokensSlashed.String()),
+		),
+	)
+}
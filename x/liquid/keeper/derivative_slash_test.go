@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordDerivativeSlash() {
+	valAddr, _, _ := suite.setupUnstakePool()
+	otherVal := sdk.ValAddress(suite.CreateAccount(suite.NewBondCoins(i(1e9)), 2).GetAddress())
+	suite.CreateNewUnbondedValidator(otherVal, i(1e9))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	// Validators without derivatives are not recorded
+	suite.SlashValidator(otherVal, d("0.1"))
+	_, found := suite.Keeper.GetLatestDerivativeSlash(suite.Ctx, otherVal)
+	suite.False(found)
+
+	suite.SlashValidator(valAddr, d("0.1"))
+
+	expected := types.NewDerivativeSlash(
+		valAddr, suite.Ctx.BlockHeight(), suite.Ctx.BlockTime(), d("0.1"), d("1"), d("0.9"), i(1e5),
+	)
+	slash, found := suite.Keeper.GetLatestDerivativeSlash(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.Equal(expected, slash)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeDerivativeSlash,
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDenom, suite.Keeper.GetLiquidStakingTokenDenom(valAddr)),
+		sdk.NewAttribute(types.AttributeKeyFraction, d("0.1").String()),
+		sdk.NewAttribute(types.AttributeKeyExchangeRateBefore, d("1").String()),
+		sdk.NewAttribute(types.AttributeKeyExchangeRateAfter, d("0.9").String()),
+		sdk.NewAttribute(types.AttributeKeyTokensSlashed, i(1e5).String()),
+	))
+
+	// A second slash in the same block is combined with the first
+	suite.SlashValidator(valAddr, d("0.5"))
+
+	slash, found = suite.Keeper.GetLatestDerivativeSlash(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.Equal(d("0.55"), slash.Fraction)
+	suite.Equal(d("1"), slash.ExchangeRateBefore)
+	suite.Equal(d("0.45"), slash.ExchangeRateAfter)
+	suite.Equal(i(55e4), slash.TokensSlashed)
+
+	// Later slashes are recorded separately
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	suite.SlashValidator(valAddr, d("0.1"))
+
+	slash, found = suite.Keeper.GetLatestDerivativeSlash(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockHeight(), slash.Height)
+	suite.Equal(d("0.45"), slash.ExchangeRateBefore)
+	suite.Equal(d("0.405"), slash.ExchangeRateAfter)
+
+	suite.Len(suite.Keeper.GetAllDerivativeSlashes(suite.Ctx), 2)
+}
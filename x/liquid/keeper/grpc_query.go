@@ -2,10 +2,11 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc/codes"
@@ -36,7 +37,10 @@ func (s queryServer) DelegatedBalance(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
 	}
 
-	delegated := s.getDelegatedBalance(ctx, delegator)
+	delegated, err := s.getDelegatedBalance(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
 
 	bondDenom := s.keeper.stakingKeeper.BondDenom(ctx)
 	vesting := s.getVesting(ctx, delegator).AmountOf(bondDenom)
@@ -68,20 +72,310 @@ func (s queryServer) TotalSupply(
 	}, nil
 }
 
-func (s queryServer) getDelegatedBalance(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int {
+func (s queryServer) Params(
+	goCtx context.Context,
+	req *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := s.keeper.mustGetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (s queryServer) UnstakePool(
+	goCtx context.Context,
+	req *types.QueryUnstakePoolRequest,
+) (*types.QueryUnstakePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool := s.keeper.mustGetUnstakePool(ctx)
+
+	totalValue, err := s.keeper.GetUnstakePoolValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnstakePoolResponse{
+		TotalShares: pool.TotalShares,
+		Liquidity:   s.keeper.GetUnstakePoolLiquidity(ctx),
+		Unbonding:   s.keeper.GetUnstakePoolUnbonding(ctx),
+		Derivatives: s.keeper.GetUnstakePoolDerivatives(ctx),
+		TotalValue:  totalValue,
+	}, nil
+}
+
+func (s queryServer) UnstakePoolDeposit(
+	goCtx context.Context,
+	req *types.QueryUnstakePoolDepositRequest,
+) (*types.QueryUnstakePoolDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(req.Depositor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid depositor address: %s", err)
+	}
+
+	deposit, found := s.keeper.GetUnstakePoolDeposit(ctx, depositor)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no unstake pool deposit found for %s", depositor)
+	}
+
+	value, err := s.keeper.GetUnstakePoolDepositValue(ctx, deposit.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnstakePoolDepositResponse{
+		Shares: deposit.Shares,
+		Value:  value,
+	}, nil
+}
+
+func (s queryServer) Derivatives(
+	goCtx context.Context,
+	req *types.QueryDerivativesRequest,
+) (*types.QueryDerivativesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	modAddr := s.keeper.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	store := prefix.NewStore(ctx.KVStore(s.keeper.stakingKey), stakingtypes.GetDelegationsKey(modAddr))
+
+	derivatives := []types.DerivativeInfo{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		delegation, err := stakingtypes.UnmarshalDelegation(s.keeper.cdc, value)
+		if err != nil {
+			return err
+		}
+
+		validator, found := s.keeper.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return status.Errorf(codes.NotFound, "validator %s for delegation not found", delegation.GetValidatorAddr())
+		}
+
+		info, err := s.getDerivativeInfo(ctx, validator, delegation.Shares)
+		if err != nil {
+			return err
+		}
+		derivatives = append(derivatives, info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDerivativesResponse{
+		Derivatives: derivatives,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (s queryServer) Derivative(
+	goCtx context.Context,
+	req *types.QueryDerivativeRequest,
+) (*types.QueryDerivativeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := types.ParseLiquidStakingTokenDenom(req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid derivative denom: %s", err)
+	}
+	if req.Denom != s.keeper.GetLiquidStakingTokenDenom(valAddr) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid derivative denom: %s", req.Denom)
+	}
+
+	validator, found := s.keeper.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", valAddr)
+	}
+
+	shares := sdk.ZeroDec()
+	modAddr := s.keeper.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	if delegation, found := s.keeper.stakingKeeper.GetDelegation(ctx, modAddr, valAddr); found {
+		shares = delegation.Shares
+	}
+
+	info, err := s.getDerivativeInfo(ctx, validator, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDerivativeResponse{
+		Derivative: info,
+	}, nil
+}
+
+func (s queryServer) DerivativeSlashes(
+	goCtx context.Context,
+	req *types.QueryDerivativeSlashesRequest,
+) (*types.QueryDerivativeSlashesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := types.ParseLiquidStakingTokenDenom(req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid derivative denom: %s", err)
+	}
+	if req.Denom != s.keeper.GetLiquidStakingTokenDenom(valAddr) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid derivative denom: %s", req.Denom)
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(s.keeper.key),
+		append(types.DerivativeSlashKeyPrefix, types.DerivativeSlashValidatorKey(valAddr)...),
+	)
+
+	slashes := []types.DerivativeSlash{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var slash types.DerivativeSlash
+		if err := s.keeper.cdc.Unmarshal(value, &slash); err != nil {
+			return err
+		}
+		slashes = append(slashes, slash)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDerivativeSlashesResponse{
+		Slashes:    slashes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (s queryServer) SimulateMint(
+	goCtx context.Context,
+	req *types.QuerySimulateMintRequest,
+) (*types.QuerySimulateMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	bondDenom := s.keeper.stakingKeeper.BondDenom(ctx)
+	conversions := []types.DerivativeConversion{}
+	pageRes, err := s.paginateValidators(ctx, req.Pagination, func(validator stakingtypes.Validator) {
+		// Derivatives are minted 1:1 with delegation shares, rounded down
+		derivativeAmount := sdkmath.ZeroInt()
+		if shares, err := validator.SharesFromTokens(req.Amount); err == nil {
+			derivativeAmount = shares.TruncateInt()
+		}
+
+		conversions = append(conversions, types.DerivativeConversion{
+			Validator: validator.OperatorAddress,
+			Input:     sdk.NewCoin(bondDenom, req.Amount),
+			Output:    sdk.NewCoin(s.keeper.GetLiquidStakingTokenDenom(validator.GetOperator()), derivativeAmount),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateMintResponse{
+		Conversions: conversions,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (s queryServer) SimulateBurn(
+	goCtx context.Context,
+	req *types.QuerySimulateBurnRequest,
+) (*types.QuerySimulateBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	bondDenom := s.keeper.stakingKeeper.BondDenom(ctx)
+	conversions := []types.DerivativeConversion{}
+	pageRes, err := s.paginateValidators(ctx, req.Pagination, func(validator stakingtypes.Validator) {
+		tokens := sdkmath.ZeroInt()
+		if validator.DelegatorShares.IsPositive() {
+			tokens = validator.TokensFromSharesTruncated(sdk.NewDecFromInt(req.Amount)).TruncateInt()
+		}
+
+		conversions = append(conversions, types.DerivativeConversion{
+			Validator: validator.OperatorAddress,
+			Input:     sdk.NewCoin(s.keeper.GetLiquidStakingTokenDenom(validator.GetOperator()), req.Amount),
+			Output:    sdk.NewCoin(bondDenom, tokens),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateBurnResponse{
+		Conversions: conversions,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// getDerivativeInfo returns the derivative info of a validator given the delegation shares held by the liquid module.
+func (s queryServer) getDerivativeInfo(
+	ctx sdk.Context, validator stakingtypes.Validator, shares sdk.Dec,
+) (types.DerivativeInfo, error) {
+	denom := s.keeper.GetLiquidStakingTokenDenom(validator.GetOperator())
+
+	value, err := s.keeper.GetDerivativeValue(ctx, denom)
+	if err != nil {
+		return types.DerivativeInfo{}, status.Error(codes.Internal, err.Error())
+	}
+
+	// bkava is 1:1 to delegation shares
+	exchangeRate := sdk.ZeroDec()
+	if validator.DelegatorShares.IsPositive() {
+		exchangeRate = validator.TokensFromShares(sdk.OneDec())
+	}
+
+	return types.DerivativeInfo{
+		Denom:            denom,
+		Validator:        validator.OperatorAddress,
+		Supply:           s.keeper.bankKeeper.GetSupply(ctx, denom).Amount,
+		DelegationShares: shares,
+		DelegatedTokens:  value.Amount,
+		ExchangeRate:     exchangeRate,
+	}, nil
+}
+
+// paginateValidators performs a callback function on each validator in the requested page of validators.
+func (s queryServer) paginateValidators(
+	ctx sdk.Context, pagination *query.PageRequest, cb func(validator stakingtypes.Validator),
+) (*query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(s.keeper.stakingKey), stakingtypes.ValidatorsKey)
+
+	return query.Paginate(store, pagination, func(_, value []byte) error {
+		validator, err := stakingtypes.UnmarshalValidator(s.keeper.cdc, value)
+		if err != nil {
+			return err
+		}
+
+		cb(validator)
+		return nil
+	})
+}
+
+func (s queryServer) getDelegatedBalance(ctx sdk.Context, delegator sdk.AccAddress) (sdkmath.Int, error) {
 	balance := sdk.ZeroDec()
 
+	var err error
 	s.keeper.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) bool {
 		validator, found := s.keeper.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
 		if !found {
-			panic(fmt.Sprintf("validator %s for delegation not found", delegation.GetValidatorAddr()))
+			err = status.Errorf(codes.NotFound, "validator %s for delegation not found", delegation.GetValidatorAddr())
+			return true
 		}
 		tokens := validator.TokensFromSharesTruncated(delegation.GetShares())
 		balance = balance.Add(tokens)
 
 		return false
 	})
-	return balance.TruncateInt()
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return balance.TruncateInt(), nil
 }
 
 func (s queryServer) getVesting(ctx sdk.Context, delegator sdk.AccAddress) sdk.Coins {
@@ -2,11 +2,13 @@ package keeper_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/stretchr/testify/suite"
 
@@ -290,3 +292,225 @@ func (suite *grpcQueryTestSuite) TestQueryTotalSupply() {
 		})
 	}
 }
+
+func (suite *grpcQueryTestSuite) TestQueryUnstakePool() {
+	lp := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 0).GetAddress()
+
+	_, err := suite.queryClient.UnstakePoolDeposit(
+		context.Background(),
+		&types.QueryUnstakePoolDepositRequest{Depositor: lp.String()},
+	)
+	suite.Require().Error(err)
+
+	_, err = suite.Keeper.DepositUnstakePool(suite.Ctx, lp, suite.NewBondCoin(i(1e6)))
+	suite.Require().NoError(err)
+	suite.AddCoinsToModule(types.UnstakePoolAccountName, suite.NewBondCoins(i(1e6)))
+
+	res, err := suite.queryClient.UnstakePool(context.Background(), &types.QueryUnstakePoolRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryUnstakePoolResponse{
+		TotalShares: d("1000000"),
+		Liquidity:   suite.NewBondCoin(i(2e6)),
+		Unbonding:   suite.NewBondCoin(sdk.ZeroInt()),
+		Derivatives: nil,
+		TotalValue:  suite.NewBondCoin(i(2e6)),
+	}, res)
+
+	depositRes, err := suite.queryClient.UnstakePoolDeposit(
+		context.Background(),
+		&types.QueryUnstakePoolDepositRequest{Depositor: lp.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Equal(d("1000000"), depositRes.Shares)
+	suite.Equal(suite.NewBondCoin(i(2e6)), depositRes.Value)
+
+	paramsRes, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(types.DefaultParams(), paramsRes.Params)
+}
+
+// setupDerivatives creates two bonded validators and mints derivatives of the first, which is then slashed by 10%.
+func (suite *grpcQueryTestSuite) setupDerivatives() (sdk.ValAddress, sdk.ValAddress) {
+	initBalance := suite.NewBondCoin(i(1e9))
+	val1Acc := suite.CreateAccount(sdk.NewCoins(initBalance), 0)
+	val2Acc := suite.CreateAccount(sdk.NewCoins(initBalance), 1)
+	delAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 2)
+	val1, val2 := sdk.ValAddress(val1Acc.GetAddress()), sdk.ValAddress(val2Acc.GetAddress())
+
+	suite.CreateNewUnbondedValidator(val1, initBalance.Amount)
+	suite.CreateNewUnbondedValidator(val2, initBalance.Amount)
+	suite.CreateDelegation(val1, delAcc.GetAddress(), initBalance.Amount)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper) // bond the validators
+
+	_, err := suite.Keeper.MintDerivative(suite.Ctx, delAcc.GetAddress(), val1, suite.NewBondCoin(i(500e6)))
+	suite.Require().NoError(err)
+
+	suite.SlashValidator(val1, d("0.1"))
+
+	return val1, val2
+}
+
+func (suite *grpcQueryTestSuite) TestQueryDerivatives() {
+	val1, val2 := suite.setupDerivatives()
+
+	expected := types.DerivativeInfo{
+		Denom:            suite.Keeper.GetLiquidStakingTokenDenom(val1),
+		Validator:        val1.String(),
+		Supply:           i(500e6),
+		DelegationShares: d("500000000"),
+		DelegatedTokens:  i(450e6),
+		ExchangeRate:     d("0.9"),
+	}
+
+	res, err := suite.queryClient.Derivatives(context.Background(), &types.QueryDerivativesRequest{})
+	suite.Require().NoError(err)
+	suite.Equal([]types.DerivativeInfo{expected}, res.Derivatives)
+	suite.Equal(uint64(1), res.Pagination.Total)
+
+	derivativeRes, err := suite.queryClient.Derivative(
+		context.Background(),
+		&types.QueryDerivativeRequest{Denom: expected.Denom},
+	)
+	suite.Require().NoError(err)
+	suite.Equal(expected, derivativeRes.Derivative)
+
+	// Validators without derivatives have no supply or delegation
+	derivativeRes, err = suite.queryClient.Derivative(
+		context.Background(),
+		&types.QueryDerivativeRequest{Denom: suite.Keeper.GetLiquidStakingTokenDenom(val2)},
+	)
+	suite.Require().NoError(err)
+	suite.Equal(types.DerivativeInfo{
+		Denom:            suite.Keeper.GetLiquidStakingTokenDenom(val2),
+		Validator:        val2.String(),
+		Supply:           sdk.ZeroInt(),
+		DelegationShares: sdk.ZeroDec(),
+		DelegatedTokens:  sdk.ZeroInt(),
+		ExchangeRate:     sdk.OneDec(),
+	}, derivativeRes.Derivative)
+
+	_, err = suite.queryClient.Derivative(context.Background(), &types.QueryDerivativeRequest{Denom: "ukava"})
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryDerivatives_Pagination() {
+	val1, val2 := suite.setupDerivatives()
+
+	delegator := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 3).GetAddress()
+	suite.CreateDelegation(val2, delegator, i(1e9))
+	_, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, val2, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Derivatives(context.Background(), &types.QueryDerivativesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Derivatives, 1)
+	suite.Equal(uint64(2), res.Pagination.Total)
+	suite.NotNil(res.Pagination.NextKey)
+
+	nextRes, err := suite.queryClient.Derivatives(context.Background(), &types.QueryDerivativesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(nextRes.Derivatives, 1)
+	suite.Nil(nextRes.Pagination.NextKey)
+
+	suite.ElementsMatch(
+		[]string{val1.String(), val2.String()},
+		[]string{res.Derivatives[0].Validator, nextRes.Derivatives[0].Validator},
+	)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryDerivativeSlashes() {
+	val1, val2 := suite.setupDerivatives()
+	denom1 := suite.Keeper.GetLiquidStakingTokenDenom(val1)
+
+	firstHeight := suite.Ctx.BlockHeight()
+	suite.Ctx = suite.Ctx.WithBlockHeight(firstHeight + 1)
+	suite.SlashValidator(val1, d("0.5"))
+
+	res, err := suite.queryClient.DerivativeSlashes(
+		context.Background(),
+		&types.QueryDerivativeSlashesRequest{Denom: denom1},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Slashes, 2)
+	suite.Equal(firstHeight, res.Slashes[0].Height)
+	suite.Equal(d("0.9"), res.Slashes[0].ExchangeRateAfter)
+	suite.Equal(d("0.45"), res.Slashes[1].ExchangeRateAfter)
+
+	// The latest slash is returned first in reverse
+	res, err = suite.queryClient.DerivativeSlashes(
+		context.Background(),
+		&types.QueryDerivativeSlashesRequest{
+			Denom:      denom1,
+			Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Slashes, 1)
+	suite.Equal(firstHeight+1, res.Slashes[0].Height)
+
+	res, err = suite.queryClient.DerivativeSlashes(
+		context.Background(),
+		&types.QueryDerivativeSlashesRequest{Denom: suite.Keeper.GetLiquidStakingTokenDenom(val2)},
+	)
+	suite.Require().NoError(err)
+	suite.Empty(res.Slashes)
+
+	_, err = suite.queryClient.DerivativeSlashes(
+		context.Background(),
+		&types.QueryDerivativeSlashesRequest{Denom: "ukava"},
+	)
+	suite.Require().Error(err)
+
+	// Non-canonical denoms of a validator are rejected
+	_, err = suite.queryClient.DerivativeSlashes(
+		context.Background(),
+		&types.QueryDerivativeSlashesRequest{Denom: types.DefaultDerivativeDenom + types.DenomSeparator + strings.ToUpper(val1.String())},
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestQuerySimulateMintAndBurn() {
+	val1, val2 := suite.setupDerivatives()
+	denom1 := suite.Keeper.GetLiquidStakingTokenDenom(val1)
+	denom2 := suite.Keeper.GetLiquidStakingTokenDenom(val2)
+
+	// The test app genesis includes a validator
+	numValidators := len(suite.StakingKeeper.GetAllValidators(suite.Ctx))
+
+	mintRes, err := suite.queryClient.SimulateMint(context.Background(), &types.QuerySimulateMintRequest{Amount: i(9e6)})
+	suite.Require().NoError(err)
+	suite.Len(mintRes.Conversions, numValidators)
+	suite.Contains(mintRes.Conversions, types.DerivativeConversion{
+		Validator: val1.String(), Input: suite.NewBondCoin(i(9e6)), Output: c(denom1, 10e6),
+	})
+	suite.Contains(mintRes.Conversions, types.DerivativeConversion{
+		Validator: val2.String(), Input: suite.NewBondCoin(i(9e6)), Output: c(denom2, 9e6),
+	})
+	suite.Equal(uint64(numValidators), mintRes.Pagination.Total)
+
+	burnRes, err := suite.queryClient.SimulateBurn(context.Background(), &types.QuerySimulateBurnRequest{Amount: i(10e6)})
+	suite.Require().NoError(err)
+	suite.Len(burnRes.Conversions, numValidators)
+	suite.Contains(burnRes.Conversions, types.DerivativeConversion{
+		Validator: val1.String(), Input: c(denom1, 10e6), Output: suite.NewBondCoin(i(9e6)),
+	})
+	suite.Contains(burnRes.Conversions, types.DerivativeConversion{
+		Validator: val2.String(), Input: c(denom2, 10e6), Output: suite.NewBondCoin(i(10e6)),
+	})
+
+	// Results are paginated across validators
+	pageRes, err := suite.queryClient.SimulateBurn(context.Background(), &types.QuerySimulateBurnRequest{
+		Amount:     i(10e6),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Len(pageRes.Conversions, 1)
+	suite.Equal(burnRes.Conversions[1], pageRes.Conversions[0])
+
+	_, err = suite.queryClient.SimulateMint(context.Background(), &types.QuerySimulateMintRequest{Amount: i(0)})
+	suite.Require().Error(err)
+}
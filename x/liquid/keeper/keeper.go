@@ -5,6 +5,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/liquid/types"
@@ -12,40 +13,76 @@ import (
 
 // Keeper struct for the liquid module.
 type Keeper struct {
+	key storetypes.StoreKey
 	cdc codec.Codec
 
+	// stakingKey is the staking module store key, used to paginate queries over delegations and validators
+	stakingKey storetypes.StoreKey
+
 	accountKeeper      types.AccountKeeper
 	bankKeeper         types.BankKeeper
 	stakingKeeper      types.StakingKeeper
 	distributionKeeper types.DistributionKeeper
 
+	hooks types.LiquidHooks
+
 	derivativeDenom string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority sdk.AccAddress
 }
 
 // NewKeeper returns a new keeper for the liquid module.
 func NewKeeper(
-	cdc codec.Codec,
+	cdc codec.Codec, key storetypes.StoreKey, stakingKey storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper,
-	derivativeDenom string,
+	derivativeDenom string, authority sdk.AccAddress,
 ) Keeper {
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
 
 	return Keeper{
+		key:                key,
 		cdc:                cdc,
+		stakingKey:         stakingKey,
 		accountKeeper:      ak,
 		bankKeeper:         bk,
 		stakingKeeper:      sk,
 		distributionKeeper: dk,
 		derivativeDenom:    derivativeDenom,
+		authority:          authority,
 	}
 }
 
 // NewDefaultKeeper returns a new keeper for the liquid module with default values.
 func NewDefaultKeeper(
-	cdc codec.Codec,
+	cdc codec.Codec, key storetypes.StoreKey, stakingKey storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper,
+	authority sdk.AccAddress,
 ) Keeper {
 
-	return NewKeeper(cdc, ak, bk, sk, dk, types.DefaultDerivativeDenom)
+	return NewKeeper(cdc, key, stakingKey, ak, bk, sk, dk, types.DefaultDerivativeDenom, authority)
+}
+
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.LiquidHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set liquid hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// ClearHooks clears the hooks on the keeper
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
+}
+
+// GetAuthority returns the x/liquid module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
 }
 
 // Logger returns a module-specific logger.
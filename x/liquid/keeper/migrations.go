@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2. Version 1 had no store, so the
+// default params and an empty instant unstake pool are set.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	m.keeper.SetUnstakePool(ctx, types.DefaultUnstakePool())
+
+	return nil
+}
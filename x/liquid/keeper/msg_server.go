@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/liquid/types"
 )
@@ -82,3 +84,106 @@ func (k msgServer) BurnDerivative(goCtx context.Context, msg *types.MsgBurnDeriv
 		Received: sharesReceived,
 	}, nil
 }
+
+// DepositUnstakePool handles DepositUnstakePool msgs.
+func (k msgServer) DepositUnstakePool(goCtx context.Context, msg *types.MsgDepositUnstakePool) (*types.MsgDepositUnstakePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := k.keeper.DepositUnstakePool(ctx, depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+
+	return &types.MsgDepositUnstakePoolResponse{
+		Shares: shares,
+	}, nil
+}
+
+// WithdrawUnstakePool handles WithdrawUnstakePool msgs.
+func (k msgServer) WithdrawUnstakePool(goCtx context.Context, msg *types.MsgWithdrawUnstakePool) (*types.MsgWithdrawUnstakePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := k.keeper.WithdrawUnstakePool(ctx, depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+
+	return &types.MsgWithdrawUnstakePoolResponse{
+		Shares: shares,
+	}, nil
+}
+
+// InstantUnstake handles InstantUnstake msgs.
+func (k msgServer) InstantUnstake(goCtx context.Context, msg *types.MsgInstantUnstake) (*types.MsgInstantUnstakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	received, err := k.keeper.InstantUnstake(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgInstantUnstakeResponse{
+		Received: received,
+	}, nil
+}
+
+// UpdateParams handles UpdateParams msgs.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.keeper.GetAuthority().String() != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			k.keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	k.keeper.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
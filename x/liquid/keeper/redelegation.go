@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// GetPendingRedelegation returns a delegator's pending redelegation to a validator.
+func (k Keeper) GetPendingRedelegation(
+	ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress,
+) (types.PendingRedelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingRedelegationKeyPrefix)
+
+	bz := store.Get(types.PendingRedelegationKey(delegator, valAddr))
+	if bz == nil {
+		return types.PendingRedelegation{}, false
+	}
+
+	var redelegation types.PendingRedelegation
+	k.cdc.MustUnmarshal(bz, &redelegation)
+
+	return redelegation, true
+}
+
+// SetPendingRedelegation stores a pending redelegation and adds it to the
+// completion queue, replacing any pending redelegation of the same delegator
+// to the same validator.
+func (k Keeper) SetPendingRedelegation(ctx sdk.Context, redelegation types.PendingRedelegation) {
+	delegator, valAddr := mustParsePendingRedelegation(redelegation)
+
+	if existing, found := k.GetPendingRedelegation(ctx, delegator, valAddr); found {
+		k.DeletePendingRedelegation(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingRedelegationKeyPrefix)
+	bz := k.cdc.MustMarshal(&redelegation)
+	store.Set(types.PendingRedelegationKey(delegator, valAddr), bz)
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.PendingRedelegationQueueKeyPrefix)
+	queueStore.Set(types.PendingRedelegationQueueKey(redelegation.CompletionTime, delegator, valAddr), []byte{})
+}
+
+// DeletePendingRedelegation removes a pending redelegation and its entry in the
+// completion queue.
+func (k Keeper) DeletePendingRedelegation(ctx sdk.Context, redelegation types.PendingRedelegation) {
+	delegator, valAddr := mustParsePendingRedelegation(redelegation)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingRedelegationKeyPrefix)
+	store.Delete(types.PendingRedelegationKey(delegator, valAddr))
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.PendingRedelegationQueueKeyPrefix)
+	queueStore.Delete(types.PendingRedelegationQueueKey(redelegation.CompletionTime, delegator, valAddr))
+}
+
+// IteratePendingRedelegations iterates over all pending redelegations and
+// performs a callback function.
+func (k Keeper) IteratePendingRedelegations(ctx sdk.Context, cb func(redelegation types.PendingRedelegation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingRedelegationKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var redelegation types.PendingRedelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		if cb(redelegation) {
+			break
+		}
+	}
+}
+
+// GetAllPendingRedelegations returns all pending redelegations.
+func (k Keeper) GetAllPendingRedelegations(ctx sdk.Context) types.PendingRedelegations {
+	redelegations := types.PendingRedelegations{}
+
+	k.IteratePendingRedelegations(ctx, func(redelegation types.PendingRedelegation) bool {
+		redelegations = append(redelegations, redelegation)
+		return false
+	})
+
+	return redelegations
+}
+
+// getCompletedPendingRedelegations returns the pending redelegations with a
+// completion time before the block time.
+func (k Keeper) getCompletedPendingRedelegations(ctx sdk.Context) types.PendingRedelegations {
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.PendingRedelegationQueueKeyPrefix)
+	iterator := queueStore.Iterator(nil, sdk.FormatTimeBytes(ctx.BlockTime()))
+	defer iterator.Close()
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingRedelegationKeyPrefix)
+	redelegations := types.PendingRedelegations{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(sdk.FormatTimeBytes(time.Time{})):]
+
+		var redelegation types.PendingRedelegation
+		k.cdc.MustUnmarshal(store.Get(key), &redelegation)
+		redelegations = append(redelegations, redelegation)
+	}
+
+	return redelegations
+}
+
+// RedelegateDerivative converts a delegator's staking derivatives of one validator into staking derivatives of
+// another validator.
+//
+// The derivatives are burned and the returned delegation redelegated. A delegation receiving a redelegation can
+// still be slashed for the source validator, so it cannot be converted to derivatives until the redelegation
+// completes. Redelegations that complete immediately are minted and returned, otherwise the received shares are
+// held as a pending redelegation, and the derivatives minted to the delegator once it completes.
+//
+// depositToEarn is recorded with a pending redelegation so the hooks can return the derivatives to an earn deposit
+// once they are minted. It must match the intent of any redelegation already pending to the same validator.
+func (k Keeper) RedelegateDerivative(
+	ctx sdk.Context, delegator sdk.AccAddress, srcVal, dstVal sdk.ValAddress, amount sdk.Coin, depositToEarn bool,
+) (sdk.Coin, time.Time, error) {
+	if srcVal.Equals(dstVal) {
+		return sdk.Coin{}, time.Time{}, errorsmod.Wrap(types.ErrUntransferableShares, "cannot redelegate to the same validator")
+	}
+	if existing, found := k.GetPendingRedelegation(ctx, delegator, dstVal); found && existing.DepositToEarn != depositToEarn {
+		return sdk.Coin{}, time.Time{}, errorsmod.Wrapf(
+			types.ErrUntransferableShares,
+			"pending redelegation to %s has deposit to earn %t", dstVal, existing.DepositToEarn,
+		)
+	}
+
+	sharesReturned, err := k.BurnDerivative(ctx, delegator, srcVal, amount)
+	if err != nil {
+		return sdk.Coin{}, time.Time{}, err
+	}
+
+	sharesBefore := sdk.ZeroDec()
+	if delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, dstVal); found {
+		sharesBefore = delegation.Shares
+	}
+
+	completionTime, err := k.stakingKeeper.BeginRedelegation(ctx, delegator, srcVal, dstVal, sharesReturned)
+	if err != nil {
+		return sdk.Coin{}, time.Time{}, err
+	}
+
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, dstVal)
+	if !found {
+		return sdk.Coin{}, time.Time{}, types.ErrNoDelegatorForAddress
+	}
+	shares := delegation.Shares.Sub(sharesBefore)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedelegateDerivative,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, dstVal.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	if k.stakingKeeper.HasReceivingRedelegation(ctx, delegator, dstVal) {
+		// Shares already pending for the validator are released together
+		// with the new shares, when the latest redelegation completes
+		if existing, found := k.GetPendingRedelegation(ctx, delegator, dstVal); found {
+			shares = shares.Add(existing.Shares)
+		}
+		k.SetPendingRedelegation(ctx, types.NewPendingRedelegation(delegator, dstVal, shares, completionTime, depositToEarn))
+
+		return sdk.NewCoin(k.GetLiquidStakingTokenDenom(dstVal), sdk.ZeroInt()), completionTime, nil
+	}
+
+	minted, err := k.mintDerivativeFromShares(ctx, delegator, dstVal, shares)
+	if err != nil {
+		return sdk.Coin{}, time.Time{}, err
+	}
+
+	return minted, completionTime, nil
+}
+
+// ProcessPendingRedelegations mints the derivatives of pending redelegations
+// that have completed.
+func (k Keeper) ProcessPendingRedelegations(ctx sdk.Context) {
+	for _, redelegation := range k.getCompletedPendingRedelegations(ctx) {
+		delegator, valAddr := mustParsePendingRedelegation(redelegation)
+
+		// A failure for one redelegation must not block minting the others
+		cacheCtx, write := ctx.CacheContext()
+		minted, err := k.mintDerivativeFromShares(cacheCtx, delegator, valAddr, redelegation.Shares)
+		if errorsmod.IsOf(err, types.ErrRedelegationsNotCompleted) {
+			// The delegator redelegated to the validator again outside of
+			// this module, retry once it completes
+			continue
+		}
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to mint pending redelegation derivatives",
+				"delegator", redelegation.Delegator, "validator", redelegation.Validator, "err", err,
+			)
+		} else {
+			write()
+			k.afterPendingRedelegationMinted(ctx, redelegation, minted)
+		}
+
+		k.DeletePendingRedelegation(ctx, redelegation)
+	}
+}
+
+// afterPendingRedelegationMinted calls the hooks for minted pending redelegation
+// derivatives. The derivatives stay with the delegator if the hooks fail.
+func (k Keeper) afterPendingRedelegationMinted(ctx sdk.Context, redelegation types.PendingRedelegation, minted sdk.Coin) {
+	if k.hooks == nil {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.hooks.AfterPendingRedelegationMinted(cacheCtx, redelegation, minted); err != nil {
+		k.Logger(ctx).Error(
+			"pending redelegation hooks failed",
+			"delegator", redelegation.Delegator, "validator", redelegation.Validator, "err", err,
+		)
+		return
+	}
+	write()
+}
+
+// mintDerivativeFromShares mints derivatives for a delegator's delegation
+// shares, limited to the shares remaining in the delegation.
+func (k Keeper) mintDerivativeFromShares(
+	ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) (sdk.Coin, error) {
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegatorForAddress
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	tokens := validator.TokensFromShares(sdk.MinDec(shares, delegation.Shares)).TruncateInt()
+	return k.MintDerivative(ctx, delegator, valAddr, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), tokens))
+}
+
+func mustParsePendingRedelegation(redelegation types.PendingRedelegation) (sdk.AccAddress, sdk.ValAddress) {
+	delegator, err := sdk.AccAddressFromBech32(redelegation.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(redelegation.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return delegator, valAddr
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func (suite *KeeperTestSuite) setupRedelegation() (sdk.AccAddress, sdk.ValAddress, sdk.ValAddress, sdk.Coin) {
+	srcVal := sdk.ValAddress(suite.CreateAccount(suite.NewBondCoins(i(1e9)), 0).GetAddress())
+	dstVal := sdk.ValAddress(suite.CreateAccount(suite.NewBondCoins(i(1e9)), 1).GetAddress())
+	delegator := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 2).GetAddress()
+
+	suite.CreateNewUnbondedValidator(srcVal, i(1e9))
+	suite.CreateNewUnbondedValidator(dstVal, i(1e9))
+	suite.CreateDelegation(srcVal, delegator, i(1e9))
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, srcVal, suite.NewBondCoin(i(1e9)))
+	suite.Require().NoError(err)
+
+	return delegator, srcVal, dstVal, derivative
+}
+
+func (suite *KeeperTestSuite) TestRedelegateDerivative_Unbonded() {
+	delegator, srcVal, dstVal, derivative := suite.setupRedelegation()
+
+	minted, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, delegator, srcVal, dstVal, derivative, false)
+	suite.Require().NoError(err)
+
+	// Redelegations from unbonded validators complete immediately
+	expected := sdk.NewCoin(suite.Keeper.GetLiquidStakingTokenDenom(dstVal), i(1e9))
+	suite.Equal(expected, minted)
+	suite.AccountBalanceEqual(delegator, sdk.NewCoins(expected))
+	suite.Empty(suite.Keeper.GetAllPendingRedelegations(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestRedelegateDerivative_Bonded() {
+	delegator, srcVal, dstVal, derivative := suite.setupRedelegation()
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	half := sdk.NewCoin(derivative.Denom, derivative.Amount.QuoRaw(2))
+	minted, completionTime, err := suite.Keeper.RedelegateDerivative(suite.Ctx, delegator, srcVal, dstVal, half, false)
+	suite.Require().NoError(err)
+	suite.True(minted.IsZero())
+	suite.AccountBalanceEqual(delegator, sdk.NewCoins(half))
+
+	expected := types.NewPendingRedelegation(delegator, dstVal, sdk.NewDecFromInt(half.Amount), completionTime, false)
+	pending, found := suite.Keeper.GetPendingRedelegation(suite.Ctx, delegator, dstVal)
+	suite.Require().True(found)
+	suite.Equal(expected, pending)
+
+	// A later redelegation is combined with the pending one and released when it completes
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	_, completionTime, err = suite.Keeper.RedelegateDerivative(suite.Ctx, delegator, srcVal, dstVal, half, false)
+	suite.Require().NoError(err)
+
+	pending, found = suite.Keeper.GetPendingRedelegation(suite.Ctx, delegator, dstVal)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDecFromInt(derivative.Amount), pending.Shares)
+	suite.Equal(completionTime, pending.CompletionTime)
+	suite.Len(suite.Keeper.GetAllPendingRedelegations(suite.Ctx), 1)
+
+	// Nothing is minted before the redelegation completes
+	suite.Ctx = suite.Ctx.WithBlockTime(completionTime.Add(-time.Second))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+	suite.Keeper.ProcessPendingRedelegations(suite.Ctx)
+	_, found = suite.Keeper.GetPendingRedelegation(suite.Ctx, delegator, dstVal)
+	suite.True(found)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(completionTime.Add(time.Second))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+	suite.Keeper.ProcessPendingRedelegations(suite.Ctx)
+
+	_, found = suite.Keeper.GetPendingRedelegation(suite.Ctx, delegator, dstVal)
+	suite.False(found)
+	suite.AccountBalanceEqual(delegator, sdk.NewCoins(
+		sdk.NewCoin(suite.Keeper.GetLiquidStakingTokenDenom(dstVal), derivative.Amount),
+	))
+}
+
+func (suite *KeeperTestSuite) TestProcessPendingRedelegations_DelegationRemoved() {
+	delegator, srcVal, dstVal, derivative := suite.setupRedelegation()
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	_, completionTime, err := suite.Keeper.RedelegateDerivative(suite.Ctx, delegator, srcVal, dstVal, derivative, false)
+	suite.Require().NoError(err)
+
+	// The delegator can still undelegate the pending shares from the staking module
+	suite.CreateUnbondingDelegation(delegator, dstVal, i(1e9))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(completionTime.Add(time.Second))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+	suite.Keeper.ProcessPendingRedelegations(suite.Ctx)
+
+	suite.Empty(suite.Keeper.GetAllPendingRedelegations(suite.Ctx))
+	balance := suite.BankKeeper.GetBalance(suite.Ctx, delegator, suite.Keeper.GetLiquidStakingTokenDenom(dstVal))
+	suite.True(balance.IsZero(), "balance %s", balance)
+}
+
+func (suite *KeeperTestSuite) TestRedelegateDerivative_DepositToEarnMismatch() {
+	delegator, srcVal, dstVal, derivative := suite.setupRedelegation()
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	half := sdk.NewCoin(derivative.Denom, derivative.Amount.QuoRaw(2))
+	_, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, delegator, srcVal, dstVal, half, true)
+	suite.Require().NoError(err)
+
+	// Derivatives minted to the account cannot be combined with derivatives returning to earn
+	_, _, err = suite.Keeper.RedelegateDerivative(suite.Ctx, delegator, srcVal, dstVal, half, false)
+	suite.Require().ErrorIs(err, types.ErrUntransferableShares)
+
+	pending, found := suite.Keeper.GetPendingRedelegation(suite.Ctx, delegator, dstVal)
+	suite.Require().True(found)
+	suite.True(pending.DepositToEarn)
+	suite.Equal(sdk.NewDecFromInt(half.Amount), pending.Shares)
+}
+
+func (suite *KeeperTestSuite) TestRedelegateDerivative_SameValidator() {
+	delegator, srcVal, _, derivative := suite.setupRedelegation()
+
+	_, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, delegator, srcVal, srcVal, derivative, false)
+	suite.Require().ErrorIs(err, types.ErrUntransferableShares)
+}
@@ -0,0 +1,391 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// GetUnstakePool returns the instant unstake pool from the store.
+func (k Keeper) GetUnstakePool(ctx sdk.Context) (types.UnstakePool, bool) {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(types.UnstakePoolKey)
+	if bz == nil {
+		return types.UnstakePool{}, false
+	}
+
+	var pool types.UnstakePool
+	k.cdc.MustUnmarshal(bz, &pool)
+
+	return pool, true
+}
+
+// SetUnstakePool sets the instant unstake pool in the store.
+func (k Keeper) SetUnstakePool(ctx sdk.Context, pool types.UnstakePool) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshal(&pool)
+	store.Set(types.UnstakePoolKey, bz)
+}
+
+func (k Keeper) mustGetUnstakePool(ctx sdk.Context) types.UnstakePool {
+	pool, found := k.GetUnstakePool(ctx)
+	if !found {
+		panic("invalid state: unstake pool not found")
+	}
+
+	return pool
+}
+
+// GetUnstakePoolDeposit returns the instant unstake pool deposit of an account.
+func (k Keeper) GetUnstakePoolDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.UnstakePoolDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.UnstakePoolDepositKeyPrefix)
+
+	bz := store.Get(types.UnstakePoolDepositKey(depositor))
+	if bz == nil {
+		return types.UnstakePoolDeposit{}, false
+	}
+
+	var deposit types.UnstakePoolDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	return deposit, true
+}
+
+// SetUnstakePoolDeposit sets the instant unstake pool deposit of an account,
+// deleting it if it has no shares.
+func (k Keeper) SetUnstakePoolDeposit(ctx sdk.Context, deposit types.UnstakePoolDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.UnstakePoolDepositKeyPrefix)
+
+	if deposit.Shares.IsZero() {
+		store.Delete(types.UnstakePoolDepositKey(deposit.Depositor))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&deposit)
+	store.Set(types.UnstakePoolDepositKey(deposit.Depositor), bz)
+}
+
+// IterateUnstakePoolDeposits iterates over all instant unstake pool deposits
+// and performs a callback function.
+func (k Keeper) IterateUnstakePoolDeposits(ctx sdk.Context, cb func(deposit types.UnstakePoolDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.UnstakePoolDepositKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.UnstakePoolDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetAllUnstakePoolDeposits returns all instant unstake pool deposits.
+func (k Keeper) GetAllUnstakePoolDeposits(ctx sdk.Context) types.UnstakePoolDeposits {
+	deposits := types.UnstakePoolDeposits{}
+
+	k.IterateUnstakePoolDeposits(ctx, func(deposit types.UnstakePoolDeposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	return deposits
+}
+
+// GetUnstakePoolLiquidity returns the staking tokens held by the instant unstake
+// pool that are available to buy derivatives or to be withdrawn.
+func (k Keeper) GetUnstakePoolLiquidity(ctx sdk.Context) sdk.Coin {
+	poolAddr := k.accountKeeper.GetModuleAddress(types.UnstakePoolAccountName)
+	return k.bankKeeper.GetBalance(ctx, poolAddr, k.stakingKeeper.BondDenom(ctx))
+}
+
+// GetUnstakePoolUnbonding returns the staking tokens the instant unstake pool
+// is waiting on to finish unbonding.
+func (k Keeper) GetUnstakePoolUnbonding(ctx sdk.Context) sdk.Coin {
+	poolAddr := k.accountKeeper.GetModuleAddress(types.UnstakePoolAccountName)
+	return sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), k.stakingKeeper.GetDelegatorUnbonding(ctx, poolAddr))
+}
+
+// GetUnstakePoolDerivatives returns the staking derivatives held by the instant
+// unstake pool that have not been undelegated yet.
+func (k Keeper) GetUnstakePoolDerivatives(ctx sdk.Context) sdk.Coins {
+	poolAddr := k.accountKeeper.GetModuleAddress(types.UnstakePoolAccountName)
+
+	derivatives := sdk.NewCoins()
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, poolAddr) {
+		if k.IsDerivativeDenom(ctx, coin.Denom) {
+			derivatives = derivatives.Add(coin)
+		}
+	}
+
+	return derivatives
+}
+
+// GetUnstakePoolValue returns the total value of the instant unstake pool in
+// staking tokens. This is the liquid staking tokens, the tokens currently
+// unbonding, and the staked value of any derivatives held.
+func (k Keeper) GetUnstakePoolValue(ctx sdk.Context) (sdk.Coin, error) {
+	derivativeValue, err := k.GetStakedTokensForDerivatives(ctx, k.GetUnstakePoolDerivatives(ctx))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return k.GetUnstakePoolLiquidity(ctx).
+		Add(k.GetUnstakePoolUnbonding(ctx)).
+		Add(derivativeValue), nil
+}
+
+// GetUnstakePoolDepositValue returns the value in staking tokens of an amount
+// of instant unstake pool shares.
+func (k Keeper) GetUnstakePoolDepositValue(ctx sdk.Context, shares sdk.Dec) (sdk.Coin, error) {
+	pool := k.mustGetUnstakePool(ctx)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	if pool.TotalShares.IsZero() {
+		return sdk.NewCoin(bondDenom, sdkmath.ZeroInt()), nil
+	}
+
+	poolValue, err := k.GetUnstakePoolValue(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	value := shares.MulInt(poolValue.Amount).Quo(pool.TotalShares).TruncateInt()
+	return sdk.NewCoin(bondDenom, value), nil
+}
+
+// DepositUnstakePool adds staking tokens to the instant unstake pool in return
+// for pool shares. Shares are issued at the current pool value so existing
+// depositors keep the fees earned so far.
+func (k Keeper) DepositUnstakePool(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) (sdk.Dec, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", bondDenom)
+	}
+
+	pool := k.mustGetUnstakePool(ctx)
+
+	shares := sdk.NewDecFromInt(amount.Amount)
+	if pool.TotalShares.IsPositive() {
+		poolValue, err := k.GetUnstakePoolValue(ctx)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if !poolValue.IsPositive() {
+			return sdk.Dec{}, errorsmod.Wrap(types.ErrInsufficientPoolLiquidity, "unstake pool has shares but no value")
+		}
+
+		shares = pool.TotalShares.MulInt(amount.Amount).QuoInt(poolValue.Amount)
+	}
+	if !shares.IsPositive() {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrInsufficientPoolShares, "deposit too small to issue shares")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, depositor, types.UnstakePoolAccountName, sdk.NewCoins(amount),
+	); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	deposit, found := k.GetUnstakePoolDeposit(ctx, depositor)
+	if !found {
+		deposit = types.NewUnstakePoolDeposit(depositor, sdk.ZeroDec())
+	}
+	deposit.Shares = deposit.Shares.Add(shares)
+	k.SetUnstakePoolDeposit(ctx, deposit)
+
+	pool.TotalShares = pool.TotalShares.Add(shares)
+	k.SetUnstakePool(ctx, pool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnstakePoolDeposit,
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+
+	return shares, nil
+}
+
+// WithdrawUnstakePool removes staking tokens from the instant unstake pool,
+// burning the depositor's shares. Only the pool's liquid staking tokens can be
+// withdrawn, tokens that are unbonding become available once unbonding completes.
+func (k Keeper) WithdrawUnstakePool(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) (sdk.Dec, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", bondDenom)
+	}
+
+	deposit, found := k.GetUnstakePoolDeposit(ctx, depositor)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrUnstakePoolDepositNotFound, "no deposit for %s", depositor)
+	}
+
+	depositValue, err := k.GetUnstakePoolDepositValue(ctx, deposit.Shares)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if amount.Amount.GT(depositValue.Amount) {
+		return sdk.Dec{}, errorsmod.Wrapf(
+			types.ErrInsufficientPoolShares, "withdraw of %s exceeds deposit value %s", amount, depositValue,
+		)
+	}
+
+	liquidity := k.GetUnstakePoolLiquidity(ctx)
+	if amount.Amount.GT(liquidity.Amount) {
+		return sdk.Dec{}, errorsmod.Wrapf(
+			types.ErrInsufficientPoolLiquidity, "withdraw of %s exceeds available liquidity %s", amount, liquidity,
+		)
+	}
+
+	pool := k.mustGetUnstakePool(ctx)
+	poolValue, err := k.GetUnstakePoolValue(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// Round up so withdrawals can't take more than the shares are worth
+	shares := pool.TotalShares.MulInt(amount.Amount).QuoRoundUp(sdk.NewDecFromInt(poolValue.Amount))
+	if shares.GT(deposit.Shares) {
+		shares = deposit.Shares
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.UnstakePoolAccountName, depositor, sdk.NewCoins(amount),
+	); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	deposit.Shares = deposit.Shares.Sub(shares)
+	k.SetUnstakePoolDeposit(ctx, deposit)
+
+	pool.TotalShares = pool.TotalShares.Sub(shares)
+	k.SetUnstakePool(ctx, pool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnstakePoolWithdraw,
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+
+	return shares, nil
+}
+
+// InstantUnstake sells staking derivatives to the instant unstake pool for
+// staking tokens. Derivatives are priced at their staked value, less the
+// instant unstake fee which is kept by the pool.
+func (k Keeper) InstantUnstake(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	if !k.IsDerivativeDenom(ctx, amount.Denom) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "'%s' is not a derivative denom", amount.Denom)
+	}
+
+	value, err := k.GetStakedTokensForDerivatives(ctx, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	params := k.mustGetParams(ctx)
+	received := sdk.NewCoin(
+		value.Denom,
+		sdk.NewDecFromInt(value.Amount).Mul(sdk.OneDec().Sub(params.InstantUnstakeFee)).TruncateInt(),
+	)
+	if !received.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidUnstakeAmount, "%s is worth no staking tokens", amount)
+	}
+
+	liquidity := k.GetUnstakePoolLiquidity(ctx)
+	if received.Amount.GT(liquidity.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrInsufficientPoolLiquidity, "unstake of %s exceeds available liquidity %s", received, liquidity,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.UnstakePoolAccountName, sdk.NewCoins(amount),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.UnstakePoolAccountName, sender, sdk.NewCoins(received),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInstantUnstake,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReceived, received.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, value.Sub(received).String()),
+		),
+	)
+
+	return received, nil
+}
+
+// ProcessUnstakePoolUndelegations converts the derivatives held by the instant
+// unstake pool back into delegations and undelegates them, once per
+// undelegation interval. Batching undelegations keeps the pool under the
+// staking module's limit of unbonding entries per validator.
+func (k Keeper) ProcessUnstakePoolUndelegations(ctx sdk.Context) {
+	params := k.mustGetParams(ctx)
+	pool := k.mustGetUnstakePool(ctx)
+
+	if ctx.BlockTime().Before(pool.LastUndelegationTime.Add(params.UndelegationInterval)) {
+		return
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAddress(types.UnstakePoolAccountName)
+	for _, derivative := range k.GetUnstakePoolDerivatives(ctx) {
+		valAddr, err := types.ParseLiquidStakingTokenDenom(derivative.Denom)
+		if err != nil {
+			k.Logger(ctx).Error("failed to parse unstake pool derivative", "denom", derivative.Denom, "err", err)
+			continue
+		}
+
+		// A failure for one validator must not block undelegating the others
+		cacheCtx, write := ctx.CacheContext()
+		completionTime, err := k.undelegateDerivative(cacheCtx, poolAddr, valAddr, derivative)
+		if err != nil {
+			k.Logger(ctx).Error("failed to undelegate unstake pool derivative", "amount", derivative, "err", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnstakePoolUndelegate,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, derivative.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			),
+		)
+	}
+
+	pool.LastUndelegationTime = ctx.BlockTime()
+	k.SetUnstakePool(ctx, pool)
+}
+
+func (k Keeper) undelegateDerivative(
+	ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, derivative sdk.Coin,
+) (time.Time, error) {
+	shares, err := k.BurnDerivative(ctx, delegator, valAddr, derivative)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return k.stakingKeeper.Undelegate(ctx, delegator, valAddr, shares)
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// setupUnstakePool creates a bonded validator and a user holding staking derivatives of it.
+func (suite *KeeperTestSuite) setupUnstakePool() (sdk.ValAddress, sdk.AccAddress, sdk.Coin) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	initialBalance := i(1e9)
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(initialBalance))
+
+	suite.CreateNewUnbondedValidator(valAddr, initialBalance)
+	suite.CreateDelegation(valAddr, user, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, user, valAddr, suite.NewBondCoin(i(1e6)))
+	suite.Require().NoError(err)
+
+	return valAddr, user, derivative
+}
+
+func (suite *KeeperTestSuite) unstakePoolShares(depositor sdk.AccAddress) sdk.Dec {
+	deposit, found := suite.Keeper.GetUnstakePoolDeposit(suite.Ctx, depositor)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return deposit.Shares
+}
+
+func (suite *KeeperTestSuite) TestDepositUnstakePool() {
+	lp1 := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 0).GetAddress()
+	lp2 := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 1).GetAddress()
+
+	// First deposit is issued shares 1:1
+	shares, err := suite.Keeper.DepositUnstakePool(suite.Ctx, lp1, suite.NewBondCoin(i(1e6)))
+	suite.Require().NoError(err)
+	suite.Equal(d("1000000"), shares)
+
+	// Pool value doubles, so later deposits are issued half the shares
+	suite.AddCoinsToModule(types.UnstakePoolAccountName, suite.NewBondCoins(i(1e6)))
+
+	shares, err = suite.Keeper.DepositUnstakePool(suite.Ctx, lp2, suite.NewBondCoin(i(1e6)))
+	suite.Require().NoError(err)
+	suite.Equal(d("500000"), shares)
+
+	suite.Equal(d("1000000"), suite.unstakePoolShares(lp1))
+	suite.Equal(d("500000"), suite.unstakePoolShares(lp2))
+
+	pool, found := suite.Keeper.GetUnstakePool(suite.Ctx)
+	suite.Require().True(found)
+	suite.Equal(d("1500000"), pool.TotalShares)
+
+	value, err := suite.Keeper.GetUnstakePoolValue(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(3e6)), value)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUnstakePoolDeposit,
+		sdk.NewAttribute(types.AttributeKeyDepositor, lp2.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, suite.NewBondCoin(i(1e6)).String()),
+		sdk.NewAttribute(types.AttributeKeyShares, d("500000").String()),
+	))
+
+	_, err = suite.Keeper.DepositUnstakePool(suite.Ctx, lp1, sdk.NewInt64Coin("usdx", 1e6))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+}
+
+func (suite *KeeperTestSuite) TestWithdrawUnstakePool() {
+	lp := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 0).GetAddress()
+	other := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 1).GetAddress()
+
+	_, err := suite.Keeper.DepositUnstakePool(suite.Ctx, lp, suite.NewBondCoin(i(1e6)))
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.WithdrawUnstakePool(suite.Ctx, other, suite.NewBondCoin(i(1)))
+	suite.Require().ErrorIs(err, types.ErrUnstakePoolDepositNotFound)
+
+	_, err = suite.Keeper.WithdrawUnstakePool(suite.Ctx, lp, suite.NewBondCoin(i(1e6+1)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientPoolShares)
+
+	shares, err := suite.Keeper.WithdrawUnstakePool(suite.Ctx, lp, suite.NewBondCoin(i(4e5)))
+	suite.Require().NoError(err)
+	suite.Equal(d("400000"), shares)
+	suite.Equal(d("600000"), suite.unstakePoolShares(lp))
+
+	shares, err = suite.Keeper.WithdrawUnstakePool(suite.Ctx, lp, suite.NewBondCoin(i(6e5)))
+	suite.Require().NoError(err)
+	suite.Equal(d("600000"), shares)
+
+	_, found := suite.Keeper.GetUnstakePoolDeposit(suite.Ctx, lp)
+	suite.False(found, "empty deposits should be deleted")
+	suite.AccountBalanceEqual(lp, suite.NewBondCoins(i(1e9)))
+
+	pool, found := suite.Keeper.GetUnstakePool(suite.Ctx)
+	suite.Require().True(found)
+	suite.True(pool.TotalShares.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestInstantUnstake() {
+	valAddr, user, derivative := suite.setupUnstakePool()
+	lp := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 2).GetAddress()
+
+	// No liquidity in the pool
+	_, err := suite.Keeper.InstantUnstake(suite.Ctx, user, derivative)
+	suite.Require().ErrorIs(err, types.ErrInsufficientPoolLiquidity)
+
+	_, err = suite.Keeper.DepositUnstakePool(suite.Ctx, lp, suite.NewBondCoin(i(10e6)))
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.InstantUnstake(suite.Ctx, user, suite.NewBondCoin(i(1e6)))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	received, err := suite.Keeper.InstantUnstake(suite.Ctx, user, derivative)
+	suite.Require().NoError(err)
+	// default fee of 0.5%
+	suite.Equal(suite.NewBondCoin(i(995000)), received)
+
+	poolAddr := suite.App.GetAccountKeeper().GetModuleAddress(types.UnstakePoolAccountName)
+	suite.AccountBalanceEqual(poolAddr, sdk.NewCoins(suite.NewBondCoin(i(9005000)), derivative))
+	suite.Equal(sdk.NewCoins(derivative), suite.Keeper.GetUnstakePoolDerivatives(suite.Ctx))
+
+	// LPs collect the fee
+	value, err := suite.Keeper.GetUnstakePoolValue(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(10005000)), value)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeInstantUnstake,
+		sdk.NewAttribute(types.AttributeKeySender, user.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, derivative.String()),
+		sdk.NewAttribute(types.AttributeKeyReceived, received.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, suite.NewBondCoin(i(5000)).String()),
+	))
+
+	// Derivatives are still valued at the validator's share price
+	suite.SlashValidator(valAddr, d("0.5"))
+	value, err = suite.Keeper.GetUnstakePoolValue(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(9505000)), value)
+}
+
+func (suite *KeeperTestSuite) TestProcessUnstakePoolUndelegations() {
+	valAddr, user, derivative := suite.setupUnstakePool()
+	lp := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 2).GetAddress()
+
+	_, err := suite.Keeper.DepositUnstakePool(suite.Ctx, lp, suite.NewBondCoin(i(10e6)))
+	suite.Require().NoError(err)
+
+	half := sdk.NewCoin(derivative.Denom, derivative.Amount.QuoRaw(2))
+	_, err = suite.Keeper.InstantUnstake(suite.Ctx, user, half)
+	suite.Require().NoError(err)
+
+	valueBefore, err := suite.Keeper.GetUnstakePoolValue(suite.Ctx)
+	suite.Require().NoError(err)
+
+	suite.Keeper.ProcessUnstakePoolUndelegations(suite.Ctx)
+
+	suite.True(suite.Keeper.GetUnstakePoolDerivatives(suite.Ctx).IsZero())
+	suite.Equal(suite.NewBondCoin(i(5e5)), suite.Keeper.GetUnstakePoolUnbonding(suite.Ctx))
+
+	valueAfter, err := suite.Keeper.GetUnstakePoolValue(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Equal(valueBefore, valueAfter, "undelegating should not change the pool value")
+
+	pool, found := suite.Keeper.GetUnstakePool(suite.Ctx)
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockTime(), pool.LastUndelegationTime)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUnstakePoolUndelegate,
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, half.String()),
+		sdk.NewAttribute(types.AttributeKeyCompletionTime, suite.unbondingCompletionTime().Format(time.RFC3339)),
+	))
+
+	// Derivatives received before the next interval are held by the pool
+	_, err = suite.Keeper.InstantUnstake(suite.Ctx, user, half)
+	suite.Require().NoError(err)
+
+	params, found := suite.Keeper.GetParams(suite.Ctx)
+	suite.Require().True(found)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(params.UndelegationInterval - time.Second))
+	suite.Keeper.ProcessUnstakePoolUndelegations(suite.Ctx)
+	suite.Equal(sdk.NewCoins(half), suite.Keeper.GetUnstakePoolDerivatives(suite.Ctx))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	suite.Keeper.ProcessUnstakePoolUndelegations(suite.Ctx)
+	suite.True(suite.Keeper.GetUnstakePoolDerivatives(suite.Ctx).IsZero())
+	suite.Equal(suite.NewBondCoin(i(1e6)), suite.Keeper.GetUnstakePoolUnbonding(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) unbondingCompletionTime() time.Time {
+	return suite.Ctx.BlockTime().Add(suite.StakingKeeper.UnbondingTime(suite.Ctx))
+}
@@ -13,6 +13,10 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMintDerivative{}, "liquid/MsgMintDerivative", nil)
 	cdc.RegisterConcrete(&MsgBurnDerivative{}, "liquid/MsgBurnDerivative", nil)
+	cdc.RegisterConcrete(&MsgDepositUnstakePool{}, "liquid/MsgDepositUnstakePool", nil)
+	cdc.RegisterConcrete(&MsgWithdrawUnstakePool{}, "liquid/MsgWithdrawUnstakePool", nil)
+	cdc.RegisterConcrete(&MsgInstantUnstake{}, "liquid/MsgInstantUnstake", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "liquid/MsgUpdateParams", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -21,6 +25,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMintDerivative{},
 		&MsgBurnDerivative{},
+		&MsgDepositUnstakePool{},
+		&MsgWithdrawUnstakePool{},
+		&MsgInstantUnstake{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDerivativeSlash returns a new DerivativeSlash.
+func NewDerivativeSlash(
+	validator sdk.ValAddress,
+	height int64,
+	time time.Time,
+	fraction sdk.Dec,
+	exchangeRateBefore sdk.Dec,
+	exchangeRateAfter sdk.Dec,
+	tokensSlashed sdkmath.Int,
+) DerivativeSlash {
+	return DerivativeSlash{
+		Validator:          validator.String(),
+		Height:             height,
+		Time:               time,
+		Fraction:           fraction,
+		ExchangeRateBefore: exchangeRateBefore,
+		ExchangeRateAfter:  exchangeRateAfter,
+		TokensSlashed:      tokensSlashed,
+	}
+}
+
+// Validate performs basic validation of the DerivativeSlash.
+func (s DerivativeSlash) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(s.Validator); err != nil {
+		return fmt.Errorf("invalid derivative slash validator: %w", err)
+	}
+
+	if s.Height < 0 {
+		return fmt.Errorf("derivative slash height must be non-negative, got %d", s.Height)
+	}
+
+	if s.Fraction.IsNil() || !s.Fraction.IsPositive() || s.Fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("derivative slash fraction must be within (0, 1], got %s", s.Fraction)
+	}
+
+	if s.ExchangeRateBefore.IsNil() || s.ExchangeRateBefore.IsNegative() {
+		return fmt.Errorf("derivative slash exchange rate before must be non-negative, got %s", s.ExchangeRateBefore)
+	}
+
+	if s.ExchangeRateAfter.IsNil() || s.ExchangeRateAfter.IsNegative() {
+		return fmt.Errorf("derivative slash exchange rate after must be non-negative, got %s", s.ExchangeRateAfter)
+	}
+
+	if s.TokensSlashed.IsNil() || s.TokensSlashed.IsNegative() {
+		return fmt.Errorf("derivative slash tokens slashed must be non-negative, got %s", s.TokensSlashed)
+	}
+
+	return nil
+}
+
+// DerivativeSlashes is a slice of DerivativeSlash.
+type DerivativeSlashes []DerivativeSlash
+
+// Validate performs basic validation of each slash and checks there is at
+// most one slash per validator per height.
+func (ss DerivativeSlashes) Validate() error {
+	seen := make(map[string]bool)
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", s.Validator, s.Height)
+		if seen[key] {
+			return fmt.Errorf("duplicate derivative slash for validator %s at height %d", s.Validator, s.Height)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/derivative_slash.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DerivativeSlash records a slash of a validator backing staking derivatives. Slashes of the same validator
+// in the same block are combined into one record.
+type DerivativeSlash struct {
+	// validator is the slashed validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// height is the block height the slash was applied.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time the slash was applied.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// fraction is the fraction of the validator's tokens removed by the slash.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// exchange_rate_before is the staking tokens per unit of derivative before the slash.
+	ExchangeRateBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exchange_rate_before,json=exchangeRateBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate_before"`
+	// exchange_rate_after is the staking tokens per unit of derivative after the slash.
+	ExchangeRateAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=exchange_rate_after,json=exchangeRateAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate_after"`
+	// tokens_slashed is the amount of staking tokens backing derivatives removed by the slash.
+	TokensSlashed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=tokens_slashed,json=tokensSlashed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens_slashed"`
+}
+
+func (m *DerivativeSlash) Reset()         { *m = DerivativeSlash{} }
+func (m *DerivativeSlash) String() string { return proto.CompactTextString(m) }
+func (*DerivativeSlash) ProtoMessage()    {}
+func (*DerivativeSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccf8d7f78ebaffa, []int{0}
+}
+func (m *DerivativeSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivativeSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivativeSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivativeSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivativeSlash.Merge(m, src)
+}
+func (m *DerivativeSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivativeSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivativeSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivativeSlash proto.InternalMessageInfo
+
+func (m *DerivativeSlash) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *DerivativeSlash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DerivativeSlash) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*DerivativeSlash)(nil), "kava.liquid.v1beta1.DerivativeSlash")
+}
+
+func init() {
+	proto.RegisterFile("kava/liquid/v1beta1/derivative_slash.proto", fileDescriptor_dccf8d7f78ebaffa)
+}
+
+var fileDescriptor_dccf8d7f78ebaffa = []byte{
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x1a, 0x42, 0xbb, 0x08, 0x10, 0xdb, 0x0a, 0x99, 0x48, 0x38, 0x81, 0x03, 0x8a,
+	0x90, 0x62, 0xab, 0x70, 0xe1, 0x80, 0x84, 0x6a, 0xe5, 0xd2, 0xab, 0x83, 0x10, 0xe2, 0x12, 0xad,
+	0xed, 0xc9, 0x7a, 0x15, 0xdb, 0x1b, 0x76, 0x27, 0x56, 0x79, 0x8b, 0x3e, 0x4c, 0x5f, 0x80, 0x5b,
+	0x8f, 0x55, 0x4f, 0x88, 0x43, 0x41, 0xc9, 0x8b, 0x20, 0xef, 0xda, 0x25, 0x1c, 0x2b, 0xe5, 0xe4,
+	0x99, 0xf1, 0x3f, 0xff, 0xf7, 0xcb, 0x1a, 0x93, 0x37, 0x0b, 0x56, 0xb1, 0x20, 0x17, 0xdf, 0x56,
+	0x22, 0x0d, 0xaa, 0xe3, 0x18, 0x90, 0x1d, 0x07, 0x29, 0x28, 0x51, 0x31, 0x14, 0x15, 0xcc, 0x74,
+	0xce, 0x74, 0xe6, 0x2f, 0x95, 0x44, 0x49, 0x0f, 0x6b, 0xad, 0x6f, 0xb5, 0x7e, 0xa3, 0xed, 0x3f,
+	0x4f, 0xa4, 0x2e, 0xa4, 0x9e, 0x19, 0x49, 0x60, 0x1b, 0xab, 0xef, 0x1f, 0x71, 0xc9, 0xa5, 0x9d,
+	0xd7, 0x55, 0x33, 0x1d, 0x70, 0x29, 0x79, 0x0e, 0x81, 0xe9, 0xe2, 0xd5, 0x3c, 0x40, 0x51, 0x80,
+	0x46, 0x56, 0x2c, 0xad, 0xe0, 0xd5, 0x8f, 0x2e, 0x79, 0x32, 0xb9, 0x4d, 0x30, 0xad, 0x03, 0xd0,
+	0x8f, 0xe4, 0xa0, 0x62, 0xb9, 0x48, 0x19, 0x4a, 0xe5, 0x3a, 0x43, 0x67, 0x74, 0x10, 0xbe, 0xbc,
+	0xbe, 0x18, 0xbf, 0x68, 0x78, 0x9f, 0xdb, 0x77, 0x27, 0x69, 0xaa, 0x40, 0xeb, 0x29, 0x2a, 0x51,
+	0xf2, 0xe8, 0xdf, 0x0e, 0x7d, 0x46, 0x7a, 0x19, 0x08, 0x9e, 0xa1, 0x7b, 0x6f, 0xe8, 0x8c, 0xf6,
+	0xa2, 0xa6, 0xa3, 0xef, 0x49, 0xb7, 0xe6, 0xbb, 0x7b, 0x43, 0x67, 0xf4, 0xf0, 0x6d, 0xdf, 0xb7,
+	0xe1, 0xfc, 0x36, 0x9c, 0xff, 0xa9, 0x0d, 0x17, 0xee, 0x5f, 0xde, 0x0c, 0x3a, 0xe7, 0xbf, 0x07,
+	0x4e, 0x64, 0x36, 0xe8, 0x17, 0xb2, 0x3f, 0x57, 0x2c, 0x41, 0x21, 0x4b, 0xb7, 0x6b, 0x12, 0x7d,
+	0xa8, 0x15, 0xbf, 0x6e, 0x06, 0xaf, 0xb9, 0xc0, 0x6c, 0x15, 0xfb, 0x89, 0x2c, 0x9a, 0x0f, 0xd2,
+	0x3c, 0xc6, 0x3a, 0x5d, 0x04, 0xf8, 0x7d, 0x09, 0xda, 0x9f, 0x40, 0x72, 0x7d, 0x31, 0x26, 0x4d,
+	0xfe, 0x09, 0x24, 0xd1, 0xad, 0x1b, 0x2d, 0xc9, 0x11, 0x9c, 0x25, 0x19, 0x2b, 0x39, 0xcc, 0x14,
+	0x43, 0x98, 0xc5, 0x30, 0x97, 0x0a, 0xdc, 0xfb, 0x3b, 0xa0, 0xd0, 0xd6, 0x39, 0x62, 0x08, 0xa1,
+	0xf1, 0xa5, 0x39, 0x39, 0xfc, 0x9f, 0xc7, 0xe6, 0x08, 0xca, 0xed, 0xed, 0x00, 0xf7, 0x74, 0x1b,
+	0x77, 0x52, 0xdb, 0xd2, 0x84, 0x3c, 0x46, 0xb9, 0x80, 0x52, 0xdb, 0xdb, 0x82, 0xd4, 0x7d, 0x70,
+	0x67, 0xd0, 0x69, 0x89, 0x5b, 0xa0, 0xd3, 0x12, 0xa3, 0x47, 0xd6, 0x73, 0x6a, 0x2d, 0xc3, 0xf0,
+	0x72, 0xed, 0x39, 0x57, 0x6b, 0xcf, 0xf9, 0xb3, 0xf6, 0x9c, 0xf3, 0x8d, 0xd7, 0xb9, 0xda, 0x78,
+	0x9d, 0x9f, 0x1b, 0xaf, 0xf3, 0x75, 0xb4, 0x65, 0x5f, 0xdf, 0xf3, 0x38, 0x67, 0xb1, 0x36, 0x55,
+	0x70, 0xd6, 0xfe, 0x07, 0x06, 0x12, 0xf7, 0xcc, 0x11, 0xbc, 0xfb, 0x3b, 0x00, 0x48, 0x72, 0x2f,
+	0xb0, 0x23, 0x03, 0x00, 0x00,
+}
+
+func (m *DerivativeSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivativeSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivativeSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokensSlashed.Size()
+		i -= size
+		if _, err := m.TokensSlashed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDerivativeSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ExchangeRateAfter.Size()
+		i -= size
+		if _, err := m.ExchangeRateAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDerivativeSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangeRateBefore.Size()
+		i -= size
+		if _, err := m.ExchangeRateBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDerivativeSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDerivativeSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDerivativeSlash(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintDerivativeSlash(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintDerivativeSlash(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDerivativeSlash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDerivativeSlash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DerivativeSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovDerivativeSlash(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDerivativeSlash(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDerivativeSlash(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovDerivativeSlash(uint64(l))
+	l = m.ExchangeRateBefore.Size()
+	n += 1 + l + sovDerivativeSlash(uint64(l))
+	l = m.ExchangeRateAfter.Size()
+	n += 1 + l + sovDerivativeSlash(uint64(l))
+	l = m.TokensSlashed.Size()
+	n += 1 + l + sovDerivativeSlash(uint64(l))
+	return n
+}
+
+func sovDerivativeSlash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDerivativeSlash(x uint64) (n int) {
+	return sovDerivativeSlash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DerivativeSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDerivativeSlash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivativeSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivativeSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRateBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRateAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensSlashed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDerivativeSlash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDerivativeSlash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDerivativeSlash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDerivativeSlash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDerivativeSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDerivativeSlash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDerivativeSlash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDerivativeSlash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDerivativeSlash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDerivativeSlash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDerivativeSlash = fmt.Errorf("proto: unexpected end of group")
+)
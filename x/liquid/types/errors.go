@@ -10,4 +10,9 @@ var (
 	ErrRedelegationsNotCompleted  = errorsmod.Register(ModuleName, 6, "active redelegations cannot be transferred")
 	ErrUntransferableShares       = errorsmod.Register(ModuleName, 7, "shares cannot be transferred")
 	ErrSelfDelegationBelowMinimum = errorsmod.Register(ModuleName, 8, "validator's self delegation must be greater than their minimum self delegation")
+	ErrInvalidParams              = errorsmod.Register(ModuleName, 9, "invalid params")
+	ErrUnstakePoolDepositNotFound = errorsmod.Register(ModuleName, 10, "unstake pool deposit not found")
+	ErrInsufficientPoolShares     = errorsmod.Register(ModuleName, 11, "insufficient unstake pool shares")
+	ErrInsufficientPoolLiquidity  = errorsmod.Register(ModuleName, 12, "insufficient unstake pool liquidity")
+	ErrInvalidUnstakeAmount       = errorsmod.Register(ModuleName, 13, "invalid unstake amount")
 )
@@ -4,8 +4,26 @@ const (
 	EventTypeMintDerivative = "mint_derivative"
 	EventTypeBurnDerivative = "burn_derivative"
 
-	AttributeValueCategory        = ModuleName
-	AttributeKeyDelegator         = "delegator"
-	AttributeKeyValidator         = "validator"
-	AttributeKeySharesTransferred = "shares_transferred"
+	EventTypeUnstakePoolDeposit    = "unstake_pool_deposit"
+	EventTypeUnstakePoolWithdraw   = "unstake_pool_withdraw"
+	EventTypeInstantUnstake        = "instant_unstake"
+	EventTypeUnstakePoolUndelegate = "unstake_pool_undelegate"
+	EventTypeDerivativeSlash       = "derivative_slash"
+	EventTypeRedelegateDerivative  = "redelegate_derivative"
+
+	AttributeValueCategory         = ModuleName
+	AttributeKeyDelegator          = "delegator"
+	AttributeKeyValidator          = "validator"
+	AttributeKeySharesTransferred  = "shares_transferred"
+	AttributeKeyDepositor          = "depositor"
+	AttributeKeySender             = "sender"
+	AttributeKeyShares             = "shares"
+	AttributeKeyReceived           = "received"
+	AttributeKeyFee                = "fee"
+	AttributeKeyCompletionTime     = "completion_time"
+	AttributeKeyDenom              = "denom"
+	AttributeKeyFraction           = "fraction"
+	AttributeKeyExchangeRateBefore = "exchange_rate_before"
+	AttributeKeyExchangeRateAfter  = "exchange_rate_after"
+	AttributeKeyTokensSlashed      = "tokens_slashed"
 )
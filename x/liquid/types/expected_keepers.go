@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +22,8 @@ type BankKeeper interface {
 
 	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 // AccountKeeper defines the expected keeper interface for interacting with account
@@ -34,9 +38,14 @@ type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) (res string)
 
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetAllValidators(ctx sdk.Context) (validators []stakingtypes.Validator)
 	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
 	IterateDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, cb func(delegation stakingtypes.Delegation) (stop bool))
 	HasReceivingRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) bool
+	BeginRedelegation(
+		ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
+	) (completionTime time.Time, err error)
+	GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int
 
 	ValidateUnbondAmount(
 		ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdkmath.Int,
@@ -49,9 +58,19 @@ type StakingKeeper interface {
 	Unbond(
 		ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
 	) (amount sdkmath.Int, err error)
+	Undelegate(
+		ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
+	) (time.Time, error)
 }
 
 type DistributionKeeper interface {
 	GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress
 	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
 }
+
+// LiquidHooks are event hooks called by the liquid module.
+type LiquidHooks interface {
+	// AfterPendingRedelegationMinted is called after the derivatives of a completed pending redelegation are minted
+	// to the delegator.
+	AfterPendingRedelegationMinted(ctx sdk.Context, redelegation PendingRedelegation, minted sdk.Coin) error
+}
@@ -0,0 +1,56 @@
+package types
+
+import "fmt"
+
+// NewGenesisState returns a new genesis state object
+func NewGenesisState(
+	params Params, unstakePool UnstakePool, deposits UnstakePoolDeposits, slashes DerivativeSlashes,
+	redelegations PendingRedelegations,
+) GenesisState {
+	return GenesisState{
+		Params:               params,
+		UnstakePool:          unstakePool,
+		UnstakePoolDeposits:  deposits,
+		DerivativeSlashes:    slashes,
+		PendingRedelegations: redelegations,
+	}
+}
+
+// DefaultGenesisState returns default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(
+		DefaultParams(), DefaultUnstakePool(), UnstakePoolDeposits{}, DerivativeSlashes{}, PendingRedelegations{},
+	)
+}
+
+// Validate checks the genesis state is valid
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.UnstakePool.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.UnstakePoolDeposits.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.DerivativeSlashes.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PendingRedelegations.Validate(); err != nil {
+		return err
+	}
+
+	if total := gs.UnstakePoolDeposits.TotalShares(); !total.Equal(gs.UnstakePool.TotalShares) {
+		return fmt.Errorf(
+			"unstake pool total shares %s does not match sum of deposit shares %s",
+			gs.UnstakePool.TotalShares, total,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the liquid module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters related to liquid
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// unstake_pool is the state of the instant unstake pool
+	UnstakePool UnstakePool `protobuf:"bytes,2,opt,name=unstake_pool,json=unstakePool,proto3" json:"unstake_pool"`
+	// unstake_pool_deposits are the shares of each depositor in the instant unstake pool
+	UnstakePoolDeposits UnstakePoolDeposits `protobuf:"bytes,3,rep,name=unstake_pool_deposits,json=unstakePoolDeposits,proto3,castrepeated=UnstakePoolDeposits" json:"unstake_pool_deposits"`
+	// derivative_slashes is the slash history of validators backing derivatives
+	DerivativeSlashes DerivativeSlashes `protobuf:"bytes,4,rep,name=derivative_slashes,json=derivativeSlashes,proto3,castrepeated=DerivativeSlashes" json:"derivative_slashes"`
+	// pending_redelegations are the redelegations waiting to complete before their derivatives are minted
+	PendingRedelegations PendingRedelegations `protobuf:"bytes,5,rep,name=pending_redelegations,json=pendingRedelegations,proto3,castrepeated=PendingRedelegations" json:"pending_redelegations"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52a1b41165d7aa5e, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetUnstakePool() UnstakePool {
+	if m != nil {
+		return m.UnstakePool
+	}
+	return UnstakePool{}
+}
+
+func (m *GenesisState) GetUnstakePoolDeposits() UnstakePoolDeposits {
+	if m != nil {
+		return m.UnstakePoolDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDerivativeSlashes() DerivativeSlashes {
+	if m != nil {
+		return m.DerivativeSlashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRedelegations() PendingRedelegations {
+	if m != nil {
+		return m.PendingRedelegations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "kava.liquid.v1beta1.GenesisState")
+}
+
+func init() { proto.RegisterFile("kava/liquid/v1beta1/genesis.proto", fileDescriptor_52a1b41165d7aa5e) }
+
+var fileDescriptor_52a1b41165d7aa5e = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x4e, 0x83, 0x40,
+	0x18, 0xc7, 0xc1, 0xd6, 0x0e, 0xb4, 0x4b, 0x69, 0x9b, 0x60, 0x6b, 0x28, 0x1a, 0xa3, 0xc4, 0x44,
+	0x48, 0xeb, 0xe4, 0x4a, 0x9a, 0x18, 0xb7, 0x86, 0xc6, 0xc5, 0x85, 0x1c, 0x72, 0xa1, 0x97, 0x52,
+	0x0e, 0xb9, 0x83, 0xd4, 0xf8, 0x12, 0x3e, 0x87, 0x4f, 0xd2, 0xb1, 0xa3, 0x93, 0x9a, 0xf6, 0x31,
+	0x5c, 0x0c, 0x1c, 0x2a, 0x69, 0x2f, 0xba, 0x5d, 0xbe, 0xef, 0xf7, 0xfd, 0x7f, 0x1c, 0xf7, 0x49,
+	0x47, 0x33, 0x90, 0x02, 0x33, 0x40, 0x0f, 0x09, 0xf2, 0xcc, 0x74, 0xe0, 0x42, 0x0a, 0x06, 0xa6,
+	0x0f, 0x43, 0x48, 0x10, 0x31, 0xa2, 0x18, 0x53, 0x2c, 0xb7, 0x32, 0xc4, 0x60, 0x88, 0x51, 0x20,
+	0xdd, 0xb6, 0x8f, 0x7d, 0x9c, 0xf7, 0xcd, 0xec, 0xc4, 0xd0, 0xee, 0x39, 0x2f, 0xcd, 0x83, 0x31,
+	0x4a, 0x01, 0x45, 0x29, 0x74, 0x48, 0x00, 0xc8, 0xb4, 0x60, 0x35, 0x1e, 0x1b, 0x81, 0x18, 0xcc,
+	0x0b, 0x71, 0xf7, 0x94, 0x47, 0xc4, 0xd0, 0x83, 0x01, 0xf4, 0x01, 0x45, 0x38, 0xfc, 0x8b, 0x4b,
+	0x42, 0x42, 0xc1, 0x0c, 0x3a, 0x11, 0xc6, 0x01, 0xe3, 0x8e, 0x3f, 0x2b, 0x52, 0xe3, 0x9a, 0x5d,
+	0x6d, 0x42, 0x01, 0x85, 0xf2, 0x95, 0x54, 0x63, 0x42, 0x45, 0xd4, 0x44, 0xbd, 0x3e, 0xec, 0x19,
+	0x9c, 0xab, 0x1a, 0xe3, 0x1c, 0xb1, 0xaa, 0xcb, 0xb7, 0xbe, 0x60, 0x17, 0x03, 0xf2, 0x8d, 0xd4,
+	0x28, 0x1b, 0x94, 0xbd, 0x3c, 0x40, 0xe3, 0x06, 0xdc, 0x32, 0x70, 0x8c, 0x71, 0x50, 0xa4, 0xd4,
+	0x93, 0xdf, 0x92, 0xbc, 0x90, 0x3a, 0xe5, 0x28, 0xc7, 0x83, 0x11, 0x26, 0x88, 0x12, 0xa5, 0xa2,
+	0x55, 0xf4, 0xfa, 0xf0, 0xec, 0xbf, 0xcc, 0x11, 0xe3, 0xad, 0x5e, 0x16, 0xfd, 0xf2, 0xde, 0x6f,
+	0xed, 0xf6, 0x88, 0xdd, 0x4a, 0x76, 0x8b, 0x72, 0x28, 0xc9, 0xdb, 0x8f, 0x03, 0x89, 0x52, 0xcd,
+	0xb5, 0x27, 0x5c, 0xed, 0xe8, 0x07, 0x9f, 0x64, 0xb4, 0x75, 0x50, 0x38, 0x9b, 0x5b, 0x0d, 0x48,
+	0xec, 0xa6, 0xb7, 0x5d, 0x92, 0x9f, 0xa4, 0x4e, 0x04, 0x43, 0x0f, 0x85, 0xbe, 0x53, 0x7e, 0x46,
+	0xa2, 0xec, 0xe7, 0x4a, 0x9d, 0xff, 0xfb, 0xd9, 0x84, 0x5d, 0x1a, 0xb0, 0x0e, 0x0b, 0x6d, 0x9b,
+	0xd3, 0x24, 0x76, 0x3b, 0xe2, 0x54, 0x2d, 0x6b, 0xb9, 0x56, 0xc5, 0xd5, 0x5a, 0x15, 0x3f, 0xd6,
+	0xaa, 0xf8, 0xbc, 0x51, 0x85, 0xd5, 0x46, 0x15, 0x5e, 0x37, 0xaa, 0x70, 0xa7, 0xfb, 0x88, 0x4e,
+	0x13, 0xd7, 0xb8, 0xc7, 0x73, 0x33, 0xfb, 0x82, 0x8b, 0x00, 0xb8, 0x24, 0x3f, 0x99, 0x8b, 0xef,
+	0xb5, 0xa2, 0x8f, 0x11, 0x24, 0x6e, 0x2d, 0x5f, 0xa4, 0xcb, 0xaf, 0x01, 0x00, 0x17, 0x84, 0x6b,
+	0x24, 0x36, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRedelegations) > 0 {
+		for iNdEx := len(m.PendingRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DerivativeSlashes) > 0 {
+		for iNdEx := len(m.DerivativeSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivativeSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnstakePoolDeposits) > 0 {
+		for iNdEx := len(m.UnstakePoolDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakePoolDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.UnstakePool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.UnstakePool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UnstakePoolDeposits) > 0 {
+		for _, e := range m.UnstakePoolDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DerivativeSlashes) > 0 {
+		for _, e := range m.DerivativeSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRedelegations) > 0 {
+		for _, e := range m.PendingRedelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakePoolDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakePoolDeposits = append(m.UnstakePoolDeposits, UnstakePoolDeposit{})
+			if err := m.UnstakePoolDeposits[len(m.UnstakePoolDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeSlashes = append(m.DerivativeSlashes, DerivativeSlash{})
+			if err := m.DerivativeSlashes[len(m.DerivativeSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRedelegations = append(m.PendingRedelegations, PendingRedelegation{})
+			if err := m.PendingRedelegations[len(m.PendingRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,181 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	depositor1 := sdk.AccAddress("depositor1__________")
+	depositor2 := sdk.AccAddress("depositor2__________")
+	validator := sdk.ValAddress("validator___________")
+
+	tests := []struct {
+		name       string
+		genesis    types.GenesisState
+		errContain string
+	}{
+		{
+			name:    "default genesis is valid",
+			genesis: types.DefaultGenesisState(),
+		},
+		{
+			name: "valid pool and deposits",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.NewUnstakePool(sdk.NewDec(300), time.Time{}),
+				types.UnstakePoolDeposits{
+					types.NewUnstakePoolDeposit(depositor1, sdk.NewDec(100)),
+					types.NewUnstakePoolDeposit(depositor2, sdk.NewDec(200)),
+				},
+				types.DerivativeSlashes{},
+				types.PendingRedelegations{},
+			),
+		},
+		{
+			name: "fee of one is invalid",
+			genesis: types.NewGenesisState(
+				types.NewParams(sdk.OneDec(), types.DefaultUndelegationInterval),
+				types.DefaultUnstakePool(),
+				types.UnstakePoolDeposits{},
+				types.DerivativeSlashes{},
+				types.PendingRedelegations{},
+			),
+			errContain: "instant unstake fee",
+		},
+		{
+			name: "zero undelegation interval is invalid",
+			genesis: types.NewGenesisState(
+				types.NewParams(types.DefaultInstantUnstakeFee, 0),
+				types.DefaultUnstakePool(),
+				types.UnstakePoolDeposits{},
+				types.DerivativeSlashes{},
+				types.PendingRedelegations{},
+			),
+			errContain: "undelegation interval",
+		},
+		{
+			name: "duplicate depositors are invalid",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.NewUnstakePool(sdk.NewDec(200), time.Time{}),
+				types.UnstakePoolDeposits{
+					types.NewUnstakePoolDeposit(depositor1, sdk.NewDec(100)),
+					types.NewUnstakePoolDeposit(depositor1, sdk.NewDec(100)),
+				},
+				types.DerivativeSlashes{},
+				types.PendingRedelegations{},
+			),
+			errContain: "duplicate unstake pool depositor",
+		},
+		{
+			name: "deposit shares must match pool shares",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.NewUnstakePool(sdk.NewDec(300), time.Time{}),
+				types.UnstakePoolDeposits{
+					types.NewUnstakePoolDeposit(depositor1, sdk.NewDec(100)),
+				},
+				types.DerivativeSlashes{},
+				types.PendingRedelegations{},
+			),
+			errContain: "does not match sum of deposit shares",
+		},
+		{
+			name: "valid derivative slashes",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.DefaultUnstakePool(),
+				types.UnstakePoolDeposits{},
+				types.DerivativeSlashes{
+					types.NewDerivativeSlash(validator, 10, time.Time{}, sdk.MustNewDecFromStr("0.05"), sdk.OneDec(), sdk.MustNewDecFromStr("0.95"), sdk.NewInt(50)),
+					types.NewDerivativeSlash(validator, 20, time.Time{}, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.9025"), sdk.NewInt(47)),
+				},
+				types.PendingRedelegations{},
+			),
+		},
+		{
+			name: "duplicate derivative slashes are invalid",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.DefaultUnstakePool(),
+				types.UnstakePoolDeposits{},
+				types.DerivativeSlashes{
+					types.NewDerivativeSlash(validator, 10, time.Time{}, sdk.MustNewDecFromStr("0.05"), sdk.OneDec(), sdk.MustNewDecFromStr("0.95"), sdk.NewInt(50)),
+					types.NewDerivativeSlash(validator, 10, time.Time{}, sdk.MustNewDecFromStr("0.05"), sdk.OneDec(), sdk.MustNewDecFromStr("0.95"), sdk.NewInt(50)),
+				},
+				types.PendingRedelegations{},
+			),
+			errContain: "duplicate derivative slash",
+		},
+		{
+			name: "slash fraction above one is invalid",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.DefaultUnstakePool(),
+				types.UnstakePoolDeposits{},
+				types.DerivativeSlashes{
+					types.NewDerivativeSlash(validator, 10, time.Time{}, sdk.NewDec(2), sdk.OneDec(), sdk.ZeroDec(), sdk.NewInt(50)),
+				},
+				types.PendingRedelegations{},
+			),
+			errContain: "derivative slash fraction",
+		},
+		{
+			name: "valid pending redelegations",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.DefaultUnstakePool(),
+				types.UnstakePoolDeposits{},
+				types.DerivativeSlashes{},
+				types.PendingRedelegations{
+					types.NewPendingRedelegation(depositor1, validator, sdk.NewDec(100), time.Time{}, false),
+					types.NewPendingRedelegation(depositor2, validator, sdk.NewDec(100), time.Time{}, false),
+				},
+			),
+		},
+		{
+			name: "duplicate pending redelegations are invalid",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.DefaultUnstakePool(),
+				types.UnstakePoolDeposits{},
+				types.DerivativeSlashes{},
+				types.PendingRedelegations{
+					types.NewPendingRedelegation(depositor1, validator, sdk.NewDec(100), time.Time{}, false),
+					types.NewPendingRedelegation(depositor1, validator, sdk.NewDec(50), time.Time{}, false),
+				},
+			),
+			errContain: "duplicate pending redelegation",
+		},
+		{
+			name: "zero pending redelegation shares are invalid",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				types.DefaultUnstakePool(),
+				types.UnstakePoolDeposits{},
+				types.DerivativeSlashes{},
+				types.PendingRedelegations{
+					types.NewPendingRedelegation(depositor1, validator, sdk.ZeroDec(), time.Time{}, false),
+				},
+			),
+			errContain: "pending redelegation shares must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.errContain == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContain)
+			}
+		})
+	}
+}
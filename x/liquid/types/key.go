@@ -3,8 +3,10 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -14,14 +16,63 @@ const (
 	// RouterKey Top level router key
 	RouterKey = ModuleName
 
+	// StoreKey Top level store key where all module items will be stored
+	StoreKey = ModuleName
+
 	// ModuleAccountName is the module account's name
 	ModuleAccountName = ModuleName
 
+	// UnstakePoolAccountName is the name of the module account holding the instant unstake pool funds
+	UnstakePoolAccountName = "liquid_unstake_pool"
+
 	DefaultDerivativeDenom = "bkava"
 
 	DenomSeparator = "-"
 )
 
+// key prefixes for store
+var (
+	ParamsKey                   = []byte{0x01}
+	UnstakePoolKey              = []byte{0x02}
+	UnstakePoolDepositKeyPrefix = []byte{0x03}
+	DerivativeSlashKeyPrefix    = []byte{0x04}
+
+	PendingRedelegationKeyPrefix      = []byte{0x05}
+	PendingRedelegationQueueKeyPrefix = []byte{0x06}
+)
+
+// UnstakePoolDepositKey returns the key of a depositor's instant unstake pool deposit
+// within the UnstakePoolDepositKeyPrefix store.
+func UnstakePoolDepositKey(depositor sdk.AccAddress) []byte {
+	return depositor.Bytes()
+}
+
+// DerivativeSlashValidatorKey returns the key prefix of a validator's slashes
+// within the DerivativeSlashKeyPrefix store.
+func DerivativeSlashValidatorKey(valAddr sdk.ValAddress) []byte {
+	return address.MustLengthPrefix(valAddr)
+}
+
+// DerivativeSlashKey returns the key of a validator's slash at a height
+// within the DerivativeSlashKeyPrefix store. Heights are big endian so slashes
+// are iterated in height order.
+func DerivativeSlashKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(DerivativeSlashValidatorKey(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// PendingRedelegationKey returns the key of a delegator's pending redelegation to a validator
+// within the PendingRedelegationKeyPrefix store.
+func PendingRedelegationKey(delegator sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(address.MustLengthPrefix(delegator), address.MustLengthPrefix(valAddr)...)
+}
+
+// PendingRedelegationQueueKey returns the key of a pending redelegation within the
+// PendingRedelegationQueueKeyPrefix store. Keys are prefixed by the completion time so
+// pending redelegations are iterated in completion order.
+func PendingRedelegationQueueKey(completionTime time.Time, delegator sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(sdk.FormatTimeBytes(completionTime), PendingRedelegationKey(delegator, valAddr)...)
+}
+
 func GetLiquidStakingTokenDenom(bondDenom string, valAddr sdk.ValAddress) string {
 	return fmt.Sprintf("%s%s%s", bondDenom, DenomSeparator, valAddr.String())
 }
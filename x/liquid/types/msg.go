@@ -12,6 +12,12 @@ const (
 	TypeMsgMintDerivative = "mint_derivative"
 	// TypeMsgBurnDerivative represents the type string for MsgBurnDerivative
 	TypeMsgBurnDerivative = "burn_derivative"
+	// TypeMsgDepositUnstakePool represents the type string for MsgDepositUnstakePool
+	TypeMsgDepositUnstakePool = "deposit_unstake_pool"
+	// TypeMsgWithdrawUnstakePool represents the type string for MsgWithdrawUnstakePool
+	TypeMsgWithdrawUnstakePool = "withdraw_unstake_pool"
+	// TypeMsgInstantUnstake represents the type string for MsgInstantUnstake
+	TypeMsgInstantUnstake = "instant_unstake"
 )
 
 // ensure Msg interface compliance at compile time
@@ -20,6 +26,14 @@ var (
 	_ legacytx.LegacyMsg = &MsgMintDerivative{}
 	_ sdk.Msg            = &MsgBurnDerivative{}
 	_ legacytx.LegacyMsg = &MsgBurnDerivative{}
+	_ sdk.Msg            = &MsgDepositUnstakePool{}
+	_ legacytx.LegacyMsg = &MsgDepositUnstakePool{}
+	_ sdk.Msg            = &MsgWithdrawUnstakePool{}
+	_ legacytx.LegacyMsg = &MsgWithdrawUnstakePool{}
+	_ sdk.Msg            = &MsgInstantUnstake{}
+	_ legacytx.LegacyMsg = &MsgInstantUnstake{}
+	_ sdk.Msg            = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 )
 
 // NewMsgMintDerivative returns a new MsgMintDerivative
@@ -119,3 +133,179 @@ func (msg MsgBurnDerivative) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgDepositUnstakePool returns a new MsgDepositUnstakePool
+func NewMsgDepositUnstakePool(depositor sdk.AccAddress, amount sdk.Coin) MsgDepositUnstakePool {
+	return MsgDepositUnstakePool{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositUnstakePool) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositUnstakePool) Type() string { return TypeMsgDepositUnstakePool }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositUnstakePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositUnstakePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositUnstakePool) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgWithdrawUnstakePool returns a new MsgWithdrawUnstakePool
+func NewMsgWithdrawUnstakePool(depositor sdk.AccAddress, amount sdk.Coin) MsgWithdrawUnstakePool {
+	return MsgWithdrawUnstakePool{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawUnstakePool) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawUnstakePool) Type() string { return TypeMsgWithdrawUnstakePool }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawUnstakePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawUnstakePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawUnstakePool) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgInstantUnstake returns a new MsgInstantUnstake
+func NewMsgInstantUnstake(sender sdk.AccAddress, amount sdk.Coin) MsgInstantUnstake {
+	return MsgInstantUnstake{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgInstantUnstake) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgInstantUnstake) Type() string { return TypeMsgInstantUnstake }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgInstantUnstake) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	if _, err := ParseLiquidStakingTokenDenom(msg.Amount.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgInstantUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgInstantUnstake) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUpdateParams returns a new MsgUpdateParams
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) MsgUpdateParams {
+	return MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -51,6 +51,64 @@ func TestMsgBurnDerivative_Signing(t *testing.T) {
 	assert.Equal(t, signBytes, msg.GetSignBytes())
 }
 
+func TestMsgInstantUnstake_Signing(t *testing.T) {
+	address := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+
+	msg := types.NewMsgInstantUnstake(
+		address,
+		sdk.NewCoin("bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42", sdkmath.NewInt(1e9)),
+	)
+
+	// checking for the "type" field ensures the msg is registered on the amino codec
+	signBytes := []byte(
+		`{"type":"liquid/MsgInstantUnstake","value":{"amount":{"amount":"1000000000","denom":"bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"},"sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`,
+	)
+
+	assert.Equal(t, []sdk.AccAddress{address}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgInstantUnstake_Validate(t *testing.T) {
+	validAddress := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+
+	tests := []struct {
+		name        string
+		msg         types.MsgInstantUnstake
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			msg:  types.NewMsgInstantUnstake(validAddress, sdk.NewInt64Coin("bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42", 1e9)),
+		},
+		{
+			name:        "invalid sender",
+			msg:         types.MsgInstantUnstake{Sender: "invalid", Amount: sdk.NewInt64Coin("bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42", 1e9)},
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:        "zero amount",
+			msg:         types.NewMsgInstantUnstake(validAddress, sdk.NewInt64Coin("bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42", 0)),
+			expectedErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:        "not a derivative",
+			msg:         types.NewMsgInstantUnstake(validAddress, sdk.NewInt64Coin("ukava", 1e9)),
+			expectedErr: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsg_Validate(t *testing.T) {
 	validAddress := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
 	validValidatorAddress := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
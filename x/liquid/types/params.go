@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// DefaultInstantUnstakeFee is the default fee kept by the instant unstake pool
+	DefaultInstantUnstakeFee = sdk.MustNewDecFromStr("0.005")
+	// DefaultUndelegationInterval is the default time between instant unstake pool undelegations. With a 21
+	// day unbonding period this keeps the pool below the default limit of 7 unbonding entries per validator.
+	DefaultUndelegationInterval = 4 * 24 * time.Hour
+)
+
+// NewParams returns a new params object
+func NewParams(instantUnstakeFee sdk.Dec, undelegationInterval time.Duration) Params {
+	return Params{
+		InstantUnstakeFee:    instantUnstakeFee,
+		UndelegationInterval: undelegationInterval,
+	}
+}
+
+// DefaultParams returns default params
+func DefaultParams() Params {
+	return NewParams(DefaultInstantUnstakeFee, DefaultUndelegationInterval)
+}
+
+// Validate checks the params are valid
+func (p Params) Validate() error {
+	if p.InstantUnstakeFee.IsNil() || p.InstantUnstakeFee.IsNegative() || p.InstantUnstakeFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("instant unstake fee must be within [0, 1), got %s", p.InstantUnstakeFee)
+	}
+
+	if p.UndelegationInterval <= 0 {
+		return fmt.Errorf("undelegation interval must be positive, got %s", p.UndelegationInterval)
+	}
+
+	return nil
+}
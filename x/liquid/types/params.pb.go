@@ -0,0 +1,411 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the liquid module.
+type Params struct {
+	// instant_unstake_fee is the fraction of the staked token value of derivatives sold to the instant unstake
+	// pool that is kept by the pool for its depositors.
+	InstantUnstakeFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=instant_unstake_fee,json=instantUnstakeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_unstake_fee"`
+	// undelegation_interval is the minimum time between undelegations of the derivatives held by the instant
+	// unstake pool. Batching undelegations keeps the pool below the staking module's unbonding entry limit.
+	UndelegationInterval time.Duration `protobuf:"bytes,2,opt,name=undelegation_interval,json=undelegationInterval,proto3,stdduration" json:"undelegation_interval"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5095dfc5eac0281, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetUndelegationInterval() time.Duration {
+	if m != nil {
+		return m.UndelegationInterval
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kava.liquid.v1beta1.Params")
+}
+
+func init() { proto.RegisterFile("kava/liquid/v1beta1/params.proto", fileDescriptor_d5095dfc5eac0281) }
+
+var fileDescriptor_d5095dfc5eac0281 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0xc7, 0x73, 0x3f, 0x7e, 0x14, 0x8d, 0x93, 0x6d, 0x85, 0xb6, 0xc3, 0xa5, 0x38, 0x48, 0x97,
+	0xde, 0x51, 0xdd, 0xc4, 0x29, 0x14, 0xc1, 0x4d, 0x0a, 0x82, 0xb8, 0x84, 0x4b, 0xf2, 0x34, 0x86,
+	0x5e, 0x73, 0x35, 0x77, 0x57, 0xf4, 0x5d, 0x38, 0x3a, 0xfa, 0x22, 0x7c, 0x11, 0x1d, 0x8b, 0x83,
+	0x88, 0x43, 0x95, 0x76, 0xf1, 0x65, 0x48, 0xee, 0xae, 0xd0, 0xe9, 0xf9, 0x93, 0x2f, 0xdf, 0xef,
+	0xe7, 0xc9, 0xf9, 0xdd, 0x09, 0x9b, 0x33, 0xca, 0xf3, 0x07, 0x9d, 0xa7, 0x74, 0x3e, 0x88, 0x41,
+	0xb1, 0x01, 0x9d, 0xb1, 0x92, 0x4d, 0x25, 0x99, 0x95, 0x42, 0x89, 0x7a, 0xa3, 0x52, 0x10, 0xab,
+	0x20, 0x4e, 0xd1, 0x69, 0x27, 0x42, 0x4e, 0x85, 0x8c, 0x8c, 0x84, 0xda, 0xc1, 0xea, 0x3b, 0xcd,
+	0x4c, 0x64, 0xc2, 0xee, 0xab, 0xce, 0x6d, 0x71, 0x26, 0x44, 0xc6, 0x81, 0x9a, 0x29, 0xd6, 0x63,
+	0x9a, 0xea, 0x92, 0xa9, 0x5c, 0x14, 0xf6, 0xfb, 0xf1, 0x07, 0xf2, 0x6b, 0xd7, 0x26, 0xb6, 0xce,
+	0xfd, 0x46, 0x5e, 0x48, 0xc5, 0x0a, 0x15, 0xe9, 0xaa, 0x4e, 0x20, 0x1a, 0x03, 0xb4, 0x50, 0x17,
+	0xf5, 0xf6, 0xc3, 0x8b, 0xc5, 0x2a, 0xf0, 0xbe, 0x56, 0xc1, 0x49, 0x96, 0xab, 0x7b, 0x1d, 0x93,
+	0x44, 0x4c, 0x5d, 0xbc, 0x2b, 0x7d, 0x99, 0x4e, 0xa8, 0x7a, 0x9a, 0x81, 0x24, 0x43, 0x48, 0xde,
+	0xdf, 0xfa, 0xbe, 0xa3, 0x1b, 0x42, 0x32, 0x3a, 0x74, 0xc6, 0x37, 0xd6, 0xf7, 0x12, 0xa0, 0x7e,
+	0xeb, 0x1f, 0xe9, 0x22, 0x05, 0x0e, 0x99, 0xc1, 0x89, 0xf2, 0x42, 0x41, 0x39, 0x67, 0xbc, 0xf5,
+	0xaf, 0x8b, 0x7a, 0x07, 0xa7, 0x6d, 0x62, 0xc1, 0xc9, 0x16, 0x9c, 0x0c, 0x1d, 0x78, 0xb8, 0x57,
+	0xa1, 0xbc, 0x7c, 0x07, 0x68, 0xd4, 0xdc, 0x75, 0xb8, 0x72, 0x06, 0xe7, 0xff, 0x7f, 0x5f, 0x03,
+	0x14, 0x86, 0x8b, 0x35, 0x46, 0xcb, 0x35, 0x46, 0x3f, 0x6b, 0x8c, 0x9e, 0x37, 0xd8, 0x5b, 0x6e,
+	0xb0, 0xf7, 0xb9, 0xc1, 0xde, 0x5d, 0x6f, 0xe7, 0x84, 0xea, 0x1f, 0xf7, 0x39, 0x8b, 0xa5, 0xe9,
+	0xe8, 0xe3, 0xf6, 0x45, 0xcc, 0x21, 0x71, 0xcd, 0x84, 0x9f, 0xfd, 0x0d, 0x00, 0xde, 0x39, 0x88,
+	0x0b, 0xad, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.InstantUnstakeFee.Equal(that1.InstantUnstakeFee) {
+		return false
+	}
+	if this.UndelegationInterval != that1.UndelegationInterval {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UndelegationInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UndelegationInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InstantUnstakeFee.Size()
+		i -= size
+		if _, err := m.InstantUnstakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InstantUnstakeFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UndelegationInterval)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnstakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UndelegationInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
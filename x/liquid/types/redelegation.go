@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPendingRedelegation returns a new PendingRedelegation.
+func NewPendingRedelegation(
+	delegator sdk.AccAddress, validator sdk.ValAddress, shares sdk.Dec, completionTime time.Time, depositToEarn bool,
+) PendingRedelegation {
+	return PendingRedelegation{
+		Delegator:      delegator.String(),
+		Validator:      validator.String(),
+		Shares:         shares,
+		CompletionTime: completionTime,
+		DepositToEarn:  depositToEarn,
+	}
+}
+
+// Validate performs basic validation of the PendingRedelegation.
+func (r PendingRedelegation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Delegator); err != nil {
+		return fmt.Errorf("invalid pending redelegation delegator: %w", err)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid pending redelegation validator: %w", err)
+	}
+
+	if r.Shares.IsNil() || !r.Shares.IsPositive() {
+		return fmt.Errorf("pending redelegation shares must be positive, got %s", r.Shares)
+	}
+
+	return nil
+}
+
+// PendingRedelegations is a slice of PendingRedelegation.
+type PendingRedelegations []PendingRedelegation
+
+// Validate performs basic validation of each pending redelegation and checks
+// there is at most one pending redelegation per delegator and validator.
+func (rs PendingRedelegations) Validate() error {
+	seen := make(map[string]bool)
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s", r.Delegator, r.Validator)
+		if seen[key] {
+			return fmt.Errorf("duplicate pending redelegation for delegator %s and validator %s", r.Delegator, r.Validator)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,537 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/redelegation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingRedelegation holds the derivatives of a redelegation from a bonded validator until the redelegation
+// completes. A delegation receiving a redelegation cannot be converted to derivatives, as it can still be slashed
+// for the source validator, so the derivatives are minted once the redelegation completes.
+type PendingRedelegation struct {
+	// delegator is the owner of the redelegated delegation that receives the derivatives.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// validator is the destination validator of the redelegation.
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// shares is the amount of delegation shares received by the redelegation.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// completion_time is the time the redelegation completes.
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// deposit_to_earn is true if the derivatives were redelegated from an earn vault deposit, and should be deposited
+	// back into earn once they are minted.
+	DepositToEarn bool `protobuf:"varint,5,opt,name=deposit_to_earn,json=depositToEarn,proto3" json:"deposit_to_earn,omitempty"`
+}
+
+func (m *PendingRedelegation) Reset()         { *m = PendingRedelegation{} }
+func (m *PendingRedelegation) String() string { return proto.CompactTextString(m) }
+func (*PendingRedelegation) ProtoMessage()    {}
+func (*PendingRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_780cd9b418a680a2, []int{0}
+}
+func (m *PendingRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRedelegation.Merge(m, src)
+}
+func (m *PendingRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRedelegation proto.InternalMessageInfo
+
+func (m *PendingRedelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *PendingRedelegation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *PendingRedelegation) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingRedelegation) GetDepositToEarn() bool {
+	if m != nil {
+		return m.DepositToEarn
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*PendingRedelegation)(nil), "kava.liquid.v1beta1.PendingRedelegation")
+}
+
+func init() {
+	proto.RegisterFile("kava/liquid/v1beta1/redelegation.proto", fileDescriptor_780cd9b418a680a2)
+}
+
+var fileDescriptor_780cd9b418a680a2 = []byte{
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xcb, 0x8e, 0xd3, 0x30,
+	0x14, 0x8d, 0x07, 0x18, 0x4d, 0x8d, 0x60, 0xa4, 0xcc, 0x2c, 0x42, 0x25, 0x92, 0xc2, 0xa2, 0xca,
+	0x26, 0xb6, 0x06, 0x24, 0x56, 0x48, 0x88, 0x68, 0x58, 0x22, 0xa1, 0x50, 0xb1, 0x60, 0x13, 0x39,
+	0xb1, 0xc9, 0x58, 0x93, 0xc4, 0xc1, 0x76, 0x2b, 0xf8, 0x8b, 0xf9, 0x98, 0x7e, 0x44, 0x97, 0x55,
+	0x57, 0x08, 0xa4, 0x82, 0xda, 0x1f, 0x41, 0x76, 0x1c, 0x5a, 0xb1, 0xca, 0x7d, 0x9c, 0x73, 0xef,
+	0x3d, 0x27, 0x86, 0xd3, 0x5b, 0xb2, 0x20, 0xb8, 0xe6, 0x5f, 0xe7, 0x9c, 0xe2, 0xc5, 0x55, 0xc1,
+	0x34, 0xb9, 0xc2, 0x92, 0x51, 0x56, 0xb3, 0x8a, 0x68, 0x2e, 0x5a, 0xd4, 0x49, 0xa1, 0x85, 0x7f,
+	0x61, 0x70, 0xa8, 0xc7, 0x21, 0x87, 0x1b, 0x3f, 0x29, 0x85, 0x6a, 0x84, 0xca, 0x2d, 0x04, 0xf7,
+	0x49, 0x8f, 0x1f, 0x5f, 0x56, 0xa2, 0x12, 0x7d, 0xdd, 0x44, 0xae, 0x1a, 0x55, 0x42, 0x54, 0x35,
+	0xc3, 0x36, 0x2b, 0xe6, 0x5f, 0xb0, 0xe6, 0x0d, 0x53, 0x9a, 0x34, 0x5d, 0x0f, 0x78, 0xfe, 0xeb,
+	0x04, 0x5e, 0x7c, 0x60, 0x2d, 0xe5, 0x6d, 0x95, 0x1d, 0x1d, 0xe1, 0xbf, 0x82, 0x23, 0x97, 0x09,
+	0x19, 0x80, 0x09, 0x88, 0x47, 0x69, 0xb0, 0x59, 0x26, 0x97, 0x6e, 0xe7, 0x5b, 0x4a, 0x25, 0x53,
+	0xea, 0xa3, 0x96, 0x86, 0x78, 0x80, 0xfa, 0x6f, 0xe0, 0x68, 0x41, 0x6a, 0x4e, 0x2d, 0xef, 0xc4,
+	0xf2, 0x9e, 0x6d, 0x96, 0xc9, 0x53, 0xc7, 0xfb, 0x34, 0xf4, 0xfe, 0x1b, 0xf0, 0x8f, 0xe3, 0xcf,
+	0xe0, 0xa9, 0xba, 0x21, 0x92, 0xa9, 0xe0, 0x9e, 0x65, 0xbf, 0x5e, 0x6d, 0x23, 0xef, 0xe7, 0x36,
+	0x9a, 0x56, 0x5c, 0xdf, 0xcc, 0x0b, 0x54, 0x8a, 0xc6, 0x09, 0x77, 0x9f, 0x44, 0xd1, 0x5b, 0xac,
+	0xbf, 0x77, 0x4c, 0xa1, 0x6b, 0x56, 0x6e, 0x96, 0x09, 0x74, 0xbb, 0xae, 0x59, 0x99, 0xb9, 0x59,
+	0xfe, 0x7b, 0x78, 0x5e, 0x8a, 0xa6, 0xab, 0x99, 0x11, 0x97, 0x1b, 0x13, 0x82, 0xfb, 0x13, 0x10,
+	0x3f, 0x7c, 0x31, 0x46, 0xbd, 0x43, 0x68, 0x70, 0x08, 0xcd, 0x06, 0x87, 0xd2, 0x33, 0xb3, 0xfa,
+	0xee, 0x77, 0x04, 0xb2, 0xc7, 0x07, 0xb2, 0x69, 0xfb, 0x53, 0x78, 0x4e, 0x59, 0x27, 0x14, 0xd7,
+	0xb9, 0x16, 0x39, 0x23, 0xb2, 0x0d, 0x1e, 0x4c, 0x40, 0x7c, 0x96, 0x3d, 0x72, 0xe5, 0x99, 0x78,
+	0x47, 0x64, 0x9b, 0xa6, 0xab, 0x5d, 0x08, 0xd6, 0xbb, 0x10, 0xfc, 0xd9, 0x85, 0xe0, 0x6e, 0x1f,
+	0x7a, 0xeb, 0x7d, 0xe8, 0xfd, 0xd8, 0x87, 0xde, 0xe7, 0xf8, 0x48, 0x8e, 0xf9, 0xd3, 0x49, 0x4d,
+	0x0a, 0x65, 0x23, 0xfc, 0x6d, 0x78, 0x1d, 0x56, 0x54, 0x71, 0x6a, 0x2f, 0x7b, 0xf9, 0x77, 0x00,
+	0xd9, 0x36, 0x17, 0xc3, 0x39, 0x02, 0x00, 0x00,
+}
+
+func (m *PendingRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepositToEarn {
+		i--
+		if m.DepositToEarn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRedelegation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintRedelegation(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintRedelegation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovRedelegation(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovRedelegation(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovRedelegation(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovRedelegation(uint64(l))
+	if m.DepositToEarn {
+		n += 2
+	}
+	return n
+}
+
+func sovRedelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedelegation(x uint64) (n int) {
+	return sovRedelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositToEarn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositToEarn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedelegation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedelegation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedelegation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedelegation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedelegation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedelegation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedelegation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedelegation = fmt.Errorf("proto: unexpected end of group")
+)
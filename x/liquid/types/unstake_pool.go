@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewUnstakePool returns a new UnstakePool with no shares.
+func NewUnstakePool(totalShares sdk.Dec, lastUndelegationTime time.Time) UnstakePool {
+	return UnstakePool{
+		TotalShares:          totalShares,
+		LastUndelegationTime: lastUndelegationTime,
+	}
+}
+
+// DefaultUnstakePool returns an empty UnstakePool.
+func DefaultUnstakePool() UnstakePool {
+	return NewUnstakePool(sdk.ZeroDec(), time.Time{})
+}
+
+// Validate performs basic validation of the UnstakePool.
+func (p UnstakePool) Validate() error {
+	if p.TotalShares.IsNil() || p.TotalShares.IsNegative() {
+		return fmt.Errorf("unstake pool total shares must be non-negative, got %s", p.TotalShares)
+	}
+
+	return nil
+}
+
+// NewUnstakePoolDeposit returns a new UnstakePoolDeposit.
+func NewUnstakePoolDeposit(depositor sdk.AccAddress, shares sdk.Dec) UnstakePoolDeposit {
+	return UnstakePoolDeposit{
+		Depositor: depositor,
+		Shares:    shares,
+	}
+}
+
+// Validate performs basic validation of the UnstakePoolDeposit.
+func (d UnstakePoolDeposit) Validate() error {
+	if d.Depositor.Empty() {
+		return fmt.Errorf("unstake pool depositor cannot be empty")
+	}
+
+	if d.Shares.IsNil() || !d.Shares.IsPositive() {
+		return fmt.Errorf("unstake pool deposit shares must be positive, got %s", d.Shares)
+	}
+
+	return nil
+}
+
+// UnstakePoolDeposits is a slice of UnstakePoolDeposit.
+type UnstakePoolDeposits []UnstakePoolDeposit
+
+// Validate performs basic validation of each deposit and checks for
+// duplicate depositors.
+func (ds UnstakePoolDeposits) Validate() error {
+	seen := make(map[string]bool)
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		if seen[d.Depositor.String()] {
+			return fmt.Errorf("duplicate unstake pool depositor %s", d.Depositor)
+		}
+		seen[d.Depositor.String()] = true
+	}
+
+	return nil
+}
+
+// TotalShares returns the sum of the shares of all deposits.
+func (ds UnstakePoolDeposits) TotalShares() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, d := range ds {
+		total = total.Add(d.Shares)
+	}
+
+	return total
+}
@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/unstake_pool.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnstakePool stores the state of the instant unstake pool. Depositors provide staking tokens that are paid
+// to users selling derivatives, and the pool undelegates the derivatives it receives.
+type UnstakePool struct {
+	// total_shares is the sum of the shares of all depositors in the pool.
+	TotalShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_shares"`
+	// last_undelegation_time is the time the pool last undelegated the derivatives it holds.
+	LastUndelegationTime time.Time `protobuf:"bytes,2,opt,name=last_undelegation_time,json=lastUndelegationTime,proto3,stdtime" json:"last_undelegation_time"`
+}
+
+func (m *UnstakePool) Reset()         { *m = UnstakePool{} }
+func (m *UnstakePool) String() string { return proto.CompactTextString(m) }
+func (*UnstakePool) ProtoMessage()    {}
+func (*UnstakePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b11b33d383549e26, []int{0}
+}
+func (m *UnstakePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnstakePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnstakePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnstakePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnstakePool.Merge(m, src)
+}
+func (m *UnstakePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnstakePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnstakePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnstakePool proto.InternalMessageInfo
+
+func (m *UnstakePool) GetLastUndelegationTime() time.Time {
+	if m != nil {
+		return m.LastUndelegationTime
+	}
+	return time.Time{}
+}
+
+// UnstakePoolDeposit stores the shares of a depositor in the instant unstake pool.
+type UnstakePoolDeposit struct {
+	// depositor is the owner of the shares.
+	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	// shares is the depositor's share of the pool.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *UnstakePoolDeposit) Reset()         { *m = UnstakePoolDeposit{} }
+func (m *UnstakePoolDeposit) String() string { return proto.CompactTextString(m) }
+func (*UnstakePoolDeposit) ProtoMessage()    {}
+func (*UnstakePoolDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b11b33d383549e26, []int{1}
+}
+func (m *UnstakePoolDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnstakePoolDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnstakePoolDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnstakePoolDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnstakePoolDeposit.Merge(m, src)
+}
+func (m *UnstakePoolDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnstakePoolDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnstakePoolDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnstakePoolDeposit proto.InternalMessageInfo
+
+func (m *UnstakePoolDeposit) GetDepositor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Depositor
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*UnstakePool)(nil), "kava.liquid.v1beta1.UnstakePool")
+	proto.RegisterType((*UnstakePoolDeposit)(nil), "kava.liquid.v1beta1.UnstakePoolDeposit")
+}
+
+func init() {
+	proto.RegisterFile("kava/liquid/v1beta1/unstake_pool.proto", fileDescriptor_b11b33d383549e26)
+}
+
+var fileDescriptor_b11b33d383549e26 = []byte{
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x31, 0x8f, 0xda, 0x30,
+	0x14, 0xc7, 0xe3, 0x1b, 0x4e, 0x3d, 0x73, 0x53, 0xee, 0x54, 0x5d, 0x19, 0x12, 0xc4, 0x80, 0x58,
+	0x62, 0x8b, 0x76, 0xed, 0x42, 0xc4, 0xd0, 0xb1, 0xa2, 0xb0, 0xb0, 0x44, 0x4e, 0x62, 0x42, 0x84,
+	0xc3, 0x4b, 0x63, 0x07, 0x95, 0x6f, 0xc1, 0x87, 0xe1, 0x43, 0xa0, 0x4e, 0x88, 0xa9, 0xea, 0x40,
+	0x2b, 0xf8, 0x16, 0x9d, 0xaa, 0xd8, 0x46, 0x65, 0xec, 0x70, 0x93, 0x9f, 0x9f, 0xfe, 0xfa, 0xbd,
+	0xff, 0xff, 0xe9, 0xe1, 0xde, 0x92, 0xad, 0x19, 0x15, 0xf9, 0xd7, 0x3a, 0x4f, 0xe9, 0x7a, 0x10,
+	0x73, 0xc5, 0x06, 0xb4, 0x5e, 0x49, 0xc5, 0x96, 0x3c, 0x2a, 0x01, 0x04, 0x29, 0x2b, 0x50, 0xe0,
+	0x3e, 0x35, 0x3a, 0x62, 0x74, 0xc4, 0xea, 0xda, 0xef, 0x12, 0x90, 0x05, 0xc8, 0x48, 0x4b, 0xa8,
+	0xf9, 0x18, 0x7d, 0xfb, 0x39, 0x83, 0x0c, 0x4c, 0xbf, 0xa9, 0x6c, 0xd7, 0xcf, 0x00, 0x32, 0xc1,
+	0xa9, 0xfe, 0xc5, 0xf5, 0x9c, 0xaa, 0xbc, 0xe0, 0x52, 0xb1, 0xa2, 0x34, 0x82, 0xee, 0x77, 0x84,
+	0x5b, 0x53, 0x33, 0xfd, 0x33, 0x80, 0x70, 0x23, 0xfc, 0xa8, 0x40, 0x31, 0x11, 0xc9, 0x05, 0xab,
+	0xb8, 0x7c, 0x41, 0x1d, 0xd4, 0x7f, 0x08, 0x3f, 0xee, 0x4f, 0xbe, 0xf3, 0xf3, 0xe4, 0xf7, 0xb2,
+	0x5c, 0x2d, 0xea, 0x98, 0x24, 0x50, 0xd8, 0xe9, 0xf6, 0x09, 0x64, 0xba, 0xa4, 0x6a, 0x53, 0x72,
+	0x49, 0x46, 0x3c, 0x39, 0xee, 0x02, 0x6c, 0xcd, 0x8d, 0x78, 0x32, 0x6e, 0x69, 0xe2, 0x17, 0x0d,
+	0x74, 0x67, 0xf8, 0xad, 0x60, 0x52, 0x45, 0xf5, 0x2a, 0xe5, 0x82, 0x67, 0x4c, 0xe5, 0xb0, 0x8a,
+	0x1a, 0x57, 0x2f, 0x77, 0x1d, 0xd4, 0x6f, 0xbd, 0x6f, 0x13, 0x63, 0x99, 0x5c, 0x2d, 0x93, 0xc9,
+	0xd5, 0x72, 0xf8, 0xa6, 0xb1, 0xb1, 0xfd, 0xe5, 0xa3, 0xf1, 0x73, 0xc3, 0x98, 0xde, 0x20, 0x1a,
+	0x51, 0xf7, 0x88, 0xb0, 0x7b, 0x13, 0x66, 0xc4, 0x4b, 0x90, 0xb9, 0x72, 0xe7, 0xf8, 0x21, 0x35,
+	0x25, 0x54, 0x3a, 0xd0, 0x63, 0xf8, 0xe9, 0xcf, 0xc9, 0x0f, 0xfe, 0x23, 0xcc, 0x30, 0x49, 0x86,
+	0x69, 0x5a, 0x71, 0x29, 0x8f, 0xbb, 0xe0, 0xc9, 0x66, 0xb2, 0x9d, 0x70, 0xa3, 0xb8, 0x1c, 0xff,
+	0x43, 0xbb, 0x13, 0x7c, 0x6f, 0xb7, 0x76, 0xf7, 0x0a, 0x5b, 0xb3, 0xac, 0x30, 0xdc, 0x9f, 0x3d,
+	0x74, 0x38, 0x7b, 0xe8, 0xf7, 0xd9, 0x43, 0xdb, 0x8b, 0xe7, 0x1c, 0x2e, 0x9e, 0xf3, 0xe3, 0xe2,
+	0x39, 0xb3, 0xfe, 0x0d, 0xb7, 0xb9, 0x96, 0x40, 0xb0, 0x58, 0xea, 0x8a, 0x7e, 0xbb, 0x5e, 0x98,
+	0xa6, 0xc7, 0xf7, 0x7a, 0x99, 0x1f, 0xfe, 0x0e, 0x00, 0x7f, 0x15, 0xf6, 0xd8, 0x7d, 0x02, 0x00,
+	0x00,
+}
+
+func (m *UnstakePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnstakePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnstakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastUndelegationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUndelegationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintUnstakePool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUnstakePool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UnstakePoolDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnstakePoolDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnstakePoolDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUnstakePool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintUnstakePool(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUnstakePool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUnstakePool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnstakePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
+	n += 1 + l + sovUnstakePool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUndelegationTime)
+	n += 1 + l + sovUnstakePool(uint64(l))
+	return n
+}
+
+func (m *UnstakePoolDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovUnstakePool(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovUnstakePool(uint64(l))
+	return n
+}
+
+func sovUnstakePool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUnstakePool(x uint64) (n int) {
+	return sovUnstakePool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UnstakePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnstakePool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnstakePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnstakePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnstakePool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUndelegationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnstakePool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastUndelegationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnstakePool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnstakePoolDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnstakePool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnstakePoolDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnstakePoolDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnstakePool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnstakePool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnstakePool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnstakePool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUnstakePool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUnstakePool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnstakePool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnstakePool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUnstakePool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUnstakePool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUnstakePool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUnstakePool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUnstakePool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUnstakePool = fmt.Errorf("proto: unexpected end of group")
+)
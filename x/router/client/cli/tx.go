@@ -31,6 +31,7 @@ func GetTxCmd() *cobra.Command {
 		getCmdDelegateMintDeposit(),
 		getCmdWithdrawBurn(),
 		getCmdWithdrawBurnUndelegate(),
+		getCmdRedelegateDerivative(),
 	}
 
 	for _, cmd := range cmds {
@@ -173,3 +174,41 @@ func getCmdWithdrawBurnUndelegate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdRedelegateDerivative() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegate-derivative [src-validator-addr] [dst-validator-addr] [amount]",
+		Short: "converts staking derivatives of one validator into staking derivatives of another, keeping any earn deposit",
+		Example: fmt.Sprintf(
+			`%s tx %s redelegate-derivative kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42 10000000ukava --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcValAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+
+			dstValAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedelegateDerivative(clientCtx.GetFromAddress(), srcValAddr, dstValAddr, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
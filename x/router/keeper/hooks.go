@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
+)
+
+// Hooks wrapper struct for hooks
+type Hooks struct {
+	k Keeper
+}
+
+var _ liquidtypes.LiquidHooks = Hooks{}
+
+// Hooks create new router hooks
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterPendingRedelegationMinted deposits the derivatives of redelegations from an earn deposit back into earn
+func (h Hooks) AfterPendingRedelegationMinted(ctx sdk.Context, redelegation liquidtypes.PendingRedelegation, minted sdk.Coin) error {
+	if !redelegation.DepositToEarn || !minted.IsPositive() {
+		return nil
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(redelegation.Delegator)
+	if err != nil {
+		return err
+	}
+
+	return h.k.earnKeeper.Deposit(ctx, delegator, minted, earntypes.STRATEGY_TYPE_SAVINGS)
+}
@@ -200,3 +200,72 @@ func (m msgServer) WithdrawBurnUndelegate(goCtx context.Context, msg *types.MsgW
 	})
 	return &types.MsgWithdrawBurnUndelegateResponse{}, nil
 }
+
+// RedelegateDerivative converts staking derivatives of one validator into staking derivatives of another validator.
+// Derivatives deposited in an earn vault are withdrawn, converted, then deposited back. If the redelegation does not
+// complete immediately, they are deposited back by the liquid hooks once the derivatives are minted.
+func (m msgServer) RedelegateDerivative(goCtx context.Context, msg *types.MsgRedelegateDerivative) (*types.MsgRedelegateDerivativeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+	srcVal, err := sdk.ValAddressFromBech32(msg.SrcValidator)
+	if err != nil {
+		return nil, err
+	}
+	dstVal, err := sdk.ValAddressFromBech32(msg.DstValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	derivative, err := m.keeper.liquidKeeper.DerivativeFromTokens(ctx, srcVal, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Derivatives are taken from the earn vault if there is a deposit, otherwise from the account balance
+	inEarn := false
+	if shares, found := m.keeper.earnKeeper.GetVaultAccountShares(ctx, from); found {
+		inEarn = shares.AmountOf(derivative.Denom).IsPositive()
+	}
+
+	if inEarn {
+		derivative, err = m.keeper.earnKeeper.Withdraw(ctx, from, derivative, earntypes.STRATEGY_TYPE_SAVINGS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Derivatives are minted to the account once the redelegation completes if it does not complete immediately,
+	// as the delegation could still be slashed for the source validator
+	derivativeMinted, completionTime, err := m.keeper.liquidKeeper.RedelegateDerivative(ctx, from, srcVal, dstVal, derivative, inEarn)
+	if err != nil {
+		return nil, err
+	}
+
+	if inEarn && derivativeMinted.IsPositive() {
+		err = m.keeper.earnKeeper.Deposit(ctx, from, derivativeMinted, earntypes.STRATEGY_TYPE_SAVINGS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			stakingtypes.EventTypeRedelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeySrcValidator, srcVal.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDstValidator, dstVal.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	})
+
+	return &types.MsgRedelegateDerivativeResponse{}, nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kava-labs/kava/app"
 	earntypes "github.com/kava-labs/kava/x/earn/types"
+	"github.com/kava-labs/kava/x/liquid"
 	"github.com/kava-labs/kava/x/router/keeper"
 	"github.com/kava-labs/kava/x/router/testutil"
 	"github.com/kava-labs/kava/x/router/types"
@@ -264,6 +265,118 @@ func (suite *msgServerTestSuite) TestDelegateMintDepositAndWithdrawBurnUndelegat
 	suite.UnbondingDelegationInDeltaBelow(valAddr, user, userBalance, sdkmath.NewInt(2))
 }
 
+func (suite *msgServerTestSuite) TestRedelegateDerivative_EarnDeposit() {
+	user, srcVal, dstVal, delegated := suite.setupRedelegation()
+
+	_, err := suite.msgServer.MintDeposit(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintDeposit(user, srcVal, delegated))
+	suite.Require().NoError(err)
+	// clear events from setup
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	msg := types.NewMsgRedelegateDerivative(user, srcVal, dstVal, delegated)
+	_, err = suite.msgServer.RedelegateDerivative(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	// The earn position is moved to the new derivative
+	dstDerivativeDenom := fmt.Sprintf("bkava-%s", dstVal)
+	suite.VaultAccountSharesEqual(user, earntypes.NewVaultShares(
+		earntypes.NewVaultShare(dstDerivativeDenom, sdk.NewDecFromInt(delegated.Amount)),
+	))
+	suite.VaultAccountValueEqual(user, sdk.NewCoin(dstDerivativeDenom, delegated.Amount))
+	suite.AccountBalanceEqual(user, sdk.NewCoins())
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, user.String()),
+		),
+	)
+	// Redelegations from unbonded validators complete immediately, with no completion time
+	suite.EventsContains(suite.Ctx.EventManager().Events(),
+		sdk.NewEvent(
+			stakingtypes.EventTypeRedelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeySrcValidator, srcVal.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDstValidator, dstVal.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, time.Time{}.Format(time.RFC3339)),
+		),
+	)
+}
+
+func (suite *msgServerTestSuite) TestRedelegateDerivative_AccountBalance() {
+	user, srcVal, dstVal, delegated := suite.setupRedelegation()
+
+	srcDerivative, err := suite.App.GetLiquidKeeper().MintDerivative(suite.Ctx, user, srcVal, delegated)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgRedelegateDerivative(user, srcVal, dstVal, delegated)
+	_, err = suite.msgServer.RedelegateDerivative(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(user, sdk.NewCoins(
+		sdk.NewCoin(fmt.Sprintf("bkava-%s", dstVal), srcDerivative.Amount),
+	))
+	suite.VaultAccountSharesEqual(user, nil)
+}
+
+func (suite *msgServerTestSuite) TestRedelegateDerivative_BondedValidator() {
+	user, srcVal, dstVal, delegated := suite.setupRedelegation()
+
+	_, err := suite.msgServer.MintDeposit(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintDeposit(user, srcVal, delegated))
+	suite.Require().NoError(err)
+
+	// Bonded validators create redelegations that must complete before derivatives can be minted
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	msg := types.NewMsgRedelegateDerivative(user, srcVal, dstVal, delegated)
+	_, err = suite.msgServer.RedelegateDerivative(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	// The derivatives are held until the redelegation completes
+	dstDerivativeDenom := fmt.Sprintf("bkava-%s", dstVal)
+	liquidKeeper := suite.App.GetLiquidKeeper()
+	pending, found := liquidKeeper.GetPendingRedelegation(suite.Ctx, user, dstVal)
+	suite.Require().True(found)
+	suite.VaultAccountSharesEqual(user, nil)
+	suite.AccountBalanceEqual(user, sdk.NewCoins())
+
+	suite.Ctx = suite.Ctx.WithBlockTime(pending.CompletionTime.Add(time.Second))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+	liquid.BeginBlocker(suite.Ctx, liquidKeeper)
+
+	_, found = liquidKeeper.GetPendingRedelegation(suite.Ctx, user, dstVal)
+	suite.False(found)
+	// The minted derivatives are deposited back into earn
+	suite.VaultAccountSharesEqual(user, earntypes.NewVaultShares(
+		earntypes.NewVaultShare(dstDerivativeDenom, sdk.NewDecFromInt(delegated.Amount)),
+	))
+	suite.AccountBalanceEqual(user, sdk.NewCoins())
+}
+
+func (suite *msgServerTestSuite) TestRedelegateDerivative_BondedValidatorAccountBalance() {
+	user, srcVal, dstVal, delegated := suite.setupRedelegation()
+
+	_, err := suite.App.GetLiquidKeeper().MintDerivative(suite.Ctx, user, srcVal, delegated)
+	suite.Require().NoError(err)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	msg := types.NewMsgRedelegateDerivative(user, srcVal, dstVal, delegated)
+	_, err = suite.msgServer.RedelegateDerivative(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	liquidKeeper := suite.App.GetLiquidKeeper()
+	pending, found := liquidKeeper.GetPendingRedelegation(suite.Ctx, user, dstVal)
+	suite.Require().True(found)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(pending.CompletionTime.Add(time.Second))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+	liquid.BeginBlocker(suite.Ctx, liquidKeeper)
+
+	// Derivatives redelegated from the account balance are minted to the account
+	suite.AccountBalanceEqual(user, sdk.NewCoins(sdk.NewCoin(fmt.Sprintf("bkava-%s", dstVal), delegated.Amount)))
+	suite.VaultAccountSharesEqual(user, nil)
+}
+
 func (suite *msgServerTestSuite) setupValidator() (sdk.AccAddress, sdk.ValAddress, sdkmath.Int) {
 	_, addrs := app.GeneratePrivKeyAddressPairs(5)
 	valAccAddr, user := addrs[0], addrs[1]
@@ -320,3 +433,27 @@ func (suite *msgServerTestSuite) setupDerivatives() (sdk.AccAddress, sdk.ValAddr
 
 	return user, valAddr, derivatives
 }
+
+func (suite *msgServerTestSuite) setupRedelegation() (sdk.AccAddress, sdk.ValAddress, sdk.ValAddress, sdk.Coin) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	srcValAccAddr, dstValAccAddr, user := addrs[0], addrs[1], addrs[2]
+	srcVal, dstVal := sdk.ValAddress(srcValAccAddr), sdk.ValAddress(dstValAccAddr)
+
+	balance := sdkmath.NewInt(1e9)
+
+	suite.CreateAccountWithAddress(srcValAccAddr, suite.NewBondCoins(balance))
+	suite.CreateAccountWithAddress(dstValAccAddr, suite.NewBondCoins(balance))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(balance))
+
+	suite.CreateNewUnbondedValidator(srcVal, balance)
+	suite.CreateNewUnbondedValidator(dstVal, balance)
+	suite.CreateDelegation(srcVal, user, balance)
+
+	suite.CreateVault("bkava", earntypes.StrategyTypes{earntypes.STRATEGY_TYPE_SAVINGS}, false, nil)
+	suite.SetSavingsSupportedDenoms([]string{
+		fmt.Sprintf("bkava-%s", srcVal),
+		fmt.Sprintf("bkava-%s", dstVal),
+	})
+
+	return user, srcVal, dstVal, suite.NewBondCoin(balance)
+}
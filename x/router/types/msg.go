@@ -16,6 +16,8 @@ const (
 	TypeMsgWithdrawBurn = "withdraw_burn"
 	// TypeMsgWithdrawBurnUndelegate defines the type for MsgWithdrawBurnUndelegate
 	TypeMsgWithdrawBurnUndelegate = "withdraw_burn_undelegate"
+	// TypeMsgRedelegateDerivative defines the type for MsgRedelegateDerivative
+	TypeMsgRedelegateDerivative = "redelegate_derivative"
 )
 
 var (
@@ -27,6 +29,8 @@ var (
 	_ legacytx.LegacyMsg = &MsgWithdrawBurn{}
 	_ sdk.Msg            = &MsgWithdrawBurnUndelegate{}
 	_ legacytx.LegacyMsg = &MsgWithdrawBurnUndelegate{}
+	_ sdk.Msg            = &MsgRedelegateDerivative{}
+	_ legacytx.LegacyMsg = &MsgRedelegateDerivative{}
 )
 
 // NewMsgMintDeposit returns a new MsgMintDeposit.
@@ -200,3 +204,57 @@ func (msg MsgWithdrawBurnUndelegate) GetSigners() []sdk.AccAddress {
 	from, _ := sdk.AccAddressFromBech32(msg.From)
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgRedelegateDerivative returns a new MsgRedelegateDerivative.
+func NewMsgRedelegateDerivative(from sdk.AccAddress, srcValidator, dstValidator sdk.ValAddress, amount sdk.Coin) *MsgRedelegateDerivative {
+	return &MsgRedelegateDerivative{
+		From:         from.String(),
+		SrcValidator: srcValidator.String(),
+		DstValidator: dstValidator.String(),
+		Amount:       amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedelegateDerivative) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedelegateDerivative) Type() string { return TypeMsgRedelegateDerivative }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedelegateDerivative) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address: %s", err)
+	}
+
+	srcValidator, err := sdk.ValAddressFromBech32(msg.SrcValidator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source validator address: %s", err)
+	}
+
+	dstValidator, err := sdk.ValAddressFromBech32(msg.DstValidator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination validator address: %s", err)
+	}
+
+	if srcValidator.Equals(dstValidator) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "source and destination validators must be different")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedelegateDerivative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedelegateDerivative) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.From)
+	return []sdk.AccAddress{from}
+}
@@ -89,6 +89,81 @@ func TestMsgWithdrawBurnUndelegate_Signing(t *testing.T) {
 	assert.Equal(t, signBytes, msg.GetSignBytes())
 }
 
+func TestMsgRedelegateDerivative_Signing(t *testing.T) {
+	address := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	srcValidatorAddress := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
+	dstValidatorAddress := mustValAddressFromBech32("kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd")
+
+	msg := types.NewMsgRedelegateDerivative(
+		address,
+		srcValidatorAddress,
+		dstValidatorAddress,
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e9)),
+	)
+
+	// checking for the "type" field ensures the msg is registered on the amino codec
+	signBytes := []byte(
+		`{"type":"router/MsgRedelegateDerivative","value":{"amount":{"amount":"1000000000","denom":"ukava"},"dst_validator":"kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd","from":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","src_validator":"kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"}}`,
+	)
+
+	assert.Equal(t, []sdk.AccAddress{address}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgRedelegateDerivative_Validate(t *testing.T) {
+	validAddress := "kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"
+	validSrcValidatorAddress := "kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"
+	validDstValidatorAddress := "kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd"
+	validCoin := sdk.NewInt64Coin("ukava", 1e9)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgRedelegateDerivative
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			msg:  types.MsgRedelegateDerivative{validAddress, validSrcValidatorAddress, validDstValidatorAddress, validCoin},
+		},
+		{
+			name:        "invalid from",
+			msg:         types.MsgRedelegateDerivative{"invalid", validSrcValidatorAddress, validDstValidatorAddress, validCoin},
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:        "invalid source validator",
+			msg:         types.MsgRedelegateDerivative{validAddress, "invalid", validDstValidatorAddress, validCoin},
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:        "invalid destination validator",
+			msg:         types.MsgRedelegateDerivative{validAddress, validSrcValidatorAddress, "", validCoin},
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:        "same validators",
+			msg:         types.MsgRedelegateDerivative{validAddress, validSrcValidatorAddress, validSrcValidatorAddress, validCoin},
+			expectedErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:        "zero coin",
+			msg:         types.MsgRedelegateDerivative{validAddress, validSrcValidatorAddress, validDstValidatorAddress, sdk.NewCoin("ukava", sdk.ZeroInt())},
+			expectedErr: sdkerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsg_Validate(t *testing.T) {
 	validAddress := "kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"
 	validValidatorAddress := "kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"
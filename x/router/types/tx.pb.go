@@ -352,6 +352,93 @@ func (m *MsgWithdrawBurnUndelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawBurnUndelegateResponse proto.InternalMessageInfo
 
+// MsgRedelegateDerivative converts staking derivatives of one validator into staking derivatives of another
+// validator.
+//
+// The redelegated delegation can only be converted back into derivatives once the redelegation has completed.
+// Redelegations that do not complete immediately are held by the liquid module, and the derivatives are minted to
+// the owner's account, rather than deposited back into an earn vault, once the redelegation completes.
+type MsgRedelegateDerivative struct {
+	// from is the owner of the staking derivatives to redelegate
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// src_validator is the address to select the derivative denom to convert from
+	SrcValidator string `protobuf:"bytes,2,opt,name=src_validator,json=srcValidator,proto3" json:"src_validator,omitempty"`
+	// dst_validator is the address of the validator to redelegate to
+	DstValidator string `protobuf:"bytes,3,opt,name=dst_validator,json=dstValidator,proto3" json:"dst_validator,omitempty"`
+	// amount is the staked token equivalent to redelegate
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedelegateDerivative) Reset()         { *m = MsgRedelegateDerivative{} }
+func (m *MsgRedelegateDerivative) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateDerivative) ProtoMessage()    {}
+func (*MsgRedelegateDerivative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63015631bbbf9425, []int{8}
+}
+func (m *MsgRedelegateDerivative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegateDerivative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegateDerivative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedelegateDerivative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateDerivative.Merge(m, src)
+}
+func (m *MsgRedelegateDerivative) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegateDerivative) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateDerivative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedelegateDerivative proto.InternalMessageInfo
+
+// MsgRedelegateDerivativeResponse defines the Msg/MsgRedelegateDerivative response type.
+type MsgRedelegateDerivativeResponse struct {
+}
+
+func (m *MsgRedelegateDerivativeResponse) Reset()         { *m = MsgRedelegateDerivativeResponse{} }
+func (m *MsgRedelegateDerivativeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateDerivativeResponse) ProtoMessage()    {}
+func (*MsgRedelegateDerivativeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63015631bbbf9425, []int{9}
+}
+func (m *MsgRedelegateDerivativeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegateDerivativeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegateDerivativeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedelegateDerivativeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateDerivativeResponse.Merge(m, src)
+}
+func (m *MsgRedelegateDerivativeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegateDerivativeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateDerivativeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedelegateDerivativeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMintDeposit)(nil), "kava.router.v1beta1.MsgMintDeposit")
 	proto.RegisterType((*MsgMintDepositResponse)(nil), "kava.router.v1beta1.MsgMintDepositResponse")
@@ -361,42 +448,48 @@ func init() {
 	proto.RegisterType((*MsgWithdrawBurnResponse)(nil), "kava.router.v1beta1.MsgWithdrawBurnResponse")
 	proto.RegisterType((*MsgWithdrawBurnUndelegate)(nil), "kava.router.v1beta1.MsgWithdrawBurnUndelegate")
 	proto.RegisterType((*MsgWithdrawBurnUndelegateResponse)(nil), "kava.router.v1beta1.MsgWithdrawBurnUndelegateResponse")
+	proto.RegisterType((*MsgRedelegateDerivative)(nil), "kava.router.v1beta1.MsgRedelegateDerivative")
+	proto.RegisterType((*MsgRedelegateDerivativeResponse)(nil), "kava.router.v1beta1.MsgRedelegateDerivativeResponse")
 }
 
 func init() { proto.RegisterFile("kava/router/v1beta1/tx.proto", fileDescriptor_63015631bbbf9425) }
 
 var fileDescriptor_63015631bbbf9425 = []byte{
-	// 472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0x31, 0xa5, 0x90, 0x57, 0x51, 0xd8, 0x96, 0x9a, 0x2c, 0x65, 0x8c, 0xa9, 0x87, 0x80,
-	0xed, 0x2c, 0x6d, 0xa1, 0x9e, 0x8d, 0xc5, 0x5b, 0x2e, 0x11, 0x11, 0xbc, 0x94, 0xd9, 0xec, 0x38,
-	0x1d, 0x4c, 0x66, 0xc2, 0xcc, 0x6c, 0x5a, 0x6f, 0xfe, 0x09, 0x9e, 0xbc, 0xea, 0xcd, 0x7f, 0xc0,
-	0xbf, 0x41, 0x72, 0x2c, 0x9e, 0x3c, 0x89, 0x26, 0xff, 0x88, 0xec, 0xcf, 0x34, 0x65, 0x17, 0xd3,
-	0x83, 0xd0, 0xdb, 0xdb, 0xf7, 0x7d, 0xef, 0x7b, 0xdf, 0x07, 0x6f, 0x07, 0x76, 0xde, 0xd1, 0x09,
-	0xf5, 0xb5, 0x8a, 0x2c, 0xd3, 0xfe, 0xe4, 0x20, 0x60, 0x96, 0x1e, 0xf8, 0xf6, 0x82, 0x8c, 0xb5,
-	0xb2, 0xca, 0xdd, 0x8c, 0x51, 0x92, 0xa2, 0x24, 0x43, 0x3d, 0x3c, 0x50, 0x66, 0xa4, 0x8c, 0x1f,
-	0x50, 0xc3, 0x8a, 0x91, 0x81, 0x12, 0x32, 0x1d, 0xf2, 0x9a, 0x29, 0x7e, 0x9a, 0x7c, 0xf9, 0xe9,
-	0x47, 0x06, 0x6d, 0x71, 0xc5, 0x55, 0xda, 0x8f, 0xab, 0xb4, 0xdb, 0xfe, 0x8c, 0xe0, 0x5e, 0xcf,
-	0xf0, 0x9e, 0x90, 0xf6, 0x84, 0x8d, 0x95, 0x11, 0xd6, 0x3d, 0x86, 0x7a, 0x98, 0x96, 0x4a, 0x37,
-	0x50, 0x0b, 0x75, 0xea, 0xdd, 0xc6, 0x8f, 0x6f, 0xfb, 0x5b, 0x99, 0xda, 0xb3, 0x30, 0xd4, 0xcc,
-	0x98, 0x97, 0x56, 0x0b, 0xc9, 0xfb, 0x0b, 0xaa, 0xbb, 0x03, 0xf5, 0x09, 0x1d, 0x8a, 0x90, 0xc6,
-	0x73, 0x77, 0xe2, 0xb9, 0xfe, 0xa2, 0xe1, 0x3e, 0x85, 0x75, 0x3a, 0x52, 0x91, 0xb4, 0x8d, 0x5a,
-	0x0b, 0x75, 0x36, 0x0e, 0x9b, 0x24, 0xd3, 0x8b, 0xa3, 0xe4, 0xf9, 0xc8, 0x73, 0x25, 0x64, 0x77,
-	0x6d, 0xfa, 0xeb, 0xa1, 0xd3, 0xcf, 0xe8, 0xed, 0x06, 0x6c, 0x2f, 0x1b, 0xec, 0x33, 0x33, 0x56,
-	0xd2, 0xb0, 0xf6, 0x57, 0x94, 0x40, 0x27, 0x6c, 0xc8, 0x38, 0xb5, 0xec, 0x16, 0x67, 0x68, 0x01,
-	0x2e, 0x37, 0x5a, 0x64, 0xf9, 0x84, 0xe0, 0x7e, 0xcf, 0xf0, 0xd7, 0xc2, 0x9e, 0x85, 0x9a, 0x9e,
-	0x77, 0x23, 0x2d, 0xdd, 0x3d, 0x58, 0x7b, 0xab, 0xd5, 0xe8, 0x9f, 0xfe, 0x13, 0xd6, 0xff, 0xb2,
-	0xde, 0x84, 0x07, 0xd7, 0x7c, 0x15, 0x9e, 0xbf, 0x20, 0x68, 0x5e, 0xc3, 0x5e, 0xc9, 0x30, 0x0b,
-	0x79, 0x3b, 0xdc, 0xef, 0xc2, 0xa3, 0x4a, 0x87, 0x79, 0x8e, 0xc3, 0xef, 0x35, 0xa8, 0xf5, 0x0c,
-	0x77, 0x4f, 0x61, 0xe3, 0xea, 0x0d, 0xed, 0x92, 0x92, 0x3f, 0x90, 0x2c, 0xdf, 0xa2, 0xf7, 0x64,
-	0x05, 0x52, 0xbe, 0xc8, 0x3d, 0x87, 0xcd, 0xb2, 0x63, 0xad, 0xd4, 0x28, 0x21, 0x7b, 0x47, 0x37,
-	0x20, 0x17, 0x8b, 0x03, 0xb8, 0xbb, 0x74, 0x59, 0x8f, 0xab, 0x44, 0xae, 0xb2, 0xbc, 0xbd, 0x55,
-	0x58, 0xc5, 0x8e, 0x0f, 0x08, 0xb6, 0x2b, 0x4e, 0x81, 0xac, 0x22, 0xb4, 0xe0, 0x7b, 0xc7, 0x37,
-	0xe3, 0xe7, 0x16, 0xba, 0x2f, 0xa6, 0x7f, 0xb0, 0x33, 0x9d, 0x61, 0x74, 0x39, 0xc3, 0xe8, 0xf7,
-	0x0c, 0xa3, 0x8f, 0x73, 0xec, 0x5c, 0xce, 0xb1, 0xf3, 0x73, 0x8e, 0x9d, 0x37, 0x1d, 0x2e, 0xec,
-	0x59, 0x14, 0x90, 0x81, 0x1a, 0xf9, 0xb1, 0xfe, 0xfe, 0x90, 0x06, 0x26, 0xa9, 0xfc, 0x8b, 0xfc,
-	0x15, 0xb6, 0xef, 0xc7, 0xcc, 0x04, 0xeb, 0xc9, 0xdb, 0x78, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x14, 0x23, 0x1c, 0x49, 0xa1, 0x05, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x34, 0xaa, 0x94, 0xb7, 0x2d, 0x48, 0x6e, 0x54, 0x12, 0xab, 0x72, 0xd3, 0x84,
+	0x21, 0x12, 0xad, 0xad, 0xb6, 0xa8, 0xcc, 0x84, 0x88, 0x2d, 0x8b, 0x11, 0x45, 0x62, 0x89, 0xce,
+	0xf6, 0xe1, 0x9e, 0x48, 0x7c, 0xd1, 0xdd, 0xc5, 0x2d, 0x4c, 0x7c, 0x04, 0x26, 0x56, 0xd8, 0xf8,
+	0x02, 0x7c, 0x02, 0xa6, 0x88, 0xa9, 0x62, 0x62, 0x42, 0x90, 0x7c, 0x11, 0xe4, 0xbf, 0xf9, 0x23,
+	0x5b, 0xc4, 0x03, 0x52, 0xb7, 0xf3, 0x3d, 0xcf, 0xfb, 0xbe, 0xcf, 0x4f, 0xf2, 0x6b, 0xc3, 0xfe,
+	0x1b, 0xec, 0x63, 0x83, 0xb3, 0xb1, 0x24, 0xdc, 0xf0, 0x4f, 0x2c, 0x22, 0xf1, 0x89, 0x21, 0xaf,
+	0xf5, 0x11, 0x67, 0x92, 0x29, 0xbb, 0x81, 0xaa, 0x47, 0xaa, 0x1e, 0xab, 0xaa, 0x66, 0x33, 0x31,
+	0x64, 0xc2, 0xb0, 0xb0, 0x20, 0x69, 0x89, 0xcd, 0xa8, 0x17, 0x15, 0xa9, 0xf5, 0x48, 0xef, 0x87,
+	0x4f, 0x46, 0xf4, 0x10, 0x4b, 0x55, 0x97, 0xb9, 0x2c, 0xba, 0x0f, 0x4e, 0xd1, 0x6d, 0xf3, 0x13,
+	0x82, 0xbb, 0x3d, 0xe1, 0xf6, 0xa8, 0x27, 0xbb, 0x64, 0xc4, 0x04, 0x95, 0xca, 0x39, 0x54, 0x9c,
+	0xe8, 0xc8, 0x78, 0x0d, 0x35, 0x50, 0xbb, 0xd2, 0xa9, 0xfd, 0xf8, 0x7a, 0x5c, 0x8d, 0xbb, 0x3d,
+	0x71, 0x1c, 0x4e, 0x84, 0x78, 0x2e, 0x39, 0xf5, 0x5c, 0x73, 0x6e, 0x55, 0xf6, 0xa1, 0xe2, 0xe3,
+	0x01, 0x75, 0x70, 0x50, 0x77, 0x27, 0xa8, 0x33, 0xe7, 0x17, 0xca, 0x63, 0xd8, 0xc4, 0x43, 0x36,
+	0xf6, 0x64, 0x6d, 0xa3, 0x81, 0xda, 0x5b, 0xa7, 0x75, 0x3d, 0xee, 0x17, 0xa0, 0x24, 0x7c, 0xfa,
+	0x53, 0x46, 0xbd, 0x4e, 0x79, 0xf2, 0xeb, 0xa0, 0x64, 0xc6, 0xf6, 0x66, 0x0d, 0xf6, 0x96, 0x03,
+	0x9a, 0x44, 0x8c, 0x98, 0x27, 0x48, 0xf3, 0x0b, 0x0a, 0xa5, 0x2e, 0x19, 0x10, 0x17, 0x4b, 0x72,
+	0x8b, 0x19, 0x1a, 0xa0, 0x65, 0x07, 0x4d, 0x59, 0x3e, 0x22, 0xb8, 0xd7, 0x13, 0xee, 0x4b, 0x2a,
+	0x2f, 0x1d, 0x8e, 0xaf, 0x3a, 0x63, 0xee, 0x29, 0x47, 0x50, 0x7e, 0xcd, 0xd9, 0xf0, 0x9f, 0xf9,
+	0x43, 0xd7, 0xff, 0x8a, 0x5e, 0x87, 0xfb, 0x2b, 0xb9, 0xd2, 0xcc, 0x9f, 0x11, 0xd4, 0x57, 0xb4,
+	0x17, 0x9e, 0x13, 0x43, 0xde, 0x8e, 0xf4, 0x2d, 0x38, 0xcc, 0x4d, 0x98, 0x72, 0x7c, 0x47, 0x21,
+	0xa3, 0x49, 0x12, 0xa5, 0x4b, 0x38, 0xf5, 0xb1, 0xa4, 0x7e, 0x51, 0x8a, 0x16, 0xec, 0x08, 0x6e,
+	0xf7, 0x57, 0x49, 0xb6, 0x05, 0xb7, 0x2f, 0x52, 0x98, 0x16, 0xec, 0x38, 0x42, 0x2e, 0x98, 0x36,
+	0x22, 0x93, 0x23, 0xe4, 0x45, 0x06, 0x71, 0xb9, 0x18, 0xf1, 0x21, 0x1c, 0xe4, 0xb0, 0x24, 0xbc,
+	0xa7, 0xdf, 0xca, 0xb0, 0xd1, 0x13, 0xae, 0xd2, 0x87, 0xad, 0xc5, 0x9d, 0x69, 0xe9, 0x19, 0x5f,
+	0x1c, 0x7d, 0x79, 0xf7, 0xd4, 0x87, 0x6b, 0x98, 0x92, 0x41, 0xca, 0x15, 0xec, 0x66, 0x2d, 0x67,
+	0x6e, 0x8f, 0x0c, 0xb3, 0x7a, 0x56, 0xc0, 0x9c, 0x0e, 0xb6, 0x60, 0x7b, 0x69, 0x93, 0x1e, 0xe4,
+	0x35, 0x59, 0x74, 0xa9, 0x47, 0xeb, 0xb8, 0xd2, 0x19, 0xef, 0x11, 0xec, 0xe5, 0xbc, 0xfa, 0xfa,
+	0x3a, 0x8d, 0xe6, 0x7e, 0xf5, 0xbc, 0x98, 0x3f, 0x8d, 0xf0, 0x0e, 0xaa, 0xd9, 0x2f, 0x6d, 0x5e,
+	0xbf, 0x2c, 0xb7, 0xfa, 0xa8, 0x88, 0x3b, 0x99, 0xdd, 0x79, 0x36, 0xf9, 0xa3, 0x95, 0x26, 0x53,
+	0x0d, 0xdd, 0x4c, 0x35, 0xf4, 0x7b, 0xaa, 0xa1, 0x0f, 0x33, 0xad, 0x74, 0x33, 0xd3, 0x4a, 0x3f,
+	0x67, 0x5a, 0xe9, 0x55, 0xdb, 0xa5, 0xf2, 0x72, 0x6c, 0xe9, 0x36, 0x1b, 0x1a, 0x41, 0xf7, 0xe3,
+	0x01, 0xb6, 0x44, 0x78, 0x32, 0xae, 0x93, 0x3f, 0x9e, 0x7c, 0x3b, 0x22, 0xc2, 0xda, 0x0c, 0xff,
+	0x43, 0x67, 0x7f, 0x07, 0x00, 0x88, 0x38, 0xec, 0x19, 0x0d, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -421,6 +514,10 @@ type MsgClient interface {
 	// WithdrawBurnUndelegate removes staking derivatives from an earn vault, converts them to a staking delegation,
 	// then undelegates them from their validator.
 	WithdrawBurnUndelegate(ctx context.Context, in *MsgWithdrawBurnUndelegate, opts ...grpc.CallOption) (*MsgWithdrawBurnUndelegateResponse, error)
+	// RedelegateDerivative converts staking derivatives of one validator into staking derivatives of another
+	// validator by burning them, redelegating the delegation, then minting them again. Derivatives deposited in
+	// an earn vault are withdrawn and the new derivatives deposited back if the redelegation completes immediately.
+	RedelegateDerivative(ctx context.Context, in *MsgRedelegateDerivative, opts ...grpc.CallOption) (*MsgRedelegateDerivativeResponse, error)
 }
 
 type msgClient struct {
@@ -467,6 +564,15 @@ func (c *msgClient) WithdrawBurnUndelegate(ctx context.Context, in *MsgWithdrawB
 	return out, nil
 }
 
+func (c *msgClient) RedelegateDerivative(ctx context.Context, in *MsgRedelegateDerivative, opts ...grpc.CallOption) (*MsgRedelegateDerivativeResponse, error) {
+	out := new(MsgRedelegateDerivativeResponse)
+	err := c.cc.Invoke(ctx, "/kava.router.v1beta1.Msg/RedelegateDerivative", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MintDeposit converts a delegation into staking derivatives and deposits it all into an earn vault.
@@ -479,6 +585,10 @@ type MsgServer interface {
 	// WithdrawBurnUndelegate removes staking derivatives from an earn vault, converts them to a staking delegation,
 	// then undelegates them from their validator.
 	WithdrawBurnUndelegate(context.Context, *MsgWithdrawBurnUndelegate) (*MsgWithdrawBurnUndelegateResponse, error)
+	// RedelegateDerivative converts staking derivatives of one validator into staking derivatives of another
+	// validator by burning them, redelegating the delegation, then minting them again. Derivatives deposited in
+	// an earn vault are withdrawn and the new derivatives deposited back if the redelegation completes immediately.
+	RedelegateDerivative(context.Context, *MsgRedelegateDerivative) (*MsgRedelegateDerivativeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -497,6 +607,9 @@ func (*UnimplementedMsgServer) WithdrawBurn(ctx context.Context, req *MsgWithdra
 func (*UnimplementedMsgServer) WithdrawBurnUndelegate(ctx context.Context, req *MsgWithdrawBurnUndelegate) (*MsgWithdrawBurnUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBurnUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) RedelegateDerivative(ctx context.Context, req *MsgRedelegateDerivative) (*MsgRedelegateDerivativeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateDerivative not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -574,6 +687,24 @@ func _Msg_WithdrawBurnUndelegate_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RedelegateDerivative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateDerivative)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateDerivative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.router.v1beta1.Msg/RedelegateDerivative",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateDerivative(ctx, req.(*MsgRedelegateDerivative))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.router.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -594,6 +725,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawBurnUndelegate",
 			Handler:    _Msg_WithdrawBurnUndelegate_Handler,
 		},
+		{
+			MethodName: "RedelegateDerivative",
+			Handler:    _Msg_RedelegateDerivative_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/router/v1beta1/tx.proto",
@@ -879,6 +1014,83 @@ func (m *MsgWithdrawBurnUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRedelegateDerivative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegateDerivative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegateDerivative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstValidator) > 0 {
+		i -= len(m.DstValidator)
+		copy(dAtA[i:], m.DstValidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstValidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidator) > 0 {
+		i -= len(m.SrcValidator)
+		copy(dAtA[i:], m.SrcValidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcValidator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegateDerivativeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegateDerivativeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegateDerivativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1002,6 +1214,38 @@ func (m *MsgWithdrawBurnUndelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRedelegateDerivative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcValidator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstValidator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedelegateDerivativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1796,6 +2040,235 @@ func (m *MsgWithdrawBurnUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRedelegateDerivative) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegateDerivative: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegateDerivative: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedelegateDerivativeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegateDerivativeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegateDerivativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0